@@ -32,6 +32,33 @@ const (
 	// resource.
 	AnnotationKeyDescription = "kargo.akuity.io/description"
 
+	// AnnotationKeySyncBackoffPolicy is an annotation key that can be set on a
+	// Stage resource to control how long the controller waits before retrying
+	// after a failed reconciliation. The value of the annotation must be a JSON
+	// object with the structure of the SyncBackoffPolicy.
+	AnnotationKeySyncBackoffPolicy = "kargo.akuity.io/sync-backoff-policy"
+
+	// AnnotationKeyHealthCheckOnly is an annotation key that can be set on a
+	// Stage resource to restrict its reconciliation to re-assessing the health
+	// of its current Freight. While the value of the annotation is "true",
+	// Promotions are not synced, verification is not performed, and the
+	// Stage's Freight history is left untouched.
+	AnnotationKeyHealthCheckOnly = "kargo.akuity.io/health-check-only"
+
+	// AnnotationKeyPromoteFreight is an annotation key that can be set on a
+	// Stage resource to request the promotion of a specific piece of Freight to
+	// the Stage, regardless of whether auto-promotion is permitted. The value of
+	// the annotation must be the name of the Freight. The controller removes
+	// the annotation once the request has been handled.
+	AnnotationKeyPromoteFreight = "kargo.akuity.io/promote-freight"
+
+	// AnnotationKeyCredentialVersion is an annotation key that can be set on a
+	// Secret holding credentials to signal that the credentials have been
+	// rotated. Any change to the value of the annotation causes credentials
+	// derived from the Secret, such as short-lived access tokens, to be looked
+	// up afresh instead of being served from a cache.
+	AnnotationKeyCredentialVersion = "kargo.akuity.io/credential-version"
+
 	AnnotationValueTrue = "true"
 )
 
@@ -97,3 +124,37 @@ func AbortAnnotationValue(annotations map[string]string) (*VerificationRequest,
 	}
 	return &vr, ok
 }
+
+// SyncBackoffPolicyAnnotationValue returns the value of the
+// AnnotationKeySyncBackoffPolicy annotation unmarshalled into a
+// SyncBackoffPolicy, and a boolean indicating whether the annotation was
+// present and valid.
+func SyncBackoffPolicyAnnotationValue(annotations map[string]string) (*SyncBackoffPolicy, bool) {
+	requested, ok := annotations[AnnotationKeySyncBackoffPolicy]
+	if !ok {
+		return nil, ok
+	}
+	var policy SyncBackoffPolicy
+	if err := json.Unmarshal([]byte(requested), &policy); err != nil {
+		return nil, false
+	}
+	if !policy.IsValid() {
+		return nil, false
+	}
+	return &policy, ok
+}
+
+// HealthCheckOnlyAnnotationValue returns true if the
+// AnnotationKeyHealthCheckOnly annotation is present and set to "true".
+func HealthCheckOnlyAnnotationValue(annotations map[string]string) bool {
+	return annotations[AnnotationKeyHealthCheckOnly] == AnnotationValueTrue
+}
+
+// PromoteFreightAnnotationValue returns the value of the
+// AnnotationKeyPromoteFreight annotation, which is the name of the Freight
+// whose promotion has been requested, and a boolean indicating whether the
+// annotation was present with a non-empty value.
+func PromoteFreightAnnotationValue(annotations map[string]string) (string, bool) {
+	freight := annotations[AnnotationKeyPromoteFreight]
+	return freight, freight != ""
+}
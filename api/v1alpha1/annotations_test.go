@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -93,3 +94,79 @@ func TestAbortAnnotationValue(t *testing.T) {
 		require.Nil(t, result)
 	})
 }
+
+func TestSyncBackoffPolicyAnnotationValue(t *testing.T) {
+	t.Run("has sync backoff policy annotation with valid JSON", func(t *testing.T) {
+		result, ok := SyncBackoffPolicyAnnotationValue(map[string]string{
+			AnnotationKeySyncBackoffPolicy: `{"initialDelay":"10s","multiplier":2,"maxDelay":"5m"}`,
+		})
+		require.True(t, ok)
+		require.Equal(t, 10*time.Second, result.InitialDelay.Duration)
+		require.Equal(t, float64(2), result.Multiplier)
+		require.Equal(t, 5*time.Minute, result.MaxDelay.Duration)
+	})
+
+	t.Run("does not have sync backoff policy annotation", func(t *testing.T) {
+		result, ok := SyncBackoffPolicyAnnotationValue(nil)
+		require.False(t, ok)
+		require.Nil(t, result)
+	})
+
+	t.Run("has sync backoff policy annotation with invalid JSON", func(t *testing.T) {
+		result, ok := SyncBackoffPolicyAnnotationValue(map[string]string{
+			AnnotationKeySyncBackoffPolicy: "10s",
+		})
+		require.False(t, ok)
+		require.Nil(t, result)
+	})
+
+	t.Run("has sync backoff policy annotation with invalid policy", func(t *testing.T) {
+		result, ok := SyncBackoffPolicyAnnotationValue(map[string]string{
+			AnnotationKeySyncBackoffPolicy: `{"initialDelay":"10s","multiplier":0.5,"maxDelay":"5m"}`,
+		})
+		require.False(t, ok)
+		require.Nil(t, result)
+	})
+}
+
+func TestHealthCheckOnlyAnnotationValue(t *testing.T) {
+	t.Run("has health check only annotation", func(t *testing.T) {
+		require.True(t, HealthCheckOnlyAnnotationValue(map[string]string{
+			AnnotationKeyHealthCheckOnly: AnnotationValueTrue,
+		}))
+	})
+
+	t.Run("does not have health check only annotation", func(t *testing.T) {
+		require.False(t, HealthCheckOnlyAnnotationValue(nil))
+	})
+
+	t.Run("has health check only annotation with other value", func(t *testing.T) {
+		require.False(t, HealthCheckOnlyAnnotationValue(map[string]string{
+			AnnotationKeyHealthCheckOnly: "false",
+		}))
+	})
+}
+
+func TestPromoteFreightAnnotationValue(t *testing.T) {
+	t.Run("has promote freight annotation", func(t *testing.T) {
+		result, ok := PromoteFreightAnnotationValue(map[string]string{
+			AnnotationKeyPromoteFreight: "fake-freight",
+		})
+		require.True(t, ok)
+		require.Equal(t, "fake-freight", result)
+	})
+
+	t.Run("does not have promote freight annotation", func(t *testing.T) {
+		result, ok := PromoteFreightAnnotationValue(nil)
+		require.False(t, ok)
+		require.Empty(t, result)
+	})
+
+	t.Run("has promote freight annotation with empty value", func(t *testing.T) {
+		result, ok := PromoteFreightAnnotationValue(map[string]string{
+			AnnotationKeyPromoteFreight: "",
+		})
+		require.False(t, ok)
+		require.Empty(t, result)
+	})
+}
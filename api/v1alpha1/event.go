@@ -34,6 +34,7 @@ const (
 	EventReasonPromotionSucceeded              = "PromotionSucceeded"
 	EventReasonPromotionFailed                 = "PromotionFailed"
 	EventReasonPromotionErrored                = "PromotionErrored"
+	EventReasonPromotionTimedOut               = "PromotionTimedOut"
 	EventReasonFreightApproved                 = "FreightApproved"
 	EventReasonFreightVerificationSucceeded    = "FreightVerificationSucceeded"
 	EventReasonFreightVerificationFailed       = "FreightVerificationFailed"
@@ -41,6 +42,12 @@ const (
 	EventReasonFreightVerificationAborted      = "FreightVerificationAborted"
 	EventReasonFreightVerificationInconclusive = "FreightVerificationInconclusive"
 	EventReasonFreightVerificationUnknown      = "FreightVerificationUnknown"
+	EventReasonPromoted                        = "Promoted"
+	EventReasonHealthDegraded                  = "HealthDegraded"
+	EventReasonCircuitOpened                   = "CircuitOpened"
+	EventReasonCircuitClosed                   = "CircuitClosed"
+	EventReasonPinnedFreightSuperseded         = "PinnedFreightSuperseded"
+	EventReasonAutoPromotionExpressionFailed   = "AutoPromotionExpressionFailed"
 )
 
 const (
@@ -118,3 +118,32 @@ func IsFreightAvailable(
 	}
 	return false
 }
+
+// IsFreightAvailableFromSources answers whether the specified Freight is
+// available to the specified Stage from the provided FreightSources. It behaves
+// like IsFreightAvailable, considering the upstream Stages of the
+// FreightSources, except when the FreightSources' AvailabilityStrategy is
+// FreightAvailabilityStrategyAll. In that case, Freight that is not approved
+// for the specified Stage is only available once it has been verified in ALL
+// of the upstream Stages.
+func IsFreightAvailableFromSources(
+	freight *Freight,
+	stage string,
+	sources FreightSources,
+) bool {
+	if sources.AvailabilityStrategy != FreightAvailabilityStrategyAll ||
+		len(sources.Stages) == 0 {
+		return IsFreightAvailable(freight, stage, sources.Stages)
+	}
+	if stage != "" {
+		if _, ok := freight.Status.ApprovedFor[stage]; ok {
+			return true
+		}
+	}
+	for _, upstream := range sources.Stages {
+		if _, ok := freight.Status.VerifiedIn[upstream]; !ok {
+			return false
+		}
+	}
+	return true
+}
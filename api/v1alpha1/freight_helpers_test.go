@@ -168,3 +168,77 @@ func TestIsFreightAvailable(t *testing.T) {
 		})
 	}
 }
+
+func TestIsFreightAvailableFromSources(t *testing.T) {
+	testFreight := &Freight{
+		Status: FreightStatus{
+			VerifiedIn: map[string]VerifiedStage{
+				"fake-stage-1": {},
+				"fake-stage-2": {},
+			},
+			ApprovedFor: map[string]ApprovedStage{
+				"fake-stage-3": {},
+			},
+		},
+	}
+	testCases := []struct {
+		name      string
+		stage     string
+		sources   FreightSources
+		available bool
+	}{
+		{
+			name: "no upstream Stages specified",
+			sources: FreightSources{
+				Direct:               true,
+				AvailabilityStrategy: FreightAvailabilityStrategyAll,
+			},
+			available: true,
+		},
+		{
+			name: "verified in one of two upstream Stages with OneOf strategy",
+			sources: FreightSources{
+				Stages: []string{"fake-stage-1", "fake-stage-4"},
+			},
+			available: true,
+		},
+		{
+			name: "verified in two of two upstream Stages with All strategy",
+			sources: FreightSources{
+				Stages:               []string{"fake-stage-1", "fake-stage-2"},
+				AvailabilityStrategy: FreightAvailabilityStrategyAll,
+			},
+			available: true,
+		},
+		{
+			name: "verified in one of two upstream Stages with All strategy",
+			sources: FreightSources{
+				Stages:               []string{"fake-stage-1", "fake-stage-4"},
+				AvailabilityStrategy: FreightAvailabilityStrategyAll,
+			},
+			available: false,
+		},
+		{
+			name:  "approved for Stage with All strategy",
+			stage: "fake-stage-3",
+			sources: FreightSources{
+				Stages:               []string{"fake-stage-1", "fake-stage-4"},
+				AvailabilityStrategy: FreightAvailabilityStrategyAll,
+			},
+			available: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.available,
+				IsFreightAvailableFromSources(
+					testFreight,
+					testCase.stage,
+					testCase.sources,
+				),
+			)
+		})
+	}
+}
@@ -268,6 +268,23 @@ func TestFreightGenerateID(t *testing.T) {
 	for i := 0; i < 100; i++ {
 		require.Equal(t, expected, freight.GenerateID())
 	}
+	// Separately discovered Freight with equivalent contents, even if listed in
+	// a different order, should yield the same ID
+	equivalent := freight.DeepCopy()
+	equivalent.Name = "fake-freight"
+	equivalent.Images = append(
+		[]Image{{RepoURL: "another-fake-image-repo", Tag: "fake-image-tag"}},
+		equivalent.Images...,
+	)
+	freight.Images = append(
+		freight.Images,
+		Image{RepoURL: "another-fake-image-repo", Tag: "fake-image-tag"},
+	)
+	expected = freight.GenerateID()
+	require.Equal(t, expected, equivalent.GenerateID())
+	// Changing the origin should change the result
+	equivalent.Origin.Name = "a-different-fake-name"
+	require.NotEqual(t, expected, equivalent.GenerateID())
 	// Changing anything should change the result
 	freight.Commits[0].ID = "a-different-fake-commit"
 	require.NotEqual(t, expected, freight.GenerateID())
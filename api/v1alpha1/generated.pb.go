@@ -10,7 +10,10 @@ import (
 
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	v11 "k8s.io/api/core/v1"
+	v13 "k8s.io/api/rbac/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	math "math"
 	math_bits "math/bits"
@@ -169,10 +172,38 @@ func (m *ApprovedStage) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApprovedStage proto.InternalMessageInfo
 
+func (m *ArgoCDAppHealthCheck) Reset()      { *m = ArgoCDAppHealthCheck{} }
+func (*ArgoCDAppHealthCheck) ProtoMessage() {}
+func (*ArgoCDAppHealthCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{5}
+}
+func (m *ArgoCDAppHealthCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArgoCDAppHealthCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArgoCDAppHealthCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArgoCDAppHealthCheck.Merge(m, src)
+}
+func (m *ArgoCDAppHealthCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArgoCDAppHealthCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArgoCDAppHealthCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArgoCDAppHealthCheck proto.InternalMessageInfo
+
 func (m *ArgoCDAppHealthStatus) Reset()      { *m = ArgoCDAppHealthStatus{} }
 func (*ArgoCDAppHealthStatus) ProtoMessage() {}
 func (*ArgoCDAppHealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{5}
+	return fileDescriptor_e26b7f7bbc391025, []int{6}
 }
 func (m *ArgoCDAppHealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -200,7 +231,7 @@ var xxx_messageInfo_ArgoCDAppHealthStatus proto.InternalMessageInfo
 func (m *ArgoCDAppStatus) Reset()      { *m = ArgoCDAppStatus{} }
 func (*ArgoCDAppStatus) ProtoMessage() {}
 func (*ArgoCDAppStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{6}
+	return fileDescriptor_e26b7f7bbc391025, []int{7}
 }
 func (m *ArgoCDAppStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -225,10 +256,38 @@ func (m *ArgoCDAppStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArgoCDAppStatus proto.InternalMessageInfo
 
+func (m *ArgoCDAppStatusCheck) Reset()      { *m = ArgoCDAppStatusCheck{} }
+func (*ArgoCDAppStatusCheck) ProtoMessage() {}
+func (*ArgoCDAppStatusCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{8}
+}
+func (m *ArgoCDAppStatusCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArgoCDAppStatusCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArgoCDAppStatusCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArgoCDAppStatusCheck.Merge(m, src)
+}
+func (m *ArgoCDAppStatusCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArgoCDAppStatusCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArgoCDAppStatusCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArgoCDAppStatusCheck proto.InternalMessageInfo
+
 func (m *ArgoCDAppSyncStatus) Reset()      { *m = ArgoCDAppSyncStatus{} }
 func (*ArgoCDAppSyncStatus) ProtoMessage() {}
 func (*ArgoCDAppSyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{7}
+	return fileDescriptor_e26b7f7bbc391025, []int{9}
 }
 func (m *ArgoCDAppSyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +315,7 @@ var xxx_messageInfo_ArgoCDAppSyncStatus proto.InternalMessageInfo
 func (m *ArgoCDAppUpdate) Reset()      { *m = ArgoCDAppUpdate{} }
 func (*ArgoCDAppUpdate) ProtoMessage() {}
 func (*ArgoCDAppUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{8}
+	return fileDescriptor_e26b7f7bbc391025, []int{10}
 }
 func (m *ArgoCDAppUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,7 +343,7 @@ var xxx_messageInfo_ArgoCDAppUpdate proto.InternalMessageInfo
 func (m *ArgoCDHelm) Reset()      { *m = ArgoCDHelm{} }
 func (*ArgoCDHelm) ProtoMessage() {}
 func (*ArgoCDHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{9}
+	return fileDescriptor_e26b7f7bbc391025, []int{11}
 }
 func (m *ArgoCDHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -312,7 +371,7 @@ var xxx_messageInfo_ArgoCDHelm proto.InternalMessageInfo
 func (m *ArgoCDHelmImageUpdate) Reset()      { *m = ArgoCDHelmImageUpdate{} }
 func (*ArgoCDHelmImageUpdate) ProtoMessage() {}
 func (*ArgoCDHelmImageUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{10}
+	return fileDescriptor_e26b7f7bbc391025, []int{12}
 }
 func (m *ArgoCDHelmImageUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,7 +399,7 @@ var xxx_messageInfo_ArgoCDHelmImageUpdate proto.InternalMessageInfo
 func (m *ArgoCDKustomize) Reset()      { *m = ArgoCDKustomize{} }
 func (*ArgoCDKustomize) ProtoMessage() {}
 func (*ArgoCDKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{11}
+	return fileDescriptor_e26b7f7bbc391025, []int{13}
 }
 func (m *ArgoCDKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,7 +427,7 @@ var xxx_messageInfo_ArgoCDKustomize proto.InternalMessageInfo
 func (m *ArgoCDKustomizeImageUpdate) Reset()      { *m = ArgoCDKustomizeImageUpdate{} }
 func (*ArgoCDKustomizeImageUpdate) ProtoMessage() {}
 func (*ArgoCDKustomizeImageUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{12}
+	return fileDescriptor_e26b7f7bbc391025, []int{14}
 }
 func (m *ArgoCDKustomizeImageUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,7 +455,7 @@ var xxx_messageInfo_ArgoCDKustomizeImageUpdate proto.InternalMessageInfo
 func (m *ArgoCDSourceUpdate) Reset()      { *m = ArgoCDSourceUpdate{} }
 func (*ArgoCDSourceUpdate) ProtoMessage() {}
 func (*ArgoCDSourceUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{13}
+	return fileDescriptor_e26b7f7bbc391025, []int{15}
 }
 func (m *ArgoCDSourceUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,10 +480,66 @@ func (m *ArgoCDSourceUpdate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArgoCDSourceUpdate proto.InternalMessageInfo
 
+func (m *CanaryPromotion) Reset()      { *m = CanaryPromotion{} }
+func (*CanaryPromotion) ProtoMessage() {}
+func (*CanaryPromotion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{16}
+}
+func (m *CanaryPromotion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanaryPromotion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CanaryPromotion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanaryPromotion.Merge(m, src)
+}
+func (m *CanaryPromotion) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanaryPromotion) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanaryPromotion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanaryPromotion proto.InternalMessageInfo
+
+func (m *ChangeApprovalCheck) Reset()      { *m = ChangeApprovalCheck{} }
+func (*ChangeApprovalCheck) ProtoMessage() {}
+func (*ChangeApprovalCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{17}
+}
+func (m *ChangeApprovalCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangeApprovalCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChangeApprovalCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeApprovalCheck.Merge(m, src)
+}
+func (m *ChangeApprovalCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangeApprovalCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeApprovalCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeApprovalCheck proto.InternalMessageInfo
+
 func (m *Chart) Reset()      { *m = Chart{} }
 func (*Chart) ProtoMessage() {}
 func (*Chart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{14}
+	return fileDescriptor_e26b7f7bbc391025, []int{18}
 }
 func (m *Chart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,10 +564,38 @@ func (m *Chart) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Chart proto.InternalMessageInfo
 
+func (m *ChartChange) Reset()      { *m = ChartChange{} }
+func (*ChartChange) ProtoMessage() {}
+func (*ChartChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{19}
+}
+func (m *ChartChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChartChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChartChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChartChange.Merge(m, src)
+}
+func (m *ChartChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChartChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChartChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChartChange proto.InternalMessageInfo
+
 func (m *ChartDiscoveryResult) Reset()      { *m = ChartDiscoveryResult{} }
 func (*ChartDiscoveryResult) ProtoMessage() {}
 func (*ChartDiscoveryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{15}
+	return fileDescriptor_e26b7f7bbc391025, []int{20}
 }
 func (m *ChartDiscoveryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,7 +623,7 @@ var xxx_messageInfo_ChartDiscoveryResult proto.InternalMessageInfo
 func (m *ChartSubscription) Reset()      { *m = ChartSubscription{} }
 func (*ChartSubscription) ProtoMessage() {}
 func (*ChartSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{16}
+	return fileDescriptor_e26b7f7bbc391025, []int{21}
 }
 func (m *ChartSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,10 +648,66 @@ func (m *ChartSubscription) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ChartSubscription proto.InternalMessageInfo
 
+func (m *CircuitBreaker) Reset()      { *m = CircuitBreaker{} }
+func (*CircuitBreaker) ProtoMessage() {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{22}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreakerStatus) Reset()      { *m = CircuitBreakerStatus{} }
+func (*CircuitBreakerStatus) ProtoMessage() {}
+func (*CircuitBreakerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{23}
+}
+func (m *CircuitBreakerStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CircuitBreakerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerStatus.Merge(m, src)
+}
+func (m *CircuitBreakerStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerStatus proto.InternalMessageInfo
+
 func (m *DiscoveredArtifacts) Reset()      { *m = DiscoveredArtifacts{} }
 func (*DiscoveredArtifacts) ProtoMessage() {}
 func (*DiscoveredArtifacts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{17}
+	return fileDescriptor_e26b7f7bbc391025, []int{24}
 }
 func (m *DiscoveredArtifacts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -536,7 +735,7 @@ var xxx_messageInfo_DiscoveredArtifacts proto.InternalMessageInfo
 func (m *DiscoveredCommit) Reset()      { *m = DiscoveredCommit{} }
 func (*DiscoveredCommit) ProtoMessage() {}
 func (*DiscoveredCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{18}
+	return fileDescriptor_e26b7f7bbc391025, []int{25}
 }
 func (m *DiscoveredCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -564,7 +763,7 @@ var xxx_messageInfo_DiscoveredCommit proto.InternalMessageInfo
 func (m *DiscoveredImageReference) Reset()      { *m = DiscoveredImageReference{} }
 func (*DiscoveredImageReference) ProtoMessage() {}
 func (*DiscoveredImageReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{19}
+	return fileDescriptor_e26b7f7bbc391025, []int{26}
 }
 func (m *DiscoveredImageReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,10 +788,38 @@ func (m *DiscoveredImageReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DiscoveredImageReference proto.InternalMessageInfo
 
+func (m *FluxHelmReleaseUpdate) Reset()      { *m = FluxHelmReleaseUpdate{} }
+func (*FluxHelmReleaseUpdate) ProtoMessage() {}
+func (*FluxHelmReleaseUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{27}
+}
+func (m *FluxHelmReleaseUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FluxHelmReleaseUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FluxHelmReleaseUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FluxHelmReleaseUpdate.Merge(m, src)
+}
+func (m *FluxHelmReleaseUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FluxHelmReleaseUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FluxHelmReleaseUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FluxHelmReleaseUpdate proto.InternalMessageInfo
+
 func (m *Freight) Reset()      { *m = Freight{} }
 func (*Freight) ProtoMessage() {}
 func (*Freight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{20}
+	return fileDescriptor_e26b7f7bbc391025, []int{28}
 }
 func (m *Freight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,7 +847,7 @@ var xxx_messageInfo_Freight proto.InternalMessageInfo
 func (m *FreightCollection) Reset()      { *m = FreightCollection{} }
 func (*FreightCollection) ProtoMessage() {}
 func (*FreightCollection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{21}
+	return fileDescriptor_e26b7f7bbc391025, []int{29}
 }
 func (m *FreightCollection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,10 +872,38 @@ func (m *FreightCollection) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FreightCollection proto.InternalMessageInfo
 
+func (m *FreightCollectionDelta) Reset()      { *m = FreightCollectionDelta{} }
+func (*FreightCollectionDelta) ProtoMessage() {}
+func (*FreightCollectionDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{30}
+}
+func (m *FreightCollectionDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreightCollectionDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FreightCollectionDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreightCollectionDelta.Merge(m, src)
+}
+func (m *FreightCollectionDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreightCollectionDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreightCollectionDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreightCollectionDelta proto.InternalMessageInfo
+
 func (m *FreightList) Reset()      { *m = FreightList{} }
 func (*FreightList) ProtoMessage() {}
 func (*FreightList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{22}
+	return fileDescriptor_e26b7f7bbc391025, []int{31}
 }
 func (m *FreightList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -676,7 +931,7 @@ var xxx_messageInfo_FreightList proto.InternalMessageInfo
 func (m *FreightOrigin) Reset()      { *m = FreightOrigin{} }
 func (*FreightOrigin) ProtoMessage() {}
 func (*FreightOrigin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{23}
+	return fileDescriptor_e26b7f7bbc391025, []int{32}
 }
 func (m *FreightOrigin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -704,7 +959,7 @@ var xxx_messageInfo_FreightOrigin proto.InternalMessageInfo
 func (m *FreightReference) Reset()      { *m = FreightReference{} }
 func (*FreightReference) ProtoMessage() {}
 func (*FreightReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{24}
+	return fileDescriptor_e26b7f7bbc391025, []int{33}
 }
 func (m *FreightReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,7 +987,7 @@ var xxx_messageInfo_FreightReference proto.InternalMessageInfo
 func (m *FreightRequest) Reset()      { *m = FreightRequest{} }
 func (*FreightRequest) ProtoMessage() {}
 func (*FreightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{25}
+	return fileDescriptor_e26b7f7bbc391025, []int{34}
 }
 func (m *FreightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -760,7 +1015,7 @@ var xxx_messageInfo_FreightRequest proto.InternalMessageInfo
 func (m *FreightSources) Reset()      { *m = FreightSources{} }
 func (*FreightSources) ProtoMessage() {}
 func (*FreightSources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{26}
+	return fileDescriptor_e26b7f7bbc391025, []int{35}
 }
 func (m *FreightSources) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -788,7 +1043,7 @@ var xxx_messageInfo_FreightSources proto.InternalMessageInfo
 func (m *FreightStatus) Reset()      { *m = FreightStatus{} }
 func (*FreightStatus) ProtoMessage() {}
 func (*FreightStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{27}
+	return fileDescriptor_e26b7f7bbc391025, []int{36}
 }
 func (m *FreightStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -816,7 +1071,7 @@ var xxx_messageInfo_FreightStatus proto.InternalMessageInfo
 func (m *GitCommit) Reset()      { *m = GitCommit{} }
 func (*GitCommit) ProtoMessage() {}
 func (*GitCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{28}
+	return fileDescriptor_e26b7f7bbc391025, []int{37}
 }
 func (m *GitCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -844,7 +1099,7 @@ var xxx_messageInfo_GitCommit proto.InternalMessageInfo
 func (m *GitDiscoveryResult) Reset()      { *m = GitDiscoveryResult{} }
 func (*GitDiscoveryResult) ProtoMessage() {}
 func (*GitDiscoveryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{29}
+	return fileDescriptor_e26b7f7bbc391025, []int{38}
 }
 func (m *GitDiscoveryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -872,7 +1127,7 @@ var xxx_messageInfo_GitDiscoveryResult proto.InternalMessageInfo
 func (m *GitHubPullRequest) Reset()      { *m = GitHubPullRequest{} }
 func (*GitHubPullRequest) ProtoMessage() {}
 func (*GitHubPullRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{30}
+	return fileDescriptor_e26b7f7bbc391025, []int{39}
 }
 func (m *GitHubPullRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -900,7 +1155,7 @@ var xxx_messageInfo_GitHubPullRequest proto.InternalMessageInfo
 func (m *GitLabPullRequest) Reset()      { *m = GitLabPullRequest{} }
 func (*GitLabPullRequest) ProtoMessage() {}
 func (*GitLabPullRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{31}
+	return fileDescriptor_e26b7f7bbc391025, []int{40}
 }
 func (m *GitLabPullRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -928,7 +1183,7 @@ var xxx_messageInfo_GitLabPullRequest proto.InternalMessageInfo
 func (m *GitRepoUpdate) Reset()      { *m = GitRepoUpdate{} }
 func (*GitRepoUpdate) ProtoMessage() {}
 func (*GitRepoUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{32}
+	return fileDescriptor_e26b7f7bbc391025, []int{41}
 }
 func (m *GitRepoUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -956,7 +1211,7 @@ var xxx_messageInfo_GitRepoUpdate proto.InternalMessageInfo
 func (m *GitSubscription) Reset()      { *m = GitSubscription{} }
 func (*GitSubscription) ProtoMessage() {}
 func (*GitSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{33}
+	return fileDescriptor_e26b7f7bbc391025, []int{42}
 }
 func (m *GitSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -981,10 +1236,38 @@ func (m *GitSubscription) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GitSubscription proto.InternalMessageInfo
 
+func (m *HTTPHealthCheck) Reset()      { *m = HTTPHealthCheck{} }
+func (*HTTPHealthCheck) ProtoMessage() {}
+func (*HTTPHealthCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{43}
+}
+func (m *HTTPHealthCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPHealthCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPHealthCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPHealthCheck.Merge(m, src)
+}
+func (m *HTTPHealthCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPHealthCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPHealthCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPHealthCheck proto.InternalMessageInfo
+
 func (m *Health) Reset()      { *m = Health{} }
 func (*Health) ProtoMessage() {}
 func (*Health) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{34}
+	return fileDescriptor_e26b7f7bbc391025, []int{44}
 }
 func (m *Health) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1009,10 +1292,38 @@ func (m *Health) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Health proto.InternalMessageInfo
 
+func (m *HealthChecks) Reset()      { *m = HealthChecks{} }
+func (*HealthChecks) ProtoMessage() {}
+func (*HealthChecks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{45}
+}
+func (m *HealthChecks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HealthChecks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HealthChecks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthChecks.Merge(m, src)
+}
+func (m *HealthChecks) XXX_Size() int {
+	return m.Size()
+}
+func (m *HealthChecks) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthChecks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthChecks proto.InternalMessageInfo
+
 func (m *HelmChartDependencyUpdate) Reset()      { *m = HelmChartDependencyUpdate{} }
 func (*HelmChartDependencyUpdate) ProtoMessage() {}
 func (*HelmChartDependencyUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{35}
+	return fileDescriptor_e26b7f7bbc391025, []int{46}
 }
 func (m *HelmChartDependencyUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1040,7 +1351,7 @@ var xxx_messageInfo_HelmChartDependencyUpdate proto.InternalMessageInfo
 func (m *HelmImageUpdate) Reset()      { *m = HelmImageUpdate{} }
 func (*HelmImageUpdate) ProtoMessage() {}
 func (*HelmImageUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{36}
+	return fileDescriptor_e26b7f7bbc391025, []int{47}
 }
 func (m *HelmImageUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1068,7 +1379,7 @@ var xxx_messageInfo_HelmImageUpdate proto.InternalMessageInfo
 func (m *HelmPromotionMechanism) Reset()      { *m = HelmPromotionMechanism{} }
 func (*HelmPromotionMechanism) ProtoMessage() {}
 func (*HelmPromotionMechanism) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{37}
+	return fileDescriptor_e26b7f7bbc391025, []int{48}
 }
 func (m *HelmPromotionMechanism) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1093,10 +1404,38 @@ func (m *HelmPromotionMechanism) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HelmPromotionMechanism proto.InternalMessageInfo
 
-func (m *Image) Reset()      { *m = Image{} }
-func (*Image) ProtoMessage() {}
-func (*Image) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{38}
+func (m *HelmValuesFileUpdate) Reset()      { *m = HelmValuesFileUpdate{} }
+func (*HelmValuesFileUpdate) ProtoMessage() {}
+func (*HelmValuesFileUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{49}
+}
+func (m *HelmValuesFileUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmValuesFileUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HelmValuesFileUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmValuesFileUpdate.Merge(m, src)
+}
+func (m *HelmValuesFileUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmValuesFileUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmValuesFileUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmValuesFileUpdate proto.InternalMessageInfo
+
+func (m *Image) Reset()      { *m = Image{} }
+func (*Image) ProtoMessage() {}
+func (*Image) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{50}
 }
 func (m *Image) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1124,7 +1463,7 @@ var xxx_messageInfo_Image proto.InternalMessageInfo
 func (m *ImageDiscoveryResult) Reset()      { *m = ImageDiscoveryResult{} }
 func (*ImageDiscoveryResult) ProtoMessage() {}
 func (*ImageDiscoveryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{39}
+	return fileDescriptor_e26b7f7bbc391025, []int{51}
 }
 func (m *ImageDiscoveryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1149,10 +1488,38 @@ func (m *ImageDiscoveryResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ImageDiscoveryResult proto.InternalMessageInfo
 
+func (m *ImagePullCheck) Reset()      { *m = ImagePullCheck{} }
+func (*ImagePullCheck) ProtoMessage() {}
+func (*ImagePullCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{52}
+}
+func (m *ImagePullCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImagePullCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ImagePullCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImagePullCheck.Merge(m, src)
+}
+func (m *ImagePullCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImagePullCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImagePullCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImagePullCheck proto.InternalMessageInfo
+
 func (m *ImageSubscription) Reset()      { *m = ImageSubscription{} }
 func (*ImageSubscription) ProtoMessage() {}
 func (*ImageSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{40}
+	return fileDescriptor_e26b7f7bbc391025, []int{53}
 }
 func (m *ImageSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1177,10 +1544,38 @@ func (m *ImageSubscription) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ImageSubscription proto.InternalMessageInfo
 
+func (m *JobTemplate) Reset()      { *m = JobTemplate{} }
+func (*JobTemplate) ProtoMessage() {}
+func (*JobTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{54}
+}
+func (m *JobTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JobTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobTemplate.Merge(m, src)
+}
+func (m *JobTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobTemplate proto.InternalMessageInfo
+
 func (m *KargoRenderImageUpdate) Reset()      { *m = KargoRenderImageUpdate{} }
 func (*KargoRenderImageUpdate) ProtoMessage() {}
 func (*KargoRenderImageUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{41}
+	return fileDescriptor_e26b7f7bbc391025, []int{55}
 }
 func (m *KargoRenderImageUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1208,7 +1603,7 @@ var xxx_messageInfo_KargoRenderImageUpdate proto.InternalMessageInfo
 func (m *KargoRenderPromotionMechanism) Reset()      { *m = KargoRenderPromotionMechanism{} }
 func (*KargoRenderPromotionMechanism) ProtoMessage() {}
 func (*KargoRenderPromotionMechanism) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{42}
+	return fileDescriptor_e26b7f7bbc391025, []int{56}
 }
 func (m *KargoRenderPromotionMechanism) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1233,10 +1628,38 @@ func (m *KargoRenderPromotionMechanism) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KargoRenderPromotionMechanism proto.InternalMessageInfo
 
+func (m *KubernetesResourceUpdate) Reset()      { *m = KubernetesResourceUpdate{} }
+func (*KubernetesResourceUpdate) ProtoMessage() {}
+func (*KubernetesResourceUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{57}
+}
+func (m *KubernetesResourceUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KubernetesResourceUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KubernetesResourceUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KubernetesResourceUpdate.Merge(m, src)
+}
+func (m *KubernetesResourceUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *KubernetesResourceUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_KubernetesResourceUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KubernetesResourceUpdate proto.InternalMessageInfo
+
 func (m *KustomizeImageUpdate) Reset()      { *m = KustomizeImageUpdate{} }
 func (*KustomizeImageUpdate) ProtoMessage() {}
 func (*KustomizeImageUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{43}
+	return fileDescriptor_e26b7f7bbc391025, []int{58}
 }
 func (m *KustomizeImageUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1264,7 +1687,7 @@ var xxx_messageInfo_KustomizeImageUpdate proto.InternalMessageInfo
 func (m *KustomizePromotionMechanism) Reset()      { *m = KustomizePromotionMechanism{} }
 func (*KustomizePromotionMechanism) ProtoMessage() {}
 func (*KustomizePromotionMechanism) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{44}
+	return fileDescriptor_e26b7f7bbc391025, []int{59}
 }
 func (m *KustomizePromotionMechanism) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1289,10 +1712,94 @@ func (m *KustomizePromotionMechanism) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KustomizePromotionMechanism proto.InternalMessageInfo
 
+func (m *KustomizeReplacementSource) Reset()      { *m = KustomizeReplacementSource{} }
+func (*KustomizeReplacementSource) ProtoMessage() {}
+func (*KustomizeReplacementSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{60}
+}
+func (m *KustomizeReplacementSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KustomizeReplacementSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KustomizeReplacementSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KustomizeReplacementSource.Merge(m, src)
+}
+func (m *KustomizeReplacementSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *KustomizeReplacementSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_KustomizeReplacementSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KustomizeReplacementSource proto.InternalMessageInfo
+
+func (m *PodImageHealthCheck) Reset()      { *m = PodImageHealthCheck{} }
+func (*PodImageHealthCheck) ProtoMessage() {}
+func (*PodImageHealthCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{61}
+}
+func (m *PodImageHealthCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PodImageHealthCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PodImageHealthCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodImageHealthCheck.Merge(m, src)
+}
+func (m *PodImageHealthCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *PodImageHealthCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodImageHealthCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PodImageHealthCheck proto.InternalMessageInfo
+
+func (m *PollingIntervals) Reset()      { *m = PollingIntervals{} }
+func (*PollingIntervals) ProtoMessage() {}
+func (*PollingIntervals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{62}
+}
+func (m *PollingIntervals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PollingIntervals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PollingIntervals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PollingIntervals.Merge(m, src)
+}
+func (m *PollingIntervals) XXX_Size() int {
+	return m.Size()
+}
+func (m *PollingIntervals) XXX_DiscardUnknown() {
+	xxx_messageInfo_PollingIntervals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PollingIntervals proto.InternalMessageInfo
+
 func (m *Project) Reset()      { *m = Project{} }
 func (*Project) ProtoMessage() {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{45}
+	return fileDescriptor_e26b7f7bbc391025, []int{63}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1320,7 +1827,7 @@ var xxx_messageInfo_Project proto.InternalMessageInfo
 func (m *ProjectList) Reset()      { *m = ProjectList{} }
 func (*ProjectList) ProtoMessage() {}
 func (*ProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{46}
+	return fileDescriptor_e26b7f7bbc391025, []int{64}
 }
 func (m *ProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1348,7 +1855,7 @@ var xxx_messageInfo_ProjectList proto.InternalMessageInfo
 func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
 func (*ProjectSpec) ProtoMessage() {}
 func (*ProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{47}
+	return fileDescriptor_e26b7f7bbc391025, []int{65}
 }
 func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1376,7 +1883,7 @@ var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
 func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
 func (*ProjectStatus) ProtoMessage() {}
 func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{48}
+	return fileDescriptor_e26b7f7bbc391025, []int{66}
 }
 func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1404,7 +1911,7 @@ var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
 func (m *Promotion) Reset()      { *m = Promotion{} }
 func (*Promotion) ProtoMessage() {}
 func (*Promotion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{49}
+	return fileDescriptor_e26b7f7bbc391025, []int{67}
 }
 func (m *Promotion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1432,7 +1939,7 @@ var xxx_messageInfo_Promotion proto.InternalMessageInfo
 func (m *PromotionList) Reset()      { *m = PromotionList{} }
 func (*PromotionList) ProtoMessage() {}
 func (*PromotionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{50}
+	return fileDescriptor_e26b7f7bbc391025, []int{68}
 }
 func (m *PromotionList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1460,7 +1967,7 @@ var xxx_messageInfo_PromotionList proto.InternalMessageInfo
 func (m *PromotionMechanisms) Reset()      { *m = PromotionMechanisms{} }
 func (*PromotionMechanisms) ProtoMessage() {}
 func (*PromotionMechanisms) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{51}
+	return fileDescriptor_e26b7f7bbc391025, []int{69}
 }
 func (m *PromotionMechanisms) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1488,7 +1995,7 @@ var xxx_messageInfo_PromotionMechanisms proto.InternalMessageInfo
 func (m *PromotionPolicy) Reset()      { *m = PromotionPolicy{} }
 func (*PromotionPolicy) ProtoMessage() {}
 func (*PromotionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{52}
+	return fileDescriptor_e26b7f7bbc391025, []int{70}
 }
 func (m *PromotionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1516,7 +2023,7 @@ var xxx_messageInfo_PromotionPolicy proto.InternalMessageInfo
 func (m *PromotionReference) Reset()      { *m = PromotionReference{} }
 func (*PromotionReference) ProtoMessage() {}
 func (*PromotionReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{53}
+	return fileDescriptor_e26b7f7bbc391025, []int{71}
 }
 func (m *PromotionReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1544,7 +2051,7 @@ var xxx_messageInfo_PromotionReference proto.InternalMessageInfo
 func (m *PromotionSpec) Reset()      { *m = PromotionSpec{} }
 func (*PromotionSpec) ProtoMessage() {}
 func (*PromotionSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{54}
+	return fileDescriptor_e26b7f7bbc391025, []int{72}
 }
 func (m *PromotionSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1572,7 +2079,7 @@ var xxx_messageInfo_PromotionSpec proto.InternalMessageInfo
 func (m *PromotionStatus) Reset()      { *m = PromotionStatus{} }
 func (*PromotionStatus) ProtoMessage() {}
 func (*PromotionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{55}
+	return fileDescriptor_e26b7f7bbc391025, []int{73}
 }
 func (m *PromotionStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1597,10 +2104,94 @@ func (m *PromotionStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PromotionStatus proto.InternalMessageInfo
 
+func (m *PromotionTemplate) Reset()      { *m = PromotionTemplate{} }
+func (*PromotionTemplate) ProtoMessage() {}
+func (*PromotionTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{74}
+}
+func (m *PromotionTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromotionTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PromotionTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromotionTemplate.Merge(m, src)
+}
+func (m *PromotionTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromotionTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromotionTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromotionTemplate proto.InternalMessageInfo
+
+func (m *PromotionTemplateList) Reset()      { *m = PromotionTemplateList{} }
+func (*PromotionTemplateList) ProtoMessage() {}
+func (*PromotionTemplateList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{75}
+}
+func (m *PromotionTemplateList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromotionTemplateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PromotionTemplateList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromotionTemplateList.Merge(m, src)
+}
+func (m *PromotionTemplateList) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromotionTemplateList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromotionTemplateList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromotionTemplateList proto.InternalMessageInfo
+
+func (m *PromotionTemplateSpec) Reset()      { *m = PromotionTemplateSpec{} }
+func (*PromotionTemplateSpec) ProtoMessage() {}
+func (*PromotionTemplateSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{76}
+}
+func (m *PromotionTemplateSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromotionTemplateSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PromotionTemplateSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromotionTemplateSpec.Merge(m, src)
+}
+func (m *PromotionTemplateSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromotionTemplateSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromotionTemplateSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromotionTemplateSpec proto.InternalMessageInfo
+
 func (m *PullRequestPromotionMechanism) Reset()      { *m = PullRequestPromotionMechanism{} }
 func (*PullRequestPromotionMechanism) ProtoMessage() {}
 func (*PullRequestPromotionMechanism) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{56}
+	return fileDescriptor_e26b7f7bbc391025, []int{77}
 }
 func (m *PullRequestPromotionMechanism) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1628,7 +2219,7 @@ var xxx_messageInfo_PullRequestPromotionMechanism proto.InternalMessageInfo
 func (m *RepoSubscription) Reset()      { *m = RepoSubscription{} }
 func (*RepoSubscription) ProtoMessage() {}
 func (*RepoSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{57}
+	return fileDescriptor_e26b7f7bbc391025, []int{78}
 }
 func (m *RepoSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1653,10 +2244,38 @@ func (m *RepoSubscription) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RepoSubscription proto.InternalMessageInfo
 
+func (m *SecretKeyReference) Reset()      { *m = SecretKeyReference{} }
+func (*SecretKeyReference) ProtoMessage() {}
+func (*SecretKeyReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{79}
+}
+func (m *SecretKeyReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretKeyReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SecretKeyReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretKeyReference.Merge(m, src)
+}
+func (m *SecretKeyReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretKeyReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretKeyReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretKeyReference proto.InternalMessageInfo
+
 func (m *Stage) Reset()      { *m = Stage{} }
 func (*Stage) ProtoMessage() {}
 func (*Stage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{58}
+	return fileDescriptor_e26b7f7bbc391025, []int{80}
 }
 func (m *Stage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1684,7 +2303,7 @@ var xxx_messageInfo_Stage proto.InternalMessageInfo
 func (m *StageList) Reset()      { *m = StageList{} }
 func (*StageList) ProtoMessage() {}
 func (*StageList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{59}
+	return fileDescriptor_e26b7f7bbc391025, []int{81}
 }
 func (m *StageList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1709,15 +2328,15 @@ func (m *StageList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StageList proto.InternalMessageInfo
 
-func (m *StageSpec) Reset()      { *m = StageSpec{} }
-func (*StageSpec) ProtoMessage() {}
-func (*StageSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{60}
+func (m *StageRole) Reset()      { *m = StageRole{} }
+func (*StageRole) ProtoMessage() {}
+func (*StageRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{82}
 }
-func (m *StageSpec) XXX_Unmarshal(b []byte) error {
+func (m *StageRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StageSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StageRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1725,27 +2344,27 @@ func (m *StageSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *StageSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StageSpec.Merge(m, src)
+func (m *StageRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StageRole.Merge(m, src)
 }
-func (m *StageSpec) XXX_Size() int {
+func (m *StageRole) XXX_Size() int {
 	return m.Size()
 }
-func (m *StageSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_StageSpec.DiscardUnknown(m)
+func (m *StageRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_StageRole.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StageSpec proto.InternalMessageInfo
+var xxx_messageInfo_StageRole proto.InternalMessageInfo
 
-func (m *StageStatus) Reset()      { *m = StageStatus{} }
-func (*StageStatus) ProtoMessage() {}
-func (*StageStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{61}
+func (m *StageRoleList) Reset()      { *m = StageRoleList{} }
+func (*StageRoleList) ProtoMessage() {}
+func (*StageRoleList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{83}
 }
-func (m *StageStatus) XXX_Unmarshal(b []byte) error {
+func (m *StageRoleList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StageStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StageRoleList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1753,27 +2372,27 @@ func (m *StageStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *StageStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StageStatus.Merge(m, src)
+func (m *StageRoleList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StageRoleList.Merge(m, src)
 }
-func (m *StageStatus) XXX_Size() int {
+func (m *StageRoleList) XXX_Size() int {
 	return m.Size()
 }
-func (m *StageStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_StageStatus.DiscardUnknown(m)
+func (m *StageRoleList) XXX_DiscardUnknown() {
+	xxx_messageInfo_StageRoleList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StageStatus proto.InternalMessageInfo
+var xxx_messageInfo_StageRoleList proto.InternalMessageInfo
 
-func (m *StageSubscription) Reset()      { *m = StageSubscription{} }
-func (*StageSubscription) ProtoMessage() {}
-func (*StageSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{62}
+func (m *StageRoleSpec) Reset()      { *m = StageRoleSpec{} }
+func (*StageRoleSpec) ProtoMessage() {}
+func (*StageRoleSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{84}
 }
-func (m *StageSubscription) XXX_Unmarshal(b []byte) error {
+func (m *StageRoleSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StageSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StageRoleSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1781,10 +2400,94 @@ func (m *StageSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 	}
 	return b[:n], nil
 }
-func (m *StageSubscription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StageSubscription.Merge(m, src)
+func (m *StageRoleSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StageRoleSpec.Merge(m, src)
 }
-func (m *StageSubscription) XXX_Size() int {
+func (m *StageRoleSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *StageRoleSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_StageRoleSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StageRoleSpec proto.InternalMessageInfo
+
+func (m *StageSpec) Reset()      { *m = StageSpec{} }
+func (*StageSpec) ProtoMessage() {}
+func (*StageSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{85}
+}
+func (m *StageSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StageSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StageSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StageSpec.Merge(m, src)
+}
+func (m *StageSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *StageSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_StageSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StageSpec proto.InternalMessageInfo
+
+func (m *StageStatus) Reset()      { *m = StageStatus{} }
+func (*StageStatus) ProtoMessage() {}
+func (*StageStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{86}
+}
+func (m *StageStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StageStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StageStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StageStatus.Merge(m, src)
+}
+func (m *StageStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *StageStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_StageStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StageStatus proto.InternalMessageInfo
+
+func (m *StageSubscription) Reset()      { *m = StageSubscription{} }
+func (*StageSubscription) ProtoMessage() {}
+func (*StageSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{87}
+}
+func (m *StageSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StageSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StageSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StageSubscription.Merge(m, src)
+}
+func (m *StageSubscription) XXX_Size() int {
 	return m.Size()
 }
 func (m *StageSubscription) XXX_DiscardUnknown() {
@@ -1793,10 +2496,38 @@ func (m *StageSubscription) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StageSubscription proto.InternalMessageInfo
 
+func (m *SubscriptionPollTimes) Reset()      { *m = SubscriptionPollTimes{} }
+func (*SubscriptionPollTimes) ProtoMessage() {}
+func (*SubscriptionPollTimes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{88}
+}
+func (m *SubscriptionPollTimes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscriptionPollTimes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SubscriptionPollTimes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionPollTimes.Merge(m, src)
+}
+func (m *SubscriptionPollTimes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscriptionPollTimes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionPollTimes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionPollTimes proto.InternalMessageInfo
+
 func (m *Subscriptions) Reset()      { *m = Subscriptions{} }
 func (*Subscriptions) ProtoMessage() {}
 func (*Subscriptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{63}
+	return fileDescriptor_e26b7f7bbc391025, []int{89}
 }
 func (m *Subscriptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1824,7 +2555,7 @@ var xxx_messageInfo_Subscriptions proto.InternalMessageInfo
 func (m *Verification) Reset()      { *m = Verification{} }
 func (*Verification) ProtoMessage() {}
 func (*Verification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{64}
+	return fileDescriptor_e26b7f7bbc391025, []int{90}
 }
 func (m *Verification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1852,7 +2583,7 @@ var xxx_messageInfo_Verification proto.InternalMessageInfo
 func (m *VerificationInfo) Reset()      { *m = VerificationInfo{} }
 func (*VerificationInfo) ProtoMessage() {}
 func (*VerificationInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{65}
+	return fileDescriptor_e26b7f7bbc391025, []int{91}
 }
 func (m *VerificationInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1880,7 +2611,7 @@ var xxx_messageInfo_VerificationInfo proto.InternalMessageInfo
 func (m *VerifiedStage) Reset()      { *m = VerifiedStage{} }
 func (*VerifiedStage) ProtoMessage() {}
 func (*VerifiedStage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{66}
+	return fileDescriptor_e26b7f7bbc391025, []int{92}
 }
 func (m *VerifiedStage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1908,7 +2639,7 @@ var xxx_messageInfo_VerifiedStage proto.InternalMessageInfo
 func (m *Warehouse) Reset()      { *m = Warehouse{} }
 func (*Warehouse) ProtoMessage() {}
 func (*Warehouse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{67}
+	return fileDescriptor_e26b7f7bbc391025, []int{93}
 }
 func (m *Warehouse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1936,7 +2667,7 @@ var xxx_messageInfo_Warehouse proto.InternalMessageInfo
 func (m *WarehouseList) Reset()      { *m = WarehouseList{} }
 func (*WarehouseList) ProtoMessage() {}
 func (*WarehouseList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{68}
+	return fileDescriptor_e26b7f7bbc391025, []int{94}
 }
 func (m *WarehouseList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1964,7 +2695,7 @@ var xxx_messageInfo_WarehouseList proto.InternalMessageInfo
 func (m *WarehouseSpec) Reset()      { *m = WarehouseSpec{} }
 func (*WarehouseSpec) ProtoMessage() {}
 func (*WarehouseSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{69}
+	return fileDescriptor_e26b7f7bbc391025, []int{95}
 }
 func (m *WarehouseSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1992,7 +2723,7 @@ var xxx_messageInfo_WarehouseSpec proto.InternalMessageInfo
 func (m *WarehouseStatus) Reset()      { *m = WarehouseStatus{} }
 func (*WarehouseStatus) ProtoMessage() {}
 func (*WarehouseStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{70}
+	return fileDescriptor_e26b7f7bbc391025, []int{96}
 }
 func (m *WarehouseStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2017,6 +2748,62 @@ func (m *WarehouseStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WarehouseStatus proto.InternalMessageInfo
 
+func (m *WebhookConfig) Reset()      { *m = WebhookConfig{} }
+func (*WebhookConfig) ProtoMessage() {}
+func (*WebhookConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{97}
+}
+func (m *WebhookConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebhookConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookConfig.Merge(m, src)
+}
+func (m *WebhookConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookConfig proto.InternalMessageInfo
+
+func (m *WebhookDeliveryStatus) Reset()      { *m = WebhookDeliveryStatus{} }
+func (*WebhookDeliveryStatus) ProtoMessage() {}
+func (*WebhookDeliveryStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{98}
+}
+func (m *WebhookDeliveryStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookDeliveryStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebhookDeliveryStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookDeliveryStatus.Merge(m, src)
+}
+func (m *WebhookDeliveryStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookDeliveryStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookDeliveryStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookDeliveryStatus proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AnalysisRunArgument)(nil), "github.com.akuity.kargo.api.v1alpha1.AnalysisRunArgument")
 	proto.RegisterType((*AnalysisRunMetadata)(nil), "github.com.akuity.kargo.api.v1alpha1.AnalysisRunMetadata")
@@ -2025,8 +2812,10 @@ func init() {
 	proto.RegisterType((*AnalysisRunReference)(nil), "github.com.akuity.kargo.api.v1alpha1.AnalysisRunReference")
 	proto.RegisterType((*AnalysisTemplateReference)(nil), "github.com.akuity.kargo.api.v1alpha1.AnalysisTemplateReference")
 	proto.RegisterType((*ApprovedStage)(nil), "github.com.akuity.kargo.api.v1alpha1.ApprovedStage")
+	proto.RegisterType((*ArgoCDAppHealthCheck)(nil), "github.com.akuity.kargo.api.v1alpha1.ArgoCDAppHealthCheck")
 	proto.RegisterType((*ArgoCDAppHealthStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.ArgoCDAppHealthStatus")
 	proto.RegisterType((*ArgoCDAppStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.ArgoCDAppStatus")
+	proto.RegisterType((*ArgoCDAppStatusCheck)(nil), "github.com.akuity.kargo.api.v1alpha1.ArgoCDAppStatusCheck")
 	proto.RegisterType((*ArgoCDAppSyncStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.ArgoCDAppSyncStatus")
 	proto.RegisterType((*ArgoCDAppUpdate)(nil), "github.com.akuity.kargo.api.v1alpha1.ArgoCDAppUpdate")
 	proto.RegisterType((*ArgoCDHelm)(nil), "github.com.akuity.kargo.api.v1alpha1.ArgoCDHelm")
@@ -2034,15 +2823,22 @@ func init() {
 	proto.RegisterType((*ArgoCDKustomize)(nil), "github.com.akuity.kargo.api.v1alpha1.ArgoCDKustomize")
 	proto.RegisterType((*ArgoCDKustomizeImageUpdate)(nil), "github.com.akuity.kargo.api.v1alpha1.ArgoCDKustomizeImageUpdate")
 	proto.RegisterType((*ArgoCDSourceUpdate)(nil), "github.com.akuity.kargo.api.v1alpha1.ArgoCDSourceUpdate")
+	proto.RegisterType((*CanaryPromotion)(nil), "github.com.akuity.kargo.api.v1alpha1.CanaryPromotion")
+	proto.RegisterType((*ChangeApprovalCheck)(nil), "github.com.akuity.kargo.api.v1alpha1.ChangeApprovalCheck")
 	proto.RegisterType((*Chart)(nil), "github.com.akuity.kargo.api.v1alpha1.Chart")
+	proto.RegisterType((*ChartChange)(nil), "github.com.akuity.kargo.api.v1alpha1.ChartChange")
 	proto.RegisterType((*ChartDiscoveryResult)(nil), "github.com.akuity.kargo.api.v1alpha1.ChartDiscoveryResult")
 	proto.RegisterType((*ChartSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.ChartSubscription")
+	proto.RegisterType((*CircuitBreaker)(nil), "github.com.akuity.kargo.api.v1alpha1.CircuitBreaker")
+	proto.RegisterType((*CircuitBreakerStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.CircuitBreakerStatus")
 	proto.RegisterType((*DiscoveredArtifacts)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredArtifacts")
 	proto.RegisterType((*DiscoveredCommit)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredCommit")
 	proto.RegisterType((*DiscoveredImageReference)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredImageReference")
+	proto.RegisterType((*FluxHelmReleaseUpdate)(nil), "github.com.akuity.kargo.api.v1alpha1.FluxHelmReleaseUpdate")
 	proto.RegisterType((*Freight)(nil), "github.com.akuity.kargo.api.v1alpha1.Freight")
 	proto.RegisterType((*FreightCollection)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightCollection")
 	proto.RegisterMapType((map[string]FreightReference)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightCollection.ItemsEntry")
+	proto.RegisterType((*FreightCollectionDelta)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightCollectionDelta")
 	proto.RegisterType((*FreightList)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightList")
 	proto.RegisterType((*FreightOrigin)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightOrigin")
 	proto.RegisterType((*FreightReference)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightReference")
@@ -2057,17 +2853,27 @@ func init() {
 	proto.RegisterType((*GitLabPullRequest)(nil), "github.com.akuity.kargo.api.v1alpha1.GitLabPullRequest")
 	proto.RegisterType((*GitRepoUpdate)(nil), "github.com.akuity.kargo.api.v1alpha1.GitRepoUpdate")
 	proto.RegisterType((*GitSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.GitSubscription")
+	proto.RegisterType((*HTTPHealthCheck)(nil), "github.com.akuity.kargo.api.v1alpha1.HTTPHealthCheck")
 	proto.RegisterType((*Health)(nil), "github.com.akuity.kargo.api.v1alpha1.Health")
+	proto.RegisterType((*HealthChecks)(nil), "github.com.akuity.kargo.api.v1alpha1.HealthChecks")
 	proto.RegisterType((*HelmChartDependencyUpdate)(nil), "github.com.akuity.kargo.api.v1alpha1.HelmChartDependencyUpdate")
 	proto.RegisterType((*HelmImageUpdate)(nil), "github.com.akuity.kargo.api.v1alpha1.HelmImageUpdate")
 	proto.RegisterType((*HelmPromotionMechanism)(nil), "github.com.akuity.kargo.api.v1alpha1.HelmPromotionMechanism")
+	proto.RegisterType((*HelmValuesFileUpdate)(nil), "github.com.akuity.kargo.api.v1alpha1.HelmValuesFileUpdate")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.akuity.kargo.api.v1alpha1.HelmValuesFileUpdate.UpdatesEntry")
 	proto.RegisterType((*Image)(nil), "github.com.akuity.kargo.api.v1alpha1.Image")
 	proto.RegisterType((*ImageDiscoveryResult)(nil), "github.com.akuity.kargo.api.v1alpha1.ImageDiscoveryResult")
+	proto.RegisterType((*ImagePullCheck)(nil), "github.com.akuity.kargo.api.v1alpha1.ImagePullCheck")
 	proto.RegisterType((*ImageSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.ImageSubscription")
+	proto.RegisterType((*JobTemplate)(nil), "github.com.akuity.kargo.api.v1alpha1.JobTemplate")
 	proto.RegisterType((*KargoRenderImageUpdate)(nil), "github.com.akuity.kargo.api.v1alpha1.KargoRenderImageUpdate")
 	proto.RegisterType((*KargoRenderPromotionMechanism)(nil), "github.com.akuity.kargo.api.v1alpha1.KargoRenderPromotionMechanism")
+	proto.RegisterType((*KubernetesResourceUpdate)(nil), "github.com.akuity.kargo.api.v1alpha1.KubernetesResourceUpdate")
 	proto.RegisterType((*KustomizeImageUpdate)(nil), "github.com.akuity.kargo.api.v1alpha1.KustomizeImageUpdate")
 	proto.RegisterType((*KustomizePromotionMechanism)(nil), "github.com.akuity.kargo.api.v1alpha1.KustomizePromotionMechanism")
+	proto.RegisterType((*KustomizeReplacementSource)(nil), "github.com.akuity.kargo.api.v1alpha1.KustomizeReplacementSource")
+	proto.RegisterType((*PodImageHealthCheck)(nil), "github.com.akuity.kargo.api.v1alpha1.PodImageHealthCheck")
+	proto.RegisterType((*PollingIntervals)(nil), "github.com.akuity.kargo.api.v1alpha1.PollingIntervals")
 	proto.RegisterType((*Project)(nil), "github.com.akuity.kargo.api.v1alpha1.Project")
 	proto.RegisterType((*ProjectList)(nil), "github.com.akuity.kargo.api.v1alpha1.ProjectList")
 	proto.RegisterType((*ProjectSpec)(nil), "github.com.akuity.kargo.api.v1alpha1.ProjectSpec")
@@ -2080,13 +2886,21 @@ func init() {
 	proto.RegisterType((*PromotionSpec)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionSpec")
 	proto.RegisterType((*PromotionStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionStatus")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionStatus.MetadataEntry")
+	proto.RegisterType((*PromotionTemplate)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionTemplate")
+	proto.RegisterType((*PromotionTemplateList)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionTemplateList")
+	proto.RegisterType((*PromotionTemplateSpec)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionTemplateSpec")
 	proto.RegisterType((*PullRequestPromotionMechanism)(nil), "github.com.akuity.kargo.api.v1alpha1.PullRequestPromotionMechanism")
 	proto.RegisterType((*RepoSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.RepoSubscription")
+	proto.RegisterType((*SecretKeyReference)(nil), "github.com.akuity.kargo.api.v1alpha1.SecretKeyReference")
 	proto.RegisterType((*Stage)(nil), "github.com.akuity.kargo.api.v1alpha1.Stage")
 	proto.RegisterType((*StageList)(nil), "github.com.akuity.kargo.api.v1alpha1.StageList")
+	proto.RegisterType((*StageRole)(nil), "github.com.akuity.kargo.api.v1alpha1.StageRole")
+	proto.RegisterType((*StageRoleList)(nil), "github.com.akuity.kargo.api.v1alpha1.StageRoleList")
+	proto.RegisterType((*StageRoleSpec)(nil), "github.com.akuity.kargo.api.v1alpha1.StageRoleSpec")
 	proto.RegisterType((*StageSpec)(nil), "github.com.akuity.kargo.api.v1alpha1.StageSpec")
 	proto.RegisterType((*StageStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.StageStatus")
 	proto.RegisterType((*StageSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.StageSubscription")
+	proto.RegisterType((*SubscriptionPollTimes)(nil), "github.com.akuity.kargo.api.v1alpha1.SubscriptionPollTimes")
 	proto.RegisterType((*Subscriptions)(nil), "github.com.akuity.kargo.api.v1alpha1.Subscriptions")
 	proto.RegisterType((*Verification)(nil), "github.com.akuity.kargo.api.v1alpha1.Verification")
 	proto.RegisterType((*VerificationInfo)(nil), "github.com.akuity.kargo.api.v1alpha1.VerificationInfo")
@@ -2095,6 +2909,9 @@ func init() {
 	proto.RegisterType((*WarehouseList)(nil), "github.com.akuity.kargo.api.v1alpha1.WarehouseList")
 	proto.RegisterType((*WarehouseSpec)(nil), "github.com.akuity.kargo.api.v1alpha1.WarehouseSpec")
 	proto.RegisterType((*WarehouseStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.WarehouseStatus")
+	proto.RegisterType((*WebhookConfig)(nil), "github.com.akuity.kargo.api.v1alpha1.WebhookConfig")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.akuity.kargo.api.v1alpha1.WebhookConfig.HeadersEntry")
+	proto.RegisterType((*WebhookDeliveryStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.WebhookDeliveryStatus")
 }
 
 func init() {
@@ -2102,272 +2919,438 @@ func init() {
 }
 
 var fileDescriptor_e26b7f7bbc391025 = []byte{
-	// 4236 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7c, 0x4d, 0x6c, 0x23, 0x47,
-	0x76, 0xf0, 0x34, 0x49, 0x51, 0xe2, 0xa3, 0x7e, 0x4b, 0x9a, 0xb1, 0x2c, 0x7f, 0x23, 0xcd, 0xd7,
-	0xeb, 0x18, 0x76, 0xec, 0xa5, 0x32, 0x33, 0x1e, 0x7b, 0x3c, 0x76, 0x66, 0x43, 0x4a, 0xd6, 0x8c,
-	0x6c, 0xd9, 0x56, 0x8a, 0xf3, 0xb3, 0xf1, 0xda, 0xd8, 0x94, 0xc8, 0x12, 0xd9, 0x11, 0xc9, 0xa6,
-	0xbb, 0x9b, 0x1a, 0x6b, 0x37, 0x48, 0xbc, 0x9b, 0x04, 0xd8, 0x4b, 0x7e, 0x0e, 0x01, 0xe2, 0xdc,
-	0x82, 0xe4, 0x12, 0x20, 0x48, 0x8e, 0x01, 0x16, 0x39, 0xec, 0x61, 0x81, 0xc4, 0x70, 0x02, 0xc3,
-	0x40, 0x72, 0x70, 0x82, 0xc5, 0x60, 0xad, 0x05, 0x36, 0xb7, 0x05, 0x72, 0xc8, 0x65, 0x82, 0x00,
-	0x41, 0xfd, 0x75, 0x57, 0xff, 0x50, 0xea, 0xe6, 0x48, 0xb3, 0xb3, 0x37, 0xa9, 0xde, 0xab, 0xf7,
-	0xaa, 0x5e, 0xbd, 0x7a, 0xbf, 0xd5, 0x84, 0x17, 0x5b, 0x96, 0xd7, 0x1e, 0xec, 0x54, 0x1a, 0x76,
-	0x77, 0x95, 0xec, 0x0d, 0x2c, 0xef, 0x60, 0x75, 0x8f, 0x38, 0x2d, 0x7b, 0x95, 0xf4, 0xad, 0xd5,
-	0xfd, 0x8b, 0xa4, 0xd3, 0x6f, 0x93, 0x8b, 0xab, 0x2d, 0xda, 0xa3, 0x0e, 0xf1, 0x68, 0xb3, 0xd2,
-	0x77, 0x6c, 0xcf, 0x46, 0x4f, 0x07, 0xb3, 0x2a, 0x62, 0x56, 0x85, 0xcf, 0xaa, 0x90, 0xbe, 0x55,
-	0x51, 0xb3, 0x96, 0xbe, 0xaa, 0xd1, 0x6e, 0xd9, 0x2d, 0x7b, 0x95, 0x4f, 0xde, 0x19, 0xec, 0xf2,
-	0xff, 0xf8, 0x3f, 0xfc, 0x2f, 0x41, 0x74, 0xe9, 0xc5, 0xbd, 0xab, 0x6e, 0xc5, 0xe2, 0x9c, 0xbb,
-	0xa4, 0xd1, 0xb6, 0x7a, 0xd4, 0x39, 0x58, 0xed, 0xef, 0xb5, 0xd8, 0x80, 0xbb, 0xda, 0xa5, 0x1e,
-	0x59, 0xdd, 0x8f, 0x2d, 0x65, 0x69, 0x75, 0xd8, 0x2c, 0x67, 0xd0, 0xf3, 0xac, 0x2e, 0x8d, 0x4d,
-	0x78, 0xe9, 0xb8, 0x09, 0x6e, 0xa3, 0x4d, 0xbb, 0x24, 0x3a, 0xcf, 0x7c, 0x0f, 0xe6, 0xab, 0x3d,
-	0xd2, 0x39, 0x70, 0x2d, 0x17, 0x0f, 0x7a, 0x55, 0xa7, 0x35, 0xe8, 0xd2, 0x9e, 0x87, 0x2e, 0x40,
-	0xa1, 0x47, 0xba, 0x74, 0xd1, 0xb8, 0x60, 0x3c, 0x5b, 0xaa, 0x4d, 0x7e, 0x72, 0x7f, 0xe5, 0xcc,
-	0xe1, 0xfd, 0x95, 0xc2, 0xdb, 0xa4, 0x4b, 0x31, 0x87, 0xa0, 0xaf, 0xc0, 0xd8, 0x3e, 0xe9, 0x0c,
-	0xe8, 0x62, 0x8e, 0xa3, 0x4c, 0x49, 0x94, 0xb1, 0x3b, 0x6c, 0x10, 0x0b, 0x98, 0xf9, 0x7b, 0xf9,
-	0x10, 0xf9, 0xb7, 0xa8, 0x47, 0x9a, 0xc4, 0x23, 0xa8, 0x0b, 0xc5, 0x0e, 0xd9, 0xa1, 0x1d, 0x77,
-	0xd1, 0xb8, 0x90, 0x7f, 0xb6, 0x7c, 0xe9, 0xf5, 0x4a, 0x1a, 0xd1, 0x57, 0x12, 0x48, 0x55, 0xb6,
-	0x38, 0x9d, 0xd7, 0x7b, 0x9e, 0x73, 0x50, 0x9b, 0x96, 0x8b, 0x28, 0x8a, 0x41, 0x2c, 0x99, 0xa0,
-	0xef, 0x18, 0x50, 0x26, 0xbd, 0x9e, 0xed, 0x11, 0xcf, 0xb2, 0x7b, 0xee, 0x62, 0x8e, 0x33, 0x7d,
-	0x63, 0x74, 0xa6, 0xd5, 0x80, 0x98, 0xe0, 0x3c, 0x2f, 0x39, 0x97, 0x35, 0x08, 0xd6, 0x79, 0x2e,
-	0xbd, 0x02, 0x65, 0x6d, 0xa9, 0x68, 0x16, 0xf2, 0x7b, 0xf4, 0x40, 0xc8, 0x17, 0xb3, 0x3f, 0xd1,
-	0x42, 0x48, 0xa0, 0x52, 0x82, 0xd7, 0x72, 0x57, 0x8d, 0xa5, 0xeb, 0x30, 0x1b, 0x65, 0x98, 0x65,
-	0xbe, 0xf9, 0x47, 0x06, 0x2c, 0x68, 0xbb, 0xc0, 0x74, 0x97, 0x3a, 0xb4, 0xd7, 0xa0, 0x68, 0x15,
-	0x4a, 0xec, 0x2c, 0xdd, 0x3e, 0x69, 0xa8, 0xa3, 0x9e, 0x93, 0x1b, 0x29, 0xbd, 0xad, 0x00, 0x38,
-	0xc0, 0xf1, 0xd5, 0x22, 0x77, 0x94, 0x5a, 0xf4, 0xdb, 0xc4, 0xa5, 0x8b, 0xf9, 0xb0, 0x5a, 0x6c,
-	0xb3, 0x41, 0x2c, 0x60, 0xe6, 0xaf, 0xc2, 0x93, 0x6a, 0x3d, 0xb7, 0x68, 0xb7, 0xdf, 0x21, 0x1e,
-	0x0d, 0x16, 0x75, 0xac, 0xea, 0x99, 0x33, 0x30, 0x55, 0xed, 0xf7, 0x1d, 0x7b, 0x9f, 0x36, 0xeb,
-	0x1e, 0x69, 0x51, 0xf3, 0xbb, 0x06, 0x9c, 0xad, 0x3a, 0x2d, 0x7b, 0x6d, 0xbd, 0xda, 0xef, 0xdf,
-	0xa4, 0xa4, 0xe3, 0xb5, 0xeb, 0x1e, 0xf1, 0x06, 0x2e, 0xba, 0x0e, 0x45, 0x97, 0xff, 0x25, 0xc9,
-	0x3d, 0xa3, 0x34, 0x44, 0xc0, 0x1f, 0xdc, 0x5f, 0x59, 0x48, 0x98, 0x48, 0xb1, 0x9c, 0x85, 0x9e,
-	0x83, 0xf1, 0x2e, 0x75, 0x5d, 0xd2, 0x52, 0x7b, 0x9e, 0x91, 0x04, 0xc6, 0xdf, 0x12, 0xc3, 0x58,
-	0xc1, 0xcd, 0x4f, 0x73, 0x30, 0xe3, 0xd3, 0x92, 0xec, 0x4f, 0x41, 0xc0, 0x03, 0x98, 0x6c, 0x6b,
-	0x3b, 0xe4, 0x72, 0x2e, 0x5f, 0x7a, 0x35, 0xa5, 0x2e, 0x27, 0x09, 0xa9, 0xb6, 0x20, 0xd9, 0x4c,
-	0xea, 0xa3, 0x38, 0xc4, 0x06, 0x75, 0x01, 0xdc, 0x83, 0x5e, 0x43, 0x32, 0x2d, 0x70, 0xa6, 0xaf,
-	0x64, 0x64, 0x5a, 0xf7, 0x09, 0xd4, 0x90, 0x64, 0x09, 0xc1, 0x18, 0xd6, 0x18, 0x98, 0x7f, 0x67,
-	0xc0, 0x7c, 0xc2, 0x3c, 0xf4, 0x5a, 0xe4, 0x3c, 0x9f, 0x8e, 0x9d, 0x27, 0x8a, 0x4d, 0x0b, 0x4e,
-	0xf3, 0x05, 0x98, 0x70, 0xe8, 0xbe, 0xe5, 0x5a, 0x76, 0x4f, 0x4a, 0x78, 0x56, 0xce, 0x9f, 0xc0,
-	0x72, 0x1c, 0xfb, 0x18, 0xe8, 0x79, 0x28, 0xa9, 0xbf, 0x99, 0x98, 0xf3, 0x4c, 0x9d, 0xd9, 0xc1,
-	0x29, 0x54, 0x17, 0x07, 0x70, 0xf3, 0x1f, 0xf5, 0xd3, 0xbf, 0xdd, 0x6f, 0x12, 0x8f, 0x32, 0xe5,
-	0x21, 0xfd, 0xfe, 0xdb, 0x81, 0x32, 0xfb, 0xca, 0x53, 0x15, 0xc3, 0x58, 0xc1, 0xd1, 0x55, 0x98,
-	0x94, 0x7f, 0x0a, 0x5d, 0x11, 0xab, 0xf3, 0x0f, 0xa6, 0xaa, 0xc1, 0x70, 0x08, 0x13, 0xdd, 0x85,
-	0xa2, 0xed, 0x58, 0x2d, 0xab, 0x27, 0x0f, 0xe5, 0x72, 0xba, 0x43, 0xd9, 0x70, 0xa8, 0xd5, 0x6a,
-	0x7b, 0xef, 0xf0, 0xa9, 0x35, 0x60, 0x22, 0x14, 0x7f, 0x63, 0x49, 0x0e, 0x0d, 0x60, 0xca, 0xb5,
-	0x07, 0x4e, 0x83, 0x8a, 0xdd, 0x08, 0x11, 0x94, 0x2f, 0x5d, 0xcd, 0x72, 0xe8, 0x75, 0x8d, 0x40,
-	0xed, 0xac, 0xdc, 0xcd, 0x94, 0x3e, 0xea, 0xe2, 0x30, 0x17, 0xf3, 0x53, 0x03, 0x40, 0x4c, 0xbe,
-	0x49, 0x3b, 0x5d, 0xd4, 0x80, 0xa2, 0xd5, 0x25, 0x2d, 0xaa, 0x3c, 0x45, 0x26, 0x45, 0x67, 0x14,
-	0x36, 0xd9, 0x6c, 0xb9, 0x02, 0xdf, 0x3f, 0xf0, 0x41, 0x17, 0x4b, 0xd2, 0x9a, 0x0c, 0x73, 0x27,
-	0x2a, 0x43, 0xf3, 0xbf, 0x7c, 0xc3, 0x14, 0x59, 0x0a, 0xb3, 0x93, 0x9c, 0xb9, 0xd4, 0x0c, 0xdf,
-	0x4e, 0x72, 0x1c, 0x2c, 0x60, 0xa7, 0x77, 0xb6, 0xe7, 0x85, 0xf7, 0x10, 0x5a, 0x56, 0x96, 0xbc,
-	0xf3, 0x6f, 0xd2, 0x03, 0xe1, 0x4a, 0x5e, 0x55, 0xae, 0x44, 0x18, 0xf1, 0x5f, 0x0a, 0xf9, 0x76,
-	0x66, 0x33, 0xb5, 0x9d, 0xf0, 0xb1, 0x5b, 0x07, 0x7d, 0xdf, 0xe7, 0xff, 0x9b, 0xa1, 0x6e, 0xc2,
-	0x9b, 0x03, 0xd7, 0xb3, 0xbb, 0xd6, 0xb7, 0x28, 0x6a, 0x47, 0x4e, 0xf1, 0xd7, 0xb2, 0x9c, 0xa2,
-	0x4f, 0xe6, 0xe7, 0x7a, 0x94, 0xff, 0x6c, 0xc0, 0xd2, 0xf0, 0xf5, 0x64, 0x3d, 0xcf, 0xfc, 0xc9,
-	0x9e, 0xe7, 0x2a, 0x94, 0x06, 0x2e, 0x5d, 0xb7, 0x5a, 0xd4, 0xf5, 0xf8, 0xc6, 0x27, 0x02, 0x3f,
-	0x73, 0x5b, 0x01, 0x70, 0x80, 0x63, 0xfe, 0x30, 0x0f, 0x28, 0x7e, 0x45, 0x99, 0xc5, 0x72, 0x68,
-	0xdf, 0xbe, 0x8d, 0xb7, 0xa2, 0x16, 0x0b, 0x8b, 0x61, 0xac, 0xe0, 0x6c, 0xc3, 0x8d, 0x36, 0x71,
-	0xbc, 0x68, 0xfc, 0xb7, 0xc6, 0x06, 0xb1, 0x80, 0x69, 0x1b, 0x2e, 0x9e, 0xec, 0x86, 0xb7, 0x61,
-	0x61, 0xc0, 0x97, 0x7c, 0x8b, 0x38, 0x2d, 0xea, 0x29, 0x93, 0xcc, 0xe5, 0x3a, 0x51, 0xfb, 0x7f,
-	0x72, 0x31, 0x0b, 0xb7, 0x13, 0x70, 0x70, 0xe2, 0x4c, 0xb4, 0x03, 0xa5, 0x3d, 0x75, 0xb0, 0xf2,
-	0xba, 0x5d, 0x19, 0x49, 0x4b, 0x85, 0x93, 0xf0, 0xff, 0xc5, 0x01, 0x59, 0xf4, 0x36, 0x14, 0xda,
-	0xb4, 0xd3, 0x5d, 0x1c, 0xe3, 0xe4, 0x7f, 0x25, 0xab, 0x29, 0xab, 0x4d, 0xb0, 0x58, 0x80, 0xfd,
-	0x85, 0x39, 0x1d, 0xf3, 0x77, 0x41, 0x88, 0x3b, 0xcb, 0xb9, 0x1d, 0x1f, 0x61, 0x3c, 0x07, 0xe3,
-	0xfb, 0xd4, 0xf1, 0xc5, 0xa9, 0x11, 0xbb, 0x23, 0x86, 0xb1, 0x82, 0x9b, 0xff, 0x6a, 0xc0, 0x02,
-	0x5f, 0xc1, 0xba, 0xe5, 0x36, 0xec, 0x7d, 0xea, 0x1c, 0x60, 0xea, 0x0e, 0x3a, 0x27, 0xbc, 0xa0,
-	0x75, 0x98, 0x75, 0x69, 0x77, 0x9f, 0x3a, 0x6b, 0x76, 0xcf, 0xf5, 0x1c, 0x62, 0xf5, 0x3c, 0xb9,
-	0xb2, 0x45, 0x89, 0x3d, 0x5b, 0x8f, 0xc0, 0x71, 0x6c, 0x06, 0x7a, 0x16, 0x26, 0xe4, 0xb2, 0x59,
-	0xfc, 0xc2, 0xbc, 0xf9, 0x24, 0x73, 0xfc, 0x72, 0x4f, 0x2e, 0xf6, 0xa1, 0xe6, 0x4f, 0x0d, 0x98,
-	0xe3, 0xbb, 0xaa, 0x0f, 0x76, 0xdc, 0x86, 0x63, 0xf5, 0x59, 0xdc, 0xfd, 0x38, 0x6e, 0xe9, 0x3a,
-	0x4c, 0x37, 0x95, 0xe0, 0xb7, 0xac, 0xae, 0xe5, 0x71, 0xc5, 0x1d, 0xab, 0x9d, 0x93, 0x34, 0xa6,
-	0xd7, 0x43, 0x50, 0x1c, 0xc1, 0x36, 0xff, 0x3e, 0x07, 0xf3, 0x0a, 0x85, 0x36, 0xab, 0x8e, 0x67,
-	0xed, 0x92, 0x86, 0xc7, 0x8c, 0x68, 0xbe, 0x65, 0x79, 0xd2, 0x56, 0xa7, 0x74, 0xf8, 0x37, 0xac,
-	0xa8, 0x12, 0x04, 0x8e, 0xe5, 0x86, 0xe5, 0x61, 0x46, 0x11, 0xed, 0xf8, 0x7e, 0x40, 0xa4, 0x60,
-	0xd7, 0xd2, 0xd1, 0xe6, 0x46, 0x34, 0x4a, 0x7d, 0x98, 0x07, 0xd8, 0x81, 0x22, 0x37, 0x3e, 0x2a,
-	0x60, 0x49, 0xc9, 0x23, 0x49, 0x8d, 0x03, 0x1e, 0x1c, 0xea, 0x62, 0x49, 0xd9, 0xfc, 0x22, 0x07,
-	0xb3, 0x81, 0xe0, 0xd6, 0xec, 0x6e, 0xd7, 0xf2, 0xd0, 0x12, 0xe4, 0xac, 0xa6, 0xd4, 0x0d, 0x90,
-	0x13, 0x73, 0x9b, 0xeb, 0x38, 0x67, 0x35, 0xd1, 0x33, 0x50, 0xdc, 0x71, 0x48, 0xaf, 0xd1, 0x96,
-	0x3a, 0xe1, 0x13, 0xae, 0xf1, 0x51, 0x2c, 0xa1, 0xcc, 0x31, 0x7b, 0xa4, 0x25, 0x55, 0xc1, 0x97,
-	0xdf, 0x2d, 0xd2, 0xc2, 0x6c, 0x9c, 0xe9, 0xa0, 0x3b, 0xd8, 0xf9, 0x2d, 0xda, 0x10, 0x27, 0xad,
-	0xe9, 0x60, 0x5d, 0x0c, 0x63, 0x05, 0x67, 0x1c, 0xc9, 0xc0, 0x6b, 0xdb, 0x0e, 0xb7, 0x36, 0x1a,
-	0xc7, 0x2a, 0x1f, 0xc5, 0x12, 0xca, 0x5c, 0x47, 0x83, 0xaf, 0xdf, 0xa3, 0x0e, 0xb7, 0xd2, 0x5a,
-	0x8a, 0xb2, 0xa6, 0x00, 0x38, 0xc0, 0x41, 0xef, 0x43, 0xb9, 0xe1, 0x50, 0xe2, 0xd9, 0xce, 0x3a,
-	0xf1, 0xe8, 0xe2, 0x38, 0xb7, 0x65, 0xbf, 0x5c, 0x11, 0xf5, 0x87, 0x8a, 0x5e, 0x7f, 0xa8, 0xf4,
-	0xf7, 0x5a, 0x6c, 0xc0, 0xad, 0x74, 0xa9, 0x47, 0x2a, 0xfb, 0x17, 0x2b, 0xb7, 0xac, 0x2e, 0xad,
-	0xcd, 0xb0, 0x3c, 0x79, 0x2d, 0x20, 0x81, 0x75, 0x7a, 0xe6, 0xcf, 0x0c, 0x58, 0x0c, 0x44, 0x2b,
-	0xdc, 0xa7, 0x9f, 0x1b, 0x4a, 0xf1, 0x18, 0x43, 0xc4, 0xf3, 0x0c, 0x14, 0x9b, 0x81, 0x0f, 0xd4,
-	0xf6, 0x2c, 0x1d, 0xa0, 0x84, 0xa2, 0x4b, 0x00, 0x2d, 0xcb, 0x93, 0xd7, 0x56, 0x0a, 0xdb, 0xcf,
-	0x48, 0x6e, 0xf8, 0x10, 0xac, 0x61, 0xa1, 0xbb, 0x50, 0xe2, 0xcb, 0xa4, 0xcd, 0xaa, 0x27, 0xfd,
-	0x43, 0x96, 0x4d, 0x73, 0xa7, 0xb0, 0xa6, 0x08, 0xe0, 0x80, 0x96, 0xf9, 0x9d, 0x31, 0x18, 0x97,
-	0x0e, 0x0f, 0xfd, 0x26, 0x4c, 0x74, 0x65, 0x8d, 0x81, 0x6f, 0x92, 0x39, 0x89, 0x54, 0x3c, 0xde,
-	0xe1, 0x87, 0xfe, 0x16, 0xf5, 0x48, 0xb0, 0x91, 0x60, 0x0c, 0xfb, 0x54, 0x99, 0xdb, 0x26, 0x1d,
-	0x8b, 0xb8, 0xfc, 0xdc, 0x34, 0xb7, 0x5d, 0x65, 0x83, 0x58, 0xc0, 0x98, 0x4e, 0xdc, 0x23, 0x0e,
-	0x6d, 0xdb, 0x03, 0x97, 0x2e, 0x4e, 0x84, 0x75, 0xe2, 0xae, 0x02, 0xe0, 0x00, 0x07, 0x7d, 0xc3,
-	0xf7, 0xf3, 0xa5, 0xd1, 0xfd, 0xbc, 0x7f, 0x5a, 0x11, 0x5f, 0xff, 0x2e, 0x8c, 0x0b, 0xed, 0x53,
-	0x37, 0x7a, 0x35, 0xb5, 0x45, 0x12, 0x0a, 0x1c, 0xdc, 0x12, 0xf1, 0xbf, 0x8b, 0x15, 0x41, 0x54,
-	0xf7, 0x0d, 0x52, 0x81, 0x93, 0x7e, 0x3e, 0x83, 0x41, 0x1a, 0x6a, 0x81, 0xea, 0xbe, 0x05, 0x1a,
-	0xcb, 0x42, 0x94, 0xdb, 0x98, 0x61, 0x26, 0x87, 0x89, 0x58, 0x66, 0xbe, 0xa3, 0x84, 0x52, 0x32,
-	0xed, 0x9e, 0x0e, 0xa7, 0xcb, 0x2a, 0x31, 0x36, 0xff, 0x34, 0x0f, 0x73, 0x12, 0x73, 0xcd, 0xee,
-	0x74, 0x68, 0x83, 0x7b, 0x3c, 0x61, 0xd0, 0xf2, 0x89, 0x06, 0xcd, 0x82, 0x31, 0xcb, 0xa3, 0x5d,
-	0x15, 0xd0, 0xd7, 0x32, 0xad, 0x26, 0xe0, 0x51, 0xd9, 0x64, 0x44, 0x44, 0x0d, 0xcd, 0x3f, 0x25,
-	0x89, 0x85, 0x05, 0x07, 0xf4, 0x07, 0x06, 0xcc, 0xef, 0x53, 0xc7, 0xda, 0xb5, 0x1a, 0xbc, 0x02,
-	0x76, 0xd3, 0x72, 0x3d, 0xdb, 0x39, 0x90, 0x2e, 0xe4, 0xa5, 0x74, 0x9c, 0xef, 0x68, 0x04, 0x36,
-	0x7b, 0xbb, 0x76, 0xed, 0x29, 0xc9, 0x6d, 0xfe, 0x4e, 0x9c, 0x34, 0x4e, 0xe2, 0xb7, 0xd4, 0x07,
-	0x08, 0x56, 0x9b, 0x50, 0x80, 0xdb, 0xd2, 0x0b, 0x70, 0xa9, 0x17, 0xa6, 0x36, 0xab, 0x6c, 0x9c,
-	0x5e, 0xb8, 0xfb, 0x81, 0x01, 0x65, 0x09, 0xdf, 0xb2, 0x5c, 0x0f, 0xbd, 0x17, 0x33, 0x0f, 0x95,
-	0x74, 0xe6, 0x81, 0xcd, 0xe6, 0xc6, 0xc1, 0xaf, 0x77, 0xa8, 0x11, 0xcd, 0x34, 0x60, 0x75, 0xa4,
-	0x42, 0xb0, 0x5f, 0xcd, 0xb4, 0x7e, 0x2d, 0xe3, 0x61, 0x34, 0xe4, 0xd9, 0x99, 0x0e, 0x4c, 0x85,
-	0x2e, 0x39, 0xba, 0x02, 0x85, 0x3d, 0xab, 0xa7, 0xdc, 0xe4, 0xff, 0x57, 0xa1, 0xd1, 0x9b, 0x56,
-	0xaf, 0xf9, 0xe0, 0xfe, 0xca, 0x5c, 0x08, 0x99, 0x0d, 0x62, 0x8e, 0x7e, 0x7c, 0x44, 0x75, 0x6d,
-	0xe2, 0xe3, 0xbf, 0x58, 0x39, 0xf3, 0xd1, 0x8f, 0x2e, 0x9c, 0x31, 0x3f, 0x1d, 0x83, 0xd9, 0xa8,
-	0x54, 0x53, 0x14, 0xb4, 0x43, 0x46, 0xaf, 0x98, 0xc9, 0xe8, 0x4d, 0x9c, 0xaa, 0xd1, 0xcb, 0x9d,
-	0x9e, 0xd1, 0xcb, 0x9f, 0x86, 0xd1, 0x2b, 0x9c, 0x9c, 0xd1, 0xfb, 0x10, 0x66, 0xf7, 0x23, 0x17,
-	0x57, 0x26, 0x4f, 0xa3, 0x5e, 0xfb, 0x05, 0x16, 0x5a, 0x47, 0x47, 0x71, 0x8c, 0xcb, 0x50, 0xa3,
-	0x33, 0xfe, 0x68, 0x8d, 0x8e, 0xf9, 0x99, 0x01, 0xd3, 0xbe, 0x32, 0x7f, 0x30, 0x60, 0xd1, 0x4b,
-	0xa0, 0x77, 0xc6, 0xc9, 0xeb, 0xdd, 0x37, 0x61, 0x5c, 0xd4, 0xe3, 0x5c, 0x69, 0xc6, 0x5e, 0xcc,
-	0xe6, 0x67, 0xc4, 0x5c, 0x2d, 0x2e, 0x15, 0x03, 0x58, 0x51, 0x35, 0xdf, 0xf3, 0xf7, 0x23, 0x41,
-	0x22, 0x6a, 0x73, 0x58, 0x4c, 0x6b, 0xf0, 0xec, 0x5d, 0x8b, 0xda, 0xd8, 0x28, 0x96, 0x50, 0x64,
-	0x72, 0x0f, 0xa8, 0x92, 0x87, 0x92, 0xa8, 0x0b, 0xf0, 0x06, 0x80, 0x70, 0x64, 0x2d, 0xea, 0x9a,
-	0x3f, 0xcb, 0xfb, 0x06, 0x47, 0x56, 0x8c, 0xef, 0x01, 0x08, 0xb9, 0xd2, 0xe6, 0x66, 0x4f, 0x7a,
-	0xab, 0xb5, 0x11, 0x7c, 0xa7, 0x3c, 0x4c, 0x46, 0x45, 0xb8, 0x2b, 0x3f, 0xce, 0x0a, 0x00, 0x58,
-	0x63, 0x85, 0xbe, 0x0d, 0x65, 0x22, 0xbb, 0x14, 0x1b, 0xb6, 0x23, 0x6f, 0xf1, 0xfa, 0x28, 0x9c,
-	0xab, 0x01, 0x99, 0x68, 0xb7, 0x29, 0x80, 0x60, 0x9d, 0xdb, 0x92, 0x03, 0x33, 0x91, 0xf5, 0x26,
-	0x38, 0xac, 0xcd, 0xb0, 0xc3, 0xba, 0x9c, 0x45, 0xa9, 0x65, 0xeb, 0x45, 0x6f, 0x53, 0xb9, 0x30,
-	0x1b, 0x5d, 0xe9, 0x89, 0x31, 0x0d, 0xf5, 0x7b, 0x74, 0x17, 0xf9, 0xd3, 0x1c, 0x94, 0x7c, 0x9b,
-	0x97, 0x25, 0x47, 0x17, 0xc1, 0x4d, 0xee, 0x98, 0x6c, 0x2d, 0x9f, 0x26, 0x5b, 0x2b, 0x0c, 0x49,
-	0x47, 0x6e, 0xc0, 0x9c, 0xe8, 0xa1, 0xac, 0xb5, 0x69, 0x63, 0x4f, 0x2c, 0x51, 0x66, 0x63, 0x4f,
-	0x4a, 0xe4, 0xb9, 0x9b, 0x51, 0x04, 0x1c, 0x9f, 0xa3, 0x77, 0xa1, 0x8a, 0x47, 0x77, 0xa1, 0xb4,
-	0xb4, 0x6f, 0x3c, 0x7d, 0xda, 0x37, 0x71, 0x7c, 0xda, 0x67, 0xfe, 0xa5, 0x01, 0x28, 0x9e, 0xe3,
-	0x67, 0x91, 0x38, 0x89, 0xba, 0xb4, 0x94, 0x56, 0x34, 0x9a, 0x68, 0x0f, 0xf7, 0x6c, 0xe6, 0x3c,
-	0xcc, 0xdd, 0xb0, 0xbc, 0x9b, 0x83, 0x9d, 0xed, 0x41, 0xa7, 0x23, 0xed, 0xa5, 0x1c, 0xdc, 0x22,
-	0xa1, 0xc1, 0x8f, 0x8a, 0x30, 0xa5, 0x32, 0xbd, 0xcc, 0xb5, 0xcf, 0xbb, 0x27, 0x91, 0xee, 0x24,
-	0x95, 0x35, 0xeb, 0x70, 0xd6, 0xea, 0xb9, 0xb4, 0x31, 0x70, 0x68, 0x7d, 0xcf, 0xea, 0xdf, 0xda,
-	0xaa, 0xf3, 0xdb, 0x76, 0x20, 0x6b, 0xba, 0xe7, 0xe5, 0x8a, 0xce, 0x6e, 0x26, 0x21, 0xe1, 0xe4,
-	0xb9, 0x2c, 0xdb, 0x75, 0x28, 0x69, 0xd6, 0x74, 0x8d, 0xf6, 0x8d, 0x17, 0xf6, 0x21, 0x58, 0xc3,
-	0x42, 0x57, 0xa0, 0x7c, 0xcf, 0xb1, 0x3c, 0x2a, 0x27, 0x09, 0x0d, 0xf7, 0xcd, 0xce, 0xdd, 0x00,
-	0x84, 0x75, 0x3c, 0xb4, 0x0f, 0xe5, 0x7e, 0x20, 0x64, 0xe9, 0xaa, 0x53, 0x5a, 0x5b, 0xed, 0x74,
-	0xb6, 0x1d, 0xbb, 0x6b, 0x33, 0x2f, 0xf8, 0x16, 0x6d, 0xb4, 0x49, 0xcf, 0x72, 0xbb, 0xa2, 0x68,
-	0xa0, 0xa1, 0x60, 0x9d, 0x11, 0x6a, 0x41, 0xd1, 0xa1, 0xbd, 0xa6, 0xac, 0x60, 0xa4, 0x66, 0xf9,
-	0x26, 0x1b, 0xc2, 0x7c, 0x62, 0x02, 0x4b, 0x7e, 0x40, 0x02, 0x8a, 0x25, 0x79, 0xd4, 0xd3, 0xab,
-	0xc4, 0xa2, 0xf4, 0x51, 0x4d, 0xc9, 0x4b, 0x4d, 0x4b, 0xe0, 0x34, 0xbc, 0x62, 0xfc, 0xae, 0xac,
-	0x18, 0x8b, 0x08, 0xf3, 0xb5, 0x74, 0xac, 0x6e, 0xd2, 0x4e, 0x37, 0x81, 0x4b, 0xb4, 0x7a, 0xfc,
-	0xdd, 0x31, 0x98, 0xb9, 0x61, 0x8d, 0x5c, 0xe4, 0xf4, 0xe0, 0x09, 0x71, 0xed, 0xea, 0x54, 0x26,
-	0x73, 0x75, 0xcf, 0x21, 0x1e, 0x6d, 0xa9, 0xbe, 0xd2, 0x35, 0x39, 0xf5, 0x89, 0xb5, 0x64, 0xb4,
-	0x07, 0xc3, 0x41, 0x78, 0x18, 0xe9, 0xd4, 0xa6, 0x39, 0xa9, 0xc0, 0x5a, 0xc8, 0x5c, 0x60, 0x5d,
-	0x85, 0x12, 0xe9, 0x74, 0xec, 0x7b, 0xb7, 0x48, 0xcb, 0x95, 0x96, 0xdb, 0xb7, 0x92, 0x55, 0x05,
-	0xc0, 0x01, 0x0e, 0xaa, 0x00, 0x58, 0xad, 0x9e, 0xed, 0x50, 0x3e, 0xa3, 0xc8, 0xe3, 0x94, 0x69,
-	0x76, 0xcf, 0x36, 0xfd, 0x51, 0xac, 0x61, 0x0c, 0xbf, 0xf0, 0xe3, 0x0f, 0x71, 0xe1, 0x5f, 0x84,
-	0x49, 0xab, 0xd7, 0xe8, 0x0c, 0x9a, 0x74, 0x9b, 0x78, 0x6d, 0x77, 0x71, 0x82, 0x2f, 0x63, 0xf6,
-	0xf0, 0xfe, 0xca, 0xe4, 0xa6, 0x36, 0x8e, 0x43, 0x58, 0x6c, 0x16, 0xfd, 0x50, 0x9b, 0x55, 0x0a,
-	0x66, 0xbd, 0xfe, 0xa1, 0x3e, 0x4b, 0xc7, 0x4a, 0x28, 0x41, 0x43, 0xa6, 0x12, 0xf4, 0x67, 0x06,
-	0x14, 0x85, 0x0f, 0x44, 0x57, 0x22, 0xbd, 0xfd, 0xf3, 0xb1, 0xde, 0x7e, 0x39, 0xe9, 0x89, 0x86,
-	0x09, 0x45, 0xcb, 0x75, 0x07, 0xe1, 0xb0, 0x70, 0x93, 0x8f, 0x60, 0x09, 0x41, 0x16, 0x00, 0x51,
-	0xcd, 0x79, 0x95, 0xf5, 0x5c, 0xc9, 0xfa, 0x7a, 0x21, 0xf2, 0x72, 0xc1, 0x07, 0xb8, 0x58, 0x23,
-	0x6e, 0xfe, 0x8f, 0x01, 0x4f, 0xb2, 0x4b, 0x26, 0xea, 0xc9, 0xb4, 0xcf, 0xec, 0x46, 0xaf, 0x71,
-	0x20, 0x9d, 0x0c, 0xb7, 0xc5, 0x7d, 0xdb, 0xb5, 0x78, 0x32, 0x61, 0x44, 0x6d, 0xb1, 0x82, 0x60,
-	0x0d, 0x2b, 0x45, 0x37, 0xe1, 0xd4, 0xfa, 0xc4, 0x2c, 0x4a, 0x60, 0xfb, 0x60, 0x67, 0x2d, 0x2f,
-	0x5c, 0x10, 0x25, 0x28, 0x00, 0x0e, 0x70, 0xcc, 0xbf, 0xc9, 0xc1, 0xcc, 0x43, 0xb6, 0xba, 0xc7,
-	0x4e, 0x76, 0x0b, 0xd7, 0x61, 0x9a, 0x47, 0x8b, 0xee, 0x86, 0xd5, 0xe1, 0x3a, 0x2b, 0xe5, 0xe8,
-	0x2b, 0xe8, 0x9d, 0x10, 0x14, 0x47, 0xb0, 0x55, 0xab, 0x3c, 0x7f, 0x5c, 0xab, 0xbc, 0x30, 0x42,
-	0xab, 0xfc, 0xfb, 0x39, 0x38, 0x97, 0x6c, 0xac, 0xd1, 0xfb, 0x91, 0x8e, 0xf9, 0x95, 0xf4, 0xa6,
-	0x3f, 0x4d, 0x9b, 0xbc, 0xe5, 0x67, 0xeb, 0x22, 0x14, 0xfb, 0x5a, 0x7a, 0xf2, 0x89, 0x8a, 0x3d,
-	0x34, 0x83, 0x3f, 0xad, 0x96, 0xb7, 0xf9, 0xb7, 0x06, 0x08, 0x0d, 0xca, 0xe2, 0xb3, 0xc2, 0x85,
-	0xff, 0x5c, 0xaa, 0xc2, 0xff, 0x31, 0x2d, 0x99, 0xa0, 0xe7, 0x50, 0x38, 0xaa, 0xe7, 0x60, 0xfe,
-	0xc4, 0x80, 0x85, 0xa4, 0x3e, 0x56, 0x96, 0xe5, 0xbf, 0x00, 0x13, 0xfd, 0x0e, 0xf1, 0x76, 0x6d,
-	0xa7, 0x1b, 0x7d, 0xbf, 0xb4, 0x2d, 0xc7, 0xb1, 0x8f, 0x81, 0x1c, 0x66, 0x6b, 0x64, 0xfd, 0x4b,
-	0x19, 0xbd, 0xeb, 0x59, 0x43, 0xee, 0x70, 0x03, 0x46, 0xb7, 0x55, 0x8a, 0x32, 0xd6, 0xb8, 0x98,
-	0x9f, 0x15, 0x60, 0x8e, 0x4f, 0x19, 0x35, 0xaa, 0x18, 0xe5, 0x84, 0xfa, 0x70, 0x8e, 0xab, 0x75,
-	0x3c, 0x10, 0x11, 0x87, 0x76, 0x55, 0xce, 0x3f, 0xb7, 0x99, 0x88, 0xf5, 0x60, 0x28, 0x04, 0x0f,
-	0xa1, 0xfb, 0x8b, 0x12, 0x5d, 0xe8, 0xfa, 0x32, 0x7e, 0xac, 0xbe, 0x0c, 0x8d, 0x45, 0x26, 0x1e,
-	0x22, 0x16, 0x89, 0xc7, 0x07, 0xa5, 0x4c, 0xf1, 0xc1, 0x3f, 0x19, 0x70, 0x4e, 0x0b, 0xd3, 0x7f,
-	0x81, 0x9f, 0xdc, 0xdc, 0x37, 0xe0, 0xfc, 0x91, 0x09, 0x07, 0x6a, 0x46, 0x6c, 0xfe, 0x6b, 0x99,
-	0xb3, 0x98, 0x9f, 0xeb, 0x0b, 0xa9, 0xff, 0x34, 0x60, 0xe1, 0x24, 0xde, 0x46, 0x9d, 0x70, 0x0c,
-	0x73, 0x01, 0x0a, 0xfd, 0xc0, 0xed, 0xfb, 0xe1, 0x13, 0x77, 0xf6, 0x1c, 0x12, 0x3e, 0xca, 0x7c,
-	0x8a, 0xa3, 0xfc, 0x0f, 0x03, 0x9e, 0x3a, 0x22, 0x9f, 0xd3, 0x9e, 0x39, 0x18, 0x59, 0x9e, 0x20,
-	0x3c, 0x1e, 0x0f, 0xdd, 0xfe, 0x3c, 0x07, 0xe3, 0xdb, 0x8e, 0xcd, 0x1f, 0x11, 0x9c, 0x7e, 0x3f,
-	0xfa, 0x1d, 0x28, 0xb8, 0x7d, 0xda, 0x90, 0x9b, 0xb8, 0x98, 0xb2, 0x54, 0x20, 0x96, 0x57, 0xef,
-	0xd3, 0x86, 0xc8, 0x6a, 0xd9, 0x5f, 0x98, 0x13, 0xd2, 0xfa, 0xa4, 0x99, 0x2e, 0xbc, 0x22, 0x79,
-	0x74, 0x9f, 0xf4, 0x07, 0x06, 0x94, 0x25, 0xe6, 0x63, 0xdb, 0x90, 0x93, 0xeb, 0x1b, 0xd2, 0x90,
-	0xfb, 0xc3, 0x60, 0x07, 0x4c, 0x68, 0xe8, 0x77, 0x60, 0xae, 0xaf, 0x14, 0x78, 0xdb, 0xee, 0x58,
-	0x0d, 0x2b, 0x6b, 0xc8, 0xb9, 0x1d, 0x9a, 0x7e, 0x10, 0x94, 0x36, 0xb7, 0xa3, 0x74, 0x71, 0x9c,
-	0x95, 0x69, 0xc3, 0x54, 0x48, 0xf4, 0xe8, 0xb2, 0xfa, 0x80, 0x20, 0x9c, 0x04, 0x8a, 0x0f, 0x08,
-	0x1e, 0xdc, 0x5f, 0x99, 0x94, 0xe8, 0xfa, 0x07, 0x05, 0x59, 0x9e, 0xe9, 0xff, 0x55, 0x0e, 0x4a,
-	0xfe, 0xca, 0x1e, 0x81, 0x82, 0xdf, 0x0e, 0x29, 0xf8, 0xe5, 0x8c, 0x32, 0xe5, 0x2a, 0xee, 0xdb,
-	0x2c, 0x4d, 0xcd, 0xdf, 0x8f, 0xa8, 0x79, 0xd6, 0xc3, 0x3a, 0x46, 0xd1, 0x7f, 0x68, 0xf0, 0x73,
-	0x11, 0xb8, 0x8f, 0x40, 0xd5, 0x6f, 0x85, 0x55, 0x7d, 0x35, 0xe3, 0x6e, 0x86, 0x28, 0xfb, 0x8f,
-	0x73, 0x30, 0x1f, 0x37, 0xcf, 0xa7, 0x97, 0x94, 0x20, 0x17, 0xa6, 0x5b, 0x7a, 0x51, 0x59, 0x5d,
-	0xa5, 0xcb, 0xa9, 0x9b, 0xb7, 0xc1, 0xdc, 0x20, 0x44, 0x0a, 0x0d, 0xbb, 0x38, 0xc2, 0x02, 0x7d,
-	0x1b, 0x66, 0x49, 0xf8, 0xcb, 0x03, 0x25, 0xc6, 0xac, 0x25, 0x0e, 0xc9, 0xd8, 0x8f, 0x61, 0x23,
-	0x00, 0x17, 0xc7, 0x18, 0x99, 0xdf, 0x33, 0x60, 0x26, 0x62, 0x01, 0x98, 0xbf, 0xe7, 0xed, 0xb8,
-	0xa8, 0xbf, 0x97, 0xcd, 0x1b, 0x0e, 0x43, 0xdb, 0xb0, 0x40, 0x06, 0x9e, 0xed, 0xcf, 0x7d, 0xbd,
-	0x47, 0x76, 0x3a, 0xb4, 0x29, 0x43, 0x29, 0xff, 0x05, 0x6f, 0x35, 0x01, 0x07, 0x27, 0xce, 0x34,
-	0xff, 0x25, 0x07, 0xc8, 0x1f, 0xcc, 0xd2, 0xf9, 0x7f, 0x1f, 0xc6, 0x77, 0xc5, 0xd1, 0x3e, 0xdc,
-	0xd3, 0x8d, 0x5a, 0x59, 0x7f, 0xbd, 0xa2, 0x68, 0xa2, 0xdf, 0x38, 0x99, 0xab, 0x0a, 0xf1, 0x6b,
-	0x8a, 0xde, 0x05, 0xd8, 0xb5, 0x7a, 0x96, 0xdb, 0x1e, 0xf1, 0x55, 0x1a, 0x4f, 0x1e, 0x36, 0x7c,
-	0x0a, 0x58, 0xa3, 0x66, 0x7e, 0x53, 0xb3, 0x00, 0xdc, 0x55, 0xa4, 0x3a, 0xd6, 0xe7, 0xc2, 0xb2,
-	0x2c, 0xc5, 0x5f, 0xf5, 0x28, 0xb8, 0xf9, 0xd7, 0x63, 0x9a, 0xea, 0x48, 0xeb, 0xff, 0x06, 0xa0,
-	0x0e, 0x71, 0xbd, 0x9b, 0xa4, 0xd7, 0x64, 0x07, 0x4d, 0x77, 0x1d, 0xea, 0xaa, 0xf6, 0xc3, 0x92,
-	0xa4, 0x84, 0xb6, 0x62, 0x18, 0x38, 0x61, 0x16, 0xba, 0x12, 0xf6, 0x24, 0x2b, 0x51, 0x4f, 0x32,
-	0x1d, 0xe8, 0xed, 0x68, 0xbe, 0x04, 0x7d, 0xa0, 0xd9, 0xc4, 0x7c, 0x96, 0xce, 0x72, 0x64, 0xdb,
-	0x15, 0xf5, 0x61, 0xa1, 0x68, 0xef, 0xfa, 0x86, 0x52, 0x0d, 0x6b, 0x86, 0x52, 0xd3, 0xd5, 0xb1,
-	0x53, 0xd0, 0xd5, 0xdf, 0x86, 0xb9, 0xdd, 0xe8, 0x1b, 0x2d, 0xd9, 0xe7, 0x78, 0x79, 0xc4, 0x27,
-	0x5e, 0xb5, 0xb3, 0x87, 0xc1, 0xc3, 0x9e, 0x60, 0x18, 0xc7, 0x19, 0x45, 0xd4, 0xb9, 0x78, 0x92,
-	0xea, 0xbc, 0xf4, 0x2a, 0x4c, 0x85, 0xa4, 0x9c, 0xe9, 0x0b, 0xca, 0x7f, 0x37, 0xe0, 0xfc, 0x91,
-	0xfd, 0x29, 0x16, 0x76, 0x0a, 0xf1, 0x48, 0xe7, 0xf8, 0x72, 0x6a, 0x8b, 0x1f, 0xee, 0x56, 0x8a,
-	0x6b, 0x2e, 0x86, 0xb1, 0x24, 0x29, 0x89, 0x77, 0xc8, 0x8e, 0xb4, 0x4f, 0xe9, 0x89, 0x87, 0xbb,
-	0x9e, 0x3e, 0xf1, 0x2d, 0x22, 0x88, 0x77, 0xc8, 0x8e, 0xf9, 0x71, 0x0e, 0x66, 0x99, 0x3b, 0x09,
-	0x55, 0x6c, 0xb6, 0xd5, 0x0b, 0xf0, 0x0c, 0x06, 0x2b, 0xd2, 0x4b, 0xaa, 0x8d, 0x87, 0x9e, 0x7e,
-	0x7f, 0x5d, 0x25, 0x81, 0x99, 0xb6, 0x10, 0xab, 0x25, 0xd5, 0x4a, 0xb1, 0xcc, 0xf1, 0xeb, 0xea,
-	0x4b, 0x94, 0x7c, 0x16, 0xca, 0xb1, 0x07, 0xfe, 0x82, 0xb2, 0xfe, 0xf9, 0x8a, 0xf9, 0x67, 0x39,
-	0x10, 0xd6, 0xed, 0x11, 0xc4, 0x89, 0xbf, 0x1e, 0x8a, 0x13, 0x53, 0x06, 0x40, 0x7c, 0x71, 0x43,
-	0x63, 0xc4, 0xa8, 0xe3, 0xb9, 0x98, 0x85, 0xe8, 0xd1, 0xf1, 0xe1, 0x3f, 0x18, 0x50, 0xe2, 0x78,
-	0x8f, 0x20, 0x36, 0xdc, 0x0e, 0xc7, 0x86, 0xcf, 0x67, 0xd8, 0xc5, 0x90, 0xb8, 0xf0, 0x8f, 0x0b,
-	0x72, 0xf5, 0xbe, 0x5f, 0x6b, 0x13, 0xa7, 0x29, 0xdd, 0x4c, 0xe0, 0xd7, 0xd8, 0x20, 0x16, 0x30,
-	0xd4, 0x87, 0x29, 0x57, 0x53, 0x16, 0x37, 0xdb, 0xdb, 0x2b, 0x5d, 0xcf, 0x5c, 0xed, 0x43, 0x48,
-	0x7d, 0x18, 0x87, 0x19, 0xa0, 0x6f, 0xc1, 0xac, 0x23, 0xae, 0x2d, 0x6d, 0x6e, 0xf8, 0x26, 0x3f,
-	0x9f, 0xf9, 0x49, 0x96, 0xba, 0xfb, 0x7e, 0x54, 0x87, 0x23, 0x54, 0x71, 0x8c, 0x0f, 0xfa, 0x7d,
-	0x03, 0xe6, 0xfb, 0xf1, 0xc0, 0x59, 0x2a, 0xe7, 0x2b, 0x19, 0x9d, 0x5c, 0x40, 0xa0, 0xf6, 0xc4,
-	0xe1, 0xfd, 0x95, 0xa4, 0x90, 0x1c, 0x27, 0xb1, 0x43, 0x6d, 0x98, 0xd4, 0xdf, 0xc4, 0x49, 0x35,
-	0xbe, 0x94, 0xfd, 0xf1, 0x9d, 0x68, 0x63, 0xea, 0x23, 0x38, 0x44, 0xd9, 0xfc, 0x7c, 0x1c, 0xca,
-	0x9a, 0xde, 0x0f, 0x89, 0x43, 0xca, 0x23, 0xc5, 0x21, 0x17, 0xc3, 0x71, 0xc8, 0x53, 0xd1, 0x38,
-	0x04, 0x38, 0xe3, 0x50, 0x0c, 0xe2, 0xc2, 0xb4, 0xf4, 0x8e, 0xea, 0xdd, 0xa1, 0x78, 0x54, 0x39,
-	0xb2, 0x0f, 0x46, 0x2c, 0x8f, 0xd8, 0x08, 0x91, 0xc4, 0x11, 0x16, 0xe8, 0xba, 0xcf, 0xb4, 0x3e,
-	0xe8, 0x76, 0x89, 0x73, 0xb0, 0x38, 0x19, 0xee, 0x94, 0x6d, 0x84, 0xa0, 0x38, 0x82, 0x8d, 0x1c,
-	0x98, 0x6e, 0x0c, 0x1c, 0x87, 0xf6, 0xbc, 0x8d, 0x13, 0x89, 0xa6, 0xf9, 0x9a, 0xd7, 0x42, 0x14,
-	0x71, 0x84, 0x03, 0x22, 0x30, 0xde, 0x96, 0x12, 0xca, 0x67, 0x79, 0x53, 0x14, 0x63, 0xe6, 0x07,
-	0x79, 0x4a, 0x3a, 0x8a, 0x2e, 0xda, 0x86, 0xa2, 0x78, 0x91, 0x25, 0x1f, 0x61, 0xbc, 0x90, 0xb6,
-	0x55, 0xc6, 0xe6, 0x08, 0x8f, 0x2b, 0xfe, 0xc6, 0x92, 0x8e, 0x1e, 0x61, 0x96, 0x8e, 0x89, 0x30,
-	0xdf, 0x00, 0x64, 0xef, 0xb8, 0xd4, 0xd9, 0xa7, 0xcd, 0x1b, 0xe2, 0x97, 0x3b, 0xd8, 0x3d, 0x60,
-	0x91, 0x51, 0x3e, 0xd0, 0xc3, 0x77, 0x62, 0x18, 0x38, 0x61, 0x16, 0x33, 0x28, 0x52, 0x7a, 0xfe,
-	0x05, 0x94, 0xa1, 0xdd, 0xd5, 0x8c, 0x17, 0x3a, 0x10, 0x1b, 0x7f, 0x4e, 0xbb, 0x16, 0xa1, 0x8a,
-	0x63, 0x7c, 0xd0, 0x07, 0x30, 0xc5, 0x6e, 0x46, 0xc0, 0x18, 0x1e, 0x92, 0xf1, 0x1c, 0xb3, 0x9f,
-	0x5b, 0x3a, 0x49, 0x1c, 0xe6, 0x60, 0x5e, 0x81, 0x39, 0x71, 0xa3, 0xf5, 0xb8, 0xe6, 0xf8, 0x1f,
-	0x97, 0xf8, 0xbe, 0x01, 0x61, 0xbb, 0x1c, 0x7e, 0x18, 0x6e, 0xa4, 0x78, 0x18, 0x7e, 0x0f, 0xa6,
-	0x07, 0x7d, 0xd7, 0x73, 0x28, 0xe9, 0xd6, 0x3d, 0xed, 0x6b, 0xb7, 0x97, 0xb3, 0xf8, 0x5f, 0x3d,
-	0x32, 0xf1, 0x6f, 0xe0, 0xed, 0x10, 0x59, 0x1c, 0x61, 0x63, 0xfe, 0x6f, 0x0e, 0x42, 0x46, 0x0e,
-	0x7d, 0xcf, 0x80, 0x39, 0x12, 0xf9, 0xa5, 0x0d, 0x55, 0x93, 0xf8, 0x5a, 0xb6, 0x9f, 0x3f, 0x89,
-	0xfd, 0x50, 0x47, 0x50, 0xe8, 0x8b, 0xa2, 0xb8, 0x38, 0xce, 0x94, 0xbb, 0x14, 0x12, 0xff, 0x29,
-	0x95, 0x6c, 0x2e, 0x25, 0xe1, 0xb7, 0x58, 0x84, 0x4b, 0x49, 0x00, 0xe0, 0x24, 0x76, 0xe8, 0x1b,
-	0x50, 0x20, 0x4e, 0x4b, 0xb5, 0x43, 0xb3, 0xb3, 0x55, 0xbf, 0x90, 0x13, 0xe8, 0x4e, 0xd5, 0x69,
-	0xb9, 0x98, 0x13, 0x35, 0x7f, 0x94, 0x87, 0xd8, 0xdb, 0x72, 0xf9, 0xd0, 0xb4, 0x90, 0xf8, 0xd0,
-	0xf4, 0x2b, 0x30, 0x46, 0x1a, 0x9e, 0xff, 0x58, 0x33, 0xf8, 0x1a, 0x8b, 0x0d, 0x62, 0x01, 0x43,
-	0x77, 0xa1, 0xe4, 0x7a, 0xc4, 0xf1, 0x58, 0x8a, 0x23, 0x93, 0xbe, 0xcc, 0x5f, 0x9e, 0xd5, 0x15,
-	0x01, 0x1c, 0xd0, 0x42, 0x57, 0xc3, 0x8e, 0xc9, 0x8c, 0x3a, 0xa6, 0x39, 0x7d, 0x2f, 0xa3, 0xe6,
-	0xc8, 0x5d, 0x28, 0x6b, 0xe7, 0x20, 0x5d, 0xf8, 0xb5, 0xcc, 0x72, 0xd7, 0x2c, 0xb5, 0xf8, 0x99,
-	0x9d, 0x00, 0xa2, 0xd3, 0x0f, 0x52, 0x48, 0x2e, 0xad, 0x87, 0x4a, 0x21, 0xb9, 0xb8, 0x34, 0x6a,
-	0xe6, 0x0c, 0x4c, 0x85, 0x1e, 0x3f, 0xf3, 0x5a, 0xb2, 0x6f, 0x01, 0x1e, 0xd7, 0x5a, 0xb2, 0xbf,
-	0xc0, 0x93, 0xae, 0x25, 0x07, 0x84, 0x8f, 0xaf, 0x25, 0xfb, 0xb8, 0x8f, 0x6d, 0x2d, 0xd9, 0x5f,
-	0xe1, 0x90, 0x9c, 0xe1, 0xbf, 0x73, 0xda, 0x2e, 0xc2, 0x79, 0x43, 0xee, 0x88, 0xbc, 0xe1, 0x3d,
-	0x98, 0xb0, 0x7a, 0x1e, 0x75, 0xf6, 0x49, 0x47, 0xd6, 0xe7, 0x52, 0x6e, 0x75, 0x7d, 0xe0, 0xc8,
-	0xd0, 0x55, 0x6d, 0x75, 0x53, 0xd2, 0xc1, 0x3e, 0x45, 0xd4, 0x81, 0xb3, 0xaa, 0x8a, 0xe2, 0x50,
-	0x12, 0x94, 0x60, 0xe5, 0xc3, 0x87, 0x97, 0x54, 0xcb, 0x7e, 0x23, 0x09, 0xe9, 0xc1, 0x30, 0x00,
-	0x4e, 0x26, 0x8a, 0xdc, 0x78, 0x0e, 0x94, 0x21, 0xe4, 0x8a, 0xd6, 0x18, 0xd2, 0xa5, 0x41, 0xe6,
-	0xc7, 0x79, 0x98, 0x89, 0x68, 0xda, 0x90, 0xe8, 0xbc, 0x38, 0x52, 0x74, 0xae, 0x99, 0xb2, 0xfc,
-	0x48, 0xc1, 0x58, 0x61, 0xa4, 0x60, 0xec, 0x55, 0x11, 0x10, 0x49, 0xf9, 0x6f, 0xae, 0xcb, 0x37,
-	0xf8, 0xbe, 0x4c, 0xb6, 0x74, 0x20, 0x0e, 0xe3, 0x72, 0x5f, 0xda, 0x8c, 0x7f, 0xb7, 0x2f, 0xa3,
-	0xb9, 0x57, 0xb2, 0xbe, 0xf1, 0xf1, 0x09, 0x08, 0x5f, 0x9a, 0x00, 0xc0, 0x49, 0xec, 0x6a, 0x6f,
-	0x7c, 0xf2, 0xe5, 0xf2, 0x99, 0xcf, 0xbf, 0x5c, 0x3e, 0xf3, 0xc5, 0x97, 0xcb, 0x67, 0x3e, 0x3a,
-	0x5c, 0x36, 0x3e, 0x39, 0x5c, 0x36, 0x3e, 0x3f, 0x5c, 0x36, 0xbe, 0x38, 0x5c, 0x36, 0x7e, 0x7c,
-	0xb8, 0x6c, 0xfc, 0xc9, 0x4f, 0x96, 0xcf, 0xbc, 0xfb, 0x74, 0x9a, 0xdf, 0xe2, 0xfb, 0xbf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x56, 0x5c, 0xc3, 0x1f, 0xb2, 0x4f, 0x00, 0x00,
+	// 6883 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3d, 0x5b, 0x8c, 0x24, 0x49,
+	0x71, 0x5b, 0xdd, 0xf3, 0x8c, 0x79, 0xe7, 0xcc, 0xee, 0xf5, 0xcd, 0xf9, 0x76, 0xcf, 0xc5, 0xf9,
+	0x74, 0xc0, 0x31, 0xc3, 0xee, 0xdd, 0xc2, 0x71, 0x7b, 0x3e, 0x6e, 0x7a, 0x66, 0x1f, 0xb3, 0x3b,
+	0xbb, 0xdb, 0xce, 0xde, 0x07, 0x1c, 0x77, 0x82, 0x9a, 0xea, 0x9c, 0xee, 0x62, 0xaa, 0xab, 0xea,
+	0xaa, 0xaa, 0x67, 0x77, 0x00, 0x01, 0x07, 0x46, 0x42, 0x96, 0xb1, 0x6c, 0x61, 0xcb, 0xf8, 0x0b,
+	0x04, 0x1f, 0xb6, 0x85, 0xec, 0x4f, 0xcb, 0x08, 0x3f, 0x3e, 0xb0, 0x64, 0x04, 0x36, 0x42, 0x32,
+	0x58, 0x7c, 0xa0, 0x95, 0x6f, 0xb1, 0x2c, 0x7f, 0x21, 0x59, 0xf2, 0x87, 0xb5, 0x16, 0x92, 0x95,
+	0x8f, 0xca, 0xca, 0xac, 0xaa, 0xde, 0xe9, 0xea, 0x9d, 0xbd, 0x3b, 0x7f, 0x4d, 0x4f, 0x46, 0x64,
+	0x44, 0x3e, 0x22, 0x23, 0x23, 0x22, 0x23, 0xb3, 0xe0, 0xb9, 0xb6, 0x13, 0x77, 0x7a, 0xdb, 0x2b,
+	0xb6, 0xdf, 0x5d, 0xb5, 0x76, 0x7b, 0x4e, 0xbc, 0xbf, 0xba, 0x6b, 0x85, 0x6d, 0x7f, 0xd5, 0x0a,
+	0x9c, 0xd5, 0xbd, 0x93, 0x96, 0x1b, 0x74, 0xac, 0x93, 0xab, 0x6d, 0xe2, 0x91, 0xd0, 0x8a, 0x49,
+	0x6b, 0x25, 0x08, 0xfd, 0xd8, 0x47, 0x4f, 0xa6, 0xb5, 0x56, 0x78, 0xad, 0x15, 0x56, 0x6b, 0xc5,
+	0x0a, 0x9c, 0x95, 0xa4, 0xd6, 0xf2, 0xfb, 0x14, 0xda, 0x6d, 0xbf, 0xed, 0xaf, 0xb2, 0xca, 0xdb,
+	0xbd, 0x1d, 0xf6, 0x1f, 0xfb, 0x87, 0xfd, 0xe2, 0x44, 0x97, 0xdf, 0xb5, 0xfb, 0x7c, 0xb4, 0xe2,
+	0x70, 0xce, 0xdb, 0x56, 0x6c, 0x77, 0x56, 0xf7, 0x72, 0x9c, 0x97, 0x4d, 0x05, 0xc9, 0xf6, 0x43,
+	0x72, 0x10, 0x4e, 0xb8, 0x6d, 0xd9, 0x45, 0x38, 0xcf, 0xa5, 0x38, 0x5d, 0xcb, 0xee, 0x38, 0x1e,
+	0x09, 0xf7, 0x57, 0x83, 0xdd, 0x36, 0x2d, 0x88, 0x56, 0xbb, 0x24, 0xb6, 0x8a, 0x6a, 0xad, 0xf6,
+	0xab, 0x15, 0xf6, 0xbc, 0xd8, 0xe9, 0x92, 0x5c, 0x85, 0x0f, 0x1c, 0x54, 0x21, 0xb2, 0x3b, 0xa4,
+	0x6b, 0x65, 0xeb, 0x99, 0xaf, 0xc2, 0xe2, 0x9a, 0x67, 0xb9, 0xfb, 0x91, 0x13, 0xe1, 0x9e, 0xb7,
+	0x16, 0xb6, 0x7b, 0x5d, 0xe2, 0xc5, 0xe8, 0x09, 0x18, 0xf1, 0xac, 0x2e, 0xa9, 0x19, 0x4f, 0x18,
+	0x4f, 0x4f, 0xd6, 0xa7, 0xbf, 0x7f, 0xe7, 0xc4, 0x91, 0xbb, 0x77, 0x4e, 0x8c, 0x5c, 0xb1, 0xba,
+	0x04, 0x33, 0x08, 0x7a, 0x17, 0x8c, 0xee, 0x59, 0x6e, 0x8f, 0xd4, 0x2a, 0x0c, 0x65, 0x46, 0xa0,
+	0x8c, 0xde, 0xa0, 0x85, 0x98, 0xc3, 0xcc, 0x2f, 0x56, 0x35, 0xf2, 0x97, 0x49, 0x6c, 0xb5, 0xac,
+	0xd8, 0x42, 0x5d, 0x18, 0x73, 0xad, 0x6d, 0xe2, 0x46, 0x35, 0xe3, 0x89, 0xea, 0xd3, 0x53, 0xa7,
+	0xce, 0xae, 0x0c, 0x32, 0xcf, 0x2b, 0x05, 0xa4, 0x56, 0xb6, 0x18, 0x9d, 0xb3, 0x5e, 0x1c, 0xee,
+	0xd7, 0x67, 0x45, 0x23, 0xc6, 0x78, 0x21, 0x16, 0x4c, 0xd0, 0x1b, 0x06, 0x4c, 0x59, 0x9e, 0xe7,
+	0xc7, 0x56, 0xec, 0xf8, 0x5e, 0x54, 0xab, 0x30, 0xa6, 0x17, 0x87, 0x67, 0xba, 0x96, 0x12, 0xe3,
+	0x9c, 0x17, 0x05, 0xe7, 0x29, 0x05, 0x82, 0x55, 0x9e, 0xcb, 0x1f, 0x82, 0x29, 0xa5, 0xa9, 0x68,
+	0x1e, 0xaa, 0xbb, 0x64, 0x9f, 0x8f, 0x2f, 0xa6, 0x3f, 0xd1, 0x92, 0x36, 0xa0, 0x62, 0x04, 0x5f,
+	0xa8, 0x3c, 0x6f, 0x2c, 0xbf, 0x04, 0xf3, 0x59, 0x86, 0x65, 0xea, 0x9b, 0xbf, 0x67, 0xc0, 0x92,
+	0xd2, 0x0b, 0x4c, 0x76, 0x48, 0x48, 0x3c, 0x9b, 0xa0, 0x55, 0x98, 0xa4, 0x73, 0x19, 0x05, 0x96,
+	0x9d, 0x4c, 0xf5, 0x82, 0xe8, 0xc8, 0xe4, 0x95, 0x04, 0x80, 0x53, 0x1c, 0x29, 0x16, 0x95, 0xfb,
+	0x89, 0x45, 0xd0, 0xb1, 0x22, 0x52, 0xab, 0xea, 0x62, 0xd1, 0xa0, 0x85, 0x98, 0xc3, 0xcc, 0xdf,
+	0x84, 0x47, 0x93, 0xf6, 0x5c, 0x23, 0xdd, 0xc0, 0xb5, 0x62, 0x92, 0x36, 0xea, 0x40, 0xd1, 0x33,
+	0xe7, 0x60, 0x66, 0x2d, 0x08, 0x42, 0x7f, 0x8f, 0xb4, 0x9a, 0xb1, 0xd5, 0x26, 0xe6, 0x1b, 0xb4,
+	0x83, 0x61, 0xdb, 0x5f, 0xdf, 0x58, 0x0b, 0x82, 0x0b, 0xc4, 0x72, 0xe3, 0xce, 0x7a, 0x87, 0xd8,
+	0xbb, 0xe8, 0x19, 0x98, 0xf8, 0x64, 0xe4, 0x7b, 0x0d, 0x2b, 0xee, 0x08, 0x7a, 0xf3, 0x82, 0xde,
+	0xc4, 0xc5, 0xe6, 0xd5, 0x2b, 0xb4, 0x1c, 0x4b, 0x0c, 0x74, 0x06, 0x66, 0xc8, 0xed, 0x80, 0xd8,
+	0x31, 0x69, 0xdd, 0x50, 0x44, 0xfb, 0xa8, 0xa8, 0x32, 0x73, 0x56, 0x05, 0x62, 0x1d, 0xd7, 0xfc,
+	0x82, 0x01, 0x47, 0x33, 0x6d, 0x68, 0xc6, 0x56, 0xdc, 0x8b, 0xd0, 0x4b, 0x30, 0x16, 0xb1, 0x5f,
+	0xa2, 0x09, 0x4f, 0x25, 0x52, 0xca, 0xe1, 0xf7, 0xee, 0x9c, 0x58, 0x2a, 0xa8, 0x48, 0xb0, 0xa8,
+	0x85, 0xde, 0x0d, 0xe3, 0x5d, 0x12, 0x45, 0x56, 0x3b, 0x69, 0xd0, 0x9c, 0x20, 0x30, 0x7e, 0x99,
+	0x17, 0xe3, 0x04, 0x6e, 0xfe, 0xa0, 0x02, 0x73, 0x92, 0x96, 0x60, 0xff, 0x10, 0x26, 0xb9, 0x07,
+	0xd3, 0x1d, 0xa5, 0x87, 0x6c, 0xae, 0xa7, 0x4e, 0x9d, 0x19, 0x70, 0x3d, 0x15, 0x0d, 0x52, 0x7d,
+	0x49, 0xb0, 0x99, 0x56, 0x4b, 0xb1, 0xc6, 0x06, 0x75, 0x01, 0xa2, 0x7d, 0xcf, 0x16, 0x4c, 0x47,
+	0x18, 0xd3, 0x0f, 0x95, 0x64, 0xda, 0x94, 0x04, 0xea, 0x48, 0xb0, 0x84, 0xb4, 0x0c, 0x2b, 0x0c,
+	0xcc, 0x1f, 0xaa, 0x52, 0xc5, 0xcb, 0xb8, 0x54, 0x1d, 0xac, 0x1c, 0xb5, 0x31, 0xaf, 0x0c, 0x30,
+	0xe6, 0x9f, 0x00, 0x14, 0x92, 0xd7, 0x7b, 0x4e, 0x48, 0x5a, 0x69, 0x6b, 0xc4, 0x1a, 0x7a, 0xbf,
+	0xa8, 0x89, 0x70, 0x0e, 0xe3, 0xde, 0x9d, 0x13, 0x28, 0xd7, 0x35, 0x82, 0x0b, 0x68, 0x99, 0x7f,
+	0x69, 0xc0, 0x62, 0xc1, 0x28, 0xa0, 0x17, 0x33, 0xd2, 0xf9, 0x64, 0x4e, 0x3a, 0x8b, 0x38, 0x24,
+	0xb2, 0xf9, 0x0c, 0x4c, 0x84, 0x64, 0xcf, 0x89, 0x1c, 0xdf, 0xab, 0x55, 0xf4, 0x05, 0x86, 0x45,
+	0x39, 0x96, 0x18, 0xe8, 0xbd, 0x30, 0x99, 0xfc, 0xa6, 0x9d, 0xab, 0x52, 0x05, 0x41, 0x87, 0x24,
+	0x41, 0x8d, 0x70, 0x0a, 0x37, 0xff, 0x7d, 0x54, 0x91, 0xe5, 0xeb, 0x41, 0xcb, 0x8a, 0x09, 0x5d,
+	0x0a, 0x56, 0x10, 0x5c, 0x49, 0x07, 0x5f, 0x2e, 0x85, 0x35, 0x5e, 0x8c, 0x13, 0x38, 0x7a, 0x1e,
+	0xa6, 0xc5, 0x4f, 0x75, 0x16, 0xa4, 0x98, 0xad, 0x29, 0x30, 0xac, 0x61, 0xa2, 0x9b, 0x30, 0xe6,
+	0x87, 0x4e, 0xdb, 0xf1, 0x84, 0x88, 0x3d, 0x3b, 0x98, 0x88, 0x9d, 0x0b, 0x89, 0xd3, 0xee, 0xc4,
+	0x57, 0x59, 0xd5, 0x3a, 0xd0, 0x21, 0xe4, 0xbf, 0xb1, 0x20, 0x87, 0x7a, 0x30, 0x13, 0xf9, 0xbd,
+	0xd0, 0x26, 0xbc, 0x37, 0x7c, 0x08, 0xa6, 0x4e, 0x3d, 0x5f, 0x46, 0x84, 0x9b, 0x0a, 0x81, 0x54,
+	0x33, 0xa9, 0xa5, 0x11, 0xd6, 0xb9, 0xa0, 0x93, 0x30, 0xc5, 0x0b, 0x36, 0xbd, 0x16, 0xb9, 0x5d,
+	0x9b, 0x78, 0xc2, 0x78, 0x7a, 0xb4, 0x3e, 0x47, 0x37, 0xab, 0x66, 0x5a, 0x8c, 0x55, 0x1c, 0xd4,
+	0x85, 0xa9, 0x4e, 0xaa, 0x46, 0x6b, 0xa3, 0x6c, 0x1c, 0x5e, 0x18, 0x6a, 0x7d, 0x33, 0x0a, 0x9c,
+	0x9d, 0x52, 0x80, 0x55, 0xfa, 0xe8, 0x3c, 0x2c, 0x58, 0xac, 0xd6, 0xba, 0xdb, 0x8b, 0x62, 0x12,
+	0xb2, 0x09, 0x1e, 0x63, 0x13, 0xf6, 0xa8, 0xe8, 0xe2, 0xc2, 0x5a, 0x16, 0x01, 0xe7, 0xeb, 0xa0,
+	0x2b, 0x30, 0x1d, 0x12, 0xde, 0x91, 0x6b, 0xfb, 0x01, 0xa9, 0x8d, 0x33, 0x1a, 0xef, 0x49, 0x26,
+	0x1d, 0x2b, 0xb0, 0x54, 0xb0, 0xd5, 0x52, 0xac, 0xd5, 0x47, 0x16, 0x4c, 0x51, 0x85, 0x70, 0xcd,
+	0xe9, 0x12, 0xbf, 0x17, 0xd7, 0x26, 0xd9, 0x38, 0xac, 0xac, 0x70, 0x5b, 0x6b, 0x45, 0xb5, 0xb5,
+	0x56, 0x82, 0xdd, 0x36, 0x2d, 0x88, 0x56, 0xba, 0x24, 0xb6, 0x56, 0xf6, 0x4e, 0xae, 0x6c, 0xf4,
+	0x42, 0xb6, 0x61, 0x8b, 0xa1, 0x4e, 0xc9, 0x60, 0x95, 0xa6, 0xf9, 0x03, 0x03, 0x80, 0xb7, 0xe3,
+	0x02, 0x71, 0xbb, 0xc8, 0x86, 0x31, 0xa7, 0x6b, 0xb5, 0x49, 0x62, 0x19, 0x95, 0x52, 0xaa, 0x94,
+	0xc2, 0x26, 0xad, 0x2d, 0xe4, 0x43, 0xda, 0x43, 0xac, 0x30, 0xc2, 0x82, 0xb4, 0x22, 0xe1, 0x95,
+	0x43, 0x95, 0x70, 0xf3, 0xbf, 0xe4, 0x26, 0x98, 0x69, 0x0a, 0xb5, 0x0b, 0x18, 0xf3, 0x9a, 0xa1,
+	0xdb, 0x05, 0x0c, 0x07, 0x73, 0xd8, 0xc3, 0x5b, 0x79, 0x8f, 0x73, 0x6b, 0x89, 0xeb, 0x80, 0x29,
+	0xc1, 0xbb, 0x7a, 0x89, 0xec, 0x73, 0xd3, 0xe9, 0x4c, 0x62, 0x3a, 0x71, 0x85, 0xfb, 0x1b, 0x9a,
+	0x2d, 0x4b, 0xf7, 0x67, 0xa5, 0x27, 0xac, 0x8c, 0x89, 0x8a, 0xb0, 0x71, 0x7f, 0x62, 0x24, 0x7a,
+	0xea, 0x52, 0x2f, 0x8a, 0xfd, 0xae, 0xf3, 0x29, 0x82, 0x3a, 0x99, 0x59, 0x7c, 0xb9, 0xcc, 0x2c,
+	0x4a, 0x32, 0x6f, 0xeb, 0x54, 0xfe, 0xd0, 0x80, 0xe5, 0xfe, 0xed, 0x29, 0x3b, 0x9f, 0xd5, 0xc3,
+	0x9d, 0xcf, 0x55, 0x98, 0xec, 0x45, 0x64, 0xc3, 0x69, 0x93, 0x28, 0x66, 0x1d, 0x9f, 0x48, 0xf7,
+	0xd7, 0xeb, 0x09, 0x00, 0xa7, 0x38, 0xe6, 0xf7, 0xaa, 0x80, 0xf2, 0x0a, 0x94, 0xee, 0x27, 0x21,
+	0x09, 0xfc, 0xeb, 0x78, 0x2b, 0xbb, 0x9f, 0x60, 0x5e, 0x8c, 0x13, 0x38, 0xed, 0xb0, 0xdd, 0xb1,
+	0xc2, 0x38, 0xeb, 0xef, 0xac, 0xd3, 0x42, 0xcc, 0x61, 0x4a, 0x87, 0xc7, 0x0e, 0xb7, 0xc3, 0x0d,
+	0x58, 0xea, 0xb1, 0x26, 0x5f, 0xb3, 0xc2, 0x36, 0x89, 0x93, 0x0d, 0x93, 0x8d, 0xeb, 0x44, 0xfd,
+	0xd7, 0x44, 0x63, 0x96, 0xae, 0x17, 0xe0, 0xe0, 0xc2, 0x9a, 0x68, 0x1b, 0x26, 0x77, 0x93, 0x89,
+	0x15, 0xcb, 0xed, 0xf4, 0x50, 0x52, 0xca, 0xb7, 0x70, 0xf9, 0x2f, 0x4e, 0xc9, 0xa2, 0x2b, 0x30,
+	0xd2, 0x21, 0x6e, 0x57, 0xec, 0x1f, 0xef, 0x2f, 0xab, 0xca, 0xea, 0x13, 0xd4, 0xac, 0xa2, 0xbf,
+	0x30, 0xa3, 0x63, 0xfe, 0x91, 0x01, 0x73, 0xeb, 0x96, 0x67, 0x85, 0xfb, 0x8d, 0xd0, 0xef, 0xfa,
+	0x54, 0xbb, 0x96, 0x37, 0x6f, 0xe9, 0x9c, 0xfb, 0xae, 0xeb, 0xf7, 0x92, 0xa9, 0x4c, 0xe7, 0x9c,
+	0x17, 0xe3, 0x04, 0x8e, 0x9e, 0x82, 0xb1, 0x5b, 0x6c, 0x66, 0xd8, 0x38, 0x8f, 0xa6, 0x8b, 0xf0,
+	0x26, 0x2b, 0xc5, 0x02, 0x6a, 0x3e, 0x07, 0x8b, 0xeb, 0x1d, 0xcb, 0x6b, 0x13, 0xee, 0x96, 0x58,
+	0x2e, 0xdf, 0xd6, 0x1e, 0x87, 0x6a, 0x2f, 0x74, 0x6b, 0x86, 0xae, 0x75, 0xa8, 0x54, 0xd1, 0x72,
+	0xf3, 0x73, 0xc0, 0x85, 0xa7, 0x8c, 0x14, 0x1e, 0x6c, 0x9b, 0xbf, 0x1b, 0xc6, 0xf7, 0x48, 0x28,
+	0x85, 0x43, 0x21, 0x76, 0x83, 0x17, 0xe3, 0x04, 0x6e, 0xfe, 0x8d, 0x01, 0x53, 0xac, 0x05, 0xbc,
+	0xf1, 0x6f, 0x57, 0x3b, 0xd0, 0x69, 0x98, 0x6a, 0x91, 0xc8, 0x0e, 0x9d, 0x80, 0xce, 0x28, 0x13,
+	0xc6, 0xc9, 0xd4, 0xa3, 0xde, 0x48, 0x41, 0x58, 0xc5, 0x33, 0xdf, 0xa8, 0xc0, 0x12, 0x6b, 0xfe,
+	0x86, 0x13, 0xd9, 0xfe, 0x1e, 0x09, 0xf7, 0x31, 0x89, 0x7a, 0xee, 0x21, 0x8f, 0xe7, 0x06, 0xcc,
+	0x47, 0xa4, 0xbb, 0x47, 0xc2, 0x75, 0xdf, 0x8b, 0xe2, 0xd0, 0x72, 0xbc, 0x58, 0x74, 0xa8, 0x26,
+	0xb0, 0xe7, 0x9b, 0x19, 0x38, 0xce, 0xd5, 0x40, 0x4f, 0xc3, 0x84, 0xe8, 0x2d, 0x75, 0x5c, 0xa8,
+	0xe1, 0x3b, 0x4d, 0x6d, 0x64, 0x31, 0x14, 0x11, 0x96, 0x50, 0x6a, 0x51, 0x47, 0x24, 0xdc, 0x23,
+	0xad, 0xfa, 0x7e, 0x6d, 0x54, 0xb7, 0xa8, 0x9b, 0xa2, 0x1c, 0x4b, 0x0c, 0xf3, 0x57, 0x55, 0x58,
+	0x60, 0x63, 0xd0, 0xec, 0x6d, 0xcb, 0x91, 0x79, 0x27, 0x0e, 0xc0, 0x4b, 0x30, 0xdb, 0x4a, 0xa6,
+	0x69, 0xcb, 0xe9, 0x3a, 0x31, 0x9b, 0xe6, 0xd1, 0xfa, 0x31, 0x41, 0x63, 0x76, 0x43, 0x83, 0xe2,
+	0x0c, 0x36, 0x7a, 0x19, 0xe6, 0x77, 0x2c, 0xd7, 0xdd, 0xb6, 0xec, 0x5d, 0xd1, 0x87, 0xa8, 0x36,
+	0xca, 0x06, 0x72, 0x89, 0xb6, 0xe0, 0x5c, 0x06, 0x86, 0x73, 0xd8, 0xb4, 0x1f, 0x7b, 0x24, 0x74,
+	0x76, 0xa8, 0xee, 0xd8, 0x23, 0x9e, 0xe5, 0xd9, 0xdc, 0xc6, 0x9c, 0x48, 0xfb, 0x71, 0x23, 0x03,
+	0xc7, 0xb9, 0x1a, 0xe8, 0x77, 0x0d, 0x38, 0x16, 0xc8, 0x7f, 0x2f, 0x91, 0xfd, 0x26, 0xb1, 0x43,
+	0xaa, 0x56, 0x77, 0x98, 0xb1, 0x39, 0xb0, 0x35, 0xcf, 0xab, 0x51, 0x0b, 0x24, 0x09, 0x7c, 0xd4,
+	0x97, 0xef, 0xde, 0x39, 0x71, 0xac, 0x51, 0x48, 0x1b, 0xf7, 0xe1, 0x69, 0x7e, 0xdd, 0x80, 0xd9,
+	0x75, 0x27, 0xb4, 0x7b, 0x4e, 0x5c, 0x0f, 0x89, 0xb5, 0x4b, 0x42, 0xaa, 0x10, 0xe3, 0x4e, 0x48,
+	0xa2, 0x8e, 0xef, 0xb6, 0xd8, 0xf4, 0x8f, 0xa6, 0x0a, 0xf1, 0x5a, 0x02, 0xc0, 0x29, 0x0e, 0x7a,
+	0x15, 0x26, 0x6c, 0xdf, 0x77, 0x5b, 0xfe, 0xad, 0xc4, 0x88, 0x28, 0x6b, 0xe1, 0x4a, 0x09, 0x5d,
+	0x17, 0x74, 0xb0, 0xa4, 0x68, 0x7e, 0xd7, 0x80, 0x25, 0xbd, 0x85, 0xc2, 0xf1, 0xbc, 0x0c, 0x8b,
+	0xb6, 0xef, 0x45, 0xc4, 0xee, 0xc5, 0xce, 0x1e, 0x39, 0x67, 0x39, 0x6e, 0x2f, 0x24, 0x91, 0x68,
+	0xf1, 0x63, 0x82, 0xe2, 0xe2, 0x7a, 0x1e, 0x05, 0x17, 0xd5, 0x43, 0xd7, 0x60, 0xc2, 0x0f, 0x88,
+	0x47, 0x5a, 0x6b, 0xb1, 0xe8, 0xc5, 0x7b, 0x06, 0xeb, 0x05, 0x35, 0xc4, 0xf9, 0x6a, 0xbc, 0x2a,
+	0xea, 0x63, 0x49, 0xc9, 0xfc, 0xab, 0x0a, 0x2c, 0x26, 0x92, 0x49, 0x5a, 0x6b, 0x61, 0xec, 0xec,
+	0x58, 0x76, 0x4c, 0xcd, 0xae, 0x6a, 0xdb, 0x89, 0x85, 0x75, 0x37, 0xe0, 0x94, 0x9f, 0x77, 0xb2,
+	0x9a, 0x2a, 0xdd, 0x14, 0xce, 0x3b, 0x31, 0xa6, 0x14, 0xd1, 0xb6, 0xb4, 0x1c, 0x79, 0x90, 0x72,
+	0x40, 0xa7, 0x8b, 0x99, 0x5d, 0x59, 0xea, 0xfd, 0x6c, 0xc6, 0x6d, 0x18, 0x63, 0xe6, 0x4a, 0xe2,
+	0x80, 0x0e, 0xc8, 0xa3, 0x48, 0xd7, 0xa6, 0x3c, 0x18, 0x34, 0xc2, 0x82, 0xb2, 0xf9, 0xb3, 0x0a,
+	0xcc, 0xa7, 0x03, 0xb7, 0xee, 0x77, 0xe9, 0x22, 0x5e, 0x86, 0x8a, 0xd3, 0x12, 0x2a, 0x09, 0x44,
+	0xc5, 0xca, 0xe6, 0x06, 0xae, 0x38, 0x2d, 0xba, 0xd7, 0x6e, 0x87, 0x96, 0x67, 0x77, 0x84, 0x2a,
+	0x92, 0x84, 0xeb, 0xac, 0x14, 0x0b, 0x28, 0xdd, 0x54, 0x63, 0xab, 0x2d, 0x34, 0x90, 0x1c, 0xbf,
+	0x6b, 0x56, 0x1b, 0xd3, 0x72, 0xaa, 0xfa, 0xa2, 0xde, 0xf6, 0x27, 0x89, 0x1d, 0xd7, 0x46, 0x74,
+	0xd5, 0xd7, 0xe4, 0xc5, 0x38, 0x81, 0x53, 0x8e, 0x56, 0x2f, 0xee, 0xf8, 0x61, 0x6d, 0x54, 0xe7,
+	0xb8, 0xc6, 0x4a, 0xb1, 0x80, 0xd2, 0x05, 0x65, 0xb3, 0xf6, 0xc7, 0x24, 0x14, 0x5e, 0xa9, 0x5c,
+	0x50, 0xeb, 0x09, 0x00, 0xa7, 0x38, 0xe8, 0x35, 0x98, 0xb2, 0x43, 0x62, 0xc5, 0x7e, 0xb8, 0x61,
+	0xc5, 0xa4, 0x36, 0x5e, 0x5a, 0x1a, 0x99, 0xc7, 0xb8, 0x9e, 0x92, 0xc0, 0x2a, 0x3d, 0xf3, 0x97,
+	0x06, 0xd4, 0xd2, 0xa1, 0xe5, 0x06, 0xb7, 0x8c, 0x9e, 0x8a, 0xe1, 0x31, 0xfa, 0x0c, 0xcf, 0x53,
+	0x30, 0xd6, 0x4a, 0xad, 0x66, 0xa5, 0xcf, 0xc2, 0x64, 0x16, 0x50, 0x74, 0x0a, 0xa0, 0xed, 0xc4,
+	0x42, 0x77, 0x8a, 0xc1, 0x96, 0xf1, 0xb2, 0xf3, 0x12, 0x82, 0x15, 0x2c, 0x74, 0x13, 0x26, 0x59,
+	0x33, 0xd9, 0x12, 0x1c, 0x29, 0xdd, 0x69, 0x66, 0x46, 0xae, 0x27, 0x04, 0x70, 0x4a, 0xcb, 0xfc,
+	0x6a, 0x05, 0x8e, 0x9e, 0x73, 0x7b, 0xb7, 0x99, 0x25, 0x48, 0x5c, 0x62, 0x45, 0x89, 0xfd, 0xfe,
+	0x10, 0x62, 0x9b, 0xca, 0xde, 0x59, 0x1d, 0xd4, 0x25, 0x18, 0x19, 0xc8, 0x25, 0x18, 0x3d, 0x5c,
+	0x07, 0xed, 0x8d, 0x51, 0x18, 0x17, 0x58, 0xe8, 0x13, 0x30, 0xd1, 0x15, 0x67, 0x13, 0x35, 0x43,
+	0x18, 0xdb, 0x03, 0x8d, 0xfc, 0x55, 0xb6, 0x14, 0xe8, 0xb9, 0x46, 0x3a, 0xbd, 0x69, 0x19, 0x96,
+	0x54, 0x69, 0x5f, 0x2d, 0xd7, 0xb1, 0xa2, 0xda, 0xb8, 0xde, 0xd7, 0x35, 0x5a, 0x88, 0x39, 0x8c,
+	0x4e, 0xc7, 0x2d, 0x2b, 0x24, 0x1d, 0xbf, 0x17, 0x91, 0xda, 0x84, 0x3e, 0x1d, 0x37, 0x13, 0x00,
+	0x4e, 0x71, 0xd0, 0xc7, 0xe4, 0xe0, 0x4c, 0x0e, 0x3f, 0x38, 0x52, 0x86, 0x33, 0x3e, 0xd3, 0x2b,
+	0x30, 0xce, 0xd7, 0x64, 0xa2, 0xe7, 0x56, 0x07, 0xd6, 0xd3, 0x7c, 0x59, 0xa7, 0x53, 0xcf, 0xff,
+	0x8f, 0x70, 0x42, 0x10, 0x35, 0xa5, 0x9a, 0x1e, 0x61, 0xa4, 0xdf, 0x5b, 0x42, 0x4d, 0xf7, 0xd5,
+	0xcb, 0x4d, 0xa9, 0x97, 0x47, 0xcb, 0x10, 0x65, 0xe2, 0xd6, 0x4f, 0x11, 0xd3, 0x21, 0x16, 0xf1,
+	0xdd, 0x61, 0x5c, 0x52, 0x11, 0x2a, 0x9f, 0xd5, 0x83, 0xc2, 0x49, 0xf8, 0xd7, 0xfc, 0xc3, 0x2a,
+	0x2c, 0x08, 0xcc, 0x75, 0xdf, 0x75, 0x89, 0xcd, 0xcc, 0x4f, 0xae, 0xe6, 0xab, 0x85, 0x6a, 0xde,
+	0x81, 0x51, 0x27, 0x26, 0xdd, 0x24, 0x30, 0x52, 0x2f, 0xd5, 0x9a, 0x94, 0xc7, 0xca, 0x26, 0x25,
+	0xc2, 0xcf, 0xde, 0xe4, 0x2c, 0x09, 0x2c, 0xcc, 0x39, 0xa0, 0x2f, 0x19, 0xb0, 0xc8, 0xec, 0x37,
+	0xc7, 0x66, 0x66, 0xca, 0x05, 0x27, 0x8a, 0xfd, 0x70, 0x5f, 0x6c, 0xac, 0x1f, 0x18, 0x8c, 0xf3,
+	0x0d, 0x85, 0xc0, 0xa6, 0xb7, 0xe3, 0xa7, 0x96, 0xc9, 0x8d, 0x3c, 0x69, 0x5c, 0xc4, 0x6f, 0x39,
+	0x00, 0x48, 0x5b, 0x5b, 0x70, 0x70, 0xb7, 0xa5, 0x1e, 0xdc, 0x0d, 0xdc, 0xb0, 0xa4, 0xb3, 0x89,
+	0xe6, 0x57, 0x0f, 0xfc, 0xbe, 0x61, 0xc0, 0xb1, 0xdc, 0x90, 0x6d, 0x10, 0x37, 0xb6, 0x90, 0x05,
+	0x13, 0xdb, 0x56, 0x44, 0x5c, 0xc7, 0x23, 0x42, 0x53, 0x7c, 0x70, 0xc8, 0x29, 0xe0, 0x36, 0x53,
+	0x5d, 0x10, 0xc3, 0x92, 0x2c, 0x3b, 0x02, 0xa4, 0xa7, 0xea, 0xd9, 0x48, 0x49, 0x83, 0x16, 0x62,
+	0x0e, 0x33, 0xff, 0xde, 0x80, 0x29, 0x41, 0x72, 0xcb, 0x89, 0x62, 0x6a, 0x84, 0x66, 0x34, 0xd8,
+	0x80, 0x46, 0x28, 0xad, 0xcd, 0xf4, 0x97, 0x34, 0x42, 0x93, 0x12, 0x45, 0x7b, 0xe1, 0x44, 0xea,
+	0xf8, 0xdc, 0xbf, 0xaf, 0x54, 0x97, 0x95, 0xe0, 0x16, 0xa5, 0x21, 0xc4, 0xcb, 0x0c, 0x61, 0x46,
+	0xd3, 0x43, 0xe8, 0x34, 0x8c, 0xec, 0x3a, 0x5e, 0x62, 0xdf, 0xfc, 0x7a, 0xb2, 0xb7, 0x5c, 0x72,
+	0xbc, 0xd6, 0xbd, 0x3b, 0x27, 0x16, 0x34, 0x64, 0x5a, 0x88, 0x19, 0xfa, 0xc1, 0x5b, 0xd2, 0x0b,
+	0x13, 0x5f, 0xfb, 0xc6, 0x89, 0x23, 0x9f, 0xff, 0xf9, 0x13, 0x47, 0xcc, 0x1f, 0x8c, 0xc2, 0x7c,
+	0x76, 0xe2, 0x07, 0x3b, 0x8e, 0x4a, 0xf5, 0xf2, 0x58, 0x29, 0xbd, 0x3c, 0xf1, 0x50, 0xf5, 0x72,
+	0xe5, 0xe1, 0xe9, 0xe5, 0xea, 0xc3, 0xd0, 0xcb, 0x23, 0x87, 0xa7, 0x97, 0x6f, 0xc3, 0xbc, 0xaa,
+	0x2c, 0xa8, 0x6e, 0xa9, 0x8d, 0x96, 0x51, 0x00, 0x39, 0xcd, 0xb4, 0x24, 0x5d, 0x58, 0xa5, 0x14,
+	0xe7, 0xb8, 0xf4, 0xd5, 0x8b, 0xe3, 0x6f, 0xad, 0x5e, 0x34, 0x7f, 0x64, 0xc0, 0xac, 0x14, 0xe6,
+	0xd7, 0x7b, 0xd4, 0xec, 0x4c, 0xe5, 0xce, 0x38, 0x7c, 0xb9, 0xfb, 0x38, 0x8c, 0xf3, 0xa3, 0x9d,
+	0x48, 0x68, 0xda, 0xe7, 0xca, 0x6d, 0x85, 0xbc, 0xae, 0xe2, 0x50, 0xf0, 0x02, 0x9c, 0x50, 0x35,
+	0xff, 0x39, 0xed, 0x90, 0x80, 0x71, 0x7b, 0x3b, 0xa4, 0xde, 0x88, 0xc1, 0x42, 0x0d, 0x8a, 0xbd,
+	0x4d, 0x4b, 0xb1, 0x80, 0x22, 0x93, 0xed, 0xd2, 0x89, 0xdb, 0x37, 0xc9, 0x0d, 0x3e, 0x96, 0xdc,
+	0xc0, 0x37, 0x5b, 0x2a, 0x86, 0x3e, 0x2c, 0x59, 0x7b, 0x96, 0xe3, 0x5a, 0xdb, 0x8e, 0xeb, 0xc4,
+	0xfb, 0xcd, 0x38, 0xb4, 0x62, 0xd2, 0xde, 0x17, 0x1b, 0xed, 0x99, 0x24, 0x06, 0xbc, 0x56, 0x80,
+	0x73, 0xef, 0xce, 0x89, 0xc7, 0x44, 0xcb, 0x8a, 0xc0, 0xb8, 0x90, 0xb0, 0xf9, 0xcb, 0xaa, 0x54,
+	0x71, 0xc2, 0x67, 0xbf, 0x05, 0xc0, 0x67, 0x92, 0xb4, 0x36, 0x3d, 0xb1, 0x85, 0xaf, 0x0f, 0x61,
+	0x50, 0xac, 0xdc, 0x90, 0x54, 0xf8, 0x1e, 0x2e, 0x8d, 0xcf, 0x14, 0x80, 0x15, 0x56, 0xe8, 0xd3,
+	0x30, 0x65, 0x89, 0x94, 0x8f, 0x73, 0x7e, 0x28, 0xf4, 0xc6, 0xc6, 0x30, 0x9c, 0xd7, 0x52, 0x32,
+	0xd9, 0xd4, 0x9d, 0x14, 0x82, 0x55, 0x6e, 0xcb, 0x21, 0xcc, 0x65, 0xda, 0x5b, 0xb0, 0x8b, 0x6f,
+	0xea, 0xbb, 0xf8, 0xb3, 0x65, 0x96, 0x91, 0xc8, 0x63, 0x51, 0x73, 0x7e, 0x22, 0x98, 0xcf, 0xb6,
+	0xf4, 0xd0, 0x98, 0x6a, 0xc9, 0x33, 0xaa, 0xdd, 0xf0, 0x1f, 0x15, 0x98, 0x94, 0x5a, 0xb6, 0x4c,
+	0x14, 0x91, 0x5b, 0x7c, 0x95, 0x03, 0x1c, 0xfb, 0xea, 0x20, 0x8e, 0xfd, 0x48, 0x1f, 0xcf, 0xf5,
+	0x3c, 0x2c, 0x28, 0x47, 0xc6, 0xbc, 0x89, 0xb5, 0x51, 0xfd, 0x8c, 0xf8, 0x42, 0x16, 0x01, 0xe7,
+	0xeb, 0xa8, 0xe9, 0x34, 0x63, 0xf7, 0x4f, 0xa7, 0x51, 0x22, 0x04, 0xe3, 0x83, 0x47, 0x08, 0x26,
+	0x0e, 0x8e, 0x10, 0x98, 0xdf, 0x34, 0x00, 0xe5, 0xc3, 0x41, 0x65, 0x46, 0xdc, 0xca, 0x6e, 0xa2,
+	0x03, 0xea, 0xed, 0x6c, 0x4c, 0xa6, 0xff, 0x5e, 0x6a, 0x2e, 0xc2, 0xc2, 0x79, 0x27, 0xbe, 0xd0,
+	0xdb, 0x6e, 0xf4, 0x5c, 0x57, 0x68, 0x68, 0x51, 0xb8, 0x65, 0x69, 0x85, 0xdf, 0x9e, 0x82, 0x99,
+	0x24, 0x28, 0x50, 0xfa, 0x60, 0xed, 0xe6, 0x61, 0xf8, 0x80, 0x45, 0x67, 0x66, 0x4d, 0x38, 0xea,
+	0xb0, 0x38, 0x61, 0x48, 0x9a, 0xbb, 0x4e, 0x70, 0x6d, 0xab, 0xc9, 0xe3, 0xbb, 0xe2, 0xc0, 0xf0,
+	0x71, 0xd1, 0xa2, 0xa3, 0x9b, 0x45, 0x48, 0xb8, 0xb8, 0x2e, 0x0d, 0x8c, 0x84, 0xc4, 0x6a, 0xd5,
+	0x55, 0x89, 0x96, 0xca, 0x0b, 0x4b, 0x08, 0x56, 0xb0, 0xe8, 0xf9, 0xc6, 0xad, 0xd0, 0x89, 0x89,
+	0xa8, 0x94, 0x39, 0xdf, 0xb8, 0x99, 0x82, 0xb0, 0x8a, 0x87, 0xf6, 0x60, 0x2a, 0x48, 0x07, 0x59,
+	0x18, 0x07, 0x03, 0x6a, 0x5b, 0x65, 0x76, 0xe4, 0x51, 0xd9, 0x65, 0x62, 0x77, 0x2c, 0xcf, 0x89,
+	0xba, 0x3c, 0xbe, 0xa4, 0xa0, 0x60, 0x95, 0x11, 0x6a, 0xc3, 0x58, 0x48, 0xbc, 0x96, 0x08, 0x76,
+	0x0d, 0xcc, 0xf2, 0x12, 0x2d, 0xc2, 0xac, 0x62, 0x01, 0x4b, 0x36, 0x41, 0x1c, 0x8a, 0x05, 0x79,
+	0xe4, 0xa9, 0x47, 0x90, 0x3c, 0x4a, 0xb6, 0x36, 0x20, 0xaf, 0xa4, 0x5a, 0x01, 0xa7, 0xfe, 0xc7,
+	0x91, 0xaf, 0x88, 0xe3, 0x48, 0x6e, 0xd3, 0xbe, 0x38, 0x18, 0x2b, 0x1a, 0x74, 0x2a, 0xe0, 0x92,
+	0x39, 0x9a, 0xa4, 0xc2, 0xc6, 0xd7, 0x8d, 0x50, 0x22, 0x49, 0x5e, 0x63, 0x0d, 0xd8, 0x6c, 0x4b,
+	0x61, 0x5b, 0x2f, 0x42, 0xc2, 0xc5, 0x75, 0xd1, 0x17, 0x0d, 0x58, 0x8c, 0x9c, 0xb6, 0xe7, 0x78,
+	0x6d, 0xed, 0xa4, 0x61, 0xea, 0x01, 0x4f, 0x1a, 0x1e, 0xa1, 0x76, 0x5a, 0x33, 0x4f, 0x18, 0x17,
+	0x71, 0xa3, 0x22, 0xcf, 0xf5, 0x1c, 0x4b, 0xcb, 0x99, 0xd6, 0x45, 0x7e, 0x4d, 0x42, 0xb0, 0x82,
+	0x45, 0x45, 0x9e, 0xff, 0x77, 0xb6, 0x6b, 0x39, 0x6e, 0x6d, 0x46, 0x17, 0xf9, 0xb5, 0x14, 0x84,
+	0x55, 0x3c, 0xaa, 0xe4, 0xa3, 0x8e, 0xe5, 0xba, 0xfe, 0xad, 0x75, 0xd7, 0xf7, 0xc8, 0x06, 0x09,
+	0xe2, 0x4e, 0x6d, 0x96, 0x9d, 0x08, 0x48, 0x25, 0xdf, 0xcc, 0x22, 0xe0, 0x7c, 0x1d, 0x74, 0x03,
+	0x8e, 0x45, 0x7e, 0x10, 0x6d, 0x10, 0x3b, 0xdc, 0x0f, 0xe2, 0x3a, 0xd9, 0xf1, 0x43, 0x7a, 0x38,
+	0xeb, 0xee, 0xd7, 0xe6, 0xd8, 0xe2, 0x3f, 0x2e, 0xa8, 0x1d, 0x6b, 0x5e, 0x6d, 0x34, 0xf3, 0x58,
+	0xb8, 0x4f, 0x6d, 0x3e, 0x23, 0x7e, 0x10, 0xad, 0xb5, 0xf5, 0xb3, 0x9f, 0xf9, 0x43, 0x99, 0x91,
+	0xab, 0x8d, 0x66, 0x86, 0x30, 0x2e, 0xe2, 0x86, 0x5e, 0x84, 0x49, 0xd2, 0x72, 0xe2, 0xab, 0x21,
+	0x5d, 0xa4, 0x0b, 0x6c, 0x6c, 0x93, 0x0e, 0x4d, 0x9e, 0x4d, 0x00, 0xf7, 0xd4, 0x7f, 0x70, 0x5a,
+	0xc1, 0xfc, 0xbb, 0x71, 0x98, 0x3b, 0xef, 0x0c, 0x7d, 0x62, 0x18, 0xc3, 0x23, 0x5c, 0x5a, 0x9b,
+	0x44, 0x44, 0x02, 0xa4, 0x25, 0xca, 0x0d, 0x80, 0x17, 0x44, 0xd5, 0x47, 0xd6, 0x8b, 0xd1, 0xee,
+	0xf5, 0x07, 0xe1, 0x7e, 0xa4, 0x07, 0xb6, 0x22, 0x9e, 0x86, 0x09, 0xfe, 0x8b, 0x44, 0xb5, 0xe9,
+	0xf4, 0xa0, 0xb5, 0x2e, 0xca, 0xb0, 0x84, 0x16, 0x9e, 0x6b, 0x8e, 0x94, 0x3e, 0xd7, 0x5c, 0x85,
+	0x49, 0x26, 0x7b, 0xd7, 0xac, 0x76, 0x54, 0x1b, 0xd5, 0xb7, 0xfe, 0xb5, 0x04, 0x80, 0x53, 0x1c,
+	0xb4, 0x02, 0xe0, 0xb4, 0x3d, 0x3f, 0x24, 0xac, 0xc6, 0x18, 0x6b, 0xe2, 0x2c, 0x5d, 0x49, 0x9b,
+	0xb2, 0x14, 0x2b, 0x18, 0xfd, 0x77, 0xb1, 0xf1, 0x07, 0xd8, 0xc5, 0x9e, 0x83, 0x69, 0xc7, 0xb3,
+	0xdd, 0x5e, 0x8b, 0xd0, 0xc4, 0xe7, 0xa8, 0x36, 0xc1, 0x9a, 0x31, 0x4f, 0x73, 0xe4, 0x36, 0x95,
+	0x72, 0xac, 0x61, 0xd1, 0x5a, 0xe4, 0xb6, 0x52, 0x6b, 0x32, 0xad, 0x75, 0xf6, 0xb6, 0x5a, 0x4b,
+	0xc5, 0x2a, 0x38, 0xf9, 0x85, 0x52, 0x27, 0xbf, 0x85, 0x3a, 0x61, 0x6a, 0x08, 0x9d, 0xf0, 0x19,
+	0x38, 0xb6, 0xeb, 0xf9, 0xb7, 0xbc, 0x0b, 0x7e, 0x14, 0x47, 0xeb, 0xbe, 0xb7, 0xe3, 0xb4, 0x2f,
+	0x5b, 0x01, 0x5d, 0xbd, 0x33, 0x6c, 0xf5, 0x3e, 0xad, 0x04, 0x9c, 0x56, 0xe8, 0x95, 0x0f, 0x16,
+	0x5e, 0xf2, 0x6d, 0xcb, 0xe5, 0x11, 0xf1, 0xcc, 0x49, 0xed, 0xa5, 0x42, 0x5a, 0xb8, 0x0f, 0x0f,
+	0xb4, 0x09, 0x8b, 0x51, 0x60, 0x85, 0x11, 0x61, 0xb6, 0xa8, 0xdf, 0x8b, 0xf9, 0x18, 0xce, 0xb2,
+	0x31, 0xe4, 0xcb, 0x3f, 0x0f, 0xc6, 0x45, 0x75, 0xcc, 0x3f, 0xa8, 0xc0, 0xdc, 0x85, 0x6b, 0xd7,
+	0x1a, 0x6a, 0xa6, 0xfb, 0xfd, 0x73, 0x4d, 0xd0, 0x45, 0x40, 0x49, 0xba, 0xba, 0xc8, 0x64, 0xf6,
+	0x5b, 0xdc, 0x6b, 0x18, 0xad, 0x2f, 0x0b, 0x6c, 0x74, 0x36, 0x87, 0x81, 0x0b, 0x6a, 0xd1, 0x09,
+	0x8d, 0x79, 0xf2, 0x62, 0x93, 0xd8, 0xbe, 0xd7, 0x8a, 0x6a, 0x55, 0x7d, 0x42, 0xaf, 0x69, 0x50,
+	0x9c, 0xc1, 0xee, 0x2f, 0xd1, 0x23, 0xc3, 0x4b, 0xb4, 0xf9, 0xa7, 0x15, 0x18, 0xe3, 0xe3, 0x81,
+	0x4e, 0x67, 0x32, 0x9a, 0x1f, 0xcf, 0x65, 0x34, 0x4f, 0x15, 0xa5, 0xd9, 0x9b, 0x30, 0xe6, 0x44,
+	0x51, 0x4f, 0x77, 0xc1, 0x37, 0x59, 0x09, 0x16, 0x10, 0xe4, 0x00, 0x58, 0x49, 0x7a, 0x6b, 0x12,
+	0x62, 0x3a, 0x5d, 0x36, 0x03, 0x3d, 0x93, 0x7d, 0x2e, 0x01, 0x11, 0x56, 0x88, 0xb3, 0xd3, 0x34,
+	0x3a, 0xb3, 0x0f, 0x74, 0x9a, 0x96, 0x10, 0xc0, 0x29, 0x2d, 0xf3, 0xa7, 0x15, 0x98, 0x56, 0x24,
+	0x87, 0x75, 0xaa, 0x13, 0xc7, 0x01, 0xff, 0xaf, 0x66, 0x94, 0xe9, 0x54, 0x46, 0x0a, 0xd3, 0x4e,
+	0x51, 0x00, 0x27, 0x88, 0x15, 0xe2, 0xc8, 0xe3, 0xe3, 0x67, 0xb7, 0xd8, 0xf8, 0x95, 0x3a, 0xe1,
+	0x2e, 0xca, 0xc4, 0xef, 0x3f, 0x88, 0x9c, 0x03, 0xfa, 0x24, 0x4c, 0x06, 0x3e, 0x3f, 0x22, 0x4d,
+	0xa6, 0x6b, 0xc0, 0x0b, 0x03, 0x0d, 0x51, 0x4d, 0xed, 0x9d, 0x54, 0xec, 0x09, 0x30, 0xc2, 0x29,
+	0x79, 0xf3, 0x7f, 0x0d, 0x78, 0x94, 0x1a, 0x84, 0xfc, 0x98, 0x9c, 0x04, 0xd4, 0xc6, 0xf5, 0xec,
+	0x7d, 0xe1, 0x10, 0x31, 0xbf, 0x21, 0xf0, 0x23, 0x87, 0x85, 0xda, 0x8c, 0xac, 0xdf, 0x90, 0x40,
+	0xb0, 0x82, 0x35, 0xc0, 0x61, 0xe5, 0x43, 0x4b, 0x98, 0xa5, 0x1e, 0x2d, 0xed, 0x07, 0xbb, 0x39,
+	0x53, 0xcd, 0x78, 0xb4, 0x09, 0x00, 0xa7, 0x38, 0xe6, 0xb7, 0xa9, 0x4e, 0x7a, 0xb0, 0x9c, 0xdf,
+	0xc3, 0x3d, 0x1f, 0xa5, 0x6a, 0x8a, 0x45, 0x36, 0xa2, 0x73, 0x8e, 0xcb, 0xb6, 0x22, 0x31, 0x8e,
+	0x52, 0x4d, 0xdd, 0xd0, 0xa0, 0x38, 0x83, 0x9d, 0xe4, 0x0c, 0x57, 0x0f, 0xca, 0x19, 0x1e, 0x19,
+	0x22, 0x67, 0xf8, 0x5b, 0xa3, 0x70, 0xac, 0xd8, 0xb1, 0x40, 0xaf, 0x65, 0x52, 0x87, 0x4f, 0x0f,
+	0xee, 0xa6, 0x0c, 0x92, 0x2f, 0xdc, 0x96, 0xb1, 0x6c, 0xbe, 0xfa, 0x3e, 0x3c, 0x38, 0xf9, 0x42,
+	0xc1, 0xee, 0x1b, 0xdf, 0x7e, 0x68, 0xb9, 0xbf, 0xf9, 0x79, 0x1d, 0x29, 0x35, 0xaf, 0x2e, 0xcc,
+	0xf1, 0x92, 0xab, 0x7b, 0x24, 0x0c, 0x9d, 0x16, 0x89, 0x84, 0xe4, 0xbd, 0xaf, 0xaf, 0x7a, 0x15,
+	0x77, 0x28, 0x57, 0xb0, 0x75, 0xeb, 0xec, 0xed, 0x98, 0x78, 0x11, 0x3d, 0xfe, 0x5a, 0xbc, 0x7b,
+	0xe7, 0xc4, 0xdc, 0x0d, 0x9d, 0x12, 0xce, 0x92, 0xa6, 0xd6, 0x4b, 0xaf, 0xbb, 0x1d, 0x12, 0xd7,
+	0xb5, 0xe4, 0xba, 0xc9, 0x5e, 0x6d, 0xb8, 0x9e, 0x45, 0xc0, 0xf9, 0x3a, 0xe8, 0x75, 0x98, 0x4a,
+	0x3b, 0x12, 0xd5, 0xc6, 0xcb, 0xe8, 0x4e, 0x3a, 0x7b, 0xe9, 0xa8, 0x88, 0x89, 0x93, 0xde, 0x58,
+	0x0a, 0x89, 0xb0, 0xca, 0xc3, 0xfc, 0x4f, 0x03, 0x96, 0x8a, 0xaa, 0x52, 0xc5, 0x14, 0xa4, 0x57,
+	0xea, 0xa4, 0x62, 0x62, 0x4d, 0x67, 0x10, 0x14, 0xc2, 0x78, 0x4f, 0x5c, 0x72, 0xe1, 0x72, 0x76,
+	0x7e, 0xf8, 0x96, 0xae, 0xf0, 0x3f, 0xd9, 0xd3, 0x5e, 0x51, 0x8a, 0x13, 0x46, 0xcb, 0x2f, 0xc0,
+	0xb4, 0x8a, 0x59, 0xea, 0x8a, 0xe4, 0x5f, 0x18, 0xc0, 0xd5, 0x52, 0x19, 0x4f, 0x48, 0x4f, 0x92,
+	0xa9, 0x0c, 0x94, 0x24, 0x73, 0x40, 0xfa, 0x52, 0x9a, 0x9f, 0x33, 0x72, 0xbf, 0xfc, 0x1c, 0xf3,
+	0x17, 0x06, 0x2c, 0x15, 0xe5, 0x7c, 0x95, 0x69, 0xfe, 0x33, 0x30, 0x41, 0xc3, 0x0c, 0x3b, 0x7e,
+	0xd8, 0xcd, 0xde, 0xdd, 0x6a, 0x88, 0x72, 0x2c, 0x31, 0x50, 0x48, 0x37, 0x30, 0x61, 0x00, 0x27,
+	0x7b, 0xe9, 0x4b, 0x65, 0x63, 0x8e, 0x7a, 0xb2, 0x92, 0xba, 0x01, 0x26, 0x94, 0xb1, 0xc2, 0xc5,
+	0xdc, 0x80, 0x59, 0x56, 0x83, 0x86, 0xaa, 0xb8, 0x99, 0x7b, 0x0a, 0x80, 0x86, 0xaa, 0xb8, 0x2b,
+	0x9c, 0xdd, 0x46, 0x1b, 0x12, 0x82, 0x15, 0x2c, 0xf3, 0x6f, 0xc7, 0x60, 0x81, 0x91, 0x19, 0xd6,
+	0xe3, 0x1d, 0x66, 0x9e, 0x03, 0x38, 0xc6, 0x34, 0x6e, 0xde, 0x49, 0xe6, 0x53, 0xff, 0x7c, 0x12,
+	0x80, 0xd8, 0x2c, 0xc4, 0xba, 0xd7, 0x17, 0x82, 0xfb, 0xd0, 0xa5, 0x9a, 0xc6, 0xa5, 0xc2, 0x1f,
+	0xd7, 0xf7, 0xeb, 0x3d, 0xc7, 0x6d, 0xb1, 0xdc, 0xb3, 0x69, 0x66, 0x52, 0x4b, 0x4d, 0xb3, 0x95,
+	0x45, 0xc0, 0xf9, 0x3a, 0x6f, 0x97, 0x63, 0xfc, 0x0c, 0x4c, 0xb4, 0x88, 0xb7, 0xcf, 0xf0, 0x41,
+	0x17, 0xc7, 0x0d, 0x51, 0x8e, 0x25, 0x46, 0x69, 0x37, 0x5a, 0x15, 0xf6, 0xf1, 0x03, 0x85, 0xbd,
+	0xaf, 0x8b, 0x32, 0xf1, 0x00, 0x4e, 0xf7, 0x1e, 0x2c, 0xd9, 0x56, 0xbd, 0xe7, 0xb5, 0x5c, 0xa2,
+	0x79, 0x9f, 0x53, 0x25, 0xbd, 0xcf, 0x1a, 0x3d, 0xe5, 0x5b, 0x5f, 0xcb, 0x53, 0xc2, 0x85, 0xf4,
+	0x0b, 0x1c, 0xf0, 0xc9, 0x32, 0x0e, 0xb8, 0x69, 0xc1, 0xd4, 0x45, 0x7f, 0x5b, 0x06, 0x25, 0x31,
+	0x4c, 0xc4, 0xe2, 0xb7, 0x38, 0xa5, 0x7d, 0x52, 0x6d, 0x3a, 0x7b, 0x50, 0x81, 0xb6, 0x5d, 0xa9,
+	0xd3, 0x0c, 0x88, 0x9d, 0x8e, 0x77, 0x52, 0x8a, 0x25, 0x1d, 0xf3, 0x1f, 0x0d, 0x38, 0xa6, 0xc4,
+	0x8f, 0xff, 0x1f, 0x5f, 0x34, 0xba, 0x63, 0xc0, 0xe3, 0xf7, 0x8d, 0x84, 0xa3, 0x56, 0xc6, 0xc0,
+	0x7b, 0xb1, 0x74, 0x78, 0xfd, 0x6d, 0xbd, 0x17, 0xf6, 0xdf, 0x06, 0xd4, 0x2e, 0xf5, 0xb6, 0x49,
+	0xe8, 0x11, 0xba, 0xfb, 0x12, 0xf5, 0xae, 0x29, 0x0b, 0x15, 0x07, 0x8e, 0xb8, 0xd5, 0x90, 0x55,
+	0xcf, 0x6b, 0x8d, 0x4d, 0x01, 0xc1, 0x0a, 0x16, 0x35, 0x26, 0x58, 0xda, 0x4c, 0xc6, 0xcb, 0x51,
+	0x32, 0x64, 0xb4, 0x2c, 0xcf, 0x6a, 0x89, 0x2c, 0xcf, 0x91, 0xfb, 0x65, 0xc4, 0x88, 0x6b, 0xff,
+	0x76, 0x27, 0xab, 0x9d, 0xc4, 0xcb, 0x00, 0x76, 0x07, 0xa7, 0x38, 0xe6, 0x5f, 0x57, 0x61, 0xe9,
+	0x30, 0x2e, 0xc2, 0x1d, 0xb2, 0x9f, 0x96, 0x58, 0x62, 0x95, 0xbe, 0x96, 0x98, 0x26, 0xc1, 0xd5,
+	0x83, 0x25, 0x98, 0xc5, 0xdb, 0xe2, 0xd0, 0x09, 0x30, 0x69, 0x3b, 0x51, 0x1c, 0xee, 0xd3, 0x50,
+	0x56, 0x6d, 0x54, 0xdf, 0x47, 0x9a, 0x59, 0x04, 0x9c, 0xaf, 0x43, 0xf3, 0x4c, 0x16, 0x42, 0x12,
+	0xb8, 0x96, 0x4d, 0xba, 0xc4, 0x13, 0x29, 0x11, 0xe2, 0x4c, 0xe9, 0xe5, 0x92, 0xe7, 0x3c, 0x38,
+	0x4b, 0xa7, 0x7e, 0x94, 0xb6, 0x23, 0x57, 0x8c, 0xf3, 0x1c, 0xcd, 0xdf, 0xa9, 0xc0, 0x63, 0xf7,
+	0x39, 0x30, 0x42, 0xdb, 0x99, 0x05, 0xf9, 0x42, 0xc9, 0xb6, 0xbd, 0x9d, 0xcb, 0x91, 0xee, 0x83,
+	0xb6, 0xdf, 0x0d, 0x7c, 0x8f, 0x78, 0x71, 0x72, 0xa7, 0x9e, 0xed, 0x83, 0xeb, 0xb2, 0x14, 0x2b,
+	0x18, 0xa6, 0x0b, 0xcb, 0xfd, 0x07, 0x95, 0x1f, 0x64, 0x8b, 0xad, 0x23, 0x9b, 0x4f, 0x9d, 0xee,
+	0x29, 0x29, 0xce, 0x01, 0x17, 0x6b, 0xcd, 0x3f, 0x37, 0x60, 0xb1, 0x20, 0x92, 0x52, 0x3e, 0x6f,
+	0xdb, 0xa2, 0xb7, 0xa2, 0xa8, 0xc5, 0xe3, 0x87, 0x72, 0x04, 0x07, 0x4b, 0x0f, 0xa4, 0x6f, 0xae,
+	0x34, 0x45, 0x55, 0xf5, 0x2a, 0x15, 0x2f, 0xc1, 0x92, 0xac, 0xf9, 0x85, 0x0a, 0xcc, 0x37, 0x7c,
+	0xd7, 0x75, 0xbc, 0xf6, 0xa6, 0x17, 0x93, 0x70, 0xcf, 0x72, 0x23, 0x1a, 0x37, 0x6d, 0x3b, 0x71,
+	0xf2, 0x7f, 0x12, 0xef, 0x34, 0xf4, 0xb8, 0xe9, 0xf9, 0x1c, 0x06, 0x2e, 0xa8, 0x45, 0xef, 0x70,
+	0x32, 0x69, 0xc8, 0x52, 0xe3, 0x51, 0x58, 0x79, 0x87, 0x73, 0xb3, 0x00, 0x07, 0x17, 0xd6, 0xa4,
+	0x14, 0x99, 0xb7, 0x9d, 0xa5, 0x58, 0xd5, 0x29, 0xae, 0x17, 0xe0, 0xe0, 0xc2, 0x9a, 0xe6, 0x9f,
+	0x54, 0x60, 0xbc, 0x11, 0xfa, 0xec, 0x7e, 0xc4, 0xc3, 0x4f, 0x2a, 0xbf, 0x0a, 0x23, 0x51, 0x40,
+	0x6c, 0x31, 0xa3, 0x27, 0x07, 0x8c, 0xcc, 0xf1, 0xe6, 0x31, 0x9b, 0x82, 0x9d, 0xc2, 0xd2, 0x5f,
+	0x98, 0x11, 0x52, 0x92, 0x9d, 0x4b, 0xd9, 0x01, 0x09, 0xc9, 0xfb, 0x27, 0x3b, 0xd3, 0x94, 0x55,
+	0x81, 0xf9, 0x8e, 0x4d, 0x59, 0x15, 0xed, 0xeb, 0x93, 0xb2, 0xfa, 0x95, 0xb4, 0x07, 0x74, 0xd0,
+	0xd0, 0x67, 0x61, 0x21, 0x48, 0xf4, 0x61, 0xc3, 0x77, 0x1d, 0xdb, 0x29, 0x1b, 0x76, 0x6a, 0x68,
+	0xd5, 0xf7, 0xd3, 0x1d, 0xa2, 0x91, 0xa5, 0x8b, 0xf3, 0xac, 0x4c, 0x1f, 0x66, 0xb4, 0xa1, 0x47,
+	0xcf, 0x26, 0xaf, 0x07, 0xe9, 0x91, 0x7b, 0xfe, 0x7a, 0xd0, 0xbd, 0x3b, 0x27, 0xa6, 0x05, 0xba,
+	0xfa, 0x9a, 0x50, 0x99, 0xf7, 0x71, 0xbe, 0x55, 0x81, 0x49, 0xd9, 0xb2, 0xb7, 0x40, 0xc0, 0xaf,
+	0x6b, 0x02, 0xfe, 0x6c, 0xc9, 0x31, 0x65, 0x22, 0x2e, 0xf7, 0x74, 0x45, 0xcc, 0x5f, 0xcb, 0x88,
+	0x79, 0xd9, 0xc9, 0x3a, 0x40, 0xd0, 0xbf, 0x67, 0xc0, 0x8c, 0xc4, 0x7d, 0x0b, 0x44, 0xfd, 0x9a,
+	0x2e, 0xea, 0xab, 0x25, 0x7b, 0xd3, 0x47, 0xd8, 0x7f, 0x3e, 0x0e, 0x8b, 0xf9, 0xdd, 0xfe, 0x21,
+	0x06, 0x26, 0x23, 0x98, 0x6d, 0xab, 0x49, 0x50, 0xc9, 0x52, 0x7a, 0x76, 0xe0, 0xf4, 0xe6, 0xb4,
+	0x6e, 0xea, 0x9c, 0x69, 0xc5, 0x11, 0xce, 0xb0, 0x40, 0x9f, 0x86, 0x79, 0x4b, 0x7f, 0x24, 0x27,
+	0x19, 0xc6, 0xb2, 0xe7, 0x52, 0x82, 0xb1, 0xf4, 0xf1, 0x33, 0x80, 0x08, 0xe7, 0x18, 0xa1, 0x1e,
+	0xcc, 0xda, 0xda, 0xbd, 0xf7, 0x72, 0x8f, 0x32, 0x15, 0xdc, 0x99, 0xaf, 0x23, 0xda, 0x67, 0x1d,
+	0x80, 0x33, 0x4c, 0x50, 0x00, 0xb3, 0x8e, 0x16, 0x16, 0xaa, 0x8d, 0x96, 0xc9, 0xe7, 0xd5, 0x43,
+	0x4a, 0x9c, 0xa3, 0x5e, 0x86, 0x33, 0xf4, 0xd1, 0x57, 0x0d, 0x38, 0xb6, 0x53, 0x74, 0x03, 0x8d,
+	0x87, 0x1e, 0x06, 0x7e, 0xa6, 0xa5, 0xf0, 0x16, 0x5b, 0x9a, 0x8c, 0x52, 0x08, 0x8e, 0x70, 0x1f,
+	0xd6, 0xe8, 0xeb, 0x06, 0x3c, 0xba, 0xdb, 0xc7, 0x15, 0x4b, 0x22, 0xc4, 0x2f, 0x0d, 0x6a, 0xcc,
+	0x16, 0x93, 0x91, 0xd7, 0x18, 0x1e, 0xed, 0x87, 0x11, 0xe1, 0xfe, 0x6d, 0x40, 0x1f, 0x85, 0x31,
+	0x9b, 0xbd, 0xd7, 0x20, 0x72, 0xae, 0x06, 0x94, 0xc9, 0xcc, 0x1b, 0x0f, 0x7c, 0xb5, 0xf1, 0x42,
+	0x2c, 0x08, 0x9a, 0x5f, 0x36, 0x60, 0x2e, 0xb3, 0xfb, 0x50, 0x5f, 0x8c, 0xe5, 0x4a, 0x67, 0x7d,
+	0x31, 0x91, 0xe8, 0xca, 0x60, 0xd4, 0x68, 0xb2, 0x7a, 0xb1, 0x2f, 0xeb, 0x9e, 0xf5, 0xac, 0x6d,
+	0x97, 0xb4, 0x84, 0x77, 0x2f, 0x8d, 0xa6, 0xb5, 0x02, 0x1c, 0x5c, 0x58, 0xd3, 0xfc, 0xa7, 0x0a,
+	0x20, 0x59, 0x58, 0xe6, 0x5e, 0xc6, 0x6b, 0x30, 0xbe, 0xc3, 0xd5, 0xca, 0x83, 0xdd, 0xfd, 0xa9,
+	0x4f, 0xa9, 0xd7, 0x9f, 0x12, 0x9a, 0x74, 0xf4, 0x0f, 0x63, 0x9b, 0x80, 0xfc, 0x16, 0x81, 0x5e,
+	0x01, 0xd8, 0x71, 0x3c, 0x27, 0xea, 0x0c, 0x79, 0x3c, 0xcd, 0x5c, 0x94, 0x73, 0x92, 0x02, 0x56,
+	0xa8, 0x99, 0x1f, 0x57, 0x76, 0x1f, 0x66, 0xa6, 0x0c, 0x34, 0xad, 0xef, 0xd6, 0xc7, 0x72, 0x32,
+	0x7f, 0x2d, 0x2c, 0x81, 0x9b, 0xdf, 0x1c, 0x57, 0x44, 0x47, 0x58, 0x1e, 0x17, 0x01, 0xb9, 0x56,
+	0x14, 0x5f, 0xb0, 0x68, 0xf8, 0xac, 0x85, 0xc9, 0x4e, 0x48, 0xa2, 0xe4, 0x64, 0x49, 0x1a, 0xfa,
+	0x5b, 0x39, 0x0c, 0x5c, 0x50, 0x0b, 0x9d, 0xd6, 0xad, 0x98, 0x13, 0x59, 0x2b, 0x66, 0x36, 0x95,
+	0xdb, 0xe1, 0xec, 0x18, 0x64, 0x53, 0xaf, 0xcf, 0x6b, 0x39, 0xfc, 0x39, 0xcb, 0x49, 0xb1, 0x6d,
+	0x0e, 0x34, 0xfc, 0xeb, 0x49, 0xbd, 0xd4, 0x74, 0x91, 0x45, 0xcc, 0x55, 0x4c, 0x7e, 0xa3, 0xd7,
+	0x95, 0x4d, 0xbf, 0x5a, 0x26, 0xd5, 0x3f, 0x33, 0xb6, 0x2b, 0xc9, 0xb3, 0x99, 0xfc, 0x00, 0x47,
+	0x5a, 0x02, 0x49, 0xb1, 0x62, 0x09, 0x28, 0x0b, 0x62, 0xf4, 0x21, 0x2c, 0x88, 0xcf, 0xc0, 0xc2,
+	0x4e, 0xf6, 0x1a, 0x5b, 0x6d, 0xfc, 0xc1, 0x6e, 0xc1, 0xb1, 0x38, 0x44, 0xae, 0x18, 0xe7, 0x19,
+	0x65, 0xd6, 0xcc, 0xd8, 0x61, 0xae, 0x19, 0x76, 0x6e, 0x14, 0xee, 0xe3, 0x9e, 0x27, 0x22, 0xd4,
+	0xe9, 0xb9, 0x11, 0x2b, 0xc5, 0x02, 0x8a, 0x76, 0x61, 0xda, 0x4e, 0x5f, 0x7c, 0xa1, 0x81, 0xf6,
+	0xea, 0xe0, 0x9e, 0x97, 0xf2, 0x56, 0x4c, 0xfa, 0x90, 0x9e, 0x52, 0x18, 0x61, 0x8d, 0xf8, 0xf2,
+	0x19, 0x98, 0xd1, 0xa6, 0xbe, 0xd4, 0x89, 0xdc, 0x4f, 0x0c, 0x48, 0x9d, 0x08, 0x19, 0x7c, 0x7e,
+	0xf8, 0x26, 0xfb, 0x6b, 0x9a, 0xc9, 0x7e, 0xa6, 0xa4, 0xc4, 0x6b, 0x11, 0xef, 0x02, 0xd3, 0xdd,
+	0xfc, 0x17, 0x03, 0x8e, 0xe6, 0xb0, 0xdf, 0x02, 0x1b, 0xfb, 0x55, 0xdd, 0xc6, 0xfe, 0xe0, 0x90,
+	0xfd, 0xea, 0x63, 0x6b, 0x7f, 0xb3, 0xa8, 0x57, 0x4c, 0x77, 0x7f, 0xd9, 0x80, 0xc5, 0x20, 0x6f,
+	0x85, 0xd7, 0x8c, 0x32, 0x86, 0x62, 0x81, 0x19, 0x9f, 0xde, 0x37, 0x2b, 0x00, 0xe2, 0x22, 0x96,
+	0xe6, 0x9f, 0x55, 0xe0, 0xf1, 0xfb, 0xe6, 0xc5, 0xd3, 0xf0, 0x01, 0x6f, 0x4f, 0xb9, 0xab, 0xb1,
+	0xb9, 0x5b, 0x12, 0x7c, 0xcb, 0xe4, 0xc5, 0x58, 0x90, 0x14, 0xc4, 0x5d, 0x6b, 0xbb, 0x56, 0x29,
+	0x49, 0x7c, 0xcb, 0x2a, 0x24, 0xbe, 0x65, 0x71, 0xe2, 0xae, 0xb5, 0x4d, 0x1f, 0x53, 0x69, 0x11,
+	0x97, 0x24, 0x77, 0x07, 0xae, 0x7a, 0x97, 0x49, 0xd8, 0x26, 0x22, 0xde, 0x2b, 0x87, 0x6a, 0x23,
+	0x8f, 0x82, 0x8b, 0xea, 0x99, 0x5f, 0xab, 0xc0, 0x3c, 0xf5, 0x32, 0xb4, 0x13, 0xd3, 0x46, 0xf2,
+	0xe6, 0x49, 0x09, 0x5b, 0x22, 0x93, 0x67, 0x5c, 0x1f, 0xd7, 0x1e, 0x3b, 0xf9, 0x48, 0x12, 0x3b,
+	0x2f, 0x35, 0x22, 0xb9, 0xb3, 0xdc, 0xfa, 0x64, 0x2e, 0xe0, 0xfe, 0x91, 0xe4, 0x69, 0x86, 0x6a,
+	0x19, 0xca, 0xb9, 0x97, 0x94, 0x38, 0x65, 0xf5, 0x3d, 0x07, 0xf3, 0x3a, 0xa0, 0x7c, 0xfe, 0xf6,
+	0x00, 0xb6, 0xde, 0x01, 0x91, 0xd2, 0x3f, 0xae, 0x00, 0xb7, 0x67, 0xde, 0x02, 0x15, 0xf7, 0x5b,
+	0x9a, 0x8a, 0x1b, 0xd0, 0xdd, 0x66, 0x8d, 0xeb, 0x1b, 0x91, 0xc8, 0x9a, 0x9a, 0x27, 0xcb, 0x10,
+	0xbd, 0x7f, 0x34, 0xe2, 0xbb, 0x06, 0x4c, 0x32, 0xbc, 0xb7, 0x40, 0x4b, 0x36, 0x74, 0x2d, 0xf9,
+	0xde, 0x12, 0xbd, 0xe8, 0xa3, 0x19, 0xff, 0x21, 0x69, 0x3d, 0xf6, 0x5d, 0xf2, 0x4e, 0x8d, 0x38,
+	0xc9, 0x06, 0xf6, 0xdd, 0xb6, 0x68, 0x48, 0x48, 0x62, 0xbd, 0x63, 0x43, 0x42, 0xb2, 0x85, 0x7d,
+	0x26, 0xe3, 0x73, 0x4a, 0x27, 0x06, 0xf7, 0x2c, 0x36, 0x61, 0x42, 0x3c, 0x19, 0x94, 0x34, 0xe7,
+	0x31, 0xa5, 0xa7, 0x2b, 0xf4, 0xe1, 0x7f, 0xda, 0x2f, 0xf1, 0xbe, 0x90, 0x72, 0xc6, 0x20, 0x2a,
+	0x61, 0x59, 0xdd, 0xfc, 0xf1, 0xac, 0x90, 0x06, 0xc9, 0xbd, 0x63, 0x85, 0xad, 0xec, 0xfb, 0x31,
+	0x4d, 0x5a, 0x88, 0x39, 0x0c, 0x05, 0x30, 0x13, 0x29, 0x1a, 0x29, 0x2a, 0x77, 0x33, 0x5a, 0x55,
+	0x66, 0x91, 0xf2, 0x5e, 0xb0, 0x5a, 0x8c, 0x75, 0x06, 0xe8, 0x53, 0x30, 0x1f, 0xf2, 0xad, 0x86,
+	0xb4, 0xce, 0x49, 0x6b, 0xbc, 0x5a, 0xfa, 0xc2, 0x74, 0xb2, 0x5f, 0xc9, 0x88, 0x12, 0xce, 0x50,
+	0xc5, 0x39, 0x3e, 0xe8, 0xb7, 0xfb, 0x98, 0x0b, 0x95, 0x07, 0x35, 0x17, 0x1e, 0x29, 0x63, 0x2a,
+	0xa0, 0x0e, 0x4c, 0xab, 0x37, 0xd6, 0x85, 0x52, 0x3b, 0x55, 0xfe, 0x6a, 0x3c, 0xbf, 0x1d, 0xa1,
+	0x96, 0x60, 0x8d, 0xb2, 0x62, 0xb8, 0x8f, 0xdd, 0xd7, 0x70, 0xbf, 0x0c, 0x8b, 0xc2, 0xa3, 0x10,
+	0xd7, 0xe7, 0x79, 0x26, 0xc7, 0xb8, 0xfe, 0x5a, 0xda, 0xb9, 0x3c, 0x0a, 0x2e, 0xaa, 0x47, 0xcf,
+	0x66, 0x97, 0x3c, 0x3f, 0x96, 0xed, 0xb8, 0x49, 0xb6, 0x3b, 0xbe, 0xbf, 0xcb, 0x6f, 0x82, 0x0c,
+	0x2c, 0x5d, 0xa2, 0x16, 0x3f, 0x19, 0x4c, 0x43, 0x27, 0x57, 0x0a, 0x08, 0xe3, 0x42, 0x76, 0xe8,
+	0x55, 0x58, 0xb0, 0x7d, 0xcf, 0xee, 0x85, 0x74, 0x1b, 0xdd, 0xe7, 0x61, 0x1c, 0x96, 0x9e, 0x32,
+	0x59, 0x5f, 0x49, 0x8e, 0x12, 0xd6, 0xb3, 0x08, 0xf7, 0x8a, 0x0a, 0x71, 0x9e, 0x10, 0x0a, 0x60,
+	0x5e, 0xce, 0x6e, 0xf2, 0x86, 0x33, 0x0c, 0xf5, 0xc2, 0x1d, 0x7b, 0x5b, 0xa1, 0x91, 0xa1, 0x85,
+	0x73, 0xd4, 0x69, 0x68, 0xd2, 0xd6, 0x1e, 0xbb, 0x13, 0xd9, 0x3d, 0x03, 0xae, 0x1c, 0xfd, 0xa1,
+	0x3c, 0x11, 0x0c, 0xd5, 0xca, 0x70, 0x86, 0x3e, 0x15, 0x55, 0xe5, 0x8e, 0x73, 0x54, 0x9b, 0x2e,
+	0x23, 0xaa, 0xea, 0x3d, 0x00, 0x2e, 0xaa, 0x6a, 0x09, 0xd6, 0x28, 0xa3, 0x88, 0x8e, 0x66, 0x7a,
+	0x80, 0x7e, 0xc1, 0xf7, 0x77, 0x6b, 0x33, 0x65, 0x76, 0x7b, 0x25, 0x23, 0x28, 0x19, 0x50, 0x9d,
+	0x1c, 0xce, 0x31, 0x40, 0x7b, 0xb0, 0x10, 0xf8, 0x51, 0xac, 0x15, 0xd6, 0x66, 0x87, 0xe5, 0xca,
+	0x9c, 0xf5, 0x46, 0x96, 0x1e, 0xce, 0xb3, 0x60, 0xf9, 0x62, 0x4e, 0xc0, 0xdf, 0xc9, 0x99, 0xcb,
+	0xe4, 0x8b, 0x89, 0x72, 0x2c, 0x31, 0xa8, 0xf9, 0x77, 0xcb, 0xda, 0x23, 0xec, 0x1a, 0xe0, 0x68,
+	0xba, 0x81, 0xde, 0xb4, 0xf6, 0x08, 0x66, 0x10, 0x9a, 0xfc, 0x15, 0x64, 0x1d, 0x24, 0x9a, 0xfc,
+	0xb5, 0x30, 0x4c, 0xf2, 0x57, 0xa3, 0x80, 0x12, 0x2e, 0xa4, 0x8f, 0x3e, 0x0a, 0x8f, 0xe8, 0x31,
+	0xcb, 0xdb, 0x41, 0x48, 0x22, 0x96, 0x9e, 0x83, 0xb4, 0xe8, 0xd4, 0x23, 0x6b, 0xc5, 0x68, 0xb8,
+	0x5f, 0x7d, 0xfa, 0xb9, 0x8c, 0xc0, 0xf1, 0xbc, 0x74, 0x93, 0x58, 0xd4, 0x3f, 0x97, 0xd1, 0x50,
+	0x81, 0x58, 0xc7, 0xa5, 0x59, 0x26, 0xb2, 0xbd, 0x4d, 0xbb, 0x43, 0x5a, 0x3d, 0x97, 0xd4, 0x96,
+	0xf4, 0xbc, 0xe8, 0x46, 0x16, 0x01, 0xe7, 0xeb, 0x98, 0x3f, 0x9a, 0x86, 0x29, 0xc5, 0x8c, 0xec,
+	0x13, 0xc8, 0x9b, 0x1a, 0x2a, 0x90, 0x77, 0x52, 0x0f, 0xe4, 0x3d, 0x96, 0x0d, 0xe4, 0x01, 0x63,
+	0xac, 0x05, 0xf1, 0x22, 0x98, 0xd5, 0xf5, 0xad, 0x78, 0x33, 0x66, 0xe8, 0xf8, 0x12, 0xd3, 0x01,
+	0xba, 0x5e, 0xc7, 0x19, 0x16, 0x88, 0x7e, 0xe0, 0x45, 0x2f, 0x62, 0x8f, 0x3d, 0x45, 0xb5, 0x85,
+	0x32, 0x19, 0x66, 0xc5, 0x2f, 0x46, 0xa5, 0x6a, 0xfd, 0x5c, 0x01, 0x07, 0x5c, 0xc8, 0x97, 0xa6,
+	0x1c, 0x8a, 0xf2, 0x66, 0xaf, 0xdb, 0xa5, 0xf1, 0xff, 0x69, 0x3d, 0x47, 0xff, 0x9c, 0x06, 0xc5,
+	0x19, 0x6c, 0x14, 0xc2, 0x2c, 0x57, 0xe5, 0xf1, 0xb9, 0x43, 0x89, 0x8f, 0x73, 0x45, 0xaa, 0x51,
+	0xc4, 0x19, 0x0e, 0xf4, 0x45, 0x85, 0x8e, 0x98, 0xb2, 0x6a, 0x99, 0x17, 0x15, 0x72, 0xcc, 0x64,
+	0xd8, 0x36, 0x99, 0xae, 0x84, 0x2e, 0x6a, 0xc0, 0x18, 0xd7, 0xa8, 0xe2, 0x38, 0xe4, 0x99, 0x32,
+	0x5a, 0x9a, 0xfb, 0xfd, 0xfc, 0x37, 0x16, 0x74, 0x32, 0x81, 0xe0, 0xb9, 0x87, 0x13, 0x08, 0x56,
+	0x02, 0xd3, 0x93, 0x07, 0x04, 0xa6, 0x2f, 0x02, 0xf2, 0xb7, 0xf9, 0xeb, 0xc4, 0xe7, 0xf9, 0x97,
+	0xa6, 0x1c, 0x9f, 0x9b, 0x36, 0xd5, 0x74, 0xf5, 0x5d, 0xcd, 0x61, 0xe0, 0x82, 0x5a, 0xd4, 0x0e,
+	0x15, 0x53, 0x24, 0x15, 0x41, 0xb9, 0x37, 0x76, 0xf3, 0x67, 0x32, 0x7c, 0xdb, 0x59, 0xcf, 0x50,
+	0xc5, 0x39, 0x3e, 0xe8, 0x75, 0x98, 0xa1, 0xfa, 0x20, 0x65, 0x0c, 0x0f, 0xc8, 0x78, 0x81, 0x6a,
+	0xc4, 0x2d, 0x95, 0x24, 0xd6, 0x39, 0xa0, 0xaf, 0xf4, 0x33, 0xc9, 0x66, 0xca, 0x9c, 0x30, 0x8a,
+	0x5a, 0x1b, 0xc4, 0x75, 0x68, 0x06, 0xaf, 0xf0, 0xad, 0x87, 0x31, 0xcd, 0xf6, 0x72, 0xa6, 0xcc,
+	0x6c, 0x99, 0xcf, 0x80, 0x14, 0xbd, 0xf9, 0x3b, 0x90, 0x41, 0xf3, 0x59, 0x38, 0xe6, 0x91, 0xdb,
+	0x71, 0xa2, 0xe0, 0x5b, 0xe9, 0x1c, 0xcc, 0x97, 0x0e, 0x99, 0xb3, 0x8b, 0xba, 0x57, 0x0a, 0xa9,
+	0xe1, 0x3e, 0x5c, 0xcc, 0xd3, 0xb0, 0xc0, 0xf7, 0x13, 0x35, 0xf6, 0x75, 0xf0, 0x47, 0xa9, 0xfe,
+	0xc7, 0x80, 0xa3, 0x6a, 0x15, 0x9a, 0x4a, 0x46, 0xdb, 0x10, 0xa1, 0xb3, 0x6a, 0xdc, 0xac, 0x4c,
+	0xeb, 0xf5, 0x60, 0xd9, 0x25, 0x3d, 0x58, 0x56, 0x86, 0x50, 0x3e, 0x3e, 0x76, 0x49, 0x8f, 0x8f,
+	0x95, 0x26, 0xa6, 0x85, 0xc4, 0xbe, 0x43, 0x83, 0x03, 0x9a, 0x0b, 0xa9, 0x3d, 0x38, 0x67, 0x0c,
+	0xf0, 0xe0, 0xdc, 0x2d, 0x98, 0xed, 0x05, 0x51, 0x1c, 0x12, 0xab, 0xdb, 0x8c, 0x95, 0xe7, 0x8f,
+	0x3f, 0x58, 0x26, 0x8e, 0xa4, 0x06, 0xee, 0xe4, 0x4e, 0x73, 0x5d, 0x23, 0x8b, 0x33, 0x6c, 0xcc,
+	0x5f, 0x55, 0x40, 0x73, 0xcf, 0x68, 0xc0, 0x7a, 0xc1, 0xca, 0x7c, 0x9c, 0x2c, 0xc9, 0xe4, 0xf8,
+	0x70, 0xb9, 0x2f, 0xc6, 0xe5, 0xbe, 0x6d, 0xa6, 0x7c, 0xcd, 0x26, 0xcb, 0x01, 0xe7, 0x99, 0x32,
+	0x67, 0xd8, 0xca, 0x7f, 0x7d, 0xae, 0x9c, 0x33, 0x5c, 0xf0, 0xf9, 0x3a, 0xee, 0x0c, 0x17, 0x00,
+	0x70, 0x11, 0x3b, 0xf4, 0x31, 0x18, 0xb1, 0xc2, 0x76, 0xc9, 0xfb, 0xb3, 0x05, 0x1f, 0x15, 0x4c,
+	0x97, 0xcd, 0x5a, 0xd8, 0x8e, 0x30, 0x23, 0x6a, 0xfe, 0xbc, 0x0a, 0xb9, 0x37, 0xeb, 0xc4, 0x73,
+	0x52, 0x23, 0x85, 0xcf, 0x49, 0xd1, 0x87, 0x68, 0x59, 0x1a, 0x68, 0xf6, 0x21, 0x5a, 0x5a, 0x88,
+	0x39, 0x8c, 0x5e, 0x9e, 0x8e, 0x62, 0x2b, 0x8c, 0xa9, 0xc0, 0xd6, 0x46, 0x4b, 0x8b, 0x38, 0xbb,
+	0x3c, 0xdd, 0x4c, 0x08, 0xe0, 0x94, 0x16, 0x7a, 0x5e, 0xb7, 0x08, 0xcd, 0xac, 0x45, 0xb8, 0xa0,
+	0xf6, 0x65, 0xd8, 0xd3, 0xdd, 0x2e, 0xfd, 0x5a, 0xa1, 0x1c, 0xbe, 0x5a, 0xb5, 0x8c, 0xda, 0x2d,
+	0xfa, 0xce, 0x1f, 0x7f, 0xef, 0x47, 0x85, 0xa8, 0xf4, 0xd3, 0x73, 0x49, 0x36, 0x5a, 0x0f, 0x74,
+	0x2e, 0xc9, 0x86, 0x4b, 0xa1, 0x46, 0x3f, 0xd5, 0xa7, 0x3d, 0x71, 0xc6, 0x32, 0xf0, 0xa4, 0x06,
+	0x78, 0xa7, 0xc6, 0x43, 0x65, 0x03, 0x0f, 0x3b, 0x03, 0x2f, 0x25, 0x7c, 0x70, 0x06, 0x9e, 0xc4,
+	0x7d, 0xc7, 0x86, 0x5b, 0x65, 0x0b, 0xfb, 0x84, 0x5b, 0x7f, 0x3a, 0xa2, 0xf4, 0x42, 0x8f, 0x78,
+	0x56, 0xee, 0x13, 0xf1, 0x7c, 0x15, 0x26, 0x1c, 0x91, 0x96, 0x5c, 0x1b, 0x29, 0xd3, 0xd5, 0xfc,
+	0xf7, 0x08, 0x92, 0xf4, 0x66, 0x2c, 0x29, 0xd2, 0x77, 0x37, 0x83, 0x4c, 0x96, 0x77, 0xb9, 0x5c,
+	0x83, 0x6c, 0x8e, 0xb8, 0x08, 0x65, 0x64, 0x4a, 0x71, 0x8e, 0x0b, 0x72, 0xe1, 0x68, 0x92, 0x14,
+	0x10, 0x12, 0x2b, 0x4d, 0x5b, 0x12, 0x57, 0x5a, 0x3e, 0x90, 0x5c, 0x2a, 0x3b, 0x57, 0x84, 0x74,
+	0xaf, 0x1f, 0x00, 0x17, 0x13, 0x45, 0x2d, 0x19, 0x30, 0x3c, 0xfb, 0x7a, 0xcf, 0x72, 0x9d, 0x78,
+	0xff, 0xb2, 0xdf, 0xe2, 0xcb, 0x7b, 0xb2, 0x7e, 0x2a, 0x13, 0x30, 0x54, 0x51, 0xee, 0x15, 0x17,
+	0xe3, 0x22, 0x72, 0x28, 0xca, 0x47, 0xa7, 0x4b, 0xb8, 0x4e, 0xd9, 0x23, 0xc6, 0xc1, 0x02, 0xd4,
+	0xe6, 0x97, 0x46, 0x60, 0x2e, 0xb3, 0x92, 0xfa, 0xb8, 0xfd, 0x63, 0x43, 0xb9, 0xfd, 0x8a, 0xaa,
+	0xae, 0x0e, 0xe5, 0xef, 0x8c, 0x0c, 0xe5, 0xef, 0x9c, 0xe1, 0x3e, 0x87, 0x18, 0xfb, 0xcd, 0x0d,
+	0xf1, 0x92, 0xa0, 0x1c, 0x93, 0x2d, 0x15, 0x88, 0x75, 0x5c, 0x66, 0x2b, 0xb4, 0xf2, 0x1f, 0xaa,
+	0x10, 0x0e, 0xd3, 0x87, 0xca, 0x5e, 0xd4, 0x95, 0x04, 0xb8, 0xad, 0x50, 0x00, 0xc0, 0x45, 0xec,
+	0xd0, 0x2e, 0x00, 0xf3, 0x6a, 0x68, 0x0c, 0xa1, 0x25, 0x1e, 0xf4, 0x3b, 0x53, 0xfe, 0xa8, 0x42,
+	0x1a, 0xcf, 0x7c, 0x73, 0xd9, 0x92, 0x24, 0xb1, 0x42, 0xde, 0xfc, 0x4e, 0x05, 0x66, 0xb4, 0x10,
+	0xf4, 0x41, 0xaf, 0xe0, 0x3c, 0x05, 0x63, 0x5d, 0x12, 0x77, 0xfc, 0x56, 0xf6, 0xeb, 0x07, 0x97,
+	0x59, 0x29, 0x16, 0x50, 0xb4, 0x0b, 0xe3, 0x1d, 0x62, 0xb5, 0x48, 0x98, 0x18, 0x3d, 0x2f, 0x0f,
+	0x11, 0x0f, 0x5f, 0xb9, 0xc0, 0x49, 0x64, 0xae, 0xad, 0x8b, 0x52, 0x9c, 0x70, 0xa0, 0x1f, 0xaa,
+	0xdc, 0xf6, 0x5b, 0xfb, 0xf2, 0xc1, 0xb8, 0x11, 0xfd, 0x43, 0x95, 0x75, 0x05, 0x86, 0x35, 0x4c,
+	0x7a, 0xe1, 0x5d, 0xe5, 0x51, 0x2a, 0xbd, 0xe6, 0x5f, 0x2b, 0x70, 0xb4, 0xd0, 0x57, 0x3c, 0x68,
+	0x0c, 0x57, 0x61, 0x52, 0x06, 0xe1, 0xb2, 0x9f, 0x36, 0x4d, 0x9d, 0xab, 0x14, 0x87, 0x7e, 0x0d,
+	0xa3, 0xc5, 0x39, 0xb0, 0xbc, 0xa7, 0xea, 0x70, 0x5f, 0xc3, 0xd8, 0x48, 0x49, 0x60, 0x95, 0x1e,
+	0xbd, 0x72, 0x18, 0xa5, 0x2f, 0x1a, 0xf1, 0x8f, 0x0a, 0xa5, 0x5f, 0x76, 0x95, 0x10, 0xac, 0x60,
+	0xd1, 0x3e, 0x44, 0x3d, 0xdb, 0x26, 0xa4, 0x45, 0x5a, 0xe2, 0x6a, 0x9b, 0xec, 0x43, 0x33, 0x01,
+	0xe0, 0x14, 0xa7, 0xc4, 0x9b, 0xa1, 0xf5, 0x8b, 0xdf, 0x7f, 0xf3, 0xf8, 0x91, 0x1f, 0xbf, 0x79,
+	0xfc, 0xc8, 0xcf, 0xde, 0x3c, 0x7e, 0xe4, 0xf3, 0x77, 0x8f, 0x1b, 0xdf, 0xbf, 0x7b, 0xdc, 0xf8,
+	0xf1, 0xdd, 0xe3, 0xc6, 0xcf, 0xee, 0x1e, 0x37, 0xfe, 0xed, 0xee, 0x71, 0xe3, 0xf7, 0x7f, 0x71,
+	0xfc, 0xc8, 0x2b, 0x4f, 0x0e, 0xf2, 0x35, 0xf4, 0xff, 0x1b, 0x00, 0xa9, 0xce, 0x58, 0xae, 0x34,
+	0x7d, 0x00, 0x00,
 }
 
 func (m *AnalysisRunArgument) Marshal() (dAtA []byte, err error) {
@@ -2563,6 +3546,39 @@ func (m *ApprovedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArgoCDAppHealthCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArgoCDAppHealthCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArgoCDAppHealthCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ExpectedValue)
+	copy(dAtA[i:], m.ExpectedValue)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ExpectedValue)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.JSONPath)
+	copy(dAtA[i:], m.JSONPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPath)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ArgoCDAppHealthStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2649,6 +3665,44 @@ func (m *ArgoCDAppStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArgoCDAppStatusCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArgoCDAppStatusCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArgoCDAppStatusCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.RequiredSyncStatus)
+	copy(dAtA[i:], m.RequiredSyncStatus)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RequiredSyncStatus)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ArgoCDAppSyncStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2711,6 +3765,45 @@ func (m *ArgoCDAppUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SyncTimeout != nil {
+		{
+			size, err := m.SyncTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.SourceIndex != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SourceIndex))
+		i--
+		dAtA[i] = 0x40
+	}
+	i -= len(m.ResourceType)
+	copy(dAtA[i:], m.ResourceType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ResourceType)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.ArgoCDClusterName)
+	copy(dAtA[i:], m.ArgoCDClusterName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ArgoCDClusterName)))
+	i--
+	dAtA[i] = 0x32
+	if m.HealthCheck != nil {
+		{
+			size, err := m.HealthCheck.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Origin != nil {
 		{
 			size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
@@ -3023,7 +4116,7 @@ func (m *ArgoCDSourceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Chart) Marshal() (dAtA []byte, err error) {
+func (m *CanaryPromotion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3033,35 +4126,33 @@ func (m *Chart) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Chart) MarshalTo(dAtA []byte) (int, error) {
+func (m *CanaryPromotion) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Chart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CanaryPromotion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Version)
-	copy(dAtA[i:], m.Version)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Weight))
 	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	dAtA[i] = 0x18
+	i -= len(m.Rollout)
+	copy(dAtA[i:], m.Rollout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Rollout)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.RepoURL)
-	copy(dAtA[i:], m.RepoURL)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ChartDiscoveryResult) Marshal() (dAtA []byte, err error) {
+func (m *ChangeApprovalCheck) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3071,37 +4162,151 @@ func (m *ChartDiscoveryResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChartDiscoveryResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangeApprovalCheck) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChartDiscoveryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChangeApprovalCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Versions) > 0 {
-		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Versions[iNdEx])
-			copy(dAtA[i:], m.Versions[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Versions[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	i -= len(m.SemverConstraint)
-	copy(dAtA[i:], m.SemverConstraint)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SemverConstraint)))
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
 	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.RepoURL)
-	copy(dAtA[i:], m.RepoURL)
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Chart) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Chart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Chart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChartChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChartChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChartChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Description)
+	copy(dAtA[i:], m.Description)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Description)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChartDiscoveryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChartDiscoveryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChartDiscoveryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.ServedBy)
+	copy(dAtA[i:], m.ServedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ServedBy)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Versions[iNdEx])
+			copy(dAtA[i:], m.Versions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Versions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.SemverConstraint)
+	copy(dAtA[i:], m.SemverConstraint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SemverConstraint)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
 	i--
 	dAtA[i] = 0xa
@@ -3128,6 +4333,35 @@ func (m *ChartSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProvenanceKeySecretRef != nil {
+		{
+			size, err := m.ProvenanceKeySecretRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	i--
+	if m.VerifyProvenance {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	if len(m.FallbackRepoURLs) > 0 {
+		for iNdEx := len(m.FallbackRepoURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FallbackRepoURLs[iNdEx])
+			copy(dAtA[i:], m.FallbackRepoURLs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.FallbackRepoURLs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	i = encodeVarintGenerated(dAtA, i, uint64(m.DiscoveryLimit))
 	i--
 	dAtA[i] = 0x20
@@ -3149,6 +4383,80 @@ func (m *ChartSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Cooldown.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Threshold))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OpenedAt != nil {
+		{
+			size, err := m.OpenedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ConsecutiveFailures))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *DiscoveredArtifacts) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3329,7 +4637,7 @@ func (m *DiscoveredImageReference) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *Freight) Marshal() (dAtA []byte, err error) {
+func (m *FluxHelmReleaseUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3339,47 +4647,102 @@ func (m *Freight) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Freight) MarshalTo(dAtA []byte) (int, error) {
+func (m *FluxHelmReleaseUpdate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Freight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FluxHelmReleaseUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Origin != nil {
+		{
+			size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x2a
 	}
+	i -= len(m.Chart)
+	copy(dAtA[i:], m.Chart)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Chart)))
 	i--
-	dAtA[i] = 0x4a
-	i -= len(m.Warehouse)
-	copy(dAtA[i:], m.Warehouse)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Warehouse)))
-	i--
-	dAtA[i] = 0x42
-	i -= len(m.Alias)
-	copy(dAtA[i:], m.Alias)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Alias)))
+	dAtA[i] = 0x22
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
 	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
-	dAtA[i] = 0x32
-	if len(m.Charts) > 0 {
+	dAtA[i] = 0x12
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Freight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Freight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Freight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.Warehouse)
+	copy(dAtA[i:], m.Warehouse)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Warehouse)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Alias)
+	copy(dAtA[i:], m.Alias)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Alias)))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Charts) > 0 {
 		for iNdEx := len(m.Charts) - 1; iNdEx >= 0; iNdEx-- {
 			{
 				size, err := m.Charts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
@@ -3505,6 +4868,46 @@ func (m *FreightCollection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FreightCollectionDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreightCollectionDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreightCollectionDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Patch)
+	copy(dAtA[i:], m.Patch)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Patch)))
+	i--
+	dAtA[i] = 0x12
+	if m.Baseline != nil {
+		{
+			size, err := m.Baseline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FreightList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3759,6 +5162,11 @@ func (m *FreightSources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.AvailabilityStrategy)
+	copy(dAtA[i:], m.AvailabilityStrategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AvailabilityStrategy)))
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Stages) > 0 {
 		for iNdEx := len(m.Stages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Stages[iNdEx])
@@ -4031,6 +5439,65 @@ func (m *GitRepoUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.EditOrder)
+	copy(dAtA[i:], m.EditOrder)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EditOrder)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.SOPSAgeKeySecretRef != nil {
+		{
+			size, err := m.SOPSAgeKeySecretRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	i--
+	if m.SOPSDecryptBeforeApply {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x78
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ShallowCloneDepth))
+	i--
+	dAtA[i] = 0x70
+	i -= len(m.AuthorEmail)
+	copy(dAtA[i:], m.AuthorEmail)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AuthorEmail)))
+	i--
+	dAtA[i] = 0x6a
+	i -= len(m.AuthorName)
+	copy(dAtA[i:], m.AuthorName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AuthorName)))
+	i--
+	dAtA[i] = 0x62
+	if m.SigningKeySecretRef != nil {
+		{
+			size, err := m.SigningKeySecretRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	i -= len(m.CommitMessageTemplate)
+	copy(dAtA[i:], m.CommitMessageTemplate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CommitMessageTemplate)))
+	i--
+	dAtA[i] = 0x52
 	if m.Origin != nil {
 		{
 			size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
@@ -4137,6 +5604,39 @@ func (m *GitSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SparseCheckoutPaths) > 0 {
+		for iNdEx := len(m.SparseCheckoutPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SparseCheckoutPaths[iNdEx])
+			copy(dAtA[i:], m.SparseCheckoutPaths[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.SparseCheckoutPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.KnownHostsConfigMapRef != nil {
+		{
+			size, err := m.KnownHostsConfigMapRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Branches) > 0 {
+		for iNdEx := len(m.Branches) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Branches[iNdEx])
+			copy(dAtA[i:], m.Branches[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Branches[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ShallowCloneDepth))
+	i--
+	dAtA[i] = 0x58
 	i = encodeVarintGenerated(dAtA, i, uint64(m.DiscoveryLimit))
 	i--
 	dAtA[i] = 0x50
@@ -4203,6 +5703,48 @@ func (m *GitSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPHealthCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPHealthCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPHealthCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.InsecureSkipTLSVerify {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TimeoutSeconds))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ExpectedStatusCode))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Health) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4223,6 +5765,18 @@ func (m *Health) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CheckedAt != nil {
+		{
+			size, err := m.CheckedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.ArgoCDApps) > 0 {
 		for iNdEx := len(m.ArgoCDApps) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4254,6 +5808,71 @@ func (m *Health) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HealthChecks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthChecks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthChecks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PodImages) > 0 {
+		for iNdEx := len(m.PodImages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PodImages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ArgoCDApps) > 0 {
+		for iNdEx := len(m.ArgoCDApps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArgoCDApps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.HTTPChecks) > 0 {
+		for iNdEx := len(m.HTTPChecks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HTTPChecks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HelmChartDependencyUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4379,20 +5998,56 @@ func (m *HelmPromotionMechanism) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
-	if m.Origin != nil {
-		{
-			size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Charts) > 0 {
-		for iNdEx := len(m.Charts) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ValuesFiles) > 0 {
+		for iNdEx := len(m.ValuesFiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValuesFiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	i -= len(m.UmbrellaChartPath)
+	copy(dAtA[i:], m.UmbrellaChartPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.UmbrellaChartPath)))
+	i--
+	dAtA[i] = 0x32
+	if m.ValuesOverrides != nil {
+		{
+			size, err := m.ValuesOverrides.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.ValuesFilePath)
+	copy(dAtA[i:], m.ValuesFilePath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ValuesFilePath)))
+	i--
+	dAtA[i] = 0x22
+	if m.Origin != nil {
+		{
+			size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Charts) > 0 {
+		for iNdEx := len(m.Charts) - 1; iNdEx >= 0; iNdEx-- {
 			{
 				size, err := m.Charts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
@@ -4422,6 +6077,58 @@ func (m *HelmPromotionMechanism) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *HelmValuesFileUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmValuesFileUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmValuesFileUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		keysForUpdates := make([]string, 0, len(m.Updates))
+		for k := range m.Updates {
+			keysForUpdates = append(keysForUpdates, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUpdates)
+		for iNdEx := len(keysForUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Updates[string(keysForUpdates[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUpdates[iNdEx])
+			copy(dAtA[i:], keysForUpdates[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUpdates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Image) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4512,6 +6219,34 @@ func (m *ImageDiscoveryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ImagePullCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImagePullCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ImagePullCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.PullSecret)
+	copy(dAtA[i:], m.PullSecret)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PullSecret)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ImageSubscription) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4532,6 +6267,31 @@ func (m *ImageSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.LatestByBuildDate {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x60
+	if m.CABundleConfigMapRef != nil {
+		{
+			size, err := m.CABundleConfigMapRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	i -= len(m.DenyTags)
+	copy(dAtA[i:], m.DenyTags)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DenyTags)))
+	i--
+	dAtA[i] = 0x52
 	i = encodeVarintGenerated(dAtA, i, uint64(m.DiscoveryLimit))
 	i--
 	dAtA[i] = 0x48
@@ -4585,6 +6345,39 @@ func (m *ImageSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JobTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *KargoRenderImageUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4682,6 +6475,54 @@ func (m *KargoRenderPromotionMechanism) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *KubernetesResourceUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KubernetesResourceUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KubernetesResourceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.JSONPatch)
+	copy(dAtA[i:], m.JSONPatch)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPatch)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.APIVersion)
+	copy(dAtA[i:], m.APIVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.APIVersion)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *KustomizeImageUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4702,6 +6543,26 @@ func (m *KustomizeImageUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReplacementSource != nil {
+		{
+			size, err := m.ReplacementSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	i--
+	if m.StripRegistryHost {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
 	if m.Origin != nil {
 		{
 			size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
@@ -4755,6 +6616,15 @@ func (m *KustomizePromotionMechanism) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.Components) > 0 {
+		for iNdEx := len(m.Components) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Components[iNdEx])
+			copy(dAtA[i:], m.Components[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Components[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Origin != nil {
 		{
 			size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
@@ -4784,7 +6654,7 @@ func (m *KustomizePromotionMechanism) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *Project) Marshal() (dAtA []byte, err error) {
+func (m *KustomizeReplacementSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4794,52 +6664,30 @@ func (m *Project) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Project) MarshalTo(dAtA []byte) (int, error) {
+func (m *KustomizeReplacementSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KustomizeReplacementSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
 	i--
-	dAtA[i] = 0x1a
-	if m.Spec != nil {
-		{
-			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	dAtA[i] = 0x12
+	i -= len(m.ConfigMap)
+	copy(dAtA[i:], m.ConfigMap)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConfigMap)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ProjectList) Marshal() (dAtA []byte, err error) {
+func (m *PodImageHealthCheck) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4849,27 +6697,152 @@ func (m *ProjectList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProjectList) MarshalTo(dAtA []byte) (int, error) {
+func (m *PodImageHealthCheck) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProjectList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PodImageHealthCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
+	{
+		size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PollingIntervals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PollingIntervals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollingIntervals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ChartIntervalSeconds))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ImageIntervalSeconds))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.GitIntervalSeconds))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Project) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Project) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0x12
 		}
 	}
@@ -5076,6 +7049,70 @@ func (m *PromotionMechanisms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Canary != nil {
+		{
+			size, err := m.Canary.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.KubernetesResourceUpdates) > 0 {
+		for iNdEx := len(m.KubernetesResourceUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KubernetesResourceUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FluxHelmReleaseUpdates) > 0 {
+		for iNdEx := len(m.FluxHelmReleaseUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FluxHelmReleaseUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ImagePullCheck != nil {
+		{
+			size, err := m.ImagePullCheck.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ChangeApproval != nil {
+		{
+			size, err := m.ChangeApproval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Origin != nil {
 		{
 			size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
@@ -5272,6 +7309,42 @@ func (m *PromotionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChartChanges) > 0 {
+		for iNdEx := len(m.ChartChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChartChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	i--
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
 	if m.FreightCollection != nil {
 		{
 			size, err := m.FreightCollection.MarshalToSizedBuffer(dAtA[:i])
@@ -5350,7 +7423,7 @@ func (m *PromotionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PullRequestPromotionMechanism) Marshal() (dAtA []byte, err error) {
+func (m *PromotionTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5360,44 +7433,40 @@ func (m *PullRequestPromotionMechanism) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PullRequestPromotionMechanism) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PullRequestPromotionMechanism) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GitLab != nil {
-		{
-			size, err := m.GitLab.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.GitHub != nil {
-		{
-			size, err := m.GitHub.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RepoSubscription) Marshal() (dAtA []byte, err error) {
+func (m *PromotionTemplateList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5407,21 +7476,156 @@ func (m *RepoSubscription) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepoSubscription) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionTemplateList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RepoSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Chart != nil {
-		{
-			size, err := m.Chart.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PromotionTemplateSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PromotionTemplateSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromotionTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PromotionMechanisms.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PullRequestPromotionMechanism) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullRequestPromotionMechanism) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullRequestPromotionMechanism) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.DeleteBranchOnMerge {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	if m.GitLab != nil {
+		{
+			size, err := m.GitLab.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GitHub != nil {
+		{
+			size, err := m.GitHub.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepoSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepoSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Chart != nil {
+		{
+			size, err := m.Chart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
 			i -= size
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
@@ -5456,6 +7660,39 @@ func (m *RepoSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SecretKeyReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretKeyReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretKeyReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Stage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5556,7 +7793,7 @@ func (m *StageList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *StageSpec) Marshal() (dAtA []byte, err error) {
+func (m *StageRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5566,20 +7803,63 @@ func (m *StageSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StageSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *StageRole) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StageSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StageRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RequestedFreight) > 0 {
-		for iNdEx := len(m.RequestedFreight) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StageRoleList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StageRoleList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StageRoleList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RequestedFreight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5587,40 +7867,11 @@ func (m *StageSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	i -= len(m.Shard)
-	copy(dAtA[i:], m.Shard)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shard)))
-	i--
-	dAtA[i] = 0x22
-	if m.Verification != nil {
-		{
-			size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PromotionMechanisms != nil {
-		{
-			size, err := m.PromotionMechanisms.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x12
 	}
 	{
-		size, err := m.Subscriptions.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -5632,7 +7883,7 @@ func (m *StageSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *StageStatus) Marshal() (dAtA []byte, err error) {
+func (m *StageRoleSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5642,29 +7893,82 @@ func (m *StageStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StageStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *StageRoleSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StageRoleSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.FreightSummary)
-	copy(dAtA[i:], m.FreightSummary)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightSummary)))
-	i--
-	dAtA[i] = 0x62
-	i -= len(m.LastHandledRefresh)
-	copy(dAtA[i:], m.LastHandledRefresh)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
+	if len(m.Subjects) > 0 {
+		for iNdEx := len(m.Subjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Stage)
+	copy(dAtA[i:], m.Stage)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Stage)))
 	i--
-	dAtA[i] = 0x5a
-	if m.LastPromotion != nil {
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StageSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StageSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StageSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.PromotionSchedule)
+	copy(dAtA[i:], m.PromotionSchedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PromotionSchedule)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	i -= len(m.PinnedFreight)
+	copy(dAtA[i:], m.PinnedFreight)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PinnedFreight)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	i -= len(m.AutoPromotionExpression)
+	copy(dAtA[i:], m.AutoPromotionExpression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AutoPromotionExpression)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.PromotionTemplateRef != nil {
 		{
-			size, err := m.LastPromotion.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PromotionTemplateRef.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5672,16 +7976,23 @@ func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Wave))
 	i--
-	dAtA[i] = 0x4a
-	if m.Health != nil {
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x80
+	i -= len(m.Pipeline)
+	copy(dAtA[i:], m.Pipeline)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Pipeline)))
+	i--
+	dAtA[i] = 0x7a
+	if m.PostPromotionHook != nil {
 		{
-			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PostPromotionHook.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5689,11 +8000,11 @@ func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x72
 	}
-	if m.CurrentPromotion != nil {
+	if m.PrePromotionHook != nil {
 		{
-			size, err := m.CurrentPromotion.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PrePromotionHook.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5701,15 +8012,53 @@ func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x6a
 	}
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	if m.HealthChecks != nil {
+		{
+			size, err := m.HealthChecks.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.CircuitBreaker != nil {
+		{
+			size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.PromotionTimeout != nil {
+		{
+			size, err := m.PromotionTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	i -= len(m.ConcurrencyPolicy)
+	copy(dAtA[i:], m.ConcurrencyPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConcurrencyPolicy)))
 	i--
-	dAtA[i] = 0x30
-	if len(m.FreightHistory) > 0 {
-		for iNdEx := len(m.FreightHistory) - 1; iNdEx >= 0; iNdEx-- {
+	dAtA[i] = 0x4a
+	if len(m.NotificationWebhooks) > 0 {
+		for iNdEx := len(m.NotificationWebhooks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FreightHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.NotificationWebhooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5717,13 +8066,24 @@ func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x42
 		}
 	}
-	if len(m.History) > 0 {
-		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+	i = encodeVarintGenerated(dAtA, i, uint64(m.FreightHistoryLimit))
+	i--
+	dAtA[i] = 0x38
+	i--
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	if len(m.RequestedFreight) > 0 {
+		for iNdEx := len(m.RequestedFreight) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RequestedFreight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5731,12 +8091,29 @@ func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x2a
 		}
 	}
-	if m.CurrentFreight != nil {
+	i -= len(m.Shard)
+	copy(dAtA[i:], m.Shard)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shard)))
+	i--
+	dAtA[i] = 0x22
+	if m.Verification != nil {
 		{
-			size, err := m.CurrentFreight.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PromotionMechanisms != nil {
+		{
+			size, err := m.PromotionMechanisms.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5746,43 +8123,20 @@ func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *StageSubscription) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	{
+		size, err := m.Subscriptions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *StageSubscription) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *StageSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Subscriptions) Marshal() (dAtA []byte, err error) {
+func (m *StageStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5792,20 +8146,20 @@ func (m *Subscriptions) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Subscriptions) MarshalTo(dAtA []byte) (int, error) {
+func (m *StageStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Subscriptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UpstreamStages) > 0 {
-		for iNdEx := len(m.UpstreamStages) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.FreightHistoryDeltas) > 0 {
+		for iNdEx := len(m.FreightHistoryDeltas) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UpstreamStages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.FreightHistoryDeltas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5813,41 +8167,29 @@ func (m *Subscriptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
 		}
 	}
-	i -= len(m.Warehouse)
-	copy(dAtA[i:], m.Warehouse)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Warehouse)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Verification) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.NextScheduledPromotion != nil {
+		{
+			size, err := m.NextScheduledPromotion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Verification) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5855,12 +8197,12 @@ func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x7a
 		}
 	}
-	if m.AnalysisRunMetadata != nil {
+	if m.CircuitBreaker != nil {
 		{
-			size, err := m.AnalysisRunMetadata.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5868,12 +8210,12 @@ func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x72
 	}
-	if len(m.AnalysisTemplates) > 0 {
-		for iNdEx := len(m.AnalysisTemplates) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.NotificationWebhooks) > 0 {
+		for iNdEx := len(m.NotificationWebhooks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AnalysisTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.NotificationWebhooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5881,40 +8223,22 @@ func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x6a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *VerificationInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *VerificationInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Actor)
-	copy(dAtA[i:], m.Actor)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Actor)))
+	i -= len(m.FreightSummary)
+	copy(dAtA[i:], m.FreightSummary)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightSummary)))
 	i--
-	dAtA[i] = 0x3a
-	if m.FinishTime != nil {
+	dAtA[i] = 0x62
+	i -= len(m.LastHandledRefresh)
+	copy(dAtA[i:], m.LastHandledRefresh)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
+	i--
+	dAtA[i] = 0x5a
+	if m.LastPromotion != nil {
 		{
-			size, err := m.FinishTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LastPromotion.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5922,11 +8246,16 @@ func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x52
 	}
-	if m.StartTime != nil {
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x4a
+	if m.Health != nil {
 		{
-			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5934,16 +8263,11 @@ func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x42
 	}
-	i -= len(m.ID)
-	copy(dAtA[i:], m.ID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
-	i--
-	dAtA[i] = 0x22
-	if m.AnalysisRun != nil {
+	if m.CurrentPromotion != nil {
 		{
-			size, err := m.AnalysisRun.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CurrentPromotion.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5951,13 +8275,51 @@ func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x3a
 	}
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x30
+	if len(m.FreightHistory) > 0 {
+		for iNdEx := len(m.FreightHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FreightHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.CurrentFreight != nil {
+		{
+			size, err := m.CurrentFreight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	i -= len(m.Phase)
 	copy(dAtA[i:], m.Phase)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
@@ -5966,7 +8328,7 @@ func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VerifiedStage) Marshal() (dAtA []byte, err error) {
+func (m *StageSubscription) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5976,20 +8338,25 @@ func (m *VerifiedStage) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VerifiedStage) MarshalTo(dAtA []byte) (int, error) {
+func (m *StageSubscription) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VerifiedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StageSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Warehouse) Marshal() (dAtA []byte, err error) {
+func (m *SubscriptionPollTimes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5999,50 +8366,56 @@ func (m *Warehouse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Warehouse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubscriptionPollTimes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Warehouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubscriptionPollTimes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Chart != nil {
+		{
+			size, err := m.Chart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Image != nil {
+		{
+			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Git != nil {
+		{
+			size, err := m.Git.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WarehouseList) Marshal() (dAtA []byte, err error) {
+func (m *Subscriptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6052,20 +8425,20 @@ func (m *WarehouseList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WarehouseList) MarshalTo(dAtA []byte) (int, error) {
+func (m *Subscriptions) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WarehouseList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Subscriptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.UpstreamStages) > 0 {
+		for iNdEx := len(m.UpstreamStages) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.UpstreamStages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6076,20 +8449,15 @@ func (m *WarehouseList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Warehouse)
+	copy(dAtA[i:], m.Warehouse)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Warehouse)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *WarehouseSpec) Marshal() (dAtA []byte, err error) {
+func (m *Verification) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6099,40 +8467,46 @@ func (m *WarehouseSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WarehouseSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *Verification) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WarehouseSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.FreightCreationPolicy)
-	copy(dAtA[i:], m.FreightCreationPolicy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightCreationPolicy)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Shard)
-	copy(dAtA[i:], m.Shard)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shard)))
-	i--
-	dAtA[i] = 0x12
-	if len(m.Subscriptions) > 0 {
-		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+	if m.AnalysisRunMetadata != nil {
+		{
+			size, err := m.AnalysisRunMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AnalysisTemplates) > 0 {
+		for iNdEx := len(m.AnalysisTemplates) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AnalysisTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6146,7 +8520,7 @@ func (m *WarehouseSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WarehouseStatus) Marshal() (dAtA []byte, err error) {
+func (m *VerificationInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6156,24 +8530,24 @@ func (m *WarehouseStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WarehouseStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *VerificationInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WarehouseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.LastFreightID)
-	copy(dAtA[i:], m.LastFreightID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastFreightID)))
+	i -= len(m.Actor)
+	copy(dAtA[i:], m.Actor)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Actor)))
 	i--
-	dAtA[i] = 0x42
-	if m.DiscoveredArtifacts != nil {
+	dAtA[i] = 0x3a
+	if m.FinishTime != nil {
 		{
-			size, err := m.DiscoveredArtifacts.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FinishTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6181,595 +8555,774 @@ func (m *WarehouseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x32
 	}
-	i -= len(m.LastHandledRefresh)
-	copy(dAtA[i:], m.LastHandledRefresh)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
-	i--
-	dAtA[i] = 0x32
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.ID)
+	copy(dAtA[i:], m.ID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
 	i--
-	dAtA[i] = 0x20
+	dAtA[i] = 0x22
+	if m.AnalysisRun != nil {
+		{
+			size, err := m.AnalysisRun.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	i -= len(m.Message)
 	copy(dAtA[i:], m.Message)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *VerifiedStage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AnalysisRunArgument) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *VerifiedStage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifiedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AnalysisRunMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Warehouse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Warehouse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Warehouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *AnalysisRunReference) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WarehouseList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AnalysisTemplateReference) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *WarehouseList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApprovedStage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WarehouseList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ArgoCDAppHealthStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WarehouseSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ArgoCDAppStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.HealthStatus.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.SyncStatus.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *WarehouseSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ArgoCDAppSyncStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WarehouseSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Revision)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Revisions) > 0 {
-		for _, s := range m.Revisions {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.FreightEqualityMode)
+	copy(dAtA[i:], m.FreightEqualityMode)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightEqualityMode)))
+	i--
+	dAtA[i] = 0x32
+	if m.PollingIntervals != nil {
+		{
+			size, err := m.PollingIntervals.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	{
+		size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.FreightCreationPolicy)
+	copy(dAtA[i:], m.FreightCreationPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightCreationPolicy)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Shard)
+	copy(dAtA[i:], m.Shard)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shard)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ArgoCDAppUpdate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WarehouseStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WarehouseStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WarehouseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.AppName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.AppNamespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.SourceUpdates) > 0 {
-		for _, e := range m.SourceUpdates {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.LastPolled != nil {
+		{
+			size, err := m.LastPolled.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.Origin != nil {
-		l = m.Origin.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.LastFreightID)
+	copy(dAtA[i:], m.LastFreightID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastFreightID)))
+	i--
+	dAtA[i] = 0x42
+	if m.DiscoveredArtifacts != nil {
+		{
+			size, err := m.DiscoveredArtifacts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	i -= len(m.LastHandledRefresh)
+	copy(dAtA[i:], m.LastHandledRefresh)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
+	i--
+	dAtA[i] = 0x32
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
 }
 
-func (m *ArgoCDHelm) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WebhookConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.BodyTemplate)
+	copy(dAtA[i:], m.BodyTemplate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BodyTemplate)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Headers) > 0 {
+		keysForHeaders := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			keysForHeaders = append(keysForHeaders, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+		for iNdEx := len(keysForHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Headers[string(keysForHeaders[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHeaders[iNdEx])
+			copy(dAtA[i:], keysForHeaders[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.Origin != nil {
-		l = m.Origin.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WebhookDeliveryStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ArgoCDHelmImageUpdate) Size() (n int) {
+func (m *WebhookDeliveryStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookDeliveryStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x32
+	i--
+	if m.Succeeded {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintGenerated(dAtA, i, uint64(m.StatusCode))
+	i--
+	dAtA[i] = 0x20
+	if m.DeliveredAt != nil {
+		{
+			size, err := m.DeliveredAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Promotion)
+	copy(dAtA[i:], m.Promotion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Promotion)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AnalysisRunArgument) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Image)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Key)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Origin != nil {
-		l = m.Origin.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
 	return n
 }
 
-func (m *ArgoCDKustomize) Size() (n int) {
+func (m *AnalysisRunMetadata) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	if m.Origin != nil {
-		l = m.Origin.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
 	return n
 }
 
-func (m *ArgoCDKustomizeImageUpdate) Size() (n int) {
+func (m *AnalysisRunReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Image)
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	if m.Origin != nil {
-		l = m.Origin.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
 	return n
 }
 
-func (m *ArgoCDSourceUpdate) Size() (n int) {
+func (m *AnalysisTemplateReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Chart)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	if m.Kustomize != nil {
-		l = m.Kustomize.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Helm != nil {
-		l = m.Helm.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Origin != nil {
-		l = m.Origin.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ApprovedStage) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	return n
 }
 
-func (m *Chart) Size() (n int) {
+func (m *ArgoCDAppHealthCheck) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
+	l = len(m.JSONPath)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Version)
+	l = len(m.ExpectedValue)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ChartDiscoveryResult) Size() (n int) {
+func (m *ArgoCDAppHealthStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
+	l = len(m.Status)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SemverConstraint)
+	l = len(m.Message)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Versions) > 0 {
-		for _, s := range m.Versions {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *ChartSubscription) Size() (n int) {
+func (m *ArgoCDAppStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
+	l = len(m.Namespace)
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SemverConstraint)
+	l = m.HealthStatus.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.SyncStatus.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
 	return n
 }
 
-func (m *DiscoveredArtifacts) Size() (n int) {
+func (m *ArgoCDAppStatusCheck) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Git) > 0 {
-		for _, e := range m.Git {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Charts) > 0 {
-		for _, e := range m.Charts {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RequiredSyncStatus)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *DiscoveredCommit) Size() (n int) {
+func (m *ArgoCDAppSyncStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Branch)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Tag)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Subject)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Author)
+	l = len(m.Status)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Committer)
+	l = len(m.Revision)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.CreatorDate != nil {
-		l = m.CreatorDate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *DiscoveredImageReference) Size() (n int) {
+func (m *ArgoCDAppUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Tag)
+	l = len(m.AppName)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Digest)
+	l = len(m.AppNamespace)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.GitRepoURL)
+	if len(m.SourceUpdates) > 0 {
+		for _, e := range m.SourceUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HealthCheck != nil {
+		l = m.HealthCheck.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ArgoCDClusterName)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
+	l = len(m.ResourceType)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SourceIndex != nil {
+		n += 1 + sovGenerated(uint64(*m.SourceIndex))
+	}
+	if m.SyncTimeout != nil {
+		l = m.SyncTimeout.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *Freight) Size() (n int) {
+func (m *ArgoCDHelm) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	if len(m.Images) > 0 {
 		for _, e := range m.Images {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.Charts) > 0 {
-		for _, e := range m.Charts {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Alias)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Warehouse)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Origin.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *FreightCollection) Size() (n int) {
+func (m *ArgoCDHelmImageUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Freight) > 0 {
-		for k, v := range m.Freight {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if len(m.VerificationHistory) > 0 {
-		for _, e := range m.VerificationHistory {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	l = len(m.ID)
+	l = len(m.Image)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *FreightList) Size() (n int) {
+func (m *ArgoCDKustomize) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *FreightOrigin) Size() (n int) {
+func (m *ArgoCDKustomizeImageUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Kind)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
+	l = len(m.Image)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *FreightReference) Size() (n int) {
+func (m *ArgoCDSourceUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Charts) > 0 {
-		for _, e := range m.Charts {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	l = len(m.Chart)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.Kustomize != nil {
+		l = m.Kustomize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.VerificationInfo != nil {
-		l = m.VerificationInfo.Size()
+	if m.Helm != nil {
+		l = m.Helm.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.Warehouse)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.VerificationHistory) > 0 {
-		for _, e := range m.VerificationHistory {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = m.Origin.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *FreightRequest) Size() (n int) {
+func (m *CanaryPromotion) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Origin.Size()
+	l = len(m.Namespace)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Sources.Size()
+	l = len(m.Rollout)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Weight))
 	return n
 }
 
-func (m *FreightSources) Size() (n int) {
+func (m *ChangeApprovalCheck) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 2
-	if len(m.Stages) > 0 {
-		for _, s := range m.Stages {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *FreightStatus) Size() (n int) {
+func (m *Chart) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.VerifiedIn) > 0 {
-		for k, v := range m.VerifiedIn {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if len(m.ApprovedFor) > 0 {
-		for k, v := range m.ApprovedFor {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *GitCommit) Size() (n int) {
+func (m *ChartChange) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6777,24 +9330,16 @@ func (m *GitCommit) Size() (n int) {
 	_ = l
 	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Branch)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Tag)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.HealthCheckCommit)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Author)
+	l = len(m.Version)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Committer)
+	l = len(m.Description)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *GitDiscoveryResult) Size() (n int) {
+func (m *ChartDiscoveryResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6802,163 +9347,158 @@ func (m *GitDiscoveryResult) Size() (n int) {
 	_ = l
 	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
-			l = e.Size()
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Versions) > 0 {
+		for _, s := range m.Versions {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.ServedBy)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *GitHubPullRequest) Size() (n int) {
+func (m *ChartSubscription) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
+	if len(m.FallbackRepoURLs) > 0 {
+		for _, s := range m.FallbackRepoURLs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	if m.ProvenanceKeySecretRef != nil {
+		l = m.ProvenanceKeySecretRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *GitLabPullRequest) Size() (n int) {
+func (m *CircuitBreaker) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	n += 1 + sovGenerated(uint64(m.Threshold))
+	l = m.Cooldown.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *GitRepoUpdate) Size() (n int) {
+func (m *CircuitBreakerStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	l = len(m.ReadBranch)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.WriteBranch)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.PullRequest != nil {
-		l = m.PullRequest.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Render != nil {
-		l = m.Render.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Kustomize != nil {
-		l = m.Kustomize.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Helm != nil {
-		l = m.Helm.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Origin != nil {
-		l = m.Origin.Size()
+	n += 1 + sovGenerated(uint64(m.ConsecutiveFailures))
+	if m.OpenedAt != nil {
+		l = m.OpenedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *GitSubscription) Size() (n int) {
+func (m *DiscoveredArtifacts) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.CommitSelectionStrategy)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Branch)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SemverConstraint)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.AllowTags)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.IgnoreTags) > 0 {
-		for _, s := range m.IgnoreTags {
-			l = len(s)
+	if len(m.Git) > 0 {
+		for _, e := range m.Git {
+			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	n += 2
-	if len(m.IncludePaths) > 0 {
-		for _, s := range m.IncludePaths {
-			l = len(s)
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.ExcludePaths) > 0 {
-		for _, s := range m.ExcludePaths {
-			l = len(s)
+	if len(m.Charts) > 0 {
+		for _, e := range m.Charts {
+			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
 	return n
 }
 
-func (m *Health) Size() (n int) {
+func (m *DiscoveredCommit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Status)
+	l = len(m.ID)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Issues) > 0 {
-		for _, s := range m.Issues {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.ArgoCDApps) > 0 {
-		for _, e := range m.ArgoCDApps {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	l = len(m.Branch)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Author)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Committer)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CreatorDate != nil {
+		l = m.CreatorDate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *HelmChartDependencyUpdate) Size() (n int) {
+func (m *DiscoveredImageReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Repository)
+	l = len(m.Tag)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
+	l = len(m.Digest)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ChartPath)
+	l = len(m.GitRepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Origin != nil {
-		l = m.Origin.Size()
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *HelmImageUpdate) Size() (n int) {
+func (m *FluxHelmReleaseUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Image)
+	l = len(m.Namespace)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ValuesFilePath)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Key)
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
+	l = len(m.Chart)
 	n += 1 + l + sovGenerated(uint64(l))
 	if m.Origin != nil {
 		l = m.Origin.Size()
@@ -6967,12 +9507,20 @@ func (m *HelmImageUpdate) Size() (n int) {
 	return n
 }
 
-func (m *HelmPromotionMechanism) Size() (n int) {
+func (m *Freight) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	if len(m.Images) > 0 {
 		for _, e := range m.Images {
 			l = e.Size()
@@ -6985,177 +9533,224 @@ func (m *HelmPromotionMechanism) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.Origin != nil {
-		l = m.Origin.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Alias)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Warehouse)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Origin.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Image) Size() (n int) {
+func (m *FreightCollection) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.GitRepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Tag)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Digest)
+	if len(m.Freight) > 0 {
+		for k, v := range m.Freight {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.VerificationHistory) > 0 {
+		for _, e := range m.VerificationHistory {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.ID)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ImageDiscoveryResult) Size() (n int) {
+func (m *FreightCollectionDelta) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Platform)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.References) > 0 {
-		for _, e := range m.References {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.Baseline != nil {
+		l = m.Baseline.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Patch)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ImageSubscription) Size() (n int) {
+func (m *FreightList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.GitRepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ImageSelectionStrategy)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SemverConstraint)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.AllowTags)
+	l = m.ListMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.IgnoreTags) > 0 {
-		for _, s := range m.IgnoreTags {
-			l = len(s)
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = len(m.Platform)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
 	return n
 }
 
-func (m *KargoRenderImageUpdate) Size() (n int) {
+func (m *FreightOrigin) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Image)
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	if m.Origin != nil {
-		l = m.Origin.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
 	return n
 }
 
-func (m *KargoRenderPromotionMechanism) Size() (n int) {
+func (m *FreightReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	if len(m.Images) > 0 {
 		for _, e := range m.Images {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.Origin != nil {
-		l = m.Origin.Size()
+	if len(m.Charts) > 0 {
+		for _, e := range m.Charts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.VerificationInfo != nil {
+		l = m.VerificationInfo.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Warehouse)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.VerificationHistory) > 0 {
+		for _, e := range m.VerificationHistory {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Origin.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *KustomizeImageUpdate) Size() (n int) {
+func (m *FreightRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Image)
+	l = m.Origin.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Path)
+	l = m.Sources.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *FreightSources) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	n += 2
-	if m.Origin != nil {
-		l = m.Origin.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Stages) > 0 {
+		for _, s := range m.Stages {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
+	l = len(m.AvailabilityStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *KustomizePromotionMechanism) Size() (n int) {
+func (m *FreightStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.VerifiedIn) > 0 {
+		for k, v := range m.VerifiedIn {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	if m.Origin != nil {
-		l = m.Origin.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ApprovedFor) > 0 {
+		for k, v := range m.ApprovedFor {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
 	return n
 }
 
-func (m *Project) Size() (n int) {
+func (m *GitCommit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Spec != nil {
-		l = m.Spec.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = m.Status.Size()
+	l = len(m.ID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Branch)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HealthCheckCommit)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Author)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Committer)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ProjectList) Size() (n int) {
+func (m *GitDiscoveryResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -7163,264 +9758,274 @@ func (m *ProjectList) Size() (n int) {
 	return n
 }
 
-func (m *ProjectSpec) Size() (n int) {
+func (m *GitHubPullRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.PromotionPolicies) > 0 {
-		for _, e := range m.PromotionPolicies {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *ProjectStatus) Size() (n int) {
+func (m *GitLabPullRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Promotion) Size() (n int) {
+func (m *GitRepoUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	n += 2
+	l = len(m.ReadBranch)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
+	l = len(m.WriteBranch)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Render != nil {
+		l = m.Render.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Kustomize != nil {
+		l = m.Kustomize.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Helm != nil {
+		l = m.Helm.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.CommitMessageTemplate)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.SigningKeySecretRef != nil {
+		l = m.SigningKeySecretRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.AuthorName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AuthorEmail)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ShallowCloneDepth))
+	n += 2
+	if m.SOPSAgeKeySecretRef != nil {
+		l = m.SOPSAgeKeySecretRef.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.EditOrder)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *PromotionList) Size() (n int) {
+func (m *GitSubscription) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
+	l = len(m.CommitSelectionStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Branch)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AllowTags)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.IgnoreTags) > 0 {
+		for _, s := range m.IgnoreTags {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *PromotionMechanisms) Size() (n int) {
-	if m == nil {
-		return 0
+	n += 2
+	if len(m.IncludePaths) > 0 {
+		for _, s := range m.IncludePaths {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if len(m.GitRepoUpdates) > 0 {
-		for _, e := range m.GitRepoUpdates {
-			l = e.Size()
+	if len(m.ExcludePaths) > 0 {
+		for _, s := range m.ExcludePaths {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.ArgoCDAppUpdates) > 0 {
-		for _, e := range m.ArgoCDAppUpdates {
-			l = e.Size()
+	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
+	n += 1 + sovGenerated(uint64(m.ShallowCloneDepth))
+	if len(m.Branches) > 0 {
+		for _, s := range m.Branches {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.Origin != nil {
-		l = m.Origin.Size()
+	if m.KnownHostsConfigMapRef != nil {
+		l = m.KnownHostsConfigMapRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.SparseCheckoutPaths) > 0 {
+		for _, s := range m.SparseCheckoutPaths {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *PromotionPolicy) Size() (n int) {
+func (m *HTTPHealthCheck) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Stage)
+	l = len(m.URL)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ExpectedStatusCode))
+	n += 1 + sovGenerated(uint64(m.TimeoutSeconds))
 	n += 2
 	return n
 }
 
-func (m *PromotionReference) Size() (n int) {
+func (m *Health) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Status)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Freight != nil {
-		l = m.Freight.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Issues) > 0 {
+		for _, s := range m.Issues {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ArgoCDApps) > 0 {
+		for _, e := range m.ArgoCDApps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.FinishedAt != nil {
-		l = m.FinishedAt.Size()
+	if m.CheckedAt != nil {
+		l = m.CheckedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *PromotionSpec) Size() (n int) {
+func (m *HealthChecks) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Stage)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Freight)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *PromotionStatus) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.HTTPChecks) > 0 {
+		for _, e := range m.HTTPChecks {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Metadata) > 0 {
-		for k, v := range m.Metadata {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.ArgoCDApps) > 0 {
+		for _, e := range m.ArgoCDApps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = len(m.LastHandledRefresh)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Freight != nil {
-		l = m.Freight.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.FinishedAt != nil {
-		l = m.FinishedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.FreightCollection != nil {
-		l = m.FreightCollection.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
-}
-
-func (m *PullRequestPromotionMechanism) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GitHub != nil {
-		l = m.GitHub.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.GitLab != nil {
-		l = m.GitLab.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.PodImages) > 0 {
+		for _, e := range m.PodImages {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *RepoSubscription) Size() (n int) {
+func (m *HelmChartDependencyUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Git != nil {
-		l = m.Git.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Image != nil {
-		l = m.Image.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Chart != nil {
-		l = m.Chart.Size()
+	l = len(m.Repository)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ChartPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Origin != nil {
+		l = m.Origin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *Stage) Size() (n int) {
+func (m *HelmImageUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
+	l = len(m.Image)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	l = len(m.ValuesFilePath)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *StageList) Size() (n int) {
+func (m *HelmPromotionMechanism) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *StageSpec) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Charts) > 0 {
+		for _, e := range m.Charts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	l = m.Subscriptions.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.PromotionMechanisms != nil {
-		l = m.PromotionMechanisms.Size()
+	if m.Origin != nil {
+		l = m.Origin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Verification != nil {
-		l = m.Verification.Size()
+	l = len(m.ValuesFilePath)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ValuesOverrides != nil {
+		l = m.ValuesOverrides.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.Shard)
+	l = len(m.UmbrellaChartPath)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.RequestedFreight) > 0 {
-		for _, e := range m.RequestedFreight {
+	if len(m.ValuesFiles) > 0 {
+		for _, e := range m.ValuesFiles {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -7428,73 +10033,54 @@ func (m *StageSpec) Size() (n int) {
 	return n
 }
 
-func (m *StageStatus) Size() (n int) {
+func (m *HelmValuesFileUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Phase)
+	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.CurrentFreight != nil {
-		l = m.CurrentFreight.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.History) > 0 {
-		for _, e := range m.History {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.FreightHistory) > 0 {
-		for _, e := range m.FreightHistory {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Updates) > 0 {
+		for k, v := range m.Updates {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
-	if m.CurrentPromotion != nil {
-		l = m.CurrentPromotion.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Health != nil {
-		l = m.Health.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.LastPromotion != nil {
-		l = m.LastPromotion.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.LastHandledRefresh)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.FreightSummary)
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *StageSubscription) Size() (n int) {
+func (m *Image) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GitRepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Digest)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Subscriptions) Size() (n int) {
+func (m *ImageDiscoveryResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Warehouse)
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.UpstreamStages) > 0 {
-		for _, e := range m.UpstreamStages {
+	l = len(m.Platform)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.References) > 0 {
+		for _, e := range m.References {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -7502,1272 +10088,8970 @@ func (m *Subscriptions) Size() (n int) {
 	return n
 }
 
-func (m *Verification) Size() (n int) {
+func (m *ImagePullCheck) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.AnalysisTemplates) > 0 {
-		for _, e := range m.AnalysisTemplates {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if m.AnalysisRunMetadata != nil {
-		l = m.AnalysisRunMetadata.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Args) > 0 {
-		for _, e := range m.Args {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
+	l = len(m.PullSecret)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *VerificationInfo) Size() (n int) {
+func (m *ImageSubscription) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Phase)
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
+	l = len(m.GitRepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.AnalysisRun != nil {
-		l = m.AnalysisRun.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.ID)
+	l = len(m.ImageSelectionStrategy)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.StartTime != nil {
-		l = m.StartTime.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AllowTags)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.IgnoreTags) > 0 {
+		for _, s := range m.IgnoreTags {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.FinishTime != nil {
-		l = m.FinishTime.Size()
+	l = len(m.Platform)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
+	l = len(m.DenyTags)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CABundleConfigMapRef != nil {
+		l = m.CABundleConfigMapRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.Actor)
-	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
-func (m *VerifiedStage) Size() (n int) {
+func (m *JobTemplate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Warehouse) Size() (n int) {
+func (m *KargoRenderImageUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
+	l = len(m.Image)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *WarehouseList) Size() (n int) {
+func (m *KargoRenderPromotionMechanism) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *WarehouseSpec) Size() (n int) {
+func (m *KubernetesResourceUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Subscriptions) > 0 {
-		for _, e := range m.Subscriptions {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	l = len(m.Shard)
+	l = len(m.APIVersion)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.FreightCreationPolicy)
+	l = len(m.Kind)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Interval.Size()
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.JSONPatch)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *WarehouseStatus) Size() (n int) {
+func (m *KustomizeImageUpdate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Message)
+	l = len(m.Image)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
-	l = len(m.LastHandledRefresh)
+	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.DiscoveredArtifacts != nil {
-		l = m.DiscoveredArtifacts.Size()
+	n += 2
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	if m.ReplacementSource != nil {
+		l = m.ReplacementSource.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.LastFreightID)
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func sovGenerated(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *AnalysisRunArgument) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&AnalysisRunArgument{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AnalysisRunMetadata) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+func (m *KustomizePromotionMechanism) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	var l int
+	_ = l
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&AnalysisRunMetadata{`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AnalysisRunReference) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Components) > 0 {
+		for _, s := range m.Components {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&AnalysisRunReference{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *AnalysisTemplateReference) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *KustomizeReplacementSource) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&AnalysisTemplateReference{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.ConfigMap)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ApprovedStage) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PodImageHealthCheck) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ApprovedStage{`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Selector.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ArgoCDAppHealthStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PollingIntervals) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ArgoCDAppHealthStatus{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.GitIntervalSeconds))
+	n += 1 + sovGenerated(uint64(m.ImageIntervalSeconds))
+	n += 1 + sovGenerated(uint64(m.ChartIntervalSeconds))
+	return n
 }
-func (this *ArgoCDAppStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Project) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ArgoCDAppStatus{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`HealthStatus:` + strings.Replace(strings.Replace(this.HealthStatus.String(), "ArgoCDAppHealthStatus", "ArgoCDAppHealthStatus", 1), `&`, ``, 1) + `,`,
-		`SyncStatus:` + strings.Replace(strings.Replace(this.SyncStatus.String(), "ArgoCDAppSyncStatus", "ArgoCDAppSyncStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArgoCDAppSyncStatus) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Spec != nil {
+		l = m.Spec.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ArgoCDAppSyncStatus{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
-		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ArgoCDAppUpdate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForSourceUpdates := "[]ArgoCDSourceUpdate{"
-	for _, f := range this.SourceUpdates {
-		repeatedStringForSourceUpdates += strings.Replace(strings.Replace(f.String(), "ArgoCDSourceUpdate", "ArgoCDSourceUpdate", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForSourceUpdates += "}"
-	s := strings.Join([]string{`&ArgoCDAppUpdate{`,
-		`AppName:` + fmt.Sprintf("%v", this.AppName) + `,`,
-		`AppNamespace:` + fmt.Sprintf("%v", this.AppNamespace) + `,`,
-		`SourceUpdates:` + repeatedStringForSourceUpdates + `,`,
-		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ArgoCDHelm) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForImages := "[]ArgoCDHelmImageUpdate{"
-	for _, f := range this.Images {
-		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "ArgoCDHelmImageUpdate", "ArgoCDHelmImageUpdate", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if len(m.PromotionPolicies) > 0 {
+		for _, e := range m.PromotionPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForImages += "}"
-	s := strings.Join([]string{`&ArgoCDHelm{`,
-		`Images:` + repeatedStringForImages + `,`,
-		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ArgoCDHelmImageUpdate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ArgoCDHelmImageUpdate{`,
-		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArgoCDKustomize) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForImages := "[]ArgoCDKustomizeImageUpdate{"
-	for _, f := range this.Images {
-		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "ArgoCDKustomizeImageUpdate", "ArgoCDKustomizeImageUpdate", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForImages += "}"
-	s := strings.Join([]string{`&ArgoCDKustomize{`,
-		`Images:` + repeatedStringForImages + `,`,
-		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ArgoCDKustomizeImageUpdate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Promotion) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ArgoCDKustomizeImageUpdate{`,
-		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
-		`UseDigest:` + fmt.Sprintf("%v", this.UseDigest) + `,`,
-		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ArgoCDSourceUpdate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ArgoCDSourceUpdate{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Chart:` + fmt.Sprintf("%v", this.Chart) + `,`,
-		`UpdateTargetRevision:` + fmt.Sprintf("%v", this.UpdateTargetRevision) + `,`,
-		`Kustomize:` + strings.Replace(this.Kustomize.String(), "ArgoCDKustomize", "ArgoCDKustomize", 1) + `,`,
-		`Helm:` + strings.Replace(this.Helm.String(), "ArgoCDHelm", "ArgoCDHelm", 1) + `,`,
-		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Chart) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&Chart{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ChartDiscoveryResult) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionMechanisms) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ChartDiscoveryResult{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
-		`Versions:` + fmt.Sprintf("%v", this.Versions) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ChartSubscription) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if len(m.GitRepoUpdates) > 0 {
+		for _, e := range m.GitRepoUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ChartSubscription{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
-		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DiscoveredArtifacts) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ArgoCDAppUpdates) > 0 {
+		for _, e := range m.ArgoCDAppUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForGit := "[]GitDiscoveryResult{"
-	for _, f := range this.Git {
-		repeatedStringForGit += strings.Replace(strings.Replace(f.String(), "GitDiscoveryResult", "GitDiscoveryResult", 1), `&`, ``, 1) + ","
+	if m.Origin != nil {
+		l = m.Origin.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForGit += "}"
-	repeatedStringForImages := "[]ImageDiscoveryResult{"
-	for _, f := range this.Images {
-		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "ImageDiscoveryResult", "ImageDiscoveryResult", 1), `&`, ``, 1) + ","
+	if m.ChangeApproval != nil {
+		l = m.ChangeApproval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForImages += "}"
-	repeatedStringForCharts := "[]ChartDiscoveryResult{"
-	for _, f := range this.Charts {
-		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "ChartDiscoveryResult", "ChartDiscoveryResult", 1), `&`, ``, 1) + ","
+	if m.ImagePullCheck != nil {
+		l = m.ImagePullCheck.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForCharts += "}"
-	s := strings.Join([]string{`&DiscoveredArtifacts{`,
-		`Git:` + repeatedStringForGit + `,`,
-		`Images:` + repeatedStringForImages + `,`,
-		`Charts:` + repeatedStringForCharts + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DiscoveredCommit) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.FluxHelmReleaseUpdates) > 0 {
+		for _, e := range m.FluxHelmReleaseUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&DiscoveredCommit{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
-		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
-		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
-		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
-		`Committer:` + fmt.Sprintf("%v", this.Committer) + `,`,
-		`CreatorDate:` + strings.Replace(fmt.Sprintf("%v", this.CreatorDate), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.KubernetesResourceUpdates) > 0 {
+		for _, e := range m.KubernetesResourceUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Canary != nil {
+		l = m.Canary.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *DiscoveredImageReference) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&DiscoveredImageReference{`,
-		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
-		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
-		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
-		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Stage)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
 }
-func (this *Freight) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionReference) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForCommits := "[]GitCommit{"
-	for _, f := range this.Commits {
-		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "GitCommit", "GitCommit", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Freight != nil {
+		l = m.Freight.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForCommits += "}"
-	repeatedStringForImages := "[]Image{"
-	for _, f := range this.Images {
-		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "Image", "Image", 1), `&`, ``, 1) + ","
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForImages += "}"
-	repeatedStringForCharts := "[]Chart{"
-	for _, f := range this.Charts {
-		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "Chart", "Chart", 1), `&`, ``, 1) + ","
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForCharts += "}"
-	s := strings.Join([]string{`&Freight{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Commits:` + repeatedStringForCommits + `,`,
-		`Images:` + repeatedStringForImages + `,`,
-		`Charts:` + repeatedStringForCharts + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "FreightStatus", "FreightStatus", 1), `&`, ``, 1) + `,`,
-		`Alias:` + fmt.Sprintf("%v", this.Alias) + `,`,
-		`Warehouse:` + fmt.Sprintf("%v", this.Warehouse) + `,`,
-		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *FreightCollection) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForVerificationHistory := "[]VerificationInfo{"
-	for _, f := range this.VerificationHistory {
-		repeatedStringForVerificationHistory += strings.Replace(strings.Replace(f.String(), "VerificationInfo", "VerificationInfo", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForVerificationHistory += "}"
-	keysForFreight := make([]string, 0, len(this.Freight))
-	for k := range this.Freight {
-		keysForFreight = append(keysForFreight, k)
+
+func (m *PromotionSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForFreight)
-	mapStringForFreight := "map[string]FreightReference{"
-	for _, k := range keysForFreight {
-		mapStringForFreight += fmt.Sprintf("%v: %v,", k, this.Freight[k])
-	}
-	mapStringForFreight += "}"
-	s := strings.Join([]string{`&FreightCollection{`,
-		`Freight:` + mapStringForFreight + `,`,
-		`VerificationHistory:` + repeatedStringForVerificationHistory + `,`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Stage)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Freight)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *FreightList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]Freight{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Freight", "Freight", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&FreightList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *FreightReference) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.LastHandledRefresh)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Freight != nil {
+		l = m.Freight.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForCommits := "[]GitCommit{"
-	for _, f := range this.Commits {
-		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "GitCommit", "GitCommit", 1), `&`, ``, 1) + ","
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForCommits += "}"
-	repeatedStringForImages := "[]Image{"
-	for _, f := range this.Images {
-		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "Image", "Image", 1), `&`, ``, 1) + ","
+	if m.FreightCollection != nil {
+		l = m.FreightCollection.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForImages += "}"
-	repeatedStringForCharts := "[]Chart{"
-	for _, f := range this.Charts {
-		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "Chart", "Chart", 1), `&`, ``, 1) + ","
+	n += 2
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForCharts += "}"
-	repeatedStringForVerificationHistory := "[]VerificationInfo{"
-	for _, f := range this.VerificationHistory {
-		repeatedStringForVerificationHistory += strings.Replace(strings.Replace(f.String(), "VerificationInfo", "VerificationInfo", 1), `&`, ``, 1) + ","
+	if len(m.ChartChanges) > 0 {
+		for _, e := range m.ChartChanges {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForVerificationHistory += "}"
-	s := strings.Join([]string{`&FreightReference{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Commits:` + repeatedStringForCommits + `,`,
-		`Images:` + repeatedStringForImages + `,`,
-		`Charts:` + repeatedStringForCharts + `,`,
-		`VerificationInfo:` + strings.Replace(this.VerificationInfo.String(), "VerificationInfo", "VerificationInfo", 1) + `,`,
-		`Warehouse:` + fmt.Sprintf("%v", this.Warehouse) + `,`,
-		`VerificationHistory:` + repeatedStringForVerificationHistory + `,`,
-		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *FreightRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionTemplate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&FreightRequest{`,
-		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
-		`Sources:` + strings.Replace(strings.Replace(this.Sources.String(), "FreightSources", "FreightSources", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *FreightSources) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionTemplateList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&FreightSources{`,
-		`Direct:` + fmt.Sprintf("%v", this.Direct) + `,`,
-		`Stages:` + fmt.Sprintf("%v", this.Stages) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *FreightStatus) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	keysForVerifiedIn := make([]string, 0, len(this.VerifiedIn))
-	for k := range this.VerifiedIn {
-		keysForVerifiedIn = append(keysForVerifiedIn, k)
+	return n
+}
+
+func (m *PromotionTemplateSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForVerifiedIn)
-	mapStringForVerifiedIn := "map[string]VerifiedStage{"
-	for _, k := range keysForVerifiedIn {
-		mapStringForVerifiedIn += fmt.Sprintf("%v: %v,", k, this.VerifiedIn[k])
+	var l int
+	_ = l
+	l = m.PromotionMechanisms.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *PullRequestPromotionMechanism) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	mapStringForVerifiedIn += "}"
-	keysForApprovedFor := make([]string, 0, len(this.ApprovedFor))
-	for k := range this.ApprovedFor {
-		keysForApprovedFor = append(keysForApprovedFor, k)
+	var l int
+	_ = l
+	if m.GitHub != nil {
+		l = m.GitHub.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForApprovedFor)
-	mapStringForApprovedFor := "map[string]ApprovedStage{"
-	for _, k := range keysForApprovedFor {
-		mapStringForApprovedFor += fmt.Sprintf("%v: %v,", k, this.ApprovedFor[k])
+	if m.GitLab != nil {
+		l = m.GitLab.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForApprovedFor += "}"
-	s := strings.Join([]string{`&FreightStatus{`,
-		`VerifiedIn:` + mapStringForVerifiedIn + `,`,
-		`ApprovedFor:` + mapStringForApprovedFor + `,`,
-		`}`,
-	}, "")
-	return s
+	n += 2
+	return n
 }
-func (this *GitCommit) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RepoSubscription) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GitCommit{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
-		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
-		`HealthCheckCommit:` + fmt.Sprintf("%v", this.HealthCheckCommit) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
-		`Committer:` + fmt.Sprintf("%v", this.Committer) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GitDiscoveryResult) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Git != nil {
+		l = m.Git.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForCommits := "[]DiscoveredCommit{"
-	for _, f := range this.Commits {
-		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "DiscoveredCommit", "DiscoveredCommit", 1), `&`, ``, 1) + ","
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForCommits += "}"
-	s := strings.Join([]string{`&GitDiscoveryResult{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Commits:` + repeatedStringForCommits + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GitHubPullRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.Chart != nil {
+		l = m.Chart.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&GitHubPullRequest{`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GitLabPullRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SecretKeyReference) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GitLabPullRequest{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GitRepoUpdate) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GitRepoUpdate{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
-		`ReadBranch:` + fmt.Sprintf("%v", this.ReadBranch) + `,`,
-		`WriteBranch:` + fmt.Sprintf("%v", this.WriteBranch) + `,`,
-		`PullRequest:` + strings.Replace(this.PullRequest.String(), "PullRequestPromotionMechanism", "PullRequestPromotionMechanism", 1) + `,`,
-		`Render:` + strings.Replace(this.Render.String(), "KargoRenderPromotionMechanism", "KargoRenderPromotionMechanism", 1) + `,`,
-		`Kustomize:` + strings.Replace(this.Kustomize.String(), "KustomizePromotionMechanism", "KustomizePromotionMechanism", 1) + `,`,
-		`Helm:` + strings.Replace(this.Helm.String(), "HelmPromotionMechanism", "HelmPromotionMechanism", 1) + `,`,
-		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *GitSubscription) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Stage) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GitSubscription{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`CommitSelectionStrategy:` + fmt.Sprintf("%v", this.CommitSelectionStrategy) + `,`,
-		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
-		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
-		`AllowTags:` + fmt.Sprintf("%v", this.AllowTags) + `,`,
-		`IgnoreTags:` + fmt.Sprintf("%v", this.IgnoreTags) + `,`,
-		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
-		`IncludePaths:` + fmt.Sprintf("%v", this.IncludePaths) + `,`,
-		`ExcludePaths:` + fmt.Sprintf("%v", this.ExcludePaths) + `,`,
-		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *Health) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StageList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForArgoCDApps := "[]ArgoCDAppStatus{"
-	for _, f := range this.ArgoCDApps {
-		repeatedStringForArgoCDApps += strings.Replace(strings.Replace(f.String(), "ArgoCDAppStatus", "ArgoCDAppStatus", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForArgoCDApps += "}"
-	s := strings.Join([]string{`&Health{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Issues:` + fmt.Sprintf("%v", this.Issues) + `,`,
-		`ArgoCDApps:` + repeatedStringForArgoCDApps + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *HelmChartDependencyUpdate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StageRole) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&HelmChartDependencyUpdate{`,
-		`Repository:` + fmt.Sprintf("%v", this.Repository) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`ChartPath:` + fmt.Sprintf("%v", this.ChartPath) + `,`,
-		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *HelmImageUpdate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StageRoleList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&HelmImageUpdate{`,
-		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
-		`ValuesFilePath:` + fmt.Sprintf("%v", this.ValuesFilePath) + `,`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *HelmPromotionMechanism) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForImages := "[]HelmImageUpdate{"
-	for _, f := range this.Images {
-		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "HelmImageUpdate", "HelmImageUpdate", 1), `&`, ``, 1) + ","
+	return n
+}
+
+func (m *StageRoleSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForImages += "}"
-	repeatedStringForCharts := "[]HelmChartDependencyUpdate{"
-	for _, f := range this.Charts {
-		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "HelmChartDependencyUpdate", "HelmChartDependencyUpdate", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Stage)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Subjects) > 0 {
+		for _, e := range m.Subjects {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForCharts += "}"
-	s := strings.Join([]string{`&HelmPromotionMechanism{`,
-		`Images:` + repeatedStringForImages + `,`,
-		`Charts:` + repeatedStringForCharts + `,`,
-		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Image) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StageSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Image{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
-		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
-		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ImageDiscoveryResult) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.Subscriptions.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.PromotionMechanisms != nil {
+		l = m.PromotionMechanisms.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForReferences := "[]DiscoveredImageReference{"
-	for _, f := range this.References {
-		repeatedStringForReferences += strings.Replace(strings.Replace(f.String(), "DiscoveredImageReference", "DiscoveredImageReference", 1), `&`, ``, 1) + ","
+	if m.Verification != nil {
+		l = m.Verification.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForReferences += "}"
-	s := strings.Join([]string{`&ImageDiscoveryResult{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
-		`References:` + repeatedStringForReferences + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ImageSubscription) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Shard)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.RequestedFreight) > 0 {
+		for _, e := range m.RequestedFreight {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ImageSubscription{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
-		`ImageSelectionStrategy:` + fmt.Sprintf("%v", this.ImageSelectionStrategy) + `,`,
-		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
-		`AllowTags:` + fmt.Sprintf("%v", this.AllowTags) + `,`,
-		`IgnoreTags:` + fmt.Sprintf("%v", this.IgnoreTags) + `,`,
-		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
-		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
-		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *KargoRenderImageUpdate) String() string {
-	if this == nil {
-		return "nil"
+	n += 2
+	n += 1 + sovGenerated(uint64(m.FreightHistoryLimit))
+	if len(m.NotificationWebhooks) > 0 {
+		for _, e := range m.NotificationWebhooks {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&KargoRenderImageUpdate{`,
-		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
-		`UseDigest:` + fmt.Sprintf("%v", this.UseDigest) + `,`,
-		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *KargoRenderPromotionMechanism) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.ConcurrencyPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.PromotionTimeout != nil {
+		l = m.PromotionTimeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForImages := "[]KargoRenderImageUpdate{"
-	for _, f := range this.Images {
-		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "KargoRenderImageUpdate", "KargoRenderImageUpdate", 1), `&`, ``, 1) + ","
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForImages += "}"
-	s := strings.Join([]string{`&KargoRenderPromotionMechanism{`,
-		`Images:` + repeatedStringForImages + `,`,
-		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *KustomizeImageUpdate) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&KustomizeImageUpdate{`,
-		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
-		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
-		`UseDigest:` + fmt.Sprintf("%v", this.UseDigest) + `,`,
-		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *KustomizePromotionMechanism) String() string {
-	if this == nil {
-		return "nil"
+	if m.HealthChecks != nil {
+		l = m.HealthChecks.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForImages := "[]KustomizeImageUpdate{"
-	for _, f := range this.Images {
-		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "KustomizeImageUpdate", "KustomizeImageUpdate", 1), `&`, ``, 1) + ","
+	if m.PrePromotionHook != nil {
+		l = m.PrePromotionHook.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForImages += "}"
-	s := strings.Join([]string{`&KustomizePromotionMechanism{`,
-		`Images:` + repeatedStringForImages + `,`,
-		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Project) String() string {
-	if this == nil {
-		return "nil"
+	if m.PostPromotionHook != nil {
+		l = m.PostPromotionHook.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&Project{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(this.Spec.String(), "ProjectSpec", "ProjectSpec", 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ProjectStatus", "ProjectStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.Pipeline)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2 + sovGenerated(uint64(m.Wave))
+	if m.PromotionTemplateRef != nil {
+		l = m.PromotionTemplateRef.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.AutoPromotionExpression)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.PinnedFreight)
+	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.PromotionSchedule)
+	n += 2 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ProjectList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StageStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]Project{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Project", "Project", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CurrentFreight != nil {
+		l = m.CurrentFreight.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ProjectList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ProjectSpec) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForPromotionPolicies := "[]PromotionPolicy{"
-	for _, f := range this.PromotionPolicies {
-		repeatedStringForPromotionPolicies += strings.Replace(strings.Replace(f.String(), "PromotionPolicy", "PromotionPolicy", 1), `&`, ``, 1) + ","
+	if len(m.FreightHistory) > 0 {
+		for _, e := range m.FreightHistory {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForPromotionPolicies += "}"
-	s := strings.Join([]string{`&ProjectSpec{`,
-		`PromotionPolicies:` + repeatedStringForPromotionPolicies + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ProjectStatus) String() string {
-	if this == nil {
-		return "nil"
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	if m.CurrentPromotion != nil {
+		l = m.CurrentPromotion.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&ProjectStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Promotion) String() string {
-	if this == nil {
-		return "nil"
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&Promotion{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionSpec", "PromotionSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "PromotionStatus", "PromotionStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PromotionList) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastPromotion != nil {
+		l = m.LastPromotion.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForItems := "[]Promotion{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Promotion", "Promotion", 1), `&`, ``, 1) + ","
+	l = len(m.LastHandledRefresh)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FreightSummary)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.NotificationWebhooks) > 0 {
+		for _, e := range m.NotificationWebhooks {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&PromotionList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PromotionMechanisms) String() string {
-	if this == nil {
-		return "nil"
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForGitRepoUpdates := "[]GitRepoUpdate{"
-	for _, f := range this.GitRepoUpdates {
-		repeatedStringForGitRepoUpdates += strings.Replace(strings.Replace(f.String(), "GitRepoUpdate", "GitRepoUpdate", 1), `&`, ``, 1) + ","
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForGitRepoUpdates += "}"
-	repeatedStringForArgoCDAppUpdates := "[]ArgoCDAppUpdate{"
-	for _, f := range this.ArgoCDAppUpdates {
-		repeatedStringForArgoCDAppUpdates += strings.Replace(strings.Replace(f.String(), "ArgoCDAppUpdate", "ArgoCDAppUpdate", 1), `&`, ``, 1) + ","
+	if m.NextScheduledPromotion != nil {
+		l = m.NextScheduledPromotion.Size()
+		n += 2 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForArgoCDAppUpdates += "}"
-	s := strings.Join([]string{`&PromotionMechanisms{`,
-		`GitRepoUpdates:` + repeatedStringForGitRepoUpdates + `,`,
-		`ArgoCDAppUpdates:` + repeatedStringForArgoCDAppUpdates + `,`,
-		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PromotionPolicy) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.FreightHistoryDeltas) > 0 {
+		for _, e := range m.FreightHistoryDeltas {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&PromotionPolicy{`,
-		`Stage:` + fmt.Sprintf("%v", this.Stage) + `,`,
-		`AutoPromotionEnabled:` + fmt.Sprintf("%v", this.AutoPromotionEnabled) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PromotionReference) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StageSubscription) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PromotionReference{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Freight:` + strings.Replace(this.Freight.String(), "FreightReference", "FreightReference", 1) + `,`,
-		`Status:` + strings.Replace(this.Status.String(), "PromotionStatus", "PromotionStatus", 1) + `,`,
-		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *PromotionSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SubscriptionPollTimes) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PromotionSpec{`,
-		`Stage:` + fmt.Sprintf("%v", this.Stage) + `,`,
-		`Freight:` + fmt.Sprintf("%v", this.Freight) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PromotionStatus) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Git != nil {
+		l = m.Git.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	keysForMetadata := make([]string, 0, len(this.Metadata))
-	for k := range this.Metadata {
-		keysForMetadata = append(keysForMetadata, k)
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
-	mapStringForMetadata := "map[string]string{"
-	for _, k := range keysForMetadata {
-		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	if m.Chart != nil {
+		l = m.Chart.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForMetadata += "}"
-	s := strings.Join([]string{`&PromotionStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`Metadata:` + mapStringForMetadata + `,`,
-		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
-		`Freight:` + strings.Replace(this.Freight.String(), "FreightReference", "FreightReference", 1) + `,`,
-		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
-		`FreightCollection:` + strings.Replace(this.FreightCollection.String(), "FreightCollection", "FreightCollection", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PullRequestPromotionMechanism) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Subscriptions) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PullRequestPromotionMechanism{`,
-		`GitHub:` + strings.Replace(this.GitHub.String(), "GitHubPullRequest", "GitHubPullRequest", 1) + `,`,
-		`GitLab:` + strings.Replace(this.GitLab.String(), "GitLabPullRequest", "GitLabPullRequest", 1) + `,`,
-		`}`,
+	var l int
+	_ = l
+	l = len(m.Warehouse)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.UpstreamStages) > 0 {
+		for _, e := range m.UpstreamStages {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Verification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AnalysisTemplates) > 0 {
+		for _, e := range m.AnalysisTemplates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.AnalysisRunMetadata != nil {
+		l = m.AnalysisRunMetadata.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VerificationInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AnalysisRun != nil {
+		l = m.AnalysisRun.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.FinishTime != nil {
+		l = m.FinishTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Actor)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *VerifiedStage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Warehouse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WarehouseList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WarehouseSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Shard)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FreightCreationPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Interval.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.PollingIntervals != nil {
+		l = m.PollingIntervals.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.FreightEqualityMode)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WarehouseStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	l = len(m.LastHandledRefresh)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.DiscoveredArtifacts != nil {
+		l = m.DiscoveredArtifacts.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.LastFreightID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastPolled != nil {
+		l = m.LastPolled.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WebhookConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.BodyTemplate)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WebhookDeliveryStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Promotion)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.DeliveredAt != nil {
+		l = m.DeliveredAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.StatusCode))
+	n += 2
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AnalysisRunArgument) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AnalysisRunArgument{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
 	}, "")
 	return s
 }
-func (this *RepoSubscription) String() string {
+func (this *AnalysisRunMetadata) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RepoSubscription{`,
-		`Git:` + strings.Replace(this.Git.String(), "GitSubscription", "GitSubscription", 1) + `,`,
-		`Image:` + strings.Replace(this.Image.String(), "ImageSubscription", "ImageSubscription", 1) + `,`,
-		`Chart:` + strings.Replace(this.Chart.String(), "ChartSubscription", "ChartSubscription", 1) + `,`,
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&AnalysisRunMetadata{`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *Stage) String() string {
+func (this *AnalysisRunReference) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Stage{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "StageSpec", "StageSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "StageStatus", "StageStatus", 1), `&`, ``, 1) + `,`,
+	s := strings.Join([]string{`&AnalysisRunReference{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *StageList) String() string {
+func (this *AnalysisTemplateReference) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForItems := "[]Stage{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Stage", "Stage", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&StageList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
+	s := strings.Join([]string{`&AnalysisTemplateReference{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *StageSpec) String() string {
+func (this *ApprovedStage) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForRequestedFreight := "[]FreightRequest{"
-	for _, f := range this.RequestedFreight {
-		repeatedStringForRequestedFreight += strings.Replace(strings.Replace(f.String(), "FreightRequest", "FreightRequest", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForRequestedFreight += "}"
-	s := strings.Join([]string{`&StageSpec{`,
-		`Subscriptions:` + strings.Replace(strings.Replace(this.Subscriptions.String(), "Subscriptions", "Subscriptions", 1), `&`, ``, 1) + `,`,
-		`PromotionMechanisms:` + strings.Replace(this.PromotionMechanisms.String(), "PromotionMechanisms", "PromotionMechanisms", 1) + `,`,
-		`Verification:` + strings.Replace(this.Verification.String(), "Verification", "Verification", 1) + `,`,
-		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
-		`RequestedFreight:` + repeatedStringForRequestedFreight + `,`,
+	s := strings.Join([]string{`&ApprovedStage{`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *StageStatus) String() string {
+func (this *ArgoCDAppHealthCheck) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForHistory := "[]FreightReference{"
-	for _, f := range this.History {
-		repeatedStringForHistory += strings.Replace(strings.Replace(f.String(), "FreightReference", "FreightReference", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForHistory += "}"
-	repeatedStringForFreightHistory := "[]*FreightCollection{"
-	for _, f := range this.FreightHistory {
-		repeatedStringForFreightHistory += strings.Replace(f.String(), "FreightCollection", "FreightCollection", 1) + ","
+	s := strings.Join([]string{`&ArgoCDAppHealthCheck{`,
+		`JSONPath:` + fmt.Sprintf("%v", this.JSONPath) + `,`,
+		`ExpectedValue:` + fmt.Sprintf("%v", this.ExpectedValue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArgoCDAppHealthStatus) String() string {
+	if this == nil {
+		return "nil"
 	}
-	repeatedStringForFreightHistory += "}"
-	s := strings.Join([]string{`&StageStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`CurrentFreight:` + strings.Replace(this.CurrentFreight.String(), "FreightReference", "FreightReference", 1) + `,`,
-		`History:` + repeatedStringForHistory + `,`,
-		`FreightHistory:` + repeatedStringForFreightHistory + `,`,
-		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
-		`CurrentPromotion:` + strings.Replace(this.CurrentPromotion.String(), "PromotionReference", "PromotionReference", 1) + `,`,
-		`Health:` + strings.Replace(this.Health.String(), "Health", "Health", 1) + `,`,
+	s := strings.Join([]string{`&ArgoCDAppHealthStatus{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`LastPromotion:` + strings.Replace(this.LastPromotion.String(), "PromotionReference", "PromotionReference", 1) + `,`,
-		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
-		`FreightSummary:` + fmt.Sprintf("%v", this.FreightSummary) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *StageSubscription) String() string {
+func (this *ArgoCDAppStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&StageSubscription{`,
+	s := strings.Join([]string{`&ArgoCDAppStatus{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`HealthStatus:` + strings.Replace(strings.Replace(this.HealthStatus.String(), "ArgoCDAppHealthStatus", "ArgoCDAppHealthStatus", 1), `&`, ``, 1) + `,`,
+		`SyncStatus:` + strings.Replace(strings.Replace(this.SyncStatus.String(), "ArgoCDAppSyncStatus", "ArgoCDAppSyncStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *Subscriptions) String() string {
+func (this *ArgoCDAppStatusCheck) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForUpstreamStages := "[]StageSubscription{"
-	for _, f := range this.UpstreamStages {
-		repeatedStringForUpstreamStages += strings.Replace(strings.Replace(f.String(), "StageSubscription", "StageSubscription", 1), `&`, ``, 1) + ","
+	s := strings.Join([]string{`&ArgoCDAppStatusCheck{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`RequiredSyncStatus:` + fmt.Sprintf("%v", this.RequiredSyncStatus) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArgoCDAppSyncStatus) String() string {
+	if this == nil {
+		return "nil"
 	}
-	repeatedStringForUpstreamStages += "}"
-	s := strings.Join([]string{`&Subscriptions{`,
-		`Warehouse:` + fmt.Sprintf("%v", this.Warehouse) + `,`,
-		`UpstreamStages:` + repeatedStringForUpstreamStages + `,`,
+	s := strings.Join([]string{`&ArgoCDAppSyncStatus{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *Verification) String() string {
+func (this *ArgoCDAppUpdate) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForAnalysisTemplates := "[]AnalysisTemplateReference{"
-	for _, f := range this.AnalysisTemplates {
-		repeatedStringForAnalysisTemplates += strings.Replace(strings.Replace(f.String(), "AnalysisTemplateReference", "AnalysisTemplateReference", 1), `&`, ``, 1) + ","
+	repeatedStringForSourceUpdates := "[]ArgoCDSourceUpdate{"
+	for _, f := range this.SourceUpdates {
+		repeatedStringForSourceUpdates += strings.Replace(strings.Replace(f.String(), "ArgoCDSourceUpdate", "ArgoCDSourceUpdate", 1), `&`, ``, 1) + ","
 	}
-	repeatedStringForAnalysisTemplates += "}"
-	repeatedStringForArgs := "[]AnalysisRunArgument{"
-	for _, f := range this.Args {
-		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "AnalysisRunArgument", "AnalysisRunArgument", 1), `&`, ``, 1) + ","
+	repeatedStringForSourceUpdates += "}"
+	s := strings.Join([]string{`&ArgoCDAppUpdate{`,
+		`AppName:` + fmt.Sprintf("%v", this.AppName) + `,`,
+		`AppNamespace:` + fmt.Sprintf("%v", this.AppNamespace) + `,`,
+		`SourceUpdates:` + repeatedStringForSourceUpdates + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
+		`HealthCheck:` + strings.Replace(this.HealthCheck.String(), "ArgoCDAppHealthCheck", "ArgoCDAppHealthCheck", 1) + `,`,
+		`ArgoCDClusterName:` + fmt.Sprintf("%v", this.ArgoCDClusterName) + `,`,
+		`ResourceType:` + fmt.Sprintf("%v", this.ResourceType) + `,`,
+		`SourceIndex:` + valueToStringGenerated(this.SourceIndex) + `,`,
+		`SyncTimeout:` + strings.Replace(fmt.Sprintf("%v", this.SyncTimeout), "Duration", "v1.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArgoCDHelm) String() string {
+	if this == nil {
+		return "nil"
 	}
-	repeatedStringForArgs += "}"
-	s := strings.Join([]string{`&Verification{`,
-		`AnalysisTemplates:` + repeatedStringForAnalysisTemplates + `,`,
-		`AnalysisRunMetadata:` + strings.Replace(this.AnalysisRunMetadata.String(), "AnalysisRunMetadata", "AnalysisRunMetadata", 1) + `,`,
-		`Args:` + repeatedStringForArgs + `,`,
+	repeatedStringForImages := "[]ArgoCDHelmImageUpdate{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "ArgoCDHelmImageUpdate", "ArgoCDHelmImageUpdate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImages += "}"
+	s := strings.Join([]string{`&ArgoCDHelm{`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *VerificationInfo) String() string {
+func (this *ArgoCDHelmImageUpdate) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&VerificationInfo{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`AnalysisRun:` + strings.Replace(this.AnalysisRun.String(), "AnalysisRunReference", "AnalysisRunReference", 1) + `,`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Time", "v1.Time", 1) + `,`,
-		`FinishTime:` + strings.Replace(fmt.Sprintf("%v", this.FinishTime), "Time", "v1.Time", 1) + `,`,
-		`Actor:` + fmt.Sprintf("%v", this.Actor) + `,`,
+	s := strings.Join([]string{`&ArgoCDHelmImageUpdate{`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *VerifiedStage) String() string {
+func (this *ArgoCDKustomize) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&VerifiedStage{`,
+	repeatedStringForImages := "[]ArgoCDKustomizeImageUpdate{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "ArgoCDKustomizeImageUpdate", "ArgoCDKustomizeImageUpdate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImages += "}"
+	s := strings.Join([]string{`&ArgoCDKustomize{`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *Warehouse) String() string {
+func (this *ArgoCDKustomizeImageUpdate) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Warehouse{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WarehouseSpec", "WarehouseSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WarehouseStatus", "WarehouseStatus", 1), `&`, ``, 1) + `,`,
+	s := strings.Join([]string{`&ArgoCDKustomizeImageUpdate{`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`UseDigest:` + fmt.Sprintf("%v", this.UseDigest) + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *WarehouseList) String() string {
+func (this *ArgoCDSourceUpdate) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForItems := "[]Warehouse{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Warehouse", "Warehouse", 1), `&`, ``, 1) + ","
+	s := strings.Join([]string{`&ArgoCDSourceUpdate{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Chart:` + fmt.Sprintf("%v", this.Chart) + `,`,
+		`UpdateTargetRevision:` + fmt.Sprintf("%v", this.UpdateTargetRevision) + `,`,
+		`Kustomize:` + strings.Replace(this.Kustomize.String(), "ArgoCDKustomize", "ArgoCDKustomize", 1) + `,`,
+		`Helm:` + strings.Replace(this.Helm.String(), "ArgoCDHelm", "ArgoCDHelm", 1) + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CanaryPromotion) String() string {
+	if this == nil {
+		return "nil"
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&WarehouseList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
+	s := strings.Join([]string{`&CanaryPromotion{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Rollout:` + fmt.Sprintf("%v", this.Rollout) + `,`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *WarehouseSpec) String() string {
+func (this *ChangeApprovalCheck) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForSubscriptions := "[]RepoSubscription{"
-	for _, f := range this.Subscriptions {
-		repeatedStringForSubscriptions += strings.Replace(strings.Replace(f.String(), "RepoSubscription", "RepoSubscription", 1), `&`, ``, 1) + ","
+	s := strings.Join([]string{`&ChangeApprovalCheck{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Chart) String() string {
+	if this == nil {
+		return "nil"
 	}
-	repeatedStringForSubscriptions += "}"
-	s := strings.Join([]string{`&WarehouseSpec{`,
-		`Subscriptions:` + repeatedStringForSubscriptions + `,`,
-		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
-		`FreightCreationPolicy:` + fmt.Sprintf("%v", this.FreightCreationPolicy) + `,`,
-		`Interval:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Interval), "Duration", "v1.Duration", 1), `&`, ``, 1) + `,`,
+	s := strings.Join([]string{`&Chart{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *WarehouseStatus) String() string {
+func (this *ChartChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChartChange{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChartDiscoveryResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChartDiscoveryResult{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
+		`Versions:` + fmt.Sprintf("%v", this.Versions) + `,`,
+		`ServedBy:` + fmt.Sprintf("%v", this.ServedBy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChartSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChartSubscription{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
+		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
+		`FallbackRepoURLs:` + fmt.Sprintf("%v", this.FallbackRepoURLs) + `,`,
+		`VerifyProvenance:` + fmt.Sprintf("%v", this.VerifyProvenance) + `,`,
+		`ProvenanceKeySecretRef:` + strings.Replace(this.ProvenanceKeySecretRef.String(), "SecretKeyReference", "SecretKeyReference", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CircuitBreaker) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CircuitBreaker{`,
+		`Threshold:` + fmt.Sprintf("%v", this.Threshold) + `,`,
+		`Cooldown:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Cooldown), "Duration", "v1.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CircuitBreakerStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CircuitBreakerStatus{`,
+		`ConsecutiveFailures:` + fmt.Sprintf("%v", this.ConsecutiveFailures) + `,`,
+		`OpenedAt:` + strings.Replace(fmt.Sprintf("%v", this.OpenedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DiscoveredArtifacts) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&WarehouseStatus{`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
-		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
-		`DiscoveredArtifacts:` + strings.Replace(this.DiscoveredArtifacts.String(), "DiscoveredArtifacts", "DiscoveredArtifacts", 1) + `,`,
-		`LastFreightID:` + fmt.Sprintf("%v", this.LastFreightID) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	repeatedStringForGit := "[]GitDiscoveryResult{"
+	for _, f := range this.Git {
+		repeatedStringForGit += strings.Replace(strings.Replace(f.String(), "GitDiscoveryResult", "GitDiscoveryResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForGit += "}"
+	repeatedStringForImages := "[]ImageDiscoveryResult{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "ImageDiscoveryResult", "ImageDiscoveryResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImages += "}"
+	repeatedStringForCharts := "[]ChartDiscoveryResult{"
+	for _, f := range this.Charts {
+		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "ChartDiscoveryResult", "ChartDiscoveryResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCharts += "}"
+	s := strings.Join([]string{`&DiscoveredArtifacts{`,
+		`Git:` + repeatedStringForGit + `,`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Charts:` + repeatedStringForCharts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DiscoveredCommit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DiscoveredCommit{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
+		`Committer:` + fmt.Sprintf("%v", this.Committer) + `,`,
+		`CreatorDate:` + strings.Replace(fmt.Sprintf("%v", this.CreatorDate), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DiscoveredImageReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DiscoveredImageReference{`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FluxHelmReleaseUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FluxHelmReleaseUpdate{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Chart:` + fmt.Sprintf("%v", this.Chart) + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Freight) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCommits := "[]GitCommit{"
+	for _, f := range this.Commits {
+		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "GitCommit", "GitCommit", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCommits += "}"
+	repeatedStringForImages := "[]Image{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "Image", "Image", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImages += "}"
+	repeatedStringForCharts := "[]Chart{"
+	for _, f := range this.Charts {
+		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "Chart", "Chart", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCharts += "}"
+	s := strings.Join([]string{`&Freight{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Commits:` + repeatedStringForCommits + `,`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Charts:` + repeatedStringForCharts + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "FreightStatus", "FreightStatus", 1), `&`, ``, 1) + `,`,
+		`Alias:` + fmt.Sprintf("%v", this.Alias) + `,`,
+		`Warehouse:` + fmt.Sprintf("%v", this.Warehouse) + `,`,
+		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightCollection) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVerificationHistory := "[]VerificationInfo{"
+	for _, f := range this.VerificationHistory {
+		repeatedStringForVerificationHistory += strings.Replace(strings.Replace(f.String(), "VerificationInfo", "VerificationInfo", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVerificationHistory += "}"
+	keysForFreight := make([]string, 0, len(this.Freight))
+	for k := range this.Freight {
+		keysForFreight = append(keysForFreight, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFreight)
+	mapStringForFreight := "map[string]FreightReference{"
+	for _, k := range keysForFreight {
+		mapStringForFreight += fmt.Sprintf("%v: %v,", k, this.Freight[k])
+	}
+	mapStringForFreight += "}"
+	s := strings.Join([]string{`&FreightCollection{`,
+		`Freight:` + mapStringForFreight + `,`,
+		`VerificationHistory:` + repeatedStringForVerificationHistory + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightCollectionDelta) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FreightCollectionDelta{`,
+		`Baseline:` + strings.Replace(this.Baseline.String(), "FreightCollection", "FreightCollection", 1) + `,`,
+		`Patch:` + fmt.Sprintf("%v", this.Patch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Freight{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Freight", "Freight", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&FreightList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCommits := "[]GitCommit{"
+	for _, f := range this.Commits {
+		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "GitCommit", "GitCommit", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCommits += "}"
+	repeatedStringForImages := "[]Image{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "Image", "Image", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImages += "}"
+	repeatedStringForCharts := "[]Chart{"
+	for _, f := range this.Charts {
+		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "Chart", "Chart", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCharts += "}"
+	repeatedStringForVerificationHistory := "[]VerificationInfo{"
+	for _, f := range this.VerificationHistory {
+		repeatedStringForVerificationHistory += strings.Replace(strings.Replace(f.String(), "VerificationInfo", "VerificationInfo", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVerificationHistory += "}"
+	s := strings.Join([]string{`&FreightReference{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Commits:` + repeatedStringForCommits + `,`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Charts:` + repeatedStringForCharts + `,`,
+		`VerificationInfo:` + strings.Replace(this.VerificationInfo.String(), "VerificationInfo", "VerificationInfo", 1) + `,`,
+		`Warehouse:` + fmt.Sprintf("%v", this.Warehouse) + `,`,
+		`VerificationHistory:` + repeatedStringForVerificationHistory + `,`,
+		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FreightRequest{`,
+		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
+		`Sources:` + strings.Replace(strings.Replace(this.Sources.String(), "FreightSources", "FreightSources", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightSources) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FreightSources{`,
+		`Direct:` + fmt.Sprintf("%v", this.Direct) + `,`,
+		`Stages:` + fmt.Sprintf("%v", this.Stages) + `,`,
+		`AvailabilityStrategy:` + fmt.Sprintf("%v", this.AvailabilityStrategy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForVerifiedIn := make([]string, 0, len(this.VerifiedIn))
+	for k := range this.VerifiedIn {
+		keysForVerifiedIn = append(keysForVerifiedIn, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForVerifiedIn)
+	mapStringForVerifiedIn := "map[string]VerifiedStage{"
+	for _, k := range keysForVerifiedIn {
+		mapStringForVerifiedIn += fmt.Sprintf("%v: %v,", k, this.VerifiedIn[k])
+	}
+	mapStringForVerifiedIn += "}"
+	keysForApprovedFor := make([]string, 0, len(this.ApprovedFor))
+	for k := range this.ApprovedFor {
+		keysForApprovedFor = append(keysForApprovedFor, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForApprovedFor)
+	mapStringForApprovedFor := "map[string]ApprovedStage{"
+	for _, k := range keysForApprovedFor {
+		mapStringForApprovedFor += fmt.Sprintf("%v: %v,", k, this.ApprovedFor[k])
+	}
+	mapStringForApprovedFor += "}"
+	s := strings.Join([]string{`&FreightStatus{`,
+		`VerifiedIn:` + mapStringForVerifiedIn + `,`,
+		`ApprovedFor:` + mapStringForApprovedFor + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitCommit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitCommit{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`HealthCheckCommit:` + fmt.Sprintf("%v", this.HealthCheckCommit) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
+		`Committer:` + fmt.Sprintf("%v", this.Committer) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitDiscoveryResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCommits := "[]DiscoveredCommit{"
+	for _, f := range this.Commits {
+		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "DiscoveredCommit", "DiscoveredCommit", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCommits += "}"
+	s := strings.Join([]string{`&GitDiscoveryResult{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Commits:` + repeatedStringForCommits + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitHubPullRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitHubPullRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitLabPullRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitLabPullRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitRepoUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitRepoUpdate{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
+		`ReadBranch:` + fmt.Sprintf("%v", this.ReadBranch) + `,`,
+		`WriteBranch:` + fmt.Sprintf("%v", this.WriteBranch) + `,`,
+		`PullRequest:` + strings.Replace(this.PullRequest.String(), "PullRequestPromotionMechanism", "PullRequestPromotionMechanism", 1) + `,`,
+		`Render:` + strings.Replace(this.Render.String(), "KargoRenderPromotionMechanism", "KargoRenderPromotionMechanism", 1) + `,`,
+		`Kustomize:` + strings.Replace(this.Kustomize.String(), "KustomizePromotionMechanism", "KustomizePromotionMechanism", 1) + `,`,
+		`Helm:` + strings.Replace(this.Helm.String(), "HelmPromotionMechanism", "HelmPromotionMechanism", 1) + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
+		`CommitMessageTemplate:` + fmt.Sprintf("%v", this.CommitMessageTemplate) + `,`,
+		`SigningKeySecretRef:` + strings.Replace(this.SigningKeySecretRef.String(), "SecretKeyReference", "SecretKeyReference", 1) + `,`,
+		`AuthorName:` + fmt.Sprintf("%v", this.AuthorName) + `,`,
+		`AuthorEmail:` + fmt.Sprintf("%v", this.AuthorEmail) + `,`,
+		`ShallowCloneDepth:` + fmt.Sprintf("%v", this.ShallowCloneDepth) + `,`,
+		`SOPSDecryptBeforeApply:` + fmt.Sprintf("%v", this.SOPSDecryptBeforeApply) + `,`,
+		`SOPSAgeKeySecretRef:` + strings.Replace(this.SOPSAgeKeySecretRef.String(), "SecretKeyReference", "SecretKeyReference", 1) + `,`,
+		`EditOrder:` + fmt.Sprintf("%v", this.EditOrder) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitSubscription{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`CommitSelectionStrategy:` + fmt.Sprintf("%v", this.CommitSelectionStrategy) + `,`,
+		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
+		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
+		`AllowTags:` + fmt.Sprintf("%v", this.AllowTags) + `,`,
+		`IgnoreTags:` + fmt.Sprintf("%v", this.IgnoreTags) + `,`,
+		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
+		`IncludePaths:` + fmt.Sprintf("%v", this.IncludePaths) + `,`,
+		`ExcludePaths:` + fmt.Sprintf("%v", this.ExcludePaths) + `,`,
+		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
+		`ShallowCloneDepth:` + fmt.Sprintf("%v", this.ShallowCloneDepth) + `,`,
+		`Branches:` + fmt.Sprintf("%v", this.Branches) + `,`,
+		`KnownHostsConfigMapRef:` + strings.Replace(fmt.Sprintf("%v", this.KnownHostsConfigMapRef), "LocalObjectReference", "v11.LocalObjectReference", 1) + `,`,
+		`SparseCheckoutPaths:` + fmt.Sprintf("%v", this.SparseCheckoutPaths) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPHealthCheck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPHealthCheck{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`ExpectedStatusCode:` + fmt.Sprintf("%v", this.ExpectedStatusCode) + `,`,
+		`TimeoutSeconds:` + fmt.Sprintf("%v", this.TimeoutSeconds) + `,`,
+		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Health) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForArgoCDApps := "[]ArgoCDAppStatus{"
+	for _, f := range this.ArgoCDApps {
+		repeatedStringForArgoCDApps += strings.Replace(strings.Replace(f.String(), "ArgoCDAppStatus", "ArgoCDAppStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgoCDApps += "}"
+	s := strings.Join([]string{`&Health{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Issues:` + fmt.Sprintf("%v", this.Issues) + `,`,
+		`ArgoCDApps:` + repeatedStringForArgoCDApps + `,`,
+		`CheckedAt:` + strings.Replace(fmt.Sprintf("%v", this.CheckedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HealthChecks) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHTTPChecks := "[]HTTPHealthCheck{"
+	for _, f := range this.HTTPChecks {
+		repeatedStringForHTTPChecks += strings.Replace(strings.Replace(f.String(), "HTTPHealthCheck", "HTTPHealthCheck", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHTTPChecks += "}"
+	repeatedStringForArgoCDApps := "[]ArgoCDAppStatusCheck{"
+	for _, f := range this.ArgoCDApps {
+		repeatedStringForArgoCDApps += strings.Replace(strings.Replace(f.String(), "ArgoCDAppStatusCheck", "ArgoCDAppStatusCheck", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgoCDApps += "}"
+	repeatedStringForPodImages := "[]PodImageHealthCheck{"
+	for _, f := range this.PodImages {
+		repeatedStringForPodImages += strings.Replace(strings.Replace(f.String(), "PodImageHealthCheck", "PodImageHealthCheck", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPodImages += "}"
+	s := strings.Join([]string{`&HealthChecks{`,
+		`HTTPChecks:` + repeatedStringForHTTPChecks + `,`,
+		`ArgoCDApps:` + repeatedStringForArgoCDApps + `,`,
+		`PodImages:` + repeatedStringForPodImages + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HelmChartDependencyUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HelmChartDependencyUpdate{`,
+		`Repository:` + fmt.Sprintf("%v", this.Repository) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ChartPath:` + fmt.Sprintf("%v", this.ChartPath) + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HelmImageUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HelmImageUpdate{`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`ValuesFilePath:` + fmt.Sprintf("%v", this.ValuesFilePath) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HelmPromotionMechanism) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForImages := "[]HelmImageUpdate{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "HelmImageUpdate", "HelmImageUpdate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImages += "}"
+	repeatedStringForCharts := "[]HelmChartDependencyUpdate{"
+	for _, f := range this.Charts {
+		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "HelmChartDependencyUpdate", "HelmChartDependencyUpdate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCharts += "}"
+	repeatedStringForValuesFiles := "[]HelmValuesFileUpdate{"
+	for _, f := range this.ValuesFiles {
+		repeatedStringForValuesFiles += strings.Replace(strings.Replace(f.String(), "HelmValuesFileUpdate", "HelmValuesFileUpdate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForValuesFiles += "}"
+	s := strings.Join([]string{`&HelmPromotionMechanism{`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Charts:` + repeatedStringForCharts + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
+		`ValuesFilePath:` + fmt.Sprintf("%v", this.ValuesFilePath) + `,`,
+		`ValuesOverrides:` + strings.Replace(fmt.Sprintf("%v", this.ValuesOverrides), "RawExtension", "runtime.RawExtension", 1) + `,`,
+		`UmbrellaChartPath:` + fmt.Sprintf("%v", this.UmbrellaChartPath) + `,`,
+		`ValuesFiles:` + repeatedStringForValuesFiles + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HelmValuesFileUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForUpdates := make([]string, 0, len(this.Updates))
+	for k := range this.Updates {
+		keysForUpdates = append(keysForUpdates, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUpdates)
+	mapStringForUpdates := "map[string]string{"
+	for _, k := range keysForUpdates {
+		mapStringForUpdates += fmt.Sprintf("%v: %v,", k, this.Updates[k])
+	}
+	mapStringForUpdates += "}"
+	s := strings.Join([]string{`&HelmValuesFileUpdate{`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Updates:` + mapStringForUpdates + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Image) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Image{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageDiscoveryResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReferences := "[]DiscoveredImageReference{"
+	for _, f := range this.References {
+		repeatedStringForReferences += strings.Replace(strings.Replace(f.String(), "DiscoveredImageReference", "DiscoveredImageReference", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForReferences += "}"
+	s := strings.Join([]string{`&ImageDiscoveryResult{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
+		`References:` + repeatedStringForReferences + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImagePullCheck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImagePullCheck{`,
+		`PullSecret:` + fmt.Sprintf("%v", this.PullSecret) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageSubscription{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
+		`ImageSelectionStrategy:` + fmt.Sprintf("%v", this.ImageSelectionStrategy) + `,`,
+		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
+		`AllowTags:` + fmt.Sprintf("%v", this.AllowTags) + `,`,
+		`IgnoreTags:` + fmt.Sprintf("%v", this.IgnoreTags) + `,`,
+		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
+		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
+		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
+		`DenyTags:` + fmt.Sprintf("%v", this.DenyTags) + `,`,
+		`CABundleConfigMapRef:` + strings.Replace(fmt.Sprintf("%v", this.CABundleConfigMapRef), "LocalObjectReference", "v11.LocalObjectReference", 1) + `,`,
+		`LatestByBuildDate:` + fmt.Sprintf("%v", this.LatestByBuildDate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobTemplate{`,
+		`Template:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Template), "JobTemplateSpec", "v12.JobTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KargoRenderImageUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KargoRenderImageUpdate{`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`UseDigest:` + fmt.Sprintf("%v", this.UseDigest) + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KargoRenderPromotionMechanism) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForImages := "[]KargoRenderImageUpdate{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "KargoRenderImageUpdate", "KargoRenderImageUpdate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImages += "}"
+	s := strings.Join([]string{`&KargoRenderPromotionMechanism{`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KubernetesResourceUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KubernetesResourceUpdate{`,
+		`APIVersion:` + fmt.Sprintf("%v", this.APIVersion) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`JSONPatch:` + fmt.Sprintf("%v", this.JSONPatch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KustomizeImageUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KustomizeImageUpdate{`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`UseDigest:` + fmt.Sprintf("%v", this.UseDigest) + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
+		`StripRegistryHost:` + fmt.Sprintf("%v", this.StripRegistryHost) + `,`,
+		`ReplacementSource:` + strings.Replace(this.ReplacementSource.String(), "KustomizeReplacementSource", "KustomizeReplacementSource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KustomizePromotionMechanism) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForImages := "[]KustomizeImageUpdate{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "KustomizeImageUpdate", "KustomizeImageUpdate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImages += "}"
+	s := strings.Join([]string{`&KustomizePromotionMechanism{`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
+		`Components:` + fmt.Sprintf("%v", this.Components) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KustomizeReplacementSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KustomizeReplacementSource{`,
+		`ConfigMap:` + fmt.Sprintf("%v", this.ConfigMap) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodImageHealthCheck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodImageHealthCheck{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Selector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PollingIntervals) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PollingIntervals{`,
+		`GitIntervalSeconds:` + fmt.Sprintf("%v", this.GitIntervalSeconds) + `,`,
+		`ImageIntervalSeconds:` + fmt.Sprintf("%v", this.ImageIntervalSeconds) + `,`,
+		`ChartIntervalSeconds:` + fmt.Sprintf("%v", this.ChartIntervalSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Project) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Project{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(this.Spec.String(), "ProjectSpec", "ProjectSpec", 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ProjectStatus", "ProjectStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Project{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Project", "Project", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ProjectList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPromotionPolicies := "[]PromotionPolicy{"
+	for _, f := range this.PromotionPolicies {
+		repeatedStringForPromotionPolicies += strings.Replace(strings.Replace(f.String(), "PromotionPolicy", "PromotionPolicy", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPromotionPolicies += "}"
+	s := strings.Join([]string{`&ProjectSpec{`,
+		`PromotionPolicies:` + repeatedStringForPromotionPolicies + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Promotion) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Promotion{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionSpec", "PromotionSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "PromotionStatus", "PromotionStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Promotion{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Promotion", "Promotion", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&PromotionList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionMechanisms) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForGitRepoUpdates := "[]GitRepoUpdate{"
+	for _, f := range this.GitRepoUpdates {
+		repeatedStringForGitRepoUpdates += strings.Replace(strings.Replace(f.String(), "GitRepoUpdate", "GitRepoUpdate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForGitRepoUpdates += "}"
+	repeatedStringForArgoCDAppUpdates := "[]ArgoCDAppUpdate{"
+	for _, f := range this.ArgoCDAppUpdates {
+		repeatedStringForArgoCDAppUpdates += strings.Replace(strings.Replace(f.String(), "ArgoCDAppUpdate", "ArgoCDAppUpdate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgoCDAppUpdates += "}"
+	repeatedStringForFluxHelmReleaseUpdates := "[]FluxHelmReleaseUpdate{"
+	for _, f := range this.FluxHelmReleaseUpdates {
+		repeatedStringForFluxHelmReleaseUpdates += strings.Replace(strings.Replace(f.String(), "FluxHelmReleaseUpdate", "FluxHelmReleaseUpdate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForFluxHelmReleaseUpdates += "}"
+	repeatedStringForKubernetesResourceUpdates := "[]KubernetesResourceUpdate{"
+	for _, f := range this.KubernetesResourceUpdates {
+		repeatedStringForKubernetesResourceUpdates += strings.Replace(strings.Replace(f.String(), "KubernetesResourceUpdate", "KubernetesResourceUpdate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForKubernetesResourceUpdates += "}"
+	s := strings.Join([]string{`&PromotionMechanisms{`,
+		`GitRepoUpdates:` + repeatedStringForGitRepoUpdates + `,`,
+		`ArgoCDAppUpdates:` + repeatedStringForArgoCDAppUpdates + `,`,
+		`Origin:` + strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1) + `,`,
+		`ChangeApproval:` + strings.Replace(this.ChangeApproval.String(), "ChangeApprovalCheck", "ChangeApprovalCheck", 1) + `,`,
+		`ImagePullCheck:` + strings.Replace(this.ImagePullCheck.String(), "ImagePullCheck", "ImagePullCheck", 1) + `,`,
+		`FluxHelmReleaseUpdates:` + repeatedStringForFluxHelmReleaseUpdates + `,`,
+		`KubernetesResourceUpdates:` + repeatedStringForKubernetesResourceUpdates + `,`,
+		`Canary:` + strings.Replace(this.Canary.String(), "CanaryPromotion", "CanaryPromotion", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionPolicy{`,
+		`Stage:` + fmt.Sprintf("%v", this.Stage) + `,`,
+		`AutoPromotionEnabled:` + fmt.Sprintf("%v", this.AutoPromotionEnabled) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionReference{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Freight:` + strings.Replace(this.Freight.String(), "FreightReference", "FreightReference", 1) + `,`,
+		`Status:` + strings.Replace(this.Status.String(), "PromotionStatus", "PromotionStatus", 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionSpec{`,
+		`Stage:` + fmt.Sprintf("%v", this.Stage) + `,`,
+		`Freight:` + fmt.Sprintf("%v", this.Freight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	repeatedStringForChartChanges := "[]ChartChange{"
+	for _, f := range this.ChartChanges {
+		repeatedStringForChartChanges += strings.Replace(strings.Replace(f.String(), "ChartChange", "ChartChange", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForChartChanges += "}"
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	s := strings.Join([]string{`&PromotionStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
+		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
+		`Freight:` + strings.Replace(this.Freight.String(), "FreightReference", "FreightReference", 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`FreightCollection:` + strings.Replace(this.FreightCollection.String(), "FreightCollection", "FreightCollection", 1) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`ChartChanges:` + repeatedStringForChartChanges + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionTemplate{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionTemplateSpec", "PromotionTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionTemplateList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]PromotionTemplate{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "PromotionTemplate", "PromotionTemplate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&PromotionTemplateList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionTemplateSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionTemplateSpec{`,
+		`PromotionMechanisms:` + strings.Replace(strings.Replace(this.PromotionMechanisms.String(), "PromotionMechanisms", "PromotionMechanisms", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PullRequestPromotionMechanism) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PullRequestPromotionMechanism{`,
+		`GitHub:` + strings.Replace(this.GitHub.String(), "GitHubPullRequest", "GitHubPullRequest", 1) + `,`,
+		`GitLab:` + strings.Replace(this.GitLab.String(), "GitLabPullRequest", "GitLabPullRequest", 1) + `,`,
+		`DeleteBranchOnMerge:` + fmt.Sprintf("%v", this.DeleteBranchOnMerge) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RepoSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RepoSubscription{`,
+		`Git:` + strings.Replace(this.Git.String(), "GitSubscription", "GitSubscription", 1) + `,`,
+		`Image:` + strings.Replace(this.Image.String(), "ImageSubscription", "ImageSubscription", 1) + `,`,
+		`Chart:` + strings.Replace(this.Chart.String(), "ChartSubscription", "ChartSubscription", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SecretKeyReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SecretKeyReference{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Stage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Stage{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "StageSpec", "StageSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "StageStatus", "StageStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Stage{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Stage", "Stage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&StageList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageRole) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StageRole{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "StageRoleSpec", "StageRoleSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageRoleList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]StageRole{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "StageRole", "StageRole", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&StageRoleList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageRoleSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSubjects := "[]Subject{"
+	for _, f := range this.Subjects {
+		repeatedStringForSubjects += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForSubjects += "}"
+	s := strings.Join([]string{`&StageRoleSpec{`,
+		`Stage:` + fmt.Sprintf("%v", this.Stage) + `,`,
+		`Subjects:` + repeatedStringForSubjects + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRequestedFreight := "[]FreightRequest{"
+	for _, f := range this.RequestedFreight {
+		repeatedStringForRequestedFreight += strings.Replace(strings.Replace(f.String(), "FreightRequest", "FreightRequest", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRequestedFreight += "}"
+	repeatedStringForNotificationWebhooks := "[]WebhookConfig{"
+	for _, f := range this.NotificationWebhooks {
+		repeatedStringForNotificationWebhooks += strings.Replace(strings.Replace(f.String(), "WebhookConfig", "WebhookConfig", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForNotificationWebhooks += "}"
+	s := strings.Join([]string{`&StageSpec{`,
+		`Subscriptions:` + strings.Replace(strings.Replace(this.Subscriptions.String(), "Subscriptions", "Subscriptions", 1), `&`, ``, 1) + `,`,
+		`PromotionMechanisms:` + strings.Replace(this.PromotionMechanisms.String(), "PromotionMechanisms", "PromotionMechanisms", 1) + `,`,
+		`Verification:` + strings.Replace(this.Verification.String(), "Verification", "Verification", 1) + `,`,
+		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
+		`RequestedFreight:` + repeatedStringForRequestedFreight + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`FreightHistoryLimit:` + fmt.Sprintf("%v", this.FreightHistoryLimit) + `,`,
+		`NotificationWebhooks:` + repeatedStringForNotificationWebhooks + `,`,
+		`ConcurrencyPolicy:` + fmt.Sprintf("%v", this.ConcurrencyPolicy) + `,`,
+		`PromotionTimeout:` + strings.Replace(fmt.Sprintf("%v", this.PromotionTimeout), "Duration", "v1.Duration", 1) + `,`,
+		`CircuitBreaker:` + strings.Replace(this.CircuitBreaker.String(), "CircuitBreaker", "CircuitBreaker", 1) + `,`,
+		`HealthChecks:` + strings.Replace(this.HealthChecks.String(), "HealthChecks", "HealthChecks", 1) + `,`,
+		`PrePromotionHook:` + strings.Replace(this.PrePromotionHook.String(), "JobTemplate", "JobTemplate", 1) + `,`,
+		`PostPromotionHook:` + strings.Replace(this.PostPromotionHook.String(), "JobTemplate", "JobTemplate", 1) + `,`,
+		`Pipeline:` + fmt.Sprintf("%v", this.Pipeline) + `,`,
+		`Wave:` + fmt.Sprintf("%v", this.Wave) + `,`,
+		`PromotionTemplateRef:` + strings.Replace(fmt.Sprintf("%v", this.PromotionTemplateRef), "LocalObjectReference", "v11.LocalObjectReference", 1) + `,`,
+		`AutoPromotionExpression:` + fmt.Sprintf("%v", this.AutoPromotionExpression) + `,`,
+		`PinnedFreight:` + fmt.Sprintf("%v", this.PinnedFreight) + `,`,
+		`PromotionSchedule:` + fmt.Sprintf("%v", this.PromotionSchedule) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHistory := "[]FreightReference{"
+	for _, f := range this.History {
+		repeatedStringForHistory += strings.Replace(strings.Replace(f.String(), "FreightReference", "FreightReference", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHistory += "}"
+	repeatedStringForFreightHistory := "[]*FreightCollection{"
+	for _, f := range this.FreightHistory {
+		repeatedStringForFreightHistory += strings.Replace(f.String(), "FreightCollection", "FreightCollection", 1) + ","
+	}
+	repeatedStringForFreightHistory += "}"
+	repeatedStringForNotificationWebhooks := "[]WebhookDeliveryStatus{"
+	for _, f := range this.NotificationWebhooks {
+		repeatedStringForNotificationWebhooks += strings.Replace(strings.Replace(f.String(), "WebhookDeliveryStatus", "WebhookDeliveryStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForNotificationWebhooks += "}"
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	repeatedStringForFreightHistoryDeltas := "[]FreightCollectionDelta{"
+	for _, f := range this.FreightHistoryDeltas {
+		repeatedStringForFreightHistoryDeltas += strings.Replace(strings.Replace(f.String(), "FreightCollectionDelta", "FreightCollectionDelta", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForFreightHistoryDeltas += "}"
+	s := strings.Join([]string{`&StageStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`CurrentFreight:` + strings.Replace(this.CurrentFreight.String(), "FreightReference", "FreightReference", 1) + `,`,
+		`History:` + repeatedStringForHistory + `,`,
+		`FreightHistory:` + repeatedStringForFreightHistory + `,`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`CurrentPromotion:` + strings.Replace(this.CurrentPromotion.String(), "PromotionReference", "PromotionReference", 1) + `,`,
+		`Health:` + strings.Replace(this.Health.String(), "Health", "Health", 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`LastPromotion:` + strings.Replace(this.LastPromotion.String(), "PromotionReference", "PromotionReference", 1) + `,`,
+		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
+		`FreightSummary:` + fmt.Sprintf("%v", this.FreightSummary) + `,`,
+		`NotificationWebhooks:` + repeatedStringForNotificationWebhooks + `,`,
+		`CircuitBreaker:` + strings.Replace(this.CircuitBreaker.String(), "CircuitBreakerStatus", "CircuitBreakerStatus", 1) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`NextScheduledPromotion:` + strings.Replace(fmt.Sprintf("%v", this.NextScheduledPromotion), "Time", "v1.Time", 1) + `,`,
+		`FreightHistoryDeltas:` + repeatedStringForFreightHistoryDeltas + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StageSubscription{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SubscriptionPollTimes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SubscriptionPollTimes{`,
+		`Git:` + strings.Replace(fmt.Sprintf("%v", this.Git), "Time", "v1.Time", 1) + `,`,
+		`Image:` + strings.Replace(fmt.Sprintf("%v", this.Image), "Time", "v1.Time", 1) + `,`,
+		`Chart:` + strings.Replace(fmt.Sprintf("%v", this.Chart), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Subscriptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForUpstreamStages := "[]StageSubscription{"
+	for _, f := range this.UpstreamStages {
+		repeatedStringForUpstreamStages += strings.Replace(strings.Replace(f.String(), "StageSubscription", "StageSubscription", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForUpstreamStages += "}"
+	s := strings.Join([]string{`&Subscriptions{`,
+		`Warehouse:` + fmt.Sprintf("%v", this.Warehouse) + `,`,
+		`UpstreamStages:` + repeatedStringForUpstreamStages + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Verification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAnalysisTemplates := "[]AnalysisTemplateReference{"
+	for _, f := range this.AnalysisTemplates {
+		repeatedStringForAnalysisTemplates += strings.Replace(strings.Replace(f.String(), "AnalysisTemplateReference", "AnalysisTemplateReference", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAnalysisTemplates += "}"
+	repeatedStringForArgs := "[]AnalysisRunArgument{"
+	for _, f := range this.Args {
+		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "AnalysisRunArgument", "AnalysisRunArgument", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgs += "}"
+	s := strings.Join([]string{`&Verification{`,
+		`AnalysisTemplates:` + repeatedStringForAnalysisTemplates + `,`,
+		`AnalysisRunMetadata:` + strings.Replace(this.AnalysisRunMetadata.String(), "AnalysisRunMetadata", "AnalysisRunMetadata", 1) + `,`,
+		`Args:` + repeatedStringForArgs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerificationInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerificationInfo{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`AnalysisRun:` + strings.Replace(this.AnalysisRun.String(), "AnalysisRunReference", "AnalysisRunReference", 1) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Time", "v1.Time", 1) + `,`,
+		`FinishTime:` + strings.Replace(fmt.Sprintf("%v", this.FinishTime), "Time", "v1.Time", 1) + `,`,
+		`Actor:` + fmt.Sprintf("%v", this.Actor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifiedStage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifiedStage{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Warehouse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Warehouse{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WarehouseSpec", "WarehouseSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WarehouseStatus", "WarehouseStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WarehouseList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Warehouse{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Warehouse", "Warehouse", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&WarehouseList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WarehouseSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSubscriptions := "[]RepoSubscription{"
+	for _, f := range this.Subscriptions {
+		repeatedStringForSubscriptions += strings.Replace(strings.Replace(f.String(), "RepoSubscription", "RepoSubscription", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSubscriptions += "}"
+	s := strings.Join([]string{`&WarehouseSpec{`,
+		`Subscriptions:` + repeatedStringForSubscriptions + `,`,
+		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
+		`FreightCreationPolicy:` + fmt.Sprintf("%v", this.FreightCreationPolicy) + `,`,
+		`Interval:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Interval), "Duration", "v1.Duration", 1), `&`, ``, 1) + `,`,
+		`PollingIntervals:` + strings.Replace(this.PollingIntervals.String(), "PollingIntervals", "PollingIntervals", 1) + `,`,
+		`FreightEqualityMode:` + fmt.Sprintf("%v", this.FreightEqualityMode) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WarehouseStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WarehouseStatus{`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
+		`DiscoveredArtifacts:` + strings.Replace(this.DiscoveredArtifacts.String(), "DiscoveredArtifacts", "DiscoveredArtifacts", 1) + `,`,
+		`LastFreightID:` + fmt.Sprintf("%v", this.LastFreightID) + `,`,
+		`LastPolled:` + strings.Replace(this.LastPolled.String(), "SubscriptionPollTimes", "SubscriptionPollTimes", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&WebhookConfig{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`BodyTemplate:` + fmt.Sprintf("%v", this.BodyTemplate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookDeliveryStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WebhookDeliveryStatus{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Promotion:` + fmt.Sprintf("%v", this.Promotion) + `,`,
+		`DeliveredAt:` + strings.Replace(fmt.Sprintf("%v", this.DeliveredAt), "Time", "v1.Time", 1) + `,`,
+		`StatusCode:` + fmt.Sprintf("%v", this.StatusCode) + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunArgument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunArgument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisTemplateReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisTemplateReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApprovedStage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApprovedStage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApprovedStage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDAppHealthCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDAppHealthCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDAppHealthCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDAppHealthStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDAppHealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ArgoCDAppHealthState(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDAppStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDAppStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HealthStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SyncStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDAppStatusCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDAppStatusCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDAppStatusCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredSyncStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredSyncStatus = ArgoCDAppSyncState(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDAppSyncStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDAppSyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ArgoCDAppSyncState(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDAppUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDAppUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDAppUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceUpdates = append(m.SourceUpdates, ArgoCDSourceUpdate{})
+			if err := m.SourceUpdates[len(m.SourceUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Origin == nil {
+				m.Origin = &FreightOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthCheck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HealthCheck == nil {
+				m.HealthCheck = &ArgoCDAppHealthCheck{}
+			}
+			if err := m.HealthCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArgoCDClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArgoCDClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceType = ArgoCDResourceType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceIndex", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SourceIndex = &v
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncTimeout == nil {
+				m.SyncTimeout = &v1.Duration{}
+			}
+			if err := m.SyncTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDHelm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDHelm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDHelm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, ArgoCDHelmImageUpdate{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Origin == nil {
+				m.Origin = &FreightOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDHelmImageUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDHelmImageUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDHelmImageUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = ImageUpdateValueType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Origin == nil {
+				m.Origin = &FreightOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDKustomize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDKustomize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDKustomize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, ArgoCDKustomizeImageUpdate{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Origin == nil {
+				m.Origin = &FreightOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDKustomizeImageUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDKustomizeImageUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDKustomizeImageUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDigest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseDigest = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Origin == nil {
+				m.Origin = &FreightOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDSourceUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDSourceUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDSourceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chart = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTargetRevision", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UpdateTargetRevision = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kustomize == nil {
+				m.Kustomize = &ArgoCDKustomize{}
+			}
+			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Helm == nil {
+				m.Helm = &ArgoCDHelm{}
+			}
+			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Origin == nil {
+				m.Origin = &FreightOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanaryPromotion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanaryPromotion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanaryPromotion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rollout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeApprovalCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeApprovalCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeApprovalCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Chart) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Chart: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Chart: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartDiscoveryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
+			}
+			m.DiscoveryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscoveryLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackRepoURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackRepoURLs = append(m.FallbackRepoURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyProvenance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VerifyProvenance = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvenanceKeySecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProvenanceKeySecretRef == nil {
+				m.ProvenanceKeySecretRef = &SecretKeyReference{}
+			}
+			if err := m.ProvenanceKeySecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cooldown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OpenedAt == nil {
+				m.OpenedAt = &v1.Time{}
+			}
+			if err := m.OpenedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscoveredArtifacts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscoveredArtifacts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Git = append(m.Git, GitDiscoveryResult{})
+			if err := m.Git[len(m.Git)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, ImageDiscoveryResult{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Charts = append(m.Charts, ChartDiscoveryResult{})
+			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscoveredCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscoveredCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Committer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatorDate == nil {
+				m.CreatorDate = &v1.Time{}
+			}
+			if err := m.CreatorDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscoveredImageReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscoveredImageReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &v1.Time{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FluxHelmReleaseUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FluxHelmReleaseUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FluxHelmReleaseUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chart = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Origin == nil {
+				m.Origin = &FreightOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Freight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Freight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Freight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commits = append(m.Commits, GitCommit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, Image{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Charts = append(m.Charts, Chart{})
+			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alias = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warehouse", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warehouse = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightCollection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightCollection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightCollection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Freight == nil {
+				m.Freight = make(map[string]FreightReference)
+			}
+			var mapkey string
+			mapvalue := &FreightReference{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &FreightReference{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Freight[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationHistory = append(m.VerificationHistory, VerificationInfo{})
+			if err := m.VerificationHistory[len(m.VerificationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightCollectionDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightCollectionDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightCollectionDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Baseline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Baseline == nil {
+				m.Baseline = &FreightCollection{}
+			}
+			if err := m.Baseline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Freight{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightOrigin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = FreightOriginKind(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commits = append(m.Commits, GitCommit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, Image{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Charts = append(m.Charts, Chart{})
+			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VerificationInfo == nil {
+				m.VerificationInfo = &VerificationInfo{}
+			}
+			if err := m.VerificationInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warehouse", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warehouse = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationHistory = append(m.VerificationHistory, VerificationInfo{})
+			if err := m.VerificationHistory[len(m.VerificationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightSources) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightSources: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightSources: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direct", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Direct = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stages = append(m.Stages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailabilityStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvailabilityStrategy = FreightAvailabilityStrategy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VerifiedIn == nil {
+				m.VerifiedIn = make(map[string]VerifiedStage)
+			}
+			var mapkey string
+			mapvalue := &VerifiedStage{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &VerifiedStage{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.VerifiedIn[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedFor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApprovedFor == nil {
+				m.ApprovedFor = make(map[string]ApprovedStage)
+			}
+			var mapkey string
+			mapvalue := &ApprovedStage{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ApprovedStage{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ApprovedFor[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
+func (m *GitCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8790,15 +19074,15 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunArgument: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunArgument: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8826,11 +19110,11 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8858,63 +19142,77 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthCheckCommit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8924,124 +19222,61 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			m.HealthCheckCommit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9051,118 +19286,55 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Committer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9185,7 +19357,7 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
+func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9208,15 +19380,15 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitDiscoveryResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9244,13 +19416,13 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9260,55 +19432,25 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Commits = append(m.Commits, DiscoveredCommit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Phase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9331,7 +19473,7 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
+func (m *GitHubPullRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9354,44 +19496,12 @@ func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisTemplateReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitHubPullRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisTemplateReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitHubPullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9413,7 +19523,7 @@ func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApprovedStage) Unmarshal(dAtA []byte) error {
+func (m *GitLabPullRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9436,10 +19546,10 @@ func (m *ApprovedStage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApprovedStage: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitLabPullRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApprovedStage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitLabPullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9463,7 +19573,7 @@ func (m *ApprovedStage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
+func (m *GitRepoUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9486,15 +19596,15 @@ func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDAppHealthStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitRepoUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDAppHealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitRepoUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9522,11 +19632,31 @@ func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ArgoCDAppHealthState(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureSkipTLSVerify = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9554,63 +19684,153 @@ func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.ReadBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WriteBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &PullRequestPromotionMechanism{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Render", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.Render == nil {
+				m.Render = &KargoRenderPromotionMechanism{}
 			}
-			if iNdEx >= l {
+			if err := m.Render.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Kustomize == nil {
+				m.Kustomize = &KustomizePromotionMechanism{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDAppStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDAppStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9620,27 +19840,67 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Helm == nil {
+				m.Helm = &HelmPromotionMechanism{}
+			}
+			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.Origin == nil {
+				m.Origin = &FreightOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMessageTemplate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9668,11 +19928,11 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.CommitMessageTemplate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKeySecretRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9699,15 +19959,18 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HealthStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SigningKeySecretRef == nil {
+				m.SigningKeySecretRef = &SecretKeyReference{}
+			}
+			if err := m.SigningKeySecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9717,78 +19980,27 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SyncStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AuthorName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDAppSyncStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDAppSyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorEmail", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9816,13 +20028,52 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ArgoCDAppSyncState(dAtA[iNdEx:postIndex])
+			m.AuthorEmail = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShallowCloneDepth", wireType)
+			}
+			m.ShallowCloneDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShallowCloneDepth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SOPSDecryptBeforeApply", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SOPSDecryptBeforeApply = bool(v != 0)
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SOPSAgeKeySecretRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9832,27 +20083,31 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			if m.SOPSAgeKeySecretRef == nil {
+				m.SOPSAgeKeySecretRef = &SecretKeyReference{}
+			}
+			if err := m.SOPSAgeKeySecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EditOrder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9880,7 +20135,7 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			m.EditOrder = EditOrder(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9903,7 +20158,7 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArgoCDAppUpdate) Unmarshal(dAtA []byte) error {
+func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9926,15 +20181,15 @@ func (m *ArgoCDAppUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDAppUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDAppUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9962,11 +20217,11 @@ func (m *ArgoCDAppUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppName = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSelectionStrategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9994,47 +20249,13 @@ func (m *ArgoCDAppUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppNamespace = string(dAtA[iNdEx:postIndex])
+			m.CommitSelectionStrategy = CommitSelectionStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceUpdates", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceUpdates = append(m.SourceUpdates, ArgoCDSourceUpdate{})
-			if err := m.SourceUpdates[len(m.SourceUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10044,83 +20265,29 @@ func (m *ArgoCDAppUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Origin == nil {
-				m.Origin = &FreightOrigin{}
-			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArgoCDHelm) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDHelm: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDHelm: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10130,31 +20297,29 @@ func (m *ArgoCDHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, ArgoCDHelmImageUpdate{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowTags", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10164,81 +20329,27 @@ func (m *ArgoCDHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Origin == nil {
-				m.Origin = &FreightOrigin{}
-			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowTags = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArgoCDHelmImageUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDHelmImageUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDHelmImageUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10266,11 +20377,31 @@ func (m *ArgoCDHelmImageUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureSkipTLSVerify = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePaths", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10298,11 +20429,11 @@ func (m *ArgoCDHelmImageUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.IncludePaths = append(m.IncludePaths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludePaths", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10330,13 +20461,51 @@ func (m *ArgoCDHelmImageUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = ImageUpdateValueType(dAtA[iNdEx:postIndex])
+			m.ExcludePaths = append(m.ExcludePaths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
+			}
+			m.DiscoveryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscoveryLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShallowCloneDepth", wireType)
+			}
+			m.ShallowCloneDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShallowCloneDepth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10346,81 +20515,27 @@ func (m *ArgoCDHelmImageUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Origin == nil {
-				m.Origin = &FreightOrigin{}
-			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Branches = append(m.Branches, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArgoCDKustomize) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDKustomize: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDKustomize: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KnownHostsConfigMapRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10447,16 +20562,18 @@ func (m *ArgoCDKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, ArgoCDKustomizeImageUpdate{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.KnownHostsConfigMapRef == nil {
+				m.KnownHostsConfigMapRef = &v11.LocalObjectReference{}
+			}
+			if err := m.KnownHostsConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SparseCheckoutPaths", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10466,27 +20583,23 @@ func (m *ArgoCDKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Origin == nil {
-				m.Origin = &FreightOrigin{}
-			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.SparseCheckoutPaths = append(m.SparseCheckoutPaths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10509,7 +20622,7 @@ func (m *ArgoCDKustomize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArgoCDKustomizeImageUpdate) Unmarshal(dAtA []byte) error {
+func (m *HTTPHealthCheck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10532,15 +20645,15 @@ func (m *ArgoCDKustomizeImageUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDKustomizeImageUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHealthCheck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDKustomizeImageUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHealthCheck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10568,13 +20681,13 @@ func (m *ArgoCDKustomizeImageUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseDigest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedStatusCode", wireType)
 			}
-			var v int
+			m.ExpectedStatusCode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10584,17 +20697,16 @@ func (m *ArgoCDKustomizeImageUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ExpectedStatusCode |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseDigest = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			var msglen int
+			m.TimeoutSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10604,28 +20716,31 @@ func (m *ArgoCDKustomizeImageUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TimeoutSeconds |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Origin == nil {
-				m.Origin = &FreightOrigin{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.InsecureSkipTLSVerify = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10647,7 +20762,7 @@ func (m *ArgoCDKustomizeImageUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArgoCDSourceUpdate) Unmarshal(dAtA []byte) error {
+func (m *Health) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10670,15 +20785,15 @@ func (m *ArgoCDSourceUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDSourceUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Health: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDSourceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Health: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10706,11 +20821,11 @@ func (m *ArgoCDSourceUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Status = HealthState(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issues", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10738,67 +20853,11 @@ func (m *ArgoCDSourceUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chart = string(dAtA[iNdEx:postIndex])
+			m.Issues = append(m.Issues, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTargetRevision", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UpdateTargetRevision = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Kustomize == nil {
-				m.Kustomize = &ArgoCDKustomize{}
-			}
-			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArgoCDApps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10825,16 +20884,14 @@ func (m *ArgoCDSourceUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Helm == nil {
-				m.Helm = &ArgoCDHelm{}
-			}
-			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ArgoCDApps = append(m.ArgoCDApps, ArgoCDAppStatus{})
+			if err := m.ArgoCDApps[len(m.ArgoCDApps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10861,10 +20918,10 @@ func (m *ArgoCDSourceUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Origin == nil {
-				m.Origin = &FreightOrigin{}
+			if m.CheckedAt == nil {
+				m.CheckedAt = &v1.Time{}
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CheckedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10889,7 +20946,7 @@ func (m *ArgoCDSourceUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Chart) Unmarshal(dAtA []byte) error {
+func (m *HealthChecks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10912,17 +20969,17 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Chart: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthChecks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Chart: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthChecks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPChecks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10932,29 +20989,31 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.HTTPChecks = append(m.HTTPChecks, HTTPHealthCheck{})
+			if err := m.HTTPChecks[len(m.HTTPChecks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArgoCDApps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10964,29 +21023,31 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ArgoCDApps = append(m.ArgoCDApps, ArgoCDAppStatusCheck{})
+			if err := m.ArgoCDApps[len(m.ArgoCDApps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodImages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10996,23 +21057,25 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.PodImages = append(m.PodImages, PodImageHealthCheck{})
+			if err := m.PodImages[len(m.PodImages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11035,7 +21098,7 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
+func (m *HelmChartDependencyUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11058,15 +21121,15 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChartDiscoveryResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmChartDependencyUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmChartDependencyUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repository", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11094,7 +21157,7 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Repository = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -11130,7 +21193,7 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChartPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11158,13 +21221,13 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			m.ChartPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11174,23 +21237,27 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
+			if m.Origin == nil {
+				m.Origin = &FreightOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11213,7 +21280,7 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
+func (m *HelmImageUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11226,25 +21293,57 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelmImageUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelmImageUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChartSubscription: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFilePath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11272,11 +21371,11 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.ValuesFilePath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11304,11 +21403,11 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11336,13 +21435,13 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			m.Value = ImageUpdateValueType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
-			m.DiscoveryLimit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11352,11 +21451,28 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DiscoveryLimit |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Origin == nil {
+				m.Origin = &FreightOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11378,7 +21494,7 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
+func (m *HelmPromotionMechanism) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11401,15 +21517,15 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DiscoveredArtifacts: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmPromotionMechanism: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiscoveredArtifacts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmPromotionMechanism: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11436,14 +21552,14 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Git = append(m.Git, GitDiscoveryResult{})
-			if err := m.Git[len(m.Git)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Images = append(m.Images, HelmImageUpdate{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11470,14 +21586,14 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, ImageDiscoveryResult{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Charts = append(m.Charts, HelmChartDependencyUpdate{})
+			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11504,64 +21620,16 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Charts = append(m.Charts, ChartDiscoveryResult{})
-			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Origin == nil {
+				m.Origin = &FreightOrigin{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DiscoveredCommit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiscoveredCommit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFilePath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11589,13 +21657,13 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.ValuesFilePath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesOverrides", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11605,27 +21673,31 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.ValuesOverrides == nil {
+				m.ValuesOverrides = &runtime.RawExtension{}
+			}
+			if err := m.ValuesOverrides.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UmbrellaChartPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11653,13 +21725,13 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.UmbrellaChartPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFiles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11669,59 +21741,79 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			m.ValuesFiles = append(m.ValuesFiles, HelmValuesFileUpdate{})
+			if err := m.ValuesFiles[len(m.ValuesFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelmValuesFileUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelmValuesFileUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelmValuesFileUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11749,11 +21841,11 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Committer = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatorDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11780,12 +21872,103 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatorDate == nil {
-				m.CreatorDate = &v1.Time{}
+			if m.Updates == nil {
+				m.Updates = make(map[string]string)
 			}
-			if err := m.CreatorDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Updates[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11808,7 +21991,7 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
+func (m *Image) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11831,15 +22014,15 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DiscoveredImageReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: Image: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiscoveredImageReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Image: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11867,11 +22050,11 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11899,11 +22082,11 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Digest = string(dAtA[iNdEx:postIndex])
+			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11931,13 +22114,13 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
+			m.Tag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11947,27 +22130,23 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &v1.Time{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11990,7 +22169,7 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Freight) Unmarshal(dAtA []byte) error {
+func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12013,17 +22192,17 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Freight: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageDiscoveryResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Freight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12033,30 +22212,29 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12066,29 +22244,27 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commits = append(m.Commits, GitCommit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12115,16 +22291,66 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, Image{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.References = append(m.References, DiscoveredImageReference{})
+			if err := m.References[len(m.References)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImagePullCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImagePullCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImagePullCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullSecret", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12134,31 +22360,79 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Charts = append(m.Charts, Chart{})
-			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12168,28 +22442,27 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12217,11 +22490,11 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Alias = string(dAtA[iNdEx:postIndex])
+			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warehouse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageSelectionStrategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12249,13 +22522,13 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Warehouse = string(dAtA[iNdEx:postIndex])
+			m.ImageSelectionStrategy = ImageSelectionStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12265,80 +22538,29 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FreightCollection) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FreightCollection: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightCollection: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowTags", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12348,126 +22570,29 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Freight == nil {
-				m.Freight = make(map[string]FreightReference)
-			}
-			var mapkey string
-			mapvalue := &FreightReference{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &FreightReference{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Freight[mapkey] = *mapvalue
+			m.AllowTags = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTags", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12477,29 +22602,27 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationHistory = append(m.VerificationHistory, VerificationInfo{})
-			if err := m.VerificationHistory[len(m.VerificationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12527,63 +22650,52 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Platform = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FreightList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.InsecureSkipTLSVerify = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DiscoveryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscoveryLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FreightList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyTags", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12593,28 +22705,27 @@ func (m *FreightList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DenyTags = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CABundleConfigMapRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12641,11 +22752,33 @@ func (m *FreightList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Freight{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CABundleConfigMapRef == nil {
+				m.CABundleConfigMapRef = &v11.LocalObjectReference{}
+			}
+			if err := m.CABundleConfigMapRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestByBuildDate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LatestByBuildDate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12667,7 +22800,7 @@ func (m *FreightList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
+func (m *JobTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12690,17 +22823,17 @@ func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FreightOrigin: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12710,55 +22843,24 @@ func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = FreightOriginKind(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12781,7 +22883,7 @@ func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreightReference) Unmarshal(dAtA []byte) error {
+func (m *KargoRenderImageUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12804,83 +22906,17 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FreightReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: KargoRenderImageUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightReference: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Commits = append(m.Commits, GitCommit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+			return fmt.Errorf("proto: KargoRenderImageUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12890,31 +22926,29 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, Image{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDigest", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12924,29 +22958,15 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Charts = append(m.Charts, Chart{})
-			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+			m.UseDigest = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12973,48 +22993,66 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VerificationInfo == nil {
-				m.VerificationInfo = &VerificationInfo{}
+			if m.Origin == nil {
+				m.Origin = &FreightOrigin{}
 			}
-			if err := m.VerificationInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warehouse", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KargoRenderPromotionMechanism) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Warehouse = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KargoRenderPromotionMechanism: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KargoRenderPromotionMechanism: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13041,12 +23079,12 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerificationHistory = append(m.VerificationHistory, VerificationInfo{})
-			if err := m.VerificationHistory[len(m.VerificationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Images = append(m.Images, KargoRenderImageUpdate{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
@@ -13075,6 +23113,9 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if m.Origin == nil {
+				m.Origin = &FreightOrigin{}
+			}
 			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -13100,7 +23141,7 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreightRequest) Unmarshal(dAtA []byte) error {
+func (m *KubernetesResourceUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13123,17 +23164,17 @@ func (m *FreightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FreightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: KubernetesResourceUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KubernetesResourceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13143,30 +23184,29 @@ func (m *FreightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13176,24 +23216,119 @@ func (m *FreightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JSONPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13216,7 +23351,7 @@ func (m *FreightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreightSources) Unmarshal(dAtA []byte) error {
+func (m *KustomizeImageUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13239,17 +23374,17 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FreightSources: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeImageUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightSources: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeImageUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direct", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13259,15 +23394,27 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Direct = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13295,61 +23442,31 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stages = append(m.Stages, string(dAtA[iNdEx:postIndex]))
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FreightStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDigest", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FreightStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.UseDigest = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13376,111 +23493,18 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VerifiedIn == nil {
-				m.VerifiedIn = make(map[string]VerifiedStage)
+			if m.Origin == nil {
+				m.Origin = &FreightOrigin{}
 			}
-			var mapkey string
-			mapvalue := &VerifiedStage{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &VerifiedStage{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.VerifiedIn[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedFor", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StripRegistryHost", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13490,120 +23514,47 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ApprovedFor == nil {
-				m.ApprovedFor = make(map[string]ApprovedStage)
+			m.StripRegistryHost = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacementSource", wireType)
 			}
-			var mapkey string
-			mapvalue := &ApprovedStage{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ApprovedStage{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.ApprovedFor[mapkey] = *mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReplacementSource == nil {
+				m.ReplacementSource = &KustomizeReplacementSource{}
+			}
+			if err := m.ReplacementSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13626,7 +23577,7 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitCommit) Unmarshal(dAtA []byte) error {
+func (m *KustomizePromotionMechanism) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13649,17 +23600,17 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizePromotionMechanism: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizePromotionMechanism: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13669,27 +23620,65 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Images = append(m.Images, KustomizeImageUpdate{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Origin == nil {
+				m.Origin = &FreightOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13717,11 +23706,61 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Components = append(m.Components, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KustomizeReplacementSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KustomizeReplacementSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KustomizeReplacementSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13749,11 +23788,11 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.ConfigMap = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13781,11 +23820,61 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodImageHealthCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodImageHealthCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodImageHealthCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthCheckCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13813,13 +23902,13 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HealthCheckCommit = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13829,29 +23918,80 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollingIntervals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollingIntervals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollingIntervals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitIntervalSeconds", wireType)
+			}
+			m.GitIntervalSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13861,29 +24001,16 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GitIntervalSeconds |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Author = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageIntervalSeconds", wireType)
 			}
-			var stringLen uint64
+			m.ImageIntervalSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13893,24 +24020,30 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ImageIntervalSeconds |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChartIntervalSeconds", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ChartIntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChartIntervalSeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Committer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13932,7 +24065,7 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
+func (m *Project) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13955,17 +24088,17 @@ func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitDiscoveryResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: Project: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13975,27 +24108,28 @@ func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14022,8 +24156,43 @@ func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commits = append(m.Commits, DiscoveredCommit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Spec == nil {
+				m.Spec = &ProjectSpec{}
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14048,7 +24217,7 @@ func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitHubPullRequest) Unmarshal(dAtA []byte) error {
+func (m *ProjectList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14067,16 +24236,83 @@ func (m *GitHubPullRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GitHubPullRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitHubPullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Project{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14098,7 +24334,7 @@ func (m *GitHubPullRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitLabPullRequest) Unmarshal(dAtA []byte) error {
+func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14121,12 +24357,46 @@ func (m *GitLabPullRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitLabPullRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitLabPullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromotionPolicies = append(m.PromotionPolicies, PromotionPolicy{})
+			if err := m.PromotionPolicies[len(m.PromotionPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14148,7 +24418,7 @@ func (m *GitLabPullRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitRepoUpdate) Unmarshal(dAtA []byte) error {
+func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14171,15 +24441,15 @@ func (m *GitRepoUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitRepoUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitRepoUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14207,31 +24477,11 @@ func (m *GitRepoUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Phase = ProjectPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InsecureSkipTLSVerify = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14259,43 +24509,61 @@ func (m *GitRepoUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReadBranch = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WriteBranch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Promotion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WriteBranch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Promotion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Promotion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14322,16 +24590,13 @@ func (m *GitRepoUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PullRequest == nil {
-				m.PullRequest = &PullRequestPromotionMechanism{}
-			}
-			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Render", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14358,16 +24623,13 @@ func (m *GitRepoUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Render == nil {
-				m.Render = &KargoRenderPromotionMechanism{}
-			}
-			if err := m.Render.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14394,16 +24656,63 @@ func (m *GitRepoUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kustomize == nil {
-				m.Kustomize = &KustomizePromotionMechanism{}
-			}
-			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14430,16 +24739,13 @@ func (m *GitRepoUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Helm == nil {
-				m.Helm = &HelmPromotionMechanism{}
-			}
-			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14466,10 +24772,8 @@ func (m *GitRepoUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Origin == nil {
-				m.Origin = &FreightOrigin{}
-			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Promotion{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14494,7 +24798,7 @@ func (m *GitRepoUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitSubscription) Unmarshal(dAtA []byte) error {
+func (m *PromotionMechanisms) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14517,17 +24821,17 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitSubscription: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionMechanisms: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionMechanisms: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoUpdates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14537,29 +24841,31 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.GitRepoUpdates = append(m.GitRepoUpdates, GitRepoUpdate{})
+			if err := m.GitRepoUpdates[len(m.GitRepoUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitSelectionStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArgoCDAppUpdates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14569,29 +24875,31 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommitSelectionStrategy = CommitSelectionStrategy(dAtA[iNdEx:postIndex])
+			m.ArgoCDAppUpdates = append(m.ArgoCDAppUpdates, ArgoCDAppUpdate{})
+			if err := m.ArgoCDAppUpdates[len(m.ArgoCDAppUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14601,29 +24909,33 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.Origin == nil {
+				m.Origin = &FreightOrigin{}
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeApproval", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14633,29 +24945,33 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			if m.ChangeApproval == nil {
+				m.ChangeApproval = &ChangeApprovalCheck{}
+			}
+			if err := m.ChangeApproval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowTags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullCheck", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14665,29 +24981,33 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowTags = string(dAtA[iNdEx:postIndex])
+			if m.ImagePullCheck == nil {
+				m.ImagePullCheck = &ImagePullCheck{}
+			}
+			if err := m.ImagePullCheck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FluxHelmReleaseUpdates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14697,49 +25017,31 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
+			m.FluxHelmReleaseUpdates = append(m.FluxHelmReleaseUpdates, FluxHelmReleaseUpdate{})
+			if err := m.FluxHelmReleaseUpdates[len(m.FluxHelmReleaseUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InsecureSkipTLSVerify = bool(v != 0)
-		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludePaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesResourceUpdates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14749,29 +25051,31 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncludePaths = append(m.IncludePaths, string(dAtA[iNdEx:postIndex]))
+			m.KubernetesResourceUpdates = append(m.KubernetesResourceUpdates, KubernetesResourceUpdate{})
+			if err := m.KubernetesResourceUpdates[len(m.KubernetesResourceUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludePaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14781,43 +25085,28 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExcludePaths = append(m.ExcludePaths, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
+			if m.Canary == nil {
+				m.Canary = &CanaryPromotion{}
 			}
-			m.DiscoveryLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DiscoveryLimit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14839,7 +25128,7 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Health) Unmarshal(dAtA []byte) error {
+func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14862,15 +25151,15 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Health: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Health: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14898,45 +25187,13 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = HealthState(dAtA[iNdEx:postIndex])
+			m.Stage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issues", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Issues = append(m.Issues, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArgoCDApps", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromotionEnabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14946,26 +25203,12 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ArgoCDApps = append(m.ArgoCDApps, ArgoCDAppStatus{})
-			if err := m.ArgoCDApps[len(m.ArgoCDApps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.AutoPromotionEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14987,7 +25230,7 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmChartDependencyUpdate) Unmarshal(dAtA []byte) error {
+func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15010,15 +25253,15 @@ func (m *HelmChartDependencyUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmChartDependencyUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmChartDependencyUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repository", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15046,13 +25289,13 @@ func (m *HelmChartDependencyUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repository = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15062,29 +25305,33 @@ func (m *HelmChartDependencyUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Freight == nil {
+				m.Freight = &FreightReference{}
+			}
+			if err := m.Freight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChartPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15094,27 +25341,31 @@ func (m *HelmChartDependencyUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChartPath = string(dAtA[iNdEx:postIndex])
+			if m.Status == nil {
+				m.Status = &PromotionStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15141,10 +25392,10 @@ func (m *HelmChartDependencyUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Origin == nil {
-				m.Origin = &FreightOrigin{}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15169,7 +25420,7 @@ func (m *HelmChartDependencyUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmImageUpdate) Unmarshal(dAtA []byte) error {
+func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15192,15 +25443,15 @@ func (m *HelmImageUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmImageUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmImageUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15228,75 +25479,11 @@ func (m *HelmImageUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.Stage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFilePath", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValuesFilePath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15324,43 +25511,7 @@ func (m *HelmImageUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = ImageUpdateValueType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Origin == nil {
-				m.Origin = &FreightOrigin{}
-			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Freight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15383,7 +25534,7 @@ func (m *HelmImageUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmPromotionMechanism) Unmarshal(dAtA []byte) error {
+func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15406,17 +25557,17 @@ func (m *HelmPromotionMechanism) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmPromotionMechanism: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmPromotionMechanism: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15426,31 +25577,29 @@ func (m *HelmPromotionMechanism) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, HelmImageUpdate{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = PromotionPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15460,29 +25609,27 @@ func (m *HelmPromotionMechanism) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Charts = append(m.Charts, HelmChartDependencyUpdate{})
-			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15499,76 +25646,117 @@ func (m *HelmPromotionMechanism) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Origin == nil {
-				m.Origin = &FreightOrigin{}
-			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Image) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Image: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Image: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15596,13 +25784,13 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15612,29 +25800,33 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
+			if m.Freight == nil {
+				m.Freight = &FreightReference{}
+			}
+			if err := m.Freight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15644,29 +25836,33 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightCollection", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15676,79 +25872,33 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Digest = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.FreightCollection == nil {
+				m.FreightCollection = &FreightCollection{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.FreightCollection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ImageDiscoveryResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15758,29 +25908,17 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.DryRun = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15790,27 +25928,29 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Platform = string(dAtA[iNdEx:postIndex])
+			m.Conditions = append(m.Conditions, v1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChartChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15837,8 +25977,8 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.References = append(m.References, DiscoveredImageReference{})
-			if err := m.References[len(m.References)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChartChanges = append(m.ChartChanges, ChartChange{})
+			if err := m.ChartChanges[len(m.ChartChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15863,7 +26003,7 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
+func (m *PromotionTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15886,17 +26026,17 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageSubscription: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15906,29 +26046,30 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15938,93 +26079,80 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageSelectionStrategy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageSelectionStrategy = ImageSelectionStrategy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionTemplateList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionTemplateList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowTags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16034,29 +26162,30 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowTags = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16066,81 +26195,81 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Items = append(m.Items, PromotionTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Platform = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionTemplateSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.InsecureSkipTLSVerify = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.DiscoveryLimit = 0
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionTemplateSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionTemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionMechanisms", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16150,11 +26279,25 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DiscoveryLimit |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PromotionMechanisms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16176,7 +26319,7 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KargoRenderImageUpdate) Unmarshal(dAtA []byte) error {
+func (m *PullRequestPromotionMechanism) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16199,17 +26342,17 @@ func (m *KargoRenderImageUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KargoRenderImageUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestPromotionMechanism: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KargoRenderImageUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestPromotionMechanism: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitHub", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16219,47 +26362,31 @@ func (m *KargoRenderImageUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseDigest", wireType)
+			if m.GitHub == nil {
+				m.GitHub = &GitHubPullRequest{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.GitHub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.UseDigest = bool(v != 0)
-		case 3:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitLab", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16286,13 +26413,33 @@ func (m *KargoRenderImageUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Origin == nil {
-				m.Origin = &FreightOrigin{}
+			if m.GitLab == nil {
+				m.GitLab = &GitLabPullRequest{}
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GitLab.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteBranchOnMerge", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeleteBranchOnMerge = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16314,7 +26461,7 @@ func (m *KargoRenderImageUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KargoRenderPromotionMechanism) Unmarshal(dAtA []byte) error {
+func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16337,15 +26484,15 @@ func (m *KargoRenderPromotionMechanism) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KargoRenderPromotionMechanism: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KargoRenderPromotionMechanism: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16372,14 +26519,16 @@ func (m *KargoRenderPromotionMechanism) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, KargoRenderImageUpdate{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Git == nil {
+				m.Git = &GitSubscription{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16406,10 +26555,46 @@ func (m *KargoRenderPromotionMechanism) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Origin == nil {
-				m.Origin = &FreightOrigin{}
+			if m.Image == nil {
+				m.Image = &ImageSubscription{}
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Chart == nil {
+				m.Chart = &ChartSubscription{}
+			}
+			if err := m.Chart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16434,7 +26619,7 @@ func (m *KargoRenderPromotionMechanism) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeImageUpdate) Unmarshal(dAtA []byte) error {
+func (m *SecretKeyReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16457,15 +26642,15 @@ func (m *KustomizeImageUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeImageUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretKeyReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeImageUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretKeyReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16493,11 +26678,11 @@ func (m *KustomizeImageUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16525,63 +26710,7 @@ func (m *KustomizeImageUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseDigest", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseDigest = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Origin == nil {
-				m.Origin = &FreightOrigin{}
-			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16604,7 +26733,7 @@ func (m *KustomizeImageUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizePromotionMechanism) Unmarshal(dAtA []byte) error {
+func (m *Stage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16617,25 +26746,58 @@ func (m *KustomizePromotionMechanism) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizePromotionMechanism: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizePromotionMechanism: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16662,14 +26824,13 @@ func (m *KustomizePromotionMechanism) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, KustomizeImageUpdate{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16696,10 +26857,7 @@ func (m *KustomizePromotionMechanism) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Origin == nil {
-				m.Origin = &FreightOrigin{}
-			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16724,7 +26882,7 @@ func (m *KustomizePromotionMechanism) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Project) Unmarshal(dAtA []byte) error {
+func (m *StageList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16747,15 +26905,15 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Project: wiretype end group for non-group")
+			return fmt.Errorf("proto: StageList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StageList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16782,49 +26940,13 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Spec == nil {
-				m.Spec = &ProjectSpec{}
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16851,7 +26973,8 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Stage{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16876,7 +26999,7 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectList) Unmarshal(dAtA []byte) error {
+func (m *StageRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16899,15 +27022,15 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
+			return fmt.Errorf("proto: StageRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StageRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16934,13 +27057,13 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16967,8 +27090,7 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Project{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16993,7 +27115,7 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
+func (m *StageRoleList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17016,15 +27138,15 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: StageRoleList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StageRoleList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromotionPolicies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17051,8 +27173,41 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PromotionPolicies = append(m.PromotionPolicies, PromotionPolicy{})
-			if err := m.PromotionPolicies[len(m.PromotionPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, StageRole{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17077,7 +27232,7 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
+func (m *StageRoleSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17100,15 +27255,15 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: StageRoleSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StageRoleSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17136,13 +27291,13 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = ProjectPhase(dAtA[iNdEx:postIndex])
+			m.Stage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subjects", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17152,23 +27307,25 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Subjects = append(m.Subjects, v13.Subject{})
+			if err := m.Subjects[len(m.Subjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17191,7 +27348,7 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Promotion) Unmarshal(dAtA []byte) error {
+func (m *StageSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17214,15 +27371,15 @@ func (m *Promotion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Promotion: wiretype end group for non-group")
+			return fmt.Errorf("proto: StageSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Promotion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StageSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17249,13 +27406,13 @@ func (m *Promotion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Subscriptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionMechanisms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17282,13 +27439,16 @@ func (m *Promotion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PromotionMechanisms == nil {
+				m.PromotionMechanisms = &PromotionMechanisms{}
+			}
+			if err := m.PromotionMechanisms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17315,63 +27475,48 @@ func (m *Promotion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Verification == nil {
+				m.Verification = &Verification{}
+			}
+			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Shard = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedFreight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17398,13 +27543,53 @@ func (m *PromotionList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RequestedFreight = append(m.RequestedFreight, FreightRequest{})
+			if err := m.RequestedFreight[len(m.RequestedFreight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightHistoryLimit", wireType)
+			}
+			m.FreightHistoryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FreightHistoryLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotificationWebhooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17431,64 +27616,46 @@ func (m *PromotionList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Promotion{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NotificationWebhooks = append(m.NotificationWebhooks, WebhookConfig{})
+			if err := m.NotificationWebhooks[len(m.NotificationWebhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionMechanisms) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionMechanisms: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionMechanisms: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17515,14 +27682,16 @@ func (m *PromotionMechanisms) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitRepoUpdates = append(m.GitRepoUpdates, GitRepoUpdate{})
-			if err := m.GitRepoUpdates[len(m.GitRepoUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PromotionTimeout == nil {
+				m.PromotionTimeout = &v1.Duration{}
+			}
+			if err := m.PromotionTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArgoCDAppUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17549,14 +27718,16 @@ func (m *PromotionMechanisms) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ArgoCDAppUpdates = append(m.ArgoCDAppUpdates, ArgoCDAppUpdate{})
-			if err := m.ArgoCDAppUpdates[len(m.ArgoCDAppUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &CircuitBreaker{}
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthChecks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17583,68 +27754,18 @@ func (m *PromotionMechanisms) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Origin == nil {
-				m.Origin = &FreightOrigin{}
+			if m.HealthChecks == nil {
+				m.HealthChecks = &HealthChecks{}
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HealthChecks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionPolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrePromotionHook", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17654,29 +27775,33 @@ func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stage = string(dAtA[iNdEx:postIndex])
+			if m.PrePromotionHook == nil {
+				m.PrePromotionHook = &JobTemplate{}
+			}
+			if err := m.PrePromotionHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromotionEnabled", wireType)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostPromotionHook", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17686,65 +27811,31 @@ func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AutoPromotionEnabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionReference) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.PostPromotionHook == nil {
+				m.PostPromotionHook = &JobTemplate{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.PostPromotionHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionReference: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionReference: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17772,13 +27863,13 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Pipeline = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wave", wireType)
 			}
-			var msglen int
+			m.Wave = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17788,31 +27879,14 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Wave |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Freight == nil {
-				m.Freight = &FreightReference{}
-			}
-			if err := m.Freight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionTemplateRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17839,18 +27913,18 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &PromotionStatus{}
+			if m.PromotionTemplateRef == nil {
+				m.PromotionTemplateRef = &v11.LocalObjectReference{}
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PromotionTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromotionExpression", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17860,81 +27934,27 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AutoPromotionExpression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PinnedFreight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17962,11 +27982,11 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stage = string(dAtA[iNdEx:postIndex])
+			m.PinnedFreight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionSchedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17994,7 +28014,7 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Freight = string(dAtA[iNdEx:postIndex])
+			m.PromotionSchedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18017,7 +28037,7 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
+func (m *StageStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18040,10 +28060,10 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: StageStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StageStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -18076,13 +28096,13 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = PromotionPhase(dAtA[iNdEx:postIndex])
+			m.Phase = StagePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFreight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18092,27 +28112,31 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.CurrentFreight == nil {
+				m.CurrentFreight = &FreightReference{}
+			}
+			if err := m.CurrentFreight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18139,109 +28163,16 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.History = append(m.History, FreightReference{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightHistory", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18251,27 +28182,48 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.FreightHistory = append(m.FreightHistory, &FreightCollection{})
+			if err := m.FreightHistory[len(m.FreightHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPromotion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18298,16 +28250,16 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Freight == nil {
-				m.Freight = &FreightReference{}
+			if m.CurrentPromotion == nil {
+				m.CurrentPromotion = &PromotionReference{}
 			}
-			if err := m.Freight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentPromotion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18334,18 +28286,18 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
+			if m.Health == nil {
+				m.Health = &Health{}
 			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FreightCollection", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18355,81 +28307,27 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FreightCollection == nil {
-				m.FreightCollection = &FreightCollection{}
-			}
-			if err := m.FreightCollection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PullRequestPromotionMechanism) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestPromotionMechanism: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestPromotionMechanism: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitHub", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPromotion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18456,18 +28354,18 @@ func (m *PullRequestPromotionMechanism) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GitHub == nil {
-				m.GitHub = &GitHubPullRequest{}
+			if m.LastPromotion == nil {
+				m.LastPromotion = &PromotionReference{}
 			}
-			if err := m.GitHub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastPromotion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitLab", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18477,83 +28375,29 @@ func (m *PullRequestPromotionMechanism) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GitLab == nil {
-				m.GitLab = &GitLabPullRequest{}
-			}
-			if err := m.GitLab.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepoSubscription: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightSummary", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18563,31 +28407,27 @@ func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitSubscription{}
-			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FreightSummary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotificationWebhooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18614,16 +28454,14 @@ func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &ImageSubscription{}
-			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NotificationWebhooks = append(m.NotificationWebhooks, WebhookDeliveryStatus{})
+			if err := m.NotificationWebhooks[len(m.NotificationWebhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18647,69 +28485,19 @@ func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Chart == nil {
-				m.Chart = &ChartSubscription{}
-			}
-			if err := m.Chart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Stage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Stage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Stage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &CircuitBreakerStatus{}
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18736,13 +28524,14 @@ func (m *Stage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, v1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledPromotion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18769,13 +28558,16 @@ func (m *Stage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NextScheduledPromotion == nil {
+				m.NextScheduledPromotion = &v1.Time{}
+			}
+			if err := m.NextScheduledPromotion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightHistoryDeltas", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18802,7 +28594,8 @@ func (m *Stage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FreightHistoryDeltas = append(m.FreightHistoryDeltas, FreightCollectionDelta{})
+			if err := m.FreightHistoryDeltas[len(m.FreightHistoryDeltas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18827,7 +28620,7 @@ func (m *Stage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StageList) Unmarshal(dAtA []byte) error {
+func (m *StageSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18850,50 +28643,17 @@ func (m *StageList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StageList: wiretype end group for non-group")
+			return fmt.Errorf("proto: StageSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StageList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StageSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18903,25 +28663,23 @@ func (m *StageList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Stage{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18944,7 +28702,7 @@ func (m *StageList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StageSpec) Unmarshal(dAtA []byte) error {
+func (m *SubscriptionPollTimes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18967,15 +28725,15 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StageSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscriptionPollTimes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StageSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscriptionPollTimes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19002,13 +28760,16 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Subscriptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Git == nil {
+				m.Git = &v1.Time{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromotionMechanisms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19035,16 +28796,16 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PromotionMechanisms == nil {
-				m.PromotionMechanisms = &PromotionMechanisms{}
+			if m.Image == nil {
+				m.Image = &v1.Time{}
 			}
-			if err := m.PromotionMechanisms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19071,16 +28832,66 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Verification == nil {
-				m.Verification = &Verification{}
+			if m.Chart == nil {
+				m.Chart = &v1.Time{}
 			}
-			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Chart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Subscriptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subscriptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subscriptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Warehouse", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19108,11 +28919,11 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Shard = string(dAtA[iNdEx:postIndex])
+			m.Warehouse = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedFreight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamStages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19139,8 +28950,8 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestedFreight = append(m.RequestedFreight, FreightRequest{})
-			if err := m.RequestedFreight[len(m.RequestedFreight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UpstreamStages = append(m.UpstreamStages, StageSubscription{})
+			if err := m.UpstreamStages[len(m.UpstreamStages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19165,7 +28976,7 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StageStatus) Unmarshal(dAtA []byte) error {
+func (m *Verification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19188,17 +28999,17 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StageStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Verification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StageStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Verification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisTemplates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19208,27 +29019,29 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = StagePhase(dAtA[iNdEx:postIndex])
+			m.AnalysisTemplates = append(m.AnalysisTemplates, AnalysisTemplateReference{})
+			if err := m.AnalysisTemplates[len(m.AnalysisTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFreight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19255,16 +29068,16 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentFreight == nil {
-				m.CurrentFreight = &FreightReference{}
+			if m.AnalysisRunMetadata == nil {
+				m.AnalysisRunMetadata = &AnalysisRunMetadata{}
 			}
-			if err := m.CurrentFreight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19291,16 +29104,66 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.History = append(m.History, FreightReference{})
-			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Args = append(m.Args, AnalysisRunArgument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerificationInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerificationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FreightHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19310,50 +29173,29 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FreightHistory = append(m.FreightHistory, &FreightCollection{})
-			if err := m.FreightHistory[len(m.FreightHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = VerificationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
-			}
-			m.ObservedGeneration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPromotion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19363,31 +29205,27 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentPromotion == nil {
-				m.CurrentPromotion = &PromotionReference{}
-			}
-			if err := m.CurrentPromotion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRun", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19414,16 +29252,16 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Health == nil {
-				m.Health = &Health{}
+			if m.AnalysisRun == nil {
+				m.AnalysisRun = &AnalysisRunReference{}
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AnalysisRun.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19451,11 +29289,11 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPromotion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19482,18 +29320,18 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastPromotion == nil {
-				m.LastPromotion = &PromotionReference{}
+			if m.StartTime == nil {
+				m.StartTime = &v1.Time{}
 			}
-			if err := m.LastPromotion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19503,27 +29341,31 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
+			if m.FinishTime == nil {
+				m.FinishTime = &v1.Time{}
+			}
+			if err := m.FinishTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FreightSummary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19551,7 +29393,7 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FreightSummary = string(dAtA[iNdEx:postIndex])
+			m.Actor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19574,7 +29416,7 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StageSubscription) Unmarshal(dAtA []byte) error {
+func (m *VerifiedStage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19597,44 +29439,12 @@ func (m *StageSubscription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StageSubscription: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifiedStage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StageSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifiedStage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19656,7 +29466,7 @@ func (m *StageSubscription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Subscriptions) Unmarshal(dAtA []byte) error {
+func (m *Warehouse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19679,17 +29489,17 @@ func (m *Subscriptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Subscriptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: Warehouse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Subscriptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Warehouse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Warehouse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19699,27 +29509,28 @@ func (m *Subscriptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Warehouse = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamStages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19746,8 +29557,40 @@ func (m *Subscriptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpstreamStages = append(m.UpstreamStages, StageSubscription{})
-			if err := m.UpstreamStages[len(m.UpstreamStages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19772,7 +29615,7 @@ func (m *Subscriptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Verification) Unmarshal(dAtA []byte) error {
+func (m *WarehouseList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19795,15 +29638,15 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Verification: wiretype end group for non-group")
+			return fmt.Errorf("proto: WarehouseList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Verification: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WarehouseList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisTemplates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19830,50 +29673,13 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnalysisTemplates = append(m.AnalysisTemplates, AnalysisTemplateReference{})
-			if err := m.AnalysisTemplates[len(m.AnalysisTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AnalysisRunMetadata == nil {
-				m.AnalysisRunMetadata = &AnalysisRunMetadata{}
-			}
-			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19899,9 +29705,9 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
-			}
-			m.Args = append(m.Args, AnalysisRunArgument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			}
+			m.Items = append(m.Items, Warehouse{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19926,7 +29732,7 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
+func (m *WarehouseSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19949,17 +29755,17 @@ func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VerificationInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: WarehouseSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerificationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WarehouseSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19969,27 +29775,29 @@ func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = VerificationPhase(dAtA[iNdEx:postIndex])
+			m.Subscriptions = append(m.Subscriptions, RepoSubscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20017,47 +29825,11 @@ func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Shard = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRun", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AnalysisRun == nil {
-				m.AnalysisRun = &AnalysisRunReference{}
-			}
-			if err := m.AnalysisRun.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightCreationPolicy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20085,11 +29857,11 @@ func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.FreightCreationPolicy = FreightCreationPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20116,16 +29888,13 @@ func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartTime == nil {
-				m.StartTime = &v1.Time{}
-			}
-			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollingIntervals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20152,16 +29921,16 @@ func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishTime == nil {
-				m.FinishTime = &v1.Time{}
+			if m.PollingIntervals == nil {
+				m.PollingIntervals = &PollingIntervals{}
 			}
-			if err := m.FinishTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PollingIntervals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightEqualityMode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20189,7 +29958,7 @@ func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actor = string(dAtA[iNdEx:postIndex])
+			m.FreightEqualityMode = FreightEqualityMode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20212,57 +29981,7 @@ func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VerifiedStage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VerifiedStage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerifiedStage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Warehouse) Unmarshal(dAtA []byte) error {
+func (m *WarehouseStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20285,17 +30004,17 @@ func (m *Warehouse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Warehouse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WarehouseStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Warehouse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WarehouseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20305,30 +30024,29 @@ func (m *Warehouse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
 			}
-			var msglen int
+			m.ObservedGeneration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20338,30 +30056,16 @@ func (m *Warehouse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ObservedGeneration |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20371,78 +30075,27 @@ func (m *Warehouse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WarehouseList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WarehouseList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WarehouseList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveredArtifacts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20469,13 +30122,48 @@ func (m *WarehouseList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DiscoveredArtifacts == nil {
+				m.DiscoveredArtifacts = &DiscoveredArtifacts{}
+			}
+			if err := m.DiscoveredArtifacts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFreightID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastFreightID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPolled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20502,8 +30190,10 @@ func (m *WarehouseList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Warehouse{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastPolled == nil {
+				m.LastPolled = &SubscriptionPollTimes{}
+			}
+			if err := m.LastPolled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20528,7 +30218,7 @@ func (m *WarehouseList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WarehouseSpec) Unmarshal(dAtA []byte) error {
+func (m *WebhookConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20551,17 +30241,17 @@ func (m *WarehouseSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WarehouseSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WarehouseSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20571,29 +30261,27 @@ func (m *WarehouseSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subscriptions = append(m.Subscriptions, RepoSubscription{})
-			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20621,13 +30309,13 @@ func (m *WarehouseSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Shard = string(dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FreightCreationPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20637,29 +30325,124 @@ func (m *WarehouseSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FreightCreationPolicy = FreightCreationPolicy(dAtA[iNdEx:postIndex])
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyTemplate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20669,24 +30452,23 @@ func (m *WarehouseSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BodyTemplate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20709,7 +30491,7 @@ func (m *WarehouseSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WarehouseStatus) Unmarshal(dAtA []byte) error {
+func (m *WebhookDeliveryStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20732,15 +30514,15 @@ func (m *WarehouseStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WarehouseStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookDeliveryStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WarehouseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookDeliveryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20768,30 +30550,11 @@ func (m *WarehouseStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
-			}
-			m.ObservedGeneration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Promotion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20819,11 +30582,11 @@ func (m *WarehouseStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
+			m.Promotion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveredArtifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveredAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20850,16 +30613,55 @@ func (m *WarehouseStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DiscoveredArtifacts == nil {
-				m.DiscoveredArtifacts = &DiscoveredArtifacts{}
+			if m.DeliveredAt == nil {
+				m.DeliveredAt = &v1.Time{}
 			}
-			if err := m.DiscoveredArtifacts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeliveredAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusCode", wireType)
+			}
+			m.StatusCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeeded = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFreightID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20887,7 +30689,7 @@ func (m *WarehouseStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastFreightID = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31,10 +31,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&FreightList{},
 		&Stage{},
 		&StageList{},
+		&StageRole{},
+		&StageRoleList{},
 		&Project{},
 		&ProjectList{},
 		&Promotion{},
 		&PromotionList{},
+		&PromotionTemplate{},
+		&PromotionTemplateList{},
 		&Warehouse{},
 		&WarehouseList{},
 	)
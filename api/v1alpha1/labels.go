@@ -4,13 +4,16 @@ const (
 	AliasLabelKey = "kargo.akuity.io/alias"
 
 	// Credentials
-	CredentialTypeLabelKey        = "kargo.akuity.io/cred-type" // nolint: gosec
-	CredentialTypeLabelValueGit   = "git"
-	CredentialTypeLabelValueHelm  = "helm"
-	CredentialTypeLabelValueImage = "image"
+	CredentialTypeLabelKey            = "kargo.akuity.io/cred-type" // nolint: gosec
+	CredentialTypeLabelValueArgoCD    = "argocd"
+	CredentialTypeLabelValueGit       = "git"
+	CredentialTypeLabelValueHelm      = "helm"
+	CredentialTypeLabelValueImage     = "image"
+	CredentialTypeLabelValueTicketing = "ticketing"
 
 	// Kargo core API
 	FreightCollectionLabelKey = "kargo.akuity.io/freight-collection"
+	PipelineLabelKey          = "kargo.akuity.io/pipeline"
 	ProjectLabelKey           = "kargo.akuity.io/project"
 	PromotionLabelKey         = "kargo.akuity.io/promotion"
 	ShardLabelKey             = "kargo.akuity.io/shard"
@@ -0,0 +1,161 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetPromotionTemplate returns a pointer to the PromotionTemplate resource
+// specified by the namespacedName argument. If no such resource is found, nil
+// is returned instead.
+func GetPromotionTemplate(
+	ctx context.Context,
+	c client.Client,
+	namespacedName types.NamespacedName,
+) (*PromotionTemplate, error) {
+	template := PromotionTemplate{}
+	if err := c.Get(ctx, namespacedName, &template); err != nil {
+		if err = client.IgnoreNotFound(err); err == nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf(
+			"error getting PromotionTemplate %q in namespace %q: %w",
+			namespacedName.Name,
+			namespacedName.Namespace,
+			err,
+		)
+	}
+	return &template, nil
+}
+
+// ResolvePromotionMechanisms returns the PromotionMechanisms of the provided
+// Stage. If the Stage references a PromotionTemplate, the PromotionMechanisms
+// of the PromotionTemplate are merged with those of the Stage as described by
+// MergePromotionMechanisms. An error is returned if the referenced
+// PromotionTemplate does not exist.
+func ResolvePromotionMechanisms(
+	ctx context.Context,
+	c client.Client,
+	stage *Stage,
+) (*PromotionMechanisms, error) {
+	ref := stage.Spec.PromotionTemplateRef
+	if ref == nil {
+		return stage.Spec.PromotionMechanisms, nil
+	}
+	template, err := GetPromotionTemplate(
+		ctx,
+		c,
+		types.NamespacedName{
+			Namespace: stage.Namespace,
+			Name:      ref.Name,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		return nil, fmt.Errorf(
+			"PromotionTemplate %q referenced by Stage %q not found in namespace %q",
+			ref.Name,
+			stage.Name,
+			stage.Namespace,
+		)
+	}
+	return MergePromotionMechanisms(
+		&template.Spec.PromotionMechanisms,
+		stage.Spec.PromotionMechanisms,
+	), nil
+}
+
+// MergePromotionMechanisms returns the result of merging the provided
+// overrides into a copy of the provided base PromotionMechanisms. The origin,
+// change approval check, image pull check, and canary of the overrides replace
+// those of the base when specified. An update in the overrides replaces any update
+// of the base that applies to the same branch of the same Git repository, the
+// same Argo CD Application, the same Flux HelmRelease, or the same Kubernetes
+// resource. Other updates of the overrides are appended to those of the base.
+// Neither argument is modified.
+func MergePromotionMechanisms(
+	base *PromotionMechanisms,
+	overrides *PromotionMechanisms,
+) *PromotionMechanisms {
+	if base == nil {
+		return overrides.DeepCopy()
+	}
+	merged := base.DeepCopy()
+	if overrides == nil {
+		return merged
+	}
+	overrides = overrides.DeepCopy()
+	if overrides.Origin != nil {
+		merged.Origin = overrides.Origin
+	}
+	if overrides.ChangeApproval != nil {
+		merged.ChangeApproval = overrides.ChangeApproval
+	}
+	if overrides.ImagePullCheck != nil {
+		merged.ImagePullCheck = overrides.ImagePullCheck
+	}
+	if overrides.Canary != nil {
+		merged.Canary = overrides.Canary
+	}
+	merged.GitRepoUpdates = mergeUpdates(
+		merged.GitRepoUpdates,
+		overrides.GitRepoUpdates,
+		func(u GitRepoUpdate) string {
+			return u.RepoURL + ":" + u.WriteBranch
+		},
+	)
+	merged.ArgoCDAppUpdates = mergeUpdates(
+		merged.ArgoCDAppUpdates,
+		overrides.ArgoCDAppUpdates,
+		func(u ArgoCDAppUpdate) string {
+			return u.AppNamespace + "/" + u.AppName
+		},
+	)
+	merged.FluxHelmReleaseUpdates = mergeUpdates(
+		merged.FluxHelmReleaseUpdates,
+		overrides.FluxHelmReleaseUpdates,
+		func(u FluxHelmReleaseUpdate) string {
+			return u.Namespace + "/" + u.Name
+		},
+	)
+	merged.KubernetesResourceUpdates = mergeUpdates(
+		merged.KubernetesResourceUpdates,
+		overrides.KubernetesResourceUpdates,
+		func(u KubernetesResourceUpdate) string {
+			return u.APIVersion + "/" + u.Kind + "/" + u.Namespace + "/" + u.Name
+		},
+	)
+	return merged
+}
+
+// mergeUpdates replaces every update in base with the update in overrides
+// having the same key, if any, and appends the remaining updates in overrides.
+func mergeUpdates[T any](base, overrides []T, key func(T) string) []T {
+	if len(overrides) == 0 {
+		return base
+	}
+	overridesByKey := make(map[string]int, len(overrides))
+	for i, update := range overrides {
+		overridesByKey[key(update)] = i
+	}
+	merged := make([]T, 0, len(base)+len(overrides))
+	applied := make(map[int]struct{}, len(overrides))
+	for _, update := range base {
+		if i, ok := overridesByKey[key(update)]; ok {
+			update = overrides[i]
+			applied[i] = struct{}{}
+		}
+		merged = append(merged, update)
+	}
+	for i, update := range overrides {
+		if _, ok := applied[i]; !ok {
+			merged = append(merged, update)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,335 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetPromotionTemplate(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, SchemeBuilder.AddToScheme(scheme))
+
+	testCases := []struct {
+		name       string
+		client     client.Client
+		assertions func(*testing.T, *PromotionTemplate, error)
+	}{
+		{
+			name:   "not found",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			assertions: func(t *testing.T, template *PromotionTemplate, err error) {
+				require.NoError(t, err)
+				require.Nil(t, template)
+			},
+		},
+
+		{
+			name: "found",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&PromotionTemplate{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-template",
+						Namespace: "fake-namespace",
+					},
+				},
+			).Build(),
+			assertions: func(t *testing.T, template *PromotionTemplate, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-template", template.Name)
+				require.Equal(t, "fake-namespace", template.Namespace)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			template, err := GetPromotionTemplate(
+				context.Background(),
+				testCase.client,
+				types.NamespacedName{
+					Namespace: "fake-namespace",
+					Name:      "fake-template",
+				},
+			)
+			testCase.assertions(t, template, err)
+		})
+	}
+}
+
+func TestResolvePromotionMechanisms(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, SchemeBuilder.AddToScheme(scheme))
+
+	testTemplate := &PromotionTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-template",
+			Namespace: "fake-namespace",
+		},
+		Spec: PromotionTemplateSpec{
+			PromotionMechanisms: PromotionMechanisms{
+				ArgoCDAppUpdates: []ArgoCDAppUpdate{{
+					AppNamespace: "argocd",
+					AppName:      "fake-app",
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		client     client.Client
+		stage      *Stage
+		assertions func(*testing.T, *PromotionMechanisms, error)
+	}{
+		{
+			name:   "no PromotionTemplate referenced",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			stage: &Stage{
+				Spec: StageSpec{
+					PromotionMechanisms: &PromotionMechanisms{
+						ImagePullCheck: &ImagePullCheck{},
+					},
+				},
+			},
+			assertions: func(t *testing.T, mechanisms *PromotionMechanisms, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&PromotionMechanisms{ImagePullCheck: &ImagePullCheck{}},
+					mechanisms,
+				)
+			},
+		},
+		{
+			name:   "PromotionTemplate not found",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			stage: &Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-stage",
+					Namespace: "fake-namespace",
+				},
+				Spec: StageSpec{
+					PromotionTemplateRef: &corev1.LocalObjectReference{
+						Name: "fake-template",
+					},
+				},
+			},
+			assertions: func(t *testing.T, mechanisms *PromotionMechanisms, err error) {
+				require.ErrorContains(t, err, "not found")
+				require.Nil(t, mechanisms)
+			},
+		},
+		{
+			name: "PromotionTemplate found",
+			client: fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(testTemplate).Build(),
+			stage: &Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-stage",
+					Namespace: "fake-namespace",
+				},
+				Spec: StageSpec{
+					PromotionTemplateRef: &corev1.LocalObjectReference{
+						Name: "fake-template",
+					},
+					PromotionMechanisms: &PromotionMechanisms{
+						ImagePullCheck: &ImagePullCheck{},
+					},
+				},
+			},
+			assertions: func(t *testing.T, mechanisms *PromotionMechanisms, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&PromotionMechanisms{
+						ArgoCDAppUpdates: []ArgoCDAppUpdate{{
+							AppNamespace: "argocd",
+							AppName:      "fake-app",
+						}},
+						ImagePullCheck: &ImagePullCheck{},
+					},
+					mechanisms,
+				)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mechanisms, err := ResolvePromotionMechanisms(
+				context.Background(),
+				testCase.client,
+				testCase.stage,
+			)
+			testCase.assertions(t, mechanisms, err)
+		})
+	}
+}
+
+func TestMergePromotionMechanisms(t *testing.T) {
+	testCases := []struct {
+		name      string
+		base      *PromotionMechanisms
+		overrides *PromotionMechanisms
+		expected  *PromotionMechanisms
+	}{
+		{
+			name: "both nil",
+		},
+		{
+			name: "nil base",
+			overrides: &PromotionMechanisms{
+				ImagePullCheck: &ImagePullCheck{},
+			},
+			expected: &PromotionMechanisms{
+				ImagePullCheck: &ImagePullCheck{},
+			},
+		},
+		{
+			name: "nil overrides",
+			base: &PromotionMechanisms{
+				ImagePullCheck: &ImagePullCheck{},
+			},
+			expected: &PromotionMechanisms{
+				ImagePullCheck: &ImagePullCheck{},
+			},
+		},
+		{
+			name: "overrides take precedence",
+			base: &PromotionMechanisms{
+				GitRepoUpdates: []GitRepoUpdate{
+					{
+						RepoURL:     "https://github.com/example/repo",
+						WriteBranch: "main",
+					},
+					{
+						RepoURL:     "https://github.com/example/repo",
+						WriteBranch: "stage/test",
+					},
+				},
+				ArgoCDAppUpdates: []ArgoCDAppUpdate{{
+					AppNamespace: "argocd",
+					AppName:      "fake-app",
+				}},
+			},
+			overrides: &PromotionMechanisms{
+				GitRepoUpdates: []GitRepoUpdate{
+					{
+						RepoURL:               "https://github.com/example/repo",
+						WriteBranch:           "main",
+						InsecureSkipTLSVerify: true,
+					},
+					{
+						RepoURL:     "https://github.com/example/other-repo",
+						WriteBranch: "main",
+					},
+				},
+				ImagePullCheck: &ImagePullCheck{},
+			},
+			expected: &PromotionMechanisms{
+				GitRepoUpdates: []GitRepoUpdate{
+					{
+						RepoURL:               "https://github.com/example/repo",
+						WriteBranch:           "main",
+						InsecureSkipTLSVerify: true,
+					},
+					{
+						RepoURL:     "https://github.com/example/repo",
+						WriteBranch: "stage/test",
+					},
+					{
+						RepoURL:     "https://github.com/example/other-repo",
+						WriteBranch: "main",
+					},
+				},
+				ArgoCDAppUpdates: []ArgoCDAppUpdate{{
+					AppNamespace: "argocd",
+					AppName:      "fake-app",
+				}},
+				ImagePullCheck: &ImagePullCheck{},
+			},
+		},
+		{
+			name: "canary of overrides takes precedence",
+			base: &PromotionMechanisms{
+				Canary: &CanaryPromotion{
+					Rollout: "fake-rollout",
+					Weight:  10,
+				},
+			},
+			overrides: &PromotionMechanisms{
+				Canary: &CanaryPromotion{
+					Rollout: "fake-rollout",
+					Weight:  50,
+				},
+			},
+			expected: &PromotionMechanisms{
+				Canary: &CanaryPromotion{
+					Rollout: "fake-rollout",
+					Weight:  50,
+				},
+			},
+		},
+		{
+			name: "Kubernetes resource updates are merged by resource",
+			base: &PromotionMechanisms{
+				KubernetesResourceUpdates: []KubernetesResourceUpdate{
+					{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "fake-resource",
+						JSONPatch:  "base-patch",
+					},
+					{
+						APIVersion: "apps/v1",
+						Kind:       "StatefulSet",
+						Name:       "fake-resource",
+						JSONPatch:  "base-patch",
+					},
+				},
+			},
+			overrides: &PromotionMechanisms{
+				KubernetesResourceUpdates: []KubernetesResourceUpdate{{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "fake-resource",
+					JSONPatch:  "override-patch",
+				}},
+			},
+			expected: &PromotionMechanisms{
+				KubernetesResourceUpdates: []KubernetesResourceUpdate{
+					{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Name:       "fake-resource",
+						JSONPatch:  "override-patch",
+					},
+					{
+						APIVersion: "apps/v1",
+						Kind:       "StatefulSet",
+						Name:       "fake-resource",
+						JSONPatch:  "base-patch",
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				MergePromotionMechanisms(testCase.base, testCase.overrides),
+			)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
+
+// PromotionTemplate describes PromotionMechanisms that may be shared by any
+// number of Stages in the same Project, each of which references it using its
+// PromotionTemplateRef field.
+type PromotionTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec describes the PromotionMechanisms shared by this PromotionTemplate.
+	//
+	// +kubebuilder:validation:Required
+	Spec PromotionTemplateSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// PromotionTemplateSpec describes the PromotionMechanisms shared by a
+// PromotionTemplate.
+type PromotionTemplateSpec struct {
+	// PromotionMechanisms describes how to incorporate Freight into Stages
+	// referencing this PromotionTemplate. Any PromotionMechanisms specified by
+	// such a Stage are merged with these, with the Stage's taking precedence.
+	//
+	// +kubebuilder:validation:Required
+	PromotionMechanisms PromotionMechanisms `json:"promotionMechanisms" protobuf:"bytes,1,opt,name=promotionMechanisms"`
+}
+
+// +kubebuilder:object:root=true
+
+// PromotionTemplateList is a list of PromotionTemplate resources.
+type PromotionTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []PromotionTemplate `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
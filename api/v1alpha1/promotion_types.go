@@ -28,6 +28,17 @@ const (
 	PromotionPhaseErrored PromotionPhase = "Errored"
 )
 
+// PromotionConditionType is the type of a condition of a Promotion.
+type PromotionConditionType string
+
+const (
+	// PromotionConditionTypePreflightFailed denotes whether the checks performed
+	// before executing a Promotion last found any of the external systems it
+	// depends upon to be unreachable. While they do, the Promotion is not
+	// executed.
+	PromotionConditionTypePreflightFailed PromotionConditionType = "PreflightFailed"
+)
+
 // IsTerminal returns true if the PromotionPhase is a terminal one.
 func (p *PromotionPhase) IsTerminal() bool {
 	switch *p {
@@ -98,6 +109,14 @@ type PromotionStatus struct {
 	// i.e. If the Phase field has a value of Failed, this field can be expected
 	// to explain why.
 	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// Conditions contains the last observations of the Promotion's current state.
+	// Condition types are those of PromotionConditionType.
+	//
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,9,rep,name=conditions"`
 	// Metadata holds arbitrary metadata set by promotion mechanisms
 	// (e.g. for display purposes, or internal bookkeeping)
 	Metadata map[string]string `json:"metadata,omitempty" protobuf:"bytes,3,rep,name=metadata" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -109,6 +128,33 @@ type PromotionStatus struct {
 	FreightCollection *FreightCollection `json:"freightCollection,omitempty" protobuf:"bytes,7,opt,name=freightCollection"`
 	// FinishedAt is the time when the promotion was completed.
 	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,6,opt,name=finishedAt"`
+	// DryRun indicates that the Promotion was executed in dry-run mode because
+	// its Stage requested it. A dry-run Promotion makes no changes to any
+	// external system and does not alter the Freight history of its Stage.
+	//
+	// +optional
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,8,opt,name=dryRun"`
+	// ChartChanges lists the changes made to the Helm charts referenced by the
+	// promoted Freight since the versions of those charts previously used by
+	// the Stage. Changes are taken from the artifacthub.io/changes annotation of
+	// each intervening version of a chart and are only available for charts from
+	// classic (HTTP/S) chart repositories.
+	//
+	// +optional
+	ChartChanges []ChartChange `json:"chartChanges,omitempty" protobuf:"bytes,10,rep,name=chartChanges"`
+}
+
+// ChartChange describes a single change introduced by a version of a Helm
+// chart.
+type ChartChange struct {
+	// RepoURL is the URL of the chart repository.
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// Name is the name of the chart.
+	Name string `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
+	// Version is the version of the chart that introduced the change.
+	Version string `json:"version" protobuf:"bytes,3,opt,name=version"`
+	// Description describes the change.
+	Description string `json:"description" protobuf:"bytes,4,opt,name=description"`
 }
 
 // WithPhase returns a copy of PromotionStatus with the given phase
@@ -74,6 +74,49 @@ func (r *VerificationRequest) String() string {
 	return string(b)
 }
 
+// SyncBackoffPolicy describes an exponential backoff that the controller
+// applies between consecutive failed reconciliations of a Stage. It can be
+// set on a Stage using the AnnotationKeySyncBackoffPolicy annotation.
+//
+// +protobuf=false
+// +k8s:deepcopy-gen=false
+// +k8s:openapi-gen=false
+type SyncBackoffPolicy struct {
+	// InitialDelay is the delay after the first failed reconciliation.
+	InitialDelay metav1.Duration `json:"initialDelay"`
+	// Multiplier is the factor by which the delay grows after each subsequent
+	// failed reconciliation. It must be at least 1.
+	Multiplier float64 `json:"multiplier"`
+	// MaxDelay is the upper bound of the delay.
+	MaxDelay metav1.Duration `json:"maxDelay"`
+}
+
+// IsValid returns true if the SyncBackoffPolicy has a positive InitialDelay,
+// a Multiplier of at least 1, and a MaxDelay no shorter than its InitialDelay.
+func (p *SyncBackoffPolicy) IsValid() bool {
+	return p != nil &&
+		p.InitialDelay.Duration > 0 &&
+		p.Multiplier >= 1 &&
+		p.MaxDelay.Duration >= p.InitialDelay.Duration
+}
+
+// Delay returns the delay the SyncBackoffPolicy prescribes after the
+// specified number of consecutive failed reconciliations. No delay is
+// prescribed if there have been no failures.
+func (p *SyncBackoffPolicy) Delay(failures int) time.Duration {
+	if failures <= 0 {
+		return 0
+	}
+	delay := float64(p.InitialDelay.Duration)
+	for i := 1; i < failures; i++ {
+		delay *= p.Multiplier
+		if delay >= float64(p.MaxDelay.Duration) {
+			return p.MaxDelay.Duration
+		}
+	}
+	return time.Duration(delay)
+}
+
 // GetStage returns a pointer to the Stage resource specified by the
 // namespacedName argument. If no such resource is found, nil is returned
 // instead.
@@ -206,3 +249,22 @@ func AbortStageFreightVerification(
 	}
 	return patchAnnotation(ctx, c, stage, AnnotationKeyAbort, ar.String())
 }
+
+// ClearStagePromoteFreightRequest removes the AnnotationKeyPromoteFreight
+// annotation from the provided Stage, indicating that the request to promote
+// the Freight it named has been handled.
+func ClearStagePromoteFreightRequest(
+	ctx context.Context,
+	c client.Client,
+	stage *Stage,
+) error {
+	if _, ok := stage.Annotations[AnnotationKeyPromoteFreight]; !ok {
+		return nil
+	}
+	if err := patchAnnotations(ctx, c, stage, map[string]*string{
+		AnnotationKeyPromoteFreight: nil,
+	}); err != nil {
+		return fmt.Errorf("clear promote freight request: %w", err)
+	}
+	return nil
+}
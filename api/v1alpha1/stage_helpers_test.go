@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -142,6 +143,79 @@ func TestVerificationRequest_String(t *testing.T) {
 	})
 }
 
+func TestSyncBackoffPolicy_IsValid(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy *SyncBackoffPolicy
+		valid  bool
+	}{
+		{
+			name: "policy is nil",
+		},
+		{
+			name: "initial delay is not positive",
+			policy: &SyncBackoffPolicy{
+				Multiplier: 2,
+				MaxDelay:   metav1.Duration{Duration: time.Minute},
+			},
+		},
+		{
+			name: "multiplier is less than one",
+			policy: &SyncBackoffPolicy{
+				InitialDelay: metav1.Duration{Duration: time.Second},
+				Multiplier:   0.5,
+				MaxDelay:     metav1.Duration{Duration: time.Minute},
+			},
+		},
+		{
+			name: "max delay is shorter than initial delay",
+			policy: &SyncBackoffPolicy{
+				InitialDelay: metav1.Duration{Duration: time.Minute},
+				Multiplier:   2,
+				MaxDelay:     metav1.Duration{Duration: time.Second},
+			},
+		},
+		{
+			name: "policy is valid",
+			policy: &SyncBackoffPolicy{
+				InitialDelay: metav1.Duration{Duration: time.Second},
+				Multiplier:   2,
+				MaxDelay:     metav1.Duration{Duration: time.Minute},
+			},
+			valid: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.valid, testCase.policy.IsValid())
+		})
+	}
+}
+
+func TestSyncBackoffPolicy_Delay(t *testing.T) {
+	policy := &SyncBackoffPolicy{
+		InitialDelay: metav1.Duration{Duration: 10 * time.Second},
+		Multiplier:   2,
+		MaxDelay:     metav1.Duration{Duration: time.Minute},
+	}
+	delays := make([]time.Duration, 6)
+	for i := range delays {
+		delays[i] = policy.Delay(i)
+	}
+	require.Equal(
+		t,
+		[]time.Duration{
+			0,
+			10 * time.Second,
+			20 * time.Second,
+			40 * time.Second,
+			time.Minute,
+			time.Minute,
+		},
+		delays,
+	)
+}
+
 func TestGetStage(t *testing.T) {
 	scheme := k8sruntime.NewScheme()
 	require.NoError(t, SchemeBuilder.AddToScheme(scheme))
@@ -476,3 +550,50 @@ func TestAbortStageFreightVerification(t *testing.T) {
 		}).String(), stage.Annotations[AnnotationKeyAbort])
 	})
 }
+
+func TestClearStagePromoteFreightRequest(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, SchemeBuilder.AddToScheme(scheme))
+
+	newFakeStage := func(annotations map[string]string) *Stage {
+		return &Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "fake-stage",
+				Namespace:   "fake-namespace",
+				Annotations: annotations,
+			},
+		}
+	}
+
+	t.Run("no promote freight annotation", func(t *testing.T) {
+		stage := newFakeStage(nil)
+		// The client knows nothing of the Stage, so any attempt to patch it
+		// would fail.
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		require.NoError(t, ClearStagePromoteFreightRequest(context.Background(), c, stage))
+	})
+
+	t.Run("promote freight annotation is removed", func(t *testing.T) {
+		stage := newFakeStage(map[string]string{
+			AnnotationKeyPromoteFreight: "fake-freight",
+			AnnotationKeyDescription:    "fake-description",
+		})
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(stage).Build()
+		require.NoError(t, ClearStagePromoteFreightRequest(context.Background(), c, stage))
+
+		patchedStage, err := GetStage(
+			context.Background(),
+			c,
+			types.NamespacedName{
+				Namespace: "fake-namespace",
+				Name:      "fake-stage",
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			map[string]string{AnnotationKeyDescription: "fake-description"},
+			patchedStage.Annotations,
+		)
+	})
+}
@@ -0,0 +1,32 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetStageRole returns a pointer to the StageRole resource specified by the
+// namespacedName argument. If no such resource is found, nil is returned
+// instead.
+func GetStageRole(
+	ctx context.Context,
+	c client.Client,
+	namespacedName types.NamespacedName,
+) (*StageRole, error) {
+	role := StageRole{}
+	if err := c.Get(ctx, namespacedName, &role); err != nil {
+		if err = client.IgnoreNotFound(err); err == nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf(
+			"error getting StageRole %q in namespace %q: %w",
+			namespacedName.Name,
+			namespacedName.Namespace,
+			err,
+		)
+	}
+	return &role, nil
+}
@@ -0,0 +1,68 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetStageRole(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, SchemeBuilder.AddToScheme(scheme))
+
+	testCases := []struct {
+		name       string
+		client     client.Client
+		assertions func(*testing.T, *StageRole, error)
+	}{
+		{
+			name:   "not found",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			assertions: func(t *testing.T, role *StageRole, err error) {
+				require.NoError(t, err)
+				require.Nil(t, role)
+			},
+		},
+
+		{
+			name: "found",
+			client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&StageRole{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-role",
+						Namespace: "fake-namespace",
+					},
+					Spec: StageRoleSpec{
+						Stage: "fake-stage",
+					},
+				},
+			).Build(),
+			assertions: func(t *testing.T, role *StageRole, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-role", role.Name)
+				require.Equal(t, "fake-namespace", role.Namespace)
+				require.Equal(t, "fake-stage", role.Spec.Stage)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			role, err := GetStageRole(
+				context.Background(),
+				testCase.client,
+				types.NamespacedName{
+					Namespace: "fake-namespace",
+					Name:      "fake-role",
+				},
+			)
+			testCase.assertions(t, role, err)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package v1alpha1
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name=Stage,type=string,JSONPath=`.spec.stage`
+// +kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
+
+// StageRole grants a set of subjects permission to get and patch a single
+// Stage in the same Project, without granting them access to any of the
+// Project's other Stages. This permits responsibility for a Stage, such as
+// approving what is promoted to it, to be delegated to a specific team.
+type StageRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec describes the Stage and the subjects that are granted access to it.
+	//
+	// +kubebuilder:validation:Required
+	Spec StageRoleSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// StageRoleSpec describes the Stage and the subjects that are granted access
+// to it by a StageRole.
+type StageRoleSpec struct {
+	// Stage is the name of a Stage in the same namespace as the StageRole. This
+	// is a required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Stage string `json:"stage" protobuf:"bytes,1,opt,name=stage"`
+	// Subjects are the users, groups, and ServiceAccounts that are granted
+	// permission to get and patch the Stage. At least one subject is required.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Subjects []rbacv1.Subject `json:"subjects" protobuf:"bytes,2,rep,name=subjects"`
+}
+
+// +kubebuilder:object:root=true
+
+// StageRoleList is a list of StageRole resources.
+type StageRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []StageRole `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
@@ -2,11 +2,18 @@ package v1alpha1
 
 import (
 	"crypto/sha1"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 type StagePhase string
@@ -23,6 +30,28 @@ const (
 	StagePhaseVerifying StagePhase = "Verifying"
 )
 
+// StageConditionType is the type of a condition of a Stage.
+type StageConditionType string
+
+const (
+	// StageConditionTypeSynced denotes whether the most recent reconciliation of
+	// a Stage completed without error.
+	StageConditionTypeSynced StageConditionType = "Synced"
+	// StageConditionTypePromoted denotes whether the most recent Promotion of a
+	// Stage succeeded.
+	StageConditionTypePromoted StageConditionType = "Promoted"
+	// StageConditionTypeHealthy denotes whether a Stage was last observed to be
+	// Healthy.
+	StageConditionTypeHealthy StageConditionType = "Healthy"
+	// StageConditionTypeDegraded denotes whether a Stage was last observed to be
+	// Unhealthy.
+	StageConditionTypeDegraded StageConditionType = "Degraded"
+	// StageConditionTypeCircuitOpen denotes whether the circuit breaker of a
+	// Stage is open, i.e. whether automatic Promotions of the Stage are
+	// suspended after too many consecutive failed Promotions.
+	StageConditionTypeCircuitOpen StageConditionType = "CircuitOpen"
+)
+
 type VerificationPhase string
 
 // Note: VerificationPhases are identical to AnalysisRunPhases. In almost all
@@ -74,6 +103,21 @@ const (
 	ImageUpdateValueTypeDigest         ImageUpdateValueType = "Digest"
 )
 
+// EditOrder is the order in which the edits making up a single Git-based
+// promotion are applied to the files of a repository.
+//
+// +kubebuilder:validation:Enum={Lexical,Listed}
+type EditOrder string
+
+const (
+	// EditOrderLexical denotes that files, and Kustomize images within a
+	// single directory, are updated in lexical order of their paths and names.
+	EditOrderLexical EditOrder = "Lexical"
+	// EditOrderListed denotes that files, and Kustomize images within a single
+	// directory, are updated in the order in which they are first listed.
+	EditOrderListed EditOrder = "Listed"
+)
+
 type HealthState string
 
 const (
@@ -128,6 +172,7 @@ const FreightOriginKindWarehouse FreightOriginKind = "Warehouse"
 // +kubebuilder:printcolumn:name=Current Freight,type=string,JSONPath=`.status.freightSummary`
 // +kubebuilder:printcolumn:name=Health,type=string,JSONPath=`.status.health.status`
 // +kubebuilder:printcolumn:name=Phase,type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name=Last Promotion,type=date,JSONPath=`.status.lastPromotion.finishedAt`
 // +kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Stage is the Kargo API's main type.
@@ -147,6 +192,13 @@ func (s *Stage) GetStatus() *StageStatus {
 	return &s.Status
 }
 
+// IsControlFlow returns true if the Stage neither specifies PromotionMechanisms
+// nor references a PromotionTemplate. Such a Stage only aggregates Freight from
+// upstream Stages and cannot be promoted to.
+func (s *Stage) IsControlFlow() bool {
+	return s.Spec.PromotionMechanisms == nil && s.Spec.PromotionTemplateRef == nil
+}
+
 // StageSpec describes the sources of Freight used by a Stage and how to
 // incorporate Freight into the Stage.
 type StageSpec struct {
@@ -155,6 +207,10 @@ type StageSpec struct {
 	// shard. A defaulting webhook will sync the value of the
 	// kargo.akuity.io/shard label with the value of this field. When this field
 	// is empty, the webhook will ensure that label is absent.
+	//
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=^(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?$
+	// +optional
 	Shard string `json:"shard,omitempty" protobuf:"bytes,4,opt,name=shard"`
 	// Subscriptions describes the Stage's sources of Freight. This is a required
 	// field.
@@ -182,6 +238,387 @@ type StageSpec struct {
 	// Verification describes how to verify a Stage's current Freight is fit for
 	// promotion downstream.
 	Verification *Verification `json:"verification,omitempty" protobuf:"bytes,3,opt,name=verification"`
+	// DryRun indicates that Promotions to this Stage should execute their
+	// promotion mechanisms without writing to any external system. Changes that
+	// would have been pushed to Git repositories or applied to Argo CD
+	// Applications are logged instead, and successful Promotions do not alter
+	// the Stage's Freight history.
+	//
+	// +optional
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,6,opt,name=dryRun"`
+	// FreightHistoryLimit is the maximum number of entries retained in the
+	// Stage's Freight history. When a successful Promotion causes the history to
+	// grow beyond this limit, the oldest entries are removed. When left
+	// unspecified, the limit is 10.
+	//
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	FreightHistoryLimit int32 `json:"freightHistoryLimit,omitempty" protobuf:"varint,7,opt,name=freightHistoryLimit"`
+	// NotificationWebhooks describes HTTP endpoints to be notified each time
+	// Freight is successfully promoted to the Stage. Notifications are delivered
+	// asynchronously and failures to deliver them do not affect the Stage.
+	//
+	// +optional
+	NotificationWebhooks []WebhookConfig `json:"notificationWebhooks,omitempty" protobuf:"bytes,8,rep,name=notificationWebhooks"`
+	// ConcurrencyPolicy specifies how a Promotion to this Stage is handled while
+	// a Promotion to another Stage is updating any of the same Git repositories.
+	// Allow, the default, lets both Promotions proceed. Forbid leaves this
+	// Promotion waiting until the other Promotion has concluded. Replace aborts
+	// the other Promotion so that this one can proceed immediately. Promotions
+	// to Stages with the Allow policy never wait for, or abort, other
+	// Promotions.
+	//
+	// +kubebuilder:default=Allow
+	// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty" protobuf:"bytes,9,opt,name=concurrencyPolicy"`
+	// PromotionTimeout is the maximum amount of time a Promotion to this Stage
+	// may spend executing its promotion mechanisms, including any promotion
+	// hooks, in a single attempt. A Promotion that exceeds it is aborted and
+	// marked as Failed. If unspecified, no timeout is enforced.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+	// +optional
+	PromotionTimeout *metav1.Duration `json:"promotionTimeout,omitempty" protobuf:"bytes,10,opt,name=promotionTimeout"`
+	// CircuitBreaker describes when automatic Promotions to this Stage are
+	// suspended following repeated Promotion failures. If unspecified,
+	// automatic Promotions are never suspended.
+	//
+	// +optional
+	CircuitBreaker *CircuitBreaker `json:"circuitBreaker,omitempty" protobuf:"bytes,11,opt,name=circuitBreaker"`
+	// HealthChecks describes checks, in addition to those of any Argo CD
+	// Applications updated by the Stage, that are performed when assessing the
+	// health of the Stage.
+	//
+	// +optional
+	HealthChecks *HealthChecks `json:"healthChecks,omitempty" protobuf:"bytes,12,opt,name=healthChecks"`
+	// PrePromotionHook describes a Job that is run in the Stage's namespace
+	// before the promotion mechanisms of a Promotion to this Stage are
+	// executed. The promotion mechanisms are only executed once the Job has
+	// completed successfully. If the Job fails, the Promotion fails without
+	// any changes having been made.
+	//
+	// +optional
+	PrePromotionHook *JobTemplate `json:"prePromotionHook,omitempty" protobuf:"bytes,13,opt,name=prePromotionHook"`
+	// PostPromotionHook describes a Job that is run in the Stage's namespace
+	// after the promotion mechanisms of a Promotion to this Stage have
+	// succeeded. The Promotion only succeeds once the Job has completed
+	// successfully. If the Job fails, the Promotion fails, but changes already
+	// made by the promotion mechanisms are not reverted.
+	//
+	// +optional
+	PostPromotionHook *JobTemplate `json:"postPromotionHook,omitempty" protobuf:"bytes,14,opt,name=postPromotionHook"`
+	// Pipeline is the name of the pipeline that this Stage belongs to. This is an
+	// optional field. Stages belonging to the same pipeline are promoted in
+	// waves: a Promotion to a Stage is only executed once every Stage of the
+	// same pipeline in a lower wave is healthy. A defaulting webhook will sync
+	// the value of the kargo.akuity.io/pipeline label with the value of this
+	// field. When this field is empty, the webhook will ensure that label is
+	// absent.
+	//
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$
+	// +optional
+	Pipeline string `json:"pipeline,omitempty" protobuf:"bytes,15,opt,name=pipeline"`
+	// Wave is the position of this Stage within its pipeline. Promotions to
+	// this Stage are only executed once every Stage of the same pipeline with a
+	// lower Wave is healthy. It is ignored if Pipeline is not specified. When
+	// left unspecified, the wave is 0.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Wave int32 `json:"wave,omitempty" protobuf:"varint,16,opt,name=wave"`
+	// PromotionTemplateRef references a PromotionTemplate in the Stage's
+	// namespace whose PromotionMechanisms describe how to incorporate Freight
+	// into the Stage. Any PromotionMechanisms specified by the Stage itself are
+	// merged with those of the PromotionTemplate, with the Stage's taking
+	// precedence wherever both describe the same update or check.
+	//
+	// +optional
+	PromotionTemplateRef *corev1.LocalObjectReference `json:"promotionTemplateRef,omitempty" protobuf:"bytes,17,opt,name=promotionTemplateRef"`
+	// AutoPromotionExpression is an optional CEL expression that must evaluate
+	// to true for a piece of Freight to be promoted to the Stage automatically,
+	// e.g. `freight.images.all(i, !i.tag.contains("-rc"))`. The candidate
+	// Freight and the Stage are available to the expression as the freight and
+	// stage variables, respectively, with the same field names as their JSON
+	// representations. When the expression evaluates to false, the Freight is
+	// not promoted automatically, as if auto-promotion were not permitted.
+	// Promotions that are requested explicitly are not subject to it.
+	//
+	// +optional
+	AutoPromotionExpression string `json:"autoPromotionExpression,omitempty" protobuf:"bytes,18,opt,name=autoPromotionExpression"`
+	// PinnedFreight is the name of a piece of Freight the Stage is pinned to.
+	// While it is set, no Promotions of the Stage are created automatically,
+	// regardless of whether auto-promotion is otherwise permitted, so that the
+	// Stage remains at a known-good state, e.g. during an incident. The health of
+	// the Stage continues to be assessed and a Warning event is recorded whenever
+	// newer Freight is available to the Stage. Removing the field resumes
+	// auto-promotion.
+	//
+	// +optional
+	PinnedFreight string `json:"pinnedFreight,omitempty" protobuf:"bytes,19,opt,name=pinnedFreight"`
+	// PromotionSchedule is an optional cron expression, in the standard five-field
+	// format and evaluated in UTC, describing when the newest Freight available to
+	// the Stage is promoted to it, e.g. "0 2 * * *" for a nightly rollout. At each
+	// scheduled time, the newest Freight available from each origin is promoted,
+	// even if it is already the Stage's current Freight. When a schedule is
+	// specified, newly available Freight is no longer promoted as soon as it
+	// becomes available. Scheduled Promotions are otherwise subject to the same
+	// conditions as auto-promotion: the Stage's Project must permit auto-promotion
+	// of the Stage, and scheduled Promotions are skipped while the Stage is pinned
+	// or its circuit is open.
+	//
+	// +optional
+	PromotionSchedule string `json:"promotionSchedule,omitempty" protobuf:"bytes,20,opt,name=promotionSchedule"`
+}
+
+// JobTemplate describes a Job that is run as a promotion hook.
+type JobTemplate struct {
+	// Template is the template of the Job. The Job is created in the Stage's
+	// namespace with a name derived from the Promotion it is run for. Any name
+	// or namespace specified by the template is ignored.
+	//
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Template batchv1.JobTemplateSpec `json:"template" protobuf:"bytes,1,opt,name=template"`
+}
+
+// CircuitBreaker describes when automatic Promotions to a Stage are suspended
+// following repeated Promotion failures. Once Threshold consecutive Promotions
+// to the Stage have failed, the circuit opens and no Promotions are
+// automatically created for the Stage until Cooldown has elapsed. The circuit
+// is then half-open: a single automatic Promotion is permitted, which closes
+// the circuit if it succeeds or opens it again if it fails.
+type CircuitBreaker struct {
+	// Threshold is the number of consecutive failed Promotions after which the
+	// circuit opens. When left unspecified, the threshold is 3.
+	//
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	Threshold int32 `json:"threshold,omitempty" protobuf:"varint,1,opt,name=threshold"`
+	// Cooldown is how long the circuit remains open before an automatic
+	// Promotion is attempted again. When left unspecified, the cooldown is 10
+	// minutes.
+	//
+	// +kubebuilder:default="10m"
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+	Cooldown metav1.Duration `json:"cooldown,omitempty" protobuf:"bytes,2,opt,name=cooldown"`
+}
+
+// GetThreshold returns the number of consecutive failed Promotions after which
+// the circuit opens. If no threshold is specified, DefaultCircuitBreakerThreshold
+// is returned.
+func (c *CircuitBreaker) GetThreshold() int32 {
+	if c == nil || c.Threshold <= 0 {
+		return DefaultCircuitBreakerThreshold
+	}
+	return c.Threshold
+}
+
+// GetCooldown returns how long the circuit remains open. If no cooldown is
+// specified, DefaultCircuitBreakerCooldown is returned.
+func (c *CircuitBreaker) GetCooldown() time.Duration {
+	if c == nil || c.Cooldown.Duration <= 0 {
+		return DefaultCircuitBreakerCooldown
+	}
+	return c.Cooldown.Duration
+}
+
+const (
+	// DefaultCircuitBreakerThreshold is the number of consecutive failed
+	// Promotions after which a circuit opens when no other threshold is
+	// specified.
+	DefaultCircuitBreakerThreshold = 3
+	// DefaultCircuitBreakerCooldown is how long a circuit remains open when no
+	// other cooldown is specified.
+	DefaultCircuitBreakerCooldown = 10 * time.Minute
+)
+
+// HealthChecks describes checks performed when assessing the health of a Stage.
+type HealthChecks struct {
+	// HTTPChecks describes HTTP endpoints that are probed when assessing the
+	// health of the Stage. The Stage is only considered healthy if each endpoint
+	// responds with its expected status code.
+	//
+	// +optional
+	HTTPChecks []HTTPHealthCheck `json:"httpChecks,omitempty" protobuf:"bytes,1,rep,name=httpChecks"`
+	// ArgoCDApps describes Argo CD Applications, other than those updated by
+	// the Stage, whose health and sync status are checked when assessing the
+	// health of the Stage. The Stage is only considered healthy if each
+	// Application is healthy and has its required sync status.
+	//
+	// +optional
+	ArgoCDApps []ArgoCDAppStatusCheck `json:"argocdApps,omitempty" protobuf:"bytes,2,rep,name=argocdApps"`
+	// PodImages describes Pods whose containers are checked to run the images
+	// of the Stage's current Freight when assessing the health of the Stage.
+	// Until every container of the matching Pods that runs an image from a
+	// repository referenced by the Freight runs the image referenced by the
+	// Freight, the Stage is considered to be progressing.
+	//
+	// +optional
+	PodImages []PodImageHealthCheck `json:"podImages,omitempty" protobuf:"bytes,3,rep,name=podImages"`
+}
+
+// HTTPHealthCheck describes an HTTP endpoint that is probed with a GET request
+// when assessing the health of a Stage.
+type HTTPHealthCheck struct {
+	// URL is the URL of the endpoint to probe.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=^https?://.+$
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// ExpectedStatusCode is the status code the endpoint must respond with for
+	// the Stage to be considered healthy. When left unspecified, 200 is expected.
+	//
+	// +kubebuilder:default=200
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	ExpectedStatusCode int32 `json:"expectedStatusCode,omitempty" protobuf:"varint,2,opt,name=expectedStatusCode"`
+	// TimeoutSeconds is the number of seconds after which a probe of the
+	// endpoint is abandoned and the Stage is considered unhealthy. When left
+	// unspecified, the timeout is 10 seconds. It may not exceed 60 seconds.
+	//
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=60
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty" protobuf:"varint,3,opt,name=timeoutSeconds"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when probing the endpoint.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,4,opt,name=insecureSkipTLSVerify"`
+}
+
+// GetExpectedStatusCode returns the status code the endpoint must respond
+// with. If no status code is specified, DefaultHTTPHealthCheckStatusCode is
+// returned.
+func (h *HTTPHealthCheck) GetExpectedStatusCode() int {
+	if h == nil || h.ExpectedStatusCode <= 0 {
+		return DefaultHTTPHealthCheckStatusCode
+	}
+	return int(h.ExpectedStatusCode)
+}
+
+// GetTimeout returns the duration after which a probe of the endpoint is
+// abandoned. If no timeout is specified, DefaultHTTPHealthCheckTimeout is
+// returned. The returned timeout never exceeds MaxHTTPHealthCheckTimeout.
+func (h *HTTPHealthCheck) GetTimeout() time.Duration {
+	if h == nil || h.TimeoutSeconds <= 0 {
+		return DefaultHTTPHealthCheckTimeout
+	}
+	return min(time.Duration(h.TimeoutSeconds)*time.Second, MaxHTTPHealthCheckTimeout)
+}
+
+const (
+	// DefaultHTTPHealthCheckStatusCode is the status code an endpoint must
+	// respond with when no other status code is specified.
+	DefaultHTTPHealthCheckStatusCode = 200
+	// DefaultHTTPHealthCheckTimeout is the duration after which a probe of an
+	// endpoint is abandoned when no other timeout is specified.
+	DefaultHTTPHealthCheckTimeout = 10 * time.Second
+	// MaxHTTPHealthCheckTimeout is the longest duration after which a probe of
+	// an endpoint is abandoned.
+	MaxHTTPHealthCheckTimeout = 60 * time.Second
+)
+
+// ArgoCDAppStatusCheck describes an Argo CD Application whose health and
+// sync status are checked when assessing the health of a Stage.
+type ArgoCDAppStatusCheck struct {
+	// Name is the name of the Argo CD Application.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace of the Argo CD Application. When left
+	// unspecified, the namespace of the Argo CD instance Kargo is configured to
+	// integrate with is used.
+	//
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// RequiredSyncStatus is the sync status the Argo CD Application must have
+	// for the Stage to be considered healthy. When left unspecified, the
+	// Application must be Synced.
+	//
+	// +kubebuilder:default=Synced
+	// +kubebuilder:validation:Enum=Synced;OutOfSync
+	RequiredSyncStatus ArgoCDAppSyncState `json:"requiredSyncStatus,omitempty" protobuf:"bytes,3,opt,name=requiredSyncStatus"`
+}
+
+// GetRequiredSyncStatus returns the sync status the Argo CD Application must
+// have. If no sync status is specified, ArgoCDAppSyncStateSynced is returned.
+func (a *ArgoCDAppStatusCheck) GetRequiredSyncStatus() ArgoCDAppSyncState {
+	if a == nil || a.RequiredSyncStatus == "" {
+		return ArgoCDAppSyncStateSynced
+	}
+	return a.RequiredSyncStatus
+}
+
+// PodImageHealthCheck describes Pods whose container images are checked
+// against the images of a Stage's current Freight when assessing the health
+// of the Stage.
+type PodImageHealthCheck struct {
+	// Namespace is the namespace of the Pods. When left unspecified, the
+	// namespace of the Stage is used.
+	//
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
+	// Selector is a label selector that selects the Pods to check.
+	Selector metav1.LabelSelector `json:"selector" protobuf:"bytes,2,opt,name=selector"`
+}
+
+// ConcurrencyPolicy describes how a Promotion is handled while a Promotion to
+// another Stage is updating any of the same Git repositories.
+type ConcurrencyPolicy string
+
+const (
+	// ConcurrencyPolicyAllow lets Promotions proceed regardless of other
+	// Promotions updating the same Git repositories.
+	ConcurrencyPolicyAllow ConcurrencyPolicy = "Allow"
+	// ConcurrencyPolicyForbid makes a Promotion wait until no other Promotion
+	// is updating any of the same Git repositories.
+	ConcurrencyPolicyForbid ConcurrencyPolicy = "Forbid"
+	// ConcurrencyPolicyReplace aborts any other Promotion updating any of the
+	// same Git repositories, so that a Promotion can proceed immediately.
+	ConcurrencyPolicyReplace ConcurrencyPolicy = "Replace"
+)
+
+// WebhookConfig describes an HTTP endpoint to be notified of successful
+// Promotions to a Stage.
+type WebhookConfig struct {
+	// URL is the URL of the endpoint to be notified.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=^https?://.+$
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Method is the HTTP method used to notify the endpoint. When left
+	// unspecified, the method is POST.
+	//
+	// +kubebuilder:default=POST
+	// +kubebuilder:validation:Enum=GET;POST
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+	// Headers are additional HTTP headers sent to the endpoint.
+	//
+	// +optional
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+	// BodyTemplate is a Go template rendered to produce the body of the request
+	// sent to the endpoint. The template is rendered against an object with the
+	// fields Project, Stage, and Promotion, which hold the names of the Stage's
+	// Project, the Stage, and the successful Promotion, and Freight, which holds
+	// the FreightCollection that was promoted. When left unspecified, the
+	// request has no body.
+	//
+	// +optional
+	BodyTemplate string `json:"bodyTemplate,omitempty" protobuf:"bytes,4,opt,name=bodyTemplate"`
+}
+
+// GetFreightHistoryLimit returns the maximum number of entries to be retained
+// in the Freight history of a Stage with this spec. If no limit is specified,
+// DefaultFreightHistoryLimit is returned.
+func (s *StageSpec) GetFreightHistoryLimit() int {
+	if s == nil || s.FreightHistoryLimit <= 0 {
+		return DefaultFreightHistoryLimit
+	}
+	return int(s.FreightHistoryLimit)
 }
 
 // Subscriptions describes a Stage's sources of Freight.
@@ -265,8 +702,36 @@ type FreightSources struct {
 	// Direct field must be true. i.e. Between the two fields, at least on source
 	// must be specified.
 	Stages []string `json:"stages,omitempty" protobuf:"bytes,2,rep,name=stages"`
+	// AvailabilityStrategy specifies how Freight verified in the upstream Stages
+	// specified by the Stages field is determined to be available to the Stage
+	// when more than one upstream Stage is specified. Freight verified in more
+	// than one upstream Stage is only ever considered once. Valid values are:
+	//
+	// - OneOf: Freight is available once it has been verified in ANY of the
+	//   upstream Stages. This is the default.
+	// - All: Freight is only available once it has been verified in ALL of the
+	//   upstream Stages.
+	//
+	// This affects which Freight the Stage considers available, e.g. for
+	// auto-promotion. Freight approved for the Stage is always available.
+	//
+	// +kubebuilder:validation:Enum=OneOf;All
+	AvailabilityStrategy FreightAvailabilityStrategy `json:"availabilityStrategy,omitempty" protobuf:"bytes,3,opt,name=availabilityStrategy"`
 }
 
+// FreightAvailabilityStrategy specifies how Freight verified in multiple
+// upstream Stages is determined to be available to a Stage.
+type FreightAvailabilityStrategy string
+
+const (
+	// FreightAvailabilityStrategyOneOf denotes that Freight is available once
+	// it has been verified in any of the upstream Stages.
+	FreightAvailabilityStrategyOneOf FreightAvailabilityStrategy = "OneOf"
+	// FreightAvailabilityStrategyAll denotes that Freight is only available once
+	// it has been verified in all of the upstream Stages.
+	FreightAvailabilityStrategyAll FreightAvailabilityStrategy = "All"
+)
+
 // PromotionMechanisms describes how to incorporate Freight into a Stage.
 type PromotionMechanisms struct {
 	// Origin disambiguates the origin from which artifacts used by this promotion
@@ -286,6 +751,97 @@ type PromotionMechanisms struct {
 	// updates specified by the GitRepoUpdates field, if any, are applied BEFORE
 	// these.
 	ArgoCDAppUpdates []ArgoCDAppUpdate `json:"argoCDAppUpdates,omitempty" protobuf:"bytes,2,rep,name=argoCDAppUpdates"`
+	// ChangeApproval describes a check that must find an approved change request
+	// in an external ticketing system before Freight is promoted to the Stage.
+	// This field is optional. When specified, the check is performed BEFORE any
+	// other promotion mechanisms are executed.
+	ChangeApproval *ChangeApprovalCheck `json:"changeApproval,omitempty" protobuf:"bytes,4,opt,name=changeApproval"`
+	// ImagePullCheck describes a check that must be able to retrieve the
+	// manifest of every image referenced by the Freight being promoted before
+	// that Freight is promoted to the Stage. This field is optional. When
+	// specified, the check is performed after any change approval check and
+	// BEFORE any other promotion mechanisms are executed.
+	ImagePullCheck *ImagePullCheck `json:"imagePullCheck,omitempty" protobuf:"bytes,5,opt,name=imagePullCheck"`
+	// FluxHelmReleaseUpdates describes updates that should be applied to Flux
+	// HelmRelease resources to incorporate Freight into the Stage. This field is
+	// optional, as such actions are not required in all cases. Note that all
+	// updates specified by the GitRepoUpdates field, if any, are applied BEFORE
+	// these.
+	FluxHelmReleaseUpdates []FluxHelmReleaseUpdate `json:"fluxHelmReleaseUpdates,omitempty" protobuf:"bytes,6,rep,name=fluxHelmReleaseUpdates"`
+	// KubernetesResourceUpdates describes JSON patches that should be applied to
+	// Kubernetes resources, such as Deployments, to incorporate Freight into the
+	// Stage. This field is optional, as such actions are not required in all
+	// cases. Note that all updates specified by the GitRepoUpdates field, if any,
+	// are applied BEFORE these.
+	KubernetesResourceUpdates []KubernetesResourceUpdate `json:"kubernetesResourceUpdates,omitempty" protobuf:"bytes,7,rep,name=kubernetesResourceUpdates"`
+	// Canary describes how to expose the Freight being promoted to only a
+	// percentage of traffic using the canary strategy of an Argo Rollouts Rollout.
+	// This field is optional. When specified, the Promotion is not considered
+	// complete until the Rollout's canary analysis has passed and the Rollout has
+	// been fully promoted. Note that all updates specified by the other fields, if
+	// any, are applied BEFORE the canary weight is set.
+	Canary *CanaryPromotion `json:"canary,omitempty" protobuf:"bytes,8,opt,name=canary"`
+}
+
+// ChangeApprovalCheck describes how to verify, using an external ticketing
+// system, that an approved change request exists for a Promotion.
+//
+// The ticketing system (or an adapter in front of it) is queried with an HTTP
+// GET request to the specified URL, with the project, stage, and freight query
+// parameters identifying the Promotion. It is expected to respond with HTTP 404
+// if no change request references the Promotion or with HTTP 200 and a JSON
+// body of the form {"id": "CHG0001234", "approved": true} otherwise.
+// Credentials for the ticketing system are looked up in the same manner as
+// repository credentials, using Secrets labeled with
+// kargo.akuity.io/cred-type: ticketing.
+type ChangeApprovalCheck struct {
+	// URL is the URL of the endpoint to query for change requests. This is a
+	// required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^https?://(\w+([\.-]\w+)*@)?\w+([\.-]\w+)*(:[\d]+)?(/.*)?$`
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+}
+
+// ImagePullCheck describes how to verify that the images referenced by Freight
+// can be pulled before the Freight is promoted to a Stage. This guards against
+// promotions that would leave workloads unable to start because an image is
+// missing from its registry or is inaccessible with the available credentials.
+type ImagePullCheck struct {
+	// PullSecret is the name of a Secret of type kubernetes.io/dockerconfigjson
+	// in the Stage's namespace, such as the image pull Secret used by the
+	// Stage's workloads, holding the credentials used to retrieve image
+	// manifests. When left unspecified, credentials are looked up in the same
+	// manner as for image subscriptions.
+	//
+	// +optional
+	PullSecret string `json:"pullSecret,omitempty" protobuf:"bytes,1,opt,name=pullSecret"`
+}
+
+// CanaryPromotion describes how to expose the Freight being promoted to a Stage
+// to only a percentage of traffic, using the canary strategy of an Argo Rollouts
+// Rollout residing in the same cluster as Kargo, instead of shifting all
+// traffic to it at once. The weight of the first setWeight step of the
+// Rollout's canary strategy is set to the specified percentage, after which
+// the Rollout's own steps, including any canary analysis, determine how it
+// advances. The Promotion remains Running until the Rollout has been fully
+// promoted and fails if the Rollout is aborted or becomes degraded. The
+// Rollout must be annotated with kargo.akuity.io/authorized-stage:
+// <project>:<stage> to permit the Stage to update it.
+type CanaryPromotion struct {
+	// Namespace is the namespace of the Rollout. When left unspecified, the
+	// Rollout is assumed to be in the Stage's namespace.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
+	// Rollout is the name of the Rollout. This is a required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Rollout string `json:"rollout" protobuf:"bytes,2,opt,name=rollout"`
+	// Weight is the percentage of traffic the canary receives before its
+	// analysis is awaited. This is a required field.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight" protobuf:"varint,3,opt,name=weight"`
 }
 
 // GitRepoUpdate describes updates that should be applied to a Git repository
@@ -340,6 +896,85 @@ type GitRepoUpdate struct {
 	// Helm describes how to use Helm to incorporate Freight into the Stage. This
 	// is mutually exclusive with the Render and Kustomize fields.
 	Helm *HelmPromotionMechanism `json:"helm,omitempty" protobuf:"bytes,8,opt,name=helm"`
+	// CommitMessageTemplate is a Go template rendered to produce the message of
+	// the commit made to the repository. The template is rendered against an
+	// object with the fields Project and Stage, which hold the names of the
+	// Stage's Project and the Stage, Freight, which holds the FreightCollection
+	// being promoted, Changes, which holds a summary of each change applied to
+	// the repository, and DefaultMessage, which holds the message used when no
+	// template is specified. When left unspecified, a message summarizing the
+	// changes is used.
+	//
+	// +optional
+	CommitMessageTemplate string `json:"commitMessageTemplate,omitempty" protobuf:"bytes,10,opt,name=commitMessageTemplate"`
+	// SigningKeySecretRef references a key of a Secret in the Stage's namespace
+	// holding an ASCII-armored GPG private key. When specified, commits made to
+	// the repository are signed with this key, which must not be protected by a
+	// passphrase. This field is optional.
+	SigningKeySecretRef *SecretKeyReference `json:"signingKeySecretRef,omitempty" protobuf:"bytes,11,opt,name=signingKeySecretRef"`
+	// AuthorName overrides the name of the author of commits made to the
+	// repository. When left unspecified, the name configured for the controller
+	// is used.
+	//
+	// +optional
+	AuthorName string `json:"authorName,omitempty" protobuf:"bytes,12,opt,name=authorName"`
+	// AuthorEmail overrides the email address of the author of commits made to
+	// the repository. When left unspecified, the email address configured for
+	// the controller is used.
+	//
+	// +optional
+	AuthorEmail string `json:"authorEmail,omitempty" protobuf:"bytes,13,opt,name=authorEmail"`
+	// ShallowCloneDepth optionally limits the number of commits fetched when
+	// cloning the repository to apply updates. This can considerably speed up
+	// Promotions for large repositories, but any commit that updates are based
+	// on must be within the specified depth of the head of its branch. If the
+	// remote repository does not support shallow clones, a full clone is
+	// performed instead. When left unspecified or set to zero, the full history
+	// of the repository is cloned.
+	//
+	// +kubebuilder:validation:Minimum=0
+	ShallowCloneDepth int32 `json:"shallowCloneDepth,omitempty" protobuf:"varint,14,opt,name=shallowCloneDepth"`
+	// SOPSDecryptBeforeApply specifies whether files in the repository whose
+	// names end in .sops.yaml are decrypted using SOPS before configuration
+	// management tools update the repository. Modified files are re-encrypted
+	// for the age recipients they were originally encrypted for before changes
+	// are committed. When true, SOPSAgeKeySecretRef must also be specified.
+	//
+	// +optional
+	SOPSDecryptBeforeApply bool `json:"sopsDecryptBeforeApply,omitempty" protobuf:"varint,15,opt,name=sopsDecryptBeforeApply"`
+	// SOPSAgeKeySecretRef references a key of a Secret in the Stage's namespace
+	// holding the age private key used to decrypt SOPS-encrypted files. It is
+	// required when SOPSDecryptBeforeApply is true.
+	//
+	// +optional
+	SOPSAgeKeySecretRef *SecretKeyReference `json:"sopsAgeKeySecretRef,omitempty" protobuf:"bytes,16,opt,name=sopsAgeKeySecretRef"`
+	// EditOrder specifies the order in which the Kustomize or Helm edits making
+	// up the single commit to the repository are applied. Valid values are:
+	//
+	// - Lexical: Files, and Kustomize images within a single directory, are
+	//   updated in lexical order of their paths and names.
+	// - Listed: Files, and Kustomize images within a single directory, are
+	//   updated in the order in which they are first listed.
+	//
+	// Either way, promoting the same Freight always produces the same diff.
+	// When left unspecified, the default is Lexical.
+	//
+	// +kubebuilder:default=Lexical
+	// +optional
+	EditOrder EditOrder `json:"editOrder,omitempty" protobuf:"bytes,17,opt,name=editOrder"`
+}
+
+// SecretKeyReference references a key of a Secret in the same namespace as
+// the resource that references it.
+type SecretKeyReference struct {
+	// Name is the name of the Secret.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Key is the key of the Secret's data that holds the referenced value.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
 }
 
 // PullRequestPromotionMechanism describes how to generate a pull request against the write branch during promotion
@@ -349,6 +984,11 @@ type PullRequestPromotionMechanism struct {
 	GitHub *GitHubPullRequest `json:"github,omitempty" protobuf:"bytes,1,opt,name=github"`
 	// GitLab indicates git provider is GitLab
 	GitLab *GitLabPullRequest `json:"gitlab,omitempty" protobuf:"bytes,2,opt,name=gitlab"`
+	// DeleteBranchOnMerge specifies whether the branch from which a pull request
+	// was opened should be deleted from the remote repository once the pull
+	// request has been merged. The branch is never deleted while any other open
+	// pull request uses it.
+	DeleteBranchOnMerge bool `json:"deleteBranchOnMerge,omitempty" protobuf:"varint,3,opt,name=deleteBranchOnMerge"`
 }
 
 type GitHubPullRequest struct {
@@ -421,6 +1061,16 @@ type KustomizePromotionMechanism struct {
 	// ambiguity regarding from which piece of Freight an artifact is to be
 	// sourced.
 	Origin *FreightOrigin `json:"origin,omitempty" protobuf:"bytes,2,opt,name=origin"`
+	// Components lists the paths of directories containing Kustomize components
+	// whose kustomization.yaml files should also be updated. Every entry of a
+	// component's images section that names an image updated by this promotion
+	// mechanism is updated to reference the same version of that image. Images a
+	// component does not already list are not added to it. This field is
+	// optional.
+	//
+	// +kubebuilder:validation:items:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+	// +optional
+	Components []string `json:"components,omitempty" protobuf:"bytes,3,rep,name=components"`
 }
 
 // KustomizeImageUpdate describes how to run `kustomize edit set image`
@@ -451,6 +1101,39 @@ type KustomizeImageUpdate struct {
 	//
 	// +kubebuilder:validation:Optional
 	UseDigest bool `json:"useDigest" protobuf:"varint,3,opt,name=useDigest"`
+	// StripRegistryHost specifies whether the registry host should be removed
+	// from the image reference written to the kustomization.yaml file. This is
+	// useful when the kustomization.yaml file references images by short names
+	// and a registry mirror is relied upon to resolve them.
+	//
+	// +kubebuilder:validation:Optional
+	StripRegistryHost bool `json:"stripRegistryHost,omitempty" protobuf:"varint,5,opt,name=stripRegistryHost"`
+	// ReplacementSource optionally designates a literal of a ConfigMap generated
+	// by the kustomization.yaml file in Path as the single source of the image
+	// reference. When specified, `kustomize edit set image` is not executed.
+	// Instead, the value of the literal is set to the image reference and the
+	// kustomization's replacements are relied upon to propagate it to every
+	// field that consumes it.
+	//
+	// +kubebuilder:validation:Optional
+	ReplacementSource *KustomizeReplacementSource `json:"replacementSource,omitempty" protobuf:"bytes,6,opt,name=replacementSource"`
+}
+
+// KustomizeReplacementSource identifies a literal of a ConfigMap generated by
+// a kustomization.yaml file's configMapGenerator that serves as the source of
+// one or more of the kustomization's replacements.
+type KustomizeReplacementSource struct {
+	// ConfigMap is the name of the ConfigMap, as it appears in the
+	// configMapGenerator section of the kustomization.yaml file. This is a
+	// required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	ConfigMap string `json:"configMap" protobuf:"bytes,1,opt,name=configMap"`
+	// Key is the key of the literal whose value should be set to the image
+	// reference. The literal must already exist. This is a required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
 }
 
 // HelmPromotionMechanism describes how to use Helm to incorporate Freight into
@@ -472,6 +1155,38 @@ type HelmPromotionMechanism struct {
 	// ambiguity regarding from which piece of Freight an artifact is to be
 	// sourced.
 	Origin *FreightOrigin `json:"origin,omitempty" protobuf:"bytes,3,opt,name=origin"`
+	// ValuesFilePath specifies a path to a Helm values file into which the
+	// contents of ValuesOverrides are to be merged. This field is optional, but
+	// is required if ValuesOverrides is specified.
+	//
+	// +kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+	ValuesFilePath string `json:"valuesFilePath,omitempty" protobuf:"bytes,4,opt,name=valuesFilePath"`
+	// ValuesOverrides specifies arbitrary values to be deep-merged into the Helm
+	// values file at ValuesFilePath after any image and chart dependency updates
+	// have been applied. Nested maps are merged key by key. Any other value,
+	// including a list, replaces the existing value at the same key. Keys not
+	// specified here are left untouched, as are comments wherever possible.
+	// This field is optional.
+	//
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	ValuesOverrides *runtime.RawExtension `json:"valuesOverrides,omitempty" protobuf:"bytes,5,opt,name=valuesOverrides"`
+	// UmbrellaChartPath specifies a path to an umbrella chart whose dependencies
+	// are all kept up to date with the Freight being promoted. Each dependency
+	// listed in the umbrella chart's Chart.yaml that is referenced by the Freight
+	// has its version updated to the one referenced by the Freight, unless it
+	// already matches. Dependencies not referenced by the Freight are left
+	// untouched. Chart.lock is regenerated whenever any version changes. This
+	// field is optional.
+	//
+	// +kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+	UmbrellaChartPath string `json:"umbrellaChartPath,omitempty" protobuf:"bytes,6,opt,name=umbrellaChartPath"`
+	// ValuesFiles describes an ordered chain of Helm values files, such as a base
+	// values file followed by the files that override it, along with the changes
+	// to be made to each of them. Files are updated in the order in which they are
+	// listed, after any image and chart dependency updates have been applied and
+	// before ValuesOverrides are merged. This field is optional.
+	ValuesFiles []HelmValuesFileUpdate `json:"valuesFiles,omitempty" protobuf:"bytes,7,rep,name=valuesFiles"`
 }
 
 // HelmImageUpdate describes how a specific image version can be incorporated
@@ -517,6 +1232,27 @@ type HelmImageUpdate struct {
 	Value ImageUpdateValueType `json:"value" protobuf:"bytes,4,opt,name=value"`
 }
 
+// HelmValuesFileUpdate describes changes to be made to a single Helm values
+// file within a chain of values files.
+type HelmValuesFileUpdate struct {
+	// Path specifies a path to the Helm values file that is to be updated. This
+	// is a required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+	// Updates maps keys of the form <key 0>.<key 1>...<key n> to the new values
+	// those keys should be set to. Values are written to the file as they are, so
+	// each must be valid YAML. A key that does not exist in this file, but that
+	// does exist in a values file listed before it, is added to this file. This
+	// permits an override file to begin overriding a value defined by a file it
+	// overrides. Any other key that does not exist in this file is ignored. This
+	// is a required field.
+	//
+	// +kubebuilder:validation:MinProperties=1
+	Updates map[string]string `json:"updates" protobuf:"bytes,2,rep,name=updates"`
+}
+
 // HelmChartDependencyUpdate describes how a specific Helm chart that is used
 // as a subchart of an umbrella chart can be updated.
 type HelmChartDependencyUpdate struct {
@@ -583,6 +1319,74 @@ type ArgoCDAppUpdate struct {
 	// SourceUpdates describes updates to be applied to various sources of the
 	// specified Argo CD Application resource.
 	SourceUpdates []ArgoCDSourceUpdate `json:"sourceUpdates,omitempty" protobuf:"bytes,3,rep,name=sourceUpdates"`
+	// SourceIndex optionally identifies, by its zero-based index in the
+	// Application's spec.sources, the single source of a multi-source Argo CD
+	// Application that SourceUpdates are applied to. When left unspecified,
+	// SourceUpdates are applied to the Application's singular source or to each
+	// of its sources that they match. Promotions fail if the index is out of
+	// bounds of the Application's sources.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SourceIndex *int32 `json:"sourceIndex,omitempty" protobuf:"varint,8,opt,name=sourceIndex"`
+	// HealthCheck optionally describes an additional condition that must be
+	// satisfied by the specified Argo CD Application resource for it to be
+	// considered healthy. This is useful when an Application's readiness is
+	// encoded in fields other than its health and sync status.
+	HealthCheck *ArgoCDAppHealthCheck `json:"healthCheck,omitempty" protobuf:"bytes,5,opt,name=healthCheck"`
+	// ArgoCDClusterName optionally identifies a remote cluster in which the
+	// specified Argo CD Application resource is managed. When specified, the
+	// Application is updated using credentials of type argocd whose repoURL is
+	// argocd://<cluster-name>. If left unspecified, the Application is managed by
+	// the Argo CD instance Kargo is configured to integrate with.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
+	ArgoCDClusterName string `json:"argoCDClusterName,omitempty" protobuf:"bytes,6,opt,name=argoCDClusterName"`
+	// ResourceType specifies the kind of Argo CD resource named by AppName.
+	// Application, the default, updates the sources of an individual
+	// Application. ApplicationSet updates the sources of the Application
+	// template of an ApplicationSet, so that all Applications generated from
+	// it are updated by the Argo CD ApplicationSet controller.
+	//
+	// +kubebuilder:default=Application
+	// +kubebuilder:validation:Enum=Application;ApplicationSet
+	ResourceType ArgoCDResourceType `json:"resourceType,omitempty" protobuf:"bytes,7,opt,name=resourceType"`
+	// SyncTimeout is the maximum amount of time to wait for a sync operation of
+	// the specified Argo CD Application resource to complete. A Promotion that
+	// is still waiting for the operation once it has been running for longer
+	// than this is marked as Failed. If unspecified, the Promotion waits for
+	// the operation to complete for as long as it takes.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+	// +optional
+	SyncTimeout *metav1.Duration `json:"syncTimeout,omitempty" protobuf:"bytes,9,opt,name=syncTimeout"`
+}
+
+// ArgoCDResourceType describes the kind of Argo CD resource updated by an
+// ArgoCDAppUpdate.
+type ArgoCDResourceType string
+
+const (
+	// ArgoCDResourceTypeApplication denotes an Argo CD Application.
+	ArgoCDResourceTypeApplication ArgoCDResourceType = "Application"
+	// ArgoCDResourceTypeApplicationSet denotes an Argo CD ApplicationSet.
+	ArgoCDResourceTypeApplicationSet ArgoCDResourceType = "ApplicationSet"
+)
+
+// ArgoCDAppHealthCheck describes a custom health check for an Argo CD
+// Application resource.
+type ArgoCDAppHealthCheck struct {
+	// JSONPath is a JSONPath expression (e.g. "{.status.operationState.phase}")
+	// that is evaluated against the Argo CD Application resource. If the
+	// expression is not enclosed in curly braces, they will be added.
+	//
+	// +kubebuilder:validation:MinLength=1
+	JSONPath string `json:"jsonPath" protobuf:"bytes,1,opt,name=jsonPath"`
+	// ExpectedValue is the value the JSONPath expression must evaluate to for
+	// the Argo CD Application resource to be considered healthy.
+	ExpectedValue string `json:"expectedValue" protobuf:"bytes,2,opt,name=expectedValue"`
 }
 
 // ArgoCDSourceUpdate describes updates that should be applied to one of an Argo
@@ -730,6 +1534,77 @@ type ArgoCDHelmImageUpdate struct {
 	Value ImageUpdateValueType `json:"value" protobuf:"bytes,3,opt,name=value"`
 }
 
+// FluxHelmReleaseUpdate describes an update that should be applied to a Flux
+// HelmRelease resource to incorporate Freight into a Stage. The version of the
+// HelmRelease's chart is updated to the version of the specified chart found
+// in the Freight. The HelmRelease must be annotated with
+// kargo.akuity.io/authorized-stage: <project>:<stage> to permit the Stage to
+// update it.
+type FluxHelmReleaseUpdate struct {
+	// Namespace is the namespace of the HelmRelease. When left unspecified,
+	// the HelmRelease is assumed to be in the Stage's namespace.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
+	// Name is the name of the HelmRelease. This is a required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// RepoURL along with the Chart field identifies the chart, as it appears
+	// in Freight, whose version the HelmRelease's chart is updated to. For
+	// charts stored in classic chart repositories, this is the URL of the
+	// repository. For charts stored in OCI registries, this is the full URL of
+	// the chart, including the oci:// prefix. This is a required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	RepoURL string `json:"repoURL" protobuf:"bytes,3,opt,name=repoURL"`
+	// Chart along with the RepoURL field identifies the chart, as it appears
+	// in Freight, whose version the HelmRelease's chart is updated to. This
+	// field should be left unspecified for charts stored in OCI registries.
+	Chart string `json:"chart,omitempty" protobuf:"bytes,4,opt,name=chart"`
+	// Origin disambiguates the origin from which artifacts used by this promotion
+	// mechanism must have originated. This is especially useful in cases where a
+	// Stage may request Freight from multiples origins (e.g. multiple Warehouses)
+	// and some of those each reference different versions of artifacts from the
+	// same repository. This field is optional. When left unspecified, it will
+	// implicitly inherit the value of the enclosing PromotionMechanisms' Origin
+	// field. If that, too, is unspecified, Promotions will fail if there is ever
+	// ambiguity regarding from which piece of Freight an artifact is to be
+	// sourced.
+	Origin *FreightOrigin `json:"origin,omitempty" protobuf:"bytes,5,opt,name=origin"`
+}
+
+// KubernetesResourceUpdate describes a JSON patch that should be applied to a
+// Kubernetes resource, such as a Deployment or StatefulSet, residing in the
+// same cluster as Kargo to incorporate Freight into a Stage. The resource must
+// be annotated with kargo.akuity.io/authorized-stage: <project>:<stage> to
+// permit the Stage to update it.
+type KubernetesResourceUpdate struct {
+	// APIVersion is the API version of the resource, e.g. apps/v1. This is a
+	// required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	APIVersion string `json:"apiVersion" protobuf:"bytes,1,opt,name=apiVersion"`
+	// Kind is the kind of the resource, e.g. Deployment. This is a required
+	// field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Namespace is the namespace of the resource. When left unspecified, the
+	// resource is assumed to be in the Stage's namespace.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	// Name is the name of the resource within its namespace. This is a
+	// required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name" protobuf:"bytes,4,opt,name=name"`
+	// JSONPatch is a JSON patch, as described by RFC 6902, to apply to the
+	// resource. It is rendered as a Go template before being applied, with the
+	// names of the Project and Stage and the Freight being promoted available as
+	// .Project, .Stage, and .Freight respectively. This is a required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	JSONPatch string `json:"jsonPatch" protobuf:"bytes,5,opt,name=jsonPatch"`
+}
+
 // StageStatus describes a Stages's current and recent Freight, health, and
 // more.
 type StageStatus struct {
@@ -745,6 +1620,14 @@ type StageStatus struct {
 	// The first item in the list is the most recent Freight selection and
 	// currently deployed to the Stage, subsequent items are older selections.
 	FreightHistory FreightHistory `json:"freightHistory,omitempty" protobuf:"bytes,4,rep,name=freightHistory" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// FreightHistoryDeltas is a compact representation of a FreightHistory, for
+	// Stages whose history would otherwise cause the Stage to exceed the maximum
+	// size of a resource. Entries are ordered like those of FreightHistory, with
+	// the most recent first. The last entry holds a full baseline and every other
+	// entry holds the differences from the entry that follows it. Use
+	// ReconstructFreightCollection to obtain the FreightCollection represented by
+	// any entry.
+	FreightHistoryDeltas []FreightCollectionDelta `json:"freightHistoryDeltas,omitempty" protobuf:"bytes,17,rep,name=freightHistoryDeltas"`
 	// FreightSummary is human-readable text maintained by the controller that
 	// summarizes what Freight is currently deployed to the Stage. For Stages that
 	// request a single piece of Freight AND the request has been fulfilled, this
@@ -768,6 +1651,14 @@ type StageStatus struct {
 	History FreightReferenceStack `json:"history,omitempty" protobuf:"bytes,3,rep,name=history"`
 	// Health is the Stage's last observed health.
 	Health *Health `json:"health,omitempty" protobuf:"bytes,8,opt,name=health"`
+	// Conditions contains the last observations of the Stage's current state.
+	// Condition types are those of StageConditionType.
+	//
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,15,rep,name=conditions"`
 	// Message describes any errors that are preventing the Stage controller
 	// from assessing Stage health or from finding new Freight.
 	Message string `json:"message,omitempty" protobuf:"bytes,9,opt,name=message"`
@@ -778,6 +1669,44 @@ type StageStatus struct {
 	CurrentPromotion *PromotionReference `json:"currentPromotion,omitempty" protobuf:"bytes,7,opt,name=currentPromotion"`
 	// LastPromotion is a reference to the last completed promotion.
 	LastPromotion *PromotionReference `json:"lastPromotion,omitempty" protobuf:"bytes,10,opt,name=lastPromotion"`
+	// NotificationWebhooks describes the last attempt to deliver a notification
+	// to each of the Stage's notification webhooks.
+	NotificationWebhooks []WebhookDeliveryStatus `json:"notificationWebhooks,omitempty" protobuf:"bytes,13,rep,name=notificationWebhooks"`
+	// CircuitBreaker describes the state of the Stage's circuit breaker. It is
+	// only maintained for Stages that specify a circuit breaker.
+	CircuitBreaker *CircuitBreakerStatus `json:"circuitBreaker,omitempty" protobuf:"bytes,14,opt,name=circuitBreaker"`
+	// NextScheduledPromotion is the time at which the Stage is next scheduled to be
+	// promoted. It is only maintained for Stages that specify a PromotionSchedule. It is absent while
+	// scheduled Promotions are suspended.
+	NextScheduledPromotion *metav1.Time `json:"nextScheduledPromotion,omitempty" protobuf:"bytes,16,opt,name=nextScheduledPromotion"`
+}
+
+// CircuitBreakerStatus describes the state of a Stage's circuit breaker.
+type CircuitBreakerStatus struct {
+	// ConsecutiveFailures is the number of Promotions to the Stage that have
+	// failed since the last successful Promotion.
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty" protobuf:"varint,1,opt,name=consecutiveFailures"`
+	// OpenedAt is the time at which the circuit was last opened. It is unset
+	// while the circuit is closed.
+	OpenedAt *metav1.Time `json:"openedAt,omitempty" protobuf:"bytes,2,opt,name=openedAt"`
+}
+
+// WebhookDeliveryStatus describes the last attempt to deliver a notification
+// to a notification webhook.
+type WebhookDeliveryStatus struct {
+	// URL is the URL of the notification webhook.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Promotion is the name of the Promotion the notification was sent for.
+	Promotion string `json:"promotion,omitempty" protobuf:"bytes,2,opt,name=promotion"`
+	// DeliveredAt is the time at which the delivery was attempted.
+	DeliveredAt *metav1.Time `json:"deliveredAt,omitempty" protobuf:"bytes,3,opt,name=deliveredAt"`
+	// StatusCode is the HTTP status code returned by the notification webhook,
+	// if any.
+	StatusCode int32 `json:"statusCode,omitempty" protobuf:"varint,4,opt,name=statusCode"`
+	// Succeeded indicates whether the notification was delivered successfully.
+	Succeeded bool `json:"succeeded,omitempty" protobuf:"varint,5,opt,name=succeeded"`
+	// Message describes why the delivery failed, if it did.
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
 }
 
 // FreightReference is a simplified representation of a piece of Freight -- not
@@ -826,6 +1755,19 @@ type FreightCollection struct {
 	VerificationHistory VerificationInfoStack `json:"verificationHistory,omitempty" protobuf:"bytes,2,rep,name=verificationHistory"`
 }
 
+// FreightCollectionDelta represents a FreightCollection in a
+// FreightHistoryDeltas list, either in full or as the differences from the
+// FreightCollection recorded before it.
+type FreightCollectionDelta struct {
+	// Baseline is the full FreightCollection. It is only specified by the oldest
+	// entry of a list.
+	Baseline *FreightCollection `json:"baseline,omitempty" protobuf:"bytes,1,opt,name=baseline"`
+	// Patch is a JSON merge patch (RFC 7386) that, applied to the
+	// FreightCollection recorded before this one, produces this one. It is
+	// specified by every entry of a list except the oldest.
+	Patch string `json:"patch,omitempty" protobuf:"bytes,2,opt,name=patch"`
+}
+
 // UpdateOrPush updates the entry in the FreightCollection based on the
 // Warehouse name of the provided FreightReference. If no such entry exists, the
 // provided FreightReference is appended to the FreightCollection. This function
@@ -866,6 +1808,10 @@ func (f *FreightCollection) References() []FreightReference {
 	return refs
 }
 
+// DefaultFreightHistoryLimit is the maximum number of entries retained in a
+// FreightHistory when no other limit is specified.
+const DefaultFreightHistoryLimit = 10
+
 // FreightHistory is a linear list of FreightCollection items. The list is
 // ordered by the time at which the FreightCollection was recorded, with the
 // most recent (current) FreightCollection at the top of the list.
@@ -879,21 +1825,128 @@ func (f *FreightHistory) Current() *FreightCollection {
 	return (*f)[0]
 }
 
+// FindByID returns the FreightCollection with the provided ID from the history
+// along with its position in the history, where 0 is the most recent (current)
+// entry. If no such FreightCollection is found, nil and -1 are returned.
+func (f *FreightHistory) FindByID(id string) (*FreightCollection, int) {
+	if f == nil || id == "" {
+		return nil, -1
+	}
+	for i, fc := range *f {
+		if fc != nil && fc.ID == id {
+			return fc, i
+		}
+	}
+	return nil, -1
+}
+
 // Record appends the provided FreightCollection as the most recent (current)
 // FreightCollection in the history. I.e. The provided FreightCollection becomes
-// the first item in the list. If the list grows beyond ten items, the bottom
-// items are removed.
+// the first item in the list. If the list grows beyond
+// DefaultFreightHistoryLimit items, the bottom items are removed.
 func (f *FreightHistory) Record(freight ...*FreightCollection) {
+	f.RecordWithLimit(DefaultFreightHistoryLimit, freight...)
+}
+
+// RecordWithLimit is like Record, but removes the bottom (oldest) items once
+// the list grows beyond the provided limit instead of beyond
+// DefaultFreightHistoryLimit.
+func (f *FreightHistory) RecordWithLimit(limit int, freight ...*FreightCollection) {
 	*f = append(freight, *f...)
-	f.truncate()
+	f.truncate(limit)
 }
 
-// truncate ensures the history does not grow beyond 10 items.
-func (f *FreightHistory) truncate() {
-	const maxSize = 10
-	if f != nil && len(*f) > maxSize {
-		*f = (*f)[:maxSize]
+// truncate ensures the history does not grow beyond the provided limit.
+func (f *FreightHistory) truncate(limit int) {
+	if f != nil && len(*f) > limit {
+		*f = (*f)[:limit]
+	}
+}
+
+// Deltas returns the compact representation of the FreightHistory described
+// by StageStatus.FreightHistoryDeltas. The oldest FreightCollection is stored
+// in full and every other FreightCollection as a JSON merge patch to be
+// applied to the FreightCollection recorded before it. Nil entries are treated
+// as empty FreightCollections.
+func (f *FreightHistory) Deltas() ([]FreightCollectionDelta, error) {
+	if f == nil || len(*f) == 0 {
+		return nil, nil
+	}
+	history := *f
+	deltas := make([]FreightCollectionDelta, len(history))
+	oldest := len(history) - 1
+	baseline := FreightCollection{}
+	if history[oldest] != nil {
+		baseline = *history[oldest].DeepCopy()
+	}
+	deltas[oldest] = FreightCollectionDelta{Baseline: &baseline}
+	previous, err := json.Marshal(baseline)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling FreightCollection %d: %w", oldest, err)
+	}
+	for i := oldest - 1; i >= 0; i-- {
+		collection := history[i]
+		if collection == nil {
+			collection = &FreightCollection{}
+		}
+		current, err := json.Marshal(collection)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling FreightCollection %d: %w", i, err)
+		}
+		patch, err := jsonpatch.CreateMergePatch(previous, current)
+		if err != nil {
+			return nil, fmt.Errorf("error computing delta of FreightCollection %d: %w", i, err)
+		}
+		deltas[i] = FreightCollectionDelta{Patch: string(patch)}
+		previous = current
+	}
+	return deltas, nil
+}
+
+// ReconstructFreightCollection returns the FreightCollection represented by
+// the entry at the provided index of the provided list of deltas, as returned
+// by FreightHistory.Deltas. This is done by applying the patches of all
+// entries from the oldest up to and including the one at the provided index to
+// the baseline held by the oldest entry.
+func ReconstructFreightCollection(
+	deltas []FreightCollectionDelta,
+	index int,
+) (FreightCollection, error) {
+	if index < 0 || index >= len(deltas) {
+		return FreightCollection{}, fmt.Errorf(
+			"index %d is out of range for %d FreightCollection deltas",
+			index,
+			len(deltas),
+		)
+	}
+	oldest := len(deltas) - 1
+	if deltas[oldest].Baseline == nil {
+		return FreightCollection{}, errors.New("oldest FreightCollection delta has no baseline")
+	}
+	if index == oldest {
+		return *deltas[oldest].Baseline.DeepCopy(), nil
+	}
+	doc, err := json.Marshal(deltas[oldest].Baseline)
+	if err != nil {
+		return FreightCollection{}, fmt.Errorf("error marshaling baseline FreightCollection: %w", err)
+	}
+	for i := oldest - 1; i >= index; i-- {
+		if deltas[i].Patch == "" {
+			return FreightCollection{}, fmt.Errorf("FreightCollection delta %d has no patch", i)
+		}
+		if doc, err = jsonpatch.MergePatch(doc, []byte(deltas[i].Patch)); err != nil {
+			return FreightCollection{}, fmt.Errorf(
+				"error applying patch of FreightCollection delta %d: %w",
+				i,
+				err,
+			)
+		}
+	}
+	var collection FreightCollection
+	if err = json.Unmarshal(doc, &collection); err != nil {
+		return FreightCollection{}, fmt.Errorf("error unmarshaling FreightCollection: %w", err)
 	}
+	return collection, nil
 }
 
 // FreightReferenceStack is a linear stack of FreightReferences.
@@ -1011,6 +2064,9 @@ type Health struct {
 	Issues []string `json:"issues,omitempty" protobuf:"bytes,2,rep,name=issues"`
 	// ArgoCDApps describes the current state of any related ArgoCD Applications.
 	ArgoCDApps []ArgoCDAppStatus `json:"argoCDApps,omitempty" protobuf:"bytes,3,rep,name=argoCDApps"`
+	// CheckedAt is the time at which the Stage's health was last assessed. Health
+	// that has not been assessed recently may no longer be accurate.
+	CheckedAt *metav1.Time `json:"checkedAt,omitempty" protobuf:"bytes,4,opt,name=checkedAt"`
 }
 
 // ArgoCDAppStatus describes the current state of a single ArgoCD Application.
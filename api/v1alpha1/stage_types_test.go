@@ -1,9 +1,14 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
 )
 
 func TestVerificationInfo_HasAnalysisRun(t *testing.T) {
@@ -255,6 +260,62 @@ func TestFreightHistoryCurrent(t *testing.T) {
 	}
 }
 
+func TestFreightHistoryFindByID(t *testing.T) {
+	history := FreightHistory{
+		{ID: "baz"},
+		nil,
+		{ID: "bar"},
+		{ID: "foo"},
+	}
+	testCases := []struct {
+		name           string
+		history        FreightHistory
+		id             string
+		expectedResult *FreightCollection
+		expectedIndex  int
+	}{
+		{
+			name:          "history is nil",
+			history:       nil,
+			id:            "foo",
+			expectedIndex: -1,
+		},
+		{
+			name:          "empty ID",
+			history:       FreightHistory{{}},
+			id:            "",
+			expectedIndex: -1,
+		},
+		{
+			name:           "current entry",
+			history:        history,
+			id:             "baz",
+			expectedResult: history[0],
+			expectedIndex:  0,
+		},
+		{
+			name:           "oldest entry",
+			history:        history,
+			id:             "foo",
+			expectedResult: history[3],
+			expectedIndex:  3,
+		},
+		{
+			name:          "missing entry",
+			history:       history,
+			id:            "qux",
+			expectedIndex: -1,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			fc, i := testCase.history.FindByID(testCase.id)
+			require.Same(t, testCase.expectedResult, fc)
+			require.Equal(t, testCase.expectedIndex, i)
+		})
+	}
+}
+
 func TestFreightHistoryRecord(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -333,6 +394,212 @@ func TestFreightHistoryRecord(t *testing.T) {
 	}
 }
 
+func TestFreightHistoryRecordWithLimit(t *testing.T) {
+	newEntry := func(warehouse string) *FreightCollection {
+		return &FreightCollection{
+			Freight: map[string]FreightReference{
+				warehouse: {Warehouse: warehouse},
+			},
+		}
+	}
+	testCases := []struct {
+		name            string
+		history         FreightHistory
+		limit           int
+		expectedHistory FreightHistory
+	}{
+		{
+			name:            "history within limit",
+			history:         FreightHistory{newEntry("b"), newEntry("a")},
+			limit:           3,
+			expectedHistory: FreightHistory{newEntry("c"), newEntry("b"), newEntry("a")},
+		},
+		{
+			name:            "oldest entries are removed first",
+			history:         FreightHistory{newEntry("b"), newEntry("a")},
+			limit:           2,
+			expectedHistory: FreightHistory{newEntry("c"), newEntry("b")},
+		},
+		{
+			name:            "history exceeding a lowered limit is truncated",
+			history:         FreightHistory{newEntry("b"), newEntry("a"), newEntry("0")},
+			limit:           1,
+			expectedHistory: FreightHistory{newEntry("c")},
+		},
+		{
+			name: "limit above default",
+			history: FreightHistory{
+				{}, {}, {}, {}, {}, {}, {}, {}, {}, {},
+			},
+			limit: 20,
+			expectedHistory: FreightHistory{
+				newEntry("c"),
+				{}, {}, {}, {}, {}, {}, {}, {}, {}, {},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.history.RecordWithLimit(testCase.limit, newEntry("c"))
+			require.Equal(t, testCase.expectedHistory, testCase.history)
+		})
+	}
+}
+
+func TestFreightHistoryDeltas(t *testing.T) {
+	// Build a history of ten FreightCollections in which each differs from the
+	// one recorded before it in a different way.
+	const origins = 3
+	var history FreightHistory
+	for i := 0; i < DefaultFreightHistoryLimit; i++ {
+		collection := &FreightCollection{}
+		if current := history.Current(); current != nil {
+			collection = current.DeepCopy()
+		}
+		collection.UpdateOrPush(FreightReference{
+			Name: fmt.Sprintf("freight-%d", i),
+			Origin: FreightOrigin{
+				Kind: FreightOriginKindWarehouse,
+				Name: fmt.Sprintf("warehouse-%d", i%origins),
+			},
+		})
+		if i%2 == 0 {
+			collection.VerificationHistory = VerificationInfoStack{{
+				ID:      fmt.Sprintf("verification-%d", i),
+				Phase:   VerificationPhaseSuccessful,
+				Message: fmt.Sprintf("verified freight-%d", i),
+			}}
+		} else {
+			collection.VerificationHistory = nil
+		}
+		history.Record(collection)
+	}
+	require.Len(t, history, DefaultFreightHistoryLimit)
+
+	deltas, err := history.Deltas()
+	require.NoError(t, err)
+	require.Len(t, deltas, len(history))
+
+	// Only the oldest entry holds a baseline.
+	for i, delta := range deltas {
+		if i == len(deltas)-1 {
+			require.NotNil(t, delta.Baseline)
+			require.Empty(t, delta.Patch)
+		} else {
+			require.Nil(t, delta.Baseline)
+			require.NotEmpty(t, delta.Patch)
+		}
+	}
+
+	for i := range history {
+		collection, err := ReconstructFreightCollection(deltas, i)
+		require.NoError(t, err)
+		require.Equal(t, *history[i], collection)
+	}
+}
+
+func TestReconstructFreightCollection(t *testing.T) {
+	testCases := []struct {
+		name       string
+		deltas     []FreightCollectionDelta
+		index      int
+		assertions func(*testing.T, FreightCollection, error)
+	}{
+		{
+			name:  "index out of range",
+			index: 1,
+			deltas: []FreightCollectionDelta{
+				{Baseline: &FreightCollection{ID: "a"}},
+			},
+			assertions: func(t *testing.T, _ FreightCollection, err error) {
+				require.ErrorContains(t, err, "index 1 is out of range")
+			},
+		},
+		{
+			name: "no baseline",
+			deltas: []FreightCollectionDelta{
+				{Patch: `{"id":"b"}`},
+				{Patch: `{"id":"a"}`},
+			},
+			assertions: func(t *testing.T, _ FreightCollection, err error) {
+				require.ErrorContains(t, err, "has no baseline")
+			},
+		},
+		{
+			name: "no patch",
+			deltas: []FreightCollectionDelta{
+				{},
+				{Baseline: &FreightCollection{ID: "a"}},
+			},
+			assertions: func(t *testing.T, _ FreightCollection, err error) {
+				require.ErrorContains(t, err, "delta 0 has no patch")
+			},
+		},
+		{
+			name: "invalid patch",
+			deltas: []FreightCollectionDelta{
+				{Patch: "{"},
+				{Baseline: &FreightCollection{ID: "a"}},
+			},
+			assertions: func(t *testing.T, _ FreightCollection, err error) {
+				require.ErrorContains(t, err, "error applying patch")
+			},
+		},
+		{
+			name:  "baseline",
+			index: 1,
+			deltas: []FreightCollectionDelta{
+				{Patch: `{"id":"b"}`},
+				{Baseline: &FreightCollection{ID: "a"}},
+			},
+			assertions: func(t *testing.T, collection FreightCollection, err error) {
+				require.NoError(t, err)
+				require.Equal(t, FreightCollection{ID: "a"}, collection)
+			},
+		},
+		{
+			name: "patched",
+			deltas: []FreightCollectionDelta{
+				{Patch: `{"items":{"Warehouse/foo":null}}`},
+				{Patch: `{"id":"b","items":{"Warehouse/bar":{"name":"bar"}}}`},
+				{
+					Baseline: &FreightCollection{
+						ID: "a",
+						Freight: map[string]FreightReference{
+							"Warehouse/foo": {Name: "foo"},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, collection FreightCollection, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					FreightCollection{
+						ID: "b",
+						Freight: map[string]FreightReference{
+							"Warehouse/bar": {Name: "bar"},
+						},
+					},
+					collection,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			collection, err := ReconstructFreightCollection(testCase.deltas, testCase.index)
+			testCase.assertions(t, collection, err)
+		})
+	}
+}
+
+func TestStageSpecGetFreightHistoryLimit(t *testing.T) {
+	require.Equal(t, DefaultFreightHistoryLimit, (*StageSpec)(nil).GetFreightHistoryLimit())
+	require.Equal(t, DefaultFreightHistoryLimit, (&StageSpec{}).GetFreightHistoryLimit())
+	require.Equal(t, 25, (&StageSpec{FreightHistoryLimit: 25}).GetFreightHistoryLimit())
+}
+
 func TestFreightReferenceStackPush(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -698,3 +965,154 @@ func TestChartDeepEquals(t *testing.T) {
 		})
 	}
 }
+
+func TestStageSchema(t *testing.T) {
+	crdBytes, err := os.ReadFile("../../charts/kargo/resources/crds/kargo.akuity.io_stages.yaml")
+	require.NoError(t, err)
+	crd := struct {
+		Spec struct {
+			Versions []struct {
+				Schema struct {
+					OpenAPIV3Schema map[string]any `json:"openAPIV3Schema"`
+				} `json:"schema"`
+			} `json:"versions"`
+		} `json:"spec"`
+	}{}
+	require.NoError(t, yaml.Unmarshal(crdBytes, &crd))
+	require.Len(t, crd.Spec.Versions, 1)
+	schema := crd.Spec.Versions[0].Schema.OpenAPIV3Schema
+
+	testCases := []struct {
+		name       string
+		stage      string
+		assertions func(*testing.T, []string)
+	}{
+		{
+			name: "valid",
+			stage: `
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  name: test
+  namespace: kargo-demo
+spec:
+  shard: shard-1
+  subscriptions: {}
+  requestedFreight:
+  - origin:
+      kind: Warehouse
+      name: my-warehouse
+    sources:
+      direct: true
+  promotionMechanisms:
+    argoCDAppUpdates:
+    - appName: my-app
+  freightHistoryLimit: 20
+  concurrencyPolicy: Forbid
+  pipeline: my-pipeline
+  wave: 1
+`,
+			assertions: func(t *testing.T, errs []string) {
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name: "invalid",
+			stage: `
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  name: test
+  namespace: kargo-demo
+spec:
+  shard: -shard-
+  requestedFreight: []
+  freightHistoryLimit: 101
+  concurrencyPolicy: Sometimes
+  pipeline: My_Pipeline
+  wave: -1
+`,
+			assertions: func(t *testing.T, errs []string) {
+				require.ElementsMatch(
+					t,
+					[]string{
+						"spec.subscriptions: is required",
+						"spec.shard: does not match pattern",
+						"spec.requestedFreight: has fewer than 1 items",
+						"spec.freightHistoryLimit: is greater than 100",
+						"spec.concurrencyPolicy: is not one of [Allow Forbid Replace]",
+						"spec.pipeline: does not match pattern",
+						"spec.wave: is less than 0",
+					},
+					errs,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			stage := map[string]any{}
+			require.NoError(t, yaml.Unmarshal([]byte(testCase.stage), &stage))
+			testCase.assertions(t, validateAgainstSchema(schema, stage, ""))
+		})
+	}
+}
+
+// validateAgainstSchema validates the provided value against the subset of
+// OpenAPI v3 schema constraints that kubebuilder markers are used for in this
+// package and returns a description of each violation.
+func validateAgainstSchema(schema map[string]any, value any, path string) []string {
+	var errs []string
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Sprintf("%s: %s", path, fmt.Sprintf(format, args...)))
+	}
+	if enum, ok := schema["enum"].([]any); ok && !slices.Contains(enum, value) {
+		fail("is not one of %v", enum)
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		properties, _ := schema["properties"].(map[string]any)
+		for key, val := range v {
+			if propSchema, ok := properties[key].(map[string]any); ok {
+				errs = append(errs, validateAgainstSchema(propSchema, val, joinSchemaPath(path, key))...)
+			}
+		}
+		required, _ := schema["required"].([]any)
+		for _, key := range required {
+			if _, ok := v[key.(string)]; !ok { // nolint: forcetypeassert
+				errs = append(errs, fmt.Sprintf("%s: is required", joinSchemaPath(path, key.(string)))) // nolint: forcetypeassert
+			}
+		}
+	case []any:
+		if minItems, ok := schema["minItems"].(float64); ok && float64(len(v)) < minItems {
+			fail("has fewer than %v items", minItems)
+		}
+		if items, ok := schema["items"].(map[string]any); ok {
+			for i, item := range v {
+				errs = append(errs, validateAgainstSchema(items, item, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	case string:
+		if maxLength, ok := schema["maxLength"].(float64); ok && float64(len(v)) > maxLength {
+			fail("is longer than %v characters", maxLength)
+		}
+		if pattern, ok := schema["pattern"].(string); ok && !regexp.MustCompile(pattern).MatchString(v) {
+			fail("does not match pattern")
+		}
+	case float64:
+		if minimum, ok := schema["minimum"].(float64); ok && v < minimum {
+			fail("is less than %v", minimum)
+		}
+		if maximum, ok := schema["maximum"].(float64); ok && v > maximum {
+			fail("is greater than %v", maximum)
+		}
+	}
+	return errs
+}
+
+func joinSchemaPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -1,6 +1,9 @@
 package v1alpha1
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 // +kubebuilder:validation:Enum={Lexical,NewestFromBranch,NewestTag,SemVer}
 type CommitSelectionStrategy string
@@ -64,6 +67,12 @@ type WarehouseSpec struct {
 	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
 	// +kubebuilder:default="5m0s"
 	Interval metav1.Duration `json:"interval" protobuf:"bytes,4,opt,name=interval"`
+	// PollingIntervals optionally overrides Interval for each type of
+	// subscription, so that, for instance, frequent polling of image registries
+	// does not also cause Git repositories to be fetched just as frequently.
+	//
+	// +optional
+	PollingIntervals *PollingIntervals `json:"pollingIntervals,omitempty" protobuf:"bytes,5,opt,name=pollingIntervals"`
 	// FreightCreationPolicy describes how Freight is created by this Warehouse.
 	// This field is optional. When left unspecified, the field is implicitly
 	// treated as if its value were "Automatic".
@@ -71,6 +80,15 @@ type WarehouseSpec struct {
 	// +kubebuilder:default=Automatic
 	// +kubebuilder:validation:Optional
 	FreightCreationPolicy FreightCreationPolicy `json:"freightCreationPolicy" protobuf:"bytes,3,opt,name=freightCreationPolicy"`
+	// FreightEqualityMode describes which artifacts are compared when deciding
+	// whether Freight built from the latest discovered artifacts differs from the
+	// Freight most recently produced by this Warehouse. Only Freight that differs
+	// is created. This field is optional. When left unspecified, the field is
+	// implicitly treated as if its value were "Strict".
+	//
+	// +kubebuilder:default=Strict
+	// +kubebuilder:validation:Optional
+	FreightEqualityMode FreightEqualityMode `json:"freightEqualityMode" protobuf:"bytes,6,opt,name=freightEqualityMode"`
 	// Subscriptions describes sources of artifacts to be included in Freight
 	// produced by this Warehouse.
 	//
@@ -78,6 +96,30 @@ type WarehouseSpec struct {
 	Subscriptions []RepoSubscription `json:"subscriptions" protobuf:"bytes,1,rep,name=subscriptions"`
 }
 
+// PollingIntervals describes how often each type of a Warehouse's
+// subscriptions is polled for new artifacts. An unspecified or zero interval
+// falls back to the Warehouse's Interval.
+type PollingIntervals struct {
+	// GitIntervalSeconds is the number of seconds between polls of the
+	// Warehouse's Git subscriptions.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	GitIntervalSeconds int32 `json:"gitIntervalSeconds,omitempty" protobuf:"varint,1,opt,name=gitIntervalSeconds"`
+	// ImageIntervalSeconds is the number of seconds between polls of the
+	// Warehouse's image subscriptions.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ImageIntervalSeconds int32 `json:"imageIntervalSeconds,omitempty" protobuf:"varint,2,opt,name=imageIntervalSeconds"`
+	// ChartIntervalSeconds is the number of seconds between polls of the
+	// Warehouse's chart subscriptions.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ChartIntervalSeconds int32 `json:"chartIntervalSeconds,omitempty" protobuf:"varint,3,opt,name=chartIntervalSeconds"`
+}
+
 // FreightCreationPolicy defines how Freight is created by a Warehouse.
 // +kubebuilder:validation:Enum={Automatic,Manual}
 type FreightCreationPolicy string
@@ -89,6 +131,23 @@ const (
 	FreightCreationPolicyManual FreightCreationPolicy = "Manual"
 )
 
+// FreightEqualityMode defines which artifacts are compared by a Warehouse when
+// deciding whether Freight is new.
+// +kubebuilder:validation:Enum={Strict,CommitsOnly,ImagesOnly}
+type FreightEqualityMode string
+
+const (
+	// FreightEqualityModeStrict indicates that Freight is new if any of its
+	// commits, images, or charts differ.
+	FreightEqualityModeStrict FreightEqualityMode = "Strict"
+	// FreightEqualityModeCommitsOnly indicates that Freight is new only if its
+	// commits differ. Changes to images and charts alone are ignored.
+	FreightEqualityModeCommitsOnly FreightEqualityMode = "CommitsOnly"
+	// FreightEqualityModeImagesOnly indicates that Freight is new only if its
+	// images differ. Changes to commits and charts alone are ignored.
+	FreightEqualityModeImagesOnly FreightEqualityMode = "ImagesOnly"
+)
+
 // RepoSubscription describes a subscription to ONE OF a Git repository, a
 // container image repository, or a Helm chart repository.
 type RepoSubscription struct {
@@ -124,6 +183,17 @@ type GitSubscription struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:Pattern=`^\w+([-/]\w+)*$`
 	Branch string `json:"branch,omitempty" protobuf:"bytes,3,opt,name=branch"`
+	// Branches optionally references additional branches of the repository.
+	// The value in this field only has any effect when the CommitSelectionStrategy
+	// is NewestFromBranch or left unspecified. Commits are discovered from each of
+	// these branches as well as from the branch referenced by the Branch field,
+	// if any, and the newest of them is selected. A commit that is found on more
+	// than one of these branches is only discovered once.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:Pattern=`^\w+([-/]\w+)*$`
+	Branches []string `json:"branches,omitempty" protobuf:"bytes,12,rep,name=branches"`
 	// SemverConstraint specifies constraints on what new tagged commits are
 	// considered in determining the newest commit of interest. The value in this
 	// field only has any effect when the CommitSelectionStrategy is SemVer. This
@@ -192,6 +262,37 @@ type GitSubscription struct {
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:default=20
 	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,10,opt,name=discoveryLimit"`
+	// ShallowCloneDepth optionally limits the number of commits fetched when
+	// cloning the repository to discover commits. This can considerably speed up
+	// discovery for large repositories, but commits beyond the specified depth
+	// cannot be discovered. If the remote repository does not support shallow
+	// clones, a full clone is performed instead. When left unspecified or set to
+	// zero, the full history of the repository is cloned.
+	//
+	// +kubebuilder:validation:Minimum=0
+	ShallowCloneDepth int32 `json:"shallowCloneDepth,omitempty" protobuf:"varint,11,opt,name=shallowCloneDepth"`
+	// KnownHostsConfigMapRef references a ConfigMap in the Warehouse's namespace
+	// whose known_hosts key holds the content of an SSH known_hosts file. When
+	// specified, the key of the repository's host is verified against it whenever
+	// the repository is accessed over SSH, and discovery fails if the ConfigMap
+	// does not exist or does not include a key for the repository's host. Known
+	// hosts specified this way take precedence over any included in the
+	// repository's credentials.
+	//
+	// +optional
+	KnownHostsConfigMapRef *corev1.LocalObjectReference `json:"knownHostsConfigMapRef,omitempty" protobuf:"bytes,13,opt,name=knownHostsConfigMapRef"`
+	// SparseCheckoutPaths optionally lists the paths of directories, relative to
+	// the root of the repository, that are of interest to this subscription. This
+	// is useful for subscriptions to monorepos. When specified, the repository is
+	// cloned without file contents and only the specified directories are checked
+	// out. Only commits that modify files in at least one of the specified
+	// directories are discovered, so changes to unrelated directories do not
+	// trigger the production of new Freight. When not specified, the entire
+	// repository is checked out and all commits are discovered.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:items:MinLength=1
+	SparseCheckoutPaths []string `json:"sparseCheckoutPaths,omitempty" protobuf:"bytes,14,rep,name=sparseCheckoutPaths"`
 }
 
 // ImageSubscription defines a subscription to an image repository.
@@ -217,6 +318,17 @@ type ImageSubscription struct {
 	//
 	// +kubebuilder:default=SemVer
 	ImageSelectionStrategy ImageSelectionStrategy `json:"imageSelectionStrategy,omitempty" protobuf:"bytes,3,opt,name=imageSelectionStrategy"`
+	// LatestByBuildDate specifies whether the newest version of the image should
+	// be identified by the build date recorded in the org.opencontainers.image.created
+	// annotation of each tag's manifest. This is useful for images whose tags
+	// carry no ordering information and whose image configurations do not record
+	// a meaningful creation date, e.g. images produced by reproducible builds. When
+	// true, the ImageSelectionStrategy and SemverConstraint fields are ignored and
+	// tags whose manifests lack a valid annotation are not considered. This field
+	// is optional.
+	//
+	// +kubebuilder:validation:Optional
+	LatestByBuildDate bool `json:"latestByBuildDate,omitempty" protobuf:"varint,12,opt,name=latestByBuildDate"`
 	// SemverConstraint specifies constraints on what new image versions are
 	// permissible. The value in this field only has any effect when the
 	// ImageSelectionStrategy is SemVer or left unspecified (which is implicitly
@@ -236,6 +348,13 @@ type ImageSubscription struct {
 	//
 	// +kubebuilder:validation:Optional
 	AllowTags string `json:"allowTags,omitempty" protobuf:"bytes,5,opt,name=allowTags"`
+	// DenyTags is a regular expression that can optionally be used to exclude
+	// image tags from consideration in determining the newest version of an
+	// image. A tag matched by both AllowTags and DenyTags is excluded. This
+	// field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	DenyTags string `json:"denyTags,omitempty" protobuf:"bytes,10,opt,name=denyTags"`
 	// IgnoreTags is a list of tags that must be ignored when determining the
 	// newest version of an image. No regular expressions or glob patterns are
 	// supported yet. This field is optional.
@@ -257,9 +376,18 @@ type ImageSubscription struct {
 	// should be ignored when connecting to the repository. This should be enabled
 	// only with great caution.
 	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,8,opt,name=insecureSkipTLSVerify"`
+	// CABundleConfigMapRef references a ConfigMap in the Warehouse's namespace
+	// whose ca.crt key holds one or more PEM-encoded CA certificates. When
+	// specified, the repository's TLS certificate is verified against these
+	// certificates in addition to the system's trusted CAs. This is useful for
+	// registries whose certificates are issued by a private CA. Discovery fails
+	// if the ConfigMap does not exist or holds no valid certificates.
+	//
+	// +optional
+	CABundleConfigMapRef *corev1.LocalObjectReference `json:"caBundleConfigMapRef,omitempty" protobuf:"bytes,11,opt,name=caBundleConfigMapRef"`
 	// DiscoveryLimit is an optional limit on the number of image references
 	// that can be discovered for this subscription. The limit is applied after
-	// filtering images based on the AllowTags and IgnoreTags fields.
+	// filtering images based on the AllowTags, DenyTags, and IgnoreTags fields.
 	// When left unspecified, the field is implicitly treated as if its value
 	// were "20". The upper limit for this field is 100.
 	//
@@ -307,6 +435,33 @@ type ChartSubscription struct {
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:default=20
 	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,4,opt,name=discoveryLimit"`
+	// FallbackRepoURLs optionally specifies additional Helm chart repositories
+	// that serve the same chart(s) as the repository specified by the RepoURL
+	// field. When the repository specified by the RepoURL field cannot be
+	// reached, these repositories are tried in the order they are listed. The
+	// same rules that govern the use of the Name field with the RepoURL field
+	// apply to every repository in this list.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:items:Pattern=`^(((https?)|(oci))://)([\w\d\.\-]+)(:[\d]+)?(/.*)*$`
+	FallbackRepoURLs []string `json:"fallbackRepoURLs,omitempty" protobuf:"bytes,5,rep,name=fallbackRepoURLs"`
+	// VerifyProvenance specifies whether only chart versions whose provenance
+	// can be verified may be discovered. When true, the provenance (.prov) file
+	// published alongside each version of the chart is downloaded along with the
+	// chart itself, and the version is skipped unless the provenance file was
+	// signed using a key from the keyring referenced by the
+	// ProvenanceKeySecretRef field and records the checksum of the chart. This
+	// is only supported for classic chart repositories. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	VerifyProvenance bool `json:"verifyProvenance,omitempty" protobuf:"varint,6,opt,name=verifyProvenance"`
+	// ProvenanceKeySecretRef references a key of a Secret in the Warehouse's
+	// namespace that holds the GPG public keyring, either binary or
+	// ASCII-armored, used to verify the provenance of chart versions. This field
+	// is required when the VerifyProvenance field is true.
+	//
+	// +kubebuilder:validation:Optional
+	ProvenanceKeySecretRef *SecretKeyReference `json:"provenanceKeySecretRef,omitempty" protobuf:"bytes,7,opt,name=provenanceKeySecretRef"`
 }
 
 // WarehouseStatus describes a Warehouse's most recently observed state.
@@ -327,6 +482,23 @@ type WarehouseStatus struct {
 	LastFreightID string `json:"lastFreightID,omitempty" protobuf:"bytes,8,opt,name=lastFreightID"`
 	// DiscoveredArtifacts holds the artifacts discovered by the Warehouse.
 	DiscoveredArtifacts *DiscoveredArtifacts `json:"discoveredArtifacts,omitempty" protobuf:"bytes,7,opt,name=discoveredArtifacts"`
+	// LastPolled holds the time at which each type of the Warehouse's
+	// subscriptions was last polled.
+	LastPolled *SubscriptionPollTimes `json:"lastPolled,omitempty" protobuf:"bytes,9,opt,name=lastPolled"`
+}
+
+// SubscriptionPollTimes holds the time at which each type of a Warehouse's
+// subscriptions was last polled.
+type SubscriptionPollTimes struct {
+	// Git is the time at which the Warehouse's Git subscriptions were last
+	// polled.
+	Git *metav1.Time `json:"git,omitempty" protobuf:"bytes,1,opt,name=git"`
+	// Image is the time at which the Warehouse's image subscriptions were last
+	// polled.
+	Image *metav1.Time `json:"image,omitempty" protobuf:"bytes,2,opt,name=image"`
+	// Chart is the time at which the Warehouse's chart subscriptions were last
+	// polled.
+	Chart *metav1.Time `json:"chart,omitempty" protobuf:"bytes,3,opt,name=chart"`
 }
 
 // DiscoveredArtifacts holds the artifacts discovered by the Warehouse for its
@@ -456,6 +628,13 @@ type ChartDiscoveryResult struct {
 	//
 	// +optional
 	Versions []string `json:"versions" protobuf:"bytes,4,rep,name=versions"`
+	// ServedBy is the URL of the chart repository from which the Versions were
+	// discovered. This differs from RepoURL when the repository specified by
+	// the ChartSubscription could not be reached and one of its fallback
+	// repositories was used instead.
+	//
+	// +optional
+	ServedBy string `json:"servedBy,omitempty" protobuf:"bytes,5,opt,name=servedBy"`
 }
 
 // +kubebuilder:object:root=true
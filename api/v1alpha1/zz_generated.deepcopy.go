@@ -5,6 +5,9 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -97,6 +100,21 @@ func (in *ApprovedStage) DeepCopy() *ApprovedStage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCDAppHealthCheck) DeepCopyInto(out *ArgoCDAppHealthCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDAppHealthCheck.
+func (in *ArgoCDAppHealthCheck) DeepCopy() *ArgoCDAppHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCDAppHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgoCDAppHealthStatus) DeepCopyInto(out *ArgoCDAppHealthStatus) {
 	*out = *in
@@ -129,6 +147,21 @@ func (in *ArgoCDAppStatus) DeepCopy() *ArgoCDAppStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCDAppStatusCheck) DeepCopyInto(out *ArgoCDAppStatusCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDAppStatusCheck.
+func (in *ArgoCDAppStatusCheck) DeepCopy() *ArgoCDAppStatusCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCDAppStatusCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgoCDAppSyncStatus) DeepCopyInto(out *ArgoCDAppSyncStatus) {
 	*out = *in
@@ -164,6 +197,21 @@ func (in *ArgoCDAppUpdate) DeepCopyInto(out *ArgoCDAppUpdate) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SourceIndex != nil {
+		in, out := &in.SourceIndex, &out.SourceIndex
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(ArgoCDAppHealthCheck)
+		**out = **in
+	}
+	if in.SyncTimeout != nil {
+		in, out := &in.SyncTimeout, &out.SyncTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDAppUpdate.
@@ -300,6 +348,36 @@ func (in *ArgoCDSourceUpdate) DeepCopy() *ArgoCDSourceUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryPromotion) DeepCopyInto(out *CanaryPromotion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryPromotion.
+func (in *CanaryPromotion) DeepCopy() *CanaryPromotion {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryPromotion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChangeApprovalCheck) DeepCopyInto(out *ChangeApprovalCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangeApprovalCheck.
+func (in *ChangeApprovalCheck) DeepCopy() *ChangeApprovalCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangeApprovalCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chart) DeepCopyInto(out *Chart) {
 	*out = *in
@@ -315,6 +393,21 @@ func (in *Chart) DeepCopy() *Chart {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChartChange) DeepCopyInto(out *ChartChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartChange.
+func (in *ChartChange) DeepCopy() *ChartChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ChartChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChartDiscoveryResult) DeepCopyInto(out *ChartDiscoveryResult) {
 	*out = *in
@@ -338,6 +431,16 @@ func (in *ChartDiscoveryResult) DeepCopy() *ChartDiscoveryResult {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChartSubscription) DeepCopyInto(out *ChartSubscription) {
 	*out = *in
+	if in.FallbackRepoURLs != nil {
+		in, out := &in.FallbackRepoURLs, &out.FallbackRepoURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProvenanceKeySecretRef != nil {
+		in, out := &in.ProvenanceKeySecretRef, &out.ProvenanceKeySecretRef
+		*out = new(SecretKeyReference)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartSubscription.
@@ -350,6 +453,41 @@ func (in *ChartSubscription) DeepCopy() *ChartSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircuitBreaker) DeepCopyInto(out *CircuitBreaker) {
+	*out = *in
+	out.Cooldown = in.Cooldown
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreaker.
+func (in *CircuitBreaker) DeepCopy() *CircuitBreaker {
+	if in == nil {
+		return nil
+	}
+	out := new(CircuitBreaker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircuitBreakerStatus) DeepCopyInto(out *CircuitBreakerStatus) {
+	*out = *in
+	if in.OpenedAt != nil {
+		in, out := &in.OpenedAt, &out.OpenedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreakerStatus.
+func (in *CircuitBreakerStatus) DeepCopy() *CircuitBreakerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CircuitBreakerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiscoveredArtifacts) DeepCopyInto(out *DiscoveredArtifacts) {
 	*out = *in
@@ -424,6 +562,26 @@ func (in *DiscoveredImageReference) DeepCopy() *DiscoveredImageReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluxHelmReleaseUpdate) DeepCopyInto(out *FluxHelmReleaseUpdate) {
+	*out = *in
+	if in.Origin != nil {
+		in, out := &in.Origin, &out.Origin
+		*out = new(FreightOrigin)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluxHelmReleaseUpdate.
+func (in *FluxHelmReleaseUpdate) DeepCopy() *FluxHelmReleaseUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(FluxHelmReleaseUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Freight) DeepCopyInto(out *Freight) {
 	*out = *in
@@ -495,6 +653,26 @@ func (in *FreightCollection) DeepCopy() *FreightCollection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreightCollectionDelta) DeepCopyInto(out *FreightCollectionDelta) {
+	*out = *in
+	if in.Baseline != nil {
+		in, out := &in.Baseline, &out.Baseline
+		*out = new(FreightCollection)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightCollectionDelta.
+func (in *FreightCollectionDelta) DeepCopy() *FreightCollectionDelta {
+	if in == nil {
+		return nil
+	}
+	out := new(FreightCollectionDelta)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in FreightHistory) DeepCopyInto(out *FreightHistory) {
 	{
@@ -792,6 +970,16 @@ func (in *GitRepoUpdate) DeepCopyInto(out *GitRepoUpdate) {
 		*out = new(HelmPromotionMechanism)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SigningKeySecretRef != nil {
+		in, out := &in.SigningKeySecretRef, &out.SigningKeySecretRef
+		*out = new(SecretKeyReference)
+		**out = **in
+	}
+	if in.SOPSAgeKeySecretRef != nil {
+		in, out := &in.SOPSAgeKeySecretRef, &out.SOPSAgeKeySecretRef
+		*out = new(SecretKeyReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepoUpdate.
@@ -807,6 +995,11 @@ func (in *GitRepoUpdate) DeepCopy() *GitRepoUpdate {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitSubscription) DeepCopyInto(out *GitSubscription) {
 	*out = *in
+	if in.Branches != nil {
+		in, out := &in.Branches, &out.Branches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.IgnoreTags != nil {
 		in, out := &in.IgnoreTags, &out.IgnoreTags
 		*out = make([]string, len(*in))
@@ -822,6 +1015,16 @@ func (in *GitSubscription) DeepCopyInto(out *GitSubscription) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.KnownHostsConfigMapRef != nil {
+		in, out := &in.KnownHostsConfigMapRef, &out.KnownHostsConfigMapRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.SparseCheckoutPaths != nil {
+		in, out := &in.SparseCheckoutPaths, &out.SparseCheckoutPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSubscription.
@@ -834,6 +1037,21 @@ func (in *GitSubscription) DeepCopy() *GitSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHealthCheck) DeepCopyInto(out *HTTPHealthCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHealthCheck.
+func (in *HTTPHealthCheck) DeepCopy() *HTTPHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Health) DeepCopyInto(out *Health) {
 	*out = *in
@@ -849,6 +1067,10 @@ func (in *Health) DeepCopyInto(out *Health) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CheckedAt != nil {
+		in, out := &in.CheckedAt, &out.CheckedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Health.
@@ -861,6 +1083,38 @@ func (in *Health) DeepCopy() *Health {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthChecks) DeepCopyInto(out *HealthChecks) {
+	*out = *in
+	if in.HTTPChecks != nil {
+		in, out := &in.HTTPChecks, &out.HTTPChecks
+		*out = make([]HTTPHealthCheck, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArgoCDApps != nil {
+		in, out := &in.ArgoCDApps, &out.ArgoCDApps
+		*out = make([]ArgoCDAppStatusCheck, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodImages != nil {
+		in, out := &in.PodImages, &out.PodImages
+		*out = make([]PodImageHealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthChecks.
+func (in *HealthChecks) DeepCopy() *HealthChecks {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthChecks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelmChartDependencyUpdate) DeepCopyInto(out *HelmChartDependencyUpdate) {
 	*out = *in
@@ -923,6 +1177,18 @@ func (in *HelmPromotionMechanism) DeepCopyInto(out *HelmPromotionMechanism) {
 		*out = new(FreightOrigin)
 		**out = **in
 	}
+	if in.ValuesOverrides != nil {
+		in, out := &in.ValuesOverrides, &out.ValuesOverrides
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ValuesFiles != nil {
+		in, out := &in.ValuesFiles, &out.ValuesFiles
+		*out = make([]HelmValuesFileUpdate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmPromotionMechanism.
@@ -935,6 +1201,28 @@ func (in *HelmPromotionMechanism) DeepCopy() *HelmPromotionMechanism {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmValuesFileUpdate) DeepCopyInto(out *HelmValuesFileUpdate) {
+	*out = *in
+	if in.Updates != nil {
+		in, out := &in.Updates, &out.Updates
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmValuesFileUpdate.
+func (in *HelmValuesFileUpdate) DeepCopy() *HelmValuesFileUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmValuesFileUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
@@ -972,6 +1260,21 @@ func (in *ImageDiscoveryResult) DeepCopy() *ImageDiscoveryResult {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePullCheck) DeepCopyInto(out *ImagePullCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePullCheck.
+func (in *ImagePullCheck) DeepCopy() *ImagePullCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePullCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageSubscription) DeepCopyInto(out *ImageSubscription) {
 	*out = *in
@@ -980,6 +1283,11 @@ func (in *ImageSubscription) DeepCopyInto(out *ImageSubscription) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CABundleConfigMapRef != nil {
+		in, out := &in.CABundleConfigMapRef, &out.CABundleConfigMapRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSubscription.
@@ -992,6 +1300,22 @@ func (in *ImageSubscription) DeepCopy() *ImageSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobTemplate) DeepCopyInto(out *JobTemplate) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobTemplate.
+func (in *JobTemplate) DeepCopy() *JobTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(JobTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KargoRenderImageUpdate) DeepCopyInto(out *KargoRenderImageUpdate) {
 	*out = *in
@@ -1039,6 +1363,21 @@ func (in *KargoRenderPromotionMechanism) DeepCopy() *KargoRenderPromotionMechani
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesResourceUpdate) DeepCopyInto(out *KubernetesResourceUpdate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesResourceUpdate.
+func (in *KubernetesResourceUpdate) DeepCopy() *KubernetesResourceUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesResourceUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KustomizeImageUpdate) DeepCopyInto(out *KustomizeImageUpdate) {
 	*out = *in
@@ -1047,6 +1386,11 @@ func (in *KustomizeImageUpdate) DeepCopyInto(out *KustomizeImageUpdate) {
 		*out = new(FreightOrigin)
 		**out = **in
 	}
+	if in.ReplacementSource != nil {
+		in, out := &in.ReplacementSource, &out.ReplacementSource
+		*out = new(KustomizeReplacementSource)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizeImageUpdate.
@@ -1074,6 +1418,11 @@ func (in *KustomizePromotionMechanism) DeepCopyInto(out *KustomizePromotionMecha
 		*out = new(FreightOrigin)
 		**out = **in
 	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizePromotionMechanism.
@@ -1086,6 +1435,52 @@ func (in *KustomizePromotionMechanism) DeepCopy() *KustomizePromotionMechanism {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KustomizeReplacementSource) DeepCopyInto(out *KustomizeReplacementSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizeReplacementSource.
+func (in *KustomizeReplacementSource) DeepCopy() *KustomizeReplacementSource {
+	if in == nil {
+		return nil
+	}
+	out := new(KustomizeReplacementSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodImageHealthCheck) DeepCopyInto(out *PodImageHealthCheck) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodImageHealthCheck.
+func (in *PodImageHealthCheck) DeepCopy() *PodImageHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(PodImageHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PollingIntervals) DeepCopyInto(out *PollingIntervals) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PollingIntervals.
+func (in *PollingIntervals) DeepCopy() *PollingIntervals {
+	if in == nil {
+		return nil
+	}
+	out := new(PollingIntervals)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
@@ -1265,6 +1660,33 @@ func (in *PromotionMechanisms) DeepCopyInto(out *PromotionMechanisms) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ChangeApproval != nil {
+		in, out := &in.ChangeApproval, &out.ChangeApproval
+		*out = new(ChangeApprovalCheck)
+		**out = **in
+	}
+	if in.ImagePullCheck != nil {
+		in, out := &in.ImagePullCheck, &out.ImagePullCheck
+		*out = new(ImagePullCheck)
+		**out = **in
+	}
+	if in.FluxHelmReleaseUpdates != nil {
+		in, out := &in.FluxHelmReleaseUpdates, &out.FluxHelmReleaseUpdates
+		*out = make([]FluxHelmReleaseUpdate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KubernetesResourceUpdates != nil {
+		in, out := &in.KubernetesResourceUpdates, &out.KubernetesResourceUpdates
+		*out = make([]KubernetesResourceUpdate, len(*in))
+		copy(*out, *in)
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryPromotion)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionMechanisms.
@@ -1339,6 +1761,13 @@ func (in *PromotionSpec) DeepCopy() *PromotionSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionStatus) DeepCopyInto(out *PromotionStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Metadata != nil {
 		in, out := &in.Metadata, &out.Metadata
 		*out = make(map[string]string, len(*in))
@@ -1360,6 +1789,11 @@ func (in *PromotionStatus) DeepCopyInto(out *PromotionStatus) {
 		in, out := &in.FinishedAt, &out.FinishedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.ChartChanges != nil {
+		in, out := &in.ChartChanges, &out.ChartChanges
+		*out = make([]ChartChange, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStatus.
@@ -1372,6 +1806,80 @@ func (in *PromotionStatus) DeepCopy() *PromotionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionTemplate) DeepCopyInto(out *PromotionTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionTemplate.
+func (in *PromotionTemplate) DeepCopy() *PromotionTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PromotionTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionTemplateList) DeepCopyInto(out *PromotionTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PromotionTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionTemplateList.
+func (in *PromotionTemplateList) DeepCopy() *PromotionTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PromotionTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionTemplateSpec) DeepCopyInto(out *PromotionTemplateSpec) {
+	*out = *in
+	in.PromotionMechanisms.DeepCopyInto(&out.PromotionMechanisms)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionTemplateSpec.
+func (in *PromotionTemplateSpec) DeepCopy() *PromotionTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PullRequestPromotionMechanism) DeepCopyInto(out *PullRequestPromotionMechanism) {
 	*out = *in
@@ -1413,7 +1921,7 @@ func (in *RepoSubscription) DeepCopyInto(out *RepoSubscription) {
 	if in.Chart != nil {
 		in, out := &in.Chart, &out.Chart
 		*out = new(ChartSubscription)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -1427,6 +1935,21 @@ func (in *RepoSubscription) DeepCopy() *RepoSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyReference) DeepCopyInto(out *SecretKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyReference.
+func (in *SecretKeyReference) DeepCopy() *SecretKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Stage) DeepCopyInto(out *Stage) {
 	*out = *in
@@ -1486,6 +2009,84 @@ func (in *StageList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StageRole) DeepCopyInto(out *StageRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageRole.
+func (in *StageRole) DeepCopy() *StageRole {
+	if in == nil {
+		return nil
+	}
+	out := new(StageRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StageRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StageRoleList) DeepCopyInto(out *StageRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StageRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageRoleList.
+func (in *StageRoleList) DeepCopy() *StageRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(StageRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StageRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StageRoleSpec) DeepCopyInto(out *StageRoleSpec) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]v1.Subject, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageRoleSpec.
+func (in *StageRoleSpec) DeepCopy() *StageRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StageRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 	*out = *in
@@ -1507,6 +2108,43 @@ func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 		*out = new(Verification)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NotificationWebhooks != nil {
+		in, out := &in.NotificationWebhooks, &out.NotificationWebhooks
+		*out = make([]WebhookConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PromotionTimeout != nil {
+		in, out := &in.PromotionTimeout, &out.PromotionTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(CircuitBreaker)
+		**out = **in
+	}
+	if in.HealthChecks != nil {
+		in, out := &in.HealthChecks, &out.HealthChecks
+		*out = new(HealthChecks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrePromotionHook != nil {
+		in, out := &in.PrePromotionHook, &out.PrePromotionHook
+		*out = new(JobTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostPromotionHook != nil {
+		in, out := &in.PostPromotionHook, &out.PostPromotionHook
+		*out = new(JobTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PromotionTemplateRef != nil {
+		in, out := &in.PromotionTemplateRef, &out.PromotionTemplateRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageSpec.
@@ -1533,6 +2171,13 @@ func (in *StageStatus) DeepCopyInto(out *StageStatus) {
 			}
 		}
 	}
+	if in.FreightHistoryDeltas != nil {
+		in, out := &in.FreightHistoryDeltas, &out.FreightHistoryDeltas
+		*out = make([]FreightCollectionDelta, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.CurrentFreight != nil {
 		in, out := &in.CurrentFreight, &out.CurrentFreight
 		*out = new(FreightReference)
@@ -1550,6 +2195,13 @@ func (in *StageStatus) DeepCopyInto(out *StageStatus) {
 		*out = new(Health)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.CurrentPromotion != nil {
 		in, out := &in.CurrentPromotion, &out.CurrentPromotion
 		*out = new(PromotionReference)
@@ -1560,6 +2212,22 @@ func (in *StageStatus) DeepCopyInto(out *StageStatus) {
 		*out = new(PromotionReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NotificationWebhooks != nil {
+		in, out := &in.NotificationWebhooks, &out.NotificationWebhooks
+		*out = make([]WebhookDeliveryStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(CircuitBreakerStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NextScheduledPromotion != nil {
+		in, out := &in.NextScheduledPromotion, &out.NextScheduledPromotion
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageStatus.
@@ -1587,6 +2255,33 @@ func (in *StageSubscription) DeepCopy() *StageSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubscriptionPollTimes) DeepCopyInto(out *SubscriptionPollTimes) {
+	*out = *in
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = (*in).DeepCopy()
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = (*in).DeepCopy()
+	}
+	if in.Chart != nil {
+		in, out := &in.Chart, &out.Chart
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionPollTimes.
+func (in *SubscriptionPollTimes) DeepCopy() *SubscriptionPollTimes {
+	if in == nil {
+		return nil
+	}
+	out := new(SubscriptionPollTimes)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subscriptions) DeepCopyInto(out *Subscriptions) {
 	*out = *in
@@ -1764,6 +2459,11 @@ func (in *WarehouseList) DeepCopyObject() runtime.Object {
 func (in *WarehouseSpec) DeepCopyInto(out *WarehouseSpec) {
 	*out = *in
 	out.Interval = in.Interval
+	if in.PollingIntervals != nil {
+		in, out := &in.PollingIntervals, &out.PollingIntervals
+		*out = new(PollingIntervals)
+		**out = **in
+	}
 	if in.Subscriptions != nil {
 		in, out := &in.Subscriptions, &out.Subscriptions
 		*out = make([]RepoSubscription, len(*in))
@@ -1791,6 +2491,11 @@ func (in *WarehouseStatus) DeepCopyInto(out *WarehouseStatus) {
 		*out = new(DiscoveredArtifacts)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LastPolled != nil {
+		in, out := &in.LastPolled, &out.LastPolled
+		*out = new(SubscriptionPollTimes)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseStatus.
@@ -1802,3 +2507,44 @@ func (in *WarehouseStatus) DeepCopy() *WarehouseStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookConfig) DeepCopyInto(out *WebhookConfig) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookConfig.
+func (in *WebhookConfig) DeepCopy() *WebhookConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookDeliveryStatus) DeepCopyInto(out *WebhookDeliveryStatus) {
+	*out = *in
+	if in.DeliveredAt != nil {
+		in, out := &in.DeliveredAt, &out.DeliveredAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookDeliveryStatus.
+func (in *WebhookDeliveryStatus) DeepCopy() *WebhookDeliveryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookDeliveryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
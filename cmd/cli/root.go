@@ -8,10 +8,12 @@ import (
 
 	"github.com/akuity/kargo/internal/cli/cmd/apply"
 	"github.com/akuity/kargo/internal/cli/cmd/approve"
+	"github.com/akuity/kargo/internal/cli/cmd/clone"
 	cliconfigcmd "github.com/akuity/kargo/internal/cli/cmd/config"
 	"github.com/akuity/kargo/internal/cli/cmd/create"
 	"github.com/akuity/kargo/internal/cli/cmd/dashboard"
 	"github.com/akuity/kargo/internal/cli/cmd/delete"
+	"github.com/akuity/kargo/internal/cli/cmd/diff"
 	"github.com/akuity/kargo/internal/cli/cmd/get"
 	"github.com/akuity/kargo/internal/cli/cmd/grant"
 	"github.com/akuity/kargo/internal/cli/cmd/login"
@@ -21,6 +23,7 @@ import (
 	"github.com/akuity/kargo/internal/cli/cmd/revoke"
 	"github.com/akuity/kargo/internal/cli/cmd/server"
 	"github.com/akuity/kargo/internal/cli/cmd/update"
+	"github.com/akuity/kargo/internal/cli/cmd/validate"
 	"github.com/akuity/kargo/internal/cli/cmd/verify"
 	"github.com/akuity/kargo/internal/cli/cmd/version"
 	clicfg "github.com/akuity/kargo/internal/cli/config"
@@ -44,9 +47,11 @@ func NewRootCommand(cfg clicfg.CLIConfig) *cobra.Command {
 	// Register the subcommands.
 	cmd.AddCommand(apply.NewCommand(cfg, streams))
 	cmd.AddCommand(approve.NewCommand(cfg))
+	cmd.AddCommand(clone.NewCommand(cfg, streams))
 	cmd.AddCommand(cliconfigcmd.NewCommand(cfg, streams))
 	cmd.AddCommand(create.NewCommand(cfg, streams))
 	cmd.AddCommand(delete.NewCommand(cfg, streams))
+	cmd.AddCommand(diff.NewCommand(cfg, streams))
 	cmd.AddCommand(get.NewCommand(cfg, streams))
 	cmd.AddCommand(grant.NewCommand(cfg, streams))
 	cmd.AddCommand(login.NewCommand(cfg))
@@ -54,6 +59,7 @@ func NewRootCommand(cfg clicfg.CLIConfig) *cobra.Command {
 	cmd.AddCommand(refresh.NewCommand(cfg))
 	cmd.AddCommand(revoke.NewCommand(cfg, streams))
 	cmd.AddCommand(update.NewCommand(cfg, streams))
+	cmd.AddCommand(validate.NewCommand(streams))
 	cmd.AddCommand(dashboard.NewCommand(cfg))
 	cmd.AddCommand(promote.NewCommand(cfg, streams))
 	cmd.AddCommand(verify.NewCommand(cfg))
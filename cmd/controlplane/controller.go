@@ -3,9 +3,15 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"sync"
+	"time"
 
 	"github.com/spf13/cobra"
+	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -20,12 +26,16 @@ import (
 	libargocd "github.com/akuity/kargo/internal/argocd"
 	"github.com/akuity/kargo/internal/controller"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/freight"
+	"github.com/akuity/kargo/internal/controller/httpapi"
+	"github.com/akuity/kargo/internal/controller/promotion"
 	"github.com/akuity/kargo/internal/controller/promotions"
 	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/stages"
 	"github.com/akuity/kargo/internal/controller/warehouses"
 	"github.com/akuity/kargo/internal/credentials"
 	credsdb "github.com/akuity/kargo/internal/credentials/kubernetes"
+	vaultcredsdb "github.com/akuity/kargo/internal/credentials/vault"
 	"github.com/akuity/kargo/internal/logging"
 	"github.com/akuity/kargo/internal/os"
 	"github.com/akuity/kargo/internal/types"
@@ -38,10 +48,15 @@ type controllerOptions struct {
 	ShardName  string
 	KubeConfig string
 
+	WatchedNamespaces controller.WatchedNamespaces
+
 	ArgoCDEnabled       bool
 	ArgoCDKubeConfig    string
 	ArgoCDNamespaceOnly bool
 
+	MetricsBindAddress string
+	HTTPAPIBindAddress string
+
 	Logger *logging.Logger
 }
 
@@ -70,9 +85,12 @@ func newControllerCommand() *cobra.Command {
 func (o *controllerOptions) complete() {
 	o.ShardName = os.GetEnv("SHARD_NAME", "")
 	o.KubeConfig = os.GetEnv("KUBECONFIG", "")
+	o.WatchedNamespaces = controller.WatchedNamespacesFromEnv()
 	o.ArgoCDEnabled = types.MustParseBool(os.GetEnv("ARGOCD_INTEGRATION_ENABLED", "true"))
 	o.ArgoCDKubeConfig = os.GetEnv("ARGOCD_KUBECONFIG", "")
 	o.ArgoCDNamespaceOnly = types.MustParseBool(os.GetEnv("ARGOCD_WATCH_ARGOCD_NAMESPACE_ONLY", "false"))
+	o.MetricsBindAddress = os.GetEnv("METRICS_BIND_ADDRESS", "0")
+	o.HTTPAPIBindAddress = os.GetEnv("HTTP_API_BIND_ADDRESS", "0")
 }
 
 func (o *controllerOptions) run(ctx context.Context) error {
@@ -85,6 +103,12 @@ func (o *controllerOptions) run(ctx context.Context) error {
 	if o.ShardName != "" {
 		startupLogger = startupLogger.WithValues("shard", o.ShardName)
 	}
+	if len(o.WatchedNamespaces.Namespaces) > 0 {
+		startupLogger = startupLogger.WithValues("watchedNamespaces", o.WatchedNamespaces.Namespaces)
+	}
+	if o.WatchedNamespaces.NamespacePrefix != "" {
+		startupLogger = startupLogger.WithValues("watchedNamespacePrefix", o.WatchedNamespaces.NamespacePrefix)
+	}
 	startupLogger.Info("Starting Kargo Controller")
 
 	promotionsReconcilerCfg := promotions.ReconcilerConfigFromEnv()
@@ -100,11 +124,10 @@ func (o *controllerOptions) run(ctx context.Context) error {
 		return fmt.Errorf("error initializing Argo CD Application controller manager: %w", err)
 	}
 
-	credentialsDB := credsdb.NewDatabase(
-		ctx,
-		kargoMgr.GetClient(),
-		credsdb.DatabaseConfigFromEnv(),
-	)
+	credentialsDB, err := o.setupCredentialsDB(ctx, kargoMgr)
+	if err != nil {
+		return fmt.Errorf("error initializing credentials database: %w", err)
+	}
 
 	if err := o.setupReconcilers(
 		ctx,
@@ -117,9 +140,69 @@ func (o *controllerOptions) run(ctx context.Context) error {
 		return fmt.Errorf("error setting up reconcilers: %w", err)
 	}
 
+	if err := o.setupHTTPAPIServer(kargoMgr, credentialsDB); err != nil {
+		return fmt.Errorf("error setting up HTTP API server: %w", err)
+	}
+
 	return o.startManagers(ctx, kargoMgr, argocdMgr)
 }
 
+// setupHTTPAPIServer adds an HTTP server to the provided manager that serves
+// the controller's HTTP API at the configured bind address. Every handler of
+// the HTTP API authenticates and authorizes its callers. Setting the bind
+// address to "0" disables the server.
+func (o *controllerOptions) setupHTTPAPIServer(
+	kargoMgr manager.Manager,
+	credentialsDB credentials.Database,
+) error {
+	if o.HTTPAPIBindAddress == "0" {
+		return nil
+	}
+	authorizer := httpapi.NewAuthorizer(kargoMgr.GetClient())
+	mux := http.NewServeMux()
+	mux.Handle(
+		stages.AvailableFreightHandlerPattern,
+		stages.NewAvailableFreightHandler(kargoMgr.GetClient(), authorizer),
+	)
+	mux.Handle(
+		freight.DiffHandlerPath,
+		freight.NewDiffHandler(kargoMgr.GetClient(), authorizer),
+	)
+	mux.Handle(
+		promotion.PreviewHandlerPath,
+		promotion.NewPreviewHandler(
+			kargoMgr.GetClient(),
+			promotion.NewGitMechanisms(kargoMgr.GetClient(), credentialsDB),
+			authorizer,
+		),
+	)
+	return kargoMgr.Add(&manager.Server{
+		Name: "http-api",
+		Server: &http.Server{
+			Addr:              o.HTTPAPIBindAddress,
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	})
+}
+
+// setupCredentialsDB returns a credentials.Database backed by HashiCorp Vault
+// if Vault is enabled, or by Kubernetes Secrets otherwise.
+func (o *controllerOptions) setupCredentialsDB(
+	ctx context.Context,
+	kargoMgr manager.Manager,
+) (credentials.Database, error) {
+	if vaultCfg := vaultcredsdb.DatabaseConfigFromEnv(); vaultCfg.Enabled {
+		o.Logger.Info("retrieving credentials from Vault")
+		return vaultcredsdb.NewDatabase(ctx, vaultCfg)
+	}
+	return credsdb.NewDatabase(
+		ctx,
+		kargoMgr.GetClient(),
+		credsdb.DatabaseConfigFromEnv(),
+	), nil
+}
+
 func (o *controllerOptions) setupKargoManager(
 	ctx context.Context,
 	stagesReconcilerCfg stages.ReconcilerConfig,
@@ -146,6 +229,30 @@ func (o *controllerOptions) setupKargoManager(
 			err,
 		)
 	}
+	if err = batchv1.AddToScheme(scheme); err != nil {
+		return nil, stagesReconcilerCfg, fmt.Errorf(
+			"error adding Kubernetes batch API to Kargo controller manager scheme: %w",
+			err,
+		)
+	}
+	if err = authnv1.AddToScheme(scheme); err != nil {
+		return nil, stagesReconcilerCfg, fmt.Errorf(
+			"error adding Kubernetes authentication API to Kargo controller manager scheme: %w",
+			err,
+		)
+	}
+	if err = authzv1.AddToScheme(scheme); err != nil {
+		return nil, stagesReconcilerCfg, fmt.Errorf(
+			"error adding Kubernetes authorization API to Kargo controller manager scheme: %w",
+			err,
+		)
+	}
+	if err = coordinationv1.AddToScheme(scheme); err != nil {
+		return nil, stagesReconcilerCfg, fmt.Errorf(
+			"error adding Kubernetes coordination API to Kargo controller manager scheme: %w",
+			err,
+		)
+	}
 	if err = kargoapi.AddToScheme(scheme); err != nil {
 		return nil, stagesReconcilerCfg, fmt.Errorf(
 			"error adding Kargo API to Kargo controller manager scheme: %w",
@@ -176,13 +283,29 @@ func (o *controllerOptions) setupKargoManager(
 		return nil, stagesReconcilerCfg, fmt.Errorf("error getting label requirement for credentials Secrets: %w", err)
 	}
 
+	secretCacheOpts := cache.ByObject{
+		Label: labels.NewSelector().Add(*secretReq),
+	}
+	// If watched namespaces are listed, only resources in those namespaces are
+	// cached. Credentials may still be found in the global credentials
+	// namespaces, so Secrets in those are cached as well.
+	watchedNamespaces := o.WatchedNamespaces.CacheNamespaces()
+	if watchedNamespaces != nil {
+		secretCacheOpts.Namespaces = make(map[string]cache.Config, len(watchedNamespaces))
+		for namespace := range watchedNamespaces {
+			secretCacheOpts.Namespaces[namespace] = cache.Config{}
+		}
+		for _, namespace := range credsdb.DatabaseConfigFromEnv().GlobalCredentialsNamespaces {
+			secretCacheOpts.Namespaces[namespace] = cache.Config{}
+		}
+	}
+
 	cacheOpts := cache.Options{
+		DefaultNamespaces: watchedNamespaces,
 		ByObject: map[client.Object]cache.ByObject{
 			// Only watch Secrets matching the label requirements
 			// for credentials.
-			&corev1.Secret{}: {
-				Label: labels.NewSelector().Add(*secretReq),
-			},
+			&corev1.Secret{}: secretCacheOpts,
 		},
 	}
 
@@ -191,9 +314,27 @@ func (o *controllerOptions) setupKargoManager(
 		ctrl.Options{
 			Scheme: scheme,
 			Metrics: server.Options{
-				BindAddress: "0",
+				BindAddress: o.MetricsBindAddress,
 			},
 			Cache: cacheOpts,
+			Client: client.Options{
+				Cache: &client.CacheOptions{
+					// Leases used to coordinate Promotions between replicas must
+					// always be read directly from the API server. Promotion hook
+					// Jobs are only ever read while waiting for them to finish, so
+					// there is no need to watch and cache them. Likewise, the few
+					// ConfigMaps holding SSH known hosts for Git subscriptions do
+					// not warrant watching every ConfigMap in the cluster, nor do
+					// the Pods selected by Pod image health checks warrant
+					// watching every Pod in the cluster.
+					DisableFor: []client.Object{
+						&corev1.ConfigMap{},
+						&corev1.Pod{},
+						&batchv1.Job{},
+						&coordinationv1.Lease{},
+					},
+				},
+			},
 		},
 	)
 	return mgr, stagesReconcilerCfg, err
@@ -273,10 +414,15 @@ func (o *controllerOptions) setupReconcilers(
 	promotionsReconcilerCfg promotions.ReconcilerConfig,
 	stagesReconcilerCfg stages.ReconcilerConfig,
 ) error {
+	// Clients for Argo CD instances in remote clusters are shared by the
+	// Promotions and Stages reconcilers.
+	argocdRemoteClients := libargocd.NewRemoteClients(credentialsDB)
+
 	if err := promotions.SetupReconcilerWithManager(
 		ctx,
 		kargoMgr,
 		argocdMgr,
+		argocdRemoteClients,
 		credentialsDB,
 		promotionsReconcilerCfg,
 	); err != nil {
@@ -287,6 +433,7 @@ func (o *controllerOptions) setupReconcilers(
 		ctx,
 		kargoMgr,
 		argocdMgr,
+		argocdRemoteClients,
 		stagesReconcilerCfg,
 	); err != nil {
 		return fmt.Errorf("error setting up Stages reconciler: %w", err)
@@ -295,7 +442,7 @@ func (o *controllerOptions) setupReconcilers(
 	if err := warehouses.SetupReconcilerWithManager(
 		kargoMgr,
 		credentialsDB,
-		o.ShardName,
+		warehouses.ReconcilerConfigFromEnv(),
 	); err != nil {
 		return fmt.Errorf("error setting up Warehouses reconciler: %w", err)
 	}
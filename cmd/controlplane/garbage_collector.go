@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -94,6 +95,9 @@ func (o *garbageCollectorOptions) setupManager(ctx context.Context) (manager.Man
 	if err = corev1.AddToScheme(scheme); err != nil {
 		return nil, fmt.Errorf("error adding Kubernetes core API to scheme: %w", err)
 	}
+	if err = batchv1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("error adding Kubernetes batch API to scheme: %w", err)
+	}
 	if err = kargoapi.AddToScheme(scheme); err != nil {
 		return nil, fmt.Errorf("error adding Kargo API to scheme: %w", err)
 	}
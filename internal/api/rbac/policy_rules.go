@@ -155,10 +155,12 @@ func buildRule(
 func validateResourceTypeName(resource string) error {
 	switch resource {
 	case "analysisruns", "analysistemplates", "events", "freights", "freights/status", "roles",
-		"rolebindings", "promotions", "secrets", "serviceaccounts", "stages", "warehouses":
+		"rolebindings", "promotions", "promotiontemplates", "secrets", "serviceaccounts", "stageroles",
+		"stages", "warehouses":
 		return nil
 	case "analysisrun", "analysistemplate", "event", "freight", "role",
-		"rolebinding", "promotion", "secret", "serviceaccount", "stage", "warehouse":
+		"rolebinding", "promotion", "promotiontemplate", "secret", "serviceaccount", "stagerole",
+		"stage", "warehouse":
 		return kubeerr.NewBadRequest(
 			fmt.Sprintf(`unrecognized resource type %q; did you mean "%ss"?`, resource, resource),
 		)
@@ -179,7 +181,8 @@ func getGroupName(resourceType string) string {
 		return ""
 	case "rolebindings", "roles":
 		return rbacv1.SchemeGroupVersion.Group
-	case "freights", "freights/status", "promotions", "stages", "warehouses":
+	case "freights", "freights/status", "promotions", "promotiontemplates", "stageroles", "stages",
+		"warehouses":
 		return kargoapi.GroupVersion.Group
 	case "analysisruns", "analysistemplates":
 		return rolloutsapi.GroupVersion.Group
@@ -1,13 +1,17 @@
 package argocd
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/jsonpath"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -39,15 +43,23 @@ type ApplicationHealthEvaluator interface {
 
 // applicationHealth is an ApplicationHealthEvaluator implementation.
 type applicationHealth struct {
-	kargoClient client.Client
-	argoClient  client.Client
+	kargoClient   client.Client
+	argoClient    client.Client
+	remoteClients RemoteClients
 }
 
-// NewApplicationHealthEvaluator returns a new ApplicationHealthEvaluator.
-func NewApplicationHealthEvaluator(kargoClient, argoClient client.Client) ApplicationHealthEvaluator {
+// NewApplicationHealthEvaluator returns a new ApplicationHealthEvaluator. The
+// health of Applications managed by Argo CD instances in named remote clusters
+// is assessed using clients obtained from the provided RemoteClients.
+func NewApplicationHealthEvaluator(
+	kargoClient client.Client,
+	argoClient client.Client,
+	remoteClients RemoteClients,
+) ApplicationHealthEvaluator {
 	return &applicationHealth{
-		kargoClient: kargoClient,
-		argoClient:  argoClient,
+		kargoClient:   kargoClient,
+		argoClient:    argoClient,
+		remoteClients: remoteClients,
 	}
 }
 
@@ -56,34 +68,51 @@ func (h *applicationHealth) EvaluateHealth(
 	ctx context.Context,
 	stage *kargoapi.Stage,
 ) *kargoapi.Health {
-	if stage.Spec.PromotionMechanisms == nil ||
-		len(stage.Spec.PromotionMechanisms.ArgoCDAppUpdates) == 0 {
-		return nil
-	}
-
-	if h.argoClient == nil {
+	mechs, err := kargoapi.ResolvePromotionMechanisms(ctx, h.kargoClient, stage)
+	if err != nil {
 		return &kargoapi.Health{
 			Status: kargoapi.HealthStateUnknown,
 			Issues: []string{
-				"Argo CD integration is disabled; cannot assess the health or sync status of Argo CD Applications",
+				fmt.Sprintf("error resolving PromotionMechanisms: %s", err),
 			},
 		}
 	}
+	if mechs == nil || len(mechs.ArgoCDAppUpdates) == 0 {
+		return nil
+	}
+
+	if h.argoClient == nil {
+		for _, update := range mechs.ArgoCDAppUpdates {
+			if update.ArgoCDClusterName == "" {
+				return &kargoapi.Health{
+					Status: kargoapi.HealthStateUnknown,
+					Issues: []string{
+						"Argo CD integration is disabled; cannot assess the health or sync status of Argo CD Applications",
+					},
+				}
+			}
+		}
+	}
 
 	health := kargoapi.Health{
 		Status:     kargoapi.HealthStateHealthy,
-		ArgoCDApps: make([]kargoapi.ArgoCDAppStatus, len(stage.Spec.PromotionMechanisms.ArgoCDAppUpdates)),
+		ArgoCDApps: make([]kargoapi.ArgoCDAppStatus, 0, len(mechs.ArgoCDAppUpdates)),
 		Issues:     make([]string, 0),
 	}
 
-	for i := range stage.Spec.PromotionMechanisms.ArgoCDAppUpdates {
-		update := &stage.Spec.PromotionMechanisms.ArgoCDAppUpdates[i]
+	for i := range mechs.ArgoCDAppUpdates {
+		update := &mechs.ArgoCDAppUpdates[i]
+		if update.ResourceType == kargoapi.ArgoCDResourceTypeApplicationSet {
+			// The names of the Applications generated from an ApplicationSet
+			// are not known, so their health cannot be assessed here.
+			continue
+		}
 		namespace := update.AppNamespace
 		if namespace == "" {
 			namespace = Namespace()
 		}
 
-		health.ArgoCDApps[i] = kargoapi.ArgoCDAppStatus{
+		appStatus := kargoapi.ArgoCDAppStatus{
 			Namespace: namespace,
 			Name:      update.AppName,
 		}
@@ -93,14 +122,15 @@ func (h *applicationHealth) EvaluateHealth(
 			stage,
 			update,
 			types.NamespacedName{
-				Namespace: health.ArgoCDApps[i].Namespace,
-				Name:      health.ArgoCDApps[i].Name,
+				Namespace: appStatus.Namespace,
+				Name:      appStatus.Name,
 			},
 		)
 
 		health.Status = health.Status.Merge(state)
-		health.ArgoCDApps[i].HealthStatus = healthStatus
-		health.ArgoCDApps[i].SyncStatus = syncStatus
+		appStatus.HealthStatus = healthStatus
+		appStatus.SyncStatus = syncStatus
+		health.ArgoCDApps = append(health.ArgoCDApps, appStatus)
 
 		if err != nil {
 			if cErr, ok := err.(compositeError); ok {
@@ -136,8 +166,13 @@ func (h *applicationHealth) GetApplicationHealth(
 		}
 	)
 
+	argoClient, err := h.getArgoCDClient(ctx, stage, update)
+	if err != nil {
+		return kargoapi.HealthStateUnknown, healthStatus, syncStatus, err
+	}
+
 	app := &argocd.Application{}
-	if err := h.argoClient.Get(ctx, key, app); err != nil {
+	if err := argoClient.Get(ctx, key, app); err != nil {
 		err = fmt.Errorf("error finding Argo CD Application %q in namespace %q: %w", key.Name, key.Namespace, err)
 		if client.IgnoreNotFound(err) == nil {
 			err = fmt.Errorf("unable to find Argo CD Application %q in namespace %q", key.Name, key.Namespace)
@@ -222,7 +257,7 @@ func (h *applicationHealth) GetApplicationHealth(
 			time.Sleep(duration)
 
 			// Re-fetch the application to get the latest state.
-			if err := h.argoClient.Get(ctx, key, app); err != nil {
+			if err := argoClient.Get(ctx, key, app); err != nil {
 				err = fmt.Errorf("error finding Argo CD Application %q in namespace %q: %w", key.Name, key.Namespace, err)
 				if client.IgnoreNotFound(err) == nil {
 					err = fmt.Errorf("unable to find Argo CD Application %q in namespace %q", key.Name, key.Namespace)
@@ -235,11 +270,101 @@ func (h *applicationHealth) GetApplicationHealth(
 	// With all the above checks passed, we can now assume the Argo CD
 	// Application's health state is reliable.
 	healthState, err := stageHealthForAppHealth(app)
+	if err != nil || update.HealthCheck == nil {
+		return healthState, healthStatus, syncStatus, err
+	}
+
+	// Finally, if a custom health check was specified, it must also pass.
+	healthState, err = stageHealthForAppHealthCheck(app, update.HealthCheck)
 	return healthState, healthStatus, syncStatus, err
 }
 
+// getArgoCDClient returns the client for the Argo CD instance managing the
+// Application referenced by the provided update.
+func (h *applicationHealth) getArgoCDClient(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	update *kargoapi.ArgoCDAppUpdate,
+) (client.Client, error) {
+	if update.ArgoCDClusterName == "" {
+		return h.argoClient, nil
+	}
+	if h.remoteClients == nil {
+		return nil, fmt.Errorf(
+			"cannot assess the health of Argo CD Application %q in cluster %q; "+
+				"remote Argo CD clusters are not supported by this controller",
+			update.AppName,
+			update.ArgoCDClusterName,
+		)
+	}
+	c, err := h.remoteClients.Get(ctx, stage.Namespace, update.ArgoCDClusterName)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error getting client for Argo CD cluster %q: %w",
+			update.ArgoCDClusterName,
+			err,
+		)
+	}
+	return c, nil
+}
+
+// stageHealthForAppHealthCheck returns the v1alpha1.HealthState for an Argo CD
+// Application based on the result of evaluating a custom health check against
+// it.
+func stageHealthForAppHealthCheck(
+	app *argocd.Application,
+	check *kargoapi.ArgoCDAppHealthCheck,
+) (kargoapi.HealthState, error) {
+	value, err := evaluateJSONPath(app, check.JSONPath)
+	if err != nil {
+		return kargoapi.HealthStateUnknown, fmt.Errorf(
+			"error evaluating health check for Argo CD Application %q in namespace %q: %w",
+			app.GetName(),
+			app.GetNamespace(),
+			err,
+		)
+	}
+	if value != check.ExpectedValue {
+		err = fmt.Errorf(
+			"Argo CD Application %q in namespace %q has value %q at %q; expected %q",
+			app.GetName(),
+			app.GetNamespace(),
+			value,
+			check.JSONPath,
+			check.ExpectedValue,
+		)
+		return kargoapi.HealthStateProgressing, err
+	}
+	return kargoapi.HealthStateHealthy, nil
+}
+
+// evaluateJSONPath evaluates the provided JSONPath expression against the
+// provided Argo CD Application and returns the result as a string. Missing
+// keys evaluate to an empty string.
+func evaluateJSONPath(app *argocd.Application, expr string) (string, error) {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(app)
+	if err != nil {
+		return "", fmt.Errorf("error converting Application to unstructured: %w", err)
+	}
+	expr = strings.TrimSpace(expr)
+	if !strings.HasPrefix(expr, "{") {
+		expr = fmt.Sprintf("{%s}", expr)
+	}
+	jp := jsonpath.New("health-check").AllowMissingKeys(true)
+	if err = jp.Parse(expr); err != nil {
+		return "", fmt.Errorf("error parsing JSONPath expression %q: %w", expr, err)
+	}
+	buf := &bytes.Buffer{}
+	if err = jp.Execute(buf, obj); err != nil {
+		return "", fmt.Errorf("error executing JSONPath expression %q: %w", expr, err)
+	}
+	return buf.String(), nil
+}
+
 // stageHealthForAppSync returns the v1alpha1.HealthState for an Argo CD
-// Application based on its sync status.
+// Application based on its sync status. An Application that does not track
+// the provided revision is considered unhealthy. If it did sync that revision
+// before, it is reported as having drifted from it.
 func stageHealthForAppSync(app *argocd.Application, revision string) (kargoapi.HealthState, error) {
 	switch {
 	case revision == "":
@@ -252,11 +377,30 @@ func stageHealthForAppSync(app *argocd.Application, revision string) (kargoapi.H
 			app.GetNamespace(),
 		)
 		return kargoapi.HealthStateUnknown, err
+	case app.Status.Sync.Revision != revision &&
+		app.Status.OperationState.SyncResult != nil &&
+		app.Status.OperationState.SyncResult.Revision == revision:
+		// The last sync operation synced the desired revision, but the
+		// Application has since been changed to track another one, e.g.
+		// manually.
+		err := fmt.Errorf(
+			"Argo CD Application %q in namespace %q has drifted: it was synced "+
+				"to revision %q of the Stage's current Freight, but now tracks "+
+				"revision %q",
+			app.GetName(),
+			app.GetNamespace(),
+			revision,
+			app.Status.Sync.Revision,
+		)
+		return kargoapi.HealthStateUnhealthy, err
 	case app.Status.Sync.Revision != revision:
 		err := fmt.Errorf(
-			"Argo CD Application %q in namespace %q is out of sync",
+			"Argo CD Application %q in namespace %q is out of sync: it tracks "+
+				"revision %q instead of revision %q of the Stage's current Freight",
 			app.GetName(),
 			app.GetNamespace(),
+			app.Status.Sync.Revision,
+			revision,
 		)
 		return kargoapi.HealthStateUnhealthy, err
 	default:
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -119,6 +120,28 @@ func TestApplicationHealth_EvaluateHealth(t *testing.T) {
 				}, health.ArgoCDApps[0])
 			},
 		},
+		{
+			name: "ApplicationSet update is skipped",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								AppNamespace: "fake-namespace",
+								AppName:      "fake-name",
+								ResourceType: kargoapi.ArgoCDResourceTypeApplicationSet,
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.NotNil(t, health)
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.Empty(t, health.Issues)
+				require.Empty(t, health.ArgoCDApps)
+			},
+		},
 		{
 			name: "multiple updates",
 			applications: []client.Object{
@@ -311,6 +334,154 @@ func TestApplicationHealth_EvaluateHealth(t *testing.T) {
 		require.Len(t, health.Issues, 1)
 		require.Contains(t, health.Issues[0], "Argo CD integration is disabled")
 	})
+
+	t.Run("Application in remote cluster", func(t *testing.T) {
+		remoteClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&argocd.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-name",
+					Namespace: "fake-namespace",
+				},
+				Status: argocd.ApplicationStatus{
+					Health: argocd.HealthStatus{Status: argocd.HealthStatusHealthy},
+					Sync:   argocd.SyncStatus{Status: argocd.SyncStatusCodeSynced},
+				},
+			},
+		).Build()
+		h := &applicationHealth{
+			remoteClients: &FakeRemoteClients{
+				GetFn: func(_ context.Context, _, clusterName string) (client.Client, error) {
+					if clusterName != "fake-cluster" {
+						return nil, errors.New("unexpected cluster")
+					}
+					return remoteClient, nil
+				},
+			},
+		}
+		health := h.EvaluateHealth(
+			context.Background(),
+			&kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{{
+							AppName:           "fake-name",
+							AppNamespace:      "fake-namespace",
+							ArgoCDClusterName: "fake-cluster",
+						}},
+					},
+				},
+			},
+		)
+		require.NotNil(t, health)
+		require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+		require.Empty(t, health.Issues)
+	})
+
+	t.Run("Application updated by PromotionTemplate", func(t *testing.T) {
+		kargoScheme := runtime.NewScheme()
+		require.NoError(t, kargoapi.AddToScheme(kargoScheme))
+		h := &applicationHealth{
+			kargoClient: fake.NewClientBuilder().WithScheme(kargoScheme).WithObjects(
+				&kargoapi.PromotionTemplate{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "fake-template",
+					},
+					Spec: kargoapi.PromotionTemplateSpec{
+						PromotionMechanisms: kargoapi.PromotionMechanisms{
+							ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{{
+								AppName:      "fake-name",
+								AppNamespace: "fake-namespace",
+							}},
+						},
+					},
+				},
+			).Build(),
+			argoClient: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&argocd.Application{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-name",
+						Namespace: "fake-namespace",
+					},
+					Status: argocd.ApplicationStatus{
+						Health: argocd.HealthStatus{Status: argocd.HealthStatusHealthy},
+						Sync:   argocd.SyncStatus{Status: argocd.SyncStatusCodeSynced},
+					},
+				},
+			).Build(),
+		}
+		health := h.EvaluateHealth(
+			context.Background(),
+			&kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "fake-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					PromotionTemplateRef: &corev1.LocalObjectReference{
+						Name: "fake-template",
+					},
+				},
+			},
+		)
+		require.NotNil(t, health)
+		require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+		require.Len(t, health.ArgoCDApps, 1)
+		require.Equal(t, "fake-name", health.ArgoCDApps[0].Name)
+	})
+
+	t.Run("error resolving PromotionTemplate", func(t *testing.T) {
+		kargoScheme := runtime.NewScheme()
+		require.NoError(t, kargoapi.AddToScheme(kargoScheme))
+		h := &applicationHealth{
+			kargoClient: fake.NewClientBuilder().WithScheme(kargoScheme).Build(),
+		}
+		health := h.EvaluateHealth(
+			context.Background(),
+			&kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "fake-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					PromotionTemplateRef: &corev1.LocalObjectReference{
+						Name: "fake-template",
+					},
+				},
+			},
+		)
+		require.NotNil(t, health)
+		require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+		require.Len(t, health.Issues, 1)
+		require.Contains(t, health.Issues[0], "error resolving PromotionMechanisms")
+	})
+
+	t.Run("error getting client for remote cluster", func(t *testing.T) {
+		h := &applicationHealth{
+			remoteClients: &FakeRemoteClients{
+				GetFn: func(context.Context, string, string) (client.Client, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+		}
+		health := h.EvaluateHealth(
+			context.Background(),
+			&kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{{
+							AppName:           "fake-name",
+							ArgoCDClusterName: "fake-cluster",
+						}},
+					},
+				},
+			},
+		)
+		require.NotNil(t, health)
+		require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+		require.Len(t, health.Issues, 1)
+		require.Contains(t, health.Issues[0], "something went wrong")
+	})
 }
 
 func TestApplicationHealth_GetApplicationHealth(t *testing.T) {
@@ -567,6 +738,72 @@ func TestApplicationHealth_GetApplicationHealth(t *testing.T) {
 				}, syncStatus)
 			},
 		},
+		{
+			name: "Application drifted from desired revision",
+			key:  types.NamespacedName{Namespace: "fake-namespace", Name: "fake-name"},
+			application: &argocd.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-name",
+				},
+				Spec: argocd.ApplicationSpec{
+					Source: &argocd.ApplicationSource{
+						RepoURL: "https://example.com/universe/42",
+					},
+				},
+				Status: argocd.ApplicationStatus{
+					Health: argocd.HealthStatus{
+						Status: argocd.HealthStatusHealthy,
+					},
+					Sync: argocd.SyncStatus{
+						Status:   argocd.SyncStatusCodeSynced,
+						Revision: "fake-revision",
+					},
+					OperationState: &argocd.OperationState{
+						SyncResult: &argocd.SyncOperationResult{
+							Revision: "other-fake-revision",
+						},
+						FinishedAt: ptr.To(metav1.Now()),
+					},
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: testStageSpec,
+				Status: kargoapi.StageStatus{
+					FreightHistory: kargoapi.FreightHistory{
+						&kargoapi.FreightCollection{
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Origin: testOrigin,
+									Commits: []kargoapi.GitCommit{{
+										RepoURL: "https://example.com/universe/42",
+										ID:      "other-fake-revision",
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				state kargoapi.HealthState,
+				healthStatus kargoapi.ArgoCDAppHealthStatus,
+				syncStatus kargoapi.ArgoCDAppSyncStatus,
+				err error,
+			) {
+				require.ErrorContains(t, err, "has drifted")
+
+				require.Equal(t, kargoapi.HealthStateUnhealthy, state)
+				require.Equal(t, kargoapi.ArgoCDAppHealthStatus{
+					Status: kargoapi.ArgoCDAppHealthStateHealthy,
+				}, healthStatus)
+				require.Equal(t, kargoapi.ArgoCDAppSyncStatus{
+					Status:   kargoapi.ArgoCDAppSyncStateSynced,
+					Revision: "fake-revision",
+				}, syncStatus)
+			},
+		},
 		{
 			name: "Without a desired revision, Application is Healthy",
 			key:  types.NamespacedName{Namespace: "fake-namespace", Name: "fake-name"},
@@ -859,6 +1096,29 @@ func Test_stageHealthForAppSync(t *testing.T) {
 			},
 			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
 				require.ErrorContains(t, err, "is out of sync")
+				require.ErrorContains(t, err, `tracks revision "other-fake-revision" instead of revision "fake-revision"`)
+				require.Equal(t, kargoapi.HealthStateUnhealthy, state)
+			},
+		},
+		{
+			name:     "sync revision drifted after sync",
+			revision: "fake-revision",
+			app: &argocd.Application{
+				Status: argocd.ApplicationStatus{
+					Sync: argocd.SyncStatus{
+						Revision: "other-fake-revision",
+					},
+					OperationState: &argocd.OperationState{
+						SyncResult: &argocd.SyncOperationResult{
+							Revision: "fake-revision",
+						},
+						FinishedAt: ptr.To(metav1.Now()),
+					},
+				},
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, "has drifted")
+				require.ErrorContains(t, err, `now tracks revision "other-fake-revision"`)
 				require.Equal(t, kargoapi.HealthStateUnhealthy, state)
 			},
 		},
@@ -972,6 +1232,91 @@ func Test_stageHealthForAppHealth(t *testing.T) {
 	}
 }
 
+func Test_stageHealthForAppHealthCheck(t *testing.T) {
+	testApp := &argocd.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-name",
+		},
+		Status: argocd.ApplicationStatus{
+			Health: argocd.HealthStatus{
+				Status: argocd.HealthStatusHealthy,
+			},
+			OperationState: &argocd.OperationState{
+				Phase:   argocd.OperationSucceeded,
+				Message: "successfully synced",
+			},
+		},
+	}
+	tests := []struct {
+		name       string
+		check      *kargoapi.ArgoCDAppHealthCheck
+		assertions func(*testing.T, kargoapi.HealthState, error)
+	}{
+		{
+			name: "invalid expression",
+			check: &kargoapi.ArgoCDAppHealthCheck{
+				JSONPath: "{.status.operationState[}",
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, "error parsing JSONPath expression")
+				require.Equal(t, kargoapi.HealthStateUnknown, state)
+			},
+		},
+		{
+			name: "value does not match",
+			check: &kargoapi.ArgoCDAppHealthCheck{
+				JSONPath:      "{.status.operationState.phase}",
+				ExpectedValue: "Running",
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, `has value "Succeeded"`)
+				require.ErrorContains(t, err, `expected "Running"`)
+				require.Equal(t, kargoapi.HealthStateProgressing, state)
+			},
+		},
+		{
+			name: "missing field does not match",
+			check: &kargoapi.ArgoCDAppHealthCheck{
+				JSONPath:      "{.status.operationState.syncResult.revision}",
+				ExpectedValue: "fake-revision",
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, `has value ""`)
+				require.Equal(t, kargoapi.HealthStateProgressing, state)
+			},
+		},
+		{
+			name: "value matches",
+			check: &kargoapi.ArgoCDAppHealthCheck{
+				JSONPath:      "{.status.operationState.phase}",
+				ExpectedValue: "Succeeded",
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.HealthStateHealthy, state)
+			},
+		},
+		{
+			name: "value matches expression without braces",
+			check: &kargoapi.ArgoCDAppHealthCheck{
+				JSONPath:      ".status.operationState.message",
+				ExpectedValue: "successfully synced",
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.HealthStateHealthy, state)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := stageHealthForAppHealthCheck(testApp, tt.check)
+			tt.assertions(t, got, err)
+		})
+	}
+}
+
 func Test_filterAppConditions(t *testing.T) {
 	tests := []struct {
 		name       string
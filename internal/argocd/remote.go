@@ -0,0 +1,206 @@
+package argocd
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+)
+
+// clusterURLPrefix is the prefix of the URL under which credentials for an
+// Argo CD instance running in a named cluster are stored.
+const clusterURLPrefix = "argocd://"
+
+// ClusterURL returns the URL under which credentials for the Argo CD instance
+// running in the named cluster are looked up.
+func ClusterURL(clusterName string) string {
+	return clusterURLPrefix + clusterName
+}
+
+// RemoteClients resolves clients for Argo CD instances running in named
+// clusters other than the one Kargo is configured to integrate with by
+// default.
+type RemoteClients interface {
+	// Get returns a client for the Argo CD instance running in the named
+	// cluster, using credentials available to the specified Project.
+	Get(ctx context.Context, project, clusterName string) (client.Client, error)
+}
+
+// remoteClient is a client for a remote Argo CD instance along with the
+// kubeconfig it was built from.
+type remoteClient struct {
+	kubeconfig string
+	client     client.Client
+}
+
+// remoteClients is a RemoteClients implementation that builds clients from
+// credentials of type argocd and caches them for as long as those credentials
+// remain unchanged.
+type remoteClients struct {
+	credentialsDB credentials.Database
+	clients       map[string]*remoteClient
+	mu            sync.Mutex
+	// These behaviors are overridable for testing purposes:
+	newClientFn func(kubeconfig []byte) (client.Client, error)
+}
+
+// NewRemoteClients returns a RemoteClients implementation that builds clients
+// from credentials of type argocd found in the provided credentials database.
+// The password field of such credentials must contain a kubeconfig for the
+// cluster in which the remote Argo CD instance is running and the repoURL
+// field must be of the form argocd://<cluster-name>.
+func NewRemoteClients(credentialsDB credentials.Database) RemoteClients {
+	return &remoteClients{
+		credentialsDB: credentialsDB,
+		clients:       map[string]*remoteClient{},
+		newClientFn:   newRemoteClient,
+	}
+}
+
+// Get implements RemoteClients.
+func (r *remoteClients) Get(
+	ctx context.Context,
+	project string,
+	clusterName string,
+) (client.Client, error) {
+	clusterURL := ClusterURL(clusterName)
+	creds, ok, err := r.credentialsDB.Get(ctx, project, credentials.TypeArgoCD, clusterURL)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error obtaining credentials for Argo CD cluster %q: %w",
+			clusterName,
+			err,
+		)
+	}
+	if !ok {
+		return nil, fmt.Errorf(
+			"no credentials found for Argo CD cluster %q in namespace %q",
+			clusterName,
+			project,
+		)
+	}
+
+	// Clients are cached per Project because each Project is free to use
+	// different credentials for the same cluster.
+	key := fmt.Sprintf("%s:%s", project, clusterName)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if c, ok := r.clients[key]; ok && c.kubeconfig == creds.Password {
+		return c.client, nil
+	}
+	c, err := r.newClientFn([]byte(creds.Password))
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error building client for Argo CD cluster %q: %w",
+			clusterName,
+			err,
+		)
+	}
+	r.clients[key] = &remoteClient{
+		kubeconfig: creds.Password,
+		client:     c,
+	}
+	return c, nil
+}
+
+// newRemoteClient builds a client for the cluster described by the provided
+// kubeconfig that is capable of working with Argo CD Applications and Events.
+// Because the kubeconfig is supplied by a Project, it is rejected if it
+// references anything other than its own content, which would otherwise permit
+// a Project to execute commands or read files within the controller.
+func newRemoteClient(kubeconfig []byte) (client.Client, error) {
+	cfg, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("error loading kubeconfig: %w", err)
+	}
+	if err = validateRemoteKubeconfig(cfg); err != nil {
+		return nil, err
+	}
+	restCfg, err := clientcmd.NewDefaultClientConfig(
+		*cfg,
+		&clientcmd.ConfigOverrides{},
+	).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error loading REST config: %w", err)
+	}
+	scheme := runtime.NewScheme()
+	if err = corev1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("error adding Kubernetes core API to scheme: %w", err)
+	}
+	if err = argocd.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("error adding Argo CD API to scheme: %w", err)
+	}
+	return client.New(restCfg, client.Options{Scheme: scheme})
+}
+
+// validateRemoteKubeconfig returns an error if the provided kubeconfig makes
+// use of an exec plugin or an auth provider, or references files, rather than
+// embedding all of the credentials and certificates it requires.
+func validateRemoteKubeconfig(cfg *clientcmdapi.Config) error {
+	for name, cluster := range cfg.Clusters {
+		if cluster.CertificateAuthority != "" {
+			return fmt.Errorf(
+				"cluster %q of kubeconfig references a certificate authority file; "+
+					"certificate-authority-data must be used instead",
+				name,
+			)
+		}
+	}
+	for name, authInfo := range cfg.AuthInfos {
+		switch {
+		case authInfo.Exec != nil:
+			return fmt.Errorf("user %q of kubeconfig uses an exec plugin, which is not permitted", name)
+		case authInfo.AuthProvider != nil:
+			return fmt.Errorf("user %q of kubeconfig uses an auth provider, which is not permitted", name)
+		case authInfo.TokenFile != "":
+			return fmt.Errorf(
+				"user %q of kubeconfig references a token file; token must be used instead",
+				name,
+			)
+		case authInfo.ClientCertificate != "":
+			return fmt.Errorf(
+				"user %q of kubeconfig references a client certificate file; "+
+					"client-certificate-data must be used instead",
+				name,
+			)
+		case authInfo.ClientKey != "":
+			return fmt.Errorf(
+				"user %q of kubeconfig references a client key file; "+
+					"client-key-data must be used instead",
+				name,
+			)
+		}
+	}
+	return nil
+}
+
+// FakeRemoteClients is a mock implementation of RemoteClients for use in
+// tests.
+type FakeRemoteClients struct {
+	GetFn func(
+		ctx context.Context,
+		project string,
+		clusterName string,
+	) (client.Client, error)
+}
+
+// Get implements RemoteClients.
+func (f *FakeRemoteClients) Get(
+	ctx context.Context,
+	project string,
+	clusterName string,
+) (client.Client, error) {
+	if f.GetFn == nil {
+		return nil, nil
+	}
+	return f.GetFn(ctx, project, clusterName)
+}
@@ -0,0 +1,276 @@
+package argocd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuity/kargo/internal/credentials"
+)
+
+func TestNewRemoteClients(t *testing.T) {
+	rc := NewRemoteClients(&credentials.FakeDB{})
+	r, ok := rc.(*remoteClients)
+	require.True(t, ok)
+	require.NotNil(t, r.credentialsDB)
+	require.NotNil(t, r.clients)
+	require.NotNil(t, r.newClientFn)
+}
+
+func TestRemoteClientsGet(t *testing.T) {
+	testCases := []struct {
+		name       string
+		clients    *remoteClients
+		assertions func(*testing.T, *remoteClients, client.Client, error)
+	}{
+		{
+			name: "error getting credentials",
+			clients: &remoteClients{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{}, false, errors.New("something went wrong")
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *remoteClients, _ client.Client, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials for Argo CD cluster")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "credentials not found",
+			clients: &remoteClients{
+				credentialsDB: &credentials.FakeDB{},
+			},
+			assertions: func(t *testing.T, _ *remoteClients, _ client.Client, err error) {
+				require.ErrorContains(t, err, "no credentials found for Argo CD cluster")
+			},
+		},
+		{
+			name: "error building client",
+			clients: &remoteClients{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{Password: "fake-kubeconfig"}, true, nil
+					},
+				},
+				clients: map[string]*remoteClient{},
+				newClientFn: func([]byte) (client.Client, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ *remoteClients, _ client.Client, err error) {
+				require.ErrorContains(t, err, "error building client for Argo CD cluster")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "cache miss",
+			clients: &remoteClients{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						_ context.Context,
+						namespace string,
+						credType credentials.Type,
+						repoURL string,
+					) (credentials.Credentials, bool, error) {
+						if namespace != "fake-project" ||
+							credType != credentials.TypeArgoCD ||
+							repoURL != "argocd://fake-cluster" {
+							return credentials.Credentials{}, false, nil
+						}
+						return credentials.Credentials{Password: "fake-kubeconfig"}, true, nil
+					},
+				},
+				clients: map[string]*remoteClient{},
+				newClientFn: func(kubeconfig []byte) (client.Client, error) {
+					if string(kubeconfig) != "fake-kubeconfig" {
+						return nil, errors.New("unexpected kubeconfig")
+					}
+					return fake.NewFakeClient(), nil
+				},
+			},
+			assertions: func(t *testing.T, r *remoteClients, c client.Client, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, c)
+				require.Len(t, r.clients, 1)
+				require.Same(t, c, r.clients["fake-project:fake-cluster"].client)
+			},
+		},
+		{
+			name: "cache hit",
+			clients: &remoteClients{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{Password: "fake-kubeconfig"}, true, nil
+					},
+				},
+				clients: map[string]*remoteClient{
+					"fake-project:fake-cluster": {
+						kubeconfig: "fake-kubeconfig",
+						client:     fake.NewFakeClient(),
+					},
+				},
+				newClientFn: func([]byte) (client.Client, error) {
+					return nil, errors.New("should not be called")
+				},
+			},
+			assertions: func(t *testing.T, r *remoteClients, c client.Client, err error) {
+				require.NoError(t, err)
+				require.Same(t, r.clients["fake-project:fake-cluster"].client, c)
+			},
+		},
+		{
+			name: "cache hit; credentials changed",
+			clients: &remoteClients{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{Password: "new-fake-kubeconfig"}, true, nil
+					},
+				},
+				clients: map[string]*remoteClient{
+					"fake-project:fake-cluster": {
+						kubeconfig: "fake-kubeconfig",
+						client:     fake.NewFakeClient(),
+					},
+				},
+				newClientFn: func([]byte) (client.Client, error) {
+					return fake.NewFakeClient(), nil
+				},
+			},
+			assertions: func(t *testing.T, r *remoteClients, c client.Client, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "new-fake-kubeconfig", r.clients["fake-project:fake-cluster"].kubeconfig)
+				require.Same(t, r.clients["fake-project:fake-cluster"].client, c)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c, err := testCase.clients.Get(context.Background(), "fake-project", "fake-cluster")
+			testCase.assertions(t, testCase.clients, c, err)
+		})
+	}
+}
+
+func TestNewRemoteClient(t *testing.T) {
+	const kubeconfigFmt = `apiVersion: v1
+kind: Config
+clusters:
+- name: fake-cluster
+  cluster:
+    server: https://fake-cluster.example.com
+%s
+users:
+- name: fake-user
+  user:
+%s
+contexts:
+- name: fake-context
+  context:
+    cluster: fake-cluster
+    user: fake-user
+current-context: fake-context
+`
+	testCases := []struct {
+		name       string
+		cluster    string
+		user       string
+		assertions func(*testing.T, client.Client, error)
+	}{
+		{
+			name:    "invalid kubeconfig",
+			cluster: "  invalid",
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, "error loading kubeconfig")
+			},
+		},
+		{
+			name:    "certificate authority file",
+			cluster: "    certificate-authority: /etc/ssl/ca.crt",
+			user:    "    token: fake-token",
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, "references a certificate authority file")
+			},
+		},
+		{
+			name: "exec plugin",
+			user: `    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: /bin/sh`,
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, "uses an exec plugin")
+			},
+		},
+		{
+			name: "auth provider",
+			user: `    auth-provider:
+      name: oidc`,
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, "uses an auth provider")
+			},
+		},
+		{
+			name: "token file",
+			user: "    tokenFile: /var/run/secrets/kubernetes.io/serviceaccount/token",
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, "references a token file")
+			},
+		},
+		{
+			name: "client certificate file",
+			user: "    client-certificate: /etc/ssl/client.crt",
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, "references a client certificate file")
+			},
+		},
+		{
+			name: "client key file",
+			user: "    client-key: /etc/ssl/client.key",
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, "references a client key file")
+			},
+		},
+		{
+			name: "success",
+			user: "    token: fake-token",
+			assertions: func(t *testing.T, c client.Client, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, c)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c, err := newRemoteClient(
+				[]byte(fmt.Sprintf(kubeconfigFmt, testCase.cluster, testCase.user)),
+			)
+			testCase.assertions(t, c, err)
+		})
+	}
+}
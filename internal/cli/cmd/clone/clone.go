@@ -0,0 +1,27 @@
+package clone
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+)
+
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clone SUBCOMMAND",
+		Short: "Create a resource from an existing one",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Create a stage named canary from the stage named staging
+kargo clone stage --project=my-project staging --to=canary
+`),
+	}
+
+	// Register subcommands.
+	cmd.AddCommand(newStageCommand(cfg, streams))
+
+	return cmd
+}
@@ -0,0 +1,331 @@
+package clone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	sigyaml "sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/kubernetes"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+const (
+	toFlag             = "to"
+	toProjectFlag      = "to-project"
+	subReplacementFlag = "sub-replacement"
+)
+
+// transientAnnotations are annotations that request a one-time action of the
+// controller or record runtime information about a particular Stage, and
+// therefore are not carried over to a clone.
+var transientAnnotations = []string{
+	kargoapi.AnnotationKeyRefresh,
+	kargoapi.AnnotationKeyReverify,
+	kargoapi.AnnotationKeyAbort,
+	kargoapi.AnnotationKeyCreateActor,
+	kargoapi.AnnotationKeyPromoteFreight,
+	corev1.LastAppliedConfigAnnotation,
+}
+
+type cloneStageOptions struct {
+	genericiooptions.IOStreams
+	*genericclioptions.PrintFlags
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project         string
+	Name            string
+	To              string
+	ToProject       string
+	SubReplacements []string
+
+	replacements [][2]string
+}
+
+func newStageCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &cloneStageOptions{
+		Config:     cfg,
+		IOStreams:  streams,
+		PrintFlags: genericclioptions.NewPrintFlags("created").WithTypeSetter(kubernetes.GetScheme()),
+	}
+
+	cmd := &cobra.Command{
+		Use: "stage [--project=project] NAME --to=NEW-NAME [--to-project=project] " +
+			"[--sub-replacement=old=new]...",
+		Short: "Create a stage with the same spec as an existing stage",
+		Args:  option.ExactArgs(1),
+		Example: templates.Example(`
+# Create a stage named canary with the same spec as the stage named staging
+kargo clone stage --project=my-project staging --to=canary
+
+# Create a stage with the same spec as a stage in another project
+kargo clone stage --project=my-project staging --to=staging --to-project=other-project
+
+# Create a stage that requests freight from a different warehouse and
+# updates a different git repository
+kargo clone stage --project=my-project staging --to=canary \
+  --sub-replacement=staging-warehouse=canary-warehouse \
+  --sub-replacement=github.com/example/staging=github.com/example/canary
+
+# Clone a stage in the default project
+kargo config set-project my-project
+kargo clone stage staging --to=canary
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdOpts.complete(args)
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the clone stage options to the provided command.
+func (o *cloneStageOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+	o.PrintFlags.AddFlags(cmd)
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project the stage to clone belongs to. If not set, the default project will be used.",
+	)
+	cmd.Flags().StringVar(&o.To, toFlag, "", "The name of the stage to create.")
+	cmd.Flags().StringVar(
+		&o.ToProject, toProjectFlag, "",
+		"The project to create the stage in. If not set, the project of the stage to clone will be used.",
+	)
+	cmd.Flags().StringArrayVar(
+		&o.SubReplacements, subReplacementFlag, nil,
+		"A replacement of the form old=new to apply to the names of the warehouses "+
+			"the stage requests freight from and to the repository URLs of its "+
+			"promotion mechanisms. May be specified multiple times.",
+	)
+
+	if err := cmd.MarkFlagRequired(toFlag); err != nil {
+		panic(fmt.Errorf("could not mark %s flag as required: %w", toFlag, err))
+	}
+}
+
+// complete sets the options from the command arguments.
+func (o *cloneStageOptions) complete(args []string) {
+	o.Name = strings.TrimSpace(strings.ToLower(args[0]))
+	o.To = strings.TrimSpace(strings.ToLower(o.To))
+	o.ToProject = strings.TrimSpace(o.ToProject)
+	if o.ToProject == "" {
+		o.ToProject = o.Project
+	}
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *cloneStageOptions) validate() error {
+	var errs []error
+	// While the flags are marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flags are not empty.
+	if o.Project == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
+	}
+	if o.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+	if o.To == "" {
+		errs = append(errs, fmt.Errorf("%s is required", toFlag))
+	}
+	if o.To == o.Name && o.ToProject == o.Project {
+		errs = append(errs, fmt.Errorf(
+			"%s must differ from the name of the stage to clone unless %s is set",
+			toFlag, toProjectFlag,
+		))
+	}
+	o.replacements = make([][2]string, 0, len(o.SubReplacements))
+	for _, r := range o.SubReplacements {
+		old, replacement, ok := strings.Cut(r, "=")
+		if !ok || old == "" {
+			errs = append(errs, fmt.Errorf(
+				"invalid %s %q: must be of the form old=new", subReplacementFlag, r,
+			))
+			continue
+		}
+		o.replacements = append(o.replacements, [2]string{old, replacement})
+	}
+	return errors.Join(errs...)
+}
+
+// run clones the stage using the provided options.
+func (o *cloneStageOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	stage, err := o.cloneStage(ctx, kargoSvcCli)
+	if err != nil {
+		return err
+	}
+
+	printer, err := o.PrintFlags.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("new printer: %w", err)
+	}
+	return printer.PrintObj(stage, o.IOStreams.Out)
+}
+
+// cloneStage creates a copy of the stage to clone and returns the stage as
+// created.
+func (o *cloneStageOptions) cloneStage(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+) (*kargoapi.Stage, error) {
+	resp, err := kargoSvcCli.GetStage(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.GetStageRequest{
+				Project: o.Project,
+				Name:    o.Name,
+			},
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get stage: %w", err)
+	}
+
+	// Fail early and clearly if the new stage already exists, rather than
+	// relying on the error returned when creating it.
+	if _, err = kargoSvcCli.GetStage(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.GetStageRequest{
+				Project: o.ToProject,
+				Name:    o.To,
+			},
+		),
+	); err == nil {
+		return nil, fmt.Errorf(
+			"stage %q already exists in project %q", o.To, o.ToProject,
+		)
+	} else if connect.CodeOf(err) != connect.CodeNotFound {
+		return nil, fmt.Errorf("get stage: %w", err)
+	}
+
+	stage := newStageClone(resp.Msg.GetStage(), o.ToProject, o.To, o.replacements)
+	stageBytes, err := sigyaml.Marshal(stage)
+	if err != nil {
+		return nil, fmt.Errorf("marshal stage: %w", err)
+	}
+
+	createResp, err := kargoSvcCli.CreateResource(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.CreateResourceRequest{
+				Manifest: stageBytes,
+			},
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create resource: %w", err)
+	}
+	results := createResp.Msg.GetResults()
+	if len(results) == 0 {
+		return nil, errors.New("create resource: no result returned")
+	}
+	if createErr := results[0].GetError(); createErr != "" {
+		return nil, fmt.Errorf("create resource: %s", createErr)
+	}
+
+	stage = &kargoapi.Stage{}
+	if err = sigyaml.Unmarshal(results[0].GetCreatedResourceManifest(), stage); err != nil {
+		return nil, fmt.Errorf("unmarshal stage: %w", err)
+	}
+	return stage, nil
+}
+
+// newStageClone returns a new Stage with the provided namespace and name and
+// the same spec, labels, and annotations as the provided Stage. Status,
+// runtime metadata, and transient annotations are not copied. Each of the
+// provided replacements of the form {old, new} is applied to the names of the
+// Warehouses the Stage requests Freight from and to the repository URLs of
+// its promotion mechanisms.
+func newStageClone(
+	src *kargoapi.Stage,
+	namespace string,
+	name string,
+	replacements [][2]string,
+) *kargoapi.Stage {
+	src = src.DeepCopy()
+	stage := &kargoapi.Stage{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kargoapi.GroupVersion.String(),
+			Kind:       "Stage",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        name,
+			Labels:      src.Labels,
+			Annotations: src.Annotations,
+		},
+		Spec: src.Spec,
+	}
+	for _, key := range transientAnnotations {
+		delete(stage.Annotations, key)
+	}
+	if len(stage.Annotations) == 0 {
+		stage.Annotations = nil
+	}
+
+	if len(replacements) == 0 {
+		return stage
+	}
+	replace := func(s *string) {
+		for _, r := range replacements {
+			*s = strings.ReplaceAll(*s, r[0], r[1])
+		}
+	}
+	for i := range stage.Spec.RequestedFreight {
+		req := &stage.Spec.RequestedFreight[i]
+		if req.Origin.Kind == kargoapi.FreightOriginKindWarehouse {
+			replace(&req.Origin.Name)
+		}
+	}
+	if mechs := stage.Spec.PromotionMechanisms; mechs != nil {
+		for i := range mechs.GitRepoUpdates {
+			replace(&mechs.GitRepoUpdates[i].RepoURL)
+		}
+		for i := range mechs.ArgoCDAppUpdates {
+			for j := range mechs.ArgoCDAppUpdates[i].SourceUpdates {
+				replace(&mechs.ArgoCDAppUpdates[i].SourceUpdates[j].RepoURL)
+			}
+		}
+		for i := range mechs.FluxHelmReleaseUpdates {
+			replace(&mechs.FluxHelmReleaseUpdates[i].RepoURL)
+		}
+	}
+	return stage
+}
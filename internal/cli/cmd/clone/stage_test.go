@@ -0,0 +1,283 @@
+package clone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	sigyaml "sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+type fakeKargoServiceClient struct {
+	svcv1alpha1connect.KargoServiceClient
+	stages  map[string]*kargoapi.Stage
+	created []*kargoapi.Stage
+}
+
+func (f *fakeKargoServiceClient) GetStage(
+	_ context.Context,
+	req *connect.Request[v1alpha1.GetStageRequest],
+) (*connect.Response[v1alpha1.GetStageResponse], error) {
+	stage, ok := f.stages[req.Msg.GetProject()+"/"+req.Msg.GetName()]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("not found"))
+	}
+	return connect.NewResponse(&v1alpha1.GetStageResponse{
+		Result: &v1alpha1.GetStageResponse_Stage{Stage: stage},
+	}), nil
+}
+
+func (f *fakeKargoServiceClient) CreateResource(
+	_ context.Context,
+	req *connect.Request[v1alpha1.CreateResourceRequest],
+) (*connect.Response[v1alpha1.CreateResourceResponse], error) {
+	stage := &kargoapi.Stage{}
+	if err := sigyaml.Unmarshal(req.Msg.GetManifest(), stage); err != nil {
+		return nil, err
+	}
+	f.created = append(f.created, stage)
+	return connect.NewResponse(&v1alpha1.CreateResourceResponse{
+		Results: []*v1alpha1.CreateResourceResult{{
+			Result: &v1alpha1.CreateResourceResult_CreatedResourceManifest{
+				CreatedResourceManifest: req.Msg.GetManifest(),
+			},
+		}},
+	}), nil
+}
+
+func TestCloneStage(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "fake-project",
+			Name:            "staging",
+			UID:             "fake-uid",
+			ResourceVersion: "42",
+			Generation:      3,
+			Finalizers:      []string{kargoapi.FinalizerName},
+			Labels: map[string]string{
+				"team": "fake-team",
+			},
+			Annotations: map[string]string{
+				kargoapi.AnnotationKeyDescription: "fake description",
+				kargoapi.AnnotationKeyRefresh:     "fake-token",
+			},
+		},
+		Spec: kargoapi.StageSpec{
+			RequestedFreight: []kargoapi.FreightRequest{{
+				Origin: kargoapi.FreightOrigin{
+					Kind: kargoapi.FreightOriginKindWarehouse,
+					Name: "staging-warehouse",
+				},
+				Sources: kargoapi.FreightSources{Direct: true},
+			}},
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates: []kargoapi.GitRepoUpdate{{
+					RepoURL:     "https://github.com/example/staging.git",
+					WriteBranch: "main",
+				}},
+			},
+		},
+		Status: kargoapi.StageStatus{
+			Phase: kargoapi.StagePhaseSteady,
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		opts          *cloneStageOptions
+		sourceMissing bool
+		stages        map[string]*kargoapi.Stage
+		assertions    func(*testing.T, *fakeKargoServiceClient, *kargoapi.Stage, error)
+	}{
+		{
+			name: "stage to clone not found",
+			opts: &cloneStageOptions{
+				Project:   "fake-project",
+				Name:      "staging",
+				To:        "canary",
+				ToProject: "fake-project",
+			},
+			sourceMissing: true,
+			assertions: func(t *testing.T, c *fakeKargoServiceClient, _ *kargoapi.Stage, err error) {
+				require.ErrorContains(t, err, "get stage")
+				require.Empty(t, c.created)
+			},
+		},
+		{
+			name: "name collision",
+			opts: &cloneStageOptions{
+				Project:   "fake-project",
+				Name:      "staging",
+				To:        "canary",
+				ToProject: "fake-project",
+			},
+			stages: map[string]*kargoapi.Stage{
+				"fake-project/canary": {},
+			},
+			assertions: func(t *testing.T, c *fakeKargoServiceClient, _ *kargoapi.Stage, err error) {
+				require.ErrorContains(
+					t, err, `stage "canary" already exists in project "fake-project"`,
+				)
+				require.Empty(t, c.created)
+			},
+		},
+		{
+			name: "same project",
+			opts: &cloneStageOptions{
+				Project:   "fake-project",
+				Name:      "staging",
+				To:        "canary",
+				ToProject: "fake-project",
+			},
+			assertions: func(t *testing.T, c *fakeKargoServiceClient, stage *kargoapi.Stage, err error) {
+				require.NoError(t, err)
+				require.Len(t, c.created, 1)
+				require.Equal(t, "fake-project", stage.Namespace)
+				require.Equal(t, "canary", stage.Name)
+				// Runtime metadata and status are not copied
+				require.Empty(t, stage.UID)
+				require.Empty(t, stage.ResourceVersion)
+				require.Zero(t, stage.Generation)
+				require.Empty(t, stage.Finalizers)
+				require.Equal(t, kargoapi.StageStatus{}, stage.Status)
+				// Labels and non-transient annotations are copied
+				require.Equal(t, map[string]string{"team": "fake-team"}, stage.Labels)
+				require.Equal(
+					t,
+					map[string]string{kargoapi.AnnotationKeyDescription: "fake description"},
+					stage.Annotations,
+				)
+				require.Equal(t, testStage.Spec, stage.Spec)
+			},
+		},
+		{
+			name: "another project",
+			opts: &cloneStageOptions{
+				Project:   "fake-project",
+				Name:      "staging",
+				To:        "staging",
+				ToProject: "other-project",
+			},
+			assertions: func(t *testing.T, c *fakeKargoServiceClient, stage *kargoapi.Stage, err error) {
+				require.NoError(t, err)
+				require.Len(t, c.created, 1)
+				require.Equal(t, "other-project", stage.Namespace)
+				require.Equal(t, "staging", stage.Name)
+				require.Equal(t, testStage.Spec, stage.Spec)
+			},
+		},
+		{
+			name: "subscription replacements",
+			opts: &cloneStageOptions{
+				Project:   "fake-project",
+				Name:      "staging",
+				To:        "canary",
+				ToProject: "fake-project",
+				replacements: [][2]string{
+					{"staging", "canary"},
+				},
+			},
+			assertions: func(t *testing.T, _ *fakeKargoServiceClient, stage *kargoapi.Stage, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"canary-warehouse",
+					stage.Spec.RequestedFreight[0].Origin.Name,
+				)
+				require.Equal(
+					t,
+					"https://github.com/example/canary.git",
+					stage.Spec.PromotionMechanisms.GitRepoUpdates[0].RepoURL,
+				)
+				// The original should not have been modified
+				require.Equal(
+					t,
+					"staging-warehouse",
+					testStage.Spec.RequestedFreight[0].Origin.Name,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &fakeKargoServiceClient{
+				stages: map[string]*kargoapi.Stage{},
+			}
+			for key, stage := range testCase.stages {
+				c.stages[key] = stage
+			}
+			if !testCase.sourceMissing {
+				c.stages[fmt.Sprintf("%s/%s", testStage.Namespace, testStage.Name)] =
+					testStage.DeepCopy()
+			}
+			stage, err := testCase.opts.cloneStage(context.Background(), c)
+			testCase.assertions(t, c, stage, err)
+		})
+	}
+}
+
+func TestCloneStageOptionsValidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		opts       *cloneStageOptions
+		assertions func(*testing.T, *cloneStageOptions, error)
+	}{
+		{
+			name: "same name in same project",
+			opts: &cloneStageOptions{
+				Project:   "fake-project",
+				Name:      "staging",
+				To:        "staging",
+				ToProject: "fake-project",
+			},
+			assertions: func(t *testing.T, _ *cloneStageOptions, err error) {
+				require.ErrorContains(t, err, "to must differ")
+			},
+		},
+		{
+			name: "invalid replacement",
+			opts: &cloneStageOptions{
+				Project:         "fake-project",
+				Name:            "staging",
+				To:              "canary",
+				ToProject:       "fake-project",
+				SubReplacements: []string{"staging"},
+			},
+			assertions: func(t *testing.T, _ *cloneStageOptions, err error) {
+				require.ErrorContains(t, err, `invalid sub-replacement "staging"`)
+			},
+		},
+		{
+			name: "valid",
+			opts: &cloneStageOptions{
+				Project:         "fake-project",
+				Name:            "staging",
+				To:              "canary",
+				ToProject:       "fake-project",
+				SubReplacements: []string{"staging=canary", "a=b=c"},
+			},
+			assertions: func(t *testing.T, opts *cloneStageOptions, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[][2]string{{"staging", "canary"}, {"a", "b=c"}},
+					opts.replacements,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.opts.validate()
+			testCase.assertions(t, testCase.opts, err)
+		})
+	}
+}
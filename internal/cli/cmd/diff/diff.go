@@ -0,0 +1,402 @@
+package diff
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+const (
+	fromFlag        = "from"
+	toFlag          = "to"
+	outputFlag      = "output"
+	outputShortFlag = "o"
+
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+const (
+	artifactKindCommit = "Commit"
+	artifactKindImage  = "Image"
+	artifactKindChart  = "Chart"
+)
+
+type diffOptions struct {
+	genericiooptions.IOStreams
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project   string
+	From      string
+	To        string
+	Output    string
+	NoHeaders bool
+
+	from stageRef
+	to   stageRef
+}
+
+// stageRef identifies a Stage by project and name.
+type stageRef struct {
+	Project string `json:"project"`
+	Name    string `json:"name"`
+}
+
+func (s stageRef) String() string {
+	return s.Project + "/" + s.Name
+}
+
+// stageDiff describes the differences between the artifacts currently in use
+// by two Stages.
+type stageDiff struct {
+	From        stageRef       `json:"from"`
+	To          stageRef       `json:"to"`
+	Identical   bool           `json:"identical"`
+	Differences []artifactDiff `json:"differences"`
+}
+
+// artifactDiff describes an artifact that is not at the same version in two
+// Stages. From or To is empty if the artifact is not in use by the
+// corresponding Stage at all.
+type artifactDiff struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+}
+
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &diffOptions{
+		Config:    cfg,
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "diff [--project=project] --from=stage[/project] --to=stage[/project] [--output=table|json]",
+		Short: "Show the differences between the artifacts in use by two stages",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Show what is in the prod stage that isn't in the staging stage
+kargo diff --project=my-project --from=staging --to=prod
+
+# Compare stages in different projects
+kargo diff --from=staging/my-project --to=prod/other-project
+
+# Show the differences in JSON output format
+kargo diff --project=my-project --from=staging --to=prod -o json
+`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmdOpts.complete()
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the diff options to the provided command.
+func (o *diffOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project of any stage that does not specify one. If not set, the default project will be used.",
+	)
+	option.NoHeaders(cmd.Flags(), &o.NoHeaders)
+	cmd.Flags().StringVar(
+		&o.From, fromFlag, "",
+		"The stage to compare from, of the form stage or stage/project.",
+	)
+	cmd.Flags().StringVar(
+		&o.To, toFlag, "",
+		"The stage to compare to, of the form stage or stage/project.",
+	)
+	cmd.Flags().StringVarP(
+		&o.Output, outputFlag, outputShortFlag, outputTable,
+		"Output format. One of: table|json.",
+	)
+
+	if err := cmd.MarkFlagRequired(fromFlag); err != nil {
+		panic(fmt.Errorf("could not mark %s flag as required: %w", fromFlag, err))
+	}
+	if err := cmd.MarkFlagRequired(toFlag); err != nil {
+		panic(fmt.Errorf("could not mark %s flag as required: %w", toFlag, err))
+	}
+}
+
+// complete sets the options from the command flags.
+func (o *diffOptions) complete() {
+	o.from = parseStageRef(o.From, o.Project)
+	o.to = parseStageRef(o.To, o.Project)
+	o.Output = strings.TrimSpace(strings.ToLower(o.Output))
+}
+
+// parseStageRef parses a reference of the form stage or stage/project. If the
+// reference does not specify a project, the provided default project is used.
+func parseStageRef(ref, defaultProject string) stageRef {
+	name, project, _ := strings.Cut(strings.TrimSpace(ref), "/")
+	if project == "" {
+		project = defaultProject
+	}
+	return stageRef{
+		Project: strings.TrimSpace(project),
+		Name:    strings.TrimSpace(strings.ToLower(name)),
+	}
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *diffOptions) validate() error {
+	var errs []error
+	for _, f := range []struct {
+		flag string
+		ref  stageRef
+	}{{fromFlag, o.from}, {toFlag, o.to}} {
+		flag, ref := f.flag, f.ref
+		if ref.Name == "" {
+			errs = append(errs, fmt.Errorf("%s is required", flag))
+		}
+		if ref.Project == "" {
+			errs = append(errs, fmt.Errorf(
+				"%s must specify a project when %s is not set", flag, option.ProjectFlag,
+			))
+		}
+	}
+	if o.Output != outputTable && o.Output != outputJSON {
+		errs = append(errs, fmt.Errorf(
+			"invalid %s %q: must be one of %s or %s", outputFlag, o.Output, outputTable, outputJSON,
+		))
+	}
+	return errors.Join(errs...)
+}
+
+// run compares the two stages and prints their differences to the console.
+func (o *diffOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	diff, err := o.diffStages(ctx, kargoSvcCli)
+	if err != nil {
+		return err
+	}
+	return o.print(diff)
+}
+
+// diffStages retrieves both stages and compares the artifacts currently in use
+// by each of them.
+func (o *diffOptions) diffStages(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+) (*stageDiff, error) {
+	from, err := getCurrentArtifacts(ctx, kargoSvcCli, o.from)
+	if err != nil {
+		return nil, err
+	}
+	to, err := getCurrentArtifacts(ctx, kargoSvcCli, o.to)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]artifactKey, 0, len(from)+len(to))
+	for key := range from {
+		keys = append(keys, key)
+	}
+	for key := range to {
+		if _, ok := from[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.SortFunc(keys, func(lhs, rhs artifactKey) int {
+		if c := strings.Compare(lhs.kind, rhs.kind); c != 0 {
+			return c
+		}
+		return strings.Compare(lhs.name, rhs.name)
+	})
+
+	diff := &stageDiff{
+		From:        o.from,
+		To:          o.to,
+		Differences: []artifactDiff{},
+	}
+	for _, key := range keys {
+		fromVersion, toVersion := from[key], to[key]
+		if fromVersion == toVersion {
+			continue
+		}
+		fromDisplay, toDisplay := fromVersion.display(), toVersion.display()
+		if fromDisplay == toDisplay {
+			// The versions only differ in a way that the short form doesn't show,
+			// e.g. an image tag that was pushed again.
+			fromDisplay, toDisplay = fromVersion.String(), toVersion.String()
+		}
+		diff.Differences = append(diff.Differences, artifactDiff{
+			Kind: key.kind,
+			Name: key.name,
+			From: fromDisplay,
+			To:   toDisplay,
+		})
+	}
+	diff.Identical = len(diff.Differences) == 0
+	return diff, nil
+}
+
+// artifactKey identifies an artifact irrespective of its version.
+type artifactKey struct {
+	kind string
+	name string
+}
+
+// artifactVersion identifies a version of an artifact. The short form is
+// preferred for display, while the long form fully identifies the version.
+type artifactVersion struct {
+	short string
+	long  string
+}
+
+func (v artifactVersion) display() string {
+	if v.short != "" {
+		return v.short
+	}
+	return v.long
+}
+
+func (v artifactVersion) String() string {
+	if v.short != "" && v.long != "" && v.short != v.long {
+		return v.short + " (" + v.long + ")"
+	}
+	return v.display()
+}
+
+// getCurrentArtifacts retrieves the referenced stage and returns the versions
+// of all artifacts that are part of its current freight, indexed by artifact.
+func getCurrentArtifacts(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	ref stageRef,
+) (map[artifactKey]artifactVersion, error) {
+	resp, err := kargoSvcCli.GetStage(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.GetStageRequest{
+				Project: ref.Project,
+				Name:    ref.Name,
+			},
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get stage %q: %w", ref, err)
+	}
+	return currentArtifacts(resp.Msg.GetStage()), nil
+}
+
+// currentArtifacts returns the versions of all artifacts that are part of the
+// current freight of the provided stage, indexed by artifact.
+func currentArtifacts(stage *kargoapi.Stage) map[artifactKey]artifactVersion {
+	artifacts := map[artifactKey]artifactVersion{}
+	for _, freight := range stage.Status.FreightHistory.Current().References() {
+		for _, commit := range freight.Commits {
+			artifacts[artifactKey{kind: artifactKindCommit, name: commit.RepoURL}] =
+				artifactVersion{short: commit.Tag, long: commit.ID}
+		}
+		for _, image := range freight.Images {
+			artifacts[artifactKey{kind: artifactKindImage, name: image.RepoURL}] =
+				artifactVersion{short: image.Tag, long: image.Digest}
+		}
+		for _, chart := range freight.Charts {
+			name := chart.RepoURL
+			if chart.Name != "" {
+				name = strings.TrimSuffix(name, "/") + "/" + chart.Name
+			}
+			artifacts[artifactKey{kind: artifactKindChart, name: name}] =
+				artifactVersion{long: chart.Version}
+		}
+	}
+	return artifacts
+}
+
+// print prints the provided diff in the requested output format.
+func (o *diffOptions) print(diff *stageDiff) error {
+	if o.Output == outputJSON {
+		enc := json.NewEncoder(o.IOStreams.Out)
+		enc.SetIndent("", "    ")
+		if err := enc.Encode(diff); err != nil {
+			return fmt.Errorf("encode diff: %w", err)
+		}
+		return nil
+	}
+
+	if diff.Identical {
+		_, _ = fmt.Fprintf(
+			o.IOStreams.Out,
+			"Stages %s and %s are using the same artifacts\n",
+			diff.From,
+			diff.To,
+		)
+		return nil
+	}
+	return printers.
+		NewTablePrinter(
+			printers.PrintOptions{
+				NoHeaders: o.NoHeaders,
+			},
+		).
+		PrintObj(newDiffTable(diff), o.IOStreams.Out)
+}
+
+func newDiffTable(diff *stageDiff) *metav1.Table {
+	rows := make([]metav1.TableRow, len(diff.Differences))
+	for i, d := range diff.Differences {
+		from, to := d.From, d.To
+		if from == "" {
+			from = "<none>"
+		}
+		if to == "" {
+			to = "<none>"
+		}
+		rows[i] = metav1.TableRow{
+			Cells: []any{d.Kind, d.Name, from, to},
+		}
+	}
+	return &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Kind", Type: "string"},
+			{Name: "Name", Type: "string"},
+			{Name: diff.From.String(), Type: "string"},
+			{Name: diff.To.String(), Type: "string"},
+		},
+		Rows: rows,
+	}
+}
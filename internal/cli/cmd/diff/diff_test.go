@@ -0,0 +1,249 @@
+package diff
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+type fakeKargoServiceClient struct {
+	svcv1alpha1connect.KargoServiceClient
+	stages map[string]*kargoapi.Stage
+}
+
+func (f *fakeKargoServiceClient) GetStage(
+	_ context.Context,
+	req *connect.Request[v1alpha1.GetStageRequest],
+) (*connect.Response[v1alpha1.GetStageResponse], error) {
+	stage, ok := f.stages[req.Msg.GetProject()+"/"+req.Msg.GetName()]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("not found"))
+	}
+	return connect.NewResponse(&v1alpha1.GetStageResponse{
+		Result: &v1alpha1.GetStageResponse_Stage{Stage: stage},
+	}), nil
+}
+
+func newTestStage(project, name string, freight ...kargoapi.FreightReference) *kargoapi.Stage {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: project,
+			Name:      name,
+		},
+	}
+	if len(freight) > 0 {
+		col := &kargoapi.FreightCollection{}
+		col.UpdateOrPush(freight...)
+		stage.Status.FreightHistory = kargoapi.FreightHistory{col}
+	}
+	return stage
+}
+
+func TestParseStageRef(t *testing.T) {
+	require.Equal(
+		t,
+		stageRef{Project: "default-project", Name: "staging"},
+		parseStageRef(" Staging ", "default-project"),
+	)
+	require.Equal(
+		t,
+		stageRef{Project: "other-project", Name: "prod"},
+		parseStageRef("prod/other-project", "default-project"),
+	)
+}
+
+func TestDiffStages(t *testing.T) {
+	testWarehouse := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	testOtherWarehouse := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "other-warehouse",
+	}
+	testFreight := kargoapi.FreightReference{
+		Name:   "fake-freight",
+		Origin: testWarehouse,
+		Commits: []kargoapi.GitCommit{{
+			RepoURL: "https://github.com/example/repo.git",
+			ID:      "abc123",
+		}},
+		Images: []kargoapi.Image{{
+			RepoURL: "example/image",
+			Tag:     "v1.0.0",
+			Digest:  "sha256:aaa",
+		}},
+		Charts: []kargoapi.Chart{{
+			RepoURL: "https://charts.example.com",
+			Name:    "fake-chart",
+			Version: "1.0.0",
+		}},
+	}
+	testNewerFreight := kargoapi.FreightReference{
+		Name:   "newer-freight",
+		Origin: testWarehouse,
+		Commits: []kargoapi.GitCommit{{
+			RepoURL: "https://github.com/example/repo.git",
+			ID:      "def456",
+			Tag:     "v1.1.0",
+		}},
+		Images: []kargoapi.Image{{
+			RepoURL: "example/image",
+			Tag:     "v1.0.0",
+			Digest:  "sha256:bbb",
+		}},
+		Charts: []kargoapi.Chart{{
+			RepoURL: "https://charts.example.com",
+			Name:    "fake-chart",
+			Version: "1.1.0",
+		}},
+	}
+	testOtherFreight := kargoapi.FreightReference{
+		Name:   "other-freight",
+		Origin: testOtherWarehouse,
+		Images: []kargoapi.Image{{
+			RepoURL: "example/sidecar",
+			Tag:     "v2.0.0",
+		}},
+	}
+
+	testCases := []struct {
+		name       string
+		stages     []*kargoapi.Stage
+		output     string
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "stage not found",
+			stages: []*kargoapi.Stage{
+				newTestStage("fake-project", "staging", testFreight),
+			},
+			output: outputTable,
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, `get stage "fake-project/prod"`)
+				require.ErrorContains(t, err, "not found")
+			},
+		},
+		{
+			name: "identical stages as a table",
+			stages: []*kargoapi.Stage{
+				newTestStage("fake-project", "staging", testFreight),
+				newTestStage("fake-project", "prod", testFreight),
+			},
+			output: outputTable,
+			assertions: func(t *testing.T, out string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"Stages fake-project/staging and fake-project/prod are using the same artifacts\n",
+					out,
+				)
+			},
+		},
+		{
+			name: "identical stages as JSON",
+			stages: []*kargoapi.Stage{
+				newTestStage("fake-project", "staging", testFreight),
+				newTestStage("fake-project", "prod", testFreight),
+			},
+			output: outputJSON,
+			assertions: func(t *testing.T, out string, err error) {
+				require.NoError(t, err)
+				require.JSONEq(
+					t,
+					`{
+						"from": {"project": "fake-project", "name": "staging"},
+						"to": {"project": "fake-project", "name": "prod"},
+						"identical": true,
+						"differences": []
+					}`,
+					out,
+				)
+			},
+		},
+		{
+			name: "divergent stages as a table",
+			stages: []*kargoapi.Stage{
+				newTestStage("fake-project", "staging", testNewerFreight, testOtherFreight),
+				newTestStage("fake-project", "prod", testFreight),
+			},
+			output: outputTable,
+			assertions: func(t *testing.T, out string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"KIND     NAME                                    FAKE-PROJECT/STAGING   FAKE-PROJECT/PROD\n"+
+						"Chart    https://charts.example.com/fake-chart   1.1.0                  1.0.0\n"+
+						"Commit   https://github.com/example/repo.git     v1.1.0                 abc123\n"+
+						"Image    example/image                           v1.0.0 (sha256:bbb)    v1.0.0 (sha256:aaa)\n"+
+						"Image    example/sidecar                         v2.0.0                 <none>\n",
+					out,
+				)
+			},
+		},
+		{
+			name: "divergent stages as JSON",
+			stages: []*kargoapi.Stage{
+				newTestStage("fake-project", "staging", testFreight),
+				// A stage without any freight
+				newTestStage("other-project", "prod"),
+			},
+			output: outputJSON,
+			assertions: func(t *testing.T, out string, err error) {
+				require.NoError(t, err)
+				require.JSONEq(
+					t,
+					`{
+						"from": {"project": "fake-project", "name": "staging"},
+						"to": {"project": "other-project", "name": "prod"},
+						"identical": false,
+						"differences": [
+							{"kind": "Chart", "name": "https://charts.example.com/fake-chart", "from": "1.0.0"},
+							{"kind": "Commit", "name": "https://github.com/example/repo.git", "from": "abc123"},
+							{"kind": "Image", "name": "example/image", "from": "v1.0.0"}
+						]
+					}`,
+					out,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			cli := &fakeKargoServiceClient{stages: map[string]*kargoapi.Stage{}}
+			for _, stage := range testCase.stages {
+				cli.stages[stage.Namespace+"/"+stage.Name] = stage
+			}
+			out := &bytes.Buffer{}
+			o := &diffOptions{
+				IOStreams: genericiooptions.IOStreams{Out: out},
+				Project:   "fake-project",
+				Output:    testCase.output,
+			}
+			to := "prod"
+			if len(testCase.stages) > 1 {
+				to += "/" + testCase.stages[1].Namespace
+			}
+			o.From = "staging"
+			o.To = to
+			o.complete()
+			require.NoError(t, o.validate())
+
+			diff, err := o.diffStages(context.Background(), cli)
+			if err == nil {
+				err = o.print(diff)
+			}
+			testCase.assertions(t, out.String(), err)
+		})
+	}
+}
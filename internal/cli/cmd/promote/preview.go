@@ -0,0 +1,147 @@
+package promote
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+// previewPath is the path at which the Kargo controller serves promotion
+// previews.
+const previewPath = "/preview"
+
+// promotionPreview is the response of the Kargo controller to a request for
+// a promotion preview.
+type promotionPreview struct {
+	// Files maps each file that would be changed by a promotion to a unified
+	// diff of the change.
+	Files map[string]string `json:"files"`
+}
+
+// runPreview prints the changes promoting the freight to the stage would
+// make to Git repositories.
+func (o *promotionOptions) runPreview(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+) error {
+	freightName := o.FreightName
+	if freightName == "" {
+		res, err := kargoSvcCli.GetFreight(
+			ctx,
+			connect.NewRequest(
+				&v1alpha1.GetFreightRequest{
+					Project: o.Project,
+					Alias:   o.FreightAlias,
+				},
+			),
+		)
+		if err != nil {
+			return fmt.Errorf("get freight: %w", err)
+		}
+		freightName = res.Msg.GetFreight().GetName()
+	}
+
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: o.ClientOptions.InsecureTLS, // nolint: gosec
+			},
+		},
+	}
+	token := o.ControllerToken
+	if token == "" {
+		token = o.Config.BearerToken
+	}
+	preview, err := getPromotionPreview(
+		ctx,
+		httpClient,
+		o.ControllerURL,
+		token,
+		o.Project,
+		o.Stage,
+		freightName,
+	)
+	if err != nil {
+		return fmt.Errorf("preview promotion: %w", err)
+	}
+	return printPromotionPreview(o.IOStreams.Out, preview)
+}
+
+// getPromotionPreview requests a preview of promoting the specified freight to
+// the specified stage from the Kargo controller at the provided URL, which
+// authenticates the request using the provided bearer token.
+func getPromotionPreview(
+	ctx context.Context,
+	httpClient *http.Client,
+	controllerURL string,
+	token string,
+	project string,
+	stage string,
+	freight string,
+) (*promotionPreview, error) {
+	query := url.Values{}
+	query.Set("namespace", project)
+	query.Set("stage", stage)
+	query.Set("freight", freight)
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		strings.TrimSuffix(controllerURL, "/")+previewPath+"?"+query.Encode(),
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("new request: %w", err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf(
+			"unexpected status %d: %s",
+			res.StatusCode,
+			strings.TrimSpace(string(body)),
+		)
+	}
+	preview := &promotionPreview{}
+	if err = json.NewDecoder(res.Body).Decode(preview); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return preview, nil
+}
+
+// printPromotionPreview writes the diff of each file in the provided preview
+// to the provided writer, ordered by file.
+func printPromotionPreview(out io.Writer, preview *promotionPreview) error {
+	if len(preview.Files) == 0 {
+		_, err := fmt.Fprintln(out, "No changes")
+		return err
+	}
+	files := make([]string, 0, len(preview.Files))
+	for file := range preview.Files {
+		files = append(files, file)
+	}
+	slices.Sort(files)
+	for _, file := range files {
+		if _, err := fmt.Fprintf(out, "# %s\n%s", file, preview.Files[file]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
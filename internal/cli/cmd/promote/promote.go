@@ -22,6 +22,12 @@ import (
 	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
 )
 
+const (
+	previewFlag         = "preview"
+	controllerURLFlag   = "controller-url"
+	controllerTokenFlag = "controller-token"
+)
+
 type promotionOptions struct {
 	genericiooptions.IOStreams
 	*genericclioptions.PrintFlags
@@ -29,12 +35,15 @@ type promotionOptions struct {
 	Config        config.CLIConfig
 	ClientOptions client.Options
 
-	Project        string
-	FreightName    string
-	FreightAlias   string
-	Stage          string
-	DownstreamFrom string
-	Wait           bool
+	Project         string
+	FreightName     string
+	FreightAlias    string
+	Stage           string
+	DownstreamFrom  string
+	Wait            bool
+	Preview         bool
+	ControllerURL   string
+	ControllerToken string
 }
 
 func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
@@ -46,7 +55,7 @@ func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra
 
 	cmd := &cobra.Command{
 		Use: "promote [--project=project] (--freight=freight | --freight-alias=alias) " +
-			"(--stage=stage | --downstream-from=stage)",
+			"(--stage=stage | --downstream-from=stage) [--preview --controller-url=url [--controller-token=token]]",
 		Short: "Promote a piece of freight",
 		Args:  option.NoArgs,
 		// nolint: lll
@@ -78,6 +87,10 @@ kargo promote --freight=abc123 --downstream-from=qa
 # Promote a piece of freight specified by alias to stages immediately downstream from of the QA stage in the default project
 kargo config set-project my-project
 kargo promote --freight-alias=wonky-wombat --downstream-from=qas
+
+# Show the changes promoting a piece of freight to the QA stage would make to Git repositories, without promoting it
+kargo promote --project=my-project --freight=abc123 --stage=qa --preview --controller-url=http://localhost:8081 \
+  --controller-token=$(kubectl create token my-service-account)
 `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := cmdOpts.validate(); err != nil {
@@ -123,12 +136,33 @@ func (o *promotionOptions) addFlags(cmd *cobra.Command) {
 		),
 	)
 	option.Wait(cmd.Flags(), &o.Wait, false, "Wait for the promotion(s) to complete.")
+	cmd.Flags().BoolVar(
+		&o.Preview, previewFlag, false,
+		"Show the changes the promotion would make to Git repositories instead of promoting the freight.",
+	)
+	cmd.Flags().StringVar(
+		&o.ControllerURL, controllerURLFlag, "",
+		fmt.Sprintf(
+			"The URL of the HTTP API of the Kargo controller, which serves promotion previews. "+
+				"Required if --%s is set.",
+			previewFlag,
+		),
+	)
+	cmd.Flags().StringVar(
+		&o.ControllerToken, controllerTokenFlag, "",
+		"The Kubernetes bearer token with which to authenticate to the HTTP API of the Kargo controller. "+
+			"Defaults to the token used to authenticate to the Kargo API server.",
+	)
 
 	cmd.MarkFlagsOneRequired(option.FreightFlag, option.FreightAliasFlag)
 	cmd.MarkFlagsMutuallyExclusive(option.FreightFlag, option.FreightAliasFlag)
 
 	cmd.MarkFlagsOneRequired(option.StageFlag, option.DownstreamFromFlag)
 	cmd.MarkFlagsMutuallyExclusive(option.StageFlag, option.DownstreamFromFlag)
+
+	cmd.MarkFlagsMutuallyExclusive(previewFlag, option.DownstreamFromFlag)
+	cmd.MarkFlagsMutuallyExclusive(previewFlag, option.WaitFlag)
+	cmd.MarkFlagsRequiredTogether(previewFlag, controllerURLFlag)
 }
 
 // validate performs validation of the options. If the options are invalid, an
@@ -152,6 +186,14 @@ func (o *promotionOptions) validate() error {
 			fmt.Errorf("either %s or %s is required", option.StageFlag, option.DownstreamFromFlag),
 		)
 	}
+	if o.Preview {
+		if o.Stage == "" {
+			errs = append(errs, fmt.Errorf("%s is required by %s", option.StageFlag, previewFlag))
+		}
+		if o.ControllerURL == "" {
+			errs = append(errs, fmt.Errorf("%s is required by %s", controllerURLFlag, previewFlag))
+		}
+	}
 	return errors.Join(errs...)
 }
 
@@ -162,6 +204,10 @@ func (o *promotionOptions) run(ctx context.Context) error {
 		return fmt.Errorf("get client from config: %w", err)
 	}
 
+	if o.Preview {
+		return o.runPreview(ctx, kargoSvcCli)
+	}
+
 	printer, err := o.PrintFlags.ToPrinter()
 	if err != nil {
 		return fmt.Errorf("new printer: %w", err)
@@ -0,0 +1,137 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/kubernetes"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	"github.com/akuity/kargo/internal/validation"
+)
+
+type validateOptions struct {
+	genericiooptions.IOStreams
+
+	Filenames []string
+	Recursive bool
+}
+
+func NewCommand(streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &validateOptions{
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "validate -f FILENAME",
+		Short: "Validate resources from a file or from stdin without a cluster",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Validate a stage using the data in stage.yaml
+kargo validate -f stage.yaml
+
+# Validate the YAML resources in the stages directory
+kargo validate -f stages/
+`),
+		RunE: func(*cobra.Command, []string) error {
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run()
+		},
+	}
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	return cmd
+}
+
+// addFlags adds the flags for the validate options to the provided command.
+func (o *validateOptions) addFlags(cmd *cobra.Command) {
+	option.Filenames(cmd.Flags(), &o.Filenames, "Filename or directory to use to validate the resource(s)")
+	option.Recursive(cmd.Flags(), &o.Recursive)
+
+	if err := cmd.MarkFlagRequired(option.FilenameFlag); err != nil {
+		panic(fmt.Errorf("could not mark filename flag as required: %w", err))
+	}
+	if err := cmd.MarkFlagFilename(option.FilenameFlag, ".yaml", ".yml"); err != nil {
+		panic(fmt.Errorf("could not mark filename flag as filename: %w", err))
+	}
+	if err := cmd.MarkFlagDirname(option.FilenameFlag); err != nil {
+		panic(fmt.Errorf("could not mark filename flag as dirname: %w", err))
+	}
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *validateOptions) validate() error {
+	// While the filename flag is marked as required, a user could still
+	// provide an empty string. This is a check to ensure that the flag is
+	// not empty.
+	if len(o.Filenames) == 0 {
+		return errors.New("filename is required")
+	}
+	return nil
+}
+
+// run validates the resources read from the files using the provided options.
+// Errors are reported for every invalid resource, after which an error is
+// returned so that the command exits with a non-zero status.
+func (o *validateOptions) run() error {
+	objs, err := option.ReadObjects(o.Recursive, o.Filenames...)
+	if err != nil {
+		return fmt.Errorf("read manifests: %w", err)
+	}
+
+	var invalid int
+	for _, u := range objs {
+		ref := fmt.Sprintf("%s/%s", strings.ToLower(u.GetKind()), u.GetName())
+		if u.GroupVersionKind().Group != kargoapi.GroupVersion.Group {
+			_, _ = fmt.Fprintf(o.IOStreams.Out, "%s skipped: not a Kargo resource\n", ref)
+			continue
+		}
+		if err = validateObject(u); err != nil {
+			invalid++
+			_, _ = fmt.Fprintf(o.IOStreams.ErrOut, "%s is invalid: %s\n", ref, err)
+			continue
+		}
+		_, _ = fmt.Fprintf(o.IOStreams.Out, "%s is valid\n", ref)
+	}
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d resource(s) are invalid", invalid, len(objs))
+	}
+	return nil
+}
+
+// validateObject converts the provided Kargo resource to the type of its kind
+// and validates it. Fields that are unknown to the type are reported as errors.
+func validateObject(u *unstructured.Unstructured) error {
+	obj, err := kubernetes.GetScheme().New(u.GroupVersionKind())
+	if err != nil {
+		if runtime.IsNotRegisteredError(err) {
+			return fmt.Errorf("unknown kind %q", u.GroupVersionKind().String())
+		}
+		return err
+	}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(
+		u.Object,
+		obj,
+		true,
+	); err != nil {
+		return err
+	}
+	return validation.Validate(obj)
+}
@@ -0,0 +1,83 @@
+package validate
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+)
+
+func TestRunValid(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "valid", "*.yaml"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			out := &bytes.Buffer{}
+			errOut := &bytes.Buffer{}
+			o := &validateOptions{
+				IOStreams: genericiooptions.IOStreams{Out: out, ErrOut: errOut},
+				Filenames: []string{file},
+			}
+			require.NoError(t, o.run())
+			require.Contains(t, out.String(), "is valid")
+			require.Empty(t, errOut.String())
+		})
+	}
+}
+
+func TestRunInvalid(t *testing.T) {
+	testCases := map[string][]string{
+		"project-duplicate-policies.yaml": {
+			`project/kargo-demo is invalid`,
+			"multiple spec.promotionPolicies reference stage test",
+		},
+		"stage-duplicate-origin.yaml": {
+			`stage/test is invalid`,
+			"freight with origin Warehouse/my-warehouse requested multiple times " +
+				"in spec.requestedFreight",
+		},
+		"stage-invalid-author-email.yaml": {
+			`stage/test is invalid`,
+			"spec.promotionMechanisms.gitRepoUpdates[0].authorEmail",
+			"must be a valid email address",
+		},
+		"stage-no-mechanisms.yaml": {
+			`stage/test is invalid`,
+			"at least one of spec.promotionMechanisms.gitRepoUpdates",
+		},
+		"stage-unknown-field.yaml": {
+			`stage/test is invalid`,
+			`unknown field "spec.promotionMechanism"`,
+		},
+		"warehouse-duplicate-subscription.yaml": {
+			`warehouse/my-warehouse is invalid`,
+			"subscription for Git repository already exists",
+		},
+		"warehouse-invalid-semver.yaml": {
+			`warehouse/my-warehouse is invalid`,
+			"spec.subscriptions[0].image.semverConstraint",
+		},
+	}
+	// Every invalid spec file must have expectations.
+	files, err := os.ReadDir(filepath.Join("testdata", "invalid"))
+	require.NoError(t, err)
+	require.Len(t, files, len(testCases))
+	for file, expected := range testCases {
+		t.Run(file, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			errOut := &bytes.Buffer{}
+			o := &validateOptions{
+				IOStreams: genericiooptions.IOStreams{Out: out, ErrOut: errOut},
+				Filenames: []string{filepath.Join("testdata", "invalid", file)},
+			}
+			require.ErrorContains(t, o.run(), "1 of 1 resource(s) are invalid")
+			for _, e := range expected {
+				require.Contains(t, errOut.String(), e)
+			}
+		})
+	}
+}
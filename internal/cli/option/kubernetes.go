@@ -15,6 +15,29 @@ import (
 //
 // WARNING: This function should not be used with untrusted input!
 func ReadManifests(recursive bool, filenames ...string) ([]byte, error) {
+	objs, err := ReadObjects(recursive, filenames...)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	defer func() {
+		_ = enc.Close()
+	}()
+	for _, u := range objs {
+		if err := enc.Encode(&u.Object); err != nil {
+			return nil, fmt.Errorf("encode object: %w", err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// ReadObjects reads Kubernetes objects from local files or remote files via
+// HTTP/S.
+//
+// WARNING: This function should not be used with untrusted input!
+func ReadObjects(recursive bool, filenames ...string) ([]*unstructured.Unstructured, error) {
 	buildRes, err := resource.NewBuilder(&genericclioptions.ConfigFlags{}).
 		Local().
 		Unstructured().
@@ -29,19 +52,13 @@ func ReadManifests(recursive bool, filenames ...string) ([]byte, error) {
 		return nil, fmt.Errorf("build resources: %w", err)
 	}
 
-	var buf bytes.Buffer
-	enc := yaml.NewEncoder(&buf)
-	defer func() {
-		_ = enc.Close()
-	}()
+	objs := make([]*unstructured.Unstructured, 0, len(buildRes))
 	for _, info := range buildRes {
 		u, ok := info.Object.(*unstructured.Unstructured)
 		if !ok {
 			return nil, fmt.Errorf("expected *unstructured.Unstructured, got %T", info.Object)
 		}
-		if err := enc.Encode(&u.Object); err != nil {
-			return nil, fmt.Errorf("encode object: %w", err)
-		}
+		objs = append(objs, u)
 	}
-	return buf.Bytes(), nil
+	return objs, nil
 }
@@ -37,3 +37,34 @@ func GetApplication(
 	}
 	return &app, nil
 }
+
+// GetApplicationSet returns a pointer to the Argo CD ApplicationSet resource
+// specified by the namespace and name arguments. If no such resource is found,
+// nil is returned instead.
+func GetApplicationSet(
+	ctx context.Context,
+	ctrlRuntimeClient client.Client,
+	namespace string,
+	name string,
+) (*ApplicationSet, error) {
+	appSet := ApplicationSet{}
+	if err := ctrlRuntimeClient.Get(
+		ctx,
+		client.ObjectKey{
+			Namespace: namespace,
+			Name:      name,
+		},
+		&appSet,
+	); err != nil {
+		if err = client.IgnoreNotFound(err); err == nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf(
+			"error getting Argo CD ApplicationSet %q in namespace %q: %w",
+			name,
+			namespace,
+			err,
+		)
+	}
+	return &appSet, nil
+}
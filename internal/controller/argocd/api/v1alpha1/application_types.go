@@ -140,7 +140,8 @@ type ApplicationList struct {
 type SyncStatusCode string
 
 const (
-	SyncStatusCodeSynced SyncStatusCode = "Synced"
+	SyncStatusCodeSynced    SyncStatusCode = "Synced"
+	SyncStatusCodeOutOfSync SyncStatusCode = "OutOfSync"
 )
 
 type SyncStatus struct {
@@ -172,6 +173,7 @@ type OperationState struct {
 	Phase      OperationPhase       `json:"phase,omitempty"`
 	Message    string               `json:"message,omitempty"`
 	SyncResult *SyncOperationResult `json:"syncResult,omitempty"`
+	StartedAt  metav1.Time          `json:"startedAt"`
 	FinishedAt *metav1.Time         `json:"finishedAt,omitempty"`
 }
 
@@ -0,0 +1,29 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//+kubebuilder:object:root=true
+
+type ApplicationSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              ApplicationSetSpec `json:"spec"`
+}
+
+type ApplicationSetSpec struct {
+	Template ApplicationSetTemplate `json:"template"`
+}
+
+type ApplicationSetTemplate struct {
+	Spec ApplicationSpec `json:"spec"`
+}
+
+//+kubebuilder:object:root=true
+
+type ApplicationSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []ApplicationSet `json:"items"`
+}
@@ -0,0 +1,153 @@
+package freight
+
+import (
+	"sort"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// CollectionDiff describes the differences between two FreightCollections.
+type CollectionDiff struct {
+	// AddedCommits are the commits found only in the newer FreightCollection.
+	AddedCommits []kargoapi.GitCommit `json:"addedCommits,omitempty"`
+	// RemovedCommits are the commits found only in the older
+	// FreightCollection.
+	RemovedCommits []kargoapi.GitCommit `json:"removedCommits,omitempty"`
+	// ChangedImages are the images whose tag differs between the two
+	// FreightCollections.
+	ChangedImages []ImageChange `json:"changedImages,omitempty"`
+	// ChangedCharts are the charts whose version differs between the two
+	// FreightCollections.
+	ChangedCharts []ChartChange `json:"changedCharts,omitempty"`
+}
+
+// ImageChange describes a change to the tag of an image. An empty OldTag
+// indicates the image was added. An empty NewTag indicates it was removed.
+type ImageChange struct {
+	// RepoURL is the URL of the image repository.
+	RepoURL string `json:"repoURL"`
+	// OldTag is the tag of the image in the older FreightCollection.
+	OldTag string `json:"oldTag,omitempty"`
+	// NewTag is the tag of the image in the newer FreightCollection.
+	NewTag string `json:"newTag,omitempty"`
+}
+
+// ChartChange describes a change to the version of a chart. An empty
+// OldVersion indicates the chart was added. An empty NewVersion indicates it
+// was removed.
+type ChartChange struct {
+	// RepoURL is the URL of the chart repository.
+	RepoURL string `json:"repoURL"`
+	// Name is the name of the chart. It is empty for charts stored in OCI
+	// registries.
+	Name string `json:"name,omitempty"`
+	// OldVersion is the version of the chart in the older FreightCollection.
+	OldVersion string `json:"oldVersion,omitempty"`
+	// NewVersion is the version of the chart in the newer FreightCollection.
+	NewVersion string `json:"newVersion,omitempty"`
+}
+
+// DiffCollections returns the differences between the artifacts referenced by
+// the FreightCollection from and those referenced by the FreightCollection to.
+// Either FreightCollection may be nil, in which case it is treated as empty.
+// All slices in the returned CollectionDiff are sorted for stable output.
+func DiffCollections(from, to *kargoapi.FreightCollection) CollectionDiff {
+	fromCommits, fromImages, fromCharts := collectArtifacts(from)
+	toCommits, toImages, toCharts := collectArtifacts(to)
+
+	var diff CollectionDiff
+	for key, commit := range toCommits {
+		if _, ok := fromCommits[key]; !ok {
+			diff.AddedCommits = append(diff.AddedCommits, commit)
+		}
+	}
+	for key, commit := range fromCommits {
+		if _, ok := toCommits[key]; !ok {
+			diff.RemovedCommits = append(diff.RemovedCommits, commit)
+		}
+	}
+	sortCommits(diff.AddedCommits)
+	sortCommits(diff.RemovedCommits)
+
+	for repoURL, oldTag := range fromImages {
+		if newTag := toImages[repoURL]; newTag != oldTag {
+			diff.ChangedImages = append(diff.ChangedImages, ImageChange{
+				RepoURL: repoURL,
+				OldTag:  oldTag,
+				NewTag:  newTag,
+			})
+		}
+	}
+	for repoURL, newTag := range toImages {
+		if _, ok := fromImages[repoURL]; !ok {
+			diff.ChangedImages = append(diff.ChangedImages, ImageChange{
+				RepoURL: repoURL,
+				NewTag:  newTag,
+			})
+		}
+	}
+	sort.Slice(diff.ChangedImages, func(i, j int) bool {
+		return diff.ChangedImages[i].RepoURL < diff.ChangedImages[j].RepoURL
+	})
+
+	for key, oldChart := range fromCharts {
+		if newChart := toCharts[key]; newChart.Version != oldChart.Version {
+			diff.ChangedCharts = append(diff.ChangedCharts, ChartChange{
+				RepoURL:    oldChart.RepoURL,
+				Name:       oldChart.Name,
+				OldVersion: oldChart.Version,
+				NewVersion: newChart.Version,
+			})
+		}
+	}
+	for key, newChart := range toCharts {
+		if _, ok := fromCharts[key]; !ok {
+			diff.ChangedCharts = append(diff.ChangedCharts, ChartChange{
+				RepoURL:    newChart.RepoURL,
+				Name:       newChart.Name,
+				NewVersion: newChart.Version,
+			})
+		}
+	}
+	sort.Slice(diff.ChangedCharts, func(i, j int) bool {
+		if diff.ChangedCharts[i].RepoURL != diff.ChangedCharts[j].RepoURL {
+			return diff.ChangedCharts[i].RepoURL < diff.ChangedCharts[j].RepoURL
+		}
+		return diff.ChangedCharts[i].Name < diff.ChangedCharts[j].Name
+	})
+
+	return diff
+}
+
+// collectArtifacts indexes the artifacts referenced by the provided
+// FreightCollection. Commits are indexed by repository URL and commit ID,
+// image tags by repository URL, and charts by repository URL and name.
+func collectArtifacts(
+	col *kargoapi.FreightCollection,
+) (map[string]kargoapi.GitCommit, map[string]string, map[string]kargoapi.Chart) {
+	commits := map[string]kargoapi.GitCommit{}
+	images := map[string]string{}
+	charts := map[string]kargoapi.Chart{}
+	for _, ref := range col.References() {
+		for _, commit := range ref.Commits {
+			commits[commit.RepoURL+"@"+commit.ID] = commit
+		}
+		for _, image := range ref.Images {
+			images[image.RepoURL] = image.Tag
+		}
+		for _, chart := range ref.Charts {
+			charts[chart.RepoURL+"/"+chart.Name] = chart
+		}
+	}
+	return commits, images, charts
+}
+
+// sortCommits sorts the provided commits by repository URL and commit ID.
+func sortCommits(commits []kargoapi.GitCommit) {
+	sort.Slice(commits, func(i, j int) bool {
+		if commits[i].RepoURL != commits[j].RepoURL {
+			return commits[i].RepoURL < commits[j].RepoURL
+		}
+		return commits[i].ID < commits[j].ID
+	})
+}
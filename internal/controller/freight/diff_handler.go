@@ -0,0 +1,121 @@
+package freight
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	authzv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/httpapi"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// DiffHandlerPath is the path at which the handler returned by NewDiffHandler
+// is expected to be served.
+const DiffHandlerPath = "/diff"
+
+// NewDiffHandler returns an http.Handler that responds to GET requests with
+// the CollectionDiff between two FreightCollections from the FreightHistory
+// of a Stage. The Stage is identified by the namespace and stage query
+// parameters and the FreightCollections by their IDs, given by the from and
+// to query parameters. Requests are authorized using the provided
+// httpapi.Authorizer and the user they are made on behalf of must be permitted
+// to get the Stage.
+func NewDiffHandler(c client.Client, authorizer httpapi.Authorizer) http.Handler {
+	return &diffHandler{
+		client:     c,
+		authorizer: authorizer,
+	}
+}
+
+type diffHandler struct {
+	client     client.Client
+	authorizer httpapi.Authorizer
+}
+
+func (d *diffHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	params := map[string]string{}
+	for _, key := range []string{"namespace", "stage", "from", "to"} {
+		if params[key] = query.Get(key); params[key] == "" {
+			http.Error(
+				w,
+				fmt.Sprintf("query parameter %q is required", key),
+				http.StatusBadRequest,
+			)
+			return
+		}
+	}
+
+	if !d.authorizer.Authorize(
+		w,
+		r,
+		authzv1.ResourceAttributes{
+			Group:     kargoapi.GroupVersion.Group,
+			Resource:  "stages",
+			Name:      params["stage"],
+			Verb:      "get",
+			Namespace: params["namespace"],
+		},
+	) {
+		return
+	}
+
+	ctx := r.Context()
+	stage, err := kargoapi.GetStage(
+		ctx,
+		d.client,
+		types.NamespacedName{
+			Namespace: params["namespace"],
+			Name:      params["stage"],
+		},
+	)
+	if err != nil {
+		logging.LoggerFromContext(ctx).Error(err, "error getting Stage")
+		http.Error(w, "error getting Stage", http.StatusInternalServerError)
+		return
+	}
+	if stage == nil {
+		http.Error(
+			w,
+			fmt.Sprintf(
+				"Stage %q not found in namespace %q",
+				params["stage"], params["namespace"],
+			),
+			http.StatusNotFound,
+		)
+		return
+	}
+
+	cols := make([]*kargoapi.FreightCollection, 0, 2)
+	for _, id := range []string{params["from"], params["to"]} {
+		col, _ := stage.Status.FreightHistory.FindByID(id)
+		if col == nil {
+			http.Error(
+				w,
+				fmt.Sprintf(
+					"Freight collection %q not found in the history of Stage %q",
+					id, stage.Name,
+				),
+				http.StatusNotFound,
+			)
+			return
+		}
+		cols = append(cols, col)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(w).Encode(DiffCollections(cols[0], cols[1])); err != nil {
+		logging.LoggerFromContext(ctx).Error(err, "error encoding Freight diff")
+	}
+}
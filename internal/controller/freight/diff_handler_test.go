@@ -0,0 +1,154 @@
+package freight
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	authzv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/httpapi"
+)
+
+func TestDiffHandler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	testOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	oldCol := &kargoapi.FreightCollection{}
+	oldCol.UpdateOrPush(kargoapi.FreightReference{
+		Name:   "old-freight",
+		Origin: testOrigin,
+		Images: []kargoapi.Image{{RepoURL: "example/image", Tag: "v1.0.0"}},
+	})
+	newCol := &kargoapi.FreightCollection{}
+	newCol.UpdateOrPush(kargoapi.FreightReference{
+		Name:   "new-freight",
+		Origin: testOrigin,
+		Images: []kargoapi.Image{{RepoURL: "example/image", Tag: "v1.1.0"}},
+	})
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+		Status: kargoapi.StageStatus{
+			FreightHistory: kargoapi.FreightHistory{newCol, oldCol},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		method       string
+		query        string
+		unauthorized bool
+		assertions   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "method not allowed",
+			method: http.MethodPost,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+			},
+		},
+		{
+			name:   "missing query parameter",
+			method: http.MethodGet,
+			query:  "namespace=fake-namespace&stage=fake-stage&from=" + oldCol.ID,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, rr.Code)
+				require.Contains(t, rr.Body.String(), `"to" is required`)
+			},
+		},
+		{
+			name:         "request not authorized",
+			method:       http.MethodGet,
+			query:        "namespace=fake-namespace&stage=fake-stage&from=a&to=b",
+			unauthorized: true,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, rr.Code)
+			},
+		},
+		{
+			name:   "Stage not found",
+			method: http.MethodGet,
+			query:  "namespace=fake-namespace&stage=other-stage&from=a&to=b",
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, rr.Code)
+				require.Contains(t, rr.Body.String(), "Stage")
+			},
+		},
+		{
+			name:   "Freight collection not found",
+			method: http.MethodGet,
+			query:  "namespace=fake-namespace&stage=fake-stage&from=" + oldCol.ID + "&to=unknown",
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, rr.Code)
+				require.Contains(t, rr.Body.String(), `"unknown" not found`)
+			},
+		},
+		{
+			name:   "success",
+			method: http.MethodGet,
+			query: "namespace=fake-namespace&stage=fake-stage&from=" + oldCol.ID +
+				"&to=" + newCol.ID,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, rr.Code)
+				require.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+				var diff CollectionDiff
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &diff))
+				require.Equal(
+					t,
+					CollectionDiff{
+						ChangedImages: []ImageChange{{
+							RepoURL: "example/image",
+							OldTag:  "v1.0.0",
+							NewTag:  "v1.1.0",
+						}},
+					},
+					diff,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			handler := NewDiffHandler(
+				fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testStage).
+					Build(),
+				&httpapi.FakeAuthorizer{
+					AuthorizeFn: func(
+						w http.ResponseWriter,
+						r *http.Request,
+						attrs authzv1.ResourceAttributes,
+					) bool {
+						require.Equal(t, "stages", attrs.Resource)
+						require.Equal(t, "get", attrs.Verb)
+						require.Equal(t, r.URL.Query().Get("namespace"), attrs.Namespace)
+						require.Equal(t, r.URL.Query().Get("stage"), attrs.Name)
+						if testCase.unauthorized {
+							http.Error(w, "forbidden", http.StatusForbidden)
+							return false
+						}
+						return true
+					},
+				},
+			)
+			req := httptest.NewRequest(testCase.method, DiffHandlerPath+"?"+testCase.query, nil)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			testCase.assertions(t, rr)
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package freight
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestDiffCollections(t *testing.T) {
+	testOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	newCollection := func(ref kargoapi.FreightReference) *kargoapi.FreightCollection {
+		ref.Origin = testOrigin
+		col := &kargoapi.FreightCollection{}
+		col.UpdateOrPush(ref)
+		return col
+	}
+	testCollection := newCollection(kargoapi.FreightReference{
+		Name: "fake-freight",
+		Commits: []kargoapi.GitCommit{{
+			RepoURL: "https://github.com/example/repo",
+			ID:      "abc",
+		}},
+		Images: []kargoapi.Image{{
+			RepoURL: "example/image",
+			Tag:     "v1.0.0",
+		}},
+		Charts: []kargoapi.Chart{{
+			RepoURL: "https://example.com/charts",
+			Name:    "fake-chart",
+			Version: "1.0.0",
+		}},
+	})
+
+	testCases := []struct {
+		name     string
+		from     *kargoapi.FreightCollection
+		to       *kargoapi.FreightCollection
+		expected CollectionDiff
+	}{
+		{
+			name: "identical collections",
+			from: testCollection,
+			to:   testCollection.DeepCopy(),
+		},
+		{
+			name: "nil collections",
+		},
+		{
+			name: "single image tag changed",
+			from: testCollection,
+			to: newCollection(kargoapi.FreightReference{
+				Name: "other-freight",
+				Commits: []kargoapi.GitCommit{{
+					RepoURL: "https://github.com/example/repo",
+					ID:      "abc",
+				}},
+				Images: []kargoapi.Image{{
+					RepoURL: "example/image",
+					Tag:     "v1.1.0",
+				}},
+				Charts: []kargoapi.Chart{{
+					RepoURL: "https://example.com/charts",
+					Name:    "fake-chart",
+					Version: "1.0.0",
+				}},
+			}),
+			expected: CollectionDiff{
+				ChangedImages: []ImageChange{{
+					RepoURL: "example/image",
+					OldTag:  "v1.0.0",
+					NewTag:  "v1.1.0",
+				}},
+			},
+		},
+		{
+			name: "disjoint collections",
+			from: testCollection,
+			to: newCollection(kargoapi.FreightReference{
+				Name: "other-freight",
+				Commits: []kargoapi.GitCommit{{
+					RepoURL: "https://github.com/example/other-repo",
+					ID:      "xyz",
+				}},
+				Images: []kargoapi.Image{{
+					RepoURL: "example/other-image",
+					Tag:     "v2.0.0",
+				}},
+				Charts: []kargoapi.Chart{{
+					RepoURL: "https://example.com/charts",
+					Name:    "other-chart",
+					Version: "2.0.0",
+				}},
+			}),
+			expected: CollectionDiff{
+				AddedCommits: []kargoapi.GitCommit{{
+					RepoURL: "https://github.com/example/other-repo",
+					ID:      "xyz",
+				}},
+				RemovedCommits: []kargoapi.GitCommit{{
+					RepoURL: "https://github.com/example/repo",
+					ID:      "abc",
+				}},
+				ChangedImages: []ImageChange{
+					{
+						RepoURL: "example/image",
+						OldTag:  "v1.0.0",
+					},
+					{
+						RepoURL: "example/other-image",
+						NewTag:  "v2.0.0",
+					},
+				},
+				ChangedCharts: []ChartChange{
+					{
+						RepoURL:    "https://example.com/charts",
+						Name:       "fake-chart",
+						OldVersion: "1.0.0",
+					},
+					{
+						RepoURL:    "https://example.com/charts",
+						Name:       "other-chart",
+						NewVersion: "2.0.0",
+					},
+				},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				DiffCollections(testCase.from, testCase.to),
+			)
+		})
+	}
+}
@@ -41,13 +41,20 @@ func getDesiredOriginInternal(
 		subMechs = []any{m.Spec.PromotionMechanisms}
 	case *kargoapi.PromotionMechanisms:
 		origin = m.Origin
-		subMechs = make([]any, len(m.GitRepoUpdates)+len(m.ArgoCDAppUpdates))
+		subMechs = make(
+			[]any,
+			len(m.GitRepoUpdates)+len(m.ArgoCDAppUpdates)+len(m.FluxHelmReleaseUpdates),
+		)
 		for i := range m.GitRepoUpdates {
 			subMechs[i] = &m.GitRepoUpdates[i]
 		}
 		for i := range m.ArgoCDAppUpdates {
 			subMechs[i+len(m.GitRepoUpdates)] = &m.ArgoCDAppUpdates[i]
 		}
+		for i := range m.FluxHelmReleaseUpdates {
+			subMechs[i+len(m.GitRepoUpdates)+len(m.ArgoCDAppUpdates)] =
+				&m.FluxHelmReleaseUpdates[i]
+		}
 	// Begin git-based
 	case *kargoapi.GitRepoUpdate:
 		origin = m.Origin
@@ -119,6 +126,10 @@ func getDesiredOriginInternal(
 		origin = m.Origin
 		// End Helm-based
 		// End ArgoCD-based
+	// Begin Flux-based
+	case *kargoapi.FluxHelmReleaseUpdate:
+		origin = m.Origin
+		// End Flux-based
 	}
 	if origin == nil {
 		origin = defaultOrigin
@@ -319,6 +319,27 @@ func TestGetDesiredOrigin(t *testing.T) {
 				return m, &m.Images[0]
 			},
 		},
+		{
+			name: "FluxHelmReleaseUpdate can inherit from PromotionMechanisms",
+			setup: func() (any, any) {
+				m := &kargoapi.PromotionMechanisms{
+					Origin:                 testOrigin,
+					FluxHelmReleaseUpdates: []kargoapi.FluxHelmReleaseUpdate{{}},
+				}
+				return m, &m.FluxHelmReleaseUpdates[0]
+			},
+		},
+		{
+			name: "FluxHelmReleaseUpdate can override PromotionMechanisms",
+			setup: func() (any, any) {
+				m := &kargoapi.PromotionMechanisms{
+					FluxHelmReleaseUpdates: []kargoapi.FluxHelmReleaseUpdate{{
+						Origin: testOrigin,
+					}},
+				}
+				return m, &m.FluxHelmReleaseUpdates[0]
+			},
+		},
 		{
 			name: "transitive inheritance",
 			setup: func() (any, any) {
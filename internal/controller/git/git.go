@@ -3,6 +3,8 @@ package git
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"net/url"
@@ -12,6 +14,8 @@ import (
 	"strings"
 	"time"
 
+	"golang.org/x/crypto/ssh"
+
 	libExec "github.com/akuity/kargo/internal/exec"
 )
 
@@ -21,6 +25,13 @@ type RepoCredentials struct {
 	// SSHPrivateKey is a private key that can be used for both reading from and
 	// writing to some remote repository.
 	SSHPrivateKey string `json:"sshPrivateKey,omitempty"`
+	// SSHPrivateKeyPassphrase is the passphrase protecting SSHPrivateKey, if
+	// any.
+	SSHPrivateKeyPassphrase string `json:"sshPrivateKeyPassphrase,omitempty"`
+	// SSHKnownHosts, if specified, is the content of a known_hosts file against
+	// which the remote repository's host key is verified when authenticating
+	// with SSHPrivateKey. If not specified, host key verification is skipped.
+	SSHKnownHosts string `json:"sshKnownHosts,omitempty"`
 	// Username identifies a principal, which combined with the value of the
 	// Password field, can be used for both reading from and writing to some
 	// remote repository.
@@ -48,6 +59,9 @@ type User struct {
 	// SigningKeyPath is an optional path referencing a signing key for
 	// signing git objects.
 	SigningKeyPath string
+	// SigningKey is an optional ASCII-armored signing key for signing git
+	// objects. When specified, it takes precedence over SigningKeyPath.
+	SigningKey string
 }
 
 // CommitOptions represents options for committing changes to a git repository.
@@ -56,6 +70,39 @@ type CommitOptions struct {
 	AllowEmpty bool
 }
 
+// RebaseConflictStrategy represents a strategy for resolving conflicts that
+// arise when rebasing local commits onto commits that were pushed to the
+// remote branch in the interim.
+type RebaseConflictStrategy string
+
+const (
+	// RebaseConflictStrategyFail aborts the rebase and returns an error when
+	// a conflict is encountered.
+	RebaseConflictStrategyFail RebaseConflictStrategy = "fail"
+	// RebaseConflictStrategyOurs resolves conflicts in favor of the local
+	// commits being rebased.
+	RebaseConflictStrategyOurs RebaseConflictStrategy = "ours"
+	// RebaseConflictStrategyTheirs resolves conflicts in favor of the commits
+	// found in the remote branch.
+	RebaseConflictStrategyTheirs RebaseConflictStrategy = "theirs"
+)
+
+// PushOptions represents options for pushing changes to a remote git
+// repository.
+type PushOptions struct {
+	// Force indicates whether the push should be forced.
+	Force bool
+	// PullRebase indicates whether, when a push is rejected because the remote
+	// branch contains commits that are not present locally, local commits
+	// should be rebased onto the remote branch before retrying the push. This
+	// option has no effect when Force is true.
+	PullRebase bool
+	// RebaseConflictStrategy specifies how conflicts encountered while
+	// rebasing are to be resolved. If not specified, it defaults to
+	// RebaseConflictStrategyFail.
+	RebaseConflictStrategy RebaseConflictStrategy
+}
+
 // TagMetadata represents metadata associated with a Git tag.
 type TagMetadata struct {
 	// Tag is the name of the tag.
@@ -117,6 +164,14 @@ type Repo interface {
 	CurrentBranch() string
 	// DeleteBranch deletes the specified branch
 	DeleteBranch(branch string) error
+	// DeleteRemoteBranch deletes the specified branch from the remote
+	// repository.
+	DeleteRemoteBranch(branch string) error
+	// Diff stages all pending changes in the working directory and returns a
+	// unified diff of each changed file against the head of the current branch,
+	// keyed by the path of the file relative to the root of the repository.
+	// Nothing is committed.
+	Diff() (map[string]string, error)
 	// HasDiffs returns a bool indicating whether the working directory currently
 	// contains any differences from what's already at the head of the current
 	// branch.
@@ -134,13 +189,15 @@ type Repo interface {
 	// commit ID, creator date, and subject.
 	ListTags() ([]TagMetadata, error)
 	// ListCommits returns a slice of commits in the current branch with
-	// metadata such as commit ID, commit date, and subject.
+	// metadata such as commit ID, commit date, and subject. If the repository
+	// was cloned with sparse checkout paths, only commits that modify files in
+	// those paths are listed.
 	ListCommits(limit, skip uint) ([]CommitMetadata, error)
 	// CommitMessage returns the text of the most recent commit message associated
 	// with the specified commit ID.
 	CommitMessage(id string) (string, error)
 	// Push pushes from the current branch to a remote branch by the same name.
-	Push(force bool) error
+	Push(opts *PushOptions) error
 	// RefsHaveDiffs returns whether there is a diff between two commits/branches
 	RefsHaveDiffs(commit1 string, commit2 string) (bool, error)
 	// RemoteBranchExists returns a bool indicating if the specified branch exists
@@ -165,6 +222,7 @@ type repo struct {
 	dir                   string
 	currentBranch         string
 	insecureSkipTLSVerify bool
+	sparseCheckoutPaths   []string
 }
 
 // ClientOptions represents options for the git client. Commonly, the
@@ -217,6 +275,13 @@ type CloneOptions struct {
 	// - https://github.blog/2020-12-21-get-up-to-speed-with-partial-clone-and-shallow-clone/
 	// - https://docs.gitlab.com/ee/topics/git/partial_clone.html
 	Filter string
+	// SparseCheckoutPaths optionally specifies the paths of directories,
+	// relative to the root of the repository, to which the working tree should
+	// be limited. When specified, the repository is cloned without blobs, unless
+	// a different Filter is specified, and only the specified directories are
+	// checked out. Commits listed by the resulting Repo are also limited to
+	// those that modify files in at least one of the specified directories.
+	SparseCheckoutPaths []string
 	// InsecureSkipTLSVerify specifies whether certificate verification errors
 	// should be ignored when cloning the repository. The setting will be
 	// remembered for subsequent interactions with the remote repository.
@@ -242,6 +307,7 @@ func Clone(
 		homeDir:               homeDir,
 		dir:                   filepath.Join(homeDir, "repo"),
 		insecureSkipTLSVerify: cloneOpts.InsecureSkipTLSVerify,
+		sparseCheckoutPaths:   cloneOpts.SparseCheckoutPaths,
 	}
 	if err = r.setupClient(clientOpts); err != nil {
 		return nil, err
@@ -249,6 +315,47 @@ func Clone(
 	return r, r.clone(cloneOpts)
 }
 
+// checkRemoteTimeout bounds how long CheckRemote waits for a remote git
+// repository to respond.
+const checkRemoteTimeout = 30 * time.Second
+
+// CheckRemote verifies that the remote git repository at the specified URL is
+// reachable and, if credentials are provided, that they grant read access to
+// it. No local clone is produced. The check is abandoned if the provided
+// context is canceled or if the remote does not respond within 30 seconds.
+func CheckRemote(
+	ctx context.Context,
+	repoURL string,
+	clientOpts *ClientOptions,
+	insecureSkipTLSVerify bool,
+) error {
+	homeDir, err := os.MkdirTemp("", "repo-")
+	if err != nil {
+		return fmt.Errorf("error creating home directory for repo %q: %w", repoURL, err)
+	}
+	defer os.RemoveAll(homeDir)
+	r := &repo{
+		url:                   repoURL,
+		homeDir:               homeDir,
+		dir:                   homeDir,
+		insecureSkipTLSVerify: insecureSkipTLSVerify,
+	}
+	if err = r.setupClient(clientOpts); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, checkRemoteTimeout)
+	defer cancel()
+	if _, err = libExec.Exec(
+		r.buildGitCommandContext(ctx, "ls-remote", "--heads", r.url),
+	); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return fmt.Errorf("error listing refs of remote repo %q: %w", repoURL, ctxErr)
+		}
+		return fmt.Errorf("error listing refs of remote repo %q: %w", repoURL, err)
+	}
+	return nil
+}
+
 func (r *repo) AddAll() error {
 	if _, err := libExec.Exec(r.buildGitCommand("add", ".")); err != nil {
 		return fmt.Errorf("error staging changes for commit: %w", err)
@@ -284,6 +391,21 @@ func (r *repo) clone(opts *CloneOptions) error {
 	}
 	if opts.Depth > 0 {
 		args = append(args, "--depth", fmt.Sprint(opts.Depth))
+		if !opts.SingleBranch {
+			// --depth implies --single-branch, but other branches may need to be
+			// checked out subsequently.
+			args = append(args, "--no-single-branch")
+		}
+	}
+	filter := opts.Filter
+	if len(opts.SparseCheckoutPaths) > 0 {
+		args = append(args, "--sparse")
+		if filter == "" {
+			filter = FilterBlobless
+		}
+	}
+	if filter != "" {
+		args = append(args, "--filter", filter)
 	}
 	args = append(args, r.url, r.dir)
 	cmd := r.buildGitCommand(args...)
@@ -291,6 +413,13 @@ func (r *repo) clone(opts *CloneOptions) error {
 	if _, err := libExec.Exec(cmd); err != nil {
 		return fmt.Errorf("error cloning repo %q into %q: %w", r.url, r.dir, err)
 	}
+	if len(opts.SparseCheckoutPaths) > 0 {
+		if _, err := libExec.Exec(r.buildGitCommand(
+			append([]string{"sparse-checkout", "set"}, opts.SparseCheckoutPaths...)...,
+		)); err != nil {
+			return fmt.Errorf("error setting sparse checkout paths for repo %q: %w", r.url, err)
+		}
+	}
 	if opts.Branch == "" {
 		// If branch wasn't specified as part of options, we need to determine it manually
 		resBytes, err := libExec.Exec(r.buildGitCommand(
@@ -400,6 +529,23 @@ func (r *repo) DeleteBranch(branch string) error {
 	return nil
 }
 
+func (r *repo) DeleteRemoteBranch(branch string) error {
+	if _, err := libExec.Exec(r.buildGitCommand(
+		"push",
+		"origin",
+		"--delete",
+		branch,
+	)); err != nil {
+		return fmt.Errorf(
+			"error deleting branch %q from remote repo %q: %w",
+			branch,
+			r.url,
+			err,
+		)
+	}
+	return nil
+}
+
 func (r *repo) HasDiffs() (bool, error) {
 	resBytes, err := libExec.Exec(r.buildGitCommand("status", "-s"))
 	if err != nil {
@@ -408,6 +554,34 @@ func (r *repo) HasDiffs() (bool, error) {
 	return len(resBytes) > 0, nil
 }
 
+func (r *repo) Diff() (map[string]string, error) {
+	if err := r.AddAll(); err != nil {
+		return nil, err
+	}
+	resBytes, err := libExec.Exec(
+		r.buildGitCommand("diff", "--cached", "--no-renames", "--name-only"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error getting paths of staged changes: %w", err)
+	}
+	diffs := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(resBytes))
+	scanner.Split(bufio.ScanLines)
+	for scanner.Scan() {
+		path := scanner.Text()
+		if path == "" {
+			continue
+		}
+		if resBytes, err = libExec.Exec(r.buildGitCommand(
+			"diff", "--cached", "--no-renames", "--no-color", "--no-ext-diff", "--", path,
+		)); err != nil {
+			return nil, fmt.Errorf("error diffing staged changes to %q: %w", path, err)
+		}
+		diffs[path] = string(resBytes)
+	}
+	return diffs, nil
+}
+
 func (r *repo) GetDiffPathsForCommitID(commitID string) ([]string, error) {
 	resBytes, err := libExec.Exec(r.buildGitCommand("show", "--pretty=", "--name-only", commitID))
 	if err != nil {
@@ -530,6 +704,11 @@ func (r *repo) ListCommits(limit, skip uint) ([]CommitMetadata, error) {
 	if skip > 0 {
 		args = append(args, fmt.Sprintf("--skip=%d", skip))
 	}
+	if len(r.sparseCheckoutPaths) > 0 {
+		// Only list commits that modify files in the checked out paths.
+		args = append(args, "--")
+		args = append(args, r.sparseCheckoutPaths...)
+	}
 
 	commitsBytes, err := libExec.Exec(r.buildGitCommand(args...))
 	if err != nil {
@@ -572,17 +751,77 @@ func (r *repo) CommitMessage(id string) (string, error) {
 	return string(msgBytes), nil
 }
 
-func (r *repo) Push(force bool) error {
+func (r *repo) Push(opts *PushOptions) error {
+	if opts == nil {
+		opts = &PushOptions{}
+	}
 	args := []string{"push", "origin", r.currentBranch}
-	if force {
+	if opts.Force {
 		args = append(args, "--force")
 	}
-	if _, err := libExec.Exec(r.buildGitCommand(args...)); err != nil {
+	_, err := libExec.Exec(r.buildGitCommand(args...))
+	if err != nil && !opts.Force && opts.PullRebase && isNonFastForwardErr(err) {
+		// The remote branch has moved on since we last fetched from it. Rebase
+		// our local commits onto it and try again.
+		if err = r.pullRebase(opts.RebaseConflictStrategy); err != nil {
+			return err
+		}
+		_, err = libExec.Exec(r.buildGitCommand(args...))
+	}
+	if err != nil {
 		return fmt.Errorf("error pushing branch %q: %w", r.currentBranch, err)
 	}
 	return nil
 }
 
+// pullRebase fetches the remote counterpart of the current branch and rebases
+// local commits onto it, resolving any conflicts according to the specified
+// strategy.
+func (r *repo) pullRebase(strategy RebaseConflictStrategy) error {
+	args := []string{"pull", "--rebase", "origin", r.currentBranch}
+	// Note that during a rebase, "ours" refers to the branch being rebased
+	// onto (i.e. the remote branch) and "theirs" refers to the local commits
+	// being replayed on top of it, which is the opposite of what one might
+	// intuitively expect.
+	switch strategy {
+	case RebaseConflictStrategyOurs:
+		args = append(args, "--strategy-option", "theirs")
+	case RebaseConflictStrategyTheirs:
+		args = append(args, "--strategy-option", "ours")
+	case RebaseConflictStrategyFail, "":
+	default:
+		return fmt.Errorf("unknown rebase conflict strategy %q", strategy)
+	}
+	if _, err := libExec.Exec(r.buildGitCommand(args...)); err != nil {
+		// Leave the working tree the way we found it
+		if _, abortErr := libExec.Exec(
+			r.buildGitCommand("rebase", "--abort"),
+		); abortErr != nil {
+			err = errors.Join(err, abortErr)
+		}
+		return fmt.Errorf(
+			"error rebasing branch %q onto remote branch: %w",
+			r.currentBranch,
+			err,
+		)
+	}
+	return nil
+}
+
+// isNonFastForwardErr returns a bool indicating whether the provided error was
+// the result of a push being rejected because the remote branch contains
+// commits that are not present locally.
+func isNonFastForwardErr(err error) bool {
+	var exitErr *libExec.ExitError
+	if !errors.As(err, &exitErr) {
+		return false
+	}
+	output := string(exitErr.Output)
+	return strings.Contains(output, "[rejected]") &&
+		(strings.Contains(output, "non-fast-forward") ||
+			strings.Contains(output, "fetch first"))
+}
+
 func (r *repo) RemoteBranchExists(branch string) (bool, error) {
 	_, err := libExec.Exec(r.buildGitCommand(
 		"ls-remote",
@@ -672,13 +911,29 @@ func (r *repo) setupAuthor(author User) error {
 		return fmt.Errorf("error configuring git user email: %w", err)
 	}
 
-	if author.SigningKeyPath != "" && author.SigningKeyType == SigningKeyTypeGPG {
+	if (author.SigningKey != "" || author.SigningKeyPath != "") &&
+		author.SigningKeyType == SigningKeyTypeGPG {
 		cmd = r.buildGitCommand("config", "--global", "commit.gpgsign", "true")
 		cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildGitCommand()
 		if _, err := libExec.Exec(cmd); err != nil {
 			return fmt.Errorf("error configuring commit gpg signing: %w", err)
 		}
 
+		if author.SigningKey != "" {
+			fingerprint, err := r.importGPGKey(author.SigningKey)
+			if err != nil {
+				return err
+			}
+			// Sign with the imported key regardless of whether its identity
+			// matches the author's.
+			cmd = r.buildGitCommand("config", "--global", "user.signingkey", fingerprint)
+			cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildGitCommand()
+			if _, err = libExec.Exec(cmd); err != nil {
+				return fmt.Errorf("error configuring git signing key: %w", err)
+			}
+			return nil
+		}
+
 		cmd = r.buildCommand("gpg", "--import", author.SigningKeyPath)
 		cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildCommand()
 		if _, err := libExec.Exec(cmd); err != nil {
@@ -689,30 +944,36 @@ func (r *repo) setupAuthor(author User) error {
 	return nil
 }
 
+// importGPGKey imports the provided ASCII-armored GPG private key into the
+// keyring in the repository's home directory and returns the fingerprint of
+// its primary key.
+func (r *repo) importGPGKey(key string) (string, error) {
+	cmd := r.buildCommand("gpg", "--batch", "--import")
+	cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildCommand()
+	cmd.Stdin = strings.NewReader(key)
+	if _, err := libExec.Exec(cmd); err != nil {
+		return "", fmt.Errorf("error importing gpg key: %w", err)
+	}
+	cmd = r.buildCommand("gpg", "--batch", "--with-colons", "--list-secret-keys")
+	cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildCommand()
+	res, err := libExec.Exec(cmd)
+	if err != nil {
+		return "", fmt.Errorf("error listing gpg keys: %w", err)
+	}
+	// The first fingerprint record is that of the primary key.
+	scanner := bufio.NewScanner(bytes.NewReader(res))
+	for scanner.Scan() {
+		if fields := strings.Split(scanner.Text(), ":"); len(fields) > 9 && fields[0] == "fpr" {
+			return fields[9], nil
+		}
+	}
+	return "", errors.New("error importing gpg key: no secret key found")
+}
+
 func (r *repo) setupAuth(creds RepoCredentials) error {
 	// If an SSH key was provided, use that.
 	if creds.SSHPrivateKey != "" {
-		sshPath := filepath.Join(r.homeDir, ".ssh")
-		if err := os.Mkdir(sshPath, 0700); err != nil {
-			return fmt.Errorf("error creating SSH directory %q: %w", sshPath, err)
-		}
-		sshConfigPath := filepath.Join(sshPath, "config")
-		rsaKeyPath := filepath.Join(sshPath, "id_rsa")
-		// nolint: lll
-		sshConfig := fmt.Sprintf("Host *\n  StrictHostKeyChecking no\n  UserKnownHostsFile=/dev/null\n  IdentityFile %q\n", rsaKeyPath)
-		if err :=
-			os.WriteFile(sshConfigPath, []byte(sshConfig), 0600); err != nil {
-			return fmt.Errorf("error writing SSH config to %q: %w", sshConfigPath, err)
-		}
-
-		if err := os.WriteFile(
-			rsaKeyPath,
-			[]byte(creds.SSHPrivateKey),
-			0600,
-		); err != nil {
-			return fmt.Errorf("error writing SSH key to %q: %w", rsaKeyPath, err)
-		}
-		return nil // We're done
+		return r.setupSSHAuth(creds)
 	}
 
 	// If we get to here, we're authenticating using a password
@@ -752,6 +1013,67 @@ func (r *repo) setupAuth(creds RepoCredentials) error {
 	return nil
 }
 
+// setupSSHAuth writes the SSH private key from the provided credentials, along
+// with an SSH config referencing it, to the repository's home directory. A
+// passphrase-protected key is decrypted first, since the git CLI has no way of
+// prompting for the passphrase. If known hosts were provided, the remote host's
+// key is verified against them. Otherwise, host key verification is skipped.
+func (r *repo) setupSSHAuth(creds RepoCredentials) error {
+	sshPath := filepath.Join(r.homeDir, ".ssh")
+	if err := os.Mkdir(sshPath, 0700); err != nil {
+		return fmt.Errorf("error creating SSH directory %q: %w", sshPath, err)
+	}
+
+	key := creds.SSHPrivateKey
+	if creds.SSHPrivateKeyPassphrase != "" {
+		var err error
+		if key, err = decryptSSHPrivateKey(key, creds.SSHPrivateKeyPassphrase); err != nil {
+			return err
+		}
+	}
+	rsaKeyPath := filepath.Join(sshPath, "id_rsa")
+	if err := os.WriteFile(rsaKeyPath, []byte(key), 0600); err != nil {
+		return fmt.Errorf("error writing SSH key to %q: %w", rsaKeyPath, err)
+	}
+
+	// nolint: lll
+	sshConfig := fmt.Sprintf("Host *\n  StrictHostKeyChecking no\n  UserKnownHostsFile=/dev/null\n  IdentityFile %q\n", rsaKeyPath)
+	if creds.SSHKnownHosts != "" {
+		knownHostsPath := filepath.Join(sshPath, "known_hosts")
+		if err := os.WriteFile(
+			knownHostsPath,
+			[]byte(creds.SSHKnownHosts),
+			0600,
+		); err != nil {
+			return fmt.Errorf("error writing SSH known hosts to %q: %w", knownHostsPath, err)
+		}
+		// nolint: lll
+		sshConfig = fmt.Sprintf("Host *\n  StrictHostKeyChecking yes\n  UserKnownHostsFile %q\n  IdentityFile %q\n", knownHostsPath, rsaKeyPath)
+	}
+	sshConfigPath := filepath.Join(sshPath, "config")
+	if err := os.WriteFile(sshConfigPath, []byte(sshConfig), 0600); err != nil {
+		return fmt.Errorf("error writing SSH config to %q: %w", sshConfigPath, err)
+	}
+	return nil
+}
+
+// decryptSSHPrivateKey decrypts the provided passphrase-protected SSH private
+// key and returns it in unencrypted OpenSSH format.
+func decryptSSHPrivateKey(key, passphrase string) (string, error) {
+	rawKey, err := ssh.ParseRawPrivateKeyWithPassphrase(
+		[]byte(key),
+		[]byte(passphrase),
+	)
+	if err != nil {
+		return "", fmt.Errorf("error decrypting SSH key: %w", err)
+	}
+	block, err := ssh.MarshalPrivateKey(rawKey, "")
+	if err != nil {
+		return "", fmt.Errorf("error encoding decrypted SSH key: %w", err)
+	}
+	return string(pem.EncodeToMemory(block)), nil
+}
+
 func (r *repo) buildCommand(command string, arg ...string) *exec.Cmd {
 	cmd := exec.Command(command, arg...)
 	cmd.Env = append(cmd.Env, os.Environ()...)
@@ -768,3 +1090,16 @@ func (r *repo) buildGitCommand(arg ...string) *exec.Cmd {
 	}
 	return cmd
 }
+
+// buildGitCommandContext is like buildGitCommand, but the returned command is
+// killed if the provided context is done before it completes.
+func (r *repo) buildGitCommandContext(ctx context.Context, arg ...string) *exec.Cmd {
+	cmd := r.buildGitCommand(arg...)
+	ctxCmd := exec.CommandContext(ctx, cmd.Args[0], cmd.Args[1:]...)
+	ctxCmd.Env = cmd.Env
+	ctxCmd.Dir = cmd.Dir
+	// Do not wait indefinitely for subprocesses, e.g. ssh, that may still hold
+	// the command's output open after it has been killed.
+	ctxCmd.WaitDelay = time.Second
+	return ctxCmd
+}
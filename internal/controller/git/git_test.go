@@ -0,0 +1,467 @@
+package git
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestPushWithPullRebase(t *testing.T) {
+	testCases := []struct {
+		name       string
+		strategy   RebaseConflictStrategy
+		assertions func(t *testing.T, remoteContents string, err error)
+	}{
+		{
+			name:     "conflict resolved in favor of local changes",
+			strategy: RebaseConflictStrategyOurs,
+			assertions: func(t *testing.T, remoteContents string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "local\n", remoteContents)
+			},
+		},
+		{
+			name:     "conflict resolved in favor of remote changes",
+			strategy: RebaseConflictStrategyTheirs,
+			assertions: func(t *testing.T, remoteContents string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "remote\n", remoteContents)
+			},
+		},
+		{
+			name:     "conflict fails the push",
+			strategy: RebaseConflictStrategyFail,
+			assertions: func(t *testing.T, remoteContents string, err error) {
+				require.ErrorContains(t, err, "error rebasing branch")
+				require.Equal(t, "remote\n", remoteContents)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			remoteURL := newTestRemote(t)
+
+			// Two independent clones of the same repository, both of which will
+			// modify the same line of the same file.
+			remoteRepo := cloneTestRepo(t, remoteURL)
+			localRepo := cloneTestRepo(t, remoteURL)
+
+			writeTestFile(t, remoteRepo, "remote\n")
+			require.NoError(t, remoteRepo.AddAllAndCommit("remote change"))
+			require.NoError(t, remoteRepo.Push(nil))
+
+			writeTestFile(t, localRepo, "local\n")
+			require.NoError(t, localRepo.AddAllAndCommit("local change"))
+			err := localRepo.Push(&PushOptions{
+				PullRebase:             true,
+				RebaseConflictStrategy: testCase.strategy,
+			})
+
+			// Read back whatever ended up in the remote repository
+			verifyRepo := cloneTestRepo(t, remoteURL)
+			contents, readErr := os.ReadFile(
+				filepath.Join(verifyRepo.WorkingDir(), "file.txt"),
+			)
+			require.NoError(t, readErr)
+
+			testCase.assertions(t, string(contents), err)
+		})
+	}
+}
+
+func TestPushWithoutPullRebase(t *testing.T) {
+	remoteURL := newTestRemote(t)
+	remoteRepo := cloneTestRepo(t, remoteURL)
+	localRepo := cloneTestRepo(t, remoteURL)
+
+	writeTestFile(t, remoteRepo, "remote\n")
+	require.NoError(t, remoteRepo.AddAllAndCommit("remote change"))
+	require.NoError(t, remoteRepo.Push(nil))
+
+	writeTestFile(t, localRepo, "local\n")
+	require.NoError(t, localRepo.AddAllAndCommit("local change"))
+	err := localRepo.Push(nil)
+	require.ErrorContains(t, err, "error pushing branch")
+}
+
+func TestDeleteRemoteBranch(t *testing.T) {
+	remoteURL := newTestRemote(t)
+	r := cloneTestRepo(t, remoteURL)
+
+	require.NoError(t, r.CreateChildBranch("feature"))
+	require.NoError(t, r.Push(nil))
+	exists, err := r.RemoteBranchExists("feature")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	require.NoError(t, r.DeleteRemoteBranch("feature"))
+	exists, err = r.RemoteBranchExists("feature")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	err = r.DeleteRemoteBranch("feature")
+	require.ErrorContains(t, err, "error deleting branch")
+}
+
+func TestCheckRemote(t *testing.T) {
+	remoteURL := newTestRemote(t)
+	require.NoError(t, CheckRemote(context.Background(), remoteURL, nil, false))
+
+	err := CheckRemote(
+		context.Background(),
+		filepath.Join(t.TempDir(), "missing.git"),
+		nil,
+		false,
+	)
+	require.ErrorContains(t, err, "error listing refs of remote repo")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = CheckRemote(ctx, remoteURL, nil, false)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestDiff(t *testing.T) {
+	remoteURL := newTestRemote(t)
+	r := cloneTestRepo(t, remoteURL)
+	initialCommitID, err := r.LastCommitID()
+	require.NoError(t, err)
+
+	diffs, err := r.Diff()
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+
+	writeTestFile(t, r, "updated\n")
+	require.NoError(
+		t,
+		os.WriteFile(filepath.Join(r.WorkingDir(), "new.txt"), []byte("new\n"), 0600),
+	)
+	diffs, err = r.Diff()
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+	require.Contains(t, diffs["file.txt"], "-initial\n+updated\n")
+	require.Contains(t, diffs["new.txt"], "+new\n")
+
+	// Nothing should have been committed
+	lastCommitID, err := r.LastCommitID()
+	require.NoError(t, err)
+	require.Equal(t, initialCommitID, lastCommitID)
+}
+
+func TestSparseCheckout(t *testing.T) {
+	// A monorepo in which each commit modifies a different directory
+	testDir := t.TempDir()
+	workDir := filepath.Join(testDir, "work")
+	remoteDir := filepath.Join(testDir, "remote.git")
+	require.NoError(t, os.Mkdir(workDir, 0700))
+	runTestGit(t, workDir, "init", "--initial-branch", "main")
+	for _, dir := range []string{"app-a", "app-b", "app-a", "app-b"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(workDir, dir), 0700))
+		f, err := os.OpenFile(
+			filepath.Join(workDir, dir, "file.txt"),
+			os.O_APPEND|os.O_CREATE|os.O_WRONLY,
+			0600,
+		)
+		require.NoError(t, err)
+		_, err = f.WriteString("change\n")
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		runTestGit(t, workDir, "add", ".")
+		runTestGit(
+			t,
+			workDir,
+			"-c", "user.name=Kargo Test",
+			"-c", "user.email=kargo-test@akuity.io",
+			"commit", "-m", "change "+dir,
+		)
+	}
+	runTestGit(t, workDir, "clone", "--bare", workDir, remoteDir)
+
+	r, err := Clone(
+		"file://"+remoteDir,
+		nil,
+		&CloneOptions{
+			Branch:              "main",
+			SparseCheckoutPaths: []string{"app-a"},
+		},
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = r.Close()
+	})
+
+	// Only the sparse checkout paths are checked out
+	require.FileExists(t, filepath.Join(r.WorkingDir(), "app-a", "file.txt"))
+	require.NoDirExists(t, filepath.Join(r.WorkingDir(), "app-b"))
+
+	// Only commits that modify the sparse checkout paths are listed
+	commits, err := r.ListCommits(0, 0)
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	for _, commit := range commits {
+		require.Equal(t, "change app-a", commit.Subject)
+	}
+
+	// The most recent commit to the branch is still the head of the branch
+	lastCommitID, err := r.LastCommitID()
+	require.NoError(t, err)
+	require.NotEqual(t, commits[0].ID, lastCommitID)
+}
+
+func TestCommitSigning(t *testing.T) {
+	privateKey, publicKey := newTestGPGKey(t)
+
+	remoteURL := newTestRemote(t)
+	r, err := Clone(
+		remoteURL,
+		&ClientOptions{
+			User: &User{
+				Name:           "Kargo Test",
+				Email:          "kargo-test@akuity.io",
+				SigningKeyType: SigningKeyTypeGPG,
+				SigningKey:     privateKey,
+			},
+		},
+		&CloneOptions{Branch: "main"},
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = r.Close()
+	})
+
+	writeTestFile(t, r, "signed\n")
+	require.NoError(t, r.AddAllAndCommit("signed change"))
+
+	// Verify the signature using a keyring that holds only the public key
+	gpgHome := t.TempDir()
+	cmd := exec.Command("gpg", "--batch", "--import")
+	cmd.Env = append(os.Environ(), "GNUPGHOME="+gpgHome)
+	cmd.Stdin = strings.NewReader(publicKey)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	t.Cleanup(func() {
+		killTestGPGAgent(gpgHome)
+	})
+	cmd = exec.Command("git", "verify-commit", "HEAD")
+	cmd.Dir = r.WorkingDir()
+	cmd.Env = append(os.Environ(), "GNUPGHOME="+gpgHome)
+	out, err = cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	require.Contains(t, string(out), "Good signature")
+}
+
+func TestCommitSigningWithInvalidKey(t *testing.T) {
+	_, err := Clone(
+		newTestRemote(t),
+		&ClientOptions{
+			User: &User{
+				Name:           "Kargo Test",
+				Email:          "kargo-test@akuity.io",
+				SigningKeyType: SigningKeyTypeGPG,
+				SigningKey:     "not a key",
+			},
+		},
+		&CloneOptions{Branch: "main"},
+	)
+	require.ErrorContains(t, err, "error importing gpg key")
+}
+
+func TestSetupSSHAuth(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(privateKey, "")
+	require.NoError(t, err)
+	testKey := string(pem.EncodeToMemory(block))
+	block, err = ssh.MarshalPrivateKeyWithPassphrase(
+		privateKey,
+		"",
+		[]byte("fake-passphrase"),
+	)
+	require.NoError(t, err)
+	testEncryptedKey := string(pem.EncodeToMemory(block))
+
+	const testKnownHosts = "github.com ssh-ed25519 AAAAfake\n"
+
+	testCases := []struct {
+		name       string
+		creds      RepoCredentials
+		assertions func(t *testing.T, sshPath string, err error)
+	}{
+		{
+			name:  "unencrypted key without known hosts",
+			creds: RepoCredentials{SSHPrivateKey: testKey},
+			assertions: func(t *testing.T, sshPath string, err error) {
+				require.NoError(t, err)
+				key, err := os.ReadFile(filepath.Join(sshPath, "id_rsa"))
+				require.NoError(t, err)
+				require.Equal(t, testKey, string(key))
+				config, err := os.ReadFile(filepath.Join(sshPath, "config"))
+				require.NoError(t, err)
+				require.Contains(t, string(config), "StrictHostKeyChecking no")
+				require.NoFileExists(t, filepath.Join(sshPath, "known_hosts"))
+			},
+		},
+		{
+			name: "encrypted key",
+			creds: RepoCredentials{
+				SSHPrivateKey:           testEncryptedKey,
+				SSHPrivateKeyPassphrase: "fake-passphrase",
+			},
+			assertions: func(t *testing.T, sshPath string, err error) {
+				require.NoError(t, err)
+				keyPath := filepath.Join(sshPath, "id_rsa")
+				info, err := os.Stat(keyPath)
+				require.NoError(t, err)
+				require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+				key, err := os.ReadFile(keyPath)
+				require.NoError(t, err)
+				// The key must be usable without the passphrase
+				rawKey, err := ssh.ParseRawPrivateKey(key)
+				require.NoError(t, err)
+				require.True(t, privateKey.Equal(rawKey))
+			},
+		},
+		{
+			name: "encrypted key with wrong passphrase",
+			creds: RepoCredentials{
+				SSHPrivateKey:           testEncryptedKey,
+				SSHPrivateKeyPassphrase: "wrong-passphrase",
+			},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error decrypting SSH key")
+			},
+		},
+		{
+			name: "known hosts",
+			creds: RepoCredentials{
+				SSHPrivateKey: testKey,
+				SSHKnownHosts: testKnownHosts,
+			},
+			assertions: func(t *testing.T, sshPath string, err error) {
+				require.NoError(t, err)
+				knownHostsPath := filepath.Join(sshPath, "known_hosts")
+				knownHosts, err := os.ReadFile(knownHostsPath)
+				require.NoError(t, err)
+				require.Equal(t, testKnownHosts, string(knownHosts))
+				config, err := os.ReadFile(filepath.Join(sshPath, "config"))
+				require.NoError(t, err)
+				require.Contains(t, string(config), "StrictHostKeyChecking yes")
+				require.Contains(t, string(config), knownHostsPath)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &repo{homeDir: t.TempDir()}
+			err := r.setupAuth(testCase.creds)
+			testCase.assertions(t, filepath.Join(r.homeDir, ".ssh"), err)
+		})
+	}
+}
+
+// newTestGPGKey generates a GPG key without a passphrase for use by tests. It
+// returns the ASCII-armored private and public keys.
+func newTestGPGKey(t *testing.T) (string, string) {
+	gpgHome := t.TempDir()
+	t.Cleanup(func() {
+		killTestGPGAgent(gpgHome)
+	})
+	gpg := func(args ...string) string {
+		cmd := exec.Command("gpg", append([]string{"--batch"}, args...)...)
+		cmd.Env = append(os.Environ(), "GNUPGHOME="+gpgHome)
+		out, err := cmd.Output()
+		require.NoError(t, err)
+		return string(out)
+	}
+	gpg(
+		"--passphrase", "",
+		"--quick-generate-key", "Kargo Signer <kargo-signer@akuity.io>",
+		"ed25519", "sign", "never",
+	)
+	return gpg("--armor", "--export-secret-keys"), gpg("--armor", "--export")
+}
+
+// killTestGPGAgent stops any gpg-agent started for the provided GPG home
+// directory.
+func killTestGPGAgent(gpgHome string) {
+	cmd := exec.Command("gpgconf", "--kill", "gpg-agent")
+	cmd.Env = append(os.Environ(), "GNUPGHOME="+gpgHome)
+	_ = cmd.Run()
+}
+
+// newTestRemote creates a bare repository, seeded with a single commit to its
+// main branch, that can be used as a remote by tests. It returns the
+// repository's URL.
+func newTestRemote(t *testing.T) string {
+	testDir := t.TempDir()
+	workDir := filepath.Join(testDir, "work")
+	remoteDir := filepath.Join(testDir, "remote.git")
+	require.NoError(t, os.Mkdir(workDir, 0700))
+	require.NoError(
+		t,
+		os.WriteFile(filepath.Join(workDir, "file.txt"), []byte("initial\n"), 0600),
+	)
+	for _, args := range [][]string{
+		{"init", "--initial-branch", "main"},
+		{"add", "."},
+		{
+			"-c", "user.name=Kargo Test",
+			"-c", "user.email=kargo-test@akuity.io",
+			"commit", "-m", "initial commit",
+		},
+		{"clone", "--bare", workDir, remoteDir},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = workDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	return remoteDir
+}
+
+// runTestGit runs git with the provided arguments in the provided directory,
+// failing the test if it exits with an error.
+func runTestGit(t *testing.T, dir string, args ...string) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func cloneTestRepo(t *testing.T, url string) Repo {
+	r, err := Clone(
+		url,
+		&ClientOptions{
+			User: &User{
+				Name:  "Kargo Test",
+				Email: "kargo-test@akuity.io",
+			},
+		},
+		&CloneOptions{Branch: "main"},
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = r.Close()
+	})
+	return r
+}
+
+func writeTestFile(t *testing.T, r Repo, contents string) {
+	require.NoError(
+		t,
+		os.WriteFile(
+			filepath.Join(r.WorkingDir(), "file.txt"),
+			[]byte(contents),
+			0600,
+		),
+	)
+}
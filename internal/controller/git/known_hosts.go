@@ -0,0 +1,95 @@
+package git
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// scpURLRegex matches SSH URLs of the form [user@]host.xz:path/to/repo.git
+var scpURLRegex = regexp.MustCompile(`^(?:[\w.-]+@)?([\w.-]+):`)
+
+// VerifyKnownHosts returns an error if the provided content of an SSH
+// known_hosts file cannot be parsed or does not include a key for the host of
+// the repository at the provided SSH URL.
+func VerifyKnownHosts(repoURL string, knownHosts string) error {
+	host, port, err := sshHostAndPort(repoURL)
+	if err != nil {
+		return err
+	}
+
+	// knownhosts only reads known_hosts files from disk.
+	knownHostsFile, err := os.CreateTemp("", "known_hosts-")
+	if err != nil {
+		return fmt.Errorf("error creating temporary known_hosts file: %w", err)
+	}
+	defer os.Remove(knownHostsFile.Name())
+	_, err = knownHostsFile.WriteString(knownHosts)
+	if closeErr := knownHostsFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("error writing temporary known_hosts file: %w", err)
+	}
+	hostKeyCallback, err := knownhosts.New(knownHostsFile.Name())
+	if err != nil {
+		return fmt.Errorf("error parsing known hosts: %w", err)
+	}
+
+	// Checking an arbitrary key, which cannot possibly be known, reveals
+	// whether any key at all is known for the host.
+	pubKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return fmt.Errorf("error generating SSH key: %w", err)
+	}
+	sshPubKey, err := ssh.NewPublicKey(pubKey)
+	if err != nil {
+		return fmt.Errorf("error generating SSH key: %w", err)
+	}
+	err = hostKeyCallback(
+		net.JoinHostPort(host, strconv.Itoa(port)),
+		&net.TCPAddr{IP: net.IPv4zero, Port: port},
+		sshPubKey,
+	)
+	keyErr := &knownhosts.KeyError{}
+	if errors.As(err, &keyErr) && len(keyErr.Want) == 0 {
+		return fmt.Errorf("known hosts do not include a key for host %q", host)
+	}
+	return nil
+}
+
+// sshHostAndPort returns the host and port of the repository at the provided
+// SSH URL.
+func sshHostAndPort(repoURL string) (string, int, error) {
+	if strings.HasPrefix(strings.ToLower(repoURL), "ssh://") {
+		u, err := url.Parse(repoURL)
+		if err != nil {
+			return "", 0, fmt.Errorf("error parsing URL %q: %w", repoURL, err)
+		}
+		port := 22
+		if p := u.Port(); p != "" {
+			if port, err = strconv.Atoi(p); err != nil {
+				return "", 0, fmt.Errorf("error parsing port of URL %q: %w", repoURL, err)
+			}
+		}
+		return u.Hostname(), port, nil
+	}
+	if strings.Contains(repoURL, "://") {
+		return "", 0, fmt.Errorf("%q is not an SSH URL", repoURL)
+	}
+	matches := scpURLRegex.FindStringSubmatch(repoURL)
+	if matches == nil {
+		return "", 0, fmt.Errorf("%q is not an SSH URL", repoURL)
+	}
+	return matches[1], 22, nil
+}
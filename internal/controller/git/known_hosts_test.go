@@ -0,0 +1,98 @@
+package git
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+func TestVerifyKnownHosts(t *testing.T) {
+	pubKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostKey, err := ssh.NewPublicKey(pubKey)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		repoURL    string
+		knownHosts string
+		assertions func(*testing.T, error)
+	}{
+		{
+			name:       "not an SSH URL",
+			repoURL:    "https://github.com/example/repo.git",
+			knownHosts: knownhosts.Line([]string{"github.com"}, hostKey),
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "is not an SSH URL")
+			},
+		},
+		{
+			name:       "invalid known hosts",
+			repoURL:    "git@github.com:example/repo.git",
+			knownHosts: "github.com ssh-ed25519 not-a-key",
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "error parsing known hosts")
+			},
+		},
+		{
+			name:       "host key absent",
+			repoURL:    "git@github.com:example/repo.git",
+			knownHosts: knownhosts.Line([]string{"gitlab.com"}, hostKey),
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(
+					t, err, `known hosts do not include a key for host "github.com"`,
+				)
+			},
+		},
+		{
+			name:       "host key absent for non-default port",
+			repoURL:    "ssh://git@git.example.com:2222/example/repo.git",
+			knownHosts: knownhosts.Line([]string{"git.example.com"}, hostKey),
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "known hosts do not include a key")
+			},
+		},
+		{
+			name:       "host key present",
+			repoURL:    "git@github.com:example/repo.git",
+			knownHosts: knownhosts.Line([]string{"github.com"}, hostKey),
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "hashed host key present",
+			repoURL: "git@github.com:example/repo.git",
+			knownHosts: knownhosts.Line(
+				[]string{knownhosts.HashHostname("github.com")},
+				hostKey,
+			),
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "host key present for non-default port",
+			repoURL: "ssh://git@git.example.com:2222/example/repo.git",
+			knownHosts: knownhosts.Line(
+				[]string{knownhosts.Normalize("git.example.com:2222")},
+				hostKey,
+			),
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				VerifyKnownHosts(testCase.repoURL, testCase.knownHosts),
+			)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package git
+
+import (
+	"encoding/json"
+	"fmt"
+
+	libGit "github.com/akuity/kargo/internal/git"
+)
+
+// MirrorConfig maps the URL of a Git repository to the URL of a mirror of that
+// repository. This permits repositories to be accessed in environments, such as
+// air-gapped ones, where their original URL cannot be reached.
+type MirrorConfig struct {
+	// OriginalURL is the URL of the mirrored repository.
+	OriginalURL string `json:"originalURL"`
+	// MirrorURL is the URL of the mirror.
+	MirrorURL string `json:"mirrorURL"`
+}
+
+// Mirrors is a list of MirrorConfigs. It implements envconfig.Decoder, so it
+// can be read from an environment variable containing a JSON array of objects
+// with originalURL and mirrorURL fields.
+type Mirrors []MirrorConfig
+
+// Decode implements envconfig.Decoder.
+func (m *Mirrors) Decode(value string) error {
+	if value == "" {
+		*m = nil
+		return nil
+	}
+	var mirrors []MirrorConfig
+	if err := json.Unmarshal([]byte(value), &mirrors); err != nil {
+		return fmt.Errorf("error decoding Git mirrors: %w", err)
+	}
+	for i, mirror := range mirrors {
+		if mirror.OriginalURL == "" || mirror.MirrorURL == "" {
+			return fmt.Errorf(
+				"mirror at index %d must specify both originalURL and mirrorURL", i,
+			)
+		}
+	}
+	*m = mirrors
+	return nil
+}
+
+// RewriteURL returns the URL of the mirror of the repository at the provided
+// URL. URLs are compared after normalization. If there is no mirror of the
+// repository, the provided URL is returned unchanged.
+func (m Mirrors) RewriteURL(repoURL string) string {
+	normalizedURL := libGit.NormalizeURL(repoURL)
+	for _, mirror := range m {
+		if libGit.NormalizeURL(mirror.OriginalURL) == normalizedURL {
+			return mirror.MirrorURL
+		}
+	}
+	return repoURL
+}
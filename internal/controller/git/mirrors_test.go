@@ -0,0 +1,121 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMirrorsDecode(t *testing.T) {
+	testCases := []struct {
+		name       string
+		value      string
+		assertions func(*testing.T, Mirrors, error)
+	}{
+		{
+			name:  "empty",
+			value: "",
+			assertions: func(t *testing.T, mirrors Mirrors, err error) {
+				require.NoError(t, err)
+				require.Empty(t, mirrors)
+			},
+		},
+		{
+			name:  "invalid JSON",
+			value: "not-json",
+			assertions: func(t *testing.T, _ Mirrors, err error) {
+				require.ErrorContains(t, err, "error decoding Git mirrors")
+			},
+		},
+		{
+			name:  "missing mirror URL",
+			value: `[{"originalURL":"https://github.com/example/repo.git"}]`,
+			assertions: func(t *testing.T, _ Mirrors, err error) {
+				require.ErrorContains(
+					t, err, "must specify both originalURL and mirrorURL",
+				)
+			},
+		},
+		{
+			name: "success",
+			value: `[{"originalURL":"https://github.com/example/repo.git",` +
+				`"mirrorURL":"https://git.example.com/mirrors/repo.git"}]`,
+			assertions: func(t *testing.T, mirrors Mirrors, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					Mirrors{{
+						OriginalURL: "https://github.com/example/repo.git",
+						MirrorURL:   "https://git.example.com/mirrors/repo.git",
+					}},
+					mirrors,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var mirrors Mirrors
+			err := mirrors.Decode(testCase.value)
+			testCase.assertions(t, mirrors, err)
+		})
+	}
+}
+
+func TestMirrorsRewriteURL(t *testing.T) {
+	mirrors := Mirrors{
+		{
+			OriginalURL: "https://github.com/example/repo.git",
+			MirrorURL:   "https://git.example.com/mirrors/repo.git",
+		},
+		{
+			OriginalURL: "git@github.com:example/other-repo.git",
+			MirrorURL:   "git@git.example.com:mirrors/other-repo.git",
+		},
+	}
+	testCases := []struct {
+		name     string
+		mirrors  Mirrors
+		repoURL  string
+		expected string
+	}{
+		{
+			name:     "no mirrors",
+			repoURL:  "https://github.com/example/repo.git",
+			expected: "https://github.com/example/repo.git",
+		},
+		{
+			name:     "no matching mirror",
+			mirrors:  mirrors,
+			repoURL:  "https://github.com/example/unmirrored-repo.git",
+			expected: "https://github.com/example/unmirrored-repo.git",
+		},
+		{
+			name:     "exact match",
+			mirrors:  mirrors,
+			repoURL:  "https://github.com/example/repo.git",
+			expected: "https://git.example.com/mirrors/repo.git",
+		},
+		{
+			name:     "match after normalization",
+			mirrors:  mirrors,
+			repoURL:  "https://GitHub.com/example/repo",
+			expected: "https://git.example.com/mirrors/repo.git",
+		},
+		{
+			name:     "SSH URL match",
+			mirrors:  mirrors,
+			repoURL:  "git@github.com:example/other-repo",
+			expected: "git@git.example.com:mirrors/other-repo.git",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				testCase.mirrors.RewriteURL(testCase.repoURL),
+			)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package httpapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// Authorizer authorizes requests to the controller's HTTP API.
+type Authorizer interface {
+	// Authorize authenticates the bearer token presented with the provided
+	// request and checks whether the user it belongs to is permitted to perform
+	// the action described by the provided ResourceAttributes. If the request is
+	// not authorized, an appropriate error response is written to the provided
+	// http.ResponseWriter and false is returned.
+	Authorize(
+		w http.ResponseWriter,
+		r *http.Request,
+		attrs authzv1.ResourceAttributes,
+	) bool
+}
+
+// NewAuthorizer returns an Authorizer that authenticates bearer tokens using
+// TokenReviews and checks permissions using SubjectAccessReviews, both of
+// which are created using the provided client.
+func NewAuthorizer(c client.Client) Authorizer {
+	return &authorizer{
+		createTokenReviewFn:         c.Create,
+		createSubjectAccessReviewFn: c.Create,
+	}
+}
+
+type authorizer struct {
+	// These behaviors are overridable for testing purposes:
+	createTokenReviewFn func(
+		context.Context,
+		client.Object,
+		...client.CreateOption,
+	) error
+	createSubjectAccessReviewFn func(
+		context.Context,
+		client.Object,
+		...client.CreateOption,
+	) error
+}
+
+// Authorize implements Authorizer.
+func (a *authorizer) Authorize(
+	w http.ResponseWriter,
+	r *http.Request,
+	attrs authzv1.ResourceAttributes,
+) bool {
+	ctx := r.Context()
+	logger := logging.LoggerFromContext(ctx)
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "a bearer token is required", http.StatusUnauthorized)
+		return false
+	}
+	tokenReview := &authnv1.TokenReview{
+		Spec: authnv1.TokenReviewSpec{
+			Token: token,
+		},
+	}
+	if err := a.createTokenReviewFn(ctx, tokenReview); err != nil {
+		logger.Error(err, "error creating TokenReview")
+		http.Error(w, "error authenticating bearer token", http.StatusInternalServerError)
+		return false
+	}
+	if !tokenReview.Status.Authenticated {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "invalid bearer token", http.StatusUnauthorized)
+		return false
+	}
+
+	user := tokenReview.Status.User
+	var extra map[string]authzv1.ExtraValue
+	if len(user.Extra) > 0 {
+		extra = make(map[string]authzv1.ExtraValue, len(user.Extra))
+		for k, v := range user.Extra {
+			extra[k] = authzv1.ExtraValue(v)
+		}
+	}
+	accessReview := &authzv1.SubjectAccessReview{
+		Spec: authzv1.SubjectAccessReviewSpec{
+			User:               user.Username,
+			Groups:             user.Groups,
+			UID:                user.UID,
+			Extra:              extra,
+			ResourceAttributes: &attrs,
+		},
+	}
+	if err := a.createSubjectAccessReviewFn(ctx, accessReview); err != nil {
+		logger.Error(err, "error creating SubjectAccessReview")
+		http.Error(w, "error authorizing request", http.StatusInternalServerError)
+		return false
+	}
+	if !accessReview.Status.Allowed {
+		http.Error(
+			w,
+			fmt.Sprintf(
+				"user %q is not permitted to %s %s %q in namespace %q",
+				user.Username, attrs.Verb, attrs.Resource, attrs.Name, attrs.Namespace,
+			),
+			http.StatusForbidden,
+		)
+		return false
+	}
+	return true
+}
+
+// FakeAuthorizer is a mock implementation of Authorizer for use in tests.
+type FakeAuthorizer struct {
+	AuthorizeFn func(
+		w http.ResponseWriter,
+		r *http.Request,
+		attrs authzv1.ResourceAttributes,
+	) bool
+}
+
+// Authorize implements Authorizer.
+func (f *FakeAuthorizer) Authorize(
+	w http.ResponseWriter,
+	r *http.Request,
+	attrs authzv1.ResourceAttributes,
+) bool {
+	if f.AuthorizeFn == nil {
+		return true
+	}
+	return f.AuthorizeFn(w, r, attrs)
+}
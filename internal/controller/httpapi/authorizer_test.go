@@ -0,0 +1,152 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNewAuthorizer(t *testing.T) {
+	a, ok := NewAuthorizer(fake.NewFakeClient()).(*authorizer)
+	require.True(t, ok)
+	require.NotNil(t, a.createTokenReviewFn)
+	require.NotNil(t, a.createSubjectAccessReviewFn)
+}
+
+func TestAuthorizerAuthorize(t *testing.T) {
+	testAttrs := authzv1.ResourceAttributes{
+		Group:     "kargo.akuity.io",
+		Resource:  "stages",
+		Name:      "fake-stage",
+		Verb:      "get",
+		Namespace: "fake-namespace",
+	}
+
+	// authenticated reviews any token as belonging to the test user.
+	authenticated := func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+		review := obj.(*authnv1.TokenReview) // nolint: forcetypeassert
+		review.Status.Authenticated = true
+		review.Status.User = authnv1.UserInfo{
+			Username: "fake-user",
+			Groups:   []string{"fake-group"},
+			Extra: map[string]authnv1.ExtraValue{
+				"fake-key": {"fake-value"},
+			},
+		}
+		return nil
+	}
+
+	testCases := []struct {
+		name       string
+		token      string
+		authorizer *authorizer
+		assertions func(*testing.T, bool, *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "no bearer token",
+			authorizer: &authorizer{},
+			assertions: func(t *testing.T, authorized bool, rr *httptest.ResponseRecorder) {
+				require.False(t, authorized)
+				require.Equal(t, http.StatusUnauthorized, rr.Code)
+				require.Equal(t, "Bearer", rr.Header().Get("WWW-Authenticate"))
+			},
+		},
+		{
+			name:  "error reviewing token",
+			token: "fake-token",
+			authorizer: &authorizer{
+				createTokenReviewFn: func(context.Context, client.Object, ...client.CreateOption) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, authorized bool, rr *httptest.ResponseRecorder) {
+				require.False(t, authorized)
+				require.Equal(t, http.StatusInternalServerError, rr.Code)
+			},
+		},
+		{
+			name:  "invalid token",
+			token: "fake-token",
+			authorizer: &authorizer{
+				createTokenReviewFn: func(context.Context, client.Object, ...client.CreateOption) error {
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, authorized bool, rr *httptest.ResponseRecorder) {
+				require.False(t, authorized)
+				require.Equal(t, http.StatusUnauthorized, rr.Code)
+				require.Contains(t, rr.Body.String(), "invalid bearer token")
+			},
+		},
+		{
+			name:  "error reviewing access",
+			token: "fake-token",
+			authorizer: &authorizer{
+				createTokenReviewFn: authenticated,
+				createSubjectAccessReviewFn: func(context.Context, client.Object, ...client.CreateOption) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, authorized bool, rr *httptest.ResponseRecorder) {
+				require.False(t, authorized)
+				require.Equal(t, http.StatusInternalServerError, rr.Code)
+			},
+		},
+		{
+			name:  "user not permitted",
+			token: "fake-token",
+			authorizer: &authorizer{
+				createTokenReviewFn: authenticated,
+				createSubjectAccessReviewFn: func(context.Context, client.Object, ...client.CreateOption) error {
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, authorized bool, rr *httptest.ResponseRecorder) {
+				require.False(t, authorized)
+				require.Equal(t, http.StatusForbidden, rr.Code)
+				require.Contains(
+					t,
+					rr.Body.String(),
+					`user "fake-user" is not permitted to get stages "fake-stage" in namespace "fake-namespace"`,
+				)
+			},
+		},
+		{
+			name:  "user permitted",
+			token: "fake-token",
+			authorizer: &authorizer{
+				createTokenReviewFn: authenticated,
+				createSubjectAccessReviewFn: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+					review := obj.(*authzv1.SubjectAccessReview) // nolint: forcetypeassert
+					review.Status.Allowed = review.Spec.User == "fake-user" &&
+						review.Spec.Extra["fake-key"][0] == "fake-value" &&
+						*review.Spec.ResourceAttributes == testAttrs
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, authorized bool, rr *httptest.ResponseRecorder) {
+				require.True(t, authorized)
+				require.Equal(t, http.StatusOK, rr.Code)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if testCase.token != "" {
+				req.Header.Set("Authorization", "Bearer "+testCase.token)
+			}
+			rr := httptest.NewRecorder()
+			authorized := testCase.authorizer.Authorize(rr, req, testAttrs)
+			testCase.assertions(t, authorized, rr)
+		})
+	}
+}
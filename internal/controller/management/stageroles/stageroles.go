@@ -0,0 +1,188 @@
+package stageroles
+
+import (
+	"context"
+	"fmt"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// rbacObjectNamePrefix is prepended to the name of a StageRole to obtain the
+// names of the Role and RoleBinding that implement it. The prefix prevents
+// StageRoles from clobbering other Roles and RoleBindings in the Project
+// namespace, such as those of Kargo's default project roles.
+const rbacObjectNamePrefix = "kargo-stagerole-"
+
+// reconciler reconciles StageRole resources.
+type reconciler struct {
+	client client.Client
+}
+
+// SetupReconcilerWithManager initializes a reconciler for StageRole resources
+// and registers it with the provided Manager.
+func SetupReconcilerWithManager(kargoMgr manager.Manager) error {
+	return ctrl.NewControllerManagedBy(kargoMgr).
+		For(&kargoapi.StageRole{}).
+		// Restore the Role and RoleBinding if they are modified or deleted.
+		Owns(&rbacv1.Role{}).
+		Owns(&rbacv1.RoleBinding{}).
+		WithOptions(controller.CommonOptions()).
+		Complete(newReconciler(kargoMgr.GetClient()))
+}
+
+func newReconciler(kubeClient client.Client) *reconciler {
+	return &reconciler{
+		client: kubeClient,
+	}
+}
+
+// Reconcile is part of the main Kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *reconciler) Reconcile(
+	ctx context.Context,
+	req ctrl.Request,
+) (ctrl.Result, error) {
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"namespace", req.NamespacedName.Namespace,
+		"stageRole", req.NamespacedName.Name,
+	)
+	ctx = logging.ContextWithLogger(ctx, logger)
+	logger.Debug("reconciling StageRole")
+
+	// Find the StageRole
+	stageRole, err := kargoapi.GetStageRole(ctx, r.client, req.NamespacedName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if stageRole == nil {
+		// Ignore if not found. This can happen if the StageRole was deleted after
+		// the current reconciliation request was issued.
+		return ctrl.Result{}, nil
+	}
+
+	if stageRole.DeletionTimestamp != nil {
+		logger.Debug("StageRole is being deleted")
+		if err = r.deleteRBAC(ctx, stageRole); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err = kargoapi.RemoveFinalizer(ctx, r.client, stageRole); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error removing finalizer: %w", err)
+		}
+		logger.Debug("done reconciling StageRole")
+		return ctrl.Result{}, nil
+	}
+
+	// The finalizer ensures the Role and RoleBinding are deleted along with the
+	// StageRole, even though they are also owned by it.
+	if _, err = kargoapi.EnsureFinalizer(ctx, r.client, stageRole); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error ensuring finalizer: %w", err)
+	}
+	if err = r.syncRBAC(ctx, stageRole); err != nil {
+		return ctrl.Result{}, err
+	}
+	logger.Debug("done reconciling StageRole")
+	return ctrl.Result{}, nil
+}
+
+// syncRBAC creates or updates the Role and RoleBinding that grant the subjects
+// of the provided StageRole permission to get and patch its Stage, and only
+// its Stage.
+func (r *reconciler) syncRBAC(ctx context.Context, stageRole *kargoapi.StageRole) error {
+	logger := logging.LoggerFromContext(ctx)
+	name := rbacObjectNamePrefix + stageRole.Name
+
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: stageRole.Namespace,
+			Name:      name,
+		},
+	}
+	result, err := controllerutil.CreateOrUpdate(ctx, r.client, role, func() error {
+		role.Rules = []rbacv1.PolicyRule{
+			{
+				APIGroups:     []string{kargoapi.GroupVersion.Group},
+				Resources:     []string{"stages"},
+				ResourceNames: []string{stageRole.Spec.Stage},
+				Verbs:         []string{"get", "patch"},
+			},
+		}
+		return controllerutil.SetControllerReference(stageRole, role, r.client.Scheme())
+	})
+	if err != nil {
+		return fmt.Errorf(
+			"error syncing Role %q in namespace %q: %w",
+			name,
+			stageRole.Namespace,
+			err,
+		)
+	}
+	logger.Debug("synced Role", "role", name, "result", result)
+
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: stageRole.Namespace,
+			Name:      name,
+		},
+	}
+	result, err = controllerutil.CreateOrUpdate(ctx, r.client, roleBinding, func() error {
+		roleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     name,
+		}
+		roleBinding.Subjects = stageRole.Spec.Subjects
+		return controllerutil.SetControllerReference(stageRole, roleBinding, r.client.Scheme())
+	})
+	if err != nil {
+		return fmt.Errorf(
+			"error syncing RoleBinding %q in namespace %q: %w",
+			name,
+			stageRole.Namespace,
+			err,
+		)
+	}
+	logger.Debug("synced RoleBinding", "roleBinding", name, "result", result)
+
+	return nil
+}
+
+// deleteRBAC deletes the Role and RoleBinding of the provided StageRole. Not
+// found errors are ignored to keep this idempotent.
+func (r *reconciler) deleteRBAC(ctx context.Context, stageRole *kargoapi.StageRole) error {
+	name := rbacObjectNamePrefix + stageRole.Name
+	objectMeta := metav1.ObjectMeta{
+		Namespace: stageRole.Namespace,
+		Name:      name,
+	}
+	if err := client.IgnoreNotFound(
+		r.client.Delete(ctx, &rbacv1.RoleBinding{ObjectMeta: objectMeta}),
+	); err != nil {
+		return fmt.Errorf(
+			"error deleting RoleBinding %q in namespace %q: %w",
+			name,
+			stageRole.Namespace,
+			err,
+		)
+	}
+	if err := client.IgnoreNotFound(
+		r.client.Delete(ctx, &rbacv1.Role{ObjectMeta: objectMeta}),
+	); err != nil {
+		return fmt.Errorf(
+			"error deleting Role %q in namespace %q: %w",
+			name,
+			stageRole.Namespace,
+			err,
+		)
+	}
+	logging.LoggerFromContext(ctx).Debug("deleted Role and RoleBinding", "name", name)
+	return nil
+}
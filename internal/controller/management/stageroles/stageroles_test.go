@@ -0,0 +1,203 @@
+package stageroles
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewReconciler(t *testing.T) {
+	r := newReconciler(fake.NewClientBuilder().Build())
+	require.NotNil(t, r.client)
+}
+
+func TestReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	require.NoError(t, rbacv1.AddToScheme(scheme))
+
+	testStageRole := &kargoapi.StageRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage-role",
+		},
+		Spec: kargoapi.StageRoleSpec{
+			Stage: "fake-stage",
+			Subjects: []rbacv1.Subject{
+				{
+					Kind:     rbacv1.UserKind,
+					APIGroup: rbacv1.GroupName,
+					Name:     "fake-user",
+				},
+			},
+		},
+	}
+	rbacObjectKey := types.NamespacedName{
+		Namespace: "fake-namespace",
+		Name:      "kargo-stagerole-fake-stage-role",
+	}
+
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		assertions func(*testing.T, client.Client, ctrl.Result, error)
+	}{
+		{
+			name: "StageRole not found",
+			assertions: func(t *testing.T, _ client.Client, result ctrl.Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, ctrl.Result{}, result)
+			},
+		},
+		{
+			name:    "creates Role and RoleBinding",
+			objects: []client.Object{testStageRole.DeepCopy()},
+			assertions: func(t *testing.T, c client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+
+				stageRole := &kargoapi.StageRole{}
+				require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(testStageRole), stageRole))
+				require.Contains(t, stageRole.Finalizers, kargoapi.FinalizerName)
+
+				role := &rbacv1.Role{}
+				require.NoError(t, c.Get(context.Background(), rbacObjectKey, role))
+				require.Equal(
+					t,
+					[]rbacv1.PolicyRule{
+						{
+							APIGroups:     []string{kargoapi.GroupVersion.Group},
+							Resources:     []string{"stages"},
+							ResourceNames: []string{"fake-stage"},
+							Verbs:         []string{"get", "patch"},
+						},
+					},
+					role.Rules,
+				)
+				require.Len(t, role.OwnerReferences, 1)
+				require.Equal(t, "fake-stage-role", role.OwnerReferences[0].Name)
+
+				roleBinding := &rbacv1.RoleBinding{}
+				require.NoError(t, c.Get(context.Background(), rbacObjectKey, roleBinding))
+				require.Equal(t, "Role", roleBinding.RoleRef.Kind)
+				require.Equal(t, rbacObjectKey.Name, roleBinding.RoleRef.Name)
+				require.Equal(t, testStageRole.Spec.Subjects, roleBinding.Subjects)
+				require.Len(t, roleBinding.OwnerReferences, 1)
+			},
+		},
+		{
+			name: "updates stale Role and RoleBinding",
+			objects: []client.Object{
+				testStageRole.DeepCopy(),
+				&rbacv1.Role{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: rbacObjectKey.Namespace,
+						Name:      rbacObjectKey.Name,
+					},
+					Rules: []rbacv1.PolicyRule{
+						{
+							APIGroups:     []string{kargoapi.GroupVersion.Group},
+							Resources:     []string{"stages"},
+							ResourceNames: []string{"other-stage"},
+							Verbs:         []string{"*"},
+						},
+					},
+				},
+				&rbacv1.RoleBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: rbacObjectKey.Namespace,
+						Name:      rbacObjectKey.Name,
+					},
+					RoleRef: rbacv1.RoleRef{
+						APIGroup: rbacv1.GroupName,
+						Kind:     "Role",
+						Name:     rbacObjectKey.Name,
+					},
+					Subjects: []rbacv1.Subject{
+						{
+							Kind:     rbacv1.UserKind,
+							APIGroup: rbacv1.GroupName,
+							Name:     "other-user",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+
+				role := &rbacv1.Role{}
+				require.NoError(t, c.Get(context.Background(), rbacObjectKey, role))
+				require.Len(t, role.Rules, 1)
+				require.Equal(t, []string{"fake-stage"}, role.Rules[0].ResourceNames)
+				require.Equal(t, []string{"get", "patch"}, role.Rules[0].Verbs)
+
+				roleBinding := &rbacv1.RoleBinding{}
+				require.NoError(t, c.Get(context.Background(), rbacObjectKey, roleBinding))
+				require.Equal(t, testStageRole.Spec.Subjects, roleBinding.Subjects)
+			},
+		},
+		{
+			name: "deletes Role and RoleBinding when StageRole is deleted",
+			objects: []client.Object{
+				func() client.Object {
+					stageRole := testStageRole.DeepCopy()
+					stageRole.Finalizers = []string{kargoapi.FinalizerName}
+					now := metav1.Now()
+					stageRole.DeletionTimestamp = &now
+					return stageRole
+				}(),
+				&rbacv1.Role{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: rbacObjectKey.Namespace,
+						Name:      rbacObjectKey.Name,
+					},
+				},
+				&rbacv1.RoleBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: rbacObjectKey.Namespace,
+						Name:      rbacObjectKey.Name,
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, _ ctrl.Result, err error) {
+				require.NoError(t, err)
+
+				err = c.Get(context.Background(), rbacObjectKey, &rbacv1.Role{})
+				require.True(t, apierrors.IsNotFound(err))
+				err = c.Get(context.Background(), rbacObjectKey, &rbacv1.RoleBinding{})
+				require.True(t, apierrors.IsNotFound(err))
+
+				// With its finalizer removed, the StageRole itself is gone
+				err = c.Get(
+					context.Background(),
+					client.ObjectKeyFromObject(testStageRole),
+					&kargoapi.StageRole{},
+				)
+				require.True(t, apierrors.IsNotFound(err))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				Build()
+			result, err := newReconciler(c).Reconcile(
+				context.Background(),
+				ctrl.Request{NamespacedName: client.ObjectKeyFromObject(testStageRole)},
+			)
+			testCase.assertions(t, c, result, err)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"strings"
+
+	"github.com/kelseyhightower/envconfig"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// WatchedNamespaces describes the namespaces whose resources a controller is
+// responsible for. This permits multiple controllers in a shared cluster to
+// each manage the resources of a distinct set of namespaces. When neither of
+// its fields is set, resources in all namespaces are watched.
+type WatchedNamespaces struct {
+	// Namespaces lists namespaces whose resources are watched.
+	Namespaces []string `envconfig:"WATCHED_NAMESPACES" default:""`
+	// NamespacePrefix is a prefix of the names of namespaces whose resources
+	// are watched.
+	NamespacePrefix string `envconfig:"WATCHED_NAMESPACE_PREFIX"`
+}
+
+// WatchedNamespacesFromEnv returns a WatchedNamespaces populated from the
+// WATCHED_NAMESPACES and WATCHED_NAMESPACE_PREFIX environment variables.
+func WatchedNamespacesFromEnv() WatchedNamespaces {
+	w := WatchedNamespaces{}
+	envconfig.MustProcess("", &w)
+	return w
+}
+
+// IsWatched returns true if resources in the specified namespace are watched,
+// which is the case if the namespace is listed, if its name begins with the
+// watched prefix, or if no namespaces or prefix are specified at all.
+func (w WatchedNamespaces) IsWatched(namespace string) bool {
+	names := w.names()
+	if len(names) == 0 && w.NamespacePrefix == "" {
+		return true
+	}
+	for _, name := range names {
+		if name == namespace {
+			return true
+		}
+	}
+	return w.NamespacePrefix != "" &&
+		strings.HasPrefix(namespace, w.NamespacePrefix)
+}
+
+// CacheNamespaces returns the namespaces that a manager's cache may be
+// restricted to, suitable for use as cache.Options.DefaultNamespaces. Because
+// a cache cannot be restricted to namespaces matching a prefix, nil, meaning
+// all namespaces, is returned unless namespaces are listed and no prefix is
+// specified.
+func (w WatchedNamespaces) CacheNamespaces() map[string]cache.Config {
+	names := w.names()
+	if len(names) == 0 || w.NamespacePrefix != "" {
+		return nil
+	}
+	namespaces := make(map[string]cache.Config, len(names))
+	for _, name := range names {
+		namespaces[name] = cache.Config{}
+	}
+	return namespaces
+}
+
+// Predicate returns a predicate, used as an event filter for various
+// reconcilers, that matches ONLY resources in watched namespaces.
+func (w WatchedNamespaces) Predicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return w.IsWatched(obj.GetNamespace())
+	})
+}
+
+// names returns the listed namespaces, ignoring any that are empty.
+func (w WatchedNamespaces) names() []string {
+	names := make([]string, 0, len(w.Namespaces))
+	for _, name := range w.Namespaces {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
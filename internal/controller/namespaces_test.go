@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestWatchedNamespacesIsWatched(t *testing.T) {
+	testCases := []struct {
+		name      string
+		watched   WatchedNamespaces
+		namespace string
+		expected  bool
+	}{
+		{
+			name:      "nothing specified",
+			watched:   WatchedNamespaces{},
+			namespace: "fake-namespace",
+			expected:  true,
+		},
+		{
+			name:      "only empty namespaces listed",
+			watched:   WatchedNamespaces{Namespaces: []string{""}},
+			namespace: "fake-namespace",
+			expected:  true,
+		},
+		{
+			name:      "namespace listed",
+			watched:   WatchedNamespaces{Namespaces: []string{"team-a", "team-b"}},
+			namespace: "team-b",
+			expected:  true,
+		},
+		{
+			name:      "namespace not listed",
+			watched:   WatchedNamespaces{Namespaces: []string{"team-a", "team-b"}},
+			namespace: "team-c",
+			expected:  false,
+		},
+		{
+			name:      "namespace matches prefix",
+			watched:   WatchedNamespaces{NamespacePrefix: "team-a-"},
+			namespace: "team-a-dev",
+			expected:  true,
+		},
+		{
+			name:      "namespace does not match prefix",
+			watched:   WatchedNamespaces{NamespacePrefix: "team-a-"},
+			namespace: "team-b-dev",
+			expected:  false,
+		},
+		{
+			name: "namespace listed but does not match prefix",
+			watched: WatchedNamespaces{
+				Namespaces:      []string{"shared"},
+				NamespacePrefix: "team-a-",
+			},
+			namespace: "shared",
+			expected:  true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.watched.IsWatched(testCase.namespace))
+		})
+	}
+}
+
+func TestWatchedNamespacesCacheNamespaces(t *testing.T) {
+	testCases := []struct {
+		name     string
+		watched  WatchedNamespaces
+		expected map[string]cache.Config
+	}{
+		{
+			name:    "nothing specified",
+			watched: WatchedNamespaces{},
+		},
+		{
+			name:    "prefix specified",
+			watched: WatchedNamespaces{NamespacePrefix: "team-a-"},
+		},
+		{
+			name: "namespaces listed and prefix specified",
+			watched: WatchedNamespaces{
+				Namespaces:      []string{"shared"},
+				NamespacePrefix: "team-a-",
+			},
+		},
+		{
+			name:    "namespaces listed",
+			watched: WatchedNamespaces{Namespaces: []string{"team-a", " team-b ", ""}},
+			expected: map[string]cache.Config{
+				"team-a": {},
+				"team-b": {},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.watched.CacheNamespaces())
+		})
+	}
+}
+
+func TestWatchedNamespacesPredicate(t *testing.T) {
+	newStageEvent := func(namespace string) event.CreateEvent {
+		return event.CreateEvent{
+			Object: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "fake-stage",
+				},
+			},
+		}
+	}
+	pred := WatchedNamespaces{
+		Namespaces:      []string{"shared"},
+		NamespacePrefix: "team-a-",
+	}.Predicate()
+	require.True(t, pred.Create(newStageEvent("shared")))
+	require.True(t, pred.Create(newStageEvent("team-a-dev")))
+	require.False(t, pred.Create(newStageEvent("team-b-dev")))
+}
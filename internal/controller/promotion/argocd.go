@@ -30,8 +30,9 @@ const (
 // argoCDMechanism is an implementation of the Mechanism interface that updates
 // Argo CD Application resources.
 type argoCDMechanism struct {
-	kargoClient  client.Client
-	argocdClient client.Client
+	kargoClient   client.Client
+	argocdClient  client.Client
+	remoteClients libargocd.RemoteClients
 	// These behaviors are overridable for testing purposes:
 	buildDesiredSourcesFn func(
 		context.Context,
@@ -51,16 +52,32 @@ type argoCDMechanism struct {
 	) (argocd.OperationPhase, bool, error)
 	updateApplicationSourcesFn func(
 		context.Context,
+		client.Client,
 		*argocd.Application,
 		*argocd.ApplicationSource,
 		argocd.ApplicationSources,
 	) error
 	getAuthorizedApplicationFn func(
 		ctx context.Context,
+		argocdClient client.Client,
 		namespace string,
 		name string,
 		stageMeta metav1.ObjectMeta,
 	) (*argocd.Application, error)
+	getAuthorizedApplicationSetFn func(
+		ctx context.Context,
+		argocdClient client.Client,
+		namespace string,
+		name string,
+		stageMeta metav1.ObjectMeta,
+	) (*argocd.ApplicationSet, error)
+	updateApplicationSetSourcesFn func(
+		context.Context,
+		client.Client,
+		*argocd.ApplicationSet,
+		*argocd.ApplicationSource,
+		argocd.ApplicationSources,
+	) error
 	applyArgoCDSourceUpdateFn func(
 		context.Context,
 		*kargoapi.Stage,
@@ -68,31 +85,38 @@ type argoCDMechanism struct {
 		argocd.ApplicationSource,
 		[]kargoapi.FreightReference,
 	) (argocd.ApplicationSource, error)
-	argoCDAppPatchFn func(
-		context.Context,
-		client.Object,
-		client.Patch,
-		...client.PatchOption,
-	) error
-	logAppEventFn func(ctx context.Context, app *argocd.Application, user, reason, message string)
+	logAppEventFn func(
+		ctx context.Context,
+		argocdClient client.Client,
+		app *argocd.Application,
+		user string,
+		reason string,
+		message string,
+	)
 }
 
 // newArgoCDMechanism returns an implementation of the Mechanism interface that
-// updates Argo CD Application resources.
-func newArgoCDMechanism(kargoClient, argocdClient client.Client) Mechanism {
+// updates Argo CD Application resources. Applications managed by Argo CD
+// instances in named remote clusters are updated using clients obtained from
+// the provided RemoteClients.
+func newArgoCDMechanism(
+	kargoClient client.Client,
+	argocdClient client.Client,
+	remoteClients libargocd.RemoteClients,
+) Mechanism {
 	a := &argoCDMechanism{
-		kargoClient:  kargoClient,
-		argocdClient: argocdClient,
+		kargoClient:   kargoClient,
+		argocdClient:  argocdClient,
+		remoteClients: remoteClients,
 	}
 	a.buildDesiredSourcesFn = a.buildDesiredSources
 	a.mustPerformUpdateFn = a.mustPerformUpdate
 	a.updateApplicationSourcesFn = a.updateApplicationSources
 	a.getAuthorizedApplicationFn = a.getAuthorizedApplication
+	a.getAuthorizedApplicationSetFn = a.getAuthorizedApplicationSet
+	a.updateApplicationSetSourcesFn = a.updateApplicationSetSources
 	a.applyArgoCDSourceUpdateFn = a.applyArgoCDSourceUpdate
-	if argocdClient != nil {
-		a.argoCDAppPatchFn = argocdClient.Patch
-		a.logAppEventFn = a.logAppEvent
-	}
+	a.logAppEventFn = a.logAppEvent
 	return a
 }
 
@@ -115,11 +139,15 @@ func (a *argoCDMechanism) Promote(
 	}
 
 	if a.argocdClient == nil {
-		return promo.Status.WithPhase(kargoapi.PromotionPhaseFailed), newFreight,
-			errors.New(
-				"Argo CD integration is disabled on this controller; cannot perform " +
-					"promotion",
-			)
+		for _, update := range updates {
+			if update.ArgoCDClusterName == "" {
+				return promo.Status.WithPhase(kargoapi.PromotionPhaseFailed), newFreight,
+					errors.New(
+						"Argo CD integration is disabled on this controller; cannot perform " +
+							"promotion",
+					)
+			}
+		}
 	}
 
 	logger := logging.LoggerFromContext(ctx)
@@ -129,8 +157,31 @@ func (a *argoCDMechanism) Promote(
 	var newStatus = promo.Status.DeepCopy()
 	for i := range updates {
 		update := &updates[i]
+		// Select the client for the Argo CD instance managing the Application.
+		argocdClient, err := a.getArgoCDClient(ctx, stage.Namespace, update)
+		if err != nil {
+			return nil, newFreight, err
+		}
+
+		// ApplicationSets are updated by changing the template their
+		// Applications are generated from.
+		if update.ResourceType == kargoapi.ArgoCDResourceTypeApplicationSet {
+			phase, err := a.promoteApplicationSet(ctx, argocdClient, stage, update, newFreight)
+			if err != nil {
+				return nil, newFreight, err
+			}
+			updateResults = append(updateResults, phase)
+			continue
+		}
+
 		// Retrieve the Argo CD Application.
-		app, err := a.getAuthorizedApplicationFn(ctx, update.AppNamespace, update.AppName, stage.ObjectMeta)
+		app, err := a.getAuthorizedApplicationFn(
+			ctx,
+			argocdClient,
+			update.AppNamespace,
+			update.AppName,
+			stage.ObjectMeta,
+		)
 		if err != nil {
 			return nil, newFreight, err
 		}
@@ -175,6 +226,18 @@ func (a *argoCDMechanism) Promote(
 				// Log the error as a warning, but continue to the next update.
 				logger.Info(err.Error())
 			}
+			if phase != "" && !phase.Completed() && syncTimedOut(update, app.Status.OperationState) {
+				// We have waited for the operation for as long as we are permitted
+				// to. Treat it as failed.
+				newStatus.Message = fmt.Sprintf(
+					"sync of Argo CD Application %q in namespace %q did not complete within %s",
+					app.Name,
+					app.Namespace,
+					update.SyncTimeout.Duration,
+				)
+				updateResults = append(updateResults, argocd.OperationFailed)
+				break
+			}
 			if phase.Failed() {
 				// Record the reason for the failure if available.
 				if app.Status.OperationState != nil {
@@ -194,8 +257,24 @@ func (a *argoCDMechanism) Promote(
 			continue
 		}
 
+		if stage.Spec.DryRun {
+			logger.Info(
+				"dry run: not updating Argo CD Application",
+				"app", app.Name,
+				"appNamespace", app.Namespace,
+			)
+			updateResults = append(updateResults, argocd.OperationSucceeded)
+			continue
+		}
+
 		// Perform the update.
-		if err := a.updateApplicationSourcesFn(ctx, app, desiredSource, desiredSources); err != nil {
+		if err := a.updateApplicationSourcesFn(
+			ctx,
+			argocdClient,
+			app,
+			desiredSource,
+			desiredSources,
+		); err != nil {
 			return nil, newFreight, err
 		}
 		// As we have initiated an update, we should wait for it to complete.
@@ -215,6 +294,28 @@ func (a *argoCDMechanism) Promote(
 	return newStatus, newFreight, nil
 }
 
+// getArgoCDClient returns the client for the Argo CD instance managing the
+// Application referenced by the provided update. Updates that do not name a
+// cluster are applied using the client for the Argo CD instance this
+// controller is configured to integrate with.
+func (a *argoCDMechanism) getArgoCDClient(
+	ctx context.Context,
+	project string,
+	update *kargoapi.ArgoCDAppUpdate,
+) (client.Client, error) {
+	if update.ArgoCDClusterName == "" {
+		return a.argocdClient, nil
+	}
+	if a.remoteClients == nil {
+		return nil, fmt.Errorf(
+			"Argo CD cluster %q cannot be used; remote Argo CD clusters are not "+
+				"supported by this controller",
+			update.ArgoCDClusterName,
+		)
+	}
+	return a.remoteClients.Get(ctx, project, update.ArgoCDClusterName)
+}
+
 // buildDesiredSources returns the desired source(s) for an Argo CD Application,
 // by updating the current source(s) with the given source updates.
 func (a *argoCDMechanism) buildDesiredSources(
@@ -224,19 +325,69 @@ func (a *argoCDMechanism) buildDesiredSources(
 	app *argocd.Application,
 	newFreight []kargoapi.FreightReference,
 ) (*argocd.ApplicationSource, argocd.ApplicationSources, error) {
-	desiredSource, desiredSources := app.Spec.Source.DeepCopy(), app.Spec.Sources.DeepCopy()
+	desiredSource, desiredSources, err := a.applySourceUpdates(
+		ctx,
+		stage,
+		update,
+		&app.Spec,
+		newFreight,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"error applying source update to Argo CD Application %q in namespace %q: %w",
+			update.AppName,
+			app.Namespace,
+			err,
+		)
+	}
+	return desiredSource, desiredSources, nil
+}
+
+// applySourceUpdates returns the source(s) of the provided ApplicationSpec
+// updated with the source updates of the provided ArgoCDAppUpdate. If the
+// ArgoCDAppUpdate specifies a source index, only the source at that index of
+// the ApplicationSpec's sources is updated. The ApplicationSpec itself is not
+// modified.
+func (a *argoCDMechanism) applySourceUpdates(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	update *kargoapi.ArgoCDAppUpdate,
+	spec *argocd.ApplicationSpec,
+	newFreight []kargoapi.FreightReference,
+) (*argocd.ApplicationSource, argocd.ApplicationSources, error) {
+	desiredSource, desiredSources := spec.Source.DeepCopy(), spec.Sources.DeepCopy()
+
+	if update.SourceIndex != nil {
+		idx := int(*update.SourceIndex)
+		if idx < 0 || idx >= len(desiredSources) {
+			return nil, nil, fmt.Errorf(
+				"source index %d is out of bounds; Argo CD Application has %d source(s)",
+				idx,
+				len(desiredSources),
+			)
+		}
+		for i := range update.SourceUpdates {
+			newSrc, err := a.applyArgoCDSourceUpdateFn(
+				ctx,
+				stage,
+				&update.SourceUpdates[i],
+				desiredSources[idx],
+				newFreight,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+			desiredSources[idx] = newSrc
+		}
+		return desiredSource, desiredSources, nil
+	}
 
 	for i := range update.SourceUpdates {
 		srcUpdate := &update.SourceUpdates[i]
 		if desiredSource != nil {
 			newSrc, err := a.applyArgoCDSourceUpdateFn(ctx, stage, srcUpdate, *desiredSource, newFreight)
 			if err != nil {
-				return nil, nil, fmt.Errorf(
-					"error applying source update to Argo CD Application %q in namespace %q: %w",
-					update.AppName,
-					app.Namespace,
-					err,
-				)
+				return nil, nil, err
 			}
 			desiredSource = &newSrc
 		}
@@ -244,12 +395,7 @@ func (a *argoCDMechanism) buildDesiredSources(
 		for j, curSrc := range desiredSources {
 			newSrc, err := a.applyArgoCDSourceUpdateFn(ctx, stage, srcUpdate, curSrc, newFreight)
 			if err != nil {
-				return nil, nil, fmt.Errorf(
-					"error applying source update to Argo CD Application %q in namespace %q: %w",
-					update.AppName,
-					app.Namespace,
-					err,
-				)
+				return nil, nil, err
 			}
 			desiredSources[j] = newSrc
 		}
@@ -258,6 +404,73 @@ func (a *argoCDMechanism) buildDesiredSources(
 	return desiredSource, desiredSources, nil
 }
 
+// promoteApplicationSet applies the source updates of the provided
+// ArgoCDAppUpdate to the Application template of the ApplicationSet it
+// references. The Argo CD ApplicationSet controller propagates the updated
+// template to all Applications generated from it, so there is no operation for
+// Kargo to wait on and the returned phase is Succeeded once the template has
+// been patched.
+func (a *argoCDMechanism) promoteApplicationSet(
+	ctx context.Context,
+	argocdClient client.Client,
+	stage *kargoapi.Stage,
+	update *kargoapi.ArgoCDAppUpdate,
+	newFreight []kargoapi.FreightReference,
+) (argocd.OperationPhase, error) {
+	appSet, err := a.getAuthorizedApplicationSetFn(
+		ctx,
+		argocdClient,
+		update.AppNamespace,
+		update.AppName,
+		stage.ObjectMeta,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	template := &appSet.Spec.Template.Spec
+	desiredSource, desiredSources, err := a.applySourceUpdates(
+		ctx,
+		stage,
+		update,
+		template,
+		newFreight,
+	)
+	if err != nil {
+		return "", fmt.Errorf(
+			"error applying source update to Argo CD ApplicationSet %q in namespace %q: %w",
+			appSet.Name,
+			appSet.Namespace,
+			err,
+		)
+	}
+
+	if desiredSource.Equals(template.Source) && desiredSources.Equals(template.Sources) {
+		// The template is already up to date.
+		return argocd.OperationSucceeded, nil
+	}
+
+	if stage.Spec.DryRun {
+		logging.LoggerFromContext(ctx).Info(
+			"dry run: not updating Argo CD ApplicationSet",
+			"appSet", appSet.Name,
+			"appSetNamespace", appSet.Namespace,
+		)
+		return argocd.OperationSucceeded, nil
+	}
+
+	if err = a.updateApplicationSetSourcesFn(
+		ctx,
+		argocdClient,
+		appSet,
+		desiredSource,
+		desiredSources,
+	); err != nil {
+		return "", err
+	}
+	return argocd.OperationSucceeded, nil
+}
+
 func (a *argoCDMechanism) mustPerformUpdate(
 	ctx context.Context,
 	stage *kargoapi.Stage,
@@ -335,8 +548,18 @@ func (a *argoCDMechanism) mustPerformUpdate(
 	return status.Phase, false, nil
 }
 
+// syncTimedOut returns true if the provided ArgoCDAppUpdate specifies a
+// SyncTimeout and the provided operation has been running for longer than it.
+func syncTimedOut(update *kargoapi.ArgoCDAppUpdate, status *argocd.OperationState) bool {
+	if update.SyncTimeout == nil || status == nil || status.StartedAt.IsZero() {
+		return false
+	}
+	return time.Since(status.StartedAt.Time) > update.SyncTimeout.Duration
+}
+
 func (a *argoCDMechanism) updateApplicationSources(
 	ctx context.Context,
+	argocdClient client.Client,
 	app *argocd.Application,
 	desiredSource *argocd.ApplicationSource,
 	desiredSources argocd.ApplicationSources,
@@ -383,7 +606,7 @@ func (a *argoCDMechanism) updateApplicationSources(
 	}
 
 	// Patch the Application with the changes from above.
-	if err := a.argoCDAppPatchFn(
+	if err := argocdClient.Patch(
 		ctx,
 		app,
 		patch,
@@ -409,12 +632,48 @@ func (a *argoCDMechanism) updateApplicationSources(
 	if app.Spec.Source != nil {
 		message += " to " + app.Spec.Source.TargetRevision
 	}
-	a.logAppEventFn(ctx, app, "kargo-controller", argocd.EventReasonOperationStarted, message)
+	a.logAppEventFn(
+		ctx,
+		argocdClient,
+		app,
+		"kargo-controller",
+		argocd.EventReasonOperationStarted,
+		message,
+	)
+
+	return nil
+}
 
+// updateApplicationSetSources patches the source(s) of the Application
+// template of the provided ApplicationSet.
+func (a *argoCDMechanism) updateApplicationSetSources(
+	ctx context.Context,
+	argocdClient client.Client,
+	appSet *argocd.ApplicationSet,
+	desiredSource *argocd.ApplicationSource,
+	desiredSources argocd.ApplicationSources,
+) error {
+	patch := client.MergeFrom(appSet.DeepCopy())
+	appSet.Spec.Template.Spec.Source = desiredSource.DeepCopy()
+	appSet.Spec.Template.Spec.Sources = desiredSources.DeepCopy()
+	if err := argocdClient.Patch(ctx, appSet, patch); err != nil {
+		return fmt.Errorf("error patching Argo CD ApplicationSet %q: %w", appSet.Name, err)
+	}
+	logging.LoggerFromContext(ctx).Debug(
+		"patched Argo CD ApplicationSet",
+		"appSet", appSet.Name,
+	)
 	return nil
 }
 
-func (a *argoCDMechanism) logAppEvent(ctx context.Context, app *argocd.Application, user, reason, message string) {
+func (a *argoCDMechanism) logAppEvent(
+	ctx context.Context,
+	argocdClient client.Client,
+	app *argocd.Application,
+	user string,
+	reason string,
+	message string,
+) {
 	logger := logging.LoggerFromContext(ctx).WithValues("app", app.Name)
 
 	// xref: https://github.com/argoproj/argo-cd/blob/44894e9e438bca5adccf58d2f904adc63365805c/server/application/application.go#L2145-L2147
@@ -454,7 +713,7 @@ func (a *argoCDMechanism) logAppEvent(ctx context.Context, app *argocd.Applicati
 		Type:    corev1.EventTypeNormal,
 		Reason:  reason,
 	}
-	if err := a.argocdClient.Create(context.Background(), &event); err != nil {
+	if err := argocdClient.Create(context.Background(), &event); err != nil {
 		logger.Error(
 			err, "unable to create event for Argo CD Application",
 			"reason", reason,
@@ -467,6 +726,7 @@ func (a *argoCDMechanism) logAppEvent(ctx context.Context, app *argocd.Applicati
 // represented by stageMeta.
 func (a *argoCDMechanism) getAuthorizedApplication(
 	ctx context.Context,
+	argocdClient client.Client,
 	namespace string,
 	name string,
 	stageMeta metav1.ObjectMeta,
@@ -475,7 +735,7 @@ func (a *argoCDMechanism) getAuthorizedApplication(
 		namespace = libargocd.Namespace()
 	}
 
-	app, err := argocd.GetApplication(ctx, a.argocdClient, namespace, name)
+	app, err := argocd.GetApplication(ctx, argocdClient, namespace, name)
 	if err != nil {
 		return nil, fmt.Errorf("error finding Argo CD Application %q in namespace %q: %w", name, namespace, err)
 	}
@@ -490,54 +750,104 @@ func (a *argoCDMechanism) getAuthorizedApplication(
 	return app, nil
 }
 
+// getAuthorizedApplicationSet returns an Argo CD ApplicationSet in the given
+// namespace with the given name, if it is authorized for mutation by the Kargo
+// Stage represented by stageMeta.
+func (a *argoCDMechanism) getAuthorizedApplicationSet(
+	ctx context.Context,
+	argocdClient client.Client,
+	namespace string,
+	name string,
+	stageMeta metav1.ObjectMeta,
+) (*argocd.ApplicationSet, error) {
+	if namespace == "" {
+		namespace = libargocd.Namespace()
+	}
+
+	appSet, err := argocd.GetApplicationSet(ctx, argocdClient, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error finding Argo CD ApplicationSet %q in namespace %q: %w",
+			name, namespace, err,
+		)
+	}
+	if appSet == nil {
+		return nil, fmt.Errorf(
+			"unable to find Argo CD ApplicationSet %q in namespace %q",
+			name, namespace,
+		)
+	}
+
+	if err = authorizeArgoCDAppUpdate(stageMeta, appSet.ObjectMeta); err != nil {
+		return nil, err
+	}
+
+	return appSet, nil
+}
+
 // authorizeArgoCDAppUpdate returns an error if the Argo CD Application
 // represented by appMeta does not explicitly permit mutation by the Kargo Stage
 // represented by stageMeta.
 func authorizeArgoCDAppUpdate(
 	stageMeta metav1.ObjectMeta,
 	appMeta metav1.ObjectMeta,
+) error {
+	return authorizeStageMutation("Argo CD Application", stageMeta, appMeta)
+}
+
+// authorizeStageMutation returns an error if the resource of the given kind
+// represented by objMeta does not explicitly permit mutation by the Kargo Stage
+// represented by stageMeta.
+func authorizeStageMutation(
+	kind string,
+	stageMeta metav1.ObjectMeta,
+	objMeta metav1.ObjectMeta,
 ) error {
 	permErr := fmt.Errorf(
-		"Argo CD Application %q in namespace %q does not permit mutation by "+
+		"%s %q in namespace %q does not permit mutation by "+
 			"Kargo Stage %s in namespace %s",
-		appMeta.Name,
-		appMeta.Namespace,
+		kind,
+		objMeta.Name,
+		objMeta.Namespace,
 		stageMeta.Name,
 		stageMeta.Namespace,
 	)
-	if appMeta.Annotations == nil {
+	if objMeta.Annotations == nil {
 		return permErr
 	}
-	allowedStage, ok := appMeta.Annotations[authorizedStageAnnotationKey]
+	allowedStage, ok := objMeta.Annotations[authorizedStageAnnotationKey]
 	if !ok {
 		return permErr
 	}
 	tokens := strings.SplitN(allowedStage, ":", 2)
 	if len(tokens) != 2 {
 		return fmt.Errorf(
-			"unable to parse value of annotation %q (%q) on Argo CD Application "+
+			"unable to parse value of annotation %q (%q) on %s "+
 				"%q in namespace %q",
 			authorizedStageAnnotationKey,
 			allowedStage,
-			appMeta.Name,
-			appMeta.Namespace,
+			kind,
+			objMeta.Name,
+			objMeta.Namespace,
 		)
 	}
 	allowedNamespaceGlob, err := glob.Compile(tokens[0])
 	if err != nil {
 		return fmt.Errorf(
-			"Argo CD Application %q in namespace %q has invalid glob expression: %q",
-			appMeta.Name,
-			appMeta.Namespace,
+			"%s %q in namespace %q has invalid glob expression: %q",
+			kind,
+			objMeta.Name,
+			objMeta.Namespace,
 			tokens[0],
 		)
 	}
 	allowedNameGlob, err := glob.Compile(tokens[1])
 	if err != nil {
 		return fmt.Errorf(
-			"Argo CD Application %q in namespace %q has invalid glob expression: %q",
-			appMeta.Name,
-			appMeta.Namespace,
+			"%s %q in namespace %q has invalid glob expression: %q",
+			kind,
+			objMeta.Name,
+			objMeta.Namespace,
 			tokens[1],
 		)
 	}
@@ -698,6 +1008,9 @@ func (a *argoCDMechanism) buildKustomizeImagesForArgoCDAppSource(
 		}
 		var kustomizeImageStr string
 		if imageUpdate.UseDigest {
+			if image.Digest == "" {
+				return nil, errImageWithoutDigest(image)
+			}
 			kustomizeImageStr =
 				fmt.Sprintf("%s=%s@%s", imageUpdate.Image, imageUpdate.Image, image.Digest)
 		} else {
@@ -746,6 +1059,11 @@ func (a *argoCDMechanism) buildHelmParamChangesForArgoCDAppSource(
 		if image == nil {
 			continue
 		}
+		if image.Digest == "" &&
+			(imageUpdate.Value == kargoapi.ImageUpdateValueTypeImageAndDigest ||
+				imageUpdate.Value == kargoapi.ImageUpdateValueTypeDigest) {
+			return nil, errImageWithoutDigest(image)
+		}
 		switch imageUpdate.Value {
 		case kargoapi.ImageUpdateValueTypeImageAndTag:
 			changes[imageUpdate.Key] = fmt.Sprintf("%s:%s", imageUpdate.Image, image.Tag)
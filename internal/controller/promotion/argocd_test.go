@@ -5,12 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
@@ -18,22 +20,30 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	libargocd "github.com/akuity/kargo/internal/argocd"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/logging"
 )
 
 func TestNewArgoCDMechanism(t *testing.T) {
-	pm := newArgoCDMechanism(fake.NewFakeClient(), fake.NewFakeClient())
+	pm := newArgoCDMechanism(
+		fake.NewFakeClient(),
+		fake.NewFakeClient(),
+		libargocd.NewRemoteClients(&credentials.FakeDB{}),
+	)
 	apm, ok := pm.(*argoCDMechanism)
 	require.True(t, ok)
 	require.Equal(t, "Argo CD promotion mechanism", apm.GetName())
 	require.NotNil(t, apm.kargoClient)
 	require.NotNil(t, apm.argocdClient)
+	require.NotNil(t, apm.remoteClients)
 	require.NotNil(t, apm.buildDesiredSourcesFn)
 	require.NotNil(t, apm.mustPerformUpdateFn)
 	require.NotNil(t, apm.updateApplicationSourcesFn)
 	require.NotNil(t, apm.getAuthorizedApplicationFn)
+	require.NotNil(t, apm.getAuthorizedApplicationSetFn)
+	require.NotNil(t, apm.updateApplicationSetSourcesFn)
 	require.NotNil(t, apm.applyArgoCDSourceUpdateFn)
-	require.NotNil(t, apm.argoCDAppPatchFn)
+	require.NotNil(t, apm.logAppEventFn)
 }
 
 func TestArgoCDGetName(t *testing.T) {
@@ -41,6 +51,12 @@ func TestArgoCDGetName(t *testing.T) {
 }
 
 func TestArgoCDPromote(t *testing.T) {
+	var localArgoCDClient client.Client = fake.NewFakeClient()
+	remoteArgoCDClients := map[string]client.Client{
+		"cluster-a": fake.NewFakeClient(),
+		"cluster-b": fake.NewFakeClient(),
+	}
+
 	testCases := []struct {
 		name       string
 		promoMech  *argoCDMechanism
@@ -97,12 +113,125 @@ func TestArgoCDPromote(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "ApplicationSet template is updated",
+			promoMech: &argoCDMechanism{
+				argocdClient: fake.NewFakeClient(),
+				getAuthorizedApplicationFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					metav1.ObjectMeta,
+				) (*argocd.Application, error) {
+					return nil, errors.New("Application should not be retrieved")
+				},
+				getAuthorizedApplicationSetFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					metav1.ObjectMeta,
+				) (*argocd.ApplicationSet, error) {
+					return &argocd.ApplicationSet{
+						Spec: argocd.ApplicationSetSpec{
+							Template: argocd.ApplicationSetTemplate{
+								Spec: argocd.ApplicationSpec{
+									Source: &argocd.ApplicationSource{
+										RepoURL:        "https://github.com/universe/42",
+										TargetRevision: "v1.0.0",
+									},
+								},
+							},
+						},
+					}, nil
+				},
+				applyArgoCDSourceUpdateFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					_ *kargoapi.ArgoCDSourceUpdate,
+					source argocd.ApplicationSource,
+					_ []kargoapi.FreightReference,
+				) (argocd.ApplicationSource, error) {
+					source.TargetRevision = "v2.0.0"
+					return source, nil
+				},
+				updateApplicationSetSourcesFn: func(
+					_ context.Context,
+					_ client.Client,
+					_ *argocd.ApplicationSet,
+					desiredSource *argocd.ApplicationSource,
+					_ argocd.ApplicationSources,
+				) error {
+					if desiredSource.TargetRevision != "v2.0.0" {
+						return errors.New("unexpected desired source")
+					}
+					return nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{{
+							ResourceType: kargoapi.ArgoCDResourceTypeApplicationSet,
+							SourceUpdates: []kargoapi.ArgoCDSourceUpdate{{
+								RepoURL: "https://github.com/universe/42",
+							}},
+						}},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				newStatus *kargoapi.PromotionStatus,
+				_ []kargoapi.FreightReference,
+				_ []kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, newStatus.Phase)
+			},
+		},
+		{
+			name: "error retrieving authorized ApplicationSet",
+			promoMech: &argoCDMechanism{
+				argocdClient: fake.NewFakeClient(),
+				getAuthorizedApplicationSetFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					metav1.ObjectMeta,
+				) (*argocd.ApplicationSet, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{{
+							ResourceType: kargoapi.ArgoCDResourceTypeApplicationSet,
+						}},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *kargoapi.PromotionStatus,
+				_ []kargoapi.FreightReference,
+				_ []kargoapi.FreightReference,
+				err error,
+			) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
 		{
 			name: "error retrieving authorized application",
 			promoMech: &argoCDMechanism{
 				argocdClient: fake.NewFakeClient(),
 				getAuthorizedApplicationFn: func(
 					context.Context,
+					client.Client,
 					string,
 					string,
 					metav1.ObjectMeta,
@@ -136,6 +265,7 @@ func TestArgoCDPromote(t *testing.T) {
 				argocdClient: fake.NewFakeClient(),
 				getAuthorizedApplicationFn: func(
 					context.Context,
+					client.Client,
 					string,
 					string,
 					metav1.ObjectMeta,
@@ -178,6 +308,7 @@ func TestArgoCDPromote(t *testing.T) {
 				argocdClient: fake.NewFakeClient(),
 				getAuthorizedApplicationFn: func(
 					context.Context,
+					client.Client,
 					string,
 					string,
 					metav1.ObjectMeta,
@@ -231,6 +362,7 @@ func TestArgoCDPromote(t *testing.T) {
 				argocdClient: fake.NewFakeClient(),
 				getAuthorizedApplicationFn: func(
 					context.Context,
+					client.Client,
 					string,
 					string,
 					metav1.ObjectMeta,
@@ -259,6 +391,7 @@ func TestArgoCDPromote(t *testing.T) {
 				},
 				updateApplicationSourcesFn: func(
 					context.Context,
+					client.Client,
 					*argocd.Application,
 					*argocd.ApplicationSource,
 					argocd.ApplicationSources,
@@ -292,6 +425,7 @@ func TestArgoCDPromote(t *testing.T) {
 				argocdClient: fake.NewFakeClient(),
 				getAuthorizedApplicationFn: func(
 					context.Context,
+					client.Client,
 					string,
 					string,
 					metav1.ObjectMeta,
@@ -346,6 +480,7 @@ func TestArgoCDPromote(t *testing.T) {
 				argocdClient: fake.NewFakeClient(),
 				getAuthorizedApplicationFn: func(
 					context.Context,
+					client.Client,
 					string,
 					string,
 					metav1.ObjectMeta,
@@ -400,6 +535,7 @@ func TestArgoCDPromote(t *testing.T) {
 				argocdClient: fake.NewFakeClient(),
 				getAuthorizedApplicationFn: func(
 					context.Context,
+					client.Client,
 					string,
 					string,
 					metav1.ObjectMeta,
@@ -428,6 +564,7 @@ func TestArgoCDPromote(t *testing.T) {
 				},
 				updateApplicationSourcesFn: func(
 					context.Context,
+					client.Client,
 					*argocd.Application,
 					*argocd.ApplicationSource,
 					argocd.ApplicationSources,
@@ -460,12 +597,78 @@ func TestArgoCDPromote(t *testing.T) {
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
+		{
+			name: "dry run does not apply update",
+			promoMech: &argoCDMechanism{
+				argocdClient: fake.NewFakeClient(),
+				getAuthorizedApplicationFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					metav1.ObjectMeta,
+				) (*argocd.Application, error) {
+					return &argocd.Application{}, nil
+				},
+				buildDesiredSourcesFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.ArgoCDAppUpdate,
+					*argocd.Application,
+					[]kargoapi.FreightReference,
+				) (*argocd.ApplicationSource, argocd.ApplicationSources, error) {
+					return nil, nil, nil
+				},
+				mustPerformUpdateFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.ArgoCDAppUpdate,
+					*argocd.Application,
+					[]kargoapi.FreightReference,
+					*argocd.ApplicationSource,
+					argocd.ApplicationSources,
+				) (argocd.OperationPhase, bool, error) {
+					return "", true, nil
+				},
+				updateApplicationSourcesFn: func(
+					context.Context,
+					client.Client,
+					*argocd.Application,
+					*argocd.ApplicationSource,
+					argocd.ApplicationSources,
+				) error {
+					return errors.New("should not be called")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{},
+						},
+					},
+					DryRun: true,
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				newStatus *kargoapi.PromotionStatus,
+				newFreightIn []kargoapi.FreightReference,
+				newFreightOut []kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, newStatus.Phase)
+				require.Equal(t, newFreightIn, newFreightOut)
+			},
+		},
 		{
 			name: "failed and pending update",
 			promoMech: &argoCDMechanism{
 				argocdClient: fake.NewFakeClient(),
 				getAuthorizedApplicationFn: func(
 					context.Context,
+					client.Client,
 					string,
 					string,
 					metav1.ObjectMeta,
@@ -509,6 +712,7 @@ func TestArgoCDPromote(t *testing.T) {
 				}(),
 				updateApplicationSourcesFn: func(
 					context.Context,
+					client.Client,
 					*argocd.Application,
 					*argocd.ApplicationSource,
 					argocd.ApplicationSources,
@@ -544,6 +748,7 @@ func TestArgoCDPromote(t *testing.T) {
 				argocdClient: fake.NewFakeClient(),
 				getAuthorizedApplicationFn: func(
 					context.Context,
+					client.Client,
 					string,
 					string,
 					metav1.ObjectMeta,
@@ -597,6 +802,7 @@ func TestArgoCDPromote(t *testing.T) {
 				argocdClient: fake.NewFakeClient(),
 				getAuthorizedApplicationFn: func(
 					context.Context,
+					client.Client,
 					string,
 					string,
 					metav1.ObjectMeta,
@@ -645,6 +851,104 @@ func TestArgoCDPromote(t *testing.T) {
 				require.Equal(t, newFreightIn, newFreightOut)
 			},
 		},
+		{
+			name: "error getting client for remote cluster",
+			promoMech: &argoCDMechanism{
+				remoteClients: &libargocd.FakeRemoteClients{
+					GetFn: func(context.Context, string, string) (client.Client, error) {
+						return nil, errors.New("something went wrong")
+					},
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{ArgoCDClusterName: "fake-cluster"},
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *kargoapi.PromotionStatus,
+				newFreightIn []kargoapi.FreightReference,
+				newFreightOut []kargoapi.FreightReference,
+				err error,
+			) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.Equal(t, newFreightIn, newFreightOut)
+			},
+		},
+		{
+			name: "updates use client for their cluster",
+			promoMech: &argoCDMechanism{
+				argocdClient: localArgoCDClient,
+				remoteClients: &libargocd.FakeRemoteClients{
+					GetFn: func(_ context.Context, _, clusterName string) (client.Client, error) {
+						return remoteArgoCDClients[clusterName], nil
+					},
+				},
+				getAuthorizedApplicationFn: func(
+					_ context.Context,
+					c client.Client,
+					_ string,
+					name string,
+					_ metav1.ObjectMeta,
+				) (*argocd.Application, error) {
+					expected := localArgoCDClient
+					if name != "local-app" {
+						expected = remoteArgoCDClients[name]
+					}
+					if c != expected {
+						return nil, fmt.Errorf("unexpected client for Application %q", name)
+					}
+					return &argocd.Application{}, nil
+				},
+				buildDesiredSourcesFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.ArgoCDAppUpdate,
+					*argocd.Application,
+					[]kargoapi.FreightReference,
+				) (*argocd.ApplicationSource, argocd.ApplicationSources, error) {
+					return nil, nil, nil
+				},
+				mustPerformUpdateFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.ArgoCDAppUpdate,
+					*argocd.Application,
+					[]kargoapi.FreightReference,
+					*argocd.ApplicationSource,
+					argocd.ApplicationSources,
+				) (argocd.OperationPhase, bool, error) {
+					return argocd.OperationSucceeded, false, nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{AppName: "local-app"},
+							{AppName: "cluster-a", ArgoCDClusterName: "cluster-a"},
+							{AppName: "cluster-b", ArgoCDClusterName: "cluster-b"},
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				newStatus *kargoapi.PromotionStatus,
+				newFreightIn []kargoapi.FreightReference,
+				newFreightOut []kargoapi.FreightReference,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, newStatus.Phase)
+				require.Equal(t, newFreightIn, newFreightOut)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -662,7 +966,135 @@ func TestArgoCDPromote(t *testing.T) {
 	}
 }
 
+func TestArgoCDPromoteSyncTimeout(t *testing.T) {
+	testCases := []struct {
+		name            string
+		phase           argocd.OperationPhase
+		startedAt       time.Time
+		expectedPhase   kargoapi.PromotionPhase
+		expectedMessage string
+	}{
+		{
+			name:          "running operation within timeout",
+			phase:         argocd.OperationRunning,
+			startedAt:     time.Now().Add(-time.Minute),
+			expectedPhase: kargoapi.PromotionPhaseRunning,
+		},
+		{
+			name:          "running operation exceeding timeout",
+			phase:         argocd.OperationRunning,
+			startedAt:     time.Now().Add(-time.Hour),
+			expectedPhase: kargoapi.PromotionPhaseFailed,
+			expectedMessage: `sync of Argo CD Application "fake-app" in namespace "fake-namespace" ` +
+				"did not complete within 5m0s",
+		},
+		{
+			name:          "succeeded operation exceeding timeout",
+			phase:         argocd.OperationSucceeded,
+			startedAt:     time.Now().Add(-time.Hour),
+			expectedPhase: kargoapi.PromotionPhaseSucceeded,
+		},
+		{
+			name:            "failed operation within timeout",
+			phase:           argocd.OperationFailed,
+			startedAt:       time.Now().Add(-time.Minute),
+			expectedPhase:   kargoapi.PromotionPhaseFailed,
+			expectedMessage: `Argo CD Application "fake-app" in namespace "fake-namespace" failed with: fake failure`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			app := &argocd.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-app",
+				},
+				Status: argocd.ApplicationStatus{
+					OperationState: &argocd.OperationState{
+						Phase:     testCase.phase,
+						Message:   "fake failure",
+						StartedAt: metav1.NewTime(testCase.startedAt),
+					},
+				},
+			}
+			promoMech := &argoCDMechanism{
+				argocdClient: fake.NewFakeClient(),
+				getAuthorizedApplicationFn: func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					metav1.ObjectMeta,
+				) (*argocd.Application, error) {
+					return app, nil
+				},
+				buildDesiredSourcesFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.ArgoCDAppUpdate,
+					*argocd.Application,
+					[]kargoapi.FreightReference,
+				) (*argocd.ApplicationSource, argocd.ApplicationSources, error) {
+					return nil, nil, nil
+				},
+				mustPerformUpdateFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.ArgoCDAppUpdate,
+					*argocd.Application,
+					[]kargoapi.FreightReference,
+					*argocd.ApplicationSource,
+					argocd.ApplicationSources,
+				) (argocd.OperationPhase, bool, error) {
+					return testCase.phase, false, nil
+				},
+			}
+			newStatus, _, err := promoMech.Promote(
+				logging.ContextWithLogger(
+					context.Background(),
+					logging.Wrap(logr.Discard()),
+				),
+				&kargoapi.Stage{
+					Spec: kargoapi.StageSpec{
+						PromotionMechanisms: &kargoapi.PromotionMechanisms{
+							ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{{
+								AppName:     "fake-app",
+								SyncTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+							}},
+						},
+					},
+				},
+				&kargoapi.Promotion{},
+				nil,
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedPhase, newStatus.Phase)
+			require.Equal(t, testCase.expectedMessage, newStatus.Message)
+		})
+	}
+}
+
 func TestArgoCDBuildDesiredSources(t *testing.T) {
+	// indexTestReconciler updates the revision of every source it is applied
+	// to, which makes it apparent which sources were updated.
+	indexTestReconciler := &argoCDMechanism{
+		applyArgoCDSourceUpdateFn: func(
+			_ context.Context,
+			_ *kargoapi.Stage,
+			_ *kargoapi.ArgoCDSourceUpdate,
+			src argocd.ApplicationSource,
+			_ []kargoapi.FreightReference,
+		) (argocd.ApplicationSource, error) {
+			src.TargetRevision = "updated-revision"
+			return src, nil
+		},
+	}
+	indexTestSources := argocd.ApplicationSources{
+		{RepoURL: "url-1"},
+		{RepoURL: "url-2"},
+		{RepoURL: "url-3"},
+	}
+
 	testCases := []struct {
 		name              string
 		reconciler        *argoCDMechanism
@@ -834,6 +1266,122 @@ func TestArgoCDBuildDesiredSources(t *testing.T) {
 				require.Nil(t, newSources)
 			},
 		},
+		{
+			name:       "applies updates to source at index 0",
+			reconciler: indexTestReconciler,
+			modifyApplication: func(app *argocd.Application) {
+				app.Spec.Sources = indexTestSources.DeepCopy()
+			},
+			update: kargoapi.ArgoCDAppUpdate{
+				SourceIndex:   ptr.To[int32](0),
+				SourceUpdates: []kargoapi.ArgoCDSourceUpdate{{}},
+			},
+			assertions: func(
+				t *testing.T,
+				_, newSource *argocd.ApplicationSource,
+				_, newSources argocd.ApplicationSources,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Nil(t, newSource)
+				require.Len(t, newSources, 3)
+				require.Equal(t, "updated-revision", newSources[0].TargetRevision)
+				require.Empty(t, newSources[1].TargetRevision)
+				require.Empty(t, newSources[2].TargetRevision)
+			},
+		},
+		{
+			name:       "applies updates to source at index 2",
+			reconciler: indexTestReconciler,
+			modifyApplication: func(app *argocd.Application) {
+				app.Spec.Sources = indexTestSources.DeepCopy()
+			},
+			update: kargoapi.ArgoCDAppUpdate{
+				SourceIndex:   ptr.To[int32](2),
+				SourceUpdates: []kargoapi.ArgoCDSourceUpdate{{}},
+			},
+			assertions: func(
+				t *testing.T,
+				_, newSource *argocd.ApplicationSource,
+				_, newSources argocd.ApplicationSources,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Nil(t, newSource)
+				require.Len(t, newSources, 3)
+				require.Empty(t, newSources[0].TargetRevision)
+				require.Empty(t, newSources[1].TargetRevision)
+				require.Equal(t, "updated-revision", newSources[2].TargetRevision)
+			},
+		},
+		{
+			name:       "source index out of bounds",
+			reconciler: indexTestReconciler,
+			modifyApplication: func(app *argocd.Application) {
+				app.Spec.Sources = indexTestSources.DeepCopy()
+			},
+			update: kargoapi.ArgoCDAppUpdate{
+				SourceIndex:   ptr.To[int32](3),
+				SourceUpdates: []kargoapi.ArgoCDSourceUpdate{{}},
+			},
+			assertions: func(
+				t *testing.T,
+				_, newSource *argocd.ApplicationSource,
+				_, newSources argocd.ApplicationSources,
+				err error,
+			) {
+				require.ErrorContains(
+					t,
+					err,
+					"source index 3 is out of bounds; Argo CD Application has 3 source(s)",
+				)
+				require.Nil(t, newSource)
+				require.Nil(t, newSources)
+			},
+		},
+		{
+			name:       "source index with singular source",
+			reconciler: indexTestReconciler,
+			modifyApplication: func(app *argocd.Application) {
+				app.Spec.Source = &argocd.ApplicationSource{RepoURL: "url"}
+			},
+			update: kargoapi.ArgoCDAppUpdate{
+				SourceIndex:   ptr.To[int32](0),
+				SourceUpdates: []kargoapi.ArgoCDSourceUpdate{{}},
+			},
+			assertions: func(
+				t *testing.T,
+				_, _ *argocd.ApplicationSource,
+				_, _ argocd.ApplicationSources,
+				err error,
+			) {
+				require.ErrorContains(
+					t,
+					err,
+					"source index 0 is out of bounds; Argo CD Application has 0 source(s)",
+				)
+			},
+		},
+		{
+			name:       "no source index applies updates to singular source",
+			reconciler: indexTestReconciler,
+			modifyApplication: func(app *argocd.Application) {
+				app.Spec.Source = &argocd.ApplicationSource{RepoURL: "url"}
+			},
+			update: kargoapi.ArgoCDAppUpdate{
+				SourceUpdates: []kargoapi.ArgoCDSourceUpdate{{}},
+			},
+			assertions: func(
+				t *testing.T,
+				_, newSource *argocd.ApplicationSource,
+				_, newSources argocd.ApplicationSources,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, "updated-revision", newSource.TargetRevision)
+				require.Nil(t, newSources)
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1147,6 +1695,7 @@ func TestArgoCDMustPerformUpdate(t *testing.T) {
 			mechanism := newArgoCDMechanism(
 				fake.NewFakeClient(),
 				fake.NewClientBuilder().WithScheme(scheme).Build(),
+				nil,
 			)
 			argocdMech, ok := mechanism.(*argoCDMechanism)
 			require.True(t, ok)
@@ -1179,19 +1728,25 @@ func TestArgoCDMustPerformUpdate(t *testing.T) {
 }
 
 func TestArgoCDUpdateApplicationSources(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, argocd.AddToScheme(scheme))
+
 	testCases := []struct {
 		name           string
 		promoMech      *argoCDMechanism
+		interceptor    interceptor.Funcs
 		app            *argocd.Application
 		desiredSource  *argocd.ApplicationSource
 		desiredSources argocd.ApplicationSources
 		assertions     func(*testing.T, error)
 	}{
 		{
-			name: "error patching Application",
-			promoMech: &argoCDMechanism{
-				argoCDAppPatchFn: func(
+			name:      "error patching Application",
+			promoMech: &argoCDMechanism{},
+			interceptor: interceptor.Funcs{
+				Patch: func(
 					context.Context,
+					client.WithWatch,
 					client.Object,
 					client.Patch,
 					...client.PatchOption,
@@ -1216,15 +1771,15 @@ func TestArgoCDUpdateApplicationSources(t *testing.T) {
 		{
 			name: "success",
 			promoMech: &argoCDMechanism{
-				argoCDAppPatchFn: func(
+				logAppEventFn: func(
 					context.Context,
-					client.Object,
-					client.Patch,
-					...client.PatchOption,
-				) error {
-					return nil
+					client.Client,
+					*argocd.Application,
+					string,
+					string,
+					string,
+				) {
 				},
-				logAppEventFn: func(context.Context, *argocd.Application, string, string, string) {},
 			},
 			app: &argocd.Application{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1246,6 +1801,11 @@ func TestArgoCDUpdateApplicationSources(t *testing.T) {
 				t,
 				testCase.promoMech.updateApplicationSources(
 					context.Background(),
+					fake.NewClientBuilder().
+						WithScheme(scheme).
+						WithObjects(testCase.app.DeepCopy()).
+						WithInterceptorFuncs(testCase.interceptor).
+						Build(),
 					testCase.app,
 					testCase.desiredSource,
 					testCase.desiredSources,
@@ -1255,6 +1815,96 @@ func TestArgoCDUpdateApplicationSources(t *testing.T) {
 	}
 }
 
+func TestArgoCDUpdateApplicationSetSources(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, argocd.AddToScheme(scheme))
+
+	testAppSet := &argocd.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-name",
+			Namespace: "fake-namespace",
+		},
+		Spec: argocd.ApplicationSetSpec{
+			Template: argocd.ApplicationSetTemplate{
+				Spec: argocd.ApplicationSpec{
+					Source: &argocd.ApplicationSource{
+						RepoURL:        "https://github.com/universe/42",
+						TargetRevision: "v1.0.0",
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		interceptor interceptor.Funcs
+		assertions  func(*testing.T, client.Client, error)
+	}{
+		{
+			name: "error patching ApplicationSet",
+			interceptor: interceptor.Funcs{
+				Patch: func(
+					context.Context,
+					client.WithWatch,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, "error patching Argo CD ApplicationSet")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			assertions: func(t *testing.T, c client.Client, err error) {
+				require.NoError(t, err)
+				appSet := &argocd.ApplicationSet{}
+				require.NoError(
+					t,
+					c.Get(
+						context.Background(),
+						client.ObjectKeyFromObject(testAppSet),
+						appSet,
+					),
+				)
+				require.Equal(
+					t,
+					&argocd.ApplicationSource{
+						RepoURL:        "https://github.com/universe/42",
+						TargetRevision: "v2.0.0",
+					},
+					appSet.Spec.Template.Spec.Source,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testAppSet.DeepCopy()).
+				WithInterceptorFuncs(testCase.interceptor).
+				Build()
+			err := (&argoCDMechanism{}).updateApplicationSetSources(
+				context.Background(),
+				c,
+				testAppSet.DeepCopy(),
+				&argocd.ApplicationSource{
+					RepoURL:        "https://github.com/universe/42",
+					TargetRevision: "v2.0.0",
+				},
+				nil,
+			)
+			testCase.assertions(t, c, err)
+		})
+	}
+}
+
 func TestLogAppEvent(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -1330,8 +1980,9 @@ func TestLogAppEvent(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			c := fake.NewFakeClient()
-			(&argoCDMechanism{argocdClient: c}).logAppEvent(
+			(&argoCDMechanism{}).logAppEvent(
 				context.Background(),
+				c,
 				testCase.app,
 				testCase.user,
 				testCase.eventReason,
@@ -1455,8 +2106,9 @@ func TestArgoCDGetAuthorizedApplication(t *testing.T) {
 				c.WithObjects(testCase.obj)
 			}
 
-			app, err := (&argoCDMechanism{argocdClient: c.Build()}).getAuthorizedApplication(
+			app, err := (&argoCDMechanism{}).getAuthorizedApplication(
 				context.Background(),
+				c.Build(),
 				testCase.appNamespace,
 				testCase.appName,
 				testCase.stageMeta,
@@ -1915,6 +2567,16 @@ func TestBuildKustomizeImagesForArgoCDAppSource(t *testing.T) {
 		},
 		result,
 	)
+
+	// An image cannot be referenced by digest if Freight records none for it
+	freight[0].Images[1].Digest = ""
+	_, err = mech.buildKustomizeImagesForArgoCDAppSource(
+		context.Background(),
+		stage,
+		stage.Spec.PromotionMechanisms.ArgoCDAppUpdates[0].SourceUpdates[0].Kustomize,
+		freight,
+	)
+	require.ErrorContains(t, err, "cannot be referenced by digest")
 }
 
 func TestBuildHelmParamChangesForArgoCDAppSource(t *testing.T) {
@@ -2006,4 +2668,14 @@ func TestBuildHelmParamChangesForArgoCDAppSource(t *testing.T) {
 		},
 		result,
 	)
+
+	// An image cannot be referenced by digest if Freight records none for it
+	freight[0].Images[3].Digest = ""
+	_, err = mech.buildHelmParamChangesForArgoCDAppSource(
+		context.Background(),
+		stage,
+		stage.Spec.PromotionMechanisms.ArgoCDAppUpdates[0].SourceUpdates[0].Helm,
+		freight,
+	)
+	require.ErrorContains(t, err, "cannot be referenced by digest")
 }
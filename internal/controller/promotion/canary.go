@@ -0,0 +1,287 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// rolloutGVK is the GroupVersionKind of Argo Rollouts Rollout resources. These
+// are handled as unstructured objects, since only a handful of their fields are
+// of any interest.
+var rolloutGVK = schema.GroupVersionKind{
+	Group:   "argoproj.io",
+	Version: "v1alpha1",
+	Kind:    "Rollout",
+}
+
+// Phases of an Argo Rollouts Rollout, as reported by its status.
+const (
+	rolloutPhaseDegraded = "Degraded"
+	rolloutPhaseHealthy  = "Healthy"
+	rolloutPhasePaused   = "Paused"
+)
+
+// canaryStepsPath is the path to the steps of the canary strategy of an Argo
+// Rollouts Rollout.
+var canaryStepsPath = []string{"spec", "strategy", "canary", "steps"}
+
+// canaryMechanism is an implementation of the Mechanism interface that exposes
+// the Freight being promoted to only a percentage of traffic using the canary
+// strategy of an Argo Rollouts Rollout and waits for the Rollout to be fully
+// promoted.
+type canaryMechanism struct {
+	kargoClient client.Client
+	// These behaviors are overridable for testing purposes:
+	getAuthorizedRolloutFn func(
+		ctx context.Context,
+		namespace string,
+		name string,
+		stageMeta metav1.ObjectMeta,
+	) (*unstructured.Unstructured, error)
+	setCanaryWeightFn func(
+		ctx context.Context,
+		rollout *unstructured.Unstructured,
+		weight int32,
+	) error
+}
+
+// newCanaryMechanism returns an implementation of the Mechanism interface that
+// exposes the Freight being promoted to only a percentage of traffic using the
+// canary strategy of an Argo Rollouts Rollout.
+func newCanaryMechanism(kargoClient client.Client) Mechanism {
+	c := &canaryMechanism{
+		kargoClient: kargoClient,
+	}
+	c.getAuthorizedRolloutFn = c.getAuthorizedRollout
+	c.setCanaryWeightFn = c.setCanaryWeight
+	return c
+}
+
+// GetName implements the Mechanism interface.
+func (*canaryMechanism) GetName() string {
+	return "canary promotion mechanism"
+}
+
+// Promote implements the Mechanism interface. The canary weight of the Rollout
+// is set to the configured percentage, after which the Promotion remains
+// Running, and is therefore reconciled again later, until the Rollout's own
+// canary analysis has passed and the Rollout has been fully promoted.
+func (c *canaryMechanism) Promote(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	newFreight []kargoapi.FreightReference,
+) (*kargoapi.PromotionStatus, []kargoapi.FreightReference, error) {
+	canary := stage.Spec.PromotionMechanisms.Canary
+	if canary == nil {
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+	}
+
+	namespace := canary.Namespace
+	if namespace == "" {
+		namespace = stage.Namespace
+	}
+
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"rollout", canary.Rollout,
+		"rolloutNamespace", namespace,
+	)
+	logger.Debug("executing canary promotion mechanism")
+
+	rollout, err := c.getAuthorizedRolloutFn(
+		ctx,
+		namespace,
+		canary.Rollout,
+		stage.ObjectMeta,
+	)
+	if err != nil {
+		return nil, newFreight, err
+	}
+
+	if stage.Spec.DryRun {
+		logger.Info("dry run: not setting canary weight", "weight", canary.Weight)
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+	}
+
+	if err = c.setCanaryWeightFn(ctx, rollout, canary.Weight); err != nil {
+		return nil, newFreight, err
+	}
+
+	newStatus := promo.Status.DeepCopy()
+	newStatus.Phase, newStatus.Message = getRolloutPromotionPhase(rollout)
+
+	logger.Debug(
+		"done executing canary promotion mechanism",
+		"phase", newStatus.Phase,
+	)
+
+	return newStatus, newFreight, nil
+}
+
+// getAuthorizedRollout returns an Argo Rollouts Rollout in the given namespace
+// with the given name, if it is authorized for mutation by the Kargo Stage
+// represented by stageMeta.
+func (c *canaryMechanism) getAuthorizedRollout(
+	ctx context.Context,
+	namespace string,
+	name string,
+	stageMeta metav1.ObjectMeta,
+) (*unstructured.Unstructured, error) {
+	rollout := &unstructured.Unstructured{}
+	rollout.SetGroupVersionKind(rolloutGVK)
+	if err := c.kargoClient.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      name,
+		},
+		rollout,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf(
+				"unable to find Argo Rollouts Rollout %q in namespace %q",
+				name, namespace,
+			)
+		}
+		return nil, fmt.Errorf(
+			"error finding Argo Rollouts Rollout %q in namespace %q: %w",
+			name, namespace, err,
+		)
+	}
+
+	if err := authorizeStageMutation(
+		"Argo Rollouts Rollout",
+		stageMeta,
+		metav1.ObjectMeta{
+			Namespace:   rollout.GetNamespace(),
+			Name:        rollout.GetName(),
+			Annotations: rollout.GetAnnotations(),
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return rollout, nil
+}
+
+// setCanaryWeight patches the weight of the first setWeight step of the canary
+// strategy of the provided Argo Rollouts Rollout. If that step already sets the
+// provided weight, the Rollout is left untouched. Otherwise, the provided
+// Rollout is updated to reflect the patched resource.
+func (c *canaryMechanism) setCanaryWeight(
+	ctx context.Context,
+	rollout *unstructured.Unstructured,
+	weight int32,
+) error {
+	steps, _, err := unstructured.NestedSlice(rollout.Object, canaryStepsPath...)
+	if err != nil {
+		return fmt.Errorf(
+			"error reading canary steps of Argo Rollouts Rollout %q in namespace %q: %w",
+			rollout.GetName(), rollout.GetNamespace(), err,
+		)
+	}
+	for i, step := range steps {
+		stepMap, ok := step.(map[string]any)
+		if !ok {
+			continue
+		}
+		if _, ok = stepMap["setWeight"]; !ok {
+			continue
+		}
+		if currentWeight, _, _ := unstructured.NestedInt64(stepMap, "setWeight"); currentWeight == int64(weight) {
+			return nil
+		}
+		patch := client.MergeFrom(rollout.DeepCopy())
+		stepMap["setWeight"] = int64(weight)
+		steps[i] = stepMap
+		if err = unstructured.SetNestedSlice(rollout.Object, steps, canaryStepsPath...); err != nil {
+			return fmt.Errorf(
+				"error setting canary weight of Argo Rollouts Rollout %q in namespace %q: %w",
+				rollout.GetName(), rollout.GetNamespace(), err,
+			)
+		}
+		if err = c.kargoClient.Patch(ctx, rollout, patch); err != nil {
+			return fmt.Errorf(
+				"error patching Argo Rollouts Rollout %q in namespace %q: %w",
+				rollout.GetName(), rollout.GetNamespace(), err,
+			)
+		}
+		return nil
+	}
+	return fmt.Errorf(
+		"no canary step of Argo Rollouts Rollout %q in namespace %q sets a weight",
+		rollout.GetName(), rollout.GetNamespace(),
+	)
+}
+
+// getRolloutPromotionPhase maps the status of the provided Argo Rollouts
+// Rollout to the phase of a Promotion awaiting its completion, along with a
+// message describing the status. Until the Rollout has observed its latest
+// spec, its status says nothing about the Freight being promoted, so the
+// Promotion remains Running. After that, an aborted or degraded Rollout fails
+// the Promotion, a healthy Rollout, i.e. one that has been fully promoted,
+// completes it, and a Rollout in any other phase, such as a canary paused for
+// analysis, leaves it Running.
+func getRolloutPromotionPhase(
+	rollout *unstructured.Unstructured,
+) (kargoapi.PromotionPhase, string) {
+	// Argo Rollouts records the observed generation as a string.
+	observedGeneration, _, _ := unstructured.NestedString(
+		rollout.Object,
+		"status", "observedGeneration",
+	)
+	if observedGeneration != strconv.FormatInt(rollout.GetGeneration(), 10) {
+		return kargoapi.PromotionPhaseRunning, fmt.Sprintf(
+			"Waiting for Argo Rollouts Rollout %q in namespace %q to observe its latest spec",
+			rollout.GetName(), rollout.GetNamespace(),
+		)
+	}
+
+	message, _, _ := unstructured.NestedString(rollout.Object, "status", "message")
+	if aborted, _, _ := unstructured.NestedBool(rollout.Object, "status", "abort"); aborted {
+		return kargoapi.PromotionPhaseFailed, fmt.Sprintf(
+			"Argo Rollouts Rollout %q in namespace %q was aborted: %s",
+			rollout.GetName(), rollout.GetNamespace(), message,
+		)
+	}
+
+	phase, _, _ := unstructured.NestedString(rollout.Object, "status", "phase")
+	switch phase {
+	case rolloutPhaseHealthy:
+		return kargoapi.PromotionPhaseSucceeded, fmt.Sprintf(
+			"Argo Rollouts Rollout %q in namespace %q was fully promoted",
+			rollout.GetName(), rollout.GetNamespace(),
+		)
+	case rolloutPhaseDegraded:
+		return kargoapi.PromotionPhaseFailed, fmt.Sprintf(
+			"Argo Rollouts Rollout %q in namespace %q is degraded: %s",
+			rollout.GetName(), rollout.GetNamespace(), message,
+		)
+	case rolloutPhasePaused:
+		weight, _, _ := unstructured.NestedInt64(
+			rollout.Object,
+			"status", "canary", "weights", "canary", "weight",
+		)
+		return kargoapi.PromotionPhaseRunning, fmt.Sprintf(
+			"Canary of Argo Rollouts Rollout %q in namespace %q is paused at %d%% weight",
+			rollout.GetName(), rollout.GetNamespace(), weight,
+		)
+	default:
+		// The Rollout is Progressing or has yet to report a phase.
+		return kargoapi.PromotionPhaseRunning, fmt.Sprintf(
+			"Argo Rollouts Rollout %q in namespace %q is progressing",
+			rollout.GetName(), rollout.GetNamespace(),
+		)
+	}
+}
@@ -0,0 +1,572 @@
+package promotion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewCanaryMechanism(t *testing.T) {
+	pm := newCanaryMechanism(fake.NewFakeClient())
+	cpm, ok := pm.(*canaryMechanism)
+	require.True(t, ok)
+	require.NotNil(t, cpm.kargoClient)
+	require.NotNil(t, cpm.getAuthorizedRolloutFn)
+	require.NotNil(t, cpm.setCanaryWeightFn)
+}
+
+func TestCanaryGetName(t *testing.T) {
+	require.NotEmpty(t, (&canaryMechanism{}).GetName())
+}
+
+func TestCanaryPromote(t *testing.T) {
+	testCanary := &kargoapi.CanaryPromotion{
+		Rollout: "fake-rollout",
+		Weight:  20,
+	}
+	// newRollout returns a Rollout that has observed its latest spec and
+	// reports the provided status.
+	newRollout := func(status map[string]any) *unstructured.Unstructured {
+		rollout := &unstructured.Unstructured{Object: map[string]any{}}
+		rollout.SetGeneration(2)
+		status["observedGeneration"] = "2"
+		require.NoError(t, unstructured.SetNestedMap(rollout.Object, status, "status"))
+		return rollout
+	}
+	// newPromoMech returns a canaryMechanism whose Rollout reports the provided
+	// status.
+	newPromoMech := func(status map[string]any) *canaryMechanism {
+		return &canaryMechanism{
+			getAuthorizedRolloutFn: func(
+				context.Context,
+				string,
+				string,
+				metav1.ObjectMeta,
+			) (*unstructured.Unstructured, error) {
+				return newRollout(status), nil
+			},
+			setCanaryWeightFn: func(
+				_ context.Context,
+				_ *unstructured.Unstructured,
+				weight int32,
+			) error {
+				if weight != 20 {
+					return errors.New("unexpected weight")
+				}
+				return nil
+			},
+		}
+	}
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+		},
+		Spec: kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				Canary: testCanary,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		promoMech  *canaryMechanism
+		stage      *kargoapi.Stage
+		assertions func(*testing.T, *kargoapi.PromotionStatus, error)
+	}{
+		{
+			name:      "no canary",
+			promoMech: &canaryMechanism{},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "error getting Rollout",
+			promoMech: &canaryMechanism{
+				getAuthorizedRolloutFn: func(
+					context.Context,
+					string,
+					string,
+					metav1.ObjectMeta,
+				) (*unstructured.Unstructured, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			stage: testStage,
+			assertions: func(t *testing.T, _ *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "Rollout namespace defaults to Stage namespace",
+			promoMech: &canaryMechanism{
+				getAuthorizedRolloutFn: func(
+					_ context.Context,
+					namespace string,
+					_ string,
+					_ metav1.ObjectMeta,
+				) (*unstructured.Unstructured, error) {
+					if namespace != "fake-namespace" {
+						return nil, errors.New("unexpected namespace")
+					}
+					return newRollout(map[string]any{"phase": rolloutPhaseHealthy}), nil
+				},
+				setCanaryWeightFn: func(
+					context.Context,
+					*unstructured.Unstructured,
+					int32,
+				) error {
+					return nil
+				},
+			},
+			stage: testStage,
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "dry run",
+			promoMech: &canaryMechanism{
+				getAuthorizedRolloutFn: func(
+					context.Context,
+					string,
+					string,
+					metav1.ObjectMeta,
+				) (*unstructured.Unstructured, error) {
+					return &unstructured.Unstructured{}, nil
+				},
+				setCanaryWeightFn: func(
+					context.Context,
+					*unstructured.Unstructured,
+					int32,
+				) error {
+					return errors.New("should not be called")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					DryRun: true,
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						Canary: testCanary,
+					},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "error setting canary weight",
+			promoMech: &canaryMechanism{
+				getAuthorizedRolloutFn: func(
+					context.Context,
+					string,
+					string,
+					metav1.ObjectMeta,
+				) (*unstructured.Unstructured, error) {
+					return &unstructured.Unstructured{}, nil
+				},
+				setCanaryWeightFn: func(
+					context.Context,
+					*unstructured.Unstructured,
+					int32,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			stage: testStage,
+			assertions: func(t *testing.T, _ *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "canary paused for analysis",
+			promoMech: newPromoMech(map[string]any{
+				"phase": rolloutPhasePaused,
+				"canary": map[string]any{
+					"weights": map[string]any{
+						"canary": map[string]any{"weight": int64(20)},
+					},
+				},
+			}),
+			stage: testStage,
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Contains(t, status.Message, "paused at 20% weight")
+			},
+		},
+		{
+			name:      "Rollout progressing",
+			promoMech: newPromoMech(map[string]any{"phase": "Progressing"}),
+			stage:     testStage,
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, status.Phase)
+				require.Contains(t, status.Message, "is progressing")
+			},
+		},
+		{
+			name:      "Rollout completed",
+			promoMech: newPromoMech(map[string]any{"phase": rolloutPhaseHealthy}),
+			stage:     testStage,
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Contains(t, status.Message, "was fully promoted")
+			},
+		},
+		{
+			name: "Rollout aborted",
+			promoMech: newPromoMech(map[string]any{
+				"phase":   rolloutPhaseDegraded,
+				"abort":   true,
+				"message": "metric \"success-rate\" assessed Failed",
+			}),
+			stage: testStage,
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Contains(t, status.Message, "was aborted")
+				require.Contains(t, status.Message, "success-rate")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status, _, err := testCase.promoMech.Promote(
+				context.Background(),
+				testCase.stage,
+				&kargoapi.Promotion{},
+				nil,
+			)
+			testCase.assertions(t, status, err)
+		})
+	}
+}
+
+func TestCanaryGetAuthorizedRollout(t *testing.T) {
+	testStageMeta := metav1.ObjectMeta{
+		Namespace: "fake-namespace",
+		Name:      "fake-stage",
+	}
+	newRollout := func(annotations map[string]string) *unstructured.Unstructured {
+		rollout := &unstructured.Unstructured{}
+		rollout.SetGroupVersionKind(rolloutGVK)
+		rollout.SetNamespace("fake-namespace")
+		rollout.SetName("fake-rollout")
+		rollout.SetAnnotations(annotations)
+		return rollout
+	}
+
+	testCases := []struct {
+		name       string
+		rollout    *unstructured.Unstructured
+		assertions func(*testing.T, *unstructured.Unstructured, error)
+	}{
+		{
+			name: "Rollout not found",
+			assertions: func(t *testing.T, _ *unstructured.Unstructured, err error) {
+				require.ErrorContains(t, err, "unable to find Argo Rollouts Rollout")
+			},
+		},
+		{
+			name:    "Rollout not authorized",
+			rollout: newRollout(nil),
+			assertions: func(t *testing.T, _ *unstructured.Unstructured, err error) {
+				require.ErrorContains(t, err, "does not permit mutation")
+				require.ErrorContains(t, err, "Argo Rollouts Rollout")
+			},
+		},
+		{
+			name: "success",
+			rollout: newRollout(map[string]string{
+				authorizedStageAnnotationKey: "fake-namespace:fake-stage",
+			}),
+			assertions: func(t *testing.T, rollout *unstructured.Unstructured, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-rollout", rollout.GetName())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(newRolloutScheme())
+			if testCase.rollout != nil {
+				c.WithObjects(testCase.rollout)
+			}
+			m := &canaryMechanism{
+				kargoClient: c.Build(),
+			}
+			rollout, err := m.getAuthorizedRollout(
+				context.Background(),
+				"fake-namespace",
+				"fake-rollout",
+				testStageMeta,
+			)
+			testCase.assertions(t, rollout, err)
+		})
+	}
+}
+
+func TestCanarySetCanaryWeight(t *testing.T) {
+	newRollout := func(steps ...any) *unstructured.Unstructured {
+		rollout := &unstructured.Unstructured{}
+		rollout.SetGroupVersionKind(rolloutGVK)
+		rollout.SetNamespace("fake-namespace")
+		rollout.SetName("fake-rollout")
+		if steps != nil {
+			require.NoError(
+				t,
+				unstructured.SetNestedSlice(rollout.Object, steps, canaryStepsPath...),
+			)
+		}
+		return rollout
+	}
+
+	testCases := []struct {
+		name        string
+		interceptor interceptor.Funcs
+		rollout     *unstructured.Unstructured
+		assertions  func(*testing.T, client.Client, error)
+	}{
+		{
+			name:    "no canary steps",
+			rollout: newRollout(),
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, "no canary step")
+			},
+		},
+		{
+			name: "no step sets a weight",
+			rollout: newRollout(
+				map[string]any{"pause": map[string]any{}},
+			),
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, "no canary step")
+			},
+		},
+		{
+			name: "weight is already up to date",
+			rollout: newRollout(
+				map[string]any{"setWeight": int64(20)},
+				map[string]any{"pause": map[string]any{}},
+			),
+			interceptor: interceptor.Funcs{
+				Patch: func(
+					context.Context,
+					client.WithWatch,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					return errors.New("should not be called")
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "error patching Rollout",
+			rollout: newRollout(
+				map[string]any{"setWeight": int64(10)},
+			),
+			interceptor: interceptor.Funcs{
+				Patch: func(
+					context.Context,
+					client.WithWatch,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, "error patching Argo Rollouts Rollout")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			rollout: newRollout(
+				map[string]any{"pause": map[string]any{"duration": "1m"}},
+				map[string]any{"setWeight": int64(10)},
+				map[string]any{"analysis": map[string]any{}},
+				map[string]any{"setWeight": int64(50)},
+			),
+			assertions: func(t *testing.T, c client.Client, err error) {
+				require.NoError(t, err)
+				rollout := &unstructured.Unstructured{}
+				rollout.SetGroupVersionKind(rolloutGVK)
+				require.NoError(
+					t,
+					c.Get(
+						context.Background(),
+						types.NamespacedName{
+							Namespace: "fake-namespace",
+							Name:      "fake-rollout",
+						},
+						rollout,
+					),
+				)
+				steps, _, err := unstructured.NestedSlice(rollout.Object, canaryStepsPath...)
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]any{
+						map[string]any{"pause": map[string]any{"duration": "1m"}},
+						// Only the first step that sets a weight is updated
+						map[string]any{"setWeight": int64(20)},
+						map[string]any{"analysis": map[string]any{}},
+						map[string]any{"setWeight": int64(50)},
+					},
+					steps,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(newRolloutScheme()).
+				WithObjects(testCase.rollout.DeepCopy()).
+				WithInterceptorFuncs(testCase.interceptor).
+				Build()
+			m := &canaryMechanism{
+				kargoClient: c,
+			}
+			err := m.setCanaryWeight(
+				context.Background(),
+				testCase.rollout,
+				20,
+			)
+			testCase.assertions(t, c, err)
+		})
+	}
+}
+
+func TestGetRolloutPromotionPhase(t *testing.T) {
+	testCases := []struct {
+		name            string
+		generation      int64
+		status          map[string]any
+		expectedPhase   kargoapi.PromotionPhase
+		expectedMessage string
+	}{
+		{
+			name:            "latest spec not yet observed",
+			generation:      3,
+			status:          map[string]any{"observedGeneration": "2", "phase": rolloutPhaseHealthy},
+			expectedPhase:   kargoapi.PromotionPhaseRunning,
+			expectedMessage: "to observe its latest spec",
+		},
+		{
+			name:            "no status",
+			generation:      1,
+			expectedPhase:   kargoapi.PromotionPhaseRunning,
+			expectedMessage: "to observe its latest spec",
+		},
+		{
+			name:            "progressing",
+			generation:      2,
+			status:          map[string]any{"observedGeneration": "2", "phase": "Progressing"},
+			expectedPhase:   kargoapi.PromotionPhaseRunning,
+			expectedMessage: "is progressing",
+		},
+		{
+			name:       "canary paused",
+			generation: 2,
+			status: map[string]any{
+				"observedGeneration": "2",
+				"phase":              rolloutPhasePaused,
+				"canary": map[string]any{
+					"weights": map[string]any{
+						"canary": map[string]any{"weight": int64(20)},
+					},
+				},
+			},
+			expectedPhase:   kargoapi.PromotionPhaseRunning,
+			expectedMessage: "is paused at 20% weight",
+		},
+		{
+			name:            "completed",
+			generation:      2,
+			status:          map[string]any{"observedGeneration": "2", "phase": rolloutPhaseHealthy},
+			expectedPhase:   kargoapi.PromotionPhaseSucceeded,
+			expectedMessage: "was fully promoted",
+		},
+		{
+			name:       "aborted",
+			generation: 2,
+			status: map[string]any{
+				"observedGeneration": "2",
+				"phase":              rolloutPhasePaused,
+				"abort":              true,
+				"message":            "analysis failed",
+			},
+			expectedPhase:   kargoapi.PromotionPhaseFailed,
+			expectedMessage: "was aborted: analysis failed",
+		},
+		{
+			name:       "degraded",
+			generation: 2,
+			status: map[string]any{
+				"observedGeneration": "2",
+				"phase":              rolloutPhaseDegraded,
+				"message":            "ProgressDeadlineExceeded",
+			},
+			expectedPhase:   kargoapi.PromotionPhaseFailed,
+			expectedMessage: "is degraded: ProgressDeadlineExceeded",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			rollout := &unstructured.Unstructured{Object: map[string]any{}}
+			rollout.SetName("fake-rollout")
+			rollout.SetGeneration(testCase.generation)
+			if testCase.status != nil {
+				require.NoError(
+					t,
+					unstructured.SetNestedMap(rollout.Object, testCase.status, "status"),
+				)
+			}
+			phase, message := getRolloutPromotionPhase(rollout)
+			require.Equal(t, testCase.expectedPhase, phase)
+			require.Contains(t, message, testCase.expectedMessage)
+		})
+	}
+}
+
+// newRolloutScheme returns a scheme that knows about Argo Rollouts Rollout
+// resources, which are handled as unstructured objects.
+func newRolloutScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypeWithName(rolloutGVK, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(
+		rolloutGVK.GroupVersion().WithKind(rolloutGVK.Kind+"List"),
+		&unstructured.UnstructuredList{},
+	)
+	return scheme
+}
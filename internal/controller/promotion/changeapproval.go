@@ -0,0 +1,229 @@
+package promotion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// changeApprovalTimeout is the maximum length of time to wait for a ticketing
+// system to respond to a query for a change request.
+const changeApprovalTimeout = 30 * time.Second
+
+// changeRequest represents a change request as returned by a ticketing system.
+type changeRequest struct {
+	// ID is the ticketing system's identifier for the change request.
+	ID string `json:"id"`
+	// Approved indicates whether the change request has been approved.
+	Approved bool `json:"approved"`
+}
+
+// changeApprovalMechanism is an implementation of the Mechanism interface that
+// verifies that an approved change request exists in an external ticketing
+// system before any other promotion mechanisms are executed.
+type changeApprovalMechanism struct {
+	credentialsDB credentials.Database
+	httpClient    *http.Client
+	// These behaviors are overridable for testing purposes:
+	getChangeRequestFn func(
+		ctx context.Context,
+		check *kargoapi.ChangeApprovalCheck,
+		promo *kargoapi.Promotion,
+		creds *credentials.Credentials,
+	) (*changeRequest, error)
+}
+
+// newChangeApprovalMechanism returns an implementation of the Mechanism
+// interface that verifies that an approved change request exists in an
+// external ticketing system before any other promotion mechanisms are
+// executed.
+func newChangeApprovalMechanism(credentialsDB credentials.Database) Mechanism {
+	c := &changeApprovalMechanism{
+		credentialsDB: credentialsDB,
+		httpClient:    &http.Client{Timeout: changeApprovalTimeout},
+	}
+	c.getChangeRequestFn = c.getChangeRequest
+	return c
+}
+
+// GetName implements the Mechanism interface.
+func (*changeApprovalMechanism) GetName() string {
+	return "change approval promotion mechanism"
+}
+
+// Promote implements the Mechanism interface.
+func (c *changeApprovalMechanism) Promote(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	newFreight []kargoapi.FreightReference,
+) (*kargoapi.PromotionStatus, []kargoapi.FreightReference, error) {
+	check := stage.Spec.PromotionMechanisms.ChangeApproval
+	if check == nil {
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+	}
+
+	// If an approved change request was already found during a previous
+	// reconciliation of this Promotion, there is no need to look again.
+	if promo.Status.Metadata[changeRequestMetadataKey(check.URL)] != "" {
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+	}
+
+	logger := logging.LoggerFromContext(ctx).WithValues("url", check.URL)
+	logger.Debug("checking for approved change request")
+
+	var creds *credentials.Credentials
+	if c.credentialsDB != nil {
+		dbCreds, ok, err := c.credentialsDB.Get(
+			ctx,
+			promo.Namespace,
+			credentials.TypeTicketing,
+			check.URL,
+		)
+		if err != nil {
+			return nil, newFreight, fmt.Errorf(
+				"error obtaining credentials for ticketing system %q: %w",
+				check.URL,
+				err,
+			)
+		}
+		if ok {
+			creds = &dbCreds
+			logger.Debug("obtained credentials for ticketing system")
+		} else {
+			logger.Debug("found no credentials for ticketing system")
+		}
+	}
+
+	change, err := c.getChangeRequestFn(ctx, check, promo, creds)
+	if err != nil {
+		return nil, newFreight, err
+	}
+
+	newStatus := promo.Status.DeepCopy()
+	switch {
+	case change == nil:
+		newStatus.Phase = kargoapi.PromotionPhaseFailed
+		newStatus.Message = fmt.Sprintf(
+			"no change request referencing Promotion %q was found in ticketing system %q",
+			promo.Name,
+			check.URL,
+		)
+	case !change.Approved:
+		newStatus.Phase = kargoapi.PromotionPhaseFailed
+		newStatus.Message = fmt.Sprintf(
+			"change request %q in ticketing system %q has not been approved",
+			change.ID,
+			check.URL,
+		)
+	default:
+		newStatus.Phase = kargoapi.PromotionPhaseSucceeded
+		if newStatus.Metadata == nil {
+			newStatus.Metadata = make(map[string]string, 1)
+		}
+		newStatus.Metadata[changeRequestMetadataKey(check.URL)] = change.ID
+	}
+
+	logger.Debug(
+		"done checking for approved change request",
+		"phase", newStatus.Phase,
+	)
+
+	return newStatus, newFreight, nil
+}
+
+// getChangeRequest queries the ticketing system described by the provided
+// ChangeApprovalCheck for a change request referencing the provided Promotion.
+// If no such change request exists, nil is returned. Provided credentials may
+// be nil if the ticketing system does not require authentication. The query is
+// abandoned if the provided context is canceled or the ticketing system does
+// not respond before the mechanism's HTTP client times out.
+func (c *changeApprovalMechanism) getChangeRequest(
+	ctx context.Context,
+	check *kargoapi.ChangeApprovalCheck,
+	promo *kargoapi.Promotion,
+	creds *credentials.Credentials,
+) (*changeRequest, error) {
+	reqURL, err := url.Parse(check.URL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing ticketing system URL %q: %w", check.URL, err)
+	}
+	query := reqURL.Query()
+	query.Set("project", promo.Namespace)
+	query.Set("stage", promo.Spec.Stage)
+	query.Set("freight", promo.Spec.Freight)
+	reqURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error preparing HTTP/S request to %q: %w",
+			check.URL,
+			err,
+		)
+	}
+	req.Header.Set("Accept", "application/json")
+	if creds != nil {
+		req.SetBasicAuth(creds.Username, creds.Password)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error querying ticketing system at %q: %w",
+			check.URL,
+			err,
+		)
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf(
+			"received unexpected HTTP %d when querying ticketing system at %q",
+			res.StatusCode,
+			check.URL,
+		)
+	}
+
+	resBodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error reading response from ticketing system at %q: %w",
+			check.URL,
+			err,
+		)
+	}
+	change := &changeRequest{}
+	if err = json.Unmarshal(resBodyBytes, change); err != nil {
+		return nil, fmt.Errorf(
+			"error unmarshaling response from ticketing system at %q: %w",
+			check.URL,
+			err,
+		)
+	}
+	if change.ID == "" {
+		return nil, fmt.Errorf(
+			"response from ticketing system at %q did not identify a change request",
+			check.URL,
+		)
+	}
+	return change, nil
+}
+
+// changeRequestMetadataKey returns the key used to store the ID of an approved
+// change request in the metadata map.
+func changeRequestMetadataKey(ticketingURL string) string {
+	return fmt.Sprintf("change:%s", ticketingURL)
+}
@@ -0,0 +1,402 @@
+package promotion
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+)
+
+func TestNewChangeApprovalMechanism(t *testing.T) {
+	pm := newChangeApprovalMechanism(&credentials.FakeDB{})
+	capm, ok := pm.(*changeApprovalMechanism)
+	require.True(t, ok)
+	require.NotNil(t, capm.credentialsDB)
+	require.NotNil(t, capm.httpClient)
+	require.Equal(t, changeApprovalTimeout, capm.httpClient.Timeout)
+	require.NotNil(t, capm.getChangeRequestFn)
+}
+
+func TestChangeApprovalGetName(t *testing.T) {
+	require.NotEmpty(t, (&changeApprovalMechanism{}).GetName())
+}
+
+func TestChangeApprovalPromote(t *testing.T) {
+	const testURL = "https://tickets.example.com/api/changes"
+	testCases := []struct {
+		name       string
+		promoMech  *changeApprovalMechanism
+		stage      *kargoapi.Stage
+		promo      *kargoapi.Promotion
+		assertions func(*testing.T, *kargoapi.PromotionStatus, error)
+	}{
+		{
+			name: "no change approval check",
+			promoMech: &changeApprovalMechanism{
+				getChangeRequestFn: func(
+					context.Context,
+					*kargoapi.ChangeApprovalCheck,
+					*kargoapi.Promotion,
+					*credentials.Credentials,
+				) (*changeRequest, error) {
+					return nil, errors.New("should not be called")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+			},
+			promo: &kargoapi.Promotion{},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "approved change request already recorded",
+			promoMech: &changeApprovalMechanism{
+				getChangeRequestFn: func(
+					context.Context,
+					*kargoapi.ChangeApprovalCheck,
+					*kargoapi.Promotion,
+					*credentials.Credentials,
+				) (*changeRequest, error) {
+					return nil, errors.New("should not be called")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ChangeApproval: &kargoapi.ChangeApprovalCheck{URL: testURL},
+					},
+				},
+			},
+			promo: &kargoapi.Promotion{
+				Status: kargoapi.PromotionStatus{
+					Metadata: map[string]string{
+						changeRequestMetadataKey(testURL): "CHG0001234",
+					},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(
+					t,
+					"CHG0001234",
+					status.Metadata[changeRequestMetadataKey(testURL)],
+				)
+			},
+		},
+		{
+			name: "error getting credentials",
+			promoMech: &changeApprovalMechanism{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{}, false, errors.New("something went wrong")
+					},
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ChangeApproval: &kargoapi.ChangeApprovalCheck{URL: testURL},
+					},
+				},
+			},
+			promo: &kargoapi.Promotion{},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials for ticketing system")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error getting change request",
+			promoMech: &changeApprovalMechanism{
+				credentialsDB: &credentials.FakeDB{},
+				getChangeRequestFn: func(
+					context.Context,
+					*kargoapi.ChangeApprovalCheck,
+					*kargoapi.Promotion,
+					*credentials.Credentials,
+				) (*changeRequest, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ChangeApproval: &kargoapi.ChangeApprovalCheck{URL: testURL},
+					},
+				},
+			},
+			promo: &kargoapi.Promotion{},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "no change request found",
+			promoMech: &changeApprovalMechanism{
+				credentialsDB: &credentials.FakeDB{},
+				getChangeRequestFn: func(
+					context.Context,
+					*kargoapi.ChangeApprovalCheck,
+					*kargoapi.Promotion,
+					*credentials.Credentials,
+				) (*changeRequest, error) {
+					return nil, nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ChangeApproval: &kargoapi.ChangeApprovalCheck{URL: testURL},
+					},
+				},
+			},
+			promo: &kargoapi.Promotion{},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Contains(t, status.Message, "no change request referencing Promotion")
+				require.Empty(t, status.Metadata)
+			},
+		},
+		{
+			name: "change request not approved",
+			promoMech: &changeApprovalMechanism{
+				credentialsDB: &credentials.FakeDB{},
+				getChangeRequestFn: func(
+					context.Context,
+					*kargoapi.ChangeApprovalCheck,
+					*kargoapi.Promotion,
+					*credentials.Credentials,
+				) (*changeRequest, error) {
+					return &changeRequest{ID: "CHG0001234"}, nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ChangeApproval: &kargoapi.ChangeApprovalCheck{URL: testURL},
+					},
+				},
+			},
+			promo: &kargoapi.Promotion{},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Contains(t, status.Message, `change request "CHG0001234"`)
+				require.Contains(t, status.Message, "has not been approved")
+				require.Empty(t, status.Metadata)
+			},
+		},
+		{
+			name: "change request approved",
+			promoMech: &changeApprovalMechanism{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{
+							Username: "fake-username",
+							Password: "fake-password",
+						}, true, nil
+					},
+				},
+				getChangeRequestFn: func(
+					_ context.Context,
+					_ *kargoapi.ChangeApprovalCheck,
+					_ *kargoapi.Promotion,
+					creds *credentials.Credentials,
+				) (*changeRequest, error) {
+					if creds == nil || creds.Username != "fake-username" {
+						return nil, errors.New("expected credentials")
+					}
+					return &changeRequest{ID: "CHG0001234", Approved: true}, nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ChangeApproval: &kargoapi.ChangeApprovalCheck{URL: testURL},
+					},
+				},
+			},
+			promo: &kargoapi.Promotion{},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+				require.Equal(
+					t,
+					"CHG0001234",
+					status.Metadata[changeRequestMetadataKey(testURL)],
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status, _, err := testCase.promoMech.Promote(
+				context.Background(),
+				testCase.stage,
+				testCase.promo,
+				nil,
+			)
+			testCase.assertions(t, status, err)
+		})
+	}
+}
+
+func TestGetChangeRequest(t *testing.T) {
+	testPromo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-promotion",
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage:   "fake-stage",
+			Freight: "fake-freight",
+		},
+	}
+	testCases := []struct {
+		name       string
+		handler    http.HandlerFunc
+		creds      *credentials.Credentials
+		assertions func(*testing.T, *changeRequest, error)
+	}{
+		{
+			name: "approved change request",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				query := r.URL.Query()
+				if query.Get("project") != "fake-project" ||
+					query.Get("stage") != "fake-stage" ||
+					query.Get("freight") != "fake-freight" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				if username, password, ok := r.BasicAuth(); !ok ||
+					username != "fake-username" || password != "fake-password" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				_, _ = w.Write([]byte(`{"id":"CHG0001234","approved":true}`))
+			},
+			creds: &credentials.Credentials{
+				Username: "fake-username",
+				Password: "fake-password",
+			},
+			assertions: func(t *testing.T, change *changeRequest, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &changeRequest{ID: "CHG0001234", Approved: true}, change)
+			},
+		},
+		{
+			name: "unapproved change request",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"id":"CHG0001234","approved":false}`))
+			},
+			assertions: func(t *testing.T, change *changeRequest, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &changeRequest{ID: "CHG0001234"}, change)
+			},
+		},
+		{
+			name: "no change request",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			},
+			assertions: func(t *testing.T, change *changeRequest, err error) {
+				require.NoError(t, err)
+				require.Nil(t, change)
+			},
+		},
+		{
+			name: "unexpected status code",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			assertions: func(t *testing.T, _ *changeRequest, err error) {
+				require.ErrorContains(t, err, "received unexpected HTTP 500")
+			},
+		},
+		{
+			name: "invalid response body",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`not json`))
+			},
+			assertions: func(t *testing.T, _ *changeRequest, err error) {
+				require.ErrorContains(t, err, "error unmarshaling response from ticketing system")
+			},
+		},
+		{
+			name: "response does not identify a change request",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"approved":true}`))
+			},
+			assertions: func(t *testing.T, _ *changeRequest, err error) {
+				require.ErrorContains(t, err, "did not identify a change request")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			srv := httptest.NewServer(testCase.handler)
+			t.Cleanup(srv.Close)
+			c := &changeApprovalMechanism{httpClient: &http.Client{}}
+			change, err := c.getChangeRequest(
+				context.Background(),
+				&kargoapi.ChangeApprovalCheck{URL: srv.URL},
+				testPromo,
+				testCase.creds,
+			)
+			testCase.assertions(t, change, err)
+		})
+	}
+}
+
+func TestGetChangeRequestTimeout(t *testing.T) {
+	// The ticketing system never responds.
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	t.Cleanup(srv.Close)
+	check := &kargoapi.ChangeApprovalCheck{URL: srv.URL}
+	promo := &kargoapi.Promotion{}
+
+	t.Run("client times out", func(t *testing.T) {
+		c := &changeApprovalMechanism{
+			httpClient: &http.Client{Timeout: 100 * time.Millisecond},
+		}
+		_, err := c.getChangeRequest(context.Background(), check, promo, nil)
+		require.ErrorContains(t, err, "error querying ticketing system")
+		require.ErrorContains(t, err, "Client.Timeout exceeded")
+	})
+
+	t.Run("context is canceled", func(t *testing.T) {
+		c := &changeApprovalMechanism{httpClient: &http.Client{}}
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_, err := c.getChangeRequest(ctx, check, promo, nil)
+		require.ErrorContains(t, err, "error querying ticketing system")
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -0,0 +1,101 @@
+package promotion
+
+import (
+	"context"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/helm"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// ChangelogBuilder builds the changelog of the Helm charts updated by a
+// Promotion from the changes recorded in the annotations of every intervening
+// version of each chart.
+type ChangelogBuilder struct {
+	// These behaviors are overridable for testing purposes:
+	getChartChangesFn func(
+		ctx context.Context,
+		namespace string,
+		repository string,
+		chart string,
+		fromVersion string,
+		toVersion string,
+	) ([]helm.ChartChange, error)
+}
+
+// NewChangelogBuilder returns a ChangelogBuilder that uses the provided
+// credentials database to obtain credentials for chart repositories.
+func NewChangelogBuilder(credentialsDB credentials.Database) *ChangelogBuilder {
+	return &ChangelogBuilder{
+		getChartChangesFn: getChartChangesFn(credentialsDB),
+	}
+}
+
+// Build returns the changes made to every chart referenced by the provided new
+// Freight since the version of the same chart referenced by the provided
+// current FreightCollection, which may be nil if the Stage has no Freight yet.
+// Charts that are not referenced by the current FreightCollection, or whose
+// versions are unchanged, contribute no changes. Credentials are looked up in
+// the provided namespace. Failure to obtain the changes made to a chart is
+// logged, but is otherwise not treated as an error, as a changelog is merely
+// informational.
+func (c *ChangelogBuilder) Build(
+	ctx context.Context,
+	namespace string,
+	current *kargoapi.FreightCollection,
+	newFreight []kargoapi.FreightReference,
+) []kargoapi.ChartChange {
+	if current == nil {
+		return nil
+	}
+	currentVersions := map[string]string{}
+	for _, freight := range current.Freight {
+		for _, chart := range freight.Charts {
+			currentVersions[chartKey(chart)] = chart.Version
+		}
+	}
+	logger := logging.LoggerFromContext(ctx)
+	var changes []kargoapi.ChartChange
+	for _, freight := range newFreight {
+		for _, chart := range freight.Charts {
+			fromVersion := currentVersions[chartKey(chart)]
+			if fromVersion == "" || fromVersion == chart.Version {
+				continue
+			}
+			chartChanges, err := c.getChartChangesFn(
+				ctx,
+				namespace,
+				chart.RepoURL,
+				chart.Name,
+				fromVersion,
+				chart.Version,
+			)
+			if err != nil {
+				logger.Error(
+					err, "error obtaining changes for chart",
+					"repoURL", chart.RepoURL,
+					"chart", chart.Name,
+					"fromVersion", fromVersion,
+					"toVersion", chart.Version,
+				)
+				continue
+			}
+			for _, change := range chartChanges {
+				changes = append(changes, kargoapi.ChartChange{
+					RepoURL:     chart.RepoURL,
+					Name:        chart.Name,
+					Version:     change.Version,
+					Description: change.Description,
+				})
+			}
+		}
+	}
+	return changes
+}
+
+// chartKey returns a key that uniquely identifies the provided chart,
+// irrespective of its version.
+func chartKey(chart kargoapi.Chart) string {
+	return chart.RepoURL + ":" + chart.Name
+}
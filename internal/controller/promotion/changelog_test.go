@@ -0,0 +1,110 @@
+package promotion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/helm"
+)
+
+func TestNewChangelogBuilder(t *testing.T) {
+	b := NewChangelogBuilder(&credentials.FakeDB{})
+	require.NotNil(t, b.getChartChangesFn)
+}
+
+func TestChangelogBuilderBuild(t *testing.T) {
+	const testRepoURL = "https://charts.example.com"
+	current := &kargoapi.FreightCollection{
+		Freight: map[string]kargoapi.FreightReference{
+			"Warehouse/fake-warehouse": {
+				Charts: []kargoapi.Chart{
+					{RepoURL: testRepoURL, Name: "fake-chart", Version: "1.0.0"},
+					{RepoURL: testRepoURL, Name: "fake-unchanged-chart", Version: "1.0.0"},
+					{RepoURL: testRepoURL, Name: "fake-broken-chart", Version: "1.0.0"},
+				},
+			},
+		},
+	}
+	newFreight := []kargoapi.FreightReference{{
+		Charts: []kargoapi.Chart{
+			{RepoURL: testRepoURL, Name: "fake-chart", Version: "1.2.0"},
+			{RepoURL: testRepoURL, Name: "fake-unchanged-chart", Version: "1.0.0"},
+			{RepoURL: testRepoURL, Name: "fake-broken-chart", Version: "1.1.0"},
+			{RepoURL: testRepoURL, Name: "fake-new-chart", Version: "1.0.0"},
+		},
+	}}
+	testCases := []struct {
+		name       string
+		current    *kargoapi.FreightCollection
+		assertions func(*testing.T, []kargoapi.ChartChange)
+	}{
+		{
+			name: "Stage has no Freight",
+			assertions: func(t *testing.T, changes []kargoapi.ChartChange) {
+				require.Empty(t, changes)
+			},
+		},
+		{
+			name:    "charts have changed",
+			current: current,
+			assertions: func(t *testing.T, changes []kargoapi.ChartChange) {
+				require.Equal(
+					t,
+					[]kargoapi.ChartChange{
+						{
+							RepoURL:     testRepoURL,
+							Name:        "fake-chart",
+							Version:     "1.1.0",
+							Description: "Added a feature",
+						},
+						{
+							RepoURL:     testRepoURL,
+							Name:        "fake-chart",
+							Version:     "1.2.0",
+							Description: "Fixed a bug",
+						},
+					},
+					changes,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			b := &ChangelogBuilder{
+				getChartChangesFn: func(
+					_ context.Context,
+					namespace string,
+					repository string,
+					chart string,
+					fromVersion string,
+					toVersion string,
+				) ([]helm.ChartChange, error) {
+					require.Equal(t, "fake-namespace", namespace)
+					require.Equal(t, testRepoURL, repository)
+					switch chart {
+					case "fake-chart":
+						require.Equal(t, "1.0.0", fromVersion)
+						require.Equal(t, "1.2.0", toVersion)
+						return []helm.ChartChange{
+							{Version: "1.1.0", Description: "Added a feature"},
+							{Version: "1.2.0", Description: "Fixed a bug"},
+						}, nil
+					case "fake-broken-chart":
+						return nil, errors.New("something went wrong")
+					}
+					return nil, errors.New("unexpected chart")
+				},
+			}
+			testCase.assertions(
+				t,
+				b.Build(context.Background(), "fake-namespace", testCase.current, newFreight),
+			)
+		})
+	}
+}
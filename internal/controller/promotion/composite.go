@@ -56,14 +56,18 @@ func (c *compositeMechanism) Promote(
 	logger.Debug("executing composite promotion mechanism")
 
 	for _, childMechanism := range c.childMechanisms {
+		// Do not start executing another mechanism if the Promotion has, for
+		// instance, already timed out.
+		if ctx.Err() != nil {
+			return nil, newFreight, context.Cause(ctx)
+		}
 		var err error
 		var otherStatus *kargoapi.PromotionStatus
 		otherStatus, newFreight, err = childMechanism.Promote(ctx, stage, promo, newFreight)
 		if err != nil {
-			return nil, newFreight, fmt.Errorf(
-				"error executing %s: %w",
-				childMechanism.GetName(),
-				err,
+			return nil, newFreight, withContextCause(
+				ctx,
+				fmt.Errorf("error executing %s: %w", childMechanism.GetName(), err),
 			)
 		}
 		newStatus = aggregateGitPromoStatus(newStatus, *otherStatus)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -136,3 +137,70 @@ func TestCompositePromote(t *testing.T) {
 		})
 	}
 }
+
+func TestCompositePromoteTimeout(t *testing.T) {
+	const timeout = 10 * time.Millisecond
+	ctx, cancel := context.WithTimeoutCause(
+		context.Background(),
+		timeout,
+		&ErrPromotionTimeout{Timeout: timeout},
+	)
+	defer cancel()
+
+	promoMech := &compositeMechanism{
+		childMechanisms: []Mechanism{
+			&FakeMechanism{
+				Name: "slow promotion mechanism",
+				PromoteFn: func(
+					ctx context.Context,
+					_ *kargoapi.Stage,
+					newFreight []kargoapi.FreightReference,
+				) (*kargoapi.PromotionStatus, []kargoapi.FreightReference, error) {
+					<-ctx.Done()
+					return nil, newFreight, errors.New("signal: killed")
+				},
+			},
+			&FakeMechanism{
+				Name: "next promotion mechanism",
+				PromoteFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					[]kargoapi.FreightReference,
+				) (*kargoapi.PromotionStatus, []kargoapi.FreightReference, error) {
+					require.Fail(t, "promotion mechanism should not have been executed")
+					return nil, nil, nil
+				},
+			},
+		},
+	}
+
+	_, _, err := promoMech.Promote(
+		ctx,
+		&kargoapi.Stage{
+			Spec: kargoapi.StageSpec{
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+			},
+		},
+		&kargoapi.Promotion{},
+		nil,
+	)
+	var timeoutErr *ErrPromotionTimeout
+	require.True(t, errors.As(err, &timeoutErr))
+	require.Equal(t, timeout, timeoutErr.Timeout)
+	require.ErrorContains(t, err, "error executing slow promotion mechanism")
+	require.ErrorContains(t, err, "signal: killed")
+
+	// Once the Promotion has timed out, no mechanism is executed at all
+	_, _, err = promoMech.Promote(
+		ctx,
+		&kargoapi.Stage{
+			Spec: kargoapi.StageSpec{
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+			},
+		},
+		&kargoapi.Promotion{},
+		nil,
+	)
+	require.True(t, errors.As(err, &timeoutErr))
+	require.Equal(t, "Promotion did not complete within 10ms", err.Error())
+}
@@ -0,0 +1,220 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/freight"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// helmReleaseGVK is the GroupVersionKind of Flux HelmRelease resources. These
+// are handled as unstructured objects to avoid a dependency on Flux's API
+// module.
+var helmReleaseGVK = schema.GroupVersionKind{
+	Group:   "helm.toolkit.fluxcd.io",
+	Version: "v2",
+	Kind:    "HelmRelease",
+}
+
+// fluxMechanism is an implementation of the Mechanism interface that updates
+// Flux HelmRelease resources.
+type fluxMechanism struct {
+	kargoClient client.Client
+	// These behaviors are overridable for testing purposes:
+	getAuthorizedHelmReleaseFn func(
+		ctx context.Context,
+		namespace string,
+		name string,
+		stageMeta metav1.ObjectMeta,
+	) (*unstructured.Unstructured, error)
+	updateHelmReleaseChartVersionFn func(
+		ctx context.Context,
+		helmRelease *unstructured.Unstructured,
+		version string,
+	) error
+}
+
+// newFluxMechanism returns an implementation of the Mechanism interface that
+// updates Flux HelmRelease resources.
+func newFluxMechanism(kargoClient client.Client) Mechanism {
+	f := &fluxMechanism{
+		kargoClient: kargoClient,
+	}
+	f.getAuthorizedHelmReleaseFn = f.getAuthorizedHelmRelease
+	f.updateHelmReleaseChartVersionFn = f.updateHelmReleaseChartVersion
+	return f
+}
+
+// GetName implements the Mechanism interface.
+func (*fluxMechanism) GetName() string {
+	return "Flux promotion mechanism"
+}
+
+// Promote implements the Mechanism interface. The chart version of each
+// HelmRelease is updated to the version of the corresponding chart found in
+// the Freight. Flux reconciles the HelmRelease asynchronously, so the outcome
+// of the upgrade itself is left to the Stage's health checks and verification.
+func (f *fluxMechanism) Promote(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	newFreight []kargoapi.FreightReference,
+) (*kargoapi.PromotionStatus, []kargoapi.FreightReference, error) {
+	updates := stage.Spec.PromotionMechanisms.FluxHelmReleaseUpdates
+
+	if len(updates) == 0 {
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	logger.Debug("executing Flux-based promotion mechanisms")
+
+	for i := range updates {
+		update := &updates[i]
+		namespace := update.Namespace
+		if namespace == "" {
+			namespace = stage.Namespace
+		}
+
+		helmRelease, err := f.getAuthorizedHelmReleaseFn(
+			ctx,
+			namespace,
+			update.Name,
+			stage.ObjectMeta,
+		)
+		if err != nil {
+			return nil, newFreight, err
+		}
+
+		desiredOrigin := freight.GetDesiredOrigin(stage, update)
+		chart, err := freight.FindChart(
+			ctx,
+			f.kargoClient,
+			stage,
+			desiredOrigin,
+			newFreight,
+			update.RepoURL,
+			update.Chart,
+		)
+		if err != nil {
+			return nil, newFreight,
+				fmt.Errorf("error finding chart from repo %q: %w", update.RepoURL, err)
+		}
+		if chart == nil {
+			// There's no change to make in this case.
+			continue
+		}
+
+		if stage.Spec.DryRun {
+			logger.Info(
+				"dry run: not updating Flux HelmRelease",
+				"helmRelease", update.Name,
+				"helmReleaseNamespace", namespace,
+				"version", chart.Version,
+			)
+			continue
+		}
+
+		if err = f.updateHelmReleaseChartVersionFn(
+			ctx,
+			helmRelease,
+			chart.Version,
+		); err != nil {
+			return nil, newFreight, err
+		}
+	}
+
+	logger.Debug("done executing Flux-based promotion mechanisms")
+	return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+}
+
+// getAuthorizedHelmRelease returns a Flux HelmRelease in the given namespace
+// with the given name, if it is authorized for mutation by the Kargo Stage
+// represented by stageMeta.
+func (f *fluxMechanism) getAuthorizedHelmRelease(
+	ctx context.Context,
+	namespace string,
+	name string,
+	stageMeta metav1.ObjectMeta,
+) (*unstructured.Unstructured, error) {
+	helmRelease := &unstructured.Unstructured{}
+	helmRelease.SetGroupVersionKind(helmReleaseGVK)
+	if err := f.kargoClient.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      name,
+		},
+		helmRelease,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf(
+				"unable to find Flux HelmRelease %q in namespace %q",
+				name, namespace,
+			)
+		}
+		return nil, fmt.Errorf(
+			"error finding Flux HelmRelease %q in namespace %q: %w",
+			name, namespace, err,
+		)
+	}
+
+	if err := authorizeStageMutation(
+		"Flux HelmRelease",
+		stageMeta,
+		metav1.ObjectMeta{
+			Namespace:   helmRelease.GetNamespace(),
+			Name:        helmRelease.GetName(),
+			Annotations: helmRelease.GetAnnotations(),
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return helmRelease, nil
+}
+
+// updateHelmReleaseChartVersion patches the chart version of the provided Flux
+// HelmRelease. If the HelmRelease already references the provided version, it
+// is left untouched.
+func (f *fluxMechanism) updateHelmReleaseChartVersion(
+	ctx context.Context,
+	helmRelease *unstructured.Unstructured,
+	version string,
+) error {
+	versionPath := []string{"spec", "chart", "spec", "version"}
+	currentVersion, _, err := unstructured.NestedString(helmRelease.Object, versionPath...)
+	if err != nil {
+		return fmt.Errorf(
+			"error reading chart version of Flux HelmRelease %q in namespace %q: %w",
+			helmRelease.GetName(), helmRelease.GetNamespace(), err,
+		)
+	}
+	if currentVersion == version {
+		return nil
+	}
+
+	patch := client.MergeFrom(helmRelease.DeepCopy())
+	if err = unstructured.SetNestedField(helmRelease.Object, version, versionPath...); err != nil {
+		return fmt.Errorf(
+			"error setting chart version of Flux HelmRelease %q in namespace %q: %w",
+			helmRelease.GetName(), helmRelease.GetNamespace(), err,
+		)
+	}
+	if err = f.kargoClient.Patch(ctx, helmRelease, patch); err != nil {
+		return fmt.Errorf(
+			"error patching Flux HelmRelease %q in namespace %q: %w",
+			helmRelease.GetName(), helmRelease.GetNamespace(), err,
+		)
+	}
+	return nil
+}
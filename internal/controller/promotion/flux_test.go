@@ -0,0 +1,459 @@
+package promotion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewFluxMechanism(t *testing.T) {
+	pm := newFluxMechanism(fake.NewFakeClient())
+	fpm, ok := pm.(*fluxMechanism)
+	require.True(t, ok)
+	require.NotNil(t, fpm.kargoClient)
+	require.NotNil(t, fpm.getAuthorizedHelmReleaseFn)
+	require.NotNil(t, fpm.updateHelmReleaseChartVersionFn)
+}
+
+func TestFluxGetName(t *testing.T) {
+	require.NotEmpty(t, (&fluxMechanism{}).GetName())
+}
+
+func TestFluxPromote(t *testing.T) {
+	testOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	testFreight := []kargoapi.FreightReference{{
+		Origin: testOrigin,
+		Charts: []kargoapi.Chart{{
+			RepoURL: "https://example.com/charts",
+			Name:    "fake-chart",
+			Version: "1.2.3",
+		}},
+	}}
+	testUpdate := kargoapi.FluxHelmReleaseUpdate{
+		Name:    "fake-helm-release",
+		RepoURL: "https://example.com/charts",
+		Chart:   "fake-chart",
+		Origin:  &testOrigin,
+	}
+
+	testCases := []struct {
+		name       string
+		promoMech  *fluxMechanism
+		stage      *kargoapi.Stage
+		assertions func(*testing.T, *kargoapi.PromotionStatus, error)
+	}{
+		{
+			name:      "no updates",
+			promoMech: &fluxMechanism{},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "error getting HelmRelease",
+			promoMech: &fluxMechanism{
+				getAuthorizedHelmReleaseFn: func(
+					context.Context,
+					string,
+					string,
+					metav1.ObjectMeta,
+				) (*unstructured.Unstructured, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						FluxHelmReleaseUpdates: []kargoapi.FluxHelmReleaseUpdate{testUpdate},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "HelmRelease namespace defaults to Stage namespace",
+			promoMech: &fluxMechanism{
+				getAuthorizedHelmReleaseFn: func(
+					_ context.Context,
+					namespace string,
+					_ string,
+					_ metav1.ObjectMeta,
+				) (*unstructured.Unstructured, error) {
+					if namespace != "fake-namespace" {
+						return nil, errors.New("unexpected namespace")
+					}
+					return &unstructured.Unstructured{}, nil
+				},
+				updateHelmReleaseChartVersionFn: func(
+					context.Context,
+					*unstructured.Unstructured,
+					string,
+				) error {
+					return nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+				},
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						FluxHelmReleaseUpdates: []kargoapi.FluxHelmReleaseUpdate{testUpdate},
+					},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "chart not found in Freight",
+			promoMech: &fluxMechanism{
+				getAuthorizedHelmReleaseFn: func(
+					context.Context,
+					string,
+					string,
+					metav1.ObjectMeta,
+				) (*unstructured.Unstructured, error) {
+					return &unstructured.Unstructured{}, nil
+				},
+				updateHelmReleaseChartVersionFn: func(
+					context.Context,
+					*unstructured.Unstructured,
+					string,
+				) error {
+					return errors.New("should not be called")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						FluxHelmReleaseUpdates: []kargoapi.FluxHelmReleaseUpdate{{
+							Name:    "fake-helm-release",
+							RepoURL: "https://example.com/other-charts",
+							Chart:   "fake-chart",
+							Origin:  &testOrigin,
+						}},
+					},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "dry run",
+			promoMech: &fluxMechanism{
+				getAuthorizedHelmReleaseFn: func(
+					context.Context,
+					string,
+					string,
+					metav1.ObjectMeta,
+				) (*unstructured.Unstructured, error) {
+					return &unstructured.Unstructured{}, nil
+				},
+				updateHelmReleaseChartVersionFn: func(
+					context.Context,
+					*unstructured.Unstructured,
+					string,
+				) error {
+					return errors.New("should not be called")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					DryRun: true,
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						FluxHelmReleaseUpdates: []kargoapi.FluxHelmReleaseUpdate{testUpdate},
+					},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "error updating HelmRelease",
+			promoMech: &fluxMechanism{
+				getAuthorizedHelmReleaseFn: func(
+					context.Context,
+					string,
+					string,
+					metav1.ObjectMeta,
+				) (*unstructured.Unstructured, error) {
+					return &unstructured.Unstructured{}, nil
+				},
+				updateHelmReleaseChartVersionFn: func(
+					context.Context,
+					*unstructured.Unstructured,
+					string,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						FluxHelmReleaseUpdates: []kargoapi.FluxHelmReleaseUpdate{testUpdate},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			promoMech: &fluxMechanism{
+				getAuthorizedHelmReleaseFn: func(
+					context.Context,
+					string,
+					string,
+					metav1.ObjectMeta,
+				) (*unstructured.Unstructured, error) {
+					return &unstructured.Unstructured{}, nil
+				},
+				updateHelmReleaseChartVersionFn: func(
+					_ context.Context,
+					_ *unstructured.Unstructured,
+					version string,
+				) error {
+					if version != "1.2.3" {
+						return errors.New("unexpected version")
+					}
+					return nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						FluxHelmReleaseUpdates: []kargoapi.FluxHelmReleaseUpdate{testUpdate},
+					},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status, _, err := testCase.promoMech.Promote(
+				context.Background(),
+				testCase.stage,
+				&kargoapi.Promotion{},
+				testFreight,
+			)
+			testCase.assertions(t, status, err)
+		})
+	}
+}
+
+func TestFluxGetAuthorizedHelmRelease(t *testing.T) {
+	testStageMeta := metav1.ObjectMeta{
+		Namespace: "fake-namespace",
+		Name:      "fake-stage",
+	}
+	newHelmRelease := func(annotations map[string]string) *unstructured.Unstructured {
+		hr := &unstructured.Unstructured{}
+		hr.SetGroupVersionKind(helmReleaseGVK)
+		hr.SetNamespace("fake-namespace")
+		hr.SetName("fake-helm-release")
+		hr.SetAnnotations(annotations)
+		return hr
+	}
+
+	testCases := []struct {
+		name        string
+		helmRelease *unstructured.Unstructured
+		assertions  func(*testing.T, *unstructured.Unstructured, error)
+	}{
+		{
+			name: "HelmRelease not found",
+			assertions: func(t *testing.T, _ *unstructured.Unstructured, err error) {
+				require.ErrorContains(t, err, "unable to find Flux HelmRelease")
+			},
+		},
+		{
+			name:        "HelmRelease not authorized",
+			helmRelease: newHelmRelease(nil),
+			assertions: func(t *testing.T, _ *unstructured.Unstructured, err error) {
+				require.ErrorContains(t, err, "does not permit mutation")
+				require.ErrorContains(t, err, "Flux HelmRelease")
+			},
+		},
+		{
+			name: "success",
+			helmRelease: newHelmRelease(map[string]string{
+				authorizedStageAnnotationKey: "fake-namespace:fake-stage",
+			}),
+			assertions: func(t *testing.T, hr *unstructured.Unstructured, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-helm-release", hr.GetName())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(newHelmReleaseScheme())
+			if testCase.helmRelease != nil {
+				c.WithObjects(testCase.helmRelease)
+			}
+			f := &fluxMechanism{
+				kargoClient: c.Build(),
+			}
+			hr, err := f.getAuthorizedHelmRelease(
+				context.Background(),
+				"fake-namespace",
+				"fake-helm-release",
+				testStageMeta,
+			)
+			testCase.assertions(t, hr, err)
+		})
+	}
+}
+
+func TestFluxUpdateHelmReleaseChartVersion(t *testing.T) {
+	newHelmRelease := func(version string) *unstructured.Unstructured {
+		hr := &unstructured.Unstructured{}
+		hr.SetGroupVersionKind(helmReleaseGVK)
+		hr.SetNamespace("fake-namespace")
+		hr.SetName("fake-helm-release")
+		require.NoError(
+			t,
+			unstructured.SetNestedField(
+				hr.Object,
+				version,
+				"spec", "chart", "spec", "version",
+			),
+		)
+		return hr
+	}
+
+	testCases := []struct {
+		name        string
+		interceptor interceptor.Funcs
+		helmRelease *unstructured.Unstructured
+		assertions  func(*testing.T, client.Client, error)
+	}{
+		{
+			name:        "version is already up to date",
+			helmRelease: newHelmRelease("1.2.3"),
+			interceptor: interceptor.Funcs{
+				Patch: func(
+					context.Context,
+					client.WithWatch,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					return errors.New("should not be called")
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:        "error patching HelmRelease",
+			helmRelease: newHelmRelease("1.0.0"),
+			interceptor: interceptor.Funcs{
+				Patch: func(
+					context.Context,
+					client.WithWatch,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, "error patching Flux HelmRelease")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name:        "success",
+			helmRelease: newHelmRelease("1.0.0"),
+			assertions: func(t *testing.T, c client.Client, err error) {
+				require.NoError(t, err)
+				hr := &unstructured.Unstructured{}
+				hr.SetGroupVersionKind(helmReleaseGVK)
+				require.NoError(
+					t,
+					c.Get(
+						context.Background(),
+						types.NamespacedName{
+							Namespace: "fake-namespace",
+							Name:      "fake-helm-release",
+						},
+						hr,
+					),
+				)
+				version, _, err := unstructured.NestedString(
+					hr.Object,
+					"spec", "chart", "spec", "version",
+				)
+				require.NoError(t, err)
+				require.Equal(t, "1.2.3", version)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(newHelmReleaseScheme()).
+				WithObjects(testCase.helmRelease.DeepCopy()).
+				WithInterceptorFuncs(testCase.interceptor).
+				Build()
+			f := &fluxMechanism{
+				kargoClient: c,
+			}
+			err := f.updateHelmReleaseChartVersion(
+				context.Background(),
+				testCase.helmRelease,
+				"1.2.3",
+			)
+			testCase.assertions(t, c, err)
+		})
+	}
+}
+
+// newHelmReleaseScheme returns a scheme that knows about Flux HelmRelease
+// resources, which are handled as unstructured objects.
+func newHelmReleaseScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypeWithName(helmReleaseGVK, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(
+		helmReleaseGVK.GroupVersion().WithKind(helmReleaseGVK.Kind+"List"),
+		&unstructured.UnstructuredList{},
+	)
+	return scheme
+}
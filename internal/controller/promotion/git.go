@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"text/template"
 
 	"github.com/kelseyhightower/envconfig"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -15,6 +19,7 @@ import (
 	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/sops"
 )
 
 const tmpPrefix = "repo-scrap-"
@@ -24,6 +29,13 @@ type GitConfig struct {
 	Email          string `envconfig:"GITCLIENT_EMAIL"`
 	SigningKeyType string `envconfig:"GITCLIENT_SIGNING_KEY_TYPE"`
 	SigningKeyPath string `envconfig:"GITCLIENT_SIGNING_KEY_PATH"`
+	// RebaseConflictStrategy specifies how conflicts are resolved when a push
+	// is rejected and the promotion's changes must be rebased onto new commits
+	// in the remote branch. Valid values are "fail", "ours" and "theirs".
+	RebaseConflictStrategy string `envconfig:"GITCLIENT_REBASE_CONFLICT_STRATEGY" default:"fail"`
+	// GitMirrors maps the URLs of Git repositories to the URLs of mirrors that
+	// they are cloned from instead.
+	GitMirrors git.Mirrors `envconfig:"GIT_MIRRORS"`
 }
 
 func GitConfigFromEnv() GitConfig {
@@ -55,8 +67,25 @@ type gitMechanism struct {
 		*kargoapi.GitRepoUpdate,
 		[]kargoapi.FreightReference,
 	) (string, *kargoapi.GitCommit, error)
-	getAuthorFn      func() (*git.User, error)
-	getCredentialsFn func(
+	getAuthorFn     func() (*git.User, error)
+	gitCloneFn      func(string, *git.ClientOptions, *git.CloneOptions) (git.Repo, error)
+	getSigningKeyFn func(
+		ctx context.Context,
+		namespace string,
+		ref kargoapi.SecretKeyReference,
+	) (string, error)
+	getSOPSAgeKeyFn func(
+		ctx context.Context,
+		namespace string,
+		ref kargoapi.SecretKeyReference,
+	) (string, error)
+	decryptSOPSFilesFn func(
+		ctx context.Context,
+		dir string,
+		ageKey string,
+	) ([]*sops.File, error)
+	encryptSOPSFilesFn func(context.Context, []*sops.File) error
+	getCredentialsFn   func(
 		ctx context.Context,
 		namespace string,
 		repoURL string,
@@ -113,6 +142,11 @@ func newGitMechanism(
 	g.getReadRefFn = getReadRef
 	g.getCredentialsFn = getRepoCredentialsFn(credentialsDB)
 	g.getAuthorFn = g.getAuthor
+	g.gitCloneFn = git.Clone
+	g.getSigningKeyFn = g.getSigningKey
+	g.getSOPSAgeKeyFn = g.getSOPSAgeKey
+	g.decryptSOPSFilesFn = sops.DecryptFiles
+	g.encryptSOPSFilesFn = sops.EncryptFiles
 	g.gitCommitFn = g.gitCommit
 	g.applyConfigManagementFn = applyConfigManagementFn
 	return g
@@ -142,6 +176,9 @@ func (g *gitMechanism) Promote(
 	logger.Debug("executing promotion mechanism")
 
 	for _, update := range updates {
+		if ctx.Err() != nil {
+			return nil, newFreight, context.Cause(ctx)
+		}
 		var err error
 		var otherStatus *kargoapi.PromotionStatus
 		if otherStatus, newFreight, err = g.doSingleUpdateFn(
@@ -151,7 +188,7 @@ func (g *gitMechanism) Promote(
 			update,
 			newFreight,
 		); err != nil {
-			return nil, newFreight, err
+			return nil, newFreight, withContextCause(ctx, err)
 		}
 		newStatus = aggregateGitPromoStatus(newStatus, *otherStatus)
 	}
@@ -161,6 +198,44 @@ func (g *gitMechanism) Promote(
 	return newStatus, newFreight, nil
 }
 
+// cloneRepo clones the repository referenced by the provided GitRepoUpdate,
+// or its mirror if one is configured. If the update specifies a shallow clone
+// depth and the shallow clone fails, as it will if the server does not support
+// shallow clones, a full clone is performed instead.
+func (g *gitMechanism) cloneRepo(
+	ctx context.Context,
+	update *kargoapi.GitRepoUpdate,
+	clientOpts *git.ClientOptions,
+) (git.Repo, error) {
+	cloneOpts := &git.CloneOptions{
+		Depth:                 uint(update.ShallowCloneDepth),
+		InsecureSkipTLSVerify: update.InsecureSkipTLSVerify,
+	}
+	repoURL := g.cfg.GitMirrors.RewriteURL(update.RepoURL)
+	if repoURL != update.RepoURL {
+		logging.LoggerFromContext(ctx).Debug(
+			"cloning git repo from mirror",
+			"repo", update.RepoURL,
+			"mirror", repoURL,
+		)
+	}
+	repo, err := g.gitCloneFn(repoURL, clientOpts, cloneOpts)
+	if err == nil || cloneOpts.Depth == 0 {
+		return repo, err
+	}
+	logging.LoggerFromContext(ctx).Info(
+		"shallow clone of git repo failed; falling back to a full clone",
+		"repo", update.RepoURL,
+		"depth", cloneOpts.Depth,
+		"error", err.Error(),
+	)
+	if repo != nil {
+		_ = repo.Close()
+	}
+	cloneOpts.Depth = 0
+	return g.gitCloneFn(repoURL, clientOpts, cloneOpts)
+}
+
 // doSingleUpdate updates configuration in a single Git repository by
 // making a git commit with the changes. If performing a pull request
 // promotion, will create a with PR for the git commit instead of
@@ -183,13 +258,10 @@ func (g *gitMechanism) doSingleUpdate(
 		return nil, newFreight, err
 	}
 
-	author, err := g.getAuthorFn()
+	author, err := g.getCommitAuthor(ctx, stage, update)
 	if err != nil {
 		return nil, newFreight, err
 	}
-	if author == nil {
-		author = &git.User{}
-	}
 	creds, err := g.getCredentialsFn(
 		ctx,
 		promo.Namespace,
@@ -201,15 +273,13 @@ func (g *gitMechanism) doSingleUpdate(
 	if creds == nil {
 		creds = &git.RepoCredentials{}
 	}
-	repo, err := git.Clone(
-		update.RepoURL,
+	repo, err := g.cloneRepo(
+		ctx,
+		update,
 		&git.ClientOptions{
 			User:        author,
 			Credentials: creds,
 		},
-		&git.CloneOptions{
-			InsecureSkipTLSVerify: update.InsecureSkipTLSVerify,
-		},
 	)
 	if err != nil {
 		return nil, newFreight, fmt.Errorf("error cloning git repo %q: %w", update.RepoURL, err)
@@ -217,7 +287,7 @@ func (g *gitMechanism) doSingleUpdate(
 	defer repo.Close()
 
 	commitBranch := update.WriteBranch
-	if update.PullRequest != nil {
+	if update.PullRequest != nil && !stage.Spec.DryRun {
 		// When doing a PR promotion, instead of committing to writeBranch directly,
 		// we commit to a temporary, PR branch, which is a child of writeBranch.
 		commitBranch = pullRequestBranchName(promo.Namespace, promo.Spec.Stage)
@@ -245,6 +315,12 @@ func (g *gitMechanism) doSingleUpdate(
 	}
 
 	newStatus := promo.Status.DeepCopy()
+	if stage.Spec.DryRun {
+		// Nothing was pushed, so there is neither a pull request to open nor a
+		// new commit to health check.
+		newStatus.Phase = kargoapi.PromotionPhaseSucceeded
+		return newStatus, newFreight, nil
+	}
 	if update.PullRequest != nil {
 		gpClient, err := newGitProvider(update, creds)
 		if err != nil {
@@ -254,6 +330,22 @@ func (g *gitMechanism) doSingleUpdate(
 		if err != nil {
 			return nil, newFreight, err
 		}
+		// A merge commit is only reported once the pull request was merged.
+		if update.PullRequest.DeleteBranchOnMerge && commitID != "" {
+			if newStatus, err = deleteMergedPullRequestBranch(
+				ctx,
+				newStatus,
+				repo,
+				gpClient,
+				commitBranch,
+			); err != nil {
+				return nil, newFreight, fmt.Errorf(
+					"error deleting merged pull request branch %q: %w",
+					commitBranch,
+					err,
+				)
+			}
+		}
 	} else {
 		// For git commit promotions, promotion is successful as soon as the commit is pushed.
 		newStatus.Phase = kargoapi.PromotionPhaseSucceeded
@@ -326,9 +418,11 @@ func getRepoCredentialsFn(
 		}
 		logger.Debug("obtained credentials for git repo")
 		return &git.RepoCredentials{
-			Username:      creds.Username,
-			Password:      creds.Password,
-			SSHPrivateKey: creds.SSHPrivateKey,
+			Username:                creds.Username,
+			Password:                creds.Password,
+			SSHPrivateKey:           creds.SSHPrivateKey,
+			SSHPrivateKeyPassphrase: creds.SSHPrivateKeyPassphrase,
+			SSHKnownHosts:           creds.SSHKnownHosts,
 		}, nil
 	}
 }
@@ -363,6 +457,105 @@ func (g *gitMechanism) getAuthor() (*git.User, error) {
 	return &author, nil
 }
 
+// getCommitAuthor returns the author of commits made for the provided
+// GitRepoUpdate. The author configured for the controller is used unless the
+// update overrides its name, email address or signing key.
+func (g *gitMechanism) getCommitAuthor(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	update *kargoapi.GitRepoUpdate,
+) (*git.User, error) {
+	author, err := g.getAuthorFn()
+	if err != nil {
+		return nil, err
+	}
+	if author == nil {
+		author = &git.User{}
+	}
+	if update.AuthorName != "" {
+		author.Name = update.AuthorName
+	}
+	if update.AuthorEmail != "" {
+		author.Email = update.AuthorEmail
+	}
+	if update.SigningKeySecretRef != nil {
+		signingKey, err := g.getSigningKeyFn(
+			ctx,
+			stage.Namespace,
+			*update.SigningKeySecretRef,
+		)
+		if err != nil {
+			return nil, err
+		}
+		author = &git.User{
+			Name:           author.Name,
+			Email:          author.Email,
+			SigningKeyType: git.SigningKeyTypeGPG,
+			SigningKey:     signingKey,
+		}
+	}
+	return author, nil
+}
+
+// getSigningKey retrieves the GPG private key held by the referenced key of a
+// Secret in the specified namespace.
+func (g *gitMechanism) getSigningKey(
+	ctx context.Context,
+	namespace string,
+	ref kargoapi.SecretKeyReference,
+) (string, error) {
+	return g.getSecretKey(ctx, "signing key", namespace, ref)
+}
+
+// getSOPSAgeKey retrieves the age private key held by the referenced key of a
+// Secret in the specified namespace.
+func (g *gitMechanism) getSOPSAgeKey(
+	ctx context.Context,
+	namespace string,
+	ref kargoapi.SecretKeyReference,
+) (string, error) {
+	return g.getSecretKey(ctx, "SOPS age key", namespace, ref)
+}
+
+// getSecretKey retrieves the value held by the referenced key of a Secret in
+// the specified namespace. The provided description of the value is used in
+// error messages.
+func (g *gitMechanism) getSecretKey(
+	ctx context.Context,
+	description string,
+	namespace string,
+	ref kargoapi.SecretKeyReference,
+) (string, error) {
+	secret := &corev1.Secret{}
+	if err := g.client.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      ref.Name,
+		},
+		secret,
+	); err != nil {
+		return "", fmt.Errorf(
+			"error getting %s Secret %q in namespace %q: %w",
+			description,
+			ref.Name,
+			namespace,
+			err,
+		)
+	}
+	key, ok := secret.Data[ref.Key]
+	if !ok || len(key) == 0 {
+		return "", fmt.Errorf(
+			"%s Secret %q in namespace %q has no key %q",
+			description,
+			ref.Name,
+			namespace,
+			ref.Key,
+		)
+	}
+	return string(key), nil
+}
+
 // gitCommit checks out the specified readRef (if non-empty), applies
 // the provided update function to the cloned repository, and then commits and
 // pushes any changes to the specified writeBranch. The function returns the
@@ -392,97 +585,190 @@ func (g *gitMechanism) gitCommit(
 		return "", err // TODO: Wrap this
 	}
 
-	var changes []string
-	if g.applyConfigManagementFn != nil {
-		if changes, err = g.applyConfigManagementFn(
-			ctx,
-			stage,
-			update,
-			newFreight,
-			sourceCommitID,
-			repo.HomeDir(),
-			repo.WorkingDir(),
-			repoCreds,
-		); err != nil {
-			return "", err
-		}
+	changes, err := g.applyConfigManagement(
+		ctx,
+		stage,
+		update,
+		newFreight,
+		sourceCommitID,
+		repo,
+		repoCreds,
+	)
+	if err != nil {
+		return "", err
+	}
+	commitMsg, err := renderCommitMessage(stage, update, newFreight, changes)
+	if err != nil {
+		return "", err
 	}
-	commitMsg := buildCommitMessage(changes)
 
 	// Sometimes we don't write to the same branch we read from...
 	if readRef != writeBranch {
-		var tempDir string
-		tempDir, err = os.MkdirTemp("", tmpPrefix)
-		if err != nil {
-			return "", fmt.Errorf("error creating temp directory for pending changes: %w", err)
+		if err = moveChangesToBranch(repo, update.RepoURL, writeBranch); err != nil {
+			return "", err
 		}
-		defer os.RemoveAll(tempDir)
+	}
 
-		if err = moveRepoContents(repo.WorkingDir(), tempDir); err != nil {
-			return "", fmt.Errorf("error moving repository working tree to temporary location: %w", err)
-		}
+	hasDiffs, err := repo.HasDiffs()
+	if err != nil {
+		return "", fmt.Errorf("error checking for diffs in git repo %q: %w", update.RepoURL, err)
+	}
 
-		if err = repo.ResetHard(); err != nil {
-			return "", fmt.Errorf("error resetting repository working tree: %w", err)
+	if hasDiffs && stage.Spec.DryRun {
+		logging.LoggerFromContext(ctx).Info(
+			"dry run: not committing or pushing updates to git repo",
+			"repo", update.RepoURL,
+			"branch", writeBranch,
+			"commitMessage", commitMsg,
+		)
+	} else if hasDiffs {
+		if err = repo.AddAllAndCommit(commitMsg); err != nil {
+			return "", fmt.Errorf("error committing updates to git repo %q: %w", update.RepoURL, err)
+		}
+		if err = repo.Push(&git.PushOptions{
+			PullRebase: true,
+			RebaseConflictStrategy: git.RebaseConflictStrategy(
+				g.cfg.RebaseConflictStrategy,
+			),
+		}); err != nil {
+			return "", fmt.Errorf("error pushing updates to git repo %q: %w", update.RepoURL, err)
 		}
+	}
+
+	commitID, err := repo.LastCommitID()
+	if err != nil {
+		return "", fmt.Errorf("error getting last commit ID from git repo %q: %w", update.RepoURL, err)
+	}
+
+	return commitID, nil
+}
+
+// applyConfigManagement applies the configuration management tool of the
+// mechanism, if any, to the working tree of the provided repository. If the
+// provided GitRepoUpdate requests it, SOPS-encrypted files are decrypted
+// beforehand and re-encrypted afterward, so that they can be updated by the
+// tool without their plaintext ever being committed.
+func (g *gitMechanism) applyConfigManagement(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	update *kargoapi.GitRepoUpdate,
+	newFreight []kargoapi.FreightReference,
+	sourceCommitID string,
+	repo git.Repo,
+	repoCreds git.RepoCredentials,
+) ([]string, error) {
+	if g.applyConfigManagementFn == nil {
+		return nil, nil
+	}
 
-		var branchExists bool
-		if branchExists, err = repo.RemoteBranchExists(writeBranch); err != nil {
-			return "", fmt.Errorf(
-				"error checking for existence of branch %q in remote repo %q: %w",
-				writeBranch,
+	var sopsFiles []*sops.File
+	if update.SOPSDecryptBeforeApply {
+		if update.SOPSAgeKeySecretRef == nil {
+			return nil, fmt.Errorf(
+				"no SOPS age key Secret specified for git repo %q",
+				update.RepoURL,
+			)
+		}
+		ageKey, err := g.getSOPSAgeKeyFn(ctx, stage.Namespace, *update.SOPSAgeKeySecretRef)
+		if err != nil {
+			return nil, err
+		}
+		if sopsFiles, err = g.decryptSOPSFilesFn(ctx, repo.WorkingDir(), ageKey); err != nil {
+			return nil, fmt.Errorf(
+				"error decrypting SOPS-encrypted files in git repo %q: %w",
 				update.RepoURL,
 				err,
 			)
-		} else if !branchExists {
-			if err = repo.CreateOrphanedBranch(writeBranch); err != nil {
-				return "", fmt.Errorf(
-					"error creating branch %q in repo %q: %w",
-					writeBranch,
-					update.RepoURL,
-					err,
-				)
-			}
-		} else {
-			if err = repo.Checkout(writeBranch); err != nil {
-				return "", fmt.Errorf(
-					"error checking out branch %q from git repo %q: %w",
-					writeBranch,
-					update.RepoURL,
-					err,
-				)
-			}
 		}
+		logging.LoggerFromContext(ctx).Debug(
+			"decrypted SOPS-encrypted files",
+			"repo", update.RepoURL,
+			"count", len(sopsFiles),
+		)
+	}
 
-		if err = deleteRepoContents(repo.WorkingDir()); err != nil {
-			return "", fmt.Errorf("error clearing contents from repository working tree: %w", err)
-		}
+	changes, err := g.applyConfigManagementFn(
+		ctx,
+		stage,
+		update,
+		newFreight,
+		sourceCommitID,
+		repo.HomeDir(),
+		repo.WorkingDir(),
+		repoCreds,
+	)
+	if err != nil {
+		return nil, err
+	}
 
-		if err = moveRepoContents(tempDir, repo.WorkingDir()); err != nil {
-			return "", fmt.Errorf("error restoring repository working tree from temporary location: %w", err)
+	if len(sopsFiles) > 0 {
+		if err = g.encryptSOPSFilesFn(ctx, sopsFiles); err != nil {
+			return nil, fmt.Errorf(
+				"error re-encrypting SOPS-encrypted files in git repo %q: %w",
+				update.RepoURL,
+				err,
+			)
 		}
 	}
+	return changes, nil
+}
 
-	hasDiffs, err := repo.HasDiffs()
+// moveChangesToBranch checks out the specified branch of the provided
+// repository while preserving the contents of the working tree, so that the
+// working tree subsequently differs from the head of that branch by whatever
+// changes would make the branch's contents match it. If the branch does not
+// exist in the remote repository, it is created as an orphaned branch.
+func moveChangesToBranch(repo git.Repo, repoURL, branch string) error {
+	tempDir, err := os.MkdirTemp("", tmpPrefix)
 	if err != nil {
-		return "", fmt.Errorf("error checking for diffs in git repo %q: %w", update.RepoURL, err)
+		return fmt.Errorf("error creating temp directory for pending changes: %w", err)
 	}
+	defer os.RemoveAll(tempDir)
 
-	if hasDiffs {
-		if err = repo.AddAllAndCommit(commitMsg); err != nil {
-			return "", fmt.Errorf("error committing updates to git repo %q: %w", update.RepoURL, err)
+	if err = moveRepoContents(repo.WorkingDir(), tempDir); err != nil {
+		return fmt.Errorf("error moving repository working tree to temporary location: %w", err)
+	}
+
+	if err = repo.ResetHard(); err != nil {
+		return fmt.Errorf("error resetting repository working tree: %w", err)
+	}
+
+	var branchExists bool
+	if branchExists, err = repo.RemoteBranchExists(branch); err != nil {
+		return fmt.Errorf(
+			"error checking for existence of branch %q in remote repo %q: %w",
+			branch,
+			repoURL,
+			err,
+		)
+	} else if !branchExists {
+		if err = repo.CreateOrphanedBranch(branch); err != nil {
+			return fmt.Errorf(
+				"error creating branch %q in repo %q: %w",
+				branch,
+				repoURL,
+				err,
+			)
 		}
-		if err = repo.Push(false); err != nil {
-			return "", fmt.Errorf("error pushing updates to git repo %q: %w", update.RepoURL, err)
+	} else {
+		if err = repo.Checkout(branch); err != nil {
+			return fmt.Errorf(
+				"error checking out branch %q from git repo %q: %w",
+				branch,
+				repoURL,
+				err,
+			)
 		}
 	}
 
-	commitID, err := repo.LastCommitID()
-	if err != nil {
-		return "", fmt.Errorf("error getting last commit ID from git repo %q: %w", update.RepoURL, err)
+	if err = deleteRepoContents(repo.WorkingDir()); err != nil {
+		return fmt.Errorf("error clearing contents from repository working tree: %w", err)
 	}
 
-	return commitID, nil
+	if err = moveRepoContents(tempDir, repo.WorkingDir()); err != nil {
+		return fmt.Errorf("error restoring repository working tree from temporary location: %w", err)
+	}
+	return nil
 }
 
 // moveRepoContents transplants the entire contents of the source directory
@@ -523,6 +809,55 @@ func deleteRepoContents(dir string) error {
 	return nil
 }
 
+// commitMessageTemplateData is the data against which the commit message
+// template of a GitRepoUpdate is rendered.
+type commitMessageTemplateData struct {
+	// Project is the name of the Project the Stage belongs to.
+	Project string
+	// Stage is the name of the Stage being promoted.
+	Stage string
+	// Freight is the FreightCollection being promoted.
+	Freight *kargoapi.FreightCollection
+	// Changes summarizes each change applied to the repository.
+	Changes []string
+	// DefaultMessage is the commit message used when no template is specified.
+	DefaultMessage string
+}
+
+// renderCommitMessage returns the message for a commit of the provided changes
+// applied to a repository by the provided GitRepoUpdate. If the GitRepoUpdate
+// specifies a commit message template, it is rendered against the provided
+// Stage and Freight. Otherwise, the message is built from the changes alone.
+func renderCommitMessage(
+	stage *kargoapi.Stage,
+	update *kargoapi.GitRepoUpdate,
+	newFreight []kargoapi.FreightReference,
+	changes []string,
+) (string, error) {
+	defaultMsg := buildCommitMessage(changes)
+	if update.CommitMessageTemplate == "" {
+		return defaultMsg, nil
+	}
+	tmpl, err := template.New("commitMessage").Option("missingkey=error").
+		Parse(update.CommitMessageTemplate)
+	if err != nil {
+		return "", fmt.Errorf("error parsing commit message template: %w", err)
+	}
+	data := commitMessageTemplateData{
+		Project:        stage.Namespace,
+		Stage:          stage.Name,
+		Freight:        &kargoapi.FreightCollection{},
+		Changes:        changes,
+		DefaultMessage: defaultMsg,
+	}
+	data.Freight.UpdateOrPush(newFreight...)
+	msg := &strings.Builder{}
+	if err = tmpl.Execute(msg, data); err != nil {
+		return "", fmt.Errorf("error rendering commit message template: %w", err)
+	}
+	return msg.String(), nil
+}
+
 // buildCommitMessage constructs a commit message from the provided change
 // summary. If the change summary is empty, then a generic message is returned.
 // If the change summary contains only one entry, then that entry is returned as
@@ -541,3 +876,31 @@ func buildCommitMessage(changeSummary []string) string {
 	}
 	return msg
 }
+
+// orderEdits returns the provided names, which identify files, directories, or
+// images to be updated as part of a single commit, in the order in which they
+// are to be updated, as specified by the provided EditOrder. With
+// EditOrderListed, names are returned in the order in which they first appear
+// in the provided listed names and any names that do not appear there follow
+// in lexical order. Otherwise, all names are returned in lexical order.
+func orderEdits(order kargoapi.EditOrder, names []string, listed []string) []string {
+	ordered := make([]string, 0, len(names))
+	remaining := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		remaining[name] = struct{}{}
+	}
+	if order == kargoapi.EditOrderListed {
+		for _, name := range listed {
+			if _, ok := remaining[name]; ok {
+				ordered = append(ordered, name)
+				delete(remaining, name)
+			}
+		}
+	}
+	rest := make([]string, 0, len(remaining))
+	for name := range remaining {
+		rest = append(rest, name)
+	}
+	slices.Sort(rest)
+	return append(ordered, rest...)
+}
@@ -5,11 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -17,6 +19,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/sops"
 )
 
 func TestNewGitMechanism(t *testing.T) {
@@ -47,6 +50,11 @@ func TestNewGitMechanism(t *testing.T) {
 	require.NotNil(t, gpm.doSingleUpdateFn)
 	require.NotNil(t, gpm.getReadRefFn)
 	require.NotNil(t, gpm.getAuthorFn)
+	require.NotNil(t, gpm.gitCloneFn)
+	require.NotNil(t, gpm.getSigningKeyFn)
+	require.NotNil(t, gpm.getSOPSAgeKeyFn)
+	require.NotNil(t, gpm.decryptSOPSFilesFn)
+	require.NotNil(t, gpm.encryptSOPSFilesFn)
 	require.NotNil(t, gpm.getCredentialsFn)
 	require.NotNil(t, gpm.gitCommitFn)
 	require.NotNil(t, gpm.applyConfigManagementFn)
@@ -292,6 +300,7 @@ func TestGitDoSingleUpdate(t *testing.T) {
 				) (*git.RepoCredentials, error) {
 					return nil, nil
 				},
+				gitCloneFn: git.Clone,
 				gitCommitFn: func(
 					context.Context,
 					*kargoapi.Stage,
@@ -341,6 +350,7 @@ func TestGitDoSingleUpdate(t *testing.T) {
 				) (*git.RepoCredentials, error) {
 					return nil, nil
 				},
+				gitCloneFn: git.Clone,
 				gitCommitFn: func(
 					context.Context,
 					*kargoapi.Stage,
@@ -392,6 +402,321 @@ func TestGitDoSingleUpdate(t *testing.T) {
 	}
 }
 
+func TestGitCloneRepo(t *testing.T) {
+	testCases := []struct {
+		name string
+		// cloneErrFn returns the error, if any, that cloning with the given depth
+		// should result in.
+		cloneErrFn func(depth uint) error
+		update     *kargoapi.GitRepoUpdate
+		assertions func(t *testing.T, depths []uint, err error)
+	}{
+		{
+			name:       "full clone",
+			cloneErrFn: func(uint) error { return nil },
+			update:     &kargoapi.GitRepoUpdate{},
+			assertions: func(t *testing.T, depths []uint, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []uint{0}, depths)
+			},
+		},
+		{
+			name:       "shallow clone",
+			cloneErrFn: func(uint) error { return nil },
+			update:     &kargoapi.GitRepoUpdate{ShallowCloneDepth: 1},
+			assertions: func(t *testing.T, depths []uint, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []uint{1}, depths)
+			},
+		},
+		{
+			name: "falls back to full clone if shallow clone fails",
+			cloneErrFn: func(depth uint) error {
+				if depth > 0 {
+					return errors.New("shallow clones are not supported")
+				}
+				return nil
+			},
+			update: &kargoapi.GitRepoUpdate{ShallowCloneDepth: 1},
+			assertions: func(t *testing.T, depths []uint, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []uint{1, 0}, depths)
+			},
+		},
+		{
+			name: "full clone fails",
+			cloneErrFn: func(uint) error {
+				return errors.New("something went wrong")
+			},
+			update: &kargoapi.GitRepoUpdate{ShallowCloneDepth: 1},
+			assertions: func(t *testing.T, depths []uint, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.Equal(t, []uint{1, 0}, depths)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var depths []uint
+			g := &gitMechanism{
+				gitCloneFn: func(
+					_ string,
+					_ *git.ClientOptions,
+					opts *git.CloneOptions,
+				) (git.Repo, error) {
+					depths = append(depths, opts.Depth)
+					return nil, testCase.cloneErrFn(opts.Depth)
+				},
+			}
+			_, err := g.cloneRepo(
+				context.Background(),
+				testCase.update,
+				&git.ClientOptions{},
+			)
+			testCase.assertions(t, depths, err)
+		})
+	}
+}
+
+func TestGitCloneRepoFromMirror(t *testing.T) {
+	mirrors := git.Mirrors{{
+		OriginalURL: "https://github.com/example/repo.git",
+		MirrorURL:   "https://git.example.com/mirrors/repo.git",
+	}}
+	testCases := []struct {
+		name        string
+		repoURL     string
+		expectedURL string
+	}{
+		{
+			name:        "matching mirror",
+			repoURL:     "https://github.com/example/repo.git",
+			expectedURL: "https://git.example.com/mirrors/repo.git",
+		},
+		{
+			name:        "no matching mirror",
+			repoURL:     "https://github.com/example/other-repo.git",
+			expectedURL: "https://github.com/example/other-repo.git",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var clonedURLs []string
+			g := &gitMechanism{
+				cfg: GitConfig{GitMirrors: mirrors},
+				gitCloneFn: func(
+					repoURL string,
+					_ *git.ClientOptions,
+					opts *git.CloneOptions,
+				) (git.Repo, error) {
+					clonedURLs = append(clonedURLs, repoURL)
+					if opts.Depth > 0 {
+						return nil, errors.New("shallow clones are not supported")
+					}
+					return nil, nil
+				},
+			}
+			_, err := g.cloneRepo(
+				context.Background(),
+				&kargoapi.GitRepoUpdate{
+					RepoURL:           testCase.repoURL,
+					ShallowCloneDepth: 1,
+				},
+				&git.ClientOptions{},
+			)
+			require.NoError(t, err)
+			// The fallback to a full clone must use the same URL.
+			require.Equal(
+				t,
+				[]string{testCase.expectedURL, testCase.expectedURL},
+				clonedURLs,
+			)
+		})
+	}
+}
+
+func TestGitGetCommitAuthor(t *testing.T) {
+	testCases := []struct {
+		name       string
+		promoMech  *gitMechanism
+		update     *kargoapi.GitRepoUpdate
+		assertions func(*testing.T, *git.User, error)
+	}{
+		{
+			name: "error getting author",
+			promoMech: &gitMechanism{
+				getAuthorFn: func() (*git.User, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			update: &kargoapi.GitRepoUpdate{},
+			assertions: func(t *testing.T, _ *git.User, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "no overrides",
+			promoMech: &gitMechanism{
+				getAuthorFn: func() (*git.User, error) {
+					return &git.User{
+						Name:  "Kargo",
+						Email: "kargo@example.com",
+					}, nil
+				},
+			},
+			update: &kargoapi.GitRepoUpdate{},
+			assertions: func(t *testing.T, author *git.User, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&git.User{
+						Name:  "Kargo",
+						Email: "kargo@example.com",
+					},
+					author,
+				)
+			},
+		},
+		{
+			name: "name and email overridden",
+			promoMech: &gitMechanism{
+				getAuthorFn: func() (*git.User, error) {
+					return &git.User{
+						Name:           "Kargo",
+						Email:          "kargo@example.com",
+						SigningKeyType: git.SigningKeyTypeGPG,
+						SigningKeyPath: "/fake/path",
+					}, nil
+				},
+			},
+			update: &kargoapi.GitRepoUpdate{
+				AuthorName:  "Test Pipeline",
+				AuthorEmail: "test-pipeline@example.com",
+			},
+			assertions: func(t *testing.T, author *git.User, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&git.User{
+						Name:           "Test Pipeline",
+						Email:          "test-pipeline@example.com",
+						SigningKeyType: git.SigningKeyTypeGPG,
+						SigningKeyPath: "/fake/path",
+					},
+					author,
+				)
+			},
+		},
+		{
+			name: "overrides combined with signing key",
+			promoMech: &gitMechanism{
+				getAuthorFn: func() (*git.User, error) {
+					return nil, nil
+				},
+				getSigningKeyFn: func(
+					context.Context,
+					string,
+					kargoapi.SecretKeyReference,
+				) (string, error) {
+					return "fake-signing-key", nil
+				},
+			},
+			update: &kargoapi.GitRepoUpdate{
+				AuthorEmail: "test-pipeline@example.com",
+				SigningKeySecretRef: &kargoapi.SecretKeyReference{
+					Name: "fake-secret",
+					Key:  "fake-key",
+				},
+			},
+			assertions: func(t *testing.T, author *git.User, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&git.User{
+						Email:          "test-pipeline@example.com",
+						SigningKeyType: git.SigningKeyTypeGPG,
+						SigningKey:     "fake-signing-key",
+					},
+					author,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			author, err := testCase.promoMech.getCommitAuthor(
+				context.Background(),
+				&kargoapi.Stage{},
+				testCase.update,
+			)
+			testCase.assertions(t, author, err)
+		})
+	}
+}
+
+func TestGitGetSigningKey(t *testing.T) {
+	testRef := kargoapi.SecretKeyReference{
+		Name: "fake-secret",
+		Key:  "fake-key",
+	}
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "Secret not found",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error getting signing key Secret")
+			},
+		},
+		{
+			name: "key not found",
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-secret",
+					},
+					Data: map[string][]byte{
+						"other-key": []byte("fake-signing-key"),
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, `has no key "fake-key"`)
+			},
+		},
+		{
+			name: "success",
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-namespace",
+						Name:      "fake-secret",
+					},
+					Data: map[string][]byte{
+						"fake-key": []byte("fake-signing-key"),
+					},
+				},
+			},
+			assertions: func(t *testing.T, key string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-signing-key", key)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := &gitMechanism{
+				client: fake.NewClientBuilder().WithObjects(testCase.objects...).Build(),
+			}
+			key, err := g.getSigningKey(context.Background(), "fake-namespace", testRef)
+			testCase.assertions(t, key, err)
+		})
+	}
+}
+
 func TestGetReadRef(t *testing.T) {
 	const testBranch = "fake-branch"
 	testOrigin := kargoapi.FreightOrigin{
@@ -671,6 +996,347 @@ func TestBuildCommitMessage(t *testing.T) {
 	}
 }
 
+func TestOrderEdits(t *testing.T) {
+	testCases := []struct {
+		name     string
+		order    kargoapi.EditOrder
+		names    []string
+		listed   []string
+		expected []string
+	}{
+		{
+			name:     "unspecified order",
+			names:    []string{"foo", "bar", "baz"},
+			listed:   []string{"baz", "foo", "bar"},
+			expected: []string{"bar", "baz", "foo"},
+		},
+		{
+			name:     "lexical order",
+			order:    kargoapi.EditOrderLexical,
+			names:    []string{"foo", "bar", "baz"},
+			listed:   []string{"baz", "foo", "bar"},
+			expected: []string{"bar", "baz", "foo"},
+		},
+		{
+			name:     "listed order",
+			order:    kargoapi.EditOrderListed,
+			names:    []string{"foo", "bar", "baz"},
+			listed:   []string{"baz", "foo", "baz", "bar"},
+			expected: []string{"baz", "foo", "bar"},
+		},
+		{
+			name:     "listed order with names that are not listed",
+			order:    kargoapi.EditOrderListed,
+			names:    []string{"qux", "foo", "bar", "baz"},
+			listed:   []string{"foo", "", "quux"},
+			expected: []string{"foo", "bar", "baz", "qux"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				orderEdits(testCase.order, testCase.names, testCase.listed),
+			)
+		})
+	}
+}
+
+func TestRenderCommitMessage(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-stage",
+		},
+	}
+	testFreight := []kargoapi.FreightReference{
+		{
+			Name: "fake-freight",
+			Origin: kargoapi.FreightOrigin{
+				Kind: kargoapi.FreightOriginKindWarehouse,
+				Name: "fake-warehouse",
+			},
+			Commits: []kargoapi.GitCommit{
+				{
+					RepoURL: "https://github.com/akuity/kargo-demo",
+					ID:      "fake-commit-id",
+				},
+			},
+			Images: []kargoapi.Image{
+				{
+					RepoURL: "fake-image",
+					Tag:     "v1.2.3",
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		template   string
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "no template",
+			assertions: func(t *testing.T, msg string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-change", msg)
+			},
+		},
+		{
+			name:     "invalid template",
+			template: "{{ .Stage ",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error parsing commit message template")
+			},
+		},
+		{
+			name:     "error rendering template",
+			template: "{{ .Bogus }}",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error rendering commit message template")
+			},
+		},
+		{
+			name: "template rendered",
+			template: "Promote {{ .Project }}/{{ .Stage }}\n" +
+				"{{ range .Freight.References }}{{ range .Commits }}" +
+				"\ncommit: {{ .ID }}{{ end }}{{ range .Images }}" +
+				"\nimage: {{ .RepoURL }}:{{ .Tag }}{{ end }}{{ end }}\n\n" +
+				"{{ .DefaultMessage }}",
+			assertions: func(t *testing.T, msg string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"Promote fake-project/fake-stage",
+						"",
+						"commit: fake-commit-id",
+						"image: fake-image:v1.2.3",
+						"",
+						"fake-change",
+					},
+					strings.Split(msg, "\n"),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			msg, err := renderCommitMessage(
+				testStage,
+				&kargoapi.GitRepoUpdate{CommitMessageTemplate: testCase.template},
+				testFreight,
+				[]string{"fake-change"},
+			)
+			testCase.assertions(t, msg, err)
+		})
+	}
+}
+
+func TestGitPromoteDryRun(t *testing.T) {
+	remoteDir := newTestRemote(t)
+	initialCommitID := gitRevParse(t, remoteDir, "main")
+
+	var appliedChanges bool
+	pm := newGitMechanism(
+		"fake",
+		nil,
+		&credentials.FakeDB{},
+		func(updates []kargoapi.GitRepoUpdate) []*kargoapi.GitRepoUpdate {
+			selected := make([]*kargoapi.GitRepoUpdate, len(updates))
+			for i := range updates {
+				selected[i] = &updates[i]
+			}
+			return selected
+		},
+		func(
+			_ context.Context,
+			_ *kargoapi.Stage,
+			_ *kargoapi.GitRepoUpdate,
+			_ []kargoapi.FreightReference,
+			_ string,
+			_ string,
+			workingDir string,
+			_ git.RepoCredentials,
+		) ([]string, error) {
+			appliedChanges = true
+			return []string{"fake-change"}, os.WriteFile(
+				filepath.Join(workingDir, "file.txt"),
+				[]byte("updated\n"),
+				0600,
+			)
+		},
+	)
+	gpm, ok := pm.(*gitMechanism)
+	require.True(t, ok)
+	gpm.getReadRefFn = func(
+		context.Context,
+		client.Client,
+		*kargoapi.Stage,
+		*kargoapi.GitRepoUpdate,
+		[]kargoapi.FreightReference,
+	) (string, *kargoapi.GitCommit, error) {
+		return "main", nil, nil
+	}
+
+	status, _, err := pm.Promote(
+		context.Background(),
+		&kargoapi.Stage{
+			Spec: kargoapi.StageSpec{
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{
+					GitRepoUpdates: []kargoapi.GitRepoUpdate{{
+						RepoURL:     remoteDir,
+						ReadBranch:  "main",
+						WriteBranch: "main",
+					}},
+				},
+				DryRun: true,
+			},
+		},
+		&kargoapi.Promotion{},
+		nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+	require.True(t, appliedChanges)
+
+	// Nothing should have been pushed to the remote repository.
+	require.Equal(t, initialCommitID, gitRevParse(t, remoteDir, "main"))
+}
+
+func TestGitPromoteWithSOPS(t *testing.T) {
+	remoteDir := newTestRemote(t)
+
+	var steps []string
+	pm := newGitMechanism(
+		"fake",
+		nil,
+		&credentials.FakeDB{},
+		func(updates []kargoapi.GitRepoUpdate) []*kargoapi.GitRepoUpdate {
+			selected := make([]*kargoapi.GitRepoUpdate, len(updates))
+			for i := range updates {
+				selected[i] = &updates[i]
+			}
+			return selected
+		},
+		func(
+			context.Context,
+			*kargoapi.Stage,
+			*kargoapi.GitRepoUpdate,
+			[]kargoapi.FreightReference,
+			string,
+			string,
+			string,
+			git.RepoCredentials,
+		) ([]string, error) {
+			steps = append(steps, "apply")
+			return []string{"fake-change"}, nil
+		},
+	)
+	gpm, ok := pm.(*gitMechanism)
+	require.True(t, ok)
+	gpm.getReadRefFn = func(
+		context.Context,
+		client.Client,
+		*kargoapi.Stage,
+		*kargoapi.GitRepoUpdate,
+		[]kargoapi.FreightReference,
+	) (string, *kargoapi.GitCommit, error) {
+		return "main", nil, nil
+	}
+	gpm.getSOPSAgeKeyFn = func(
+		_ context.Context,
+		namespace string,
+		ref kargoapi.SecretKeyReference,
+	) (string, error) {
+		require.Equal(t, "fake-namespace", namespace)
+		require.Equal(t, "sops-age-key", ref.Name)
+		return "fake-age-key", nil
+	}
+	testFiles := []*sops.File{{Path: "values.sops.yaml"}}
+	gpm.decryptSOPSFilesFn = func(_ context.Context, dir, ageKey string) ([]*sops.File, error) {
+		require.NotEmpty(t, dir)
+		require.Equal(t, "fake-age-key", ageKey)
+		steps = append(steps, "decrypt")
+		return testFiles, nil
+	}
+	gpm.encryptSOPSFilesFn = func(_ context.Context, files []*sops.File) error {
+		require.Equal(t, testFiles, files)
+		steps = append(steps, "encrypt")
+		return nil
+	}
+
+	status, _, err := pm.Promote(
+		context.Background(),
+		&kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+			},
+			Spec: kargoapi.StageSpec{
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{
+					GitRepoUpdates: []kargoapi.GitRepoUpdate{{
+						RepoURL:                remoteDir,
+						ReadBranch:             "main",
+						WriteBranch:            "main",
+						SOPSDecryptBeforeApply: true,
+						SOPSAgeKeySecretRef: &kargoapi.SecretKeyReference{
+							Name: "sops-age-key",
+							Key:  "key.txt",
+						},
+					}},
+				},
+				DryRun: true,
+			},
+		},
+		&kargoapi.Promotion{},
+		nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+	require.Equal(t, []string{"decrypt", "apply", "encrypt"}, steps)
+}
+
+// newTestRemote creates a bare repository, seeded with a single commit to its
+// main branch, that can be used as a remote by tests. It returns the path to
+// the repository.
+func newTestRemote(t *testing.T) string {
+	testDir := t.TempDir()
+	workDir := filepath.Join(testDir, "work")
+	remoteDir := filepath.Join(testDir, "remote.git")
+	require.NoError(t, os.Mkdir(workDir, 0700))
+	require.NoError(
+		t,
+		os.WriteFile(filepath.Join(workDir, "file.txt"), []byte("initial\n"), 0600),
+	)
+	for _, args := range [][]string{
+		{"init", "--initial-branch", "main"},
+		{"add", "."},
+		{
+			"-c", "user.name=Kargo Test",
+			"-c", "user.email=kargo-test@akuity.io",
+			"commit", "-m", "initial commit",
+		},
+		{"clone", "--bare", workDir, remoteDir},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = workDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	return remoteDir
+}
+
+// gitRevParse returns the ID of the commit the provided ref points to in the
+// repository at the provided path.
+func gitRevParse(t *testing.T, repoDir, ref string) string {
+	cmd := exec.Command("git", "rev-parse", ref)
+	cmd.Dir = repoDir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
 func createDummyRepoDir(t *testing.T, dirCount, fileCount int) (string, error) {
 	t.Helper()
 	// Create a temporary directory
@@ -2,10 +2,12 @@ package promotion
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -16,6 +18,7 @@ import (
 	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/helm"
+	"github.com/akuity/kargo/internal/logging"
 	libYAML "github.com/akuity/kargo/internal/yaml"
 )
 
@@ -31,8 +34,10 @@ func newHelmMechanism(
 	h.buildValuesFilesChangesFn = h.buildValuesFilesChanges
 	h.buildChartDependencyChangesFn = h.buildChartDependencyChanges
 	h.setStringsInYAMLFileFn = libYAML.SetStringsInFile
+	h.mergeIntoYAMLFileFn = libYAML.MergeIntoFile
 	h.prepareDependencyCredentialsFn = prepareDependencyCredentialsFn(credentialsDB)
 	h.updateChartDependenciesFn = helm.UpdateChartDependencies
+	h.getChartChangesFn = getChartChangesFn(credentialsDB)
 
 	return newGitMechanism(
 		"Helm promotion mechanism",
@@ -73,8 +78,17 @@ type helmer struct {
 		workingDir string,
 	) (map[string]map[string]string, []string, error)
 	setStringsInYAMLFileFn         func(file string, changes map[string]string) error
+	mergeIntoYAMLFileFn            func(file string, overrides map[string]any) error
 	prepareDependencyCredentialsFn func(ctx context.Context, homePath, chartPath, namespace string) error
-	updateChartDependenciesFn      func(homeDir, chartPath string) error
+	updateChartDependenciesFn      func(ctx context.Context, homeDir, chartPath string) error
+	getChartChangesFn              func(
+		ctx context.Context,
+		namespace string,
+		repository string,
+		chart string,
+		fromVersion string,
+		toVersion string,
+	) ([]helm.ChartChange, error)
 }
 
 // apply uses Helm to carry out the provided update in the specified working
@@ -99,10 +113,21 @@ func (h *helmer) apply(
 		return nil,
 			fmt.Errorf("error preparing changes to affected values files: %w", err)
 	}
-	for file, changes := range changesByFile {
+	// Values files are always updated before any Chart.yaml files and, like
+	// those, in a predictable order so that the resulting commit is the same
+	// no matter how many times the same Freight is promoted.
+	files := make([]string, 0, len(changesByFile))
+	for file := range changesByFile {
+		files = append(files, file)
+	}
+	listedFiles := make([]string, len(update.Helm.Images))
+	for i, imageUpdate := range update.Helm.Images {
+		listedFiles[i] = imageUpdate.ValuesFilePath
+	}
+	for _, file := range orderEdits(update.EditOrder, files, listedFiles) {
 		if err = h.setStringsInYAMLFileFn(
 			filepath.Join(workingDir, file),
-			changes,
+			changesByFile[file],
 		); err != nil {
 			return nil, fmt.Errorf("updating values in file %q: %w", file, err)
 		}
@@ -120,7 +145,18 @@ func (h *helmer) apply(
 	if err != nil {
 		return nil, fmt.Errorf("preparing changes to affected Chart.yaml files: %w", err)
 	}
-	for chart, changes := range changesByChart {
+	// Apply the changes chart by chart in a predictable order
+	charts := make([]string, 0, len(changesByChart))
+	for chart := range changesByChart {
+		charts = append(charts, chart)
+	}
+	listedCharts := make([]string, 0, len(update.Helm.Charts)+1)
+	for _, chartUpdate := range update.Helm.Charts {
+		listedCharts = append(listedCharts, chartUpdate.ChartPath)
+	}
+	listedCharts = append(listedCharts, update.Helm.UmbrellaChartPath)
+	for _, chart := range orderEdits(update.EditOrder, charts, listedCharts) {
+		changes := changesByChart[chart]
 		chartPath := filepath.Join(workingDir, chart)
 		chartYAMLPath := filepath.Join(chartPath, "Chart.yaml")
 		if err = h.setStringsInYAMLFileFn(chartYAMLPath, changes); err != nil {
@@ -131,12 +167,150 @@ func (h *helmer) apply(
 		); err != nil {
 			return nil, fmt.Errorf("preparing credentials for chart dependencies %q: :%w", chart, err)
 		}
-		if err = h.updateChartDependenciesFn(homeDir, chartPath); err != nil {
+		if err = h.updateChartDependenciesFn(ctx, homeDir, chartPath); err != nil {
 			return nil, fmt.Errorf("updating dependencies for chart %q: %w", chart, err)
 		}
 	}
 
-	return append(imageChangeSummary, subchartChangeSummary...), nil
+	changeSummary := make(
+		[]string,
+		0,
+		len(imageChangeSummary)+len(subchartChangeSummary)+len(update.Helm.ValuesFiles)+1,
+	)
+	changeSummary = append(changeSummary, imageChangeSummary...)
+	changeSummary = append(changeSummary, subchartChangeSummary...)
+
+	// Values files in a chain are updated in the order in which they are listed
+	// so that each file can inherit keys from the files listed before it.
+	valuesFilesChangeSummary, err := h.applyValuesFilesUpdates(workingDir, update.Helm.ValuesFiles)
+	if err != nil {
+		return nil, err
+	}
+	changeSummary = append(changeSummary, valuesFilesChangeSummary...)
+
+	// Values overrides are merged last so that they take precedence over any
+	// image updates made to the same values file.
+	if update.Helm.ValuesOverrides != nil && update.Helm.ValuesFilePath != "" {
+		overrides := map[string]any{}
+		if err = json.Unmarshal(update.Helm.ValuesOverrides.Raw, &overrides); err != nil {
+			return nil, fmt.Errorf("unmarshaling values overrides: %w", err)
+		}
+		if err = h.mergeIntoYAMLFileFn(
+			filepath.Join(workingDir, update.Helm.ValuesFilePath),
+			overrides,
+		); err != nil {
+			return nil, fmt.Errorf(
+				"merging values overrides into file %q: %w",
+				update.Helm.ValuesFilePath,
+				err,
+			)
+		}
+		changeSummary = append(
+			changeSummary,
+			fmt.Sprintf("updated %s with values overrides", update.Helm.ValuesFilePath),
+		)
+	}
+
+	return changeSummary, nil
+}
+
+// applyValuesFilesUpdates applies the provided chain of values files updates to
+// the files in the specified working directory, one file at a time, in the
+// order in which they are listed. Keys that are not found in a file, but that
+// are found in a file listed before it, are added to the file.
+func (h *helmer) applyValuesFilesUpdates(
+	workingDir string,
+	valuesFiles []kargoapi.HelmValuesFileUpdate,
+) ([]string, error) {
+	changeSummary := make([]string, 0, len(valuesFiles))
+	// previousFiles holds the updated contents of every file in the chain that
+	// has already been updated.
+	previousFiles := make([][]byte, 0, len(valuesFiles))
+	for _, valuesFile := range valuesFiles {
+		file := filepath.Join(workingDir, valuesFile.Path)
+		inBytes, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("reading values file %q: %w", valuesFile.Path, err)
+		}
+		inherited, err := buildInheritedValues(inBytes, previousFiles, valuesFile.Updates)
+		if err != nil {
+			return nil, fmt.Errorf("preparing changes to values file %q: %w", valuesFile.Path, err)
+		}
+		if err = h.setStringsInYAMLFileFn(file, valuesFile.Updates); err != nil {
+			return nil, fmt.Errorf("updating values in file %q: %w", valuesFile.Path, err)
+		}
+		if len(inherited) > 0 {
+			if err = h.mergeIntoYAMLFileFn(file, inherited); err != nil {
+				return nil, fmt.Errorf(
+					"adding inherited values to file %q: %w",
+					valuesFile.Path,
+					err,
+				)
+			}
+		}
+		outBytes, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("reading values file %q: %w", valuesFile.Path, err)
+		}
+		previousFiles = append(previousFiles, outBytes)
+		changeSummary = append(changeSummary, fmt.Sprintf("updated %s", valuesFile.Path))
+	}
+	return changeSummary, nil
+}
+
+// buildInheritedValues returns those of the provided changes whose keys are not
+// found in the provided values file, but are found in at least one of the
+// provided previous values files, as a map of values suitable for merging into
+// the values file. Each new value is parsed as YAML.
+func buildInheritedValues(
+	valuesFile []byte,
+	previousFiles [][]byte,
+	changes map[string]string,
+) (map[string]any, error) {
+	keys := make([]string, 0, len(changes))
+	for key := range changes {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	inherited := map[string]any{}
+	for _, key := range keys {
+		found, err := libYAML.HasKey(valuesFile, key)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			continue
+		}
+		var foundPreviously bool
+		for _, previousFile := range previousFiles {
+			if foundPreviously, err = libYAML.HasKey(previousFile, key); err != nil {
+				return nil, err
+			}
+			if foundPreviously {
+				break
+			}
+		}
+		if !foundPreviously {
+			// Like any other key that is not found, this one is ignored.
+			continue
+		}
+		var value any
+		if err = yaml.Unmarshal([]byte(changes[key]), &value); err != nil {
+			return nil, fmt.Errorf("error parsing new value for key %q: %w", key, err)
+		}
+		keyPath := strings.Split(key, ".")
+		m := inherited
+		for _, part := range keyPath[:len(keyPath)-1] {
+			next, ok := m[part].(map[string]any)
+			if !ok {
+				next = map[string]any{}
+				m[part] = next
+			}
+			m = next
+		}
+		m[keyPath[len(keyPath)-1]] = value
+	}
+	return inherited, nil
 }
 
 // buildValuesFilesChanges takes a list of images and a list of instructions
@@ -172,6 +346,11 @@ func (h *helmer) buildValuesFilesChanges(
 			// There's no change to make in this case.
 			continue
 		}
+		if image.Digest == "" &&
+			(imageUpdate.Value == kargoapi.ImageUpdateValueTypeImageAndDigest ||
+				imageUpdate.Value == kargoapi.ImageUpdateValueTypeDigest) {
+			return nil, nil, errImageWithoutDigest(image)
+		}
 		if _, found := changesByFile[imageUpdate.ValuesFilePath]; !found {
 			changesByFile[imageUpdate.ValuesFilePath] = map[string]string{}
 		}
@@ -217,20 +396,25 @@ func (h *helmer) buildChartDependencyChanges(
 	newFreight []kargoapi.FreightReference,
 	repoDir string,
 ) (map[string]map[string]string, []string, error) {
-	// Build a map of updates by chart
+	// Build a map of updates by chart. We also keep track of the order in which
+	// each chart was first seen so that the change summary (and therefore the
+	// commit message) is stable.
 	updatesByChartPath := map[string][]*kargoapi.HelmChartDependencyUpdate{}
+	chartPaths := make([]string, 0, len(update.Charts))
 	for i := range update.Charts {
 		chartUpdate := &update.Charts[i]
 		if updates, found := updatesByChartPath[chartUpdate.ChartPath]; !found {
 			updates = []*kargoapi.HelmChartDependencyUpdate{chartUpdate}
 			updatesByChartPath[chartUpdate.ChartPath] = updates
+			chartPaths = append(chartPaths, chartUpdate.ChartPath)
 		} else {
 			updatesByChartPath[chartUpdate.ChartPath] = append(updates, chartUpdate)
 		}
 	}
 	changesByChart := make(map[string]map[string]string)
 	changeSummary := make([]string, 0)
-	for chartPath, updates := range updatesByChartPath {
+	for _, chartPath := range chartPaths {
+		updates := updatesByChartPath[chartPath]
 		absChartYAMLPath := filepath.Join(repoDir, chartPath, "Chart.yaml")
 		chartDependencies, err := loadChartDependencies(absChartYAMLPath)
 		if err != nil {
@@ -269,20 +453,151 @@ func (h *helmer) buildChartDependencyChanges(
 				}
 				changeSummary = append(
 					changeSummary,
-					fmt.Sprintf(
-						"updated %s/Chart.yaml to use subchart %s:%s",
-						chartPath,
-						dependency.Name,
-						chart.Version,
-					),
+					h.buildSubchartChangeSummary(ctx, stage, chartPath, dependency, chart.Version)...,
 				)
 			}
 		}
 
 	}
+
+	if update.UmbrellaChartPath != "" {
+		umbrellaChanges, umbrellaChangeSummary, err := h.buildUmbrellaChartChanges(
+			ctx,
+			stage,
+			update,
+			newFreight,
+			repoDir,
+			changesByChart[update.UmbrellaChartPath],
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(umbrellaChanges) > 0 {
+			if _, found := changesByChart[update.UmbrellaChartPath]; !found {
+				changesByChart[update.UmbrellaChartPath] = map[string]string{}
+			}
+			for key, version := range umbrellaChanges {
+				changesByChart[update.UmbrellaChartPath][key] = version
+			}
+		}
+		changeSummary = append(changeSummary, umbrellaChangeSummary...)
+	}
+
 	return changesByChart, changeSummary, nil
 }
 
+// buildUmbrellaChartChanges compares the dependencies listed in the Chart.yaml
+// of the umbrella chart at the provided HelmPromotionMechanism's
+// UmbrellaChartPath to the charts referenced by the provided Freight and
+// returns the minimal set of changes, indexed by key, that bring every
+// dependency found in the Freight up to the version referenced by it.
+// Dependencies whose versions already match, or that are not found in the
+// Freight, are left untouched, as are any dependencies for which changes have
+// already been built.
+func (h *helmer) buildUmbrellaChartChanges(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	update *kargoapi.HelmPromotionMechanism,
+	newFreight []kargoapi.FreightReference,
+	repoDir string,
+	existingChanges map[string]string,
+) (map[string]string, []string, error) {
+	chartPath := update.UmbrellaChartPath
+	chartDependencies, err := loadChartDependencies(
+		filepath.Join(repoDir, chartPath, "Chart.yaml"),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading dependencies for umbrella chart: %w", err)
+	}
+	desiredOrigin := freight.GetDesiredOrigin(stage, update)
+	changes := map[string]string{}
+	changeSummary := make([]string, 0)
+	for i, dependency := range chartDependencies {
+		key := fmt.Sprintf("dependencies.%d.version", i)
+		if _, found := existingChanges[key]; found {
+			continue
+		}
+		if !strings.HasPrefix(dependency.Repository, "https://") &&
+			!strings.HasPrefix(dependency.Repository, "http://") &&
+			!strings.HasPrefix(dependency.Repository, "oci://") {
+			// Local and aliased dependencies cannot be found in Freight.
+			continue
+		}
+		chart, err := freight.FindChart(
+			ctx,
+			h.client,
+			stage,
+			desiredOrigin,
+			newFreight,
+			dependency.Repository,
+			dependency.Name,
+		)
+		if err != nil {
+			return nil, nil,
+				fmt.Errorf("error finding chart from repo %q: %w", dependency.Repository, err)
+		}
+		if chart == nil || chart.Version == dependency.Version {
+			// There's no change to make in this case.
+			continue
+		}
+		changes[key] = chart.Version
+		changeSummary = append(
+			changeSummary,
+			h.buildSubchartChangeSummary(ctx, stage, chartPath, dependency, chart.Version)...,
+		)
+	}
+	return changes, changeSummary, nil
+}
+
+// buildSubchartChangeSummary returns lines for a change summary describing the
+// update of the provided dependency of the chart at the provided path to the
+// provided version. Where possible, the changes made to the subchart since the
+// version currently in use are included. Failure to obtain them is not a
+// reason to fail the promotion.
+func (h *helmer) buildSubchartChangeSummary(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	chartPath string,
+	dependency chartDependency,
+	version string,
+) []string {
+	changeSummary := []string{
+		fmt.Sprintf(
+			"updated %s/Chart.yaml to use subchart %s:%s",
+			chartPath,
+			dependency.Name,
+			version,
+		),
+	}
+	if dependency.Version == "" || dependency.Version == version {
+		return changeSummary
+	}
+	changes, err := h.getChartChangesFn(
+		ctx,
+		stage.Namespace,
+		dependency.Repository,
+		dependency.Name,
+		dependency.Version,
+		version,
+	)
+	if err != nil {
+		logging.LoggerFromContext(ctx).Error(
+			err, "error obtaining changes for subchart",
+			"chart", dependency.Name,
+			"fromVersion", dependency.Version,
+			"toVersion", version,
+		)
+		return changeSummary
+	}
+	for _, change := range changes {
+		changeSummary = append(
+			changeSummary,
+			fmt.Sprintf("%s %s", dependency.Name, change),
+		)
+	}
+	return changeSummary
+}
+
 // prepareDependencyCredentialsFn returns a function that prepares the necessary
 // credentials for the dependencies of a Helm chart. The returned function is
 // intended to be called once per chart.
@@ -303,28 +618,28 @@ func prepareDependencyCredentialsFn(
 			switch {
 			case strings.HasPrefix(dependency.Repository, "https://"):
 				repository = dependency.Repository
-				if creds, ok, err = db.Get(ctx, namespace, credentials.TypeHelm, repository); err != nil {
-					return fmt.Errorf(
-						"obtaining credentials for chart repository %q: %w",
-						dependency.Repository,
-						err,
-					)
+				if creds, ok, err = credentials.GetHelmCredentials(
+					ctx,
+					db,
+					namespace,
+					repository,
+				); err != nil {
+					return err
 				}
 			case strings.HasPrefix(dependency.Repository, "oci://"):
 				// NB: We log in to the OCI registry using the repository URL,
-				// and not the full chart reference.
+				// and not the full chart reference. Credentials, however, are
+				// looked up using the full chart reference, falling back to
+				// credentials for the registry as a whole, exactly as they are
+				// when discovering charts.
 				repository = dependency.Repository
-				if creds, ok, err = db.Get(
+				if creds, ok, err = credentials.GetHelmCredentials(
 					ctx,
+					db,
 					namespace,
-					credentials.TypeHelm,
 					"oci://"+path.Join(helm.NormalizeChartRepositoryURL(repository), dependency.Name),
 				); err != nil {
-					return fmt.Errorf(
-						"obtaining credentials for chart repository %q: %w",
-						repository,
-						err,
-					)
+					return err
 				}
 			}
 
@@ -344,11 +659,53 @@ func prepareDependencyCredentialsFn(
 	}
 }
 
+// getChartChangesFn returns a function that closes over the provided
+// credentials database and, when invoked, uses that database to obtain
+// credentials for the specified chart repository before retrieving the changes
+// made to the specified chart between two versions.
+func getChartChangesFn(
+	db credentials.Database,
+) func(
+	ctx context.Context,
+	namespace string,
+	repository string,
+	chart string,
+	fromVersion string,
+	toVersion string,
+) ([]helm.ChartChange, error) {
+	return func(
+		ctx context.Context,
+		namespace string,
+		repository string,
+		chart string,
+		fromVersion string,
+		toVersion string,
+	) ([]helm.ChartChange, error) {
+		var helmCreds *helm.Credentials
+		creds, ok, err := db.Get(ctx, namespace, credentials.TypeHelm, repository)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"obtaining credentials for chart repository %q: %w",
+				repository,
+				err,
+			)
+		}
+		if ok {
+			helmCreds = &helm.Credentials{
+				Username: creds.Username,
+				Password: creds.Password,
+			}
+		}
+		return helm.GetChartChanges(ctx, repository, chart, fromVersion, toVersion, helmCreds)
+	}
+}
+
 // chartDependency is a struct that represents a dependency listed in a
 // Chart.yaml. It only includes the fields that are relevant to this package.
 type chartDependency struct {
 	Repository string `json:"repository,omitempty"`
 	Name       string `json:"name,omitempty"`
+	Version    string `json:"version,omitempty"`
 }
 
 // loadChartDependencies reads the Chart.yaml file at the given path and returns
@@ -6,14 +6,19 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/helm"
+	libYAML "github.com/akuity/kargo/internal/yaml"
 )
 
 func TestNewHelmMechanism(t *testing.T) {
@@ -85,11 +90,14 @@ func TestHelmerApply(t *testing.T) {
 	testChartFile := filepath.Join(testChartDir, "Chart.yaml")
 	const testKey = "fake-key"
 	const testValue = "fake-value"
-	testCases := []struct {
+	type testCase struct {
 		name       string
 		helmer     *helmer
+		update     *kargoapi.HelmPromotionMechanism
+		editOrder  kargoapi.EditOrder
 		assertions func(t *testing.T, changes []string, err error)
-	}{
+	}
+	testCases := []testCase{
 		{
 			name: "error updating values file",
 			helmer: &helmer{
@@ -245,7 +253,7 @@ func TestHelmerApply(t *testing.T) {
 				setStringsInYAMLFileFn: func(string, map[string]string) error {
 					return nil
 				},
-				updateChartDependenciesFn: func(string, string) error {
+				updateChartDependenciesFn: func(context.Context, string, string) error {
 					return errors.New("something went wrong")
 				},
 			},
@@ -288,7 +296,7 @@ func TestHelmerApply(t *testing.T) {
 				prepareDependencyCredentialsFn: func(context.Context, string, string, string) error {
 					return nil
 				},
-				updateChartDependenciesFn: func(string, string) error {
+				updateChartDependenciesFn: func(context.Context, string, string) error {
 					return nil
 				},
 			},
@@ -297,14 +305,280 @@ func TestHelmerApply(t *testing.T) {
 				require.Len(t, changes, 2)
 			},
 		},
+		func() testCase {
+			var updatedValuesFiles []string
+			return testCase{
+				name: "multiple values files are updated in a predictable order",
+				helmer: &helmer{
+					buildValuesFilesChangesFn: func(
+						context.Context,
+						*kargoapi.Stage,
+						*kargoapi.HelmPromotionMechanism,
+						[]kargoapi.FreightReference,
+					) (map[string]map[string]string, []string, error) {
+						return map[string]map[string]string{
+							"charts/foo/values.yaml": {
+								testKey: testValue,
+							},
+							"charts/bar/values.yaml": {
+								testKey: testValue,
+							},
+							"charts/baz/values.yaml": {
+								testKey: testValue,
+							},
+						}, []string{"fake-image-update"}, nil
+					},
+					buildChartDependencyChangesFn: func(
+						context.Context,
+						*kargoapi.Stage,
+						*kargoapi.HelmPromotionMechanism,
+						[]kargoapi.FreightReference,
+						string,
+					) (map[string]map[string]string, []string, error) {
+						return nil, nil, nil
+					},
+					setStringsInYAMLFileFn: func(file string, _ map[string]string) error {
+						updatedValuesFiles = append(updatedValuesFiles, file)
+						return nil
+					},
+				},
+				assertions: func(t *testing.T, _ []string, err error) {
+					require.NoError(t, err)
+					require.Equal(
+						t,
+						[]string{
+							"charts/bar/values.yaml",
+							"charts/baz/values.yaml",
+							"charts/foo/values.yaml",
+						},
+						updatedValuesFiles,
+					)
+				},
+			}
+		}(),
+		func() testCase {
+			var updatedValuesFiles []string
+			return testCase{
+				name: "multiple values files are updated in listed order",
+				update: &kargoapi.HelmPromotionMechanism{
+					Images: []kargoapi.HelmImageUpdate{
+						{ValuesFilePath: "charts/baz/values.yaml"},
+						{ValuesFilePath: "charts/foo/values.yaml"},
+						{ValuesFilePath: "charts/baz/values.yaml"},
+					},
+				},
+				editOrder: kargoapi.EditOrderListed,
+				helmer: &helmer{
+					buildValuesFilesChangesFn: func(
+						context.Context,
+						*kargoapi.Stage,
+						*kargoapi.HelmPromotionMechanism,
+						[]kargoapi.FreightReference,
+					) (map[string]map[string]string, []string, error) {
+						return map[string]map[string]string{
+							"charts/foo/values.yaml": {
+								testKey: testValue,
+							},
+							"charts/bar/values.yaml": {
+								testKey: testValue,
+							},
+							"charts/baz/values.yaml": {
+								testKey: testValue,
+							},
+						}, []string{"fake-image-update"}, nil
+					},
+					buildChartDependencyChangesFn: func(
+						context.Context,
+						*kargoapi.Stage,
+						*kargoapi.HelmPromotionMechanism,
+						[]kargoapi.FreightReference,
+						string,
+					) (map[string]map[string]string, []string, error) {
+						return nil, nil, nil
+					},
+					setStringsInYAMLFileFn: func(file string, _ map[string]string) error {
+						updatedValuesFiles = append(updatedValuesFiles, file)
+						return nil
+					},
+				},
+				assertions: func(t *testing.T, _ []string, err error) {
+					require.NoError(t, err)
+					require.Equal(
+						t,
+						[]string{
+							"charts/baz/values.yaml",
+							"charts/foo/values.yaml",
+							// Not listed, so updated last
+							"charts/bar/values.yaml",
+						},
+						updatedValuesFiles,
+					)
+				},
+			}
+		}(),
+		func() testCase {
+			var updatedCharts []string
+			return testCase{
+				name: "multiple charts are updated in a predictable order",
+				helmer: &helmer{
+					buildValuesFilesChangesFn: func(
+						context.Context,
+						*kargoapi.Stage,
+						*kargoapi.HelmPromotionMechanism,
+						[]kargoapi.FreightReference,
+					) (map[string]map[string]string, []string, error) {
+						return nil, nil, nil
+					},
+					buildChartDependencyChangesFn: func(
+						context.Context,
+						*kargoapi.Stage,
+						*kargoapi.HelmPromotionMechanism,
+						[]kargoapi.FreightReference,
+						string,
+					) (map[string]map[string]string, []string, error) {
+						return map[string]map[string]string{
+							"charts/foo": {
+								testKey: testValue,
+							},
+							"charts/bar": {
+								testKey: testValue,
+							},
+							"charts/baz": {
+								testKey: testValue,
+							},
+						}, []string{"fake-chart-update"}, nil
+					},
+					setStringsInYAMLFileFn: func(string, map[string]string) error {
+						return nil
+					},
+					prepareDependencyCredentialsFn: func(context.Context, string, string, string) error {
+						return nil
+					},
+					updateChartDependenciesFn: func(_ context.Context, _ string, chartPath string) error {
+						updatedCharts = append(updatedCharts, chartPath)
+						return nil
+					},
+				},
+				assertions: func(t *testing.T, _ []string, err error) {
+					require.NoError(t, err)
+					require.Equal(
+						t,
+						[]string{"charts/bar", "charts/baz", "charts/foo"},
+						updatedCharts,
+					)
+				},
+			}
+		}(),
+		{
+			name: "error merging values overrides",
+			helmer: &helmer{
+				buildValuesFilesChangesFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.HelmPromotionMechanism,
+					[]kargoapi.FreightReference,
+				) (map[string]map[string]string, []string, error) {
+					return nil, nil, nil
+				},
+				buildChartDependencyChangesFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.HelmPromotionMechanism,
+					[]kargoapi.FreightReference,
+					string,
+				) (map[string]map[string]string, []string, error) {
+					return nil, nil, nil
+				},
+				mergeIntoYAMLFileFn: func(string, map[string]any) error {
+					return errors.New("something went wrong")
+				},
+			},
+			update: &kargoapi.HelmPromotionMechanism{
+				ValuesFilePath: testValuesFile,
+				ValuesOverrides: &runtime.RawExtension{
+					Raw: []byte(`{"replicas":2}`),
+				},
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "merging values overrides into file")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "values overrides are merged after other changes",
+			helmer: &helmer{
+				buildValuesFilesChangesFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.HelmPromotionMechanism,
+					[]kargoapi.FreightReference,
+				) (map[string]map[string]string, []string, error) {
+					return map[string]map[string]string{
+						testValuesFile: {
+							testKey: testValue,
+						},
+					}, []string{"fake-image-update"}, nil
+				},
+				buildChartDependencyChangesFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.HelmPromotionMechanism,
+					[]kargoapi.FreightReference,
+					string,
+				) (map[string]map[string]string, []string, error) {
+					return nil, []string{"fake-chart-update"}, nil
+				},
+				setStringsInYAMLFileFn: func(string, map[string]string) error {
+					return nil
+				},
+				mergeIntoYAMLFileFn: func(file string, overrides map[string]any) error {
+					if file != testValuesFile {
+						return fmt.Errorf("unexpected file %q", file)
+					}
+					expected := map[string]any{
+						"replicas": float64(2),
+						"image": map[string]any{
+							"pullPolicy": "Always",
+						},
+					}
+					if !reflect.DeepEqual(expected, overrides) {
+						return fmt.Errorf("unexpected overrides %v", overrides)
+					}
+					return nil
+				},
+			},
+			update: &kargoapi.HelmPromotionMechanism{
+				ValuesFilePath: testValuesFile,
+				ValuesOverrides: &runtime.RawExtension{
+					Raw: []byte(`{"replicas":2,"image":{"pullPolicy":"Always"}}`),
+				},
+			},
+			assertions: func(t *testing.T, changes []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"fake-image-update",
+						"fake-chart-update",
+						"updated " + testValuesFile + " with values overrides",
+					},
+					changes,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
+			update := testCase.update
+			if update == nil {
+				update = &kargoapi.HelmPromotionMechanism{}
+			}
 			stage := &kargoapi.Stage{
 				Spec: kargoapi.StageSpec{
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{
 						GitRepoUpdates: []kargoapi.GitRepoUpdate{{
-							Helm: &kargoapi.HelmPromotionMechanism{},
+							EditOrder: testCase.editOrder,
+							Helm:      update,
 						}},
 					},
 				},
@@ -324,6 +598,145 @@ func TestHelmerApply(t *testing.T) {
 	}
 }
 
+func TestHelmerApplyValuesFilesUpdates(t *testing.T) {
+	const testBaseValues = `# Base values
+image:
+  repository: nginx
+  tag: 1.25.0
+replicas: 1
+`
+	const testOverrideValues = `# Overrides for test
+replicas: 2
+`
+	testCases := []struct {
+		name        string
+		helmer      *helmer
+		valuesFiles []kargoapi.HelmValuesFileUpdate
+		assertions  func(t *testing.T, dir string, changes []string, err error)
+	}{
+		{
+			name:   "values file not found",
+			helmer: &helmer{},
+			valuesFiles: []kargoapi.HelmValuesFileUpdate{{
+				Path:    "missing.yaml",
+				Updates: map[string]string{"replicas": "3"},
+			}},
+			assertions: func(t *testing.T, _ string, _ []string, err error) {
+				require.ErrorContains(t, err, `reading values file "missing.yaml"`)
+			},
+		},
+		{
+			name: "error updating values file",
+			helmer: &helmer{
+				setStringsInYAMLFileFn: func(string, map[string]string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			valuesFiles: []kargoapi.HelmValuesFileUpdate{{
+				Path:    "base.yaml",
+				Updates: map[string]string{"replicas": "3"},
+			}},
+			assertions: func(t *testing.T, _ string, _ []string, err error) {
+				require.ErrorContains(t, err, `updating values in file "base.yaml"`)
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error parsing inherited value",
+			helmer: &helmer{
+				setStringsInYAMLFileFn: libYAML.SetStringsInFile,
+				mergeIntoYAMLFileFn:    libYAML.MergeIntoFile,
+			},
+			valuesFiles: []kargoapi.HelmValuesFileUpdate{
+				{
+					Path:    "base.yaml",
+					Updates: map[string]string{"replicas": "3"},
+				},
+				{
+					Path:    "override.yaml",
+					Updates: map[string]string{"image.tag": "[1.26.0"},
+				},
+			},
+			assertions: func(t *testing.T, _ string, _ []string, err error) {
+				require.ErrorContains(t, err, `preparing changes to values file "override.yaml"`)
+				require.ErrorContains(t, err, `error parsing new value for key "image.tag"`)
+			},
+		},
+		{
+			name: "every values file in the chain is updated in order",
+			helmer: &helmer{
+				setStringsInYAMLFileFn: libYAML.SetStringsInFile,
+				mergeIntoYAMLFileFn:    libYAML.MergeIntoFile,
+			},
+			valuesFiles: []kargoapi.HelmValuesFileUpdate{
+				{
+					Path: "base.yaml",
+					Updates: map[string]string{
+						"image.tag": "1.26.0",
+						"replicas":  "3",
+					},
+				},
+				{
+					Path: "override.yaml",
+					Updates: map[string]string{
+						"replicas": "4",
+						// Not in override.yaml, but inherited from base.yaml
+						"image.tag": "'1.26.1'",
+						// Not in any values file
+						"image.digest": "sha256:fake",
+					},
+				},
+			},
+			assertions: func(t *testing.T, dir string, changes []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{"updated base.yaml", "updated override.yaml"},
+					changes,
+				)
+				baseValues, err := os.ReadFile(filepath.Join(dir, "base.yaml"))
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`# Base values
+image:
+  repository: nginx
+  tag: 1.26.0
+replicas: 3
+`,
+					string(baseValues),
+				)
+				overrideValues, err := os.ReadFile(filepath.Join(dir, "override.yaml"))
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`# Overrides for test
+replicas: 4
+image:
+  tag: 1.26.1
+`,
+					string(overrideValues),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(
+				t,
+				os.WriteFile(filepath.Join(dir, "base.yaml"), []byte(testBaseValues), 0600),
+			)
+			require.NoError(
+				t,
+				os.WriteFile(filepath.Join(dir, "override.yaml"), []byte(testOverrideValues), 0600),
+			)
+			changes, err := testCase.helmer.applyValuesFilesUpdates(dir, testCase.valuesFiles)
+			testCase.assertions(t, dir, changes, err)
+		})
+	}
+}
+
 func TestBuildValuesFilesChanges(t *testing.T) {
 	testOrigin := kargoapi.FreightOrigin{
 		Kind: kargoapi.FreightOriginKindWarehouse,
@@ -372,36 +785,37 @@ func TestBuildValuesFilesChanges(t *testing.T) {
 			},
 		},
 	}
+	freight := []kargoapi.FreightReference{{
+		Origin: testOrigin,
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "fake-url",
+				Tag:     "fake-tag",
+				Digest:  "fake-digest",
+			},
+			{
+				RepoURL: "second-fake-url",
+				Tag:     "second-fake-tag",
+				Digest:  "second-fake-digest",
+			},
+			{
+				RepoURL: "third-fake-url",
+				Tag:     "third-fake-tag",
+				Digest:  "third-fake-digest",
+			},
+			{
+				RepoURL: "fourth-fake-url",
+				Tag:     "fourth-fake-tag",
+				Digest:  "fourth-fake-digest",
+			},
+		},
+	}}
 	h := &helmer{}
 	result, changeSummary, err := h.buildValuesFilesChanges(
 		context.Background(),
 		stage,
 		stage.Spec.PromotionMechanisms.GitRepoUpdates[0].Helm,
-		[]kargoapi.FreightReference{{
-			Origin: testOrigin,
-			Images: []kargoapi.Image{
-				{
-					RepoURL: "fake-url",
-					Tag:     "fake-tag",
-					Digest:  "fake-digest",
-				},
-				{
-					RepoURL: "second-fake-url",
-					Tag:     "second-fake-tag",
-					Digest:  "second-fake-digest",
-				},
-				{
-					RepoURL: "third-fake-url",
-					Tag:     "third-fake-tag",
-					Digest:  "third-fake-digest",
-				},
-				{
-					RepoURL: "fourth-fake-url",
-					Tag:     "fourth-fake-tag",
-					Digest:  "fourth-fake-digest",
-				},
-			},
-		}},
+		freight,
 	)
 	require.NoError(t, err)
 	require.Equal(
@@ -428,6 +842,16 @@ func TestBuildValuesFilesChanges(t *testing.T) {
 		},
 		changeSummary,
 	)
+
+	// An image cannot be referenced by digest if Freight records none for it
+	freight[0].Images[2].Digest = ""
+	_, _, err = h.buildValuesFilesChanges(
+		context.Background(),
+		stage,
+		stage.Spec.PromotionMechanisms.GitRepoUpdates[0].Helm,
+		freight,
+	)
+	require.ErrorContains(t, err, "cannot be referenced by digest")
 }
 
 func TestBuildChartDependencyChanges(t *testing.T) {
@@ -521,7 +945,26 @@ func TestBuildChartDependencyChanges(t *testing.T) {
 		},
 	}
 
-	h := &helmer{}
+	h := &helmer{
+		getChartChangesFn: func(
+			_ context.Context,
+			_ string,
+			_ string,
+			chart string,
+			fromVersion string,
+			toVersion string,
+		) ([]helm.ChartChange, error) {
+			if chart != "fake-chart" {
+				// Failure to obtain changes should not fail the promotion
+				return nil, errors.New("something went wrong")
+			}
+			require.Equal(t, "placeholder", fromVersion)
+			require.Equal(t, "fake-version", toVersion)
+			return []helm.ChartChange{
+				{Version: "fake-version", Description: "fake change"},
+			}, nil
+		},
+	}
 	result, changeSummary, err := h.buildChartDependencyChanges(
 		context.Background(),
 		stage,
@@ -542,15 +985,337 @@ func TestBuildChartDependencyChanges(t *testing.T) {
 		},
 		result,
 	)
-	require.Contains(
+	require.Equal(
 		t,
+		[]string{
+			"updated charts/foo/Chart.yaml to use subchart fake-chart:fake-version",
+			"fake-chart fake-version: fake change",
+			"updated charts/bar/Chart.yaml to use subchart " +
+				"another-fake-chart:another-fake-version",
+		},
 		changeSummary,
-		"updated charts/foo/Chart.yaml to use subchart fake-chart:fake-version",
 	)
-	require.Contains(
+}
+
+func TestHelmerApplyMultipleChartDependencies(t *testing.T) {
+	// Set up an umbrella chart with two dependencies, both of which will be
+	// bumped by the same promotion.
+	testDir := t.TempDir()
+	testChartDir := filepath.Join(testDir, "charts", "umbrella")
+	err := os.MkdirAll(testChartDir, 0755)
+	require.NoError(t, err)
+	err = os.WriteFile(
+		filepath.Join(testChartDir, "Chart.yaml"),
+		[]byte(`dependencies:
+- repository: fake-repo
+  name: fake-chart
+  version: placeholder
+- repository: another-fake-repo
+  name: another-fake-chart
+  version: placeholder
+`),
+		0600,
+	)
+	require.NoError(t, err)
+
+	testOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	freight := []kargoapi.FreightReference{{
+		Origin: testOrigin,
+		Charts: []kargoapi.Chart{
+			{
+				RepoURL: "fake-repo",
+				Name:    "fake-chart",
+				Version: "fake-version",
+			},
+			{
+				RepoURL: "another-fake-repo",
+				Name:    "another-fake-chart",
+				Version: "another-fake-version",
+			},
+		},
+	}}
+	stage := &kargoapi.Stage{
+		Spec: kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates: []kargoapi.GitRepoUpdate{{
+					Helm: &kargoapi.HelmPromotionMechanism{
+						Origin: &testOrigin,
+						Charts: []kargoapi.HelmChartDependencyUpdate{
+							{
+								Repository: "fake-repo",
+								Name:       "fake-chart",
+								ChartPath:  "charts/umbrella",
+							},
+							{
+								Repository: "another-fake-repo",
+								Name:       "another-fake-chart",
+								ChartPath:  "charts/umbrella",
+							},
+						},
+					},
+				}},
+			},
+		},
+	}
+
+	var chartYAMLWrites []map[string]string
+	var dependencyUpdates []string
+	h := &helmer{
+		buildValuesFilesChangesFn: func(
+			context.Context,
+			*kargoapi.Stage,
+			*kargoapi.HelmPromotionMechanism,
+			[]kargoapi.FreightReference,
+		) (map[string]map[string]string, []string, error) {
+			return nil, nil, nil
+		},
+		setStringsInYAMLFileFn: func(_ string, changes map[string]string) error {
+			chartYAMLWrites = append(chartYAMLWrites, changes)
+			return nil
+		},
+		prepareDependencyCredentialsFn: func(context.Context, string, string, string) error {
+			return nil
+		},
+		updateChartDependenciesFn: func(_ context.Context, _, chartPath string) error {
+			dependencyUpdates = append(dependencyUpdates, chartPath)
+			return nil
+		},
+		getChartChangesFn: func(
+			context.Context,
+			string,
+			string,
+			string,
+			string,
+			string,
+		) ([]helm.ChartChange, error) {
+			return nil, nil
+		},
+	}
+	h.buildChartDependencyChangesFn = h.buildChartDependencyChanges
+
+	changes, err := h.apply(
+		context.Background(),
+		stage,
+		&stage.Spec.PromotionMechanisms.GitRepoUpdates[0],
+		freight,
+		"",
+		"",
+		testDir,
+		git.RepoCredentials{},
+	)
+	require.NoError(t, err)
+
+	// Both dependency bumps should have been applied to Chart.yaml in a single
+	// write, followed by a single dependency update for the umbrella chart.
+	require.Equal(
 		t,
-		changeSummary,
-		"updated charts/bar/Chart.yaml to use subchart "+
-			"another-fake-chart:another-fake-version",
+		[]map[string]string{{
+			"dependencies.0.version": "fake-version",
+			"dependencies.1.version": "another-fake-version",
+		}},
+		chartYAMLWrites,
+	)
+	require.Equal(t, []string{testChartDir}, dependencyUpdates)
+	require.Equal(
+		t,
+		[]string{
+			"updated charts/umbrella/Chart.yaml to use subchart fake-chart:fake-version",
+			"updated charts/umbrella/Chart.yaml to use subchart another-fake-chart:another-fake-version",
+		},
+		changes,
 	)
 }
+
+func TestBuildChartDependencyChangesWithUmbrellaChart(t *testing.T) {
+	const testUmbrellaChartYAML = `apiVersion: v2
+name: umbrella
+version: 0.1.0
+dependencies:
+# Referenced by the Freight at a newer version
+- name: frontend
+  repository: https://charts.example.com
+  version: 1.0.0
+# Referenced by the Freight at the same version
+- name: backend
+  repository: oci://registry.example.com/charts
+  version: 2.0.0
+# Local dependency
+- name: common
+  repository: file://../common
+  version: 0.1.0
+# Not referenced by the Freight
+- name: database
+  repository: https://charts.example.com
+  version: 3.0.0
+# Also covered by an explicit chart dependency update
+- name: cache
+  repository: https://charts.example.com
+  version: 4.0.0
+`
+
+	testOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	freight := []kargoapi.FreightReference{{
+		Origin: testOrigin,
+		Charts: []kargoapi.Chart{
+			{
+				RepoURL: "https://charts.example.com",
+				Name:    "frontend",
+				Version: "1.1.0",
+			},
+			{
+				RepoURL: "oci://registry.example.com/charts",
+				Name:    "backend",
+				Version: "2.0.0",
+			},
+			{
+				RepoURL: "https://charts.example.com",
+				Name:    "cache",
+				Version: "4.1.0",
+			},
+		},
+	}}
+
+	testCases := []struct {
+		name       string
+		charts     []kargoapi.HelmChartDependencyUpdate
+		assertions func(*testing.T, string, map[string]map[string]string, []string, error)
+	}{
+		{
+			name: "only changed dependency versions are written",
+			assertions: func(
+				t *testing.T,
+				testDir string,
+				changes map[string]map[string]string,
+				changeSummary []string,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]map[string]string{
+						"charts/umbrella": {
+							"dependencies.0.version": "1.1.0",
+							"dependencies.4.version": "4.1.0",
+						},
+					},
+					changes,
+				)
+				require.Equal(
+					t,
+					[]string{
+						"updated charts/umbrella/Chart.yaml to use subchart frontend:1.1.0",
+						"updated charts/umbrella/Chart.yaml to use subchart cache:4.1.0",
+					},
+					changeSummary,
+				)
+
+				chartYAMLPath := filepath.Join(testDir, "charts", "umbrella", "Chart.yaml")
+				require.NoError(
+					t,
+					libYAML.SetStringsInFile(chartYAMLPath, changes["charts/umbrella"]),
+				)
+				chartYAML, err := os.ReadFile(chartYAMLPath)
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					strings.NewReplacer(
+						"version: 1.0.0", "version: 1.1.0",
+						"version: 4.0.0", "version: 4.1.0",
+					).Replace(testUmbrellaChartYAML),
+					string(chartYAML),
+				)
+			},
+		},
+		{
+			name: "dependency already covered by a chart dependency update",
+			charts: []kargoapi.HelmChartDependencyUpdate{{
+				Repository: "https://charts.example.com",
+				Name:       "cache",
+				ChartPath:  "charts/umbrella",
+			}},
+			assertions: func(
+				t *testing.T,
+				_ string,
+				changes map[string]map[string]string,
+				changeSummary []string,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]map[string]string{
+						"charts/umbrella": {
+							"dependencies.0.version": "1.1.0",
+							"dependencies.4.version": "4.1.0",
+						},
+					},
+					changes,
+				)
+				// The cache dependency is only reported once
+				require.Equal(
+					t,
+					[]string{
+						"updated charts/umbrella/Chart.yaml to use subchart cache:4.1.0",
+						"updated charts/umbrella/Chart.yaml to use subchart frontend:1.1.0",
+					},
+					changeSummary,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testDir := t.TempDir()
+			testChartDir := filepath.Join(testDir, "charts", "umbrella")
+			require.NoError(t, os.MkdirAll(testChartDir, 0755))
+			require.NoError(
+				t,
+				os.WriteFile(
+					filepath.Join(testChartDir, "Chart.yaml"),
+					[]byte(testUmbrellaChartYAML),
+					0600,
+				),
+			)
+
+			stage := &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						GitRepoUpdates: []kargoapi.GitRepoUpdate{{
+							Helm: &kargoapi.HelmPromotionMechanism{
+								Origin:            &testOrigin,
+								Charts:            testCase.charts,
+								UmbrellaChartPath: "charts/umbrella",
+							},
+						}},
+					},
+				},
+			}
+			h := &helmer{
+				getChartChangesFn: func(
+					context.Context,
+					string,
+					string,
+					string,
+					string,
+					string,
+				) ([]helm.ChartChange, error) {
+					return nil, nil
+				},
+			}
+			changes, changeSummary, err := h.buildChartDependencyChanges(
+				context.Background(),
+				stage,
+				stage.Spec.PromotionMechanisms.GitRepoUpdates[0].Helm,
+				freight,
+				testDir,
+			)
+			testCase.assertions(t, testDir, changes, changeSummary, err)
+		})
+	}
+}
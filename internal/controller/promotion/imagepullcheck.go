@@ -0,0 +1,200 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/credentials/kubernetes/dockerconfig"
+	"github.com/akuity/kargo/internal/image"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// imagePullCheckMechanism is an implementation of the Mechanism interface that
+// verifies that every image referenced by the Freight being promoted can be
+// pulled before any subsequent promotion mechanisms are executed.
+type imagePullCheckMechanism struct {
+	kargoClient   client.Client
+	credentialsDB credentials.Database
+	// These behaviors are overridable for testing purposes:
+	checkManifestFn func(
+		ctx context.Context,
+		repoURL string,
+		tagOrDigest string,
+		creds *image.Credentials,
+	) error
+}
+
+// newImagePullCheckMechanism returns an implementation of the Mechanism
+// interface that verifies that every image referenced by the Freight being
+// promoted can be pulled before any subsequent promotion mechanisms are
+// executed.
+func newImagePullCheckMechanism(
+	kargoClient client.Client,
+	credentialsDB credentials.Database,
+) Mechanism {
+	i := &imagePullCheckMechanism{
+		kargoClient:   kargoClient,
+		credentialsDB: credentialsDB,
+	}
+	i.checkManifestFn = image.CheckManifest
+	return i
+}
+
+// GetName implements the Mechanism interface.
+func (*imagePullCheckMechanism) GetName() string {
+	return "image pull check promotion mechanism"
+}
+
+// Promote implements the Mechanism interface.
+func (i *imagePullCheckMechanism) Promote(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	newFreight []kargoapi.FreightReference,
+) (*kargoapi.PromotionStatus, []kargoapi.FreightReference, error) {
+	check := stage.Spec.PromotionMechanisms.ImagePullCheck
+	if check == nil {
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	logger.Debug("checking that images can be pulled")
+
+	var pullSecret *dockerconfig.Config
+	if check.PullSecret != "" {
+		var err error
+		if pullSecret, err = i.getPullSecret(ctx, stage.Namespace, check.PullSecret); err != nil {
+			return nil, newFreight, err
+		}
+	}
+
+	newStatus := promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded)
+	for _, freight := range newFreight {
+		for _, img := range freight.Images {
+			ref := img.Tag
+			if img.Digest != "" {
+				ref = img.Digest
+			}
+			creds, err := i.getCredentials(ctx, stage.Namespace, pullSecret, img.RepoURL)
+			if err != nil {
+				return nil, newFreight, err
+			}
+			if err = i.checkManifestFn(ctx, img.RepoURL, ref, creds); err != nil {
+				newStatus.Phase = kargoapi.PromotionPhaseFailed
+				newStatus.Message = fmt.Sprintf(
+					"image %q cannot be pulled: %s",
+					imageString(img.RepoURL, ref),
+					err,
+				)
+				logger.Debug(
+					"done checking that images can be pulled",
+					"phase", newStatus.Phase,
+				)
+				return newStatus, newFreight, nil
+			}
+		}
+	}
+
+	logger.Debug(
+		"done checking that images can be pulled",
+		"phase", newStatus.Phase,
+	)
+
+	return newStatus, newFreight, nil
+}
+
+// getPullSecret retrieves the specified Secret of type
+// kubernetes.io/dockerconfigjson and returns its parsed contents.
+func (i *imagePullCheckMechanism) getPullSecret(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (*dockerconfig.Config, error) {
+	secret := &corev1.Secret{}
+	if err := i.kargoClient.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      name,
+		},
+		secret,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error getting image pull Secret %q in namespace %q: %w",
+			name,
+			namespace,
+			err,
+		)
+	}
+	cfg, err := dockerconfig.FromSecret(secret)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error parsing image pull Secret %q in namespace %q: %w",
+			name,
+			namespace,
+			err,
+		)
+	}
+	return cfg, nil
+}
+
+// getCredentials returns the credentials to use for retrieving image manifests
+// from the specified repository. If a pull secret is provided, credentials are
+// taken from it. Otherwise, they are looked up in the credentials database.
+// Nil is returned if no credentials are found.
+func (i *imagePullCheckMechanism) getCredentials(
+	ctx context.Context,
+	namespace string,
+	pullSecret *dockerconfig.Config,
+	repoURL string,
+) (*image.Credentials, error) {
+	if pullSecret != nil {
+		creds, err := pullSecret.CredentialsFor(repoURL)
+		if err != nil || creds == nil {
+			return nil, err
+		}
+		return toImageCredentials(*creds), nil
+	}
+	if i.credentialsDB == nil {
+		return nil, nil
+	}
+	creds, ok, err := i.credentialsDB.Get(ctx, namespace, credentials.TypeImage, repoURL)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error obtaining credentials for image repo %q: %w",
+			repoURL,
+			err,
+		)
+	}
+	if !ok {
+		return nil, nil
+	}
+	return toImageCredentials(creds), nil
+}
+
+// toImageCredentials returns the image registry credentials held by the
+// provided Credentials.
+func toImageCredentials(creds credentials.Credentials) *image.Credentials {
+	return &image.Credentials{
+		Username:      creds.Username,
+		Password:      creds.Password,
+		IdentityToken: creds.IdentityToken,
+		RegistryToken: creds.RegistryToken,
+	}
+}
+
+// imageString returns a human-readable reference to the image identified by
+// the provided tag or digest in the specified repository.
+func imageString(repoURL, tagOrDigest string) string {
+	if strings.Contains(tagOrDigest, ":") {
+		return fmt.Sprintf("%s@%s", repoURL, tagOrDigest)
+	}
+	return fmt.Sprintf("%s:%s", repoURL, tagOrDigest)
+}
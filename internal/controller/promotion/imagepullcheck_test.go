@@ -0,0 +1,208 @@
+package promotion
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/image"
+)
+
+func TestNewImagePullCheckMechanism(t *testing.T) {
+	pm := newImagePullCheckMechanism(
+		fake.NewClientBuilder().Build(),
+		&credentials.FakeDB{},
+	)
+	ipcm, ok := pm.(*imagePullCheckMechanism)
+	require.True(t, ok)
+	require.NotNil(t, ipcm.kargoClient)
+	require.NotNil(t, ipcm.credentialsDB)
+	require.NotNil(t, ipcm.checkManifestFn)
+}
+
+func TestImagePullCheckGetName(t *testing.T) {
+	require.NotEmpty(t, (&imagePullCheckMechanism{}).GetName())
+}
+
+func TestImagePullCheckPromote(t *testing.T) {
+	const testNamespace = "fake-namespace"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	testFreight := []kargoapi.FreightReference{{
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "fake-registry.example.com/fake-image",
+				Tag:     "v1.0.0",
+			},
+			{
+				RepoURL: "fake-registry.example.com/other-image",
+				Tag:     "v2.0.0",
+				Digest:  "sha256:abc",
+			},
+		},
+	}}
+
+	testCases := []struct {
+		name       string
+		promoMech  *imagePullCheckMechanism
+		check      *kargoapi.ImagePullCheck
+		assertions func(*testing.T, *kargoapi.PromotionStatus, error)
+	}{
+		{
+			name: "no image pull check",
+			promoMech: &imagePullCheckMechanism{
+				checkManifestFn: func(context.Context, string, string, *image.Credentials) error {
+					return errors.New("should not be called")
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "error getting pull secret",
+			promoMech: &imagePullCheckMechanism{
+				kargoClient: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			},
+			check: &kargoapi.ImagePullCheck{PullSecret: "missing-secret"},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(t, err, "error getting image pull Secret")
+			},
+		},
+		{
+			name: "error getting credentials",
+			promoMech: &imagePullCheckMechanism{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{}, false, errors.New("something went wrong")
+					},
+				},
+			},
+			check: &kargoapi.ImagePullCheck{},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials for image repo")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "image cannot be pulled",
+			promoMech: &imagePullCheckMechanism{
+				checkManifestFn: func(_ context.Context, repoURL, _ string, _ *image.Credentials) error {
+					if repoURL == "fake-registry.example.com/other-image" {
+						return errors.New("MANIFEST_UNKNOWN")
+					}
+					return nil
+				},
+			},
+			check: &kargoapi.ImagePullCheck{},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseFailed, status.Phase)
+				require.Equal(
+					t,
+					`image "fake-registry.example.com/other-image@sha256:abc" cannot be pulled: MANIFEST_UNKNOWN`,
+					status.Message,
+				)
+			},
+		},
+		{
+			name: "images can be pulled using credentials database",
+			promoMech: &imagePullCheckMechanism{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{
+							Username: "fake-username",
+							Password: "fake-password",
+						}, true, nil
+					},
+				},
+				checkManifestFn: func(_ context.Context, _, ref string, creds *image.Credentials) error {
+					if ref != "v1.0.0" && ref != "sha256:abc" {
+						return errors.New("unexpected reference")
+					}
+					if creds == nil || creds.Username != "fake-username" {
+						return errors.New("unexpected credentials")
+					}
+					return nil
+				},
+			},
+			check: &kargoapi.ImagePullCheck{},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "images can be pulled using pull secret",
+			promoMech: &imagePullCheckMechanism{
+				kargoClient: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      "pull-secret",
+						},
+						Type: corev1.SecretTypeDockerConfigJson,
+						Data: map[string][]byte{
+							corev1.DockerConfigJsonKey: []byte(
+								`{"auths":{"fake-registry.example.com":{"auth":"` +
+									base64.StdEncoding.EncodeToString([]byte("fake-username:fake-password")) +
+									`"}}}`,
+							),
+						},
+					},
+				).Build(),
+				checkManifestFn: func(_ context.Context, _, _ string, creds *image.Credentials) error {
+					if creds == nil || creds.Username != "fake-username" || creds.Password != "fake-password" {
+						return errors.New("unexpected credentials")
+					}
+					return nil
+				},
+			},
+			check: &kargoapi.ImagePullCheck{PullSecret: "pull-secret"},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status, _, err := testCase.promoMech.Promote(
+				context.Background(),
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace},
+					Spec: kargoapi.StageSpec{
+						PromotionMechanisms: &kargoapi.PromotionMechanisms{
+							ImagePullCheck: testCase.check,
+						},
+					},
+				},
+				&kargoapi.Promotion{},
+				testFreight,
+			)
+			testCase.assertions(t, status, err)
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// kubernetesMechanism is an implementation of the Mechanism interface that
+// applies JSON patches to arbitrary Kubernetes resources, such as Deployments
+// and StatefulSets.
+type kubernetesMechanism struct {
+	kargoClient client.Client
+	// These behaviors are overridable for testing purposes:
+	getAuthorizedResourceFn func(
+		ctx context.Context,
+		update *kargoapi.KubernetesResourceUpdate,
+		namespace string,
+		stageMeta metav1.ObjectMeta,
+	) (*unstructured.Unstructured, error)
+	patchResourceFn func(
+		ctx context.Context,
+		resource *unstructured.Unstructured,
+		patch []byte,
+	) error
+}
+
+// newKubernetesMechanism returns an implementation of the Mechanism interface
+// that applies JSON patches to arbitrary Kubernetes resources.
+func newKubernetesMechanism(kargoClient client.Client) Mechanism {
+	k := &kubernetesMechanism{
+		kargoClient: kargoClient,
+	}
+	k.getAuthorizedResourceFn = k.getAuthorizedResource
+	k.patchResourceFn = k.patchResource
+	return k
+}
+
+// GetName implements the Mechanism interface.
+func (*kubernetesMechanism) GetName() string {
+	return "Kubernetes resource promotion mechanism"
+}
+
+// Promote implements the Mechanism interface. The JSON patch of each update is
+// rendered against the Freight being promoted and applied to the targeted
+// resource. Controllers of the resource, such as the one responsible for
+// Deployments, act on the change asynchronously, so the outcome of that is left
+// to the Stage's health checks and verification.
+func (k *kubernetesMechanism) Promote(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	newFreight []kargoapi.FreightReference,
+) (*kargoapi.PromotionStatus, []kargoapi.FreightReference, error) {
+	updates := stage.Spec.PromotionMechanisms.KubernetesResourceUpdates
+
+	if len(updates) == 0 {
+		return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	logger.Debug("executing Kubernetes resource promotion mechanisms")
+
+	for i := range updates {
+		update := &updates[i]
+		namespace := update.Namespace
+		if namespace == "" {
+			namespace = stage.Namespace
+		}
+
+		resource, err := k.getAuthorizedResourceFn(
+			ctx,
+			update,
+			namespace,
+			stage.ObjectMeta,
+		)
+		if err != nil {
+			return nil, newFreight, err
+		}
+
+		patch, err := renderJSONPatch(stage, update, newFreight)
+		if err != nil {
+			return nil, newFreight, fmt.Errorf(
+				"error rendering JSON patch for %s %q in namespace %q: %w",
+				update.Kind, update.Name, namespace, err,
+			)
+		}
+
+		if stage.Spec.DryRun {
+			logger.Info(
+				"dry run: not patching Kubernetes resource",
+				"kind", update.Kind,
+				"name", update.Name,
+				"namespace", namespace,
+				"patch", string(patch),
+			)
+			continue
+		}
+
+		if err = k.patchResourceFn(ctx, resource, patch); err != nil {
+			return nil, newFreight, err
+		}
+	}
+
+	logger.Debug("done executing Kubernetes resource promotion mechanisms")
+	return promo.Status.WithPhase(kargoapi.PromotionPhaseSucceeded), newFreight, nil
+}
+
+// getAuthorizedResource returns the Kubernetes resource targeted by the
+// provided update from the given namespace, if it is authorized for mutation
+// by the Kargo Stage represented by stageMeta.
+func (k *kubernetesMechanism) getAuthorizedResource(
+	ctx context.Context,
+	update *kargoapi.KubernetesResourceUpdate,
+	namespace string,
+	stageMeta metav1.ObjectMeta,
+) (*unstructured.Unstructured, error) {
+	gv, err := schema.ParseGroupVersion(update.APIVersion)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error parsing API version %q: %w",
+			update.APIVersion, err,
+		)
+	}
+	resource := &unstructured.Unstructured{}
+	resource.SetGroupVersionKind(gv.WithKind(update.Kind))
+	if err = k.kargoClient.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      update.Name,
+		},
+		resource,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf(
+				"unable to find %s %q in namespace %q",
+				update.Kind, update.Name, namespace,
+			)
+		}
+		return nil, fmt.Errorf(
+			"error finding %s %q in namespace %q: %w",
+			update.Kind, update.Name, namespace, err,
+		)
+	}
+
+	if err = authorizeStageMutation(
+		update.Kind,
+		stageMeta,
+		metav1.ObjectMeta{
+			Namespace:   resource.GetNamespace(),
+			Name:        resource.GetName(),
+			Annotations: resource.GetAnnotations(),
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+// patchResource applies the provided JSON patch to the provided Kubernetes
+// resource.
+func (k *kubernetesMechanism) patchResource(
+	ctx context.Context,
+	resource *unstructured.Unstructured,
+	patch []byte,
+) error {
+	if err := k.kargoClient.Patch(
+		ctx,
+		resource,
+		client.RawPatch(types.JSONPatchType, patch),
+	); err != nil {
+		return fmt.Errorf(
+			"error patching %s %q in namespace %q: %w",
+			resource.GetKind(), resource.GetName(), resource.GetNamespace(), err,
+		)
+	}
+	return nil
+}
+
+// jsonPatchTemplateData is the data against which the JSON patch of a
+// KubernetesResourceUpdate is rendered.
+type jsonPatchTemplateData struct {
+	// Project is the name of the Project the Stage belongs to.
+	Project string
+	// Stage is the name of the Stage being promoted.
+	Stage string
+	// Freight is the FreightCollection being promoted.
+	Freight *kargoapi.FreightCollection
+}
+
+// renderJSONPatch renders the JSON patch of the provided update against the
+// provided Stage and Freight and verifies that the result is a valid JSON
+// patch.
+func renderJSONPatch(
+	stage *kargoapi.Stage,
+	update *kargoapi.KubernetesResourceUpdate,
+	newFreight []kargoapi.FreightReference,
+) ([]byte, error) {
+	tmpl, err := template.New("jsonPatch").Option("missingkey=error").
+		Parse(update.JSONPatch)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing JSON patch template: %w", err)
+	}
+	data := jsonPatchTemplateData{
+		Project: stage.Namespace,
+		Stage:   stage.Name,
+		Freight: &kargoapi.FreightCollection{},
+	}
+	data.Freight.UpdateOrPush(newFreight...)
+	patch := &strings.Builder{}
+	if err = tmpl.Execute(patch, data); err != nil {
+		return nil, fmt.Errorf("error rendering JSON patch template: %w", err)
+	}
+	if _, err = jsonpatch.DecodePatch([]byte(patch.String())); err != nil {
+		return nil, fmt.Errorf("error decoding JSON patch: %w", err)
+	}
+	return []byte(patch.String()), nil
+}
@@ -0,0 +1,496 @@
+package promotion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewKubernetesMechanism(t *testing.T) {
+	pm := newKubernetesMechanism(fake.NewFakeClient())
+	kpm, ok := pm.(*kubernetesMechanism)
+	require.True(t, ok)
+	require.NotNil(t, kpm.kargoClient)
+	require.NotNil(t, kpm.getAuthorizedResourceFn)
+	require.NotNil(t, kpm.patchResourceFn)
+}
+
+func TestKubernetesGetName(t *testing.T) {
+	require.NotEmpty(t, (&kubernetesMechanism{}).GetName())
+}
+
+func TestKubernetesPromote(t *testing.T) {
+	testOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	testFreight := []kargoapi.FreightReference{{
+		Name:   "fake-freight",
+		Origin: testOrigin,
+	}}
+	testUpdate := kargoapi.KubernetesResourceUpdate{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "fake-deployment",
+		JSONPatch: `[{"op":"add","path":"/spec/template/metadata/annotations/stage",` +
+			`"value":"{{ .Project }}/{{ .Stage }}"}]`,
+	}
+
+	testCases := []struct {
+		name       string
+		promoMech  *kubernetesMechanism
+		stage      *kargoapi.Stage
+		assertions func(*testing.T, *kargoapi.PromotionStatus, error)
+	}{
+		{
+			name:      "no updates",
+			promoMech: &kubernetesMechanism{},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "error getting resource",
+			promoMech: &kubernetesMechanism{
+				getAuthorizedResourceFn: func(
+					context.Context,
+					*kargoapi.KubernetesResourceUpdate,
+					string,
+					metav1.ObjectMeta,
+				) (*unstructured.Unstructured, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						KubernetesResourceUpdates: []kargoapi.KubernetesResourceUpdate{testUpdate},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "resource namespace defaults to Stage namespace",
+			promoMech: &kubernetesMechanism{
+				getAuthorizedResourceFn: func(
+					_ context.Context,
+					_ *kargoapi.KubernetesResourceUpdate,
+					namespace string,
+					_ metav1.ObjectMeta,
+				) (*unstructured.Unstructured, error) {
+					if namespace != "fake-namespace" {
+						return nil, errors.New("unexpected namespace")
+					}
+					return &unstructured.Unstructured{}, nil
+				},
+				patchResourceFn: func(
+					context.Context,
+					*unstructured.Unstructured,
+					[]byte,
+				) error {
+					return nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+				},
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						KubernetesResourceUpdates: []kargoapi.KubernetesResourceUpdate{testUpdate},
+					},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "invalid JSON patch",
+			promoMech: &kubernetesMechanism{
+				getAuthorizedResourceFn: func(
+					context.Context,
+					*kargoapi.KubernetesResourceUpdate,
+					string,
+					metav1.ObjectMeta,
+				) (*unstructured.Unstructured, error) {
+					return &unstructured.Unstructured{}, nil
+				},
+				patchResourceFn: func(
+					context.Context,
+					*unstructured.Unstructured,
+					[]byte,
+				) error {
+					return errors.New("should not be called")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						KubernetesResourceUpdates: []kargoapi.KubernetesResourceUpdate{{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Name:       "fake-deployment",
+							JSONPatch:  `{"op":"add"}`,
+						}},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(t, err, "error rendering JSON patch")
+				require.ErrorContains(t, err, "error decoding JSON patch")
+			},
+		},
+		{
+			name: "dry run",
+			promoMech: &kubernetesMechanism{
+				getAuthorizedResourceFn: func(
+					context.Context,
+					*kargoapi.KubernetesResourceUpdate,
+					string,
+					metav1.ObjectMeta,
+				) (*unstructured.Unstructured, error) {
+					return &unstructured.Unstructured{}, nil
+				},
+				patchResourceFn: func(
+					context.Context,
+					*unstructured.Unstructured,
+					[]byte,
+				) error {
+					return errors.New("should not be called")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					DryRun: true,
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						KubernetesResourceUpdates: []kargoapi.KubernetesResourceUpdate{testUpdate},
+					},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+		{
+			name: "error patching resource",
+			promoMech: &kubernetesMechanism{
+				getAuthorizedResourceFn: func(
+					context.Context,
+					*kargoapi.KubernetesResourceUpdate,
+					string,
+					metav1.ObjectMeta,
+				) (*unstructured.Unstructured, error) {
+					return &unstructured.Unstructured{}, nil
+				},
+				patchResourceFn: func(
+					context.Context,
+					*unstructured.Unstructured,
+					[]byte,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						KubernetesResourceUpdates: []kargoapi.KubernetesResourceUpdate{testUpdate},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			promoMech: &kubernetesMechanism{
+				getAuthorizedResourceFn: func(
+					context.Context,
+					*kargoapi.KubernetesResourceUpdate,
+					string,
+					metav1.ObjectMeta,
+				) (*unstructured.Unstructured, error) {
+					return &unstructured.Unstructured{}, nil
+				},
+				patchResourceFn: func(
+					_ context.Context,
+					_ *unstructured.Unstructured,
+					patch []byte,
+				) error {
+					expected := `[{"op":"add","path":"/spec/template/metadata/annotations/stage",` +
+						`"value":"fake-namespace/fake-stage"}]`
+					if string(patch) != expected {
+						return errors.New("unexpected patch")
+					}
+					return nil
+				},
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						KubernetesResourceUpdates: []kargoapi.KubernetesResourceUpdate{testUpdate},
+					},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, status.Phase)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			status, _, err := testCase.promoMech.Promote(
+				context.Background(),
+				testCase.stage,
+				&kargoapi.Promotion{},
+				testFreight,
+			)
+			testCase.assertions(t, status, err)
+		})
+	}
+}
+
+func TestKubernetesGetAuthorizedResource(t *testing.T) {
+	testStageMeta := metav1.ObjectMeta{
+		Namespace: "fake-namespace",
+		Name:      "fake-stage",
+	}
+	testUpdate := &kargoapi.KubernetesResourceUpdate{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "fake-deployment",
+	}
+	newDeployment := func(annotations map[string]string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "fake-namespace",
+				Name:        "fake-deployment",
+				Annotations: annotations,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		update     *kargoapi.KubernetesResourceUpdate
+		deployment *appsv1.Deployment
+		assertions func(*testing.T, *unstructured.Unstructured, error)
+	}{
+		{
+			name: "invalid API version",
+			update: &kargoapi.KubernetesResourceUpdate{
+				APIVersion: "apps/v1/invalid",
+				Kind:       "Deployment",
+				Name:       "fake-deployment",
+			},
+			assertions: func(t *testing.T, _ *unstructured.Unstructured, err error) {
+				require.ErrorContains(t, err, "error parsing API version")
+			},
+		},
+		{
+			name:   "resource not found",
+			update: testUpdate,
+			assertions: func(t *testing.T, _ *unstructured.Unstructured, err error) {
+				require.ErrorContains(t, err, `unable to find Deployment "fake-deployment"`)
+			},
+		},
+		{
+			name:       "resource not authorized",
+			update:     testUpdate,
+			deployment: newDeployment(nil),
+			assertions: func(t *testing.T, _ *unstructured.Unstructured, err error) {
+				require.ErrorContains(t, err, "does not permit mutation")
+				require.ErrorContains(t, err, "Deployment")
+			},
+		},
+		{
+			name:   "success",
+			update: testUpdate,
+			deployment: newDeployment(map[string]string{
+				authorizedStageAnnotationKey: "fake-namespace:fake-stage",
+			}),
+			assertions: func(t *testing.T, resource *unstructured.Unstructured, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-deployment", resource.GetName())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(newAppsScheme(t))
+			if testCase.deployment != nil {
+				c.WithObjects(testCase.deployment)
+			}
+			k := &kubernetesMechanism{
+				kargoClient: c.Build(),
+			}
+			resource, err := k.getAuthorizedResource(
+				context.Background(),
+				testCase.update,
+				"fake-namespace",
+				testStageMeta,
+			)
+			testCase.assertions(t, resource, err)
+		})
+	}
+}
+
+func TestKubernetesPatchResource(t *testing.T) {
+	const testPatch = `[{"op":"add","path":"/spec/template/metadata/annotations",` +
+		`"value":{"kargo.akuity.io/freight":"fake-freight"}}]`
+
+	testCases := []struct {
+		name        string
+		resource    client.Object
+		interceptor interceptor.Funcs
+		assertions  func(*testing.T, client.Client, error)
+	}{
+		{
+			name: "error patching resource",
+			resource: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-resource",
+				},
+			},
+			interceptor: interceptor.Funcs{
+				Patch: func(
+					context.Context,
+					client.WithWatch,
+					client.Object,
+					client.Patch,
+					...client.PatchOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, `error patching Deployment "fake-resource"`)
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "Deployment patched",
+			resource: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-resource",
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, err error) {
+				require.NoError(t, err)
+				deployment := &appsv1.Deployment{}
+				require.NoError(
+					t,
+					c.Get(
+						context.Background(),
+						types.NamespacedName{
+							Namespace: "fake-namespace",
+							Name:      "fake-resource",
+						},
+						deployment,
+					),
+				)
+				require.Equal(
+					t,
+					"fake-freight",
+					deployment.Spec.Template.Annotations["kargo.akuity.io/freight"],
+				)
+			},
+		},
+		{
+			name: "StatefulSet patched",
+			resource: &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-resource",
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, err error) {
+				require.NoError(t, err)
+				statefulSet := &appsv1.StatefulSet{}
+				require.NoError(
+					t,
+					c.Get(
+						context.Background(),
+						types.NamespacedName{
+							Namespace: "fake-namespace",
+							Name:      "fake-resource",
+						},
+						statefulSet,
+					),
+				)
+				require.Equal(
+					t,
+					"fake-freight",
+					statefulSet.Spec.Template.Annotations["kargo.akuity.io/freight"],
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			scheme := newAppsScheme(t)
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.resource).
+				WithInterceptorFuncs(testCase.interceptor).
+				Build()
+			k := &kubernetesMechanism{
+				kargoClient: c,
+			}
+
+			// Resources are patched as unstructured objects, just as they are
+			// when retrieved by getAuthorizedResource.
+			gvks, _, err := scheme.ObjectKinds(testCase.resource)
+			require.NoError(t, err)
+			resource := &unstructured.Unstructured{}
+			resource.SetGroupVersionKind(gvks[0])
+			resource.SetNamespace(testCase.resource.GetNamespace())
+			resource.SetName(testCase.resource.GetName())
+
+			testCase.assertions(
+				t,
+				c,
+				k.patchResource(context.Background(), resource, []byte(testPatch)),
+			)
+		})
+	}
+}
+
+// newAppsScheme returns a scheme that knows about Deployments and
+// StatefulSets.
+func newAppsScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	require.NoError(t, appsv1.AddToScheme(scheme))
+	return scheme
+}
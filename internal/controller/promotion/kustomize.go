@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -26,9 +28,11 @@ func newKustomizeMechanism(
 		credentialsDB,
 		selectKustomizeUpdates,
 		(&kustomizer{
-			client:      cl,
-			findImageFn: freight.FindImage,
-			setImageFn:  kustomize.SetImage,
+			client:                cl,
+			findImageFn:           freight.FindImage,
+			setImageFn:            kustomize.SetImage,
+			setConfigMapLiteralFn: kustomize.SetConfigMapLiteral,
+			setComponentImagesFn:  kustomize.SetComponentImages,
 		}).apply,
 	)
 }
@@ -58,7 +62,9 @@ type kustomizer struct {
 		freight []kargoapi.FreightReference,
 		repoURL string,
 	) (*kargoapi.Image, error)
-	setImageFn func(dir, fqImageRef string) error
+	setImageFn            func(ctx context.Context, dir string, fqImageRefs ...string) error
+	setConfigMapLiteralFn func(dir, configMap, key, value string) error
+	setComponentImagesFn  func(dir string, fqImageRefs ...string) ([]string, error)
 }
 
 // apply uses Kustomize to carry out the provided update in the specified
@@ -74,6 +80,11 @@ func (k *kustomizer) apply(
 	_ git.RepoCredentials,
 ) ([]string, error) {
 	changeSummary := make([]string, 0, len(update.Kustomize.Images))
+	// Images are accumulated by directory so that all images in a single
+	// overlay that are to be set using `kustomize edit set image` are set by a
+	// single invocation of Kustomize.
+	var listedDirs []string
+	imagesByDir := make(map[string][]*kustomizeImage)
 	for i := range update.Kustomize.Images {
 		imgUpdate := &update.Kustomize.Images[i]
 		desiredOrigin := freight.GetDesiredOrigin(stage, imgUpdate)
@@ -86,29 +97,183 @@ func (k *kustomizer) apply(
 			// TODO: Warn?
 			continue
 		}
+		repoURL := image.RepoURL
+		if imgUpdate.StripRegistryHost {
+			repoURL = stripRegistryHost(repoURL)
+		}
 		var fqImageRef string // Fully-qualified image reference
 		if imgUpdate.UseDigest {
-			fqImageRef = fmt.Sprintf("%s@%s", image.RepoURL, image.Digest)
+			if image.Digest == "" {
+				return nil, errImageWithoutDigest(image)
+			}
+			fqImageRef = fmt.Sprintf("%s@%s", repoURL, image.Digest)
 		} else {
-			fqImageRef = fmt.Sprintf("%s:%s", image.RepoURL, image.Tag)
+			fqImageRef = fmt.Sprintf("%s:%s", repoURL, image.Tag)
 		}
 		dir := filepath.Join(workingDir, imgUpdate.Path)
-		if err := k.setImageFn(dir, fqImageRef); err != nil {
+		if _, ok := imagesByDir[dir]; !ok {
+			listedDirs = append(listedDirs, dir)
+		}
+		imagesByDir[dir] = append(
+			imagesByDir[dir],
+			&kustomizeImage{
+				image:             imgUpdate.Image,
+				path:              imgUpdate.Path,
+				fqImageRef:        fqImageRef,
+				replacementSource: imgUpdate.ReplacementSource,
+			},
+		)
+	}
+	// Directories, and images within each directory, are updated in the order
+	// specified by the update so that the resulting commit is the same no
+	// matter how many times the same Freight is promoted. Within a directory,
+	// replacement sources are updated before any other images.
+	// Every image reference is also set in any components that list the image.
+	var fqImageRefs []string
+	for _, dir := range orderEdits(update.EditOrder, listedDirs, listedDirs) {
+		imgUpdates := orderKustomizeImages(update.EditOrder, imagesByDir[dir])
+		var images, setImageRefs []string
+		for _, imgUpdate := range imgUpdates {
+			fqImageRefs = append(fqImageRefs, imgUpdate.fqImageRef)
+			src := imgUpdate.replacementSource
+			if src == nil {
+				images = append(images, imgUpdate.image)
+				setImageRefs = append(setImageRefs, imgUpdate.fqImageRef)
+				continue
+			}
+			if err := k.setConfigMapLiteralFn(dir, src.ConfigMap, src.Key, imgUpdate.fqImageRef); err != nil {
+				return nil, fmt.Errorf(
+					"error updating replacement source for image %q to %q: %w",
+					imgUpdate.image,
+					imgUpdate.fqImageRef,
+					err,
+				)
+			}
+			changeSummary = append(
+				changeSummary,
+				fmt.Sprintf(
+					"updated %s/kustomization.yaml to set %s in ConfigMap %s to image %s",
+					imgUpdate.path,
+					src.Key,
+					src.ConfigMap,
+					imgUpdate.fqImageRef,
+				),
+			)
+		}
+		if len(setImageRefs) == 0 {
+			continue
+		}
+		if err := k.setImageFn(ctx, dir, setImageRefs...); err != nil {
 			return nil, fmt.Errorf(
-				"error updating image %q to %q using Kustomize: %w",
-				imgUpdate.Image,
-				fqImageRef,
+				"error updating images %q to %q using Kustomize: %w",
+				images,
+				setImageRefs,
 				err,
 			)
 		}
-		changeSummary = append(
-			changeSummary,
-			fmt.Sprintf(
-				"updated %s/kustomization.yaml to use image %s",
-				imgUpdate.Path,
-				fqImageRef,
-			),
+		for _, imgUpdate := range imgUpdates {
+			if imgUpdate.replacementSource != nil {
+				continue
+			}
+			changeSummary = append(
+				changeSummary,
+				fmt.Sprintf(
+					"updated %s/kustomization.yaml to use image %s",
+					imgUpdate.path,
+					imgUpdate.fqImageRef,
+				),
+			)
+		}
+	}
+	if len(fqImageRefs) == 0 {
+		return changeSummary, nil
+	}
+	for _, component := range update.Kustomize.Components {
+		updatedRefs, err := k.setComponentImagesFn(
+			filepath.Join(workingDir, component),
+			fqImageRefs...,
 		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error updating images in Kustomize component %q: %w",
+				component,
+				err,
+			)
+		}
+		for _, fqImageRef := range updatedRefs {
+			changeSummary = append(
+				changeSummary,
+				fmt.Sprintf(
+					"updated %s/kustomization.yaml to use image %s",
+					component,
+					fqImageRef,
+				),
+			)
+		}
 	}
 	return changeSummary, nil
 }
+
+// kustomizeImage describes a single image to be set using
+// `kustomize edit set image`.
+type kustomizeImage struct {
+	// image is the image repository URL as specified by the update.
+	image string
+	// path is the path of the directory containing the kustomization.yaml file,
+	// relative to the root of the repository.
+	path string
+	// fqImageRef is the fully-qualified image reference to set.
+	fqImageRef string
+	// replacementSource, if non-nil, designates the ConfigMap literal to set
+	// instead of running `kustomize edit set image`.
+	replacementSource *kargoapi.KustomizeReplacementSource
+}
+
+// orderKustomizeImages returns the provided images, all of which are to be
+// updated in a single directory, in the order in which they are to be updated,
+// as specified by the provided EditOrder. Images that designate a replacement
+// source always precede all others. With EditOrderListed, images are otherwise
+// returned in the order in which they are listed. Otherwise, they are returned
+// in lexical order of their names.
+func orderKustomizeImages(
+	order kargoapi.EditOrder,
+	images []*kustomizeImage,
+) []*kustomizeImage {
+	ordered := slices.Clone(images)
+	slices.SortStableFunc(ordered, func(a, b *kustomizeImage) int {
+		if (a.replacementSource == nil) != (b.replacementSource == nil) {
+			if a.replacementSource != nil {
+				return -1
+			}
+			return 1
+		}
+		if order == kargoapi.EditOrderListed {
+			return 0
+		}
+		return strings.Compare(a.image, b.image)
+	})
+	return ordered
+}
+
+// stripRegistryHost removes the registry host, if any, from the provided image
+// repository URL. Following the same convention as Docker, the first component
+// of the URL is only considered to be a registry host if it contains a "." or a
+// ":" or is "localhost".
+func stripRegistryHost(repoURL string) string {
+	host, rest, found := strings.Cut(repoURL, "/")
+	if found && (strings.ContainsAny(host, ".:") || host == "localhost") {
+		return rest
+	}
+	return repoURL
+}
+
+// errImageWithoutDigest returns an error indicating that the provided image
+// cannot be referenced by digest because none was recorded for it. This can
+// happen with Freight that was created manually.
+func errImageWithoutDigest(image *kargoapi.Image) error {
+	return fmt.Errorf(
+		"image %q cannot be referenced by digest because Freight records no "+
+			"digest for it",
+		imageString(image.RepoURL, image.Tag),
+	)
+}
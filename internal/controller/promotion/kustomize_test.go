@@ -3,6 +3,12 @@ package promotion
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -12,6 +18,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/kustomize"
 )
 
 func TestNewKustomizeMechanism(t *testing.T) {
@@ -130,7 +137,7 @@ func TestKustomizerApply(t *testing.T) {
 				) (*kargoapi.Image, error) {
 					return &kargoapi.Image{}, nil
 				},
-				setImageFn: func(string, string) error {
+				setImageFn: func(context.Context, string, ...string) error {
 					return errors.New("something went wrong")
 				},
 			},
@@ -139,6 +146,44 @@ func TestKustomizerApply(t *testing.T) {
 				require.ErrorContains(t, err, "something went wrong")
 			},
 		},
+		{
+			name: "error referencing image without digest by digest",
+			update: kargoapi.GitRepoUpdate{
+				Kustomize: &kargoapi.KustomizePromotionMechanism{
+					Images: []kargoapi.KustomizeImageUpdate{
+						{
+							Image:     "fake-image",
+							UseDigest: true,
+						},
+					},
+				},
+			},
+			kustomizer: &kustomizer{
+				findImageFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+					*kargoapi.FreightOrigin,
+					[]kargoapi.FreightReference,
+					string,
+				) (*kargoapi.Image, error) {
+					return &kargoapi.Image{
+						RepoURL: "fake-image",
+						Tag:     "fake-tag",
+					}, nil
+				},
+				setImageFn: func(context.Context, string, ...string) error {
+					return errors.New("should not be called")
+				},
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(
+					t,
+					err,
+					`image "fake-image:fake-tag" cannot be referenced by digest`,
+				)
+			},
+		},
 		{
 			name: "success using tag",
 			update: kargoapi.GitRepoUpdate{
@@ -165,7 +210,7 @@ func TestKustomizerApply(t *testing.T) {
 						Tag:     "fake-tag",
 					}, nil
 				},
-				setImageFn: func(string, string) error {
+				setImageFn: func(context.Context, string, ...string) error {
 					return nil
 				},
 			},
@@ -207,7 +252,7 @@ func TestKustomizerApply(t *testing.T) {
 						Digest:  "fake-digest",
 					}, nil
 				},
-				setImageFn: func(string, string) error {
+				setImageFn: func(context.Context, string, ...string) error {
 					return nil
 				},
 			},
@@ -222,6 +267,317 @@ func TestKustomizerApply(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "success stripping registry host",
+			update: kargoapi.GitRepoUpdate{
+				Kustomize: &kargoapi.KustomizePromotionMechanism{
+					Images: []kargoapi.KustomizeImageUpdate{
+						{
+							Image:             "registry.example.com:5000/fake-org/fake-image",
+							Path:              "fake-path",
+							StripRegistryHost: true,
+						},
+					},
+				},
+			},
+			kustomizer: &kustomizer{
+				findImageFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+					*kargoapi.FreightOrigin,
+					[]kargoapi.FreightReference,
+					string,
+				) (*kargoapi.Image, error) {
+					return &kargoapi.Image{
+						RepoURL: "registry.example.com:5000/fake-org/fake-image",
+						Tag:     "fake-tag",
+					}, nil
+				},
+				setImageFn: func(_ context.Context, _ string, fqImageRefs ...string) error {
+					if len(fqImageRefs) != 1 || fqImageRefs[0] != "fake-org/fake-image:fake-tag" {
+						return fmt.Errorf("unexpected image references %q", fqImageRefs)
+					}
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, changes []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"updated fake-path/kustomization.yaml to use image fake-org/fake-image:fake-tag",
+					},
+					changes,
+				)
+			},
+		},
+		{
+			name: "success setting multiple images",
+			update: kargoapi.GitRepoUpdate{
+				Kustomize: &kargoapi.KustomizePromotionMechanism{
+					Images: []kargoapi.KustomizeImageUpdate{
+						{
+							Image: "fake-image",
+							Path:  "fake-path",
+						},
+						{
+							Image: "fake-other-image",
+							Path:  "fake-other-path",
+						},
+						{
+							Image:     "fake-third-image",
+							Path:      "fake-path",
+							UseDigest: true,
+						},
+					},
+				},
+			},
+			kustomizer: &kustomizer{
+				findImageFn: func(
+					_ context.Context,
+					_ client.Client,
+					_ *kargoapi.Stage,
+					_ *kargoapi.FreightOrigin,
+					_ []kargoapi.FreightReference,
+					repoURL string,
+				) (*kargoapi.Image, error) {
+					return &kargoapi.Image{
+						RepoURL: repoURL,
+						Tag:     "fake-tag",
+						Digest:  "fake-digest",
+					}, nil
+				},
+				setImageFn: func(_ context.Context, dir string, fqImageRefs ...string) error {
+					switch dir {
+					case "fake-path":
+						if len(fqImageRefs) != 2 ||
+							fqImageRefs[0] != "fake-image:fake-tag" ||
+							fqImageRefs[1] != "fake-third-image@fake-digest" {
+							return fmt.Errorf("unexpected image references %q", fqImageRefs)
+						}
+					case "fake-other-path":
+						if len(fqImageRefs) != 1 || fqImageRefs[0] != "fake-other-image:fake-tag" {
+							return fmt.Errorf("unexpected image references %q", fqImageRefs)
+						}
+					default:
+						return fmt.Errorf("unexpected directory %q", dir)
+					}
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, changes []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"updated fake-other-path/kustomization.yaml to use image fake-other-image:fake-tag",
+						"updated fake-path/kustomization.yaml to use image fake-image:fake-tag",
+						"updated fake-path/kustomization.yaml to use image fake-third-image@fake-digest",
+					},
+					changes,
+				)
+			},
+		},
+		{
+			name: "success setting multiple images in listed order",
+			update: kargoapi.GitRepoUpdate{
+				EditOrder: kargoapi.EditOrderListed,
+				Kustomize: &kargoapi.KustomizePromotionMechanism{
+					Images: []kargoapi.KustomizeImageUpdate{
+						{
+							Image:     "fake-third-image",
+							Path:      "fake-path",
+							UseDigest: true,
+						},
+						{
+							Image: "fake-other-image",
+							Path:  "fake-other-path",
+						},
+						{
+							Image: "fake-image",
+							Path:  "fake-path",
+						},
+					},
+				},
+			},
+			kustomizer: &kustomizer{
+				findImageFn: func(
+					_ context.Context,
+					_ client.Client,
+					_ *kargoapi.Stage,
+					_ *kargoapi.FreightOrigin,
+					_ []kargoapi.FreightReference,
+					repoURL string,
+				) (*kargoapi.Image, error) {
+					return &kargoapi.Image{
+						RepoURL: repoURL,
+						Tag:     "fake-tag",
+						Digest:  "fake-digest",
+					}, nil
+				},
+				setImageFn: func(_ context.Context, dir string, fqImageRefs ...string) error {
+					switch dir {
+					case "fake-path":
+						if len(fqImageRefs) != 2 ||
+							fqImageRefs[0] != "fake-third-image@fake-digest" ||
+							fqImageRefs[1] != "fake-image:fake-tag" {
+							return fmt.Errorf("unexpected image references %q", fqImageRefs)
+						}
+					case "fake-other-path":
+						if len(fqImageRefs) != 1 || fqImageRefs[0] != "fake-other-image:fake-tag" {
+							return fmt.Errorf("unexpected image references %q", fqImageRefs)
+						}
+					default:
+						return fmt.Errorf("unexpected directory %q", dir)
+					}
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, changes []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"updated fake-path/kustomization.yaml to use image fake-third-image@fake-digest",
+						"updated fake-path/kustomization.yaml to use image fake-image:fake-tag",
+						"updated fake-other-path/kustomization.yaml to use image fake-other-image:fake-tag",
+					},
+					changes,
+				)
+			},
+		},
+		{
+			name: "error updating images in component",
+			update: kargoapi.GitRepoUpdate{
+				Kustomize: &kargoapi.KustomizePromotionMechanism{
+					Images: []kargoapi.KustomizeImageUpdate{
+						{
+							Image: "fake-image",
+							Path:  "fake-path",
+						},
+					},
+					Components: []string{"fake-component"},
+				},
+			},
+			kustomizer: &kustomizer{
+				findImageFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+					*kargoapi.FreightOrigin,
+					[]kargoapi.FreightReference,
+					string,
+				) (*kargoapi.Image, error) {
+					return &kargoapi.Image{
+						RepoURL: "fake-image",
+						Tag:     "fake-tag",
+					}, nil
+				},
+				setImageFn: func(context.Context, string, ...string) error {
+					return nil
+				},
+				setComponentImagesFn: func(string, ...string) ([]string, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(
+					t, err, `error updating images in Kustomize component "fake-component"`,
+				)
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error updating replacement source",
+			update: kargoapi.GitRepoUpdate{
+				Kustomize: &kargoapi.KustomizePromotionMechanism{
+					Images: []kargoapi.KustomizeImageUpdate{
+						{
+							Image: "fake-image",
+							ReplacementSource: &kargoapi.KustomizeReplacementSource{
+								ConfigMap: "fake-config",
+								Key:       "IMAGE",
+							},
+						},
+					},
+				},
+			},
+			kustomizer: &kustomizer{
+				findImageFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+					*kargoapi.FreightOrigin,
+					[]kargoapi.FreightReference,
+					string,
+				) (*kargoapi.Image, error) {
+					return &kargoapi.Image{}, nil
+				},
+				setImageFn: func(context.Context, string, ...string) error {
+					return errors.New("should not be called")
+				},
+				setConfigMapLiteralFn: func(string, string, string, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "error updating replacement source for image")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "update replacement source",
+			update: kargoapi.GitRepoUpdate{
+				Kustomize: &kargoapi.KustomizePromotionMechanism{
+					Images: []kargoapi.KustomizeImageUpdate{
+						{
+							Image: "fake-image",
+							Path:  "fake-path",
+							ReplacementSource: &kargoapi.KustomizeReplacementSource{
+								ConfigMap: "fake-config",
+								Key:       "IMAGE",
+							},
+						},
+					},
+				},
+			},
+			kustomizer: &kustomizer{
+				findImageFn: func(
+					context.Context,
+					client.Client,
+					*kargoapi.Stage,
+					*kargoapi.FreightOrigin,
+					[]kargoapi.FreightReference,
+					string,
+				) (*kargoapi.Image, error) {
+					return &kargoapi.Image{
+						RepoURL: "fake-image",
+						Tag:     "fake-tag",
+					}, nil
+				},
+				setImageFn: func(context.Context, string, ...string) error {
+					return errors.New("should not be called")
+				},
+				setConfigMapLiteralFn: func(_, configMap, key, value string) error {
+					if configMap != "fake-config" || key != "IMAGE" || value != "fake-image:fake-tag" {
+						return fmt.Errorf("unexpected literal %s/%s=%s", configMap, key, value)
+					}
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, changes []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"updated fake-path/kustomization.yaml to set IMAGE in ConfigMap fake-config " +
+							"to image fake-image:fake-tag",
+					},
+					changes,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		stage := &kargoapi.Stage{
@@ -254,3 +610,274 @@ func TestKustomizerApply(t *testing.T) {
 		})
 	}
 }
+
+func TestKustomizerApplyEditOrder(t *testing.T) {
+	images := []kargoapi.KustomizeImageUpdate{
+		{
+			Image: "fake-image",
+			Path:  "overlays/prod",
+		},
+		{
+			Image: "fake-other-image",
+			Path:  "overlays/test",
+		},
+		{
+			Image: "fake-config-image",
+			Path:  "overlays/prod",
+			ReplacementSource: &kargoapi.KustomizeReplacementSource{
+				ConfigMap: "fake-config",
+				Key:       "IMAGE",
+			},
+		},
+		{
+			Image: "fake-third-image",
+			Path:  "overlays/prod",
+		},
+	}
+	// apply records every edit made by the kustomizer, in the order in which it
+	// was made, when the provided images are promoted.
+	apply := func(images []kargoapi.KustomizeImageUpdate) ([]string, []string) {
+		stage := &kargoapi.Stage{
+			Spec: kargoapi.StageSpec{
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{
+					GitRepoUpdates: []kargoapi.GitRepoUpdate{{
+						Kustomize: &kargoapi.KustomizePromotionMechanism{
+							Images: images,
+						},
+					}},
+				},
+			},
+		}
+		var edits []string
+		k := &kustomizer{
+			findImageFn: func(
+				_ context.Context,
+				_ client.Client,
+				_ *kargoapi.Stage,
+				_ *kargoapi.FreightOrigin,
+				_ []kargoapi.FreightReference,
+				repoURL string,
+			) (*kargoapi.Image, error) {
+				return &kargoapi.Image{
+					RepoURL: repoURL,
+					Tag:     "fake-tag",
+				}, nil
+			},
+			setImageFn: func(_ context.Context, dir string, fqImageRefs ...string) error {
+				edits = append(edits, fmt.Sprintf("%s: %s", dir, strings.Join(fqImageRefs, " ")))
+				return nil
+			},
+			setConfigMapLiteralFn: func(dir, configMap, key, value string) error {
+				edits = append(edits, fmt.Sprintf("%s: %s/%s=%s", dir, configMap, key, value))
+				return nil
+			},
+		}
+		changes, err := k.apply(
+			context.Background(),
+			stage,
+			&stage.Spec.PromotionMechanisms.GitRepoUpdates[0],
+			nil,
+			"",
+			"",
+			"",
+			git.RepoCredentials{},
+		)
+		require.NoError(t, err)
+		return edits, changes
+	}
+
+	expectedEdits := []string{
+		"overlays/prod: fake-config/IMAGE=fake-config-image:fake-tag",
+		"overlays/prod: fake-image:fake-tag fake-third-image:fake-tag",
+		"overlays/test: fake-other-image:fake-tag",
+	}
+	expectedChanges := []string{
+		"updated overlays/prod/kustomization.yaml to set IMAGE in ConfigMap " +
+			"fake-config to image fake-config-image:fake-tag",
+		"updated overlays/prod/kustomization.yaml to use image fake-image:fake-tag",
+		"updated overlays/prod/kustomization.yaml to use image fake-third-image:fake-tag",
+		"updated overlays/test/kustomization.yaml to use image fake-other-image:fake-tag",
+	}
+	// However the images happen to be listed, the same edits are made in the
+	// same order every time.
+	for i := 0; i < 10; i++ {
+		shuffled := slices.Clone(images)
+		rand.Shuffle(len(shuffled), func(i, j int) {
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		})
+		edits, changes := apply(shuffled)
+		require.Equal(t, expectedEdits, edits)
+		require.Equal(t, expectedChanges, changes)
+	}
+}
+
+func TestKustomizerApplyWithComponents(t *testing.T) {
+	workingDir := t.TempDir()
+	for path, content := range map[string]string{
+		"base/kustomization.yaml": `resources:
+- deployment.yaml
+`,
+		"components/monitoring/kustomization.yaml": `apiVersion: kustomize.config.k8s.io/v1alpha1
+kind: Component
+images:
+- name: fake-exporter
+  newTag: 1.0.0
+- name: fake-sidecar
+  newTag: 1.0.0
+`,
+		"components/logging/kustomization.yaml": `apiVersion: kustomize.config.k8s.io/v1alpha1
+kind: Component
+images:
+- name: fake-shipper
+  newTag: 1.0.0
+`,
+		"overlays/prod/kustomization.yaml": `resources:
+- ../../base
+components:
+- ../../components/monitoring
+- ../../components/logging
+`,
+	} {
+		path = filepath.Join(workingDir, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+
+	stage := &kargoapi.Stage{
+		Spec: kargoapi.StageSpec{
+			PromotionMechanisms: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates: []kargoapi.GitRepoUpdate{{
+					Kustomize: &kargoapi.KustomizePromotionMechanism{
+						Images: []kargoapi.KustomizeImageUpdate{
+							{
+								Image: "fake-image",
+								Path:  "overlays/prod",
+							},
+							{
+								Image:     "fake-exporter",
+								Path:      "overlays/prod",
+								UseDigest: true,
+							},
+						},
+						Components: []string{
+							"components/monitoring",
+							"components/logging",
+						},
+					},
+				}},
+			},
+		},
+	}
+	k := &kustomizer{
+		findImageFn: func(
+			_ context.Context,
+			_ client.Client,
+			_ *kargoapi.Stage,
+			_ *kargoapi.FreightOrigin,
+			_ []kargoapi.FreightReference,
+			repoURL string,
+		) (*kargoapi.Image, error) {
+			return &kargoapi.Image{
+				RepoURL: repoURL,
+				Tag:     "2.0.0",
+				Digest:  "sha256:abc",
+			}, nil
+		},
+		// Kustomize itself is not relied upon to update the overlay.
+		setImageFn: func(context.Context, string, ...string) error {
+			return nil
+		},
+		setComponentImagesFn: kustomize.SetComponentImages,
+	}
+
+	changes, err := k.apply(
+		context.Background(),
+		stage,
+		&stage.Spec.PromotionMechanisms.GitRepoUpdates[0],
+		nil,
+		"",
+		"",
+		workingDir,
+		git.RepoCredentials{},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]string{
+			"updated overlays/prod/kustomization.yaml to use image fake-exporter@sha256:abc",
+			"updated overlays/prod/kustomization.yaml to use image fake-image:2.0.0",
+			"updated components/monitoring/kustomization.yaml to use image fake-exporter@sha256:abc",
+		},
+		changes,
+	)
+
+	fileBytes, err := os.ReadFile(
+		filepath.Join(workingDir, "components", "monitoring", "kustomization.yaml"),
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`apiVersion: kustomize.config.k8s.io/v1alpha1
+kind: Component
+images:
+  - name: fake-exporter
+    digest: sha256:abc
+  - name: fake-sidecar
+    newTag: 1.0.0
+`,
+		string(fileBytes),
+	)
+
+	// Components that list none of the images are left untouched.
+	fileBytes, err = os.ReadFile(
+		filepath.Join(workingDir, "components", "logging", "kustomization.yaml"),
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`apiVersion: kustomize.config.k8s.io/v1alpha1
+kind: Component
+images:
+- name: fake-shipper
+  newTag: 1.0.0
+`,
+		string(fileBytes),
+	)
+}
+
+func TestStripRegistryHost(t *testing.T) {
+	testCases := []struct {
+		repoURL  string
+		expected string
+	}{
+		{
+			repoURL:  "nginx",
+			expected: "nginx",
+		},
+		{
+			repoURL:  "library/nginx",
+			expected: "library/nginx",
+		},
+		{
+			repoURL:  "docker.io/library/nginx",
+			expected: "library/nginx",
+		},
+		{
+			repoURL:  "ghcr.io/akuity/kargo",
+			expected: "akuity/kargo",
+		},
+		{
+			repoURL:  "registry:5000/fake-image",
+			expected: "fake-image",
+		},
+		{
+			repoURL:  "localhost/fake-image",
+			expected: "fake-image",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.repoURL, func(t *testing.T) {
+			require.Equal(t, testCase.expected, stripRegistryHost(testCase.repoURL))
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package promotion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libargocd "github.com/akuity/kargo/internal/argocd"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/image"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// PreflightChecker verifies that the external systems that the promotion
+// mechanisms of a Stage depend upon are reachable before any of those
+// mechanisms are executed. This prevents a Promotion from failing part way
+// through and leaving some, but not all, of its changes behind.
+type PreflightChecker struct {
+	argocd *argoCDMechanism
+	images *imagePullCheckMechanism
+	// These behaviors are overridable for testing purposes:
+	getGitCredentialsFn func(
+		ctx context.Context,
+		namespace string,
+		repoURL string,
+	) (*git.RepoCredentials, error)
+	checkGitRepoFn func(
+		ctx context.Context,
+		repoURL string,
+		clientOpts *git.ClientOptions,
+		insecureSkipTLSVerify bool,
+	) error
+	checkManifestFn func(
+		ctx context.Context,
+		repoURL string,
+		tagOrDigest string,
+		creds *image.Credentials,
+	) error
+}
+
+// NewPreflightChecker returns a PreflightChecker that uses the provided
+// clients and credentials database to reach the external systems it checks.
+func NewPreflightChecker(
+	argocdClient client.Client,
+	argocdRemoteClients libargocd.RemoteClients,
+	credentialsDB credentials.Database,
+) *PreflightChecker {
+	return &PreflightChecker{
+		argocd: &argoCDMechanism{
+			argocdClient:  argocdClient,
+			remoteClients: argocdRemoteClients,
+		},
+		images: &imagePullCheckMechanism{
+			credentialsDB: credentialsDB,
+		},
+		getGitCredentialsFn: getRepoCredentialsFn(credentialsDB),
+		checkGitRepoFn:      git.CheckRemote,
+		checkManifestFn:     image.CheckManifest,
+	}
+}
+
+// Check verifies that every Git repository updated by the provided
+// PromotionMechanisms can be reached, that the manifest of every image
+// referenced by the provided FreightCollection can be retrieved from its
+// registry, and that every Argo CD Application or ApplicationSet updated by
+// the provided PromotionMechanisms can be retrieved from the Argo CD instance
+// managing it. Credentials are looked up in the provided namespace. All checks
+// are performed and any failures are returned together.
+func (p *PreflightChecker) Check(
+	ctx context.Context,
+	namespace string,
+	freightCol kargoapi.FreightCollection,
+	mechs *kargoapi.PromotionMechanisms,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+	var errs []error
+
+	checkedRepos := map[string]struct{}{}
+	for _, update := range mechs.GitRepoUpdates {
+		if _, checked := checkedRepos[update.RepoURL]; checked {
+			continue
+		}
+		checkedRepos[update.RepoURL] = struct{}{}
+		creds, err := p.getGitCredentialsFn(ctx, namespace, update.RepoURL)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err = p.checkGitRepoFn(
+			ctx,
+			update.RepoURL,
+			&git.ClientOptions{Credentials: creds},
+			update.InsecureSkipTLSVerify,
+		); err != nil {
+			errs = append(errs, fmt.Errorf("Git repository %q is unreachable: %w", update.RepoURL, err))
+			continue
+		}
+		logger.Debug("Git repository is reachable", "repo", update.RepoURL)
+	}
+
+	for _, freight := range freightCol.References() {
+		for _, img := range freight.Images {
+			tagOrDigest := img.Digest
+			if tagOrDigest == "" {
+				tagOrDigest = img.Tag
+			}
+			creds, err := p.images.getCredentials(ctx, namespace, nil, img.RepoURL)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if err = p.checkManifestFn(ctx, img.RepoURL, tagOrDigest, creds); err != nil {
+				errs = append(errs, fmt.Errorf(
+					"image %s:%s is unreachable: %w", img.RepoURL, tagOrDigest, err,
+				))
+				continue
+			}
+			logger.Debug("image is reachable", "image", img.RepoURL, "ref", tagOrDigest)
+		}
+	}
+
+	for i := range mechs.ArgoCDAppUpdates {
+		if err := p.checkArgoCDApp(ctx, namespace, &mechs.ArgoCDAppUpdates[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkArgoCDApp verifies that the Argo CD Application or ApplicationSet
+// updated by the provided ArgoCDAppUpdate can be retrieved from the Argo CD
+// instance managing it.
+func (p *PreflightChecker) checkArgoCDApp(
+	ctx context.Context,
+	project string,
+	update *kargoapi.ArgoCDAppUpdate,
+) error {
+	argocdClient, err := p.argocd.getArgoCDClient(ctx, project, update)
+	if err != nil {
+		return err
+	}
+	if argocdClient == nil {
+		return errors.New("Argo CD integration is disabled on this controller")
+	}
+	namespace := update.AppNamespace
+	if namespace == "" {
+		namespace = libargocd.Namespace()
+	}
+	kind := "Application"
+	var found bool
+	if update.ResourceType == kargoapi.ArgoCDResourceTypeApplicationSet {
+		kind = "ApplicationSet"
+		var appSet *argocd.ApplicationSet
+		appSet, err = argocd.GetApplicationSet(ctx, argocdClient, namespace, update.AppName)
+		found = appSet != nil
+	} else {
+		var app *argocd.Application
+		app, err = argocd.GetApplication(ctx, argocdClient, namespace, update.AppName)
+		found = app != nil
+	}
+	if err != nil {
+		return fmt.Errorf(
+			"Argo CD is unavailable; error finding Argo CD %s %q in namespace %q: %w",
+			kind, update.AppName, namespace, err,
+		)
+	}
+	if !found {
+		return fmt.Errorf(
+			"unable to find Argo CD %s %q in namespace %q",
+			kind, update.AppName, namespace,
+		)
+	}
+	logging.LoggerFromContext(ctx).Debug(
+		"Argo CD resource is available",
+		"kind", kind,
+		"namespace", namespace,
+		"name", update.AppName,
+	)
+	return nil
+}
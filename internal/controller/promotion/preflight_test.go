@@ -0,0 +1,213 @@
+package promotion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libargocd "github.com/akuity/kargo/internal/argocd"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/image"
+)
+
+func TestNewPreflightChecker(t *testing.T) {
+	p := NewPreflightChecker(
+		fake.NewFakeClient(),
+		libargocd.NewRemoteClients(&credentials.FakeDB{}),
+		&credentials.FakeDB{},
+	)
+	require.NotNil(t, p.argocd)
+	require.NotNil(t, p.argocd.argocdClient)
+	require.NotNil(t, p.argocd.remoteClients)
+	require.NotNil(t, p.images)
+	require.NotNil(t, p.getGitCredentialsFn)
+	require.NotNil(t, p.checkGitRepoFn)
+	require.NotNil(t, p.checkManifestFn)
+}
+
+func TestPreflightCheckerCheck(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, argocd.AddToScheme(scheme))
+	argocdClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&argocd.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: libargocd.Namespace(),
+				Name:      "fake-app",
+			},
+		},
+	).Build()
+
+	testFreightCol := kargoapi.FreightCollection{}
+	testFreightCol.UpdateOrPush(kargoapi.FreightReference{
+		Name: "fake-freight",
+		Origin: kargoapi.FreightOrigin{
+			Kind: kargoapi.FreightOriginKindWarehouse,
+			Name: "fake-warehouse",
+		},
+		Images: []kargoapi.Image{{
+			RepoURL: "fake-registry/fake-image",
+			Tag:     "v1.0.0",
+			Digest:  "sha256:fake",
+		}},
+	})
+	testMechs := &kargoapi.PromotionMechanisms{
+		GitRepoUpdates: []kargoapi.GitRepoUpdate{
+			{RepoURL: "https://github.com/akuity/kargo-demo.git"},
+			// The same repository is only checked once
+			{RepoURL: "https://github.com/akuity/kargo-demo.git"},
+		},
+		ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{{AppName: "fake-app"}},
+	}
+
+	reachableRepo := func(context.Context, string, *git.ClientOptions, bool) error { return nil }
+	reachableImage := func(context.Context, string, string, *image.Credentials) error {
+		return nil
+	}
+
+	testCases := []struct {
+		name           string
+		argocdClient   client.Client
+		checkGitRepoFn func(context.Context, string, *git.ClientOptions, bool) error
+		checkManifest  func(context.Context, string, string, *image.Credentials) error
+		mechs          *kargoapi.PromotionMechanisms
+		assertions     func(*testing.T, []string, error)
+	}{
+		{
+			name:           "nothing to check",
+			argocdClient:   argocdClient,
+			checkGitRepoFn: reachableRepo,
+			checkManifest:  reachableImage,
+			mechs:          &kargoapi.PromotionMechanisms{},
+			assertions: func(t *testing.T, checkedRepos []string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, checkedRepos)
+			},
+		},
+		{
+			name:         "Git repository unreachable",
+			argocdClient: argocdClient,
+			checkGitRepoFn: func(context.Context, string, *git.ClientOptions, bool) error {
+				return errors.New("could not resolve host")
+			},
+			checkManifest: reachableImage,
+			mechs:         testMechs,
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "Git repository")
+				require.ErrorContains(t, err, "is unreachable")
+				require.ErrorContains(t, err, "could not resolve host")
+			},
+		},
+		{
+			name:           "image registry unreachable",
+			argocdClient:   argocdClient,
+			checkGitRepoFn: reachableRepo,
+			checkManifest: func(_ context.Context, _ string, tagOrDigest string, _ *image.Credentials) error {
+				// Images are checked by digest
+				require.Equal(t, "sha256:fake", tagOrDigest)
+				return errors.New("connection refused")
+			},
+			mechs: testMechs,
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "image fake-registry/fake-image:sha256:fake is unreachable")
+				require.ErrorContains(t, err, "connection refused")
+			},
+		},
+		{
+			name:           "Argo CD integration disabled",
+			checkGitRepoFn: reachableRepo,
+			checkManifest:  reachableImage,
+			mechs:          testMechs,
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "Argo CD integration is disabled")
+			},
+		},
+		{
+			name:           "Argo CD Application not found",
+			argocdClient:   argocdClient,
+			checkGitRepoFn: reachableRepo,
+			checkManifest:  reachableImage,
+			mechs: &kargoapi.PromotionMechanisms{
+				ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{{AppName: "missing-app"}},
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, `unable to find Argo CD Application "missing-app"`)
+			},
+		},
+		{
+			name:         "all failures are reported",
+			argocdClient: argocdClient,
+			checkGitRepoFn: func(context.Context, string, *git.ClientOptions, bool) error {
+				return errors.New("could not resolve host")
+			},
+			checkManifest: func(context.Context, string, string, *image.Credentials) error {
+				return errors.New("connection refused")
+			},
+			mechs: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates:   testMechs.GitRepoUpdates,
+				ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{{AppName: "missing-app"}},
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "could not resolve host")
+				require.ErrorContains(t, err, "connection refused")
+				require.ErrorContains(t, err, "missing-app")
+			},
+		},
+		{
+			name:           "success",
+			argocdClient:   argocdClient,
+			checkGitRepoFn: reachableRepo,
+			checkManifest:  reachableImage,
+			mechs:          testMechs,
+			assertions: func(t *testing.T, checkedRepos []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{"https://github.com/akuity/kargo-demo.git"},
+					checkedRepos,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var checkedRepos []string
+			p := &PreflightChecker{
+				argocd: &argoCDMechanism{argocdClient: testCase.argocdClient},
+				images: &imagePullCheckMechanism{},
+				getGitCredentialsFn: func(
+					context.Context,
+					string,
+					string,
+				) (*git.RepoCredentials, error) {
+					return nil, nil
+				},
+				checkGitRepoFn: func(
+					ctx context.Context,
+					repoURL string,
+					clientOpts *git.ClientOptions,
+					insecureSkipTLSVerify bool,
+				) error {
+					checkedRepos = append(checkedRepos, repoURL)
+					return testCase.checkGitRepoFn(ctx, repoURL, clientOpts, insecureSkipTLSVerify)
+				},
+				checkManifestFn: testCase.checkManifest,
+			}
+			err := p.Check(
+				context.Background(),
+				"fake-namespace",
+				testFreightCol,
+				testCase.mechs,
+			)
+			testCase.assertions(t, checkedRepos, err)
+		})
+	}
+}
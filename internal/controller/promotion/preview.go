@@ -0,0 +1,191 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// PromotionPreview describes the changes a Promotion would make to the Git
+// repositories updated by the promotion mechanisms of a Stage.
+type PromotionPreview struct {
+	// Files maps each file that would be changed to a unified diff of the
+	// change. Files are identified by the URL of the repository they belong to
+	// and their path within it, separated by a slash.
+	Files map[string]string `json:"files"`
+}
+
+// previewer is implemented by Mechanisms that are able to report the changes
+// they would make without making them.
+type previewer interface {
+	// preview returns a map of files that would be changed by promoting the
+	// provided Stage to the provided FreightReferences to unified diffs of the
+	// changes.
+	preview(
+		context.Context,
+		*kargoapi.Stage,
+		[]kargoapi.FreightReference,
+	) (map[string]string, error)
+}
+
+// PreviewPromotion returns a PromotionPreview of the changes the provided
+// Mechanism would make to Git repositories when promoting the provided Stage
+// to the provided FreightReferences. Repositories are cloned and updated in
+// scratch space, but nothing is committed or pushed. Mechanisms that do not
+// update Git repositories, such as the Argo CD promotion mechanism, are not
+// executed at all.
+func PreviewPromotion(
+	ctx context.Context,
+	mech Mechanism,
+	stage *kargoapi.Stage,
+	freight []kargoapi.FreightReference,
+) (*PromotionPreview, error) {
+	preview := &PromotionPreview{Files: map[string]string{}}
+	p, ok := mech.(previewer)
+	if !ok {
+		return preview, nil
+	}
+	files, err := p.preview(ctx, stage, freight)
+	if err != nil {
+		return nil, err
+	}
+	if files != nil {
+		preview.Files = files
+	}
+	return preview, nil
+}
+
+// preview implements the previewer interface by merging the previews of all
+// child Mechanisms that implement it.
+func (c *compositeMechanism) preview(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight []kargoapi.FreightReference,
+) (map[string]string, error) {
+	files := map[string]string{}
+	if stage.Spec.PromotionMechanisms == nil {
+		return files, nil
+	}
+	for _, childMechanism := range c.childMechanisms {
+		p, ok := childMechanism.(previewer)
+		if !ok {
+			continue
+		}
+		childFiles, err := p.preview(ctx, stage, freight)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error previewing %s: %w",
+				childMechanism.GetName(),
+				err,
+			)
+		}
+		for path, diff := range childFiles {
+			files[path] = diff
+		}
+	}
+	return files, nil
+}
+
+// preview implements the previewer interface.
+func (g *gitMechanism) preview(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight []kargoapi.FreightReference,
+) (map[string]string, error) {
+	files := map[string]string{}
+	if stage.Spec.PromotionMechanisms == nil {
+		return files, nil
+	}
+
+	logger := logging.LoggerFromContext(ctx).WithValues("name", g.name)
+	logger.Debug("previewing promotion mechanism")
+
+	for _, update := range g.selectUpdatesFn(stage.Spec.PromotionMechanisms.GitRepoUpdates) {
+		if ctx.Err() != nil {
+			return nil, context.Cause(ctx)
+		}
+		diffs, err := g.previewSingleUpdate(ctx, stage, update, freight)
+		if err != nil {
+			return nil, err
+		}
+		for path, diff := range diffs {
+			files[strings.TrimSuffix(update.RepoURL, "/")+"/"+path] = diff
+		}
+	}
+
+	logger.Debug("done previewing promotion mechanism")
+
+	return files, nil
+}
+
+// previewSingleUpdate clones the repository referenced by the provided
+// GitRepoUpdate, applies the update to the working tree, and returns unified
+// diffs of the resulting changes relative to the head of the branch the update
+// would be committed to, keyed by the paths of the changed files. Unlike
+// doSingleUpdate, it never commits, pushes, or opens a pull request.
+func (g *gitMechanism) previewSingleUpdate(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	update *kargoapi.GitRepoUpdate,
+	freight []kargoapi.FreightReference,
+) (map[string]string, error) {
+	readRef, _, err := g.getReadRefFn(ctx, g.client, stage, update, freight)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := g.getCredentialsFn(ctx, stage.Namespace, update.RepoURL)
+	if err != nil {
+		return nil, err
+	}
+	if creds == nil {
+		creds = &git.RepoCredentials{}
+	}
+	repo, err := g.cloneRepo(ctx, update, &git.ClientOptions{Credentials: creds})
+	if err != nil {
+		return nil, fmt.Errorf("error cloning git repo %q: %w", update.RepoURL, err)
+	}
+	defer repo.Close()
+
+	if readRef != "" {
+		if err = repo.Checkout(readRef); err != nil {
+			return nil, fmt.Errorf("error checking out %q from git repo: %w", readRef, err)
+		}
+	}
+	if g.applyConfigManagementFn != nil {
+		var sourceCommitID string
+		if sourceCommitID, err = repo.LastCommitID(); err != nil {
+			return nil, fmt.Errorf(
+				"error getting last commit ID from git repo %q: %w",
+				update.RepoURL,
+				err,
+			)
+		}
+		if _, err = g.applyConfigManagementFn(
+			ctx,
+			stage,
+			update,
+			freight,
+			sourceCommitID,
+			repo.HomeDir(),
+			repo.WorkingDir(),
+			*creds,
+		); err != nil {
+			return nil, err
+		}
+	}
+	if readRef != update.WriteBranch {
+		if err = moveChangesToBranch(repo, update.RepoURL, update.WriteBranch); err != nil {
+			return nil, err
+		}
+	}
+
+	diffs, err := repo.Diff()
+	if err != nil {
+		return nil, fmt.Errorf("error diffing changes to git repo %q: %w", update.RepoURL, err)
+	}
+	return diffs, nil
+}
@@ -0,0 +1,193 @@
+package promotion
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	authzv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/httpapi"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// PreviewHandlerPath is the path at which the handler returned by
+// NewPreviewHandler is expected to be served.
+const PreviewHandlerPath = "/preview"
+
+// NewPreviewHandler returns an http.Handler that responds to GET requests with
+// a PromotionPreview of the changes the provided Mechanism would make when
+// promoting a Stage to a piece of Freight. The Stage is identified by the
+// namespace and stage query parameters and the Freight by the freight query
+// parameter. Requests are authorized using the provided httpapi.Authorizer and
+// the user they are made on behalf of must be permitted to promote to the
+// Stage.
+func NewPreviewHandler(
+	c client.Client,
+	mech Mechanism,
+	authorizer httpapi.Authorizer,
+) http.Handler {
+	return &previewHandler{
+		client:     c,
+		mech:       mech,
+		authorizer: authorizer,
+	}
+}
+
+type previewHandler struct {
+	client     client.Client
+	mech       Mechanism
+	authorizer httpapi.Authorizer
+}
+
+func (p *previewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	params := map[string]string{}
+	for _, key := range []string{"namespace", "stage", "freight"} {
+		if params[key] = query.Get(key); params[key] == "" {
+			http.Error(
+				w,
+				fmt.Sprintf("query parameter %q is required", key),
+				http.StatusBadRequest,
+			)
+			return
+		}
+	}
+
+	if !p.authorizer.Authorize(
+		w,
+		r,
+		authzv1.ResourceAttributes{
+			Group:     kargoapi.GroupVersion.Group,
+			Resource:  "stages",
+			Name:      params["stage"],
+			Verb:      "promote",
+			Namespace: params["namespace"],
+		},
+	) {
+		return
+	}
+
+	ctx := r.Context()
+	stage, err := kargoapi.GetStage(
+		ctx,
+		p.client,
+		types.NamespacedName{
+			Namespace: params["namespace"],
+			Name:      params["stage"],
+		},
+	)
+	if err != nil {
+		logging.LoggerFromContext(ctx).Error(err, "error getting Stage")
+		http.Error(w, "error getting Stage", http.StatusInternalServerError)
+		return
+	}
+	if stage == nil {
+		http.Error(
+			w,
+			fmt.Sprintf(
+				"Stage %q not found in namespace %q",
+				params["stage"], params["namespace"],
+			),
+			http.StatusNotFound,
+		)
+		return
+	}
+
+	// The Stage's PromotionMechanisms may be described, in part or in full, by
+	// a PromotionTemplate.
+	if stage.Spec.PromotionMechanisms, err =
+		kargoapi.ResolvePromotionMechanisms(ctx, p.client, stage); err != nil {
+		logging.LoggerFromContext(ctx).Error(err, "error resolving PromotionMechanisms")
+		http.Error(
+			w,
+			fmt.Sprintf("error resolving PromotionMechanisms: %s", err),
+			http.StatusInternalServerError,
+		)
+		return
+	}
+
+	freight, err := kargoapi.GetFreight(
+		ctx,
+		p.client,
+		types.NamespacedName{
+			Namespace: params["namespace"],
+			Name:      params["freight"],
+		},
+	)
+	if err != nil {
+		logging.LoggerFromContext(ctx).Error(err, "error getting Freight")
+		http.Error(w, "error getting Freight", http.StatusInternalServerError)
+		return
+	}
+	if freight == nil {
+		http.Error(
+			w,
+			fmt.Sprintf(
+				"Freight %q not found in namespace %q",
+				params["freight"], params["namespace"],
+			),
+			http.StatusNotFound,
+		)
+		return
+	}
+
+	preview, err := PreviewPromotion(
+		ctx,
+		p.mech,
+		stage,
+		buildPreviewFreight(stage, freight),
+	)
+	if err != nil {
+		logging.LoggerFromContext(ctx).Error(err, "error previewing Promotion")
+		http.Error(
+			w,
+			fmt.Sprintf("error previewing Promotion: %s", err),
+			http.StatusInternalServerError,
+		)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(w).Encode(preview); err != nil {
+		logging.LoggerFromContext(ctx).Error(err, "error encoding Promotion preview")
+	}
+}
+
+// buildPreviewFreight returns the FreightReferences a Promotion of the
+// provided Freight to the provided Stage would promote. These are the
+// provided Freight itself plus, for a Stage that requests Freight from more
+// than one origin, the Freight from the Stage's other origins that is
+// currently in use.
+func buildPreviewFreight(
+	stage *kargoapi.Stage,
+	freight *kargoapi.Freight,
+) []kargoapi.FreightReference {
+	freightCol := &kargoapi.FreightCollection{}
+	if len(stage.Spec.RequestedFreight) > 1 {
+		if current := stage.Status.FreightHistory.Current(); current != nil {
+			for _, req := range stage.Spec.RequestedFreight {
+				if ref, ok := current.Freight[req.Origin.String()]; ok {
+					freightCol.UpdateOrPush(ref)
+				}
+			}
+		}
+	}
+	freightCol.UpdateOrPush(kargoapi.FreightReference{
+		Name:    freight.Name,
+		Commits: freight.Commits,
+		Images:  freight.Images,
+		Charts:  freight.Charts,
+		Origin:  freight.Origin,
+	})
+	return freightCol.References()
+}
@@ -0,0 +1,230 @@
+package promotion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	authzv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/httpapi"
+)
+
+type fakePreviewMechanism struct {
+	FakeMechanism
+	previewFn func(
+		context.Context,
+		*kargoapi.Stage,
+		[]kargoapi.FreightReference,
+	) (map[string]string, error)
+}
+
+func (f *fakePreviewMechanism) preview(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight []kargoapi.FreightReference,
+) (map[string]string, error) {
+	return f.previewFn(ctx, stage, freight)
+}
+
+func TestPreviewHandler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+		Spec: kargoapi.StageSpec{
+			PromotionTemplateRef: &corev1.LocalObjectReference{Name: "fake-template"},
+		},
+	}
+	testTemplate := &kargoapi.PromotionTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-template",
+		},
+		Spec: kargoapi.PromotionTemplateSpec{
+			PromotionMechanisms: kargoapi.PromotionMechanisms{
+				GitRepoUpdates: []kargoapi.GitRepoUpdate{{
+					RepoURL: "https://example.com/repo.git",
+				}},
+			},
+		},
+	}
+	testStageWithoutTemplate := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "stage-without-template",
+		},
+		Spec: kargoapi.StageSpec{
+			PromotionTemplateRef: &corev1.LocalObjectReference{Name: "missing-template"},
+		},
+	}
+	testFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-freight",
+		},
+		Origin: kargoapi.FreightOrigin{
+			Kind: kargoapi.FreightOriginKindWarehouse,
+			Name: "fake-warehouse",
+		},
+		Images: []kargoapi.Image{{RepoURL: "example/image", Tag: "v1.0.0"}},
+	}
+
+	testCases := []struct {
+		name         string
+		method       string
+		query        string
+		unauthorized bool
+		previewErr   error
+		assertions   func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "method not allowed",
+			method: http.MethodPost,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+			},
+		},
+		{
+			name:   "missing query parameter",
+			method: http.MethodGet,
+			query:  "namespace=fake-namespace&stage=fake-stage",
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, rr.Code)
+				require.Contains(t, rr.Body.String(), `"freight" is required`)
+			},
+		},
+		{
+			name:         "request not authorized",
+			method:       http.MethodGet,
+			query:        "namespace=fake-namespace&stage=fake-stage&freight=fake-freight",
+			unauthorized: true,
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, rr.Code)
+			},
+		},
+		{
+			name:   "Stage not found",
+			method: http.MethodGet,
+			query:  "namespace=fake-namespace&stage=other-stage&freight=fake-freight",
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, rr.Code)
+				require.Contains(t, rr.Body.String(), `Stage "other-stage" not found`)
+			},
+		},
+		{
+			name:   "error resolving PromotionMechanisms",
+			method: http.MethodGet,
+			query:  "namespace=fake-namespace&stage=stage-without-template&freight=fake-freight",
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, rr.Code)
+				require.Contains(t, rr.Body.String(), `PromotionTemplate "missing-template"`)
+			},
+		},
+		{
+			name:   "Freight not found",
+			method: http.MethodGet,
+			query:  "namespace=fake-namespace&stage=fake-stage&freight=other-freight",
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, rr.Code)
+				require.Contains(t, rr.Body.String(), `Freight "other-freight" not found`)
+			},
+		},
+		{
+			name:       "error previewing Promotion",
+			method:     http.MethodGet,
+			query:      "namespace=fake-namespace&stage=fake-stage&freight=fake-freight",
+			previewErr: errors.New("something went wrong"),
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, rr.Code)
+				require.Contains(t, rr.Body.String(), "something went wrong")
+			},
+		},
+		{
+			name:   "success",
+			method: http.MethodGet,
+			query:  "namespace=fake-namespace&stage=fake-stage&freight=fake-freight",
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, rr.Code)
+				require.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+				var preview PromotionPreview
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &preview))
+				require.Equal(
+					t,
+					map[string]string{"https://example.com/repo.git/file.txt": "fake-diff"},
+					preview.Files,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			handler := NewPreviewHandler(
+				fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testStage, testStageWithoutTemplate, testTemplate, testFreight).
+					Build(),
+				&fakePreviewMechanism{
+					previewFn: func(
+						_ context.Context,
+						stage *kargoapi.Stage,
+						freight []kargoapi.FreightReference,
+					) (map[string]string, error) {
+						require.Equal(t, testStage.Name, stage.Name)
+						// The Stage's PromotionMechanisms are resolved from its
+						// PromotionTemplate
+						require.NotNil(t, stage.Spec.PromotionMechanisms)
+						require.Equal(
+							t,
+							testTemplate.Spec.PromotionMechanisms.GitRepoUpdates,
+							stage.Spec.PromotionMechanisms.GitRepoUpdates,
+						)
+						require.Len(t, freight, 1)
+						require.Equal(t, testFreight.Name, freight[0].Name)
+						require.Equal(t, testFreight.Images, freight[0].Images)
+						if testCase.previewErr != nil {
+							return nil, testCase.previewErr
+						}
+						return map[string]string{
+							"https://example.com/repo.git/file.txt": "fake-diff",
+						}, nil
+					},
+				},
+				&httpapi.FakeAuthorizer{
+					AuthorizeFn: func(
+						w http.ResponseWriter,
+						r *http.Request,
+						attrs authzv1.ResourceAttributes,
+					) bool {
+						require.Equal(t, "stages", attrs.Resource)
+						require.Equal(t, "promote", attrs.Verb)
+						require.Equal(t, r.URL.Query().Get("namespace"), attrs.Namespace)
+						require.Equal(t, r.URL.Query().Get("stage"), attrs.Name)
+						if testCase.unauthorized {
+							http.Error(w, "forbidden", http.StatusForbidden)
+							return false
+						}
+						return true
+					},
+				},
+			)
+			req := httptest.NewRequest(testCase.method, PreviewHandlerPath+"?"+testCase.query, nil)
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+			testCase.assertions(t, rr)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package promotion
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/credentials"
+)
+
+func TestPreviewPromotion(t *testing.T) {
+	testCases := []struct {
+		name         string
+		writeBranch  string
+		applyChanges func(workingDir string) error
+		assertions   func(*testing.T, *PromotionPreview, error)
+	}{
+		{
+			name:        "no changes",
+			writeBranch: "main",
+			assertions: func(t *testing.T, preview *PromotionPreview, err error) {
+				require.NoError(t, err)
+				require.Empty(t, preview.Files)
+			},
+		},
+		{
+			name:        "error applying changes",
+			writeBranch: "main",
+			applyChanges: func(string) error {
+				return errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, _ *PromotionPreview, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name:        "files changed, added, and removed",
+			writeBranch: "main",
+			applyChanges: func(workingDir string) error {
+				if err := os.WriteFile(
+					filepath.Join(workingDir, "file.txt"),
+					[]byte("updated\n"),
+					0600,
+				); err != nil {
+					return err
+				}
+				if err := os.MkdirAll(filepath.Join(workingDir, "env"), 0700); err != nil {
+					return err
+				}
+				return os.WriteFile(
+					filepath.Join(workingDir, "env", "values.yaml"),
+					[]byte("image: v1.0.0\n"),
+					0600,
+				)
+			},
+			assertions: func(t *testing.T, preview *PromotionPreview, err error) {
+				require.NoError(t, err)
+				require.Len(t, preview.Files, 2)
+				var changed, added string
+				for path, diff := range preview.Files {
+					switch filepath.Base(path) {
+					case "file.txt":
+						changed = diff
+					case "values.yaml":
+						require.Equal(t, "env", filepath.Base(filepath.Dir(path)))
+						added = diff
+					}
+				}
+				require.Contains(t, changed, "-initial\n+updated\n")
+				require.Contains(t, added, "new file")
+				require.Contains(t, added, "+image: v1.0.0\n")
+			},
+		},
+		{
+			name:        "changes written to a branch that does not exist yet",
+			writeBranch: "env/qa",
+			assertions: func(t *testing.T, preview *PromotionPreview, err error) {
+				require.NoError(t, err)
+				require.Len(t, preview.Files, 1)
+				for path, diff := range preview.Files {
+					require.Equal(t, "file.txt", filepath.Base(path))
+					require.Contains(t, diff, "new file")
+					require.Contains(t, diff, "+initial\n")
+				}
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			remoteDir := newTestRemote(t)
+			initialCommitID := gitRevParse(t, remoteDir, "main")
+
+			mech := newGitMechanism(
+				"fake",
+				nil,
+				&credentials.FakeDB{},
+				func(updates []kargoapi.GitRepoUpdate) []*kargoapi.GitRepoUpdate {
+					selected := make([]*kargoapi.GitRepoUpdate, len(updates))
+					for i := range updates {
+						selected[i] = &updates[i]
+					}
+					return selected
+				},
+				func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					_ *kargoapi.GitRepoUpdate,
+					_ []kargoapi.FreightReference,
+					_ string,
+					_ string,
+					workingDir string,
+					_ git.RepoCredentials,
+				) ([]string, error) {
+					if testCase.applyChanges == nil {
+						return nil, nil
+					}
+					return []string{"fake-change"}, testCase.applyChanges(workingDir)
+				},
+			)
+			gm, ok := mech.(*gitMechanism)
+			require.True(t, ok)
+			gm.getReadRefFn = func(
+				context.Context,
+				client.Client,
+				*kargoapi.Stage,
+				*kargoapi.GitRepoUpdate,
+				[]kargoapi.FreightReference,
+			) (string, *kargoapi.GitCommit, error) {
+				return "main", nil, nil
+			}
+
+			preview, err := PreviewPromotion(
+				context.Background(),
+				newCompositeMechanism("fake composite", mech),
+				&kargoapi.Stage{
+					Spec: kargoapi.StageSpec{
+						PromotionMechanisms: &kargoapi.PromotionMechanisms{
+							GitRepoUpdates: []kargoapi.GitRepoUpdate{{
+								RepoURL:     remoteDir,
+								ReadBranch:  "main",
+								WriteBranch: testCase.writeBranch,
+							}},
+						},
+					},
+				},
+				nil,
+			)
+			testCase.assertions(t, preview, err)
+
+			// Nothing should ever be pushed to the remote repository.
+			require.Equal(t, initialCommitID, gitRevParse(t, remoteDir, "main"))
+		})
+	}
+}
+
+func TestPreviewPromotionWithoutPreviewer(t *testing.T) {
+	preview, err := PreviewPromotion(
+		context.Background(),
+		&FakeMechanism{Name: "fake promotion mechanism"},
+		&kargoapi.Stage{},
+		nil,
+	)
+	require.NoError(t, err)
+	require.Empty(t, preview.Files)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/akuity/kargo/internal/gitprovider"
 	"github.com/akuity/kargo/internal/gitprovider/github"
 	"github.com/akuity/kargo/internal/gitprovider/gitlab"
+	"github.com/akuity/kargo/internal/logging"
 )
 
 func pullRequestBranchName(project, stage string) string {
@@ -38,7 +39,7 @@ func preparePullRequestBranch(repo git.Repo, prBranch string, base string) error
 		); err != nil {
 			return err
 		}
-		if err = repo.Push(false); err != nil {
+		if err = repo.Push(nil); err != nil {
 			return err
 		}
 	} else if err = repo.Checkout(base); err != nil {
@@ -53,7 +54,7 @@ func preparePullRequestBranch(repo git.Repo, prBranch string, base string) error
 		if err := repo.CreateChildBranch(prBranch); err != nil {
 			return err
 		}
-		if err := repo.Push(false); err != nil {
+		if err := repo.Push(nil); err != nil {
 			return err
 		}
 	} else {
@@ -77,7 +78,7 @@ func preparePullRequestBranch(repo git.Repo, prBranch string, base string) error
 			if err = repo.CreateChildBranch(prBranch); err != nil {
 				return err
 			}
-			if err = repo.Push(true); err != nil {
+			if err = repo.Push(&git.PushOptions{Force: true}); err != nil {
 				return err
 			}
 		}
@@ -186,11 +187,69 @@ func reconcilePullRequest(
 	return mergeCommitSHA, newStatus, nil
 }
 
+// deleteMergedPullRequestBranch deletes the provided pull request branch from
+// the remote repository after the pull request opened from it has been merged,
+// then returns a PromotionStatus that records the deletion. The branch is left
+// in place if any open pull request still uses it. A branch that no longer
+// exists is treated as having been deleted.
+func deleteMergedPullRequestBranch(
+	ctx context.Context,
+	status *kargoapi.PromotionStatus,
+	repo git.Repo,
+	gpClient gitprovider.GitProviderService,
+	prBranch string,
+) (*kargoapi.PromotionStatus, error) {
+	if status.Metadata[pullRequestBranchDeletedMetadataKey(repo.URL())] != "" {
+		return status, nil
+	}
+	logger := logging.LoggerFromContext(ctx).WithValues("branch", prBranch)
+
+	openPRs, err := gpClient.ListPullRequests(ctx, gitprovider.ListPullRequestOpts{
+		State: gitprovider.PullRequestStateOpen,
+		Head:  prBranch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error listing open pull requests for branch %q: %w",
+			prBranch,
+			err,
+		)
+	}
+	if len(openPRs) > 0 {
+		logger.Debug("not deleting pull request branch used by open pull requests")
+		return status, nil
+	}
+
+	exists, err := repo.RemoteBranchExists(prBranch)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		if err = repo.DeleteRemoteBranch(prBranch); err != nil {
+			return nil, err
+		}
+		logger.Debug("deleted merged pull request branch")
+	}
+
+	newStatus := status.DeepCopy()
+	if newStatus.Metadata == nil {
+		newStatus.Metadata = make(map[string]string, 1)
+	}
+	newStatus.Metadata[pullRequestBranchDeletedMetadataKey(repo.URL())] = prBranch
+	return newStatus, nil
+}
+
 // pullRequestMetadataKey returns the key used to store the pull request number in the metadata map.
 func pullRequestMetadataKey(repoURL string) string {
 	return fmt.Sprintf("pr:%s", repoURL)
 }
 
+// pullRequestBranchDeletedMetadataKey returns the key used to record the
+// deletion of a merged pull request branch in the metadata map.
+func pullRequestBranchDeletedMetadataKey(repoURL string) string {
+	return fmt.Sprintf("pr-branch-deleted:%s", repoURL)
+}
+
 // setPullRequestMetadata sets pull request bookkeeping information to the metadata map.
 func setPullRequestMetadata(metadata map[string]string, repoURL string, number int64, url string) map[string]string {
 	if metadata == nil {
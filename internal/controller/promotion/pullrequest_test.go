@@ -0,0 +1,158 @@
+package promotion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
+	"github.com/akuity/kargo/internal/gitprovider"
+)
+
+// fakeGitProvider is an implementation of gitprovider.GitProviderService whose
+// behavior can be overridden for testing purposes.
+type fakeGitProvider struct {
+	gitprovider.GitProviderService
+	listPullRequestsFn func(
+		context.Context,
+		gitprovider.ListPullRequestOpts,
+	) ([]*gitprovider.PullRequest, error)
+}
+
+func (f *fakeGitProvider) ListPullRequests(
+	ctx context.Context,
+	opts gitprovider.ListPullRequestOpts,
+) ([]*gitprovider.PullRequest, error) {
+	return f.listPullRequestsFn(ctx, opts)
+}
+
+func TestDeleteMergedPullRequestBranch(t *testing.T) {
+	const testBranch = "kargo/fake-project/fake-stage/promotion"
+	testCases := []struct {
+		name             string
+		deletionRecorded bool
+		gpClient         *fakeGitProvider
+		assertions       func(*testing.T, git.Repo, *kargoapi.PromotionStatus, error)
+	}{
+		{
+			name:             "deletion already recorded",
+			deletionRecorded: true,
+			gpClient: &fakeGitProvider{
+				listPullRequestsFn: func(
+					context.Context,
+					gitprovider.ListPullRequestOpts,
+				) ([]*gitprovider.PullRequest, error) {
+					return nil, errors.New("should not be called")
+				},
+			},
+			assertions: func(t *testing.T, repo git.Repo, _ *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				exists, err := repo.RemoteBranchExists(testBranch)
+				require.NoError(t, err)
+				require.True(t, exists)
+			},
+		},
+		{
+			name: "error listing open pull requests",
+			gpClient: &fakeGitProvider{
+				listPullRequestsFn: func(
+					context.Context,
+					gitprovider.ListPullRequestOpts,
+				) ([]*gitprovider.PullRequest, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ git.Repo, _ *kargoapi.PromotionStatus, err error) {
+				require.ErrorContains(t, err, "error listing open pull requests")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "branch has an open pull request",
+			gpClient: &fakeGitProvider{
+				listPullRequestsFn: func(
+					_ context.Context,
+					opts gitprovider.ListPullRequestOpts,
+				) ([]*gitprovider.PullRequest, error) {
+					if opts.State != gitprovider.PullRequestStateOpen || opts.Head != testBranch {
+						return nil, errors.New("unexpected options")
+					}
+					return []*gitprovider.PullRequest{
+						{
+							Number: 42,
+							State:  gitprovider.PullRequestStateOpen,
+						},
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, repo git.Repo, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Empty(t, status.Metadata)
+				exists, err := repo.RemoteBranchExists(testBranch)
+				require.NoError(t, err)
+				require.True(t, exists)
+			},
+		},
+		{
+			name: "branch is deleted after merge",
+			gpClient: &fakeGitProvider{
+				listPullRequestsFn: func(
+					context.Context,
+					gitprovider.ListPullRequestOpts,
+				) ([]*gitprovider.PullRequest, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(t *testing.T, repo git.Repo, status *kargoapi.PromotionStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					testBranch,
+					status.Metadata[pullRequestBranchDeletedMetadataKey(repo.URL())],
+				)
+				exists, err := repo.RemoteBranchExists(testBranch)
+				require.NoError(t, err)
+				require.False(t, exists)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			repo, err := git.Clone(
+				newTestRemote(t),
+				&git.ClientOptions{
+					User: &git.User{
+						Name:  "Kargo Test",
+						Email: "kargo-test@akuity.io",
+					},
+				},
+				&git.CloneOptions{Branch: "main"},
+			)
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				_ = repo.Close()
+			})
+			require.NoError(t, repo.CreateChildBranch(testBranch))
+			require.NoError(t, repo.Push(nil))
+
+			status := &kargoapi.PromotionStatus{}
+			if testCase.deletionRecorded {
+				status.Metadata = map[string]string{
+					pullRequestBranchDeletedMetadataKey(repo.URL()): testBranch,
+				}
+			}
+
+			status, err = deleteMergedPullRequestBranch(
+				context.Background(),
+				status,
+				repo,
+				testCase.gpClient,
+				testBranch,
+			)
+			testCase.assertions(t, repo, status, err)
+		})
+	}
+}
@@ -112,6 +112,9 @@ func (r *renderer) apply(
 			}
 			if image != nil {
 				if imageUpdate.UseDigest {
+					if image.Digest == "" {
+						return nil, errImageWithoutDigest(image)
+					}
 					images[fmt.Sprintf("%s@%s", imageUpdate.Image, image.Digest)] = struct{}{}
 				} else {
 					images[fmt.Sprintf("%s:%s", imageUpdate.Image, image.Tag)] = struct{}{}
@@ -2,10 +2,14 @@ package promotion
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libargocd "github.com/akuity/kargo/internal/argocd"
 	"github.com/akuity/kargo/internal/credentials"
 )
 
@@ -30,17 +34,59 @@ type Mechanism interface {
 func NewMechanisms(
 	kargoClient client.Client,
 	argocdClient client.Client,
+	argocdRemoteClients libargocd.RemoteClients,
 	credentialsDB credentials.Database,
 ) Mechanism {
 	return newCompositeMechanism(
 		"promotion mechanisms",
-		newCompositeMechanism(
-			"Git-based promotion mechanisms",
-			newGenericGitMechanism(kargoClient, credentialsDB),
-			newKargoRenderMechanism(kargoClient, credentialsDB),
-			newKustomizeMechanism(kargoClient, credentialsDB),
-			newHelmMechanism(kargoClient, credentialsDB),
-		),
-		newArgoCDMechanism(kargoClient, argocdClient),
+		newChangeApprovalMechanism(credentialsDB),
+		newImagePullCheckMechanism(kargoClient, credentialsDB),
+		NewGitMechanisms(kargoClient, credentialsDB),
+		newArgoCDMechanism(kargoClient, argocdClient, argocdRemoteClients),
+		newFluxMechanism(kargoClient),
+		newKubernetesMechanism(kargoClient),
+		newCanaryMechanism(kargoClient),
 	)
 }
+
+// NewGitMechanisms returns a Mechanism composed of all promotion mechanisms
+// that update configuration in Git repositories.
+func NewGitMechanisms(
+	kargoClient client.Client,
+	credentialsDB credentials.Database,
+) Mechanism {
+	return newCompositeMechanism(
+		"Git-based promotion mechanisms",
+		newGenericGitMechanism(kargoClient, credentialsDB),
+		newKargoRenderMechanism(kargoClient, credentialsDB),
+		newKustomizeMechanism(kargoClient, credentialsDB),
+		newHelmMechanism(kargoClient, credentialsDB),
+	)
+}
+
+// ErrPromotionTimeout is the error returned by promotion mechanisms that were
+// aborted because a Promotion did not complete within the PromotionTimeout of
+// the Stage being promoted to.
+type ErrPromotionTimeout struct {
+	// Timeout is the timeout that was exceeded.
+	Timeout time.Duration
+}
+
+func (e *ErrPromotionTimeout) Error() string {
+	return fmt.Sprintf("Promotion did not complete within %s", e.Timeout)
+}
+
+// withContextCause returns the provided error unchanged unless the provided
+// context is done, in which case the returned error also wraps the cause of
+// the context being done. This permits callers to determine whether a failure
+// was the result of a timeout, for instance, using errors.As().
+func withContextCause(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+	cause := context.Cause(ctx)
+	if errors.Is(err, cause) {
+		return err
+	}
+	return fmt.Errorf("%w: %w", cause, err)
+}
@@ -2,12 +2,15 @@ package promotion
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libargocd "github.com/akuity/kargo/internal/argocd"
 	"github.com/akuity/kargo/internal/credentials"
 )
 
@@ -15,11 +18,63 @@ func TestNewMechanisms(t *testing.T) {
 	promoMechs := NewMechanisms(
 		fake.NewFakeClient(),
 		fake.NewFakeClient(),
+		libargocd.NewRemoteClients(&credentials.FakeDB{}),
 		&credentials.FakeDB{},
 	)
 	require.IsType(t, &compositeMechanism{}, promoMechs)
 }
 
+func TestWithContextCause(t *testing.T) {
+	timeoutErr := &ErrPromotionTimeout{Timeout: time.Minute}
+	timedOutCtx, cancel := context.WithCancelCause(context.Background())
+	cancel(timeoutErr)
+
+	testCases := []struct {
+		name       string
+		ctx        context.Context
+		err        error
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "no error",
+			ctx:  timedOutCtx,
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "context not done",
+			ctx:  context.Background(),
+			err:  errors.New("something went wrong"),
+			assertions: func(t *testing.T, err error) {
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "context done",
+			ctx:  timedOutCtx,
+			err:  errors.New("something went wrong"),
+			assertions: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, timeoutErr)
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error already wraps cause",
+			ctx:  timedOutCtx,
+			err:  timeoutErr,
+			assertions: func(t *testing.T, err error) {
+				require.Same(t, timeoutErr, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, withContextCause(testCase.ctx, testCase.err))
+		})
+	}
+}
+
 // FakeMechanism is a fake implementation of the Mechanism interface used for
 // testing.
 type FakeMechanism struct {
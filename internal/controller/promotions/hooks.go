@@ -0,0 +1,143 @@
+package promotions
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// promotionHook identifies when a promotion hook Job is run relative to the
+// promotion mechanisms of a Promotion.
+type promotionHook string
+
+const (
+	// promotionHookPre identifies a Job that is run before the promotion
+	// mechanisms of a Promotion are executed.
+	promotionHookPre promotionHook = "pre-promotion"
+	// promotionHookPost identifies a Job that is run after the promotion
+	// mechanisms of a Promotion have succeeded.
+	promotionHookPost promotionHook = "post-promotion"
+)
+
+// hookJobName returns the name of the Job that is run as the specified
+// promotion hook for the provided Promotion. The name is derived from the
+// Promotion's UID so that it is both unique and no longer than is permitted
+// for the value of the job-name label Kubernetes applies to the Job's Pods.
+func hookJobName(hook promotionHook, promo *kargoapi.Promotion) string {
+	return fmt.Sprintf("%s-%s", hook, promo.UID)
+}
+
+// runPromotionHook creates a Job from the provided template as the specified
+// promotion hook for the provided Promotion and waits for it to finish. The Job
+// is labeled with the ID of the FreightCollection being promoted so that it can
+// be garbage collected once that FreightCollection is no longer part of the
+// Stage's history. If the Job already exists, because this Promotion was
+// previously interrupted while waiting for it, the existing Job is waited for
+// instead. It returns true if the Job completed successfully and false if it
+// failed. An error is returned if the Job could not be created or its status
+// could not be retrieved, or if the provided context is canceled before the
+// Job has finished.
+func (r *reconciler) runPromotionHook(
+	ctx context.Context,
+	hook promotionHook,
+	promo *kargoapi.Promotion,
+	freightColID string,
+	tmpl *kargoapi.JobTemplate,
+) (bool, error) {
+	logger := logging.LoggerFromContext(ctx).WithValues("hook", hook)
+
+	job := &batchv1.Job{
+		ObjectMeta: *tmpl.Template.ObjectMeta.DeepCopy(),
+		Spec:       *tmpl.Template.Spec.DeepCopy(),
+	}
+	job.Name = hookJobName(hook, promo)
+	job.GenerateName = ""
+	job.Namespace = promo.Namespace
+	if job.Labels == nil {
+		job.Labels = map[string]string{}
+	}
+	job.Labels[kargoapi.PromotionLabelKey] = promo.Name
+	job.Labels[kargoapi.StageLabelKey] = promo.Spec.Stage
+	job.Labels[kargoapi.FreightCollectionLabelKey] = freightColID
+	// Mark the Promotion as the owner of the Job so the Job is garbage
+	// collected along with the Promotion.
+	job.OwnerReferences = append(
+		job.OwnerReferences,
+		metav1.OwnerReference{
+			APIVersion:         kargoapi.GroupVersion.String(),
+			Kind:               "Promotion",
+			Name:               promo.Name,
+			UID:                promo.UID,
+			BlockOwnerDeletion: ptr.To(true),
+		},
+	)
+	if job.Spec.Template.Spec.RestartPolicy == "" {
+		job.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyNever
+	}
+
+	if err := r.kargoClient.Create(ctx, job); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return false, fmt.Errorf(
+				"error creating %s hook Job %q in namespace %q: %w",
+				hook, job.Name, job.Namespace, err,
+			)
+		}
+		logger.Debug("resuming wait for existing hook Job", "job", job.Name)
+	} else {
+		logger.Debug("created hook Job", "job", job.Name)
+	}
+
+	var succeeded bool
+	if err := wait.PollUntilContextCancel(
+		ctx,
+		r.hookJobPollInterval,
+		true,
+		func(ctx context.Context) (bool, error) {
+			if err := r.kargoClient.Get(ctx, client.ObjectKeyFromObject(job), job); err != nil {
+				return false, fmt.Errorf(
+					"error getting %s hook Job %q in namespace %q: %w",
+					hook, job.Name, job.Namespace, err,
+				)
+			}
+			var finished bool
+			finished, succeeded = jobFinished(job)
+			return finished, nil
+		},
+	); err != nil {
+		if ctx.Err() != nil {
+			// Surface why the context was canceled, e.g. because the
+			// Promotion timed out.
+			return false, context.Cause(ctx)
+		}
+		return false, err
+	}
+	logger.Debug("hook Job finished", "job", job.Name, "succeeded", succeeded)
+	return succeeded, nil
+}
+
+// jobFinished returns whether the provided Job has finished and, if so,
+// whether it completed successfully.
+func jobFinished(job *batchv1.Job) (finished bool, succeeded bool) {
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return true, true
+		case batchv1.JobFailed:
+			return true, false
+		}
+	}
+	return false, false
+}
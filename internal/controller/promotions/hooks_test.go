@@ -0,0 +1,187 @@
+package promotions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
+)
+
+func TestRunPromotionHook(t *testing.T) {
+	testPromo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-promo",
+			UID:       types.UID("fake-uid"),
+		},
+		Spec: kargoapi.PromotionSpec{
+			Stage: "fake-stage",
+		},
+	}
+	testTemplate := &kargoapi.JobTemplate{
+		Template: batchv1.JobTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "ignored",
+				Labels: map[string]string{"foo": "bar"},
+			},
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:  "smoke-test",
+							Image: "busybox",
+						}},
+					},
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name string
+		// condition is set on the Job once it has been created. If empty, the
+		// Job never finishes.
+		condition  batchv1.JobConditionType
+		assertions func(*testing.T, bool, error)
+	}{
+		{
+			name:      "Job completes",
+			condition: batchv1.JobComplete,
+			assertions: func(t *testing.T, succeeded bool, err error) {
+				require.NoError(t, err)
+				require.True(t, succeeded)
+			},
+		},
+		{
+			name:      "Job fails",
+			condition: batchv1.JobFailed,
+			assertions: func(t *testing.T, succeeded bool, err error) {
+				require.NoError(t, err)
+				require.False(t, succeeded)
+			},
+		},
+		{
+			name: "context is canceled before Job finishes",
+			assertions: func(t *testing.T, succeeded bool, err error) {
+				require.ErrorIs(t, err, errTestHookCanceled)
+				require.False(t, succeeded)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := newFakeReconciler(t, fakeevent.NewEventRecorder(1))
+			r.hookJobPollInterval = time.Millisecond
+
+			ctx, cancel := context.WithCancelCause(context.Background())
+			defer cancel(nil)
+
+			type result struct {
+				succeeded bool
+				err       error
+			}
+			resCh := make(chan result, 1)
+			go func() {
+				succeeded, err := r.runPromotionHook(
+					ctx,
+					promotionHookPre,
+					testPromo,
+					"fake-freight-collection",
+					testTemplate,
+				)
+				resCh <- result{succeeded: succeeded, err: err}
+			}()
+
+			job := &batchv1.Job{}
+			jobKey := types.NamespacedName{
+				Namespace: testPromo.Namespace,
+				Name:      "pre-promotion-fake-uid",
+			}
+			require.Eventually(t, func() bool {
+				return r.kargoClient.Get(ctx, jobKey, job) == nil
+			}, time.Second, time.Millisecond)
+			require.Equal(t, "bar", job.Labels["foo"])
+			require.Equal(t, testPromo.Name, job.Labels[kargoapi.PromotionLabelKey])
+			require.Equal(t, testPromo.Spec.Stage, job.Labels[kargoapi.StageLabelKey])
+			require.Equal(t, "fake-freight-collection", job.Labels[kargoapi.FreightCollectionLabelKey])
+			require.Len(t, job.OwnerReferences, 1)
+			require.Equal(t, testPromo.UID, job.OwnerReferences[0].UID)
+			require.Equal(t, corev1.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy)
+
+			if testCase.condition != "" {
+				job.Status.Conditions = []batchv1.JobCondition{{
+					Type:   testCase.condition,
+					Status: corev1.ConditionTrue,
+				}}
+				// Job conditions belong to the status subresource, which
+				// an ordinary update leaves untouched.
+				require.NoError(t, r.kargoClient.Status().Update(ctx, job))
+			} else {
+				cancel(errTestHookCanceled)
+			}
+
+			select {
+			case res := <-resCh:
+				testCase.assertions(t, res.succeeded, res.err)
+			case <-time.After(5 * time.Second):
+				require.FailNow(t, "timed out waiting for hook to finish")
+			}
+		})
+	}
+}
+
+var errTestHookCanceled = errors.New("canceled")
+
+func TestJobFinished(t *testing.T) {
+	testCases := []struct {
+		name              string
+		conditions        []batchv1.JobCondition
+		expectedFinished  bool
+		expectedSucceeded bool
+	}{
+		{
+			name: "no conditions",
+		},
+		{
+			name: "condition is not true",
+			conditions: []batchv1.JobCondition{{
+				Type:   batchv1.JobComplete,
+				Status: corev1.ConditionFalse,
+			}},
+		},
+		{
+			name: "complete",
+			conditions: []batchv1.JobCondition{{
+				Type:   batchv1.JobComplete,
+				Status: corev1.ConditionTrue,
+			}},
+			expectedFinished:  true,
+			expectedSucceeded: true,
+		},
+		{
+			name: "failed",
+			conditions: []batchv1.JobCondition{{
+				Type:   batchv1.JobFailed,
+				Status: corev1.ConditionTrue,
+			}},
+			expectedFinished: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			finished, succeeded := jobFinished(&batchv1.Job{
+				Status: batchv1.JobStatus{Conditions: testCase.conditions},
+			})
+			require.Equal(t, testCase.expectedFinished, finished)
+			require.Equal(t, testCase.expectedSucceeded, succeeded)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package promotions
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// promotionLeaseDuration is the minimum duration for which a Lease acquired to
+// execute a Promotion is valid. Leases are released as soon as execution
+// concludes, so this only matters if the replica holding the Lease stops
+// before it could release it.
+const promotionLeaseDuration = 10 * time.Minute
+
+// promotionLeaseName returns the name of the Lease that a controller replica
+// must hold to execute a Promotion to the provided Stage. Names that would
+// exceed the maximum length of a Kubernetes resource name are truncated and
+// suffixed with a hash of the full name to keep them unique.
+func promotionLeaseName(stage *kargoapi.Stage) string {
+	name := fmt.Sprintf("kargo-promote-%s-%s", stage.Namespace, stage.Name)
+	if len(name) <= validation.DNS1123SubdomainMaxLength {
+		return name
+	}
+	sum := sha256.Sum256([]byte(name))
+	hash := hex.EncodeToString(sum[:])[:16]
+	prefix := strings.TrimRight(
+		name[:validation.DNS1123SubdomainMaxLength-len(hash)-1],
+		"-.",
+	)
+	return prefix + "-" + hash
+}
+
+// promotionLeaseOwnerRefs returns the owner references of the Lease that a
+// controller replica must hold to execute a Promotion to the provided Stage.
+// The Stage is the Lease's controller, so that the Lease is garbage collected
+// along with the Stage.
+func promotionLeaseOwnerRefs(stage *kargoapi.Stage) []metav1.OwnerReference {
+	return []metav1.OwnerReference{
+		*metav1.NewControllerRef(stage, kargoapi.GroupVersion.WithKind("Stage")),
+	}
+}
+
+// newLeaseHolderIdentity returns an identity that is unique to this controller
+// replica for it to hold Leases with.
+func newLeaseHolderIdentity() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "kargo-controller"
+	}
+	return hostname + "_" + uuid.NewString()
+}
+
+// tryAcquirePromotionLease attempts to acquire the Lease that a controller
+// replica must hold to execute a Promotion to the provided Stage. It returns
+// true if the Lease was acquired or renewed, and false if it is held by
+// another replica.
+func (r *reconciler) tryAcquirePromotionLease(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) (bool, error) {
+	now := metav1.NewMicroTime(time.Now())
+	duration := promotionLeaseDuration
+	if stage.Spec.PromotionTimeout != nil && stage.Spec.PromotionTimeout.Duration > duration {
+		duration = stage.Spec.PromotionTimeout.Duration
+	}
+
+	lease := &coordinationv1.Lease{}
+	err := r.leaseClient.Get(
+		ctx,
+		client.ObjectKey{
+			Namespace: stage.Namespace,
+			Name:      promotionLeaseName(stage),
+		},
+		lease,
+	)
+	if apierrors.IsNotFound(err) {
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       stage.Namespace,
+				Name:            promotionLeaseName(stage),
+				OwnerReferences: promotionLeaseOwnerRefs(stage),
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(r.leaseHolderIdentity),
+				LeaseDurationSeconds: ptr.To(int32(duration.Seconds())),
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+		if err = r.leaseClient.Create(ctx, lease); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				// Another replica created the Lease first.
+				return false, nil
+			}
+			return false, fmt.Errorf("error creating Lease %q: %w", lease.Name, err)
+		}
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error getting Lease %q: %w", promotionLeaseName(stage), err)
+	}
+
+	holder := ptr.Deref(lease.Spec.HolderIdentity, "")
+	if holder != "" && holder != r.leaseHolderIdentity && !leaseExpired(lease, now.Time) {
+		return false, nil
+	}
+	if holder != r.leaseHolderIdentity {
+		lease.Spec.HolderIdentity = ptr.To(r.leaseHolderIdentity)
+		lease.Spec.AcquireTime = &now
+	}
+	if metav1.GetControllerOf(lease) == nil {
+		// The Lease may have been created before Leases were owned by Stages.
+		lease.OwnerReferences = append(lease.OwnerReferences, promotionLeaseOwnerRefs(stage)...)
+	}
+	lease.Spec.LeaseDurationSeconds = ptr.To(int32(duration.Seconds()))
+	lease.Spec.RenewTime = &now
+	if err = r.leaseClient.Update(ctx, lease); err != nil {
+		if apierrors.IsConflict(err) {
+			// Another replica updated the Lease first.
+			return false, nil
+		}
+		return false, fmt.Errorf("error updating Lease %q: %w", lease.Name, err)
+	}
+	return true, nil
+}
+
+// releasePromotionLease releases the Lease held by this controller replica to
+// execute a Promotion to the provided Stage, so that other replicas do not
+// need to wait for it to expire. Failure to release the Lease is logged, but
+// is otherwise inconsequential.
+func (r *reconciler) releasePromotionLease(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) {
+	logger := logging.LoggerFromContext(ctx)
+	lease := &coordinationv1.Lease{}
+	if err := r.leaseClient.Get(
+		ctx,
+		client.ObjectKey{
+			Namespace: stage.Namespace,
+			Name:      promotionLeaseName(stage),
+		},
+		lease,
+	); err != nil {
+		logger.Error(err, "error getting Lease to release it", "lease", promotionLeaseName(stage))
+		return
+	}
+	if ptr.Deref(lease.Spec.HolderIdentity, "") != r.leaseHolderIdentity {
+		return
+	}
+	lease.Spec.HolderIdentity = nil
+	lease.Spec.AcquireTime = nil
+	lease.Spec.RenewTime = nil
+	if err := r.leaseClient.Update(ctx, lease); err != nil {
+		logger.Error(err, "error releasing Lease", "lease", lease.Name)
+	}
+}
+
+// leaseExpired returns true if the provided Lease has not been renewed within
+// its duration as of the provided time.
+func leaseExpired(lease *coordinationv1.Lease, now time.Time) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	expiry := lease.Spec.RenewTime.Add(
+		time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second,
+	)
+	return !now.Before(expiry)
+}
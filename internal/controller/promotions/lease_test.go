@@ -0,0 +1,202 @@
+package promotions
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestPromotionLeaseName(t *testing.T) {
+	testCases := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		assertions func(*testing.T, string)
+	}{
+		{
+			name: "short name",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-stage",
+				},
+			},
+			assertions: func(t *testing.T, name string) {
+				require.Equal(t, "kargo-promote-fake-namespace-fake-stage", name)
+			},
+		},
+		{
+			name: "long name",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: strings.Repeat("n", 63),
+					Name:      strings.Repeat("s", 190) + ".stage",
+				},
+			},
+			assertions: func(t *testing.T, name string) {
+				require.Len(t, name, validation.DNS1123SubdomainMaxLength)
+				require.Empty(t, validation.IsDNS1123Subdomain(name))
+				require.True(t, strings.HasPrefix(name, "kargo-promote-nnn"))
+				// Stages whose names differ only beyond the truncated prefix
+				// do not share a Lease
+				other := promotionLeaseName(&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: strings.Repeat("n", 63),
+						Name:      strings.Repeat("s", 190) + ".other",
+					},
+				})
+				require.NotEqual(t, name, other)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, promotionLeaseName(testCase.stage))
+		})
+	}
+}
+
+func TestTryAcquirePromotionLease(t *testing.T) {
+	const testHolder = "fake-holder"
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+			UID:       types.UID("fake-uid"),
+		},
+	}
+	newLease := func(holder string, renewed time.Time) *coordinationv1.Lease {
+		return &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "kargo-promote-fake-namespace-fake-stage",
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(holder),
+				LeaseDurationSeconds: ptr.To(int32(60)),
+				RenewTime:            &metav1.MicroTime{Time: renewed},
+			},
+		}
+	}
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		assertions func(*testing.T, bool, error, *coordinationv1.Lease)
+	}{
+		{
+			name: "Lease does not exist",
+			assertions: func(t *testing.T, acquired bool, err error, lease *coordinationv1.Lease) {
+				require.NoError(t, err)
+				require.True(t, acquired)
+				require.Equal(t, testHolder, *lease.Spec.HolderIdentity)
+				require.Equal(t, int32(promotionLeaseDuration.Seconds()), *lease.Spec.LeaseDurationSeconds)
+				owner := metav1.GetControllerOf(lease)
+				require.NotNil(t, owner)
+				require.Equal(t, "Stage", owner.Kind)
+				require.Equal(t, testStage.UID, owner.UID)
+			},
+		},
+		{
+			name:    "Lease is held by another holder",
+			objects: []client.Object{newLease("competing-holder", time.Now())},
+			assertions: func(t *testing.T, acquired bool, err error, lease *coordinationv1.Lease) {
+				require.NoError(t, err)
+				require.False(t, acquired)
+				require.Equal(t, "competing-holder", *lease.Spec.HolderIdentity)
+			},
+		},
+		{
+			name:    "Lease held by another holder has expired",
+			objects: []client.Object{newLease("competing-holder", time.Now().Add(-time.Hour))},
+			assertions: func(t *testing.T, acquired bool, err error, lease *coordinationv1.Lease) {
+				require.NoError(t, err)
+				require.True(t, acquired)
+				require.Equal(t, testHolder, *lease.Spec.HolderIdentity)
+				owner := metav1.GetControllerOf(lease)
+				require.NotNil(t, owner)
+				require.Equal(t, testStage.UID, owner.UID)
+			},
+		},
+		{
+			name:    "Lease was released",
+			objects: []client.Object{newLease("", time.Now())},
+			assertions: func(t *testing.T, acquired bool, err error, lease *coordinationv1.Lease) {
+				require.NoError(t, err)
+				require.True(t, acquired)
+				require.Equal(t, testHolder, *lease.Spec.HolderIdentity)
+			},
+		},
+		{
+			name:    "Lease is already held",
+			objects: []client.Object{newLease(testHolder, time.Now().Add(-time.Minute))},
+			assertions: func(t *testing.T, acquired bool, err error, lease *coordinationv1.Lease) {
+				require.NoError(t, err)
+				require.True(t, acquired)
+				require.Equal(t, testHolder, *lease.Spec.HolderIdentity)
+				require.False(t, leaseExpired(lease, time.Now()))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				leaseClient:         fake.NewClientBuilder().WithObjects(testCase.objects...).Build(),
+				leaseHolderIdentity: testHolder,
+			}
+			acquired, err := r.tryAcquirePromotionLease(context.Background(), testStage)
+			lease := &coordinationv1.Lease{}
+			require.NoError(t, r.leaseClient.Get(
+				context.Background(),
+				client.ObjectKey{
+					Namespace: testStage.Namespace,
+					Name:      promotionLeaseName(testStage),
+				},
+				lease,
+			))
+			testCase.assertions(t, acquired, err, lease)
+		})
+	}
+}
+
+func TestReleasePromotionLease(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	r := &reconciler{
+		leaseClient:         fake.NewClientBuilder().Build(),
+		leaseHolderIdentity: "fake-holder",
+	}
+	competitor := &reconciler{
+		leaseClient:         r.leaseClient,
+		leaseHolderIdentity: "competing-holder",
+	}
+
+	acquired, err := r.tryAcquirePromotionLease(context.Background(), testStage)
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	// Releasing a Lease held by another holder has no effect
+	competitor.releasePromotionLease(context.Background(), testStage)
+	acquired, err = competitor.tryAcquirePromotionLease(context.Background(), testStage)
+	require.NoError(t, err)
+	require.False(t, acquired)
+
+	r.releasePromotionLease(context.Background(), testStage)
+	acquired, err = competitor.tryAcquirePromotionLease(context.Background(), testStage)
+	require.NoError(t, err)
+	require.True(t, acquired)
+}
@@ -0,0 +1,188 @@
+package promotions
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/git"
+)
+
+// errPromotionReplaced is the cause with which the context of a running
+// Promotion is canceled when another Promotion replaces it.
+type errPromotionReplaced struct {
+	replacedBy types.NamespacedName
+}
+
+func (e *errPromotionReplaced) Error() string {
+	return fmt.Sprintf("Promotion was replaced by Promotion %q", e.replacedBy.Name)
+}
+
+// promotionLock tracks which Promotions are updating which Git repositories
+// so that Stages with a ConcurrencyPolicy other than Allow do not have
+// Promotions updating the same repositories at the same time. A Promotion
+// holds the lock on its repositories from when it is first permitted to
+// proceed until it is released, which should happen once it has concluded.
+type promotionLock struct {
+	// holdersByRepo holds the Promotion currently updating each repository,
+	// keyed by normalized repository URL.
+	holdersByRepo map[string]*promotionLockHolder
+	// holdersByPromo holds the lock held by each Promotion.
+	holdersByPromo map[types.NamespacedName]*promotionLockHolder
+	// replaced records Promotions that were replaced before they concluded,
+	// along with the Promotions that replaced them.
+	replaced map[types.NamespacedName]types.NamespacedName
+	// mu protects access to the above maps
+	mu sync.Mutex
+}
+
+// promotionLockHolder describes a Promotion holding the lock on a set of
+// repositories.
+type promotionLockHolder struct {
+	promo    types.NamespacedName
+	repoURLs []string
+	// cancel cancels the context of the Promotion while it is being executed.
+	// It is nil otherwise.
+	cancel context.CancelCauseFunc
+}
+
+func newPromotionLock() *promotionLock {
+	return &promotionLock{
+		holdersByRepo:  map[string]*promotionLockHolder{},
+		holdersByPromo: map[types.NamespacedName]*promotionLockHolder{},
+		replaced:       map[types.NamespacedName]types.NamespacedName{},
+	}
+}
+
+// stageRepoURLs returns the normalized, de-duplicated URLs of all Git
+// repositories updated by the promotion mechanisms of the provided Stage.
+func stageRepoURLs(stage *kargoapi.Stage) []string {
+	if stage.Spec.PromotionMechanisms == nil {
+		return nil
+	}
+	repoURLs := make([]string, 0, len(stage.Spec.PromotionMechanisms.GitRepoUpdates))
+	for _, update := range stage.Spec.PromotionMechanisms.GitRepoUpdates {
+		repoURLs = append(repoURLs, git.NormalizeURL(update.RepoURL))
+	}
+	slices.Sort(repoURLs)
+	return slices.Compact(repoURLs)
+}
+
+// tryAcquire attempts to obtain the lock on the provided repositories for the
+// provided Promotion in accordance with the provided ConcurrencyPolicy. It
+// returns true if the Promotion may proceed. With the Forbid policy, false is
+// returned while another Promotion holds the lock on any of the repositories.
+// With the Replace policy, any such Promotions are replaced and returned so
+// they can be made to conclude. With the Allow policy, or when there are no
+// repositories, the Promotion may always proceed and no lock is obtained.
+func (l *promotionLock) tryAcquire(
+	promo types.NamespacedName,
+	repoURLs []string,
+	policy kargoapi.ConcurrencyPolicy,
+) (bool, []types.NamespacedName) {
+	if len(repoURLs) == 0 ||
+		(policy != kargoapi.ConcurrencyPolicyForbid && policy != kargoapi.ConcurrencyPolicyReplace) {
+		return true, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.holdersByPromo[promo]; ok {
+		return true, nil
+	}
+
+	var others []*promotionLockHolder
+	for _, repoURL := range repoURLs {
+		if other, ok := l.holdersByRepo[repoURL]; ok && !slices.Contains(others, other) {
+			others = append(others, other)
+		}
+	}
+
+	var replaced []types.NamespacedName
+	if len(others) > 0 {
+		if policy == kargoapi.ConcurrencyPolicyForbid {
+			return false, nil
+		}
+		for _, other := range others {
+			if other.cancel != nil {
+				other.cancel(&errPromotionReplaced{replacedBy: promo})
+			}
+			l.releaseHolder(other)
+			l.replaced[other.promo] = promo
+			replaced = append(replaced, other.promo)
+		}
+	}
+
+	holder := &promotionLockHolder{
+		promo:    promo,
+		repoURLs: repoURLs,
+	}
+	l.holdersByPromo[promo] = holder
+	for _, repoURL := range repoURLs {
+		l.holdersByRepo[repoURL] = holder
+	}
+	return true, replaced
+}
+
+// execute returns a context for executing the provided Promotion that is
+// canceled if the Promotion is replaced, along with a function that must be
+// called once execution has finished. If the Promotion holds no lock, the
+// provided context is returned unchanged.
+func (l *promotionLock) execute(
+	ctx context.Context,
+	promo types.NamespacedName,
+) (context.Context, func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	holder, ok := l.holdersByPromo[promo]
+	if !ok {
+		return ctx, func() {}
+	}
+	ctx, cancel := context.WithCancelCause(ctx)
+	holder.cancel = cancel
+	return ctx, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		holder.cancel = nil
+		cancel(nil)
+	}
+}
+
+// replacedBy returns the Promotion that replaced the provided Promotion, if it
+// has been replaced.
+func (l *promotionLock) replacedBy(
+	promo types.NamespacedName,
+) (types.NamespacedName, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	replacedBy, ok := l.replaced[promo]
+	return replacedBy, ok
+}
+
+// release relinquishes any lock held by the provided Promotion and forgets
+// whether it was replaced. It should be called once the Promotion has
+// concluded.
+func (l *promotionLock) release(promo types.NamespacedName) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if holder, ok := l.holdersByPromo[promo]; ok {
+		l.releaseHolder(holder)
+	}
+	delete(l.replaced, promo)
+}
+
+// releaseHolder removes all traces of the provided holder from the lock. The
+// caller MUST hold the mutex.
+func (l *promotionLock) releaseHolder(holder *promotionLockHolder) {
+	for _, repoURL := range holder.repoURLs {
+		if l.holdersByRepo[repoURL] == holder {
+			delete(l.holdersByRepo, repoURL)
+		}
+	}
+	delete(l.holdersByPromo, holder.promo)
+}
@@ -0,0 +1,140 @@
+package promotions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestStageRepoURLs(t *testing.T) {
+	testCases := []struct {
+		name     string
+		stage    *kargoapi.Stage
+		expected []string
+	}{
+		{
+			name:  "no promotion mechanisms",
+			stage: &kargoapi.Stage{},
+		},
+		{
+			name: "repositories are normalized and de-duplicated",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						GitRepoUpdates: []kargoapi.GitRepoUpdate{
+							{RepoURL: "https://github.com/akuity/kargo-demo.git"},
+							{RepoURL: "https://github.com/akuity/kargo"},
+							{RepoURL: "https://github.com/Akuity/kargo-demo"},
+						},
+					},
+				},
+			},
+			expected: []string{
+				"https://github.com/akuity/kargo",
+				"https://github.com/akuity/kargo-demo",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, stageRepoURLs(testCase.stage))
+		})
+	}
+}
+
+func TestPromotionLock(t *testing.T) {
+	repoURLs := []string{"https://github.com/akuity/kargo-demo"}
+	promoA := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo-a"}
+	promoB := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo-b"}
+
+	testCases := []struct {
+		name       string
+		assertions func(*testing.T, *promotionLock)
+	}{
+		{
+			name: "Allow never waits and never holds the lock",
+			assertions: func(t *testing.T, l *promotionLock) {
+				ok, _ := l.tryAcquire(promoA, repoURLs, kargoapi.ConcurrencyPolicyForbid)
+				require.True(t, ok)
+				ok, replaced := l.tryAcquire(promoB, repoURLs, kargoapi.ConcurrencyPolicyAllow)
+				require.True(t, ok)
+				require.Empty(t, replaced)
+				require.NotContains(t, l.holdersByPromo, promoB)
+				require.Equal(t, promoA, l.holdersByRepo[repoURLs[0]].promo)
+			},
+		},
+		{
+			name: "Promotions without repositories never wait",
+			assertions: func(t *testing.T, l *promotionLock) {
+				ok, _ := l.tryAcquire(promoA, repoURLs, kargoapi.ConcurrencyPolicyForbid)
+				require.True(t, ok)
+				ok, _ = l.tryAcquire(promoB, nil, kargoapi.ConcurrencyPolicyForbid)
+				require.True(t, ok)
+			},
+		},
+		{
+			name: "Forbid waits until the lock is released",
+			assertions: func(t *testing.T, l *promotionLock) {
+				ok, _ := l.tryAcquire(promoA, repoURLs, kargoapi.ConcurrencyPolicyForbid)
+				require.True(t, ok)
+				// Acquiring again is permitted
+				ok, _ = l.tryAcquire(promoA, repoURLs, kargoapi.ConcurrencyPolicyForbid)
+				require.True(t, ok)
+				ok, _ = l.tryAcquire(promoB, repoURLs, kargoapi.ConcurrencyPolicyForbid)
+				require.False(t, ok)
+				l.release(promoA)
+				ok, _ = l.tryAcquire(promoB, repoURLs, kargoapi.ConcurrencyPolicyForbid)
+				require.True(t, ok)
+			},
+		},
+		{
+			name: "Replace cancels the executing Promotion",
+			assertions: func(t *testing.T, l *promotionLock) {
+				ok, _ := l.tryAcquire(promoA, repoURLs, kargoapi.ConcurrencyPolicyForbid)
+				require.True(t, ok)
+				ctx, finish := l.execute(context.Background(), promoA)
+				defer finish()
+
+				ok, replaced := l.tryAcquire(promoB, repoURLs, kargoapi.ConcurrencyPolicyReplace)
+				require.True(t, ok)
+				require.Equal(t, []types.NamespacedName{promoA}, replaced)
+
+				<-ctx.Done()
+				var replacedErr *errPromotionReplaced
+				require.True(t, errors.As(context.Cause(ctx), &replacedErr))
+				require.Equal(t, promoB, replacedErr.replacedBy)
+
+				replacedBy, ok := l.replacedBy(promoA)
+				require.True(t, ok)
+				require.Equal(t, promoB, replacedBy)
+				l.release(promoA)
+				_, ok = l.replacedBy(promoA)
+				require.False(t, ok)
+
+				// The lock is now held by the replacing Promotion
+				ok, _ = l.tryAcquire(promoA, repoURLs, kargoapi.ConcurrencyPolicyForbid)
+				require.False(t, ok)
+			},
+		},
+		{
+			name: "execution is not canceled once finished",
+			assertions: func(t *testing.T, l *promotionLock) {
+				ok, _ := l.tryAcquire(promoA, repoURLs, kargoapi.ConcurrencyPolicyForbid)
+				require.True(t, ok)
+				_, finish := l.execute(context.Background(), promoA)
+				finish()
+				require.Nil(t, l.holdersByPromo[promoA].cancel)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, newPromotionLock())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package promotions
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strconv"
@@ -21,6 +22,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libargocd "github.com/akuity/kargo/internal/argocd"
 	"github.com/akuity/kargo/internal/controller"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/promotion"
@@ -30,10 +32,20 @@ import (
 	"github.com/akuity/kargo/internal/kubeclient"
 	libEvent "github.com/akuity/kargo/internal/kubernetes/event"
 	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/pkg/conditions"
 )
 
+// promotionLockRetryInterval is how long to wait before retrying a Promotion
+// that may not proceed because of its Stage's ConcurrencyPolicy.
+const promotionLockRetryInterval = 10 * time.Second
+
+// preflightRetryInterval is how long to wait before re-running the preflight
+// checks of a Promotion that failed them.
+const preflightRetryInterval = 30 * time.Second
+
 // ReconcilerConfig represents configuration for the promotion reconciler.
 type ReconcilerConfig struct {
+	controller.WatchedNamespaces
 	ShardName string `envconfig:"SHARD_NAME"`
 }
 
@@ -63,6 +75,17 @@ type reconciler struct {
 	pqs            *promoQueues
 	initializeOnce sync.Once
 
+	promoLock *promotionLock
+
+	// leaseClient is used to manage the Leases that ensure only one controller
+	// replica executes a Promotion to any given Stage at a time.
+	leaseClient         client.Client
+	leaseHolderIdentity string
+
+	// hookJobPollInterval is the interval at which the status of a promotion
+	// hook Job is checked while waiting for it to finish.
+	hookJobPollInterval time.Duration
+
 	// The following behaviors are overridable for testing purposes:
 
 	getStageFn func(
@@ -77,6 +100,28 @@ type reconciler struct {
 		*kargoapi.Stage,
 		*kargoapi.Freight,
 	) (*kargoapi.PromotionStatus, error)
+
+	preflightChecksFn func(
+		context.Context,
+		string,
+		kargoapi.FreightCollection,
+		*kargoapi.PromotionMechanisms,
+	) error
+
+	runPromotionHookFn func(
+		context.Context,
+		promotionHook,
+		*kargoapi.Promotion,
+		string,
+		*kargoapi.JobTemplate,
+	) (bool, error)
+
+	buildChangelogFn func(
+		context.Context,
+		string,
+		*kargoapi.FreightCollection,
+		[]kargoapi.FreightReference,
+	) []kargoapi.ChartChange
 }
 
 // SetupReconcilerWithManager initializes a reconciler for Promotion resources
@@ -85,6 +130,7 @@ func SetupReconcilerWithManager(
 	ctx context.Context,
 	kargoMgr manager.Manager,
 	argocdMgr manager.Manager,
+	argocdRemoteClients libargocd.RemoteClients,
 	credentialsDB credentials.Database,
 	cfg ReconcilerConfig,
 ) error {
@@ -111,8 +157,10 @@ func SetupReconcilerWithManager(
 	reconciler := newReconciler(
 		kargoMgr.GetClient(),
 		argocdClient,
+		argocdRemoteClients,
 		libEvent.NewRecorder(ctx, kargoMgr.GetScheme(), kargoMgr.GetClient(), cfg.Name()),
 		credentialsDB,
+		kargoMgr.GetClient(),
 		cfg,
 	)
 
@@ -123,6 +171,7 @@ func SetupReconcilerWithManager(
 			kargo.RefreshRequested{},
 		)).
 		WithEventFilter(shardPredicate).
+		WithEventFilter(cfg.WatchedNamespaces.Predicate()).
 		WithOptions(controller.CommonOptions()).
 		Build(reconciler)
 	if err != nil {
@@ -176,8 +225,10 @@ func SetupReconcilerWithManager(
 func newReconciler(
 	kargoClient client.Client,
 	argocdClient client.Client,
+	argocdRemoteClients libargocd.RemoteClients,
 	recorder record.EventRecorder,
 	credentialsDB credentials.Database,
+	leaseClient client.Client,
 	cfg ReconcilerConfig,
 ) *reconciler {
 	pqs := promoQueues{
@@ -189,14 +240,27 @@ func newReconciler(
 		recorder:    recorder,
 		cfg:         cfg,
 		pqs:         &pqs,
+		promoLock:   newPromotionLock(),
+		leaseClient: leaseClient,
+		// Each replica must hold Leases with a distinct identity
+		leaseHolderIdentity: newLeaseHolderIdentity(),
+		hookJobPollInterval: 5 * time.Second,
 		promoMechanisms: promotion.NewMechanisms(
 			kargoClient,
 			argocdClient,
+			argocdRemoteClients,
 			credentialsDB,
 		),
 	}
 	r.getStageFn = kargoapi.GetStage
 	r.promoteFn = r.promote
+	r.preflightChecksFn = promotion.NewPreflightChecker(
+		argocdClient,
+		argocdRemoteClients,
+		credentialsDB,
+	).Check
+	r.runPromotionHookFn = r.runPromotionHook
+	r.buildChangelogFn = promotion.NewChangelogBuilder(credentialsDB).Build
 	return r
 }
 
@@ -211,6 +275,13 @@ func (r *reconciler) Reconcile(
 		"promotion", req.NamespacedName.Name,
 	)
 	ctx = logging.ContextWithLogger(ctx, logger)
+
+	// Promotions may be enqueued by watches of other resources that are not
+	// subject to the watched namespaces predicate.
+	if !r.cfg.IsWatched(req.NamespacedName.Namespace) {
+		logger.Debug("ignoring Promotion in namespace that is not watched")
+		return ctrl.Result{}, nil
+	}
 	logger.Debug("reconciling Promotion")
 
 	// Note that initialization occurs here because we basically know that the
@@ -240,6 +311,7 @@ func (r *reconciler) Reconcile(
 	if promo == nil || promo.Status.Phase.IsTerminal() {
 		// Ignore if not found or already finished. Promo might be nil if the
 		// Promotion was deleted after the current reconciliation request was issued.
+		r.promoLock.release(req.NamespacedName)
 		return ctrl.Result{}, nil
 	}
 	// Find the Freight
@@ -314,6 +386,17 @@ func (r *reconciler) Reconcile(
 	}
 	logger.Debug("found associated Stage")
 
+	// The Stage's PromotionMechanisms may be described, in part or in full, by
+	// a PromotionTemplate. Resolve them once so that everything below sees the
+	// effective PromotionMechanisms.
+	if stage.Spec.PromotionMechanisms, err =
+		kargoapi.ResolvePromotionMechanisms(ctx, r.kargoClient, stage); err != nil {
+		return ctrl.Result{}, fmt.Errorf(
+			"error resolving PromotionMechanisms of Stage %q in namespace %q: %w",
+			stage.Name, stage.Namespace, err,
+		)
+	}
+
 	// Confirm that the Stage is awaiting this Promotion.
 	//
 	// This is a temporary measure to ensure that the Promotion is only
@@ -333,43 +416,182 @@ func (r *reconciler) Reconcile(
 		return ctrl.Result{Requeue: true}, nil
 	}
 
-	promoCtx := logging.ContextWithLogger(ctx, logger)
-
 	newStatus := promo.Status.DeepCopy()
+	// timedOut indicates whether executing the Promotion was aborted because it
+	// exceeded the Stage's PromotionTimeout.
+	var timedOut bool
+
+	if replacedBy, replaced := r.promoLock.replacedBy(req.NamespacedName); replaced {
+		// Another Promotion updating the same Git repositories replaced this one
+		// while it was not being executed.
+		newStatus.Phase = kargoapi.PromotionPhaseFailed
+		newStatus.Message = (&errPromotionReplaced{replacedBy: replacedBy}).Error()
+	} else {
+		// Stages in a pipeline are promoted in waves. Wait for every Stage in a
+		// lower wave to be healthy before executing the Promotion.
+		var unhealthyStages []string
+		if unhealthyStages, err = r.getUnhealthyLowerWaveStages(ctx, stage); err != nil {
+			return ctrl.Result{}, err
+		}
+		if len(unhealthyStages) > 0 {
+			logger.Debug(
+				"Stages in lower waves of the pipeline are not healthy; "+
+					"waiting for them to become healthy",
+				"pipeline", stage.Spec.Pipeline,
+				"stages", unhealthyStages,
+			)
+			return ctrl.Result{RequeueAfter: promotionLockRetryInterval}, nil
+		}
+
+		// Before performing any writes, make sure that everything the Stage's
+		// PromotionMechanisms depend upon is reachable. Otherwise, the Promotion
+		// could fail part way through and leave some of its changes behind. The
+		// checks are only performed before the Promotion is first executed and
+		// their success is recorded in its status, so they are not repeated
+		// while, e.g., the Promotion waits for an Argo CD Application to sync.
+		if freight != nil && stage.Spec.PromotionMechanisms != nil && !preflightChecksPassed(promo) {
+			freightCol := r.buildTargetFreightCollection(ctx, newFreightReference(freight), stage)
+			if preflightErr := r.preflightChecksFn(
+				ctx,
+				promo.Namespace,
+				*freightCol,
+				stage.Spec.PromotionMechanisms,
+			); preflightErr != nil {
+				logger.Error(preflightErr, "Promotion preflight checks failed; will retry")
+				if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+					conditions.SetCondition(&status.Conditions, metav1.Condition{
+						Type:               string(kargoapi.PromotionConditionTypePreflightFailed),
+						Status:             metav1.ConditionTrue,
+						Reason:             "PreflightFailed",
+						Message:            preflightErr.Error(),
+						ObservedGeneration: promo.Generation,
+					})
+				}); err != nil {
+					return ctrl.Result{}, err
+				}
+				return ctrl.Result{RequeueAfter: preflightRetryInterval}, nil
+			}
+			preflightSucceeded := metav1.Condition{
+				Type:               string(kargoapi.PromotionConditionTypePreflightFailed),
+				Status:             metav1.ConditionFalse,
+				Reason:             "PreflightSucceeded",
+				Message:            "All preflight checks passed",
+				ObservedGeneration: promo.Generation,
+			}
+			if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+				conditions.SetCondition(&status.Conditions, preflightSucceeded)
+			}); err != nil {
+				return ctrl.Result{}, err
+			}
+			conditions.SetCondition(&newStatus.Conditions, preflightSucceeded)
+		}
+
+		// Another replica of the controller may be executing a Promotion to the
+		// same Stage. Before performing any writes, hold the Stage's Lease to
+		// make sure it is the only one doing so.
+		leased, leaseErr := r.tryAcquirePromotionLease(ctx, stage)
+		if leaseErr != nil {
+			logger.Error(leaseErr, "error acquiring Lease; will retry")
+			return ctrl.Result{RequeueAfter: promotionLockRetryInterval}, nil
+		}
+		if !leased {
+			logger.Debug(
+				"another controller replica holds the Lease of the Stage; " +
+					"waiting for it to release it",
+			)
+			return ctrl.Result{RequeueAfter: promotionLockRetryInterval}, nil
+		}
+
+		ok, replacedPromos := r.promoLock.tryAcquire(
+			req.NamespacedName,
+			stageRepoURLs(stage),
+			stage.Spec.ConcurrencyPolicy,
+		)
+		if !ok {
+			logger.Debug(
+				"another Promotion is updating the same Git repositories; " +
+					"waiting for it to conclude",
+			)
+			r.releasePromotionLease(ctx, stage)
+			return ctrl.Result{RequeueAfter: promotionLockRetryInterval}, nil
+		}
+		for _, replacedPromo := range replacedPromos {
+			logger.Info(
+				"replaced Promotion updating the same Git repositories",
+				"replacedPromotion", replacedPromo.Name,
+				"replacedPromotionNamespace", replacedPromo.Namespace,
+			)
+			// Make sure the replaced Promotion is reconciled promptly so that it
+			// concludes.
+			if _, err = kargoapi.RefreshPromotion(ctx, r.kargoClient, replacedPromo); err != nil {
+				logger.Error(
+					err, "error refreshing replaced Promotion",
+					"replacedPromotion", replacedPromo.Name,
+					"replacedPromotionNamespace", replacedPromo.Namespace,
+				)
+			}
+		}
+
+		promoCtx, finishExecution := r.promoLock.execute(
+			logging.ContextWithLogger(ctx, logger),
+			req.NamespacedName,
+		)
 
-	// Wrap the promoteFn() call in an anonymous function to recover() any panics, so
-	// we can update the promo's phase with Error if it does. This breaks an infinite
-	// cycle of a bad promo continuously failing to reconcile, and surfaces the error.
-	func() {
-		defer func() {
-			if err := recover(); err != nil {
-				if theErr, ok := err.(error); ok {
-					logger.Error(theErr, "Promotion panic")
-				} else {
-					logger.Error(nil, "Promotion panic")
+		// Wrap the promoteFn() call in an anonymous function to recover() any panics, so
+		// we can update the promo's phase with Error if it does. This breaks an infinite
+		// cycle of a bad promo continuously failing to reconcile, and surfaces the error.
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					if theErr, ok := err.(error); ok {
+						logger.Error(theErr, "Promotion panic")
+					} else {
+						logger.Error(nil, "Promotion panic")
+					}
+					newStatus.Phase = kargoapi.PromotionPhaseErrored
+					newStatus.Message = fmt.Sprintf("%v", err)
 				}
+			}()
+			otherStatus, promoteErr := r.promoteFn(
+				promoCtx,
+				*promo,
+				stage,
+				freight,
+			)
+			var timeoutErr *promotion.ErrPromotionTimeout
+			if errors.As(promoteErr, &timeoutErr) {
+				newStatus.Phase = kargoapi.PromotionPhaseFailed
+				newStatus.Message = promoteErr.Error()
+				timedOut = true
+				logger.Info("Promotion timed out", "timeout", timeoutErr.Timeout)
+			} else if promoteErr != nil {
 				newStatus.Phase = kargoapi.PromotionPhaseErrored
-				newStatus.Message = fmt.Sprintf("%v", err)
+				newStatus.Message = promoteErr.Error()
+				logger.Error(promoteErr, "error executing Promotion")
+			} else {
+				// The status returned by promoteFn does not carry the Promotion's
+				// conditions over.
+				otherStatus.Conditions = newStatus.Conditions
+				newStatus = otherStatus
 			}
 		}()
-		otherStatus, promoteErr := r.promoteFn(
-			promoCtx,
-			*promo,
-			stage,
-			freight,
-		)
-		if promoteErr != nil {
-			newStatus.Phase = kargoapi.PromotionPhaseErrored
-			newStatus.Message = promoteErr.Error()
-			logger.Error(promoteErr, "error executing Promotion")
-		} else {
-			newStatus = otherStatus
+
+		// If another Promotion replaced this one while it was being executed, the
+		// Promotion has failed, unless it managed to succeed regardless.
+		var replacedErr *errPromotionReplaced
+		if newStatus.Phase != kargoapi.PromotionPhaseSucceeded &&
+			errors.As(context.Cause(promoCtx), &replacedErr) {
+			newStatus.Phase = kargoapi.PromotionPhaseFailed
+			newStatus.Message = replacedErr.Error()
 		}
-	}()
+		finishExecution()
+		r.releasePromotionLease(ctx, stage)
+	}
 
 	if newStatus.Phase.IsTerminal() {
 		newStatus.FinishedAt = &metav1.Time{Time: time.Now()}
 		logger.Info("promotion", "phase", newStatus.Phase)
+		r.promoLock.release(req.NamespacedName)
 	}
 
 	// Record the current refresh token as having been handled.
@@ -411,6 +633,9 @@ func (r *reconciler) Reconcile(
 			reason = kargoapi.EventReasonPromotionSucceeded
 		case kargoapi.PromotionPhaseFailed:
 			reason = kargoapi.EventReasonPromotionFailed
+			if timedOut {
+				reason = kargoapi.EventReasonPromotionTimedOut
+			}
 		case kargoapi.PromotionPhaseErrored:
 			reason = kargoapi.EventReasonPromotionErrored
 		}
@@ -447,6 +672,16 @@ func (r *reconciler) Reconcile(
 	return ctrl.Result{}, nil
 }
 
+// preflightChecksPassed returns whether the preflight checks of the provided
+// Promotion have already passed.
+func preflightChecksPassed(promo *kargoapi.Promotion) bool {
+	cond := conditions.GetCondition(
+		promo.Status.Conditions,
+		string(kargoapi.PromotionConditionTypePreflightFailed),
+	)
+	return cond != nil && cond.Status == metav1.ConditionFalse
+}
+
 func (r *reconciler) promote(
 	ctx context.Context,
 	promo kargoapi.Promotion,
@@ -468,7 +703,17 @@ func (r *reconciler) promote(
 	slices.Sort(upstreams)
 	upstreams = slices.Compact(upstreams)
 
-	if !kargoapi.IsFreightAvailable(targetFreight, stageName, upstreams) {
+	available := kargoapi.IsFreightAvailable(targetFreight, stageName, upstreams)
+	// Freight requested from upstream Stages using the All availability
+	// strategy must also have been verified in every one of those Stages.
+	for _, req := range stage.Spec.RequestedFreight {
+		if req.Origin.Equals(&targetFreight.Origin) {
+			available = available &&
+				kargoapi.IsFreightAvailableFromSources(targetFreight, stageName, req.Sources)
+			break
+		}
+	}
+	if !available {
 		return nil, fmt.Errorf(
 			"Freight %q is not available to Stage %q in namespace %q",
 			promo.Spec.Freight,
@@ -479,20 +724,85 @@ func (r *reconciler) promote(
 
 	logger = logger.WithValues("targetFreight", targetFreight.Name)
 
-	targetFreightRef := kargoapi.FreightReference{
-		Name:    targetFreight.Name,
-		Commits: targetFreight.Commits,
-		Images:  targetFreight.Images,
-		Charts:  targetFreight.Charts,
-		Origin:  targetFreight.Origin,
-	}
+	targetFreightRef := newFreightReference(targetFreight)
 	targetFreightCol := r.buildTargetFreightCollection(ctx, targetFreightRef, stage)
 
+	promoCtx := ctx
+	if stage.Spec.PromotionTimeout != nil {
+		timeout := stage.Spec.PromotionTimeout.Duration
+		var cancel context.CancelFunc
+		promoCtx, cancel = context.WithTimeoutCause(
+			ctx,
+			timeout,
+			&promotion.ErrPromotionTimeout{Timeout: timeout},
+		)
+		defer cancel()
+	}
+
+	// Hooks are not run for dry-run Promotions, as they could make changes.
+	if stage.Spec.PrePromotionHook != nil && !stage.Spec.DryRun {
+		succeeded, err := r.runPromotionHookFn(
+			promoCtx,
+			promotionHookPre,
+			&promo,
+			targetFreightCol.ID,
+			stage.Spec.PrePromotionHook,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if !succeeded {
+			return &kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseFailed,
+				Message: fmt.Sprintf(
+					"%s hook Job %q failed",
+					promotionHookPre,
+					hookJobName(promotionHookPre, &promo),
+				),
+			}, nil
+		}
+	}
+
 	newStatus, nextFreight, err :=
-		r.promoMechanisms.Promote(ctx, stage, &promo, targetFreightCol.References())
+		r.promoMechanisms.Promote(promoCtx, stage, &promo, targetFreightCol.References())
 	if err != nil {
 		return nil, err
 	}
+
+	if newStatus.Phase == kargoapi.PromotionPhaseSucceeded &&
+		stage.Spec.PostPromotionHook != nil && !stage.Spec.DryRun {
+		var succeeded bool
+		if succeeded, err = r.runPromotionHookFn(
+			promoCtx,
+			promotionHookPost,
+			&promo,
+			targetFreightCol.ID,
+			stage.Spec.PostPromotionHook,
+		); err != nil {
+			return nil, err
+		}
+		if !succeeded {
+			// The changes made by the promotion mechanisms cannot be reverted,
+			// but the Promotion must not be considered successful.
+			newStatus.Phase = kargoapi.PromotionPhaseFailed
+			newStatus.Message = fmt.Sprintf(
+				"%s hook Job %q failed",
+				promotionHookPost,
+				hookJobName(promotionHookPost, &promo),
+			)
+		}
+	}
+	newStatus.DryRun = stage.Spec.DryRun
+	if newStatus.Phase == kargoapi.PromotionPhaseSucceeded {
+		// Record what changed in the charts that were promoted, so that it can
+		// be included in notifications of the Promotion.
+		newStatus.ChartChanges = r.buildChangelogFn(
+			ctx,
+			promo.Namespace,
+			stage.Status.FreightHistory.Current(),
+			nextFreight,
+		)
+	}
 	newStatus.Freight = &targetFreightRef
 	newStatus.FreightCollection = &kargoapi.FreightCollection{}
 	for _, freightRef := range nextFreight {
@@ -501,9 +811,10 @@ func (r *reconciler) promote(
 
 	logger.Debug("promotion", "phase", newStatus.Phase)
 
-	if newStatus.Phase == kargoapi.PromotionPhaseSucceeded {
+	if newStatus.Phase == kargoapi.PromotionPhaseSucceeded && !newStatus.DryRun {
 		// Trigger re-verification of the Stage if the promotion succeeded and
-		// this is a re-promotion of the same Freight.
+		// this is a re-promotion of the same Freight. A dry-run promotion changed
+		// nothing, so there is nothing to re-verify.
 		current := stage.Status.FreightHistory.Current()
 		if current != nil && current.VerificationHistory.Current() != nil {
 			for _, f := range current.Freight {
@@ -529,6 +840,17 @@ func (r *reconciler) promote(
 	return newStatus, nil
 }
 
+// newFreightReference returns a FreightReference to the provided Freight.
+func newFreightReference(freight *kargoapi.Freight) kargoapi.FreightReference {
+	return kargoapi.FreightReference{
+		Name:    freight.Name,
+		Commits: freight.Commits,
+		Images:  freight.Images,
+		Charts:  freight.Charts,
+		Origin:  freight.Origin,
+	}
+}
+
 // buildTargetFreightCollection constructs a FreightCollection that contains all
 // FreightReferences from the previous Promotion (excepting those that are no
 // longer requested), plus a FreightReference for the provided targetFreight.
@@ -545,9 +867,18 @@ func (r *reconciler) buildTargetFreightCollection(
 	// longer requested by the Stage.
 	if len(stage.Spec.RequestedFreight) > 1 {
 		lastPromo := stage.Status.LastPromotion
-		if lastPromo.Status != nil && lastPromo.Status.FreightCollection != nil {
+		var lastFreightCol *kargoapi.FreightCollection
+		if lastPromo.Status != nil {
+			lastFreightCol = lastPromo.Status.FreightCollection
+			if lastPromo.Status.DryRun {
+				// A dry-run promotion did not change the Freight in use by the
+				// Stage, so inherit from the Stage's Freight history instead.
+				lastFreightCol = stage.Status.FreightHistory.Current()
+			}
+		}
+		if lastFreightCol != nil {
 			for _, req := range stage.Spec.RequestedFreight {
-				if freight, ok := lastPromo.Status.FreightCollection.Freight[req.Origin.String()]; ok {
+				if freight, ok := lastFreightCol.Freight[req.Origin.String()]; ok {
 					freightCol.UpdateOrPush(freight)
 				}
 			}
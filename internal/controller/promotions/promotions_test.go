@@ -3,9 +3,12 @@ package promotions
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -15,6 +18,8 @@ import (
 
 	"github.com/akuity/kargo/api/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libargocd "github.com/akuity/kargo/internal/argocd"
+	"github.com/akuity/kargo/internal/controller/promotion"
 	"github.com/akuity/kargo/internal/credentials"
 	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
 )
@@ -24,14 +29,21 @@ func TestNewPromotionReconciler(t *testing.T) {
 	r := newReconciler(
 		kubeClient,
 		kubeClient,
+		libargocd.NewRemoteClients(&credentials.FakeDB{}),
 		&fakeevent.EventRecorder{},
 		&credentials.FakeDB{},
+		kubeClient,
 		ReconcilerConfig{},
 	)
 	require.NotNil(t, r.kargoClient)
+	require.NotNil(t, r.leaseClient)
+	require.NotEmpty(t, r.leaseHolderIdentity)
 	require.NotNil(t, r.pqs.pendingPromoQueuesByStage)
 	require.NotNil(t, r.getStageFn)
 	require.NotNil(t, r.promoteFn)
+	require.NotNil(t, r.preflightChecksFn)
+	require.NotNil(t, r.runPromotionHookFn)
+	require.NotNil(t, r.buildChangelogFn)
 }
 
 func newFakeReconciler(
@@ -41,14 +53,17 @@ func newFakeReconciler(
 ) *reconciler {
 	scheme := k8sruntime.NewScheme()
 	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
 	kargoClient := fake.NewClientBuilder().WithScheme(scheme).
 		WithObjects(objects...).WithStatusSubresource(objects...).Build()
 	kubeClient := fake.NewClientBuilder().Build()
 	return newReconciler(
 		kargoClient,
 		kubeClient,
+		libargocd.NewRemoteClients(&credentials.FakeDB{}),
 		recorder,
 		&credentials.FakeDB{},
+		fake.NewClientBuilder().Build(),
 		ReconcilerConfig{},
 	)
 }
@@ -59,11 +74,14 @@ func TestReconcile(t *testing.T) {
 		promos    []client.Object
 		promoteFn func(context.Context, v1alpha1.Promotion,
 			*v1alpha1.Freight) (*kargoapi.PromotionStatus, error)
+		preflightChecksFn func(context.Context, string, kargoapi.FreightCollection,
+			*kargoapi.PromotionMechanisms) error
 		promoToReconcile      *types.NamespacedName // if nil, uses the first of the promos
 		expectPromoteFnCalled bool
 		expectedPhase         kargoapi.PromotionPhase
 		expectedEventRecorded bool
 		expectedEventReason   string
+		expectedConditions    []metav1.Condition
 	}{
 		{
 			name:                  "normal reconcile",
@@ -178,6 +196,170 @@ func TestReconcile(t *testing.T) {
 				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now),
 			},
 		},
+		{
+			name:                  "preflight checks fail",
+			expectPromoteFnCalled: false,
+			expectedPhase:         kargoapi.PromotionPhaseRunning,
+			expectedEventRecorded: false,
+			expectedConditions: []metav1.Condition{{
+				Type:    string(kargoapi.PromotionConditionTypePreflightFailed),
+				Status:  metav1.ConditionTrue,
+				Reason:  "PreflightFailed",
+				Message: `Git repository "https://github.com/example/repo.git" is unreachable`,
+			}},
+			promos: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Spec: kargoapi.StageSpec{
+						PromotionMechanisms: &kargoapi.PromotionMechanisms{
+							GitRepoUpdates: []kargoapi.GitRepoUpdate{{
+								RepoURL: "https://github.com/example/repo.git",
+							}},
+						},
+					},
+					Status: kargoapi.StageStatus{
+						CurrentPromotion: &kargoapi.PromotionReference{
+							Name: "fake-promo",
+						},
+					},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-freight",
+						Namespace: "fake-namespace",
+					},
+				},
+				func() client.Object {
+					promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now)
+					promo.Spec.Freight = "fake-freight"
+					return promo
+				}(),
+			},
+			promoToReconcile: &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
+			preflightChecksFn: func(
+				context.Context,
+				string,
+				kargoapi.FreightCollection,
+				*kargoapi.PromotionMechanisms,
+			) error {
+				return errors.New(`Git repository "https://github.com/example/repo.git" is unreachable`)
+			},
+		},
+		{
+			name:                  "preflight checks pass",
+			expectPromoteFnCalled: true,
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			expectedEventRecorded: true,
+			expectedEventReason:   kargoapi.EventReasonPromotionSucceeded,
+			expectedConditions: []metav1.Condition{{
+				Type:    string(kargoapi.PromotionConditionTypePreflightFailed),
+				Status:  metav1.ConditionFalse,
+				Reason:  "PreflightSucceeded",
+				Message: "All preflight checks passed",
+			}},
+			promos: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Spec: kargoapi.StageSpec{
+						PromotionMechanisms: &kargoapi.PromotionMechanisms{
+							GitRepoUpdates: []kargoapi.GitRepoUpdate{{
+								RepoURL: "https://github.com/example/repo.git",
+							}},
+						},
+					},
+					Status: kargoapi.StageStatus{
+						CurrentPromotion: &kargoapi.PromotionReference{
+							Name: "fake-promo",
+						},
+					},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-freight",
+						Namespace: "fake-namespace",
+					},
+				},
+				func() client.Object {
+					promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now)
+					promo.Spec.Freight = "fake-freight"
+					return promo
+				}(),
+			},
+			promoToReconcile: &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
+			preflightChecksFn: func(
+				context.Context,
+				string,
+				kargoapi.FreightCollection,
+				*kargoapi.PromotionMechanisms,
+			) error {
+				return nil
+			},
+		},
+		{
+			name:                  "preflight checks already passed",
+			expectPromoteFnCalled: true,
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			expectedEventRecorded: true,
+			expectedEventReason:   kargoapi.EventReasonPromotionSucceeded,
+			expectedConditions: []metav1.Condition{{
+				Type:    string(kargoapi.PromotionConditionTypePreflightFailed),
+				Status:  metav1.ConditionFalse,
+				Reason:  "PreflightSucceeded",
+				Message: "All preflight checks passed",
+			}},
+			promos: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Spec: kargoapi.StageSpec{
+						PromotionMechanisms: &kargoapi.PromotionMechanisms{
+							GitRepoUpdates: []kargoapi.GitRepoUpdate{{
+								RepoURL: "https://github.com/example/repo.git",
+							}},
+						},
+					},
+					Status: kargoapi.StageStatus{
+						CurrentPromotion: &kargoapi.PromotionReference{
+							Name: "fake-promo",
+						},
+					},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-freight",
+						Namespace: "fake-namespace",
+					},
+				},
+				func() client.Object {
+					promo := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now)
+					promo.Spec.Freight = "fake-freight"
+					promo.Status.Conditions = []metav1.Condition{{
+						Type:    string(kargoapi.PromotionConditionTypePreflightFailed),
+						Status:  metav1.ConditionFalse,
+						Reason:  "PreflightSucceeded",
+						Message: "All preflight checks passed",
+					}}
+					return promo
+				}(),
+			},
+			promoToReconcile: &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
+			preflightChecksFn: func(
+				context.Context,
+				string,
+				kargoapi.FreightCollection,
+				*kargoapi.PromotionMechanisms,
+			) error {
+				return errors.New("preflight checks should not have been performed again")
+			},
+		},
 		{
 			name:                  "promoteFn panics",
 			expectPromoteFnCalled: true,
@@ -228,6 +410,34 @@ func TestReconcile(t *testing.T) {
 				return nil, errors.New("expected error")
 			},
 		},
+		{
+			name:                  "promoteFn times out",
+			expectPromoteFnCalled: true,
+			expectedPhase:         kargoapi.PromotionPhaseFailed,
+			expectedEventRecorded: true,
+			expectedEventReason:   kargoapi.EventReasonPromotionTimedOut,
+			promos: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Status: kargoapi.StageStatus{
+						CurrentPromotion: &kargoapi.PromotionReference{
+							Name: "fake-promo",
+						},
+					},
+				},
+				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, before),
+			},
+			promoToReconcile: &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
+			promoteFn: func(_ context.Context, _ v1alpha1.Promotion, _ *v1alpha1.Freight) (*kargoapi.PromotionStatus, error) {
+				return nil, fmt.Errorf(
+					"error executing promotion mechanisms: %w",
+					&promotion.ErrPromotionTimeout{Timeout: time.Millisecond},
+				)
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -247,6 +457,9 @@ func TestReconcile(t *testing.T) {
 				}
 				return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded}, nil
 			}
+			if tc.preflightChecksFn != nil {
+				r.preflightChecksFn = tc.preflightChecksFn
+			}
 			var req ctrl.Request
 			if tc.promoToReconcile != nil {
 				req = ctrl.Request{NamespacedName: *tc.promoToReconcile}
@@ -267,6 +480,14 @@ func TestReconcile(t *testing.T) {
 				err = r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo)
 				require.NoError(t, err)
 				require.Equal(t, tc.expectedPhase, updatedPromo.Status.Phase)
+				require.Len(t, updatedPromo.Status.Conditions, len(tc.expectedConditions))
+				for i, expected := range tc.expectedConditions {
+					actual := updatedPromo.Status.Conditions[i]
+					require.Equal(t, expected.Type, actual.Type)
+					require.Equal(t, expected.Status, actual.Status)
+					require.Equal(t, expected.Reason, actual.Reason)
+					require.Equal(t, expected.Message, actual.Message)
+				}
 				if tc.expectedEventRecorded {
 					require.Len(t, recorder.Events, 1)
 					event := <-recorder.Events
@@ -297,3 +518,622 @@ func TestReconcileInitializeQueues(t *testing.T) {
 	stageKey := types.NamespacedName{Namespace: "fake-namespace", Name: "fake-stage"}
 	require.Equal(t, 2, r.pqs.pendingPromoQueuesByStage[stageKey].Depth())
 }
+
+func TestReconcileConcurrencyPolicy(t *testing.T) {
+	const testNamespace = "fake-namespace"
+	promoA := types.NamespacedName{Namespace: testNamespace, Name: "fake-promo-a"}
+	promoB := types.NamespacedName{Namespace: testNamespace, Name: "fake-promo-b"}
+
+	// newStage returns a Stage awaiting the specified Promotion whose promotion
+	// mechanisms update the same Git repository as every other such Stage.
+	newStage := func(
+		name string,
+		promo string,
+		policy kargoapi.ConcurrencyPolicy,
+	) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+			Spec: kargoapi.StageSpec{
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{
+					GitRepoUpdates: []kargoapi.GitRepoUpdate{{
+						RepoURL: "https://github.com/akuity/kargo-demo.git",
+					}},
+				},
+				ConcurrencyPolicy: policy,
+			},
+			Status: kargoapi.StageStatus{
+				CurrentPromotion: &kargoapi.PromotionReference{Name: promo},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		policyB    kargoapi.ConcurrencyPolicy
+		assertions func(
+			t *testing.T,
+			r *reconciler,
+			reconcileB func() (ctrl.Result, bool),
+			releaseA func(),
+		)
+	}{
+		{
+			name:    "Forbid waits for the in-flight Promotion",
+			policyB: kargoapi.ConcurrencyPolicyForbid,
+			assertions: func(
+				t *testing.T,
+				r *reconciler,
+				reconcileB func() (ctrl.Result, bool),
+				releaseA func(),
+			) {
+				res, promoteCalled := reconcileB()
+				require.False(t, promoteCalled)
+				require.Equal(t, promotionLockRetryInterval, res.RequeueAfter)
+
+				releaseA()
+				requirePromoPhase(t, r, promoA, kargoapi.PromotionPhaseSucceeded)
+
+				res, promoteCalled = reconcileB()
+				require.True(t, promoteCalled)
+				require.Zero(t, res.RequeueAfter)
+				requirePromoPhase(t, r, promoB, kargoapi.PromotionPhaseSucceeded)
+			},
+		},
+		{
+			name:    "Replace aborts the in-flight Promotion",
+			policyB: kargoapi.ConcurrencyPolicyReplace,
+			assertions: func(
+				t *testing.T,
+				r *reconciler,
+				reconcileB func() (ctrl.Result, bool),
+				releaseA func(),
+			) {
+				_, promoteCalled := reconcileB()
+				require.True(t, promoteCalled)
+				requirePromoPhase(t, r, promoB, kargoapi.PromotionPhaseSucceeded)
+
+				releaseA()
+				promo := requirePromoPhase(t, r, promoA, kargoapi.PromotionPhaseFailed)
+				require.Contains(t, promo.Status.Message, `replaced by Promotion "fake-promo-b"`)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := newFakeReconciler(
+				t,
+				fakeevent.NewEventRecorder(10),
+				newStage("fake-stage-a", promoA.Name, kargoapi.ConcurrencyPolicyForbid),
+				newStage("fake-stage-b", promoB.Name, tc.policyB),
+				newPromo(testNamespace, promoA.Name, "fake-stage-a", kargoapi.PromotionPhasePending, before),
+				newPromo(testNamespace, promoB.Name, "fake-stage-b", kargoapi.PromotionPhasePending, now),
+			)
+
+			// Promotion A blocks until it is released or its context is canceled.
+			aStarted := make(chan struct{})
+			aRelease := make(chan struct{})
+			var bCalled bool
+			r.promoteFn = func(
+				ctx context.Context,
+				p kargoapi.Promotion,
+				_ *kargoapi.Stage,
+				_ *kargoapi.Freight,
+			) (*kargoapi.PromotionStatus, error) {
+				if p.Name == promoA.Name {
+					close(aStarted)
+					select {
+					case <-aRelease:
+					case <-ctx.Done():
+						return nil, ctx.Err()
+					}
+				} else {
+					bCalled = true
+				}
+				return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded}, nil
+			}
+
+			aDone := make(chan error)
+			go func() {
+				_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: promoA})
+				aDone <- err
+			}()
+			<-aStarted
+
+			reconcileB := func() (ctrl.Result, bool) {
+				bCalled = false
+				res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: promoB})
+				require.NoError(t, err)
+				return res, bCalled
+			}
+			releaseA := func() {
+				close(aRelease)
+				require.NoError(t, <-aDone)
+			}
+
+			tc.assertions(t, r, reconcileB, releaseA)
+		})
+	}
+}
+
+func TestReconcilePromotionLease(t *testing.T) {
+	const testNamespace = "fake-namespace"
+	promoKey := types.NamespacedName{Namespace: testNamespace, Name: "fake-promo"}
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-stage",
+			Namespace: testNamespace,
+		},
+		Status: kargoapi.StageStatus{
+			CurrentPromotion: &kargoapi.PromotionReference{Name: promoKey.Name},
+		},
+	}
+
+	r := newFakeReconciler(
+		t,
+		fakeevent.NewEventRecorder(10),
+		stage,
+		newPromo(testNamespace, promoKey.Name, stage.Name, kargoapi.PromotionPhasePending, now),
+	)
+	var promoteCalled bool
+	r.promoteFn = func(
+		context.Context,
+		kargoapi.Promotion,
+		*kargoapi.Stage,
+		*kargoapi.Freight,
+	) (*kargoapi.PromotionStatus, error) {
+		promoteCalled = true
+		return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded}, nil
+	}
+
+	// Simulate another replica of the controller holding the Lease
+	competitor := newFakeReconciler(t, fakeevent.NewEventRecorder(10))
+	competitor.leaseClient = r.leaseClient
+	acquired, err := competitor.tryAcquirePromotionLease(context.Background(), stage)
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	res, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: promoKey})
+	require.NoError(t, err)
+	require.Equal(t, promotionLockRetryInterval, res.RequeueAfter)
+	require.False(t, promoteCalled)
+	requirePromoPhase(t, r, promoKey, kargoapi.PromotionPhaseRunning)
+
+	// Once the other replica releases the Lease, the Promotion is executed
+	competitor.releasePromotionLease(context.Background(), stage)
+	res, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: promoKey})
+	require.NoError(t, err)
+	require.Zero(t, res.RequeueAfter)
+	require.True(t, promoteCalled)
+	requirePromoPhase(t, r, promoKey, kargoapi.PromotionPhaseSucceeded)
+
+	// The Lease is released once the Promotion has been executed
+	acquired, err = competitor.tryAcquirePromotionLease(context.Background(), stage)
+	require.NoError(t, err)
+	require.True(t, acquired)
+}
+
+func TestReconcilePipelineWaves(t *testing.T) {
+	const testNamespace = "fake-namespace"
+	const testPipeline = "fake-pipeline"
+	promoKey := types.NamespacedName{Namespace: testNamespace, Name: "fake-promo"}
+	lowerStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-stage-wave-0",
+			Namespace: testNamespace,
+			Labels:    map[string]string{kargoapi.PipelineLabelKey: testPipeline},
+		},
+		Spec: kargoapi.StageSpec{
+			Pipeline: testPipeline,
+		},
+		Status: kargoapi.StageStatus{
+			Health: &kargoapi.Health{Status: kargoapi.HealthStateUnhealthy},
+		},
+	}
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-stage-wave-1",
+			Namespace: testNamespace,
+			Labels:    map[string]string{kargoapi.PipelineLabelKey: testPipeline},
+		},
+		Spec: kargoapi.StageSpec{
+			Pipeline: testPipeline,
+			Wave:     1,
+		},
+		Status: kargoapi.StageStatus{
+			CurrentPromotion: &kargoapi.PromotionReference{Name: promoKey.Name},
+		},
+	}
+
+	r := newFakeReconciler(
+		t,
+		fakeevent.NewEventRecorder(10),
+		lowerStage,
+		stage,
+		newPromo(testNamespace, promoKey.Name, stage.Name, kargoapi.PromotionPhasePending, now),
+	)
+	var promoteCalled bool
+	r.promoteFn = func(
+		context.Context,
+		kargoapi.Promotion,
+		*kargoapi.Stage,
+		*kargoapi.Freight,
+	) (*kargoapi.PromotionStatus, error) {
+		promoteCalled = true
+		return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded}, nil
+	}
+
+	// The degraded Stage in the lower wave blocks the Promotion
+	res, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: promoKey})
+	require.NoError(t, err)
+	require.Equal(t, promotionLockRetryInterval, res.RequeueAfter)
+	require.False(t, promoteCalled)
+	requirePromoPhase(t, r, promoKey, kargoapi.PromotionPhaseRunning)
+
+	// Once it is healthy, the Promotion is executed
+	require.NoError(t, r.kargoClient.Get(
+		context.Background(),
+		client.ObjectKeyFromObject(lowerStage),
+		lowerStage,
+	))
+	lowerStage.Status.Health.Status = kargoapi.HealthStateHealthy
+	require.NoError(t, r.kargoClient.Status().Update(context.Background(), lowerStage))
+	res, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: promoKey})
+	require.NoError(t, err)
+	require.Zero(t, res.RequeueAfter)
+	require.True(t, promoteCalled)
+	requirePromoPhase(t, r, promoKey, kargoapi.PromotionPhaseSucceeded)
+}
+
+func requirePromoPhase(
+	t *testing.T,
+	r *reconciler,
+	promoKey types.NamespacedName,
+	phase kargoapi.PromotionPhase,
+) *kargoapi.Promotion {
+	promo := &kargoapi.Promotion{}
+	require.NoError(t, r.kargoClient.Get(context.Background(), promoKey, promo))
+	require.Equal(t, phase, promo.Status.Phase)
+	return promo
+}
+
+func TestPromoteFreightAvailability(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sources    kargoapi.FreightSources
+		verifiedIn []string
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "verified in one of two upstream Stages with OneOf strategy",
+			sources: kargoapi.FreightSources{
+				Stages: []string{"fake-upstream-1", "fake-upstream-2"},
+			},
+			verifiedIn: []string{"fake-upstream-1"},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "verified in two of two upstream Stages with All strategy",
+			sources: kargoapi.FreightSources{
+				Stages:               []string{"fake-upstream-1", "fake-upstream-2"},
+				AvailabilityStrategy: kargoapi.FreightAvailabilityStrategyAll,
+			},
+			verifiedIn: []string{"fake-upstream-1", "fake-upstream-2"},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "verified in one of two upstream Stages with All strategy",
+			sources: kargoapi.FreightSources{
+				Stages:               []string{"fake-upstream-1", "fake-upstream-2"},
+				AvailabilityStrategy: kargoapi.FreightAvailabilityStrategyAll,
+			},
+			verifiedIn: []string{"fake-upstream-1"},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "is not available to Stage")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := newFakeReconciler(t, &fakeevent.EventRecorder{})
+			r.promoMechanisms = &fakeMechanism{
+				promoteFn: func(context.Context) (*kargoapi.PromotionStatus, error) {
+					return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded}, nil
+				},
+			}
+			origin := kargoapi.FreightOrigin{
+				Kind: kargoapi.FreightOriginKindWarehouse,
+				Name: "fake-warehouse",
+			}
+			freight := &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-freight",
+					Namespace: "fake-namespace",
+				},
+				Origin: origin,
+				Status: kargoapi.FreightStatus{
+					VerifiedIn: map[string]kargoapi.VerifiedStage{},
+				},
+			}
+			for _, upstream := range testCase.verifiedIn {
+				freight.Status.VerifiedIn[upstream] = kargoapi.VerifiedStage{}
+			}
+			_, err := r.promote(
+				context.Background(),
+				kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-promo",
+						Namespace: "fake-namespace",
+					},
+					Spec: kargoapi.PromotionSpec{
+						Stage:   "fake-stage",
+						Freight: "fake-freight",
+					},
+				},
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Spec: kargoapi.StageSpec{
+						RequestedFreight: []kargoapi.FreightRequest{{
+							Origin:  origin,
+							Sources: testCase.sources,
+						}},
+					},
+				},
+				freight,
+			)
+			testCase.assertions(t, err)
+		})
+	}
+}
+
+func TestPromoteTimeout(t *testing.T) {
+	const timeout = time.Millisecond
+	r := newFakeReconciler(t, &fakeevent.EventRecorder{})
+	r.promoMechanisms = &fakeMechanism{
+		promoteFn: func(ctx context.Context) (*kargoapi.PromotionStatus, error) {
+			<-ctx.Done()
+			return nil, context.Cause(ctx)
+		},
+	}
+	_, err := r.promote(
+		context.Background(),
+		kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-promo",
+				Namespace: "fake-namespace",
+			},
+			Spec: kargoapi.PromotionSpec{
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+			},
+		},
+		&kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-stage",
+				Namespace: "fake-namespace",
+			},
+			Spec: kargoapi.StageSpec{
+				PromotionTimeout: &metav1.Duration{Duration: timeout},
+			},
+		},
+		&kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-freight",
+				Namespace: "fake-namespace",
+			},
+		},
+	)
+	var timeoutErr *promotion.ErrPromotionTimeout
+	require.True(t, errors.As(err, &timeoutErr))
+	require.Equal(t, timeout, timeoutErr.Timeout)
+}
+
+func TestPromoteHooks(t *testing.T) {
+	testHook := &kargoapi.JobTemplate{}
+	testCases := []struct {
+		name string
+		// failedHook is the hook whose Job fails, if any.
+		failedHook    promotionHook
+		dryRun        bool
+		expectedCalls []string
+		expectedPhase kargoapi.PromotionPhase
+	}{
+		{
+			name: "hooks succeed",
+			expectedCalls: []string{
+				string(promotionHookPre),
+				"promote",
+				string(promotionHookPost),
+			},
+			expectedPhase: kargoapi.PromotionPhaseSucceeded,
+		},
+		{
+			name:          "pre-promotion hook fails",
+			failedHook:    promotionHookPre,
+			expectedCalls: []string{string(promotionHookPre)},
+			expectedPhase: kargoapi.PromotionPhaseFailed,
+		},
+		{
+			name:       "post-promotion hook fails",
+			failedHook: promotionHookPost,
+			expectedCalls: []string{
+				string(promotionHookPre),
+				"promote",
+				string(promotionHookPost),
+			},
+			expectedPhase: kargoapi.PromotionPhaseFailed,
+		},
+		{
+			name:          "hooks are skipped for dry runs",
+			dryRun:        true,
+			expectedCalls: []string{"promote"},
+			expectedPhase: kargoapi.PromotionPhaseSucceeded,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var calls []string
+			r := newFakeReconciler(t, &fakeevent.EventRecorder{})
+			r.runPromotionHookFn = func(
+				_ context.Context,
+				hook promotionHook,
+				_ *kargoapi.Promotion,
+				_ string,
+				tmpl *kargoapi.JobTemplate,
+			) (bool, error) {
+				require.Same(t, testHook, tmpl)
+				calls = append(calls, string(hook))
+				return hook != testCase.failedHook, nil
+			}
+			r.promoMechanisms = &fakeMechanism{
+				promoteFn: func(context.Context) (*kargoapi.PromotionStatus, error) {
+					calls = append(calls, "promote")
+					return &kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhaseSucceeded}, nil
+				},
+			}
+			status, err := r.promote(
+				context.Background(),
+				kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-promo",
+						Namespace: "fake-namespace",
+					},
+					Spec: kargoapi.PromotionSpec{
+						Stage:   "fake-stage",
+						Freight: "fake-freight",
+					},
+				},
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Spec: kargoapi.StageSpec{
+						DryRun:            testCase.dryRun,
+						PrePromotionHook:  testHook,
+						PostPromotionHook: testHook,
+					},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-freight",
+						Namespace: "fake-namespace",
+					},
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedCalls, calls)
+			require.Equal(t, testCase.expectedPhase, status.Phase)
+			if testCase.failedHook != "" {
+				require.Contains(t, status.Message, string(testCase.failedHook)+" hook Job")
+			}
+		})
+	}
+}
+
+func TestPromoteRecordsChartChanges(t *testing.T) {
+	testChanges := []kargoapi.ChartChange{{
+		RepoURL:     "https://charts.example.com",
+		Name:        "fake-chart",
+		Version:     "1.1.0",
+		Description: "Added a feature",
+	}}
+	testCases := []struct {
+		name            string
+		phase           kargoapi.PromotionPhase
+		expectedChanges []kargoapi.ChartChange
+	}{
+		{
+			name:            "promotion succeeds",
+			phase:           kargoapi.PromotionPhaseSucceeded,
+			expectedChanges: testChanges,
+		},
+		{
+			name:  "promotion is still running",
+			phase: kargoapi.PromotionPhaseRunning,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			currentFreight := &kargoapi.FreightCollection{}
+			r := newFakeReconciler(t, &fakeevent.EventRecorder{})
+			r.promoMechanisms = &fakeMechanism{
+				promoteFn: func(context.Context) (*kargoapi.PromotionStatus, error) {
+					return &kargoapi.PromotionStatus{Phase: testCase.phase}, nil
+				},
+			}
+			r.buildChangelogFn = func(
+				_ context.Context,
+				namespace string,
+				current *kargoapi.FreightCollection,
+				newFreight []kargoapi.FreightReference,
+			) []kargoapi.ChartChange {
+				require.Equal(t, "fake-namespace", namespace)
+				require.Same(t, currentFreight, current)
+				require.Len(t, newFreight, 1)
+				require.Equal(t, "fake-freight", newFreight[0].Name)
+				return testChanges
+			}
+			status, err := r.promote(
+				context.Background(),
+				kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-promo",
+						Namespace: "fake-namespace",
+					},
+					Spec: kargoapi.PromotionSpec{
+						Stage:   "fake-stage",
+						Freight: "fake-freight",
+					},
+				},
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Status: kargoapi.StageStatus{
+						FreightHistory: kargoapi.FreightHistory{currentFreight},
+					},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-freight",
+						Namespace: "fake-namespace",
+					},
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedChanges, status.ChartChanges)
+		})
+	}
+}
+
+// fakeMechanism is a promotion.Mechanism whose Promote method is implemented
+// by a function.
+type fakeMechanism struct {
+	promoteFn func(context.Context) (*kargoapi.PromotionStatus, error)
+}
+
+func (f *fakeMechanism) GetName() string {
+	return "fake promotion mechanism"
+}
+
+func (f *fakeMechanism) Promote(
+	ctx context.Context,
+	_ *kargoapi.Stage,
+	_ *kargoapi.Promotion,
+	freight []kargoapi.FreightReference,
+) (*kargoapi.PromotionStatus, []kargoapi.FreightReference, error) {
+	status, err := f.promoteFn(ctx)
+	return status, freight, err
+}
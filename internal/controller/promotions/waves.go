@@ -0,0 +1,60 @@
+package promotions
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// getUnhealthyLowerWaveStages returns the names of all Stages that belong to
+// the same pipeline as the provided Stage, but to a lower wave, and that are
+// not healthy, ordered by wave. Stages whose health has not been assessed are
+// not considered healthy. Promotions to the provided Stage must not be
+// executed for as long as any such Stage exists. If the provided Stage does
+// not belong to a pipeline, nil is returned.
+func (r *reconciler) getUnhealthyLowerWaveStages(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) ([]string, error) {
+	if stage.Spec.Pipeline == "" {
+		return nil, nil
+	}
+
+	stages := kargoapi.StageList{}
+	if err := r.kargoClient.List(
+		ctx,
+		&stages,
+		client.InNamespace(stage.Namespace),
+		client.MatchingLabels{kargoapi.PipelineLabelKey: stage.Spec.Pipeline},
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error listing Stages of pipeline %q in namespace %q: %w",
+			stage.Spec.Pipeline,
+			stage.Namespace,
+			err,
+		)
+	}
+
+	slices.SortFunc(stages.Items, func(a, b kargoapi.Stage) int {
+		if c := cmp.Compare(a.Spec.Wave, b.Spec.Wave); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	var unhealthy []string
+	for _, s := range stages.Items {
+		if s.Spec.Wave >= stage.Spec.Wave {
+			break
+		}
+		if s.Status.Health == nil || s.Status.Health.Status != kargoapi.HealthStateHealthy {
+			unhealthy = append(unhealthy, s.Name)
+		}
+	}
+	return unhealthy, nil
+}
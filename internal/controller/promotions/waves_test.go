@@ -0,0 +1,99 @@
+package promotions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
+)
+
+func TestGetUnhealthyLowerWaveStages(t *testing.T) {
+	const testNamespace = "fake-namespace"
+	const testPipeline = "fake-pipeline"
+	newStage := func(
+		name string,
+		pipeline string,
+		wave int32,
+		health kargoapi.HealthState,
+	) *kargoapi.Stage {
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      name,
+			},
+			Spec: kargoapi.StageSpec{
+				Pipeline: pipeline,
+				Wave:     wave,
+			},
+		}
+		if pipeline != "" {
+			stage.Labels = map[string]string{kargoapi.PipelineLabelKey: pipeline}
+		}
+		if health != "" {
+			stage.Status.Health = &kargoapi.Health{Status: health}
+		}
+		return stage
+	}
+	testCases := []struct {
+		name     string
+		stage    *kargoapi.Stage
+		objects  []client.Object
+		expected []string
+	}{
+		{
+			name:  "Stage does not belong to a pipeline",
+			stage: newStage("fake-stage", "", 2, ""),
+			objects: []client.Object{
+				newStage("wave-0", "", 0, kargoapi.HealthStateUnhealthy),
+			},
+		},
+		{
+			name:  "every Stage in lower waves is healthy",
+			stage: newStage("wave-2", testPipeline, 2, ""),
+			objects: []client.Object{
+				newStage("wave-0", testPipeline, 0, kargoapi.HealthStateHealthy),
+				newStage("wave-1-a", testPipeline, 1, kargoapi.HealthStateHealthy),
+				newStage("wave-1-b", testPipeline, 1, kargoapi.HealthStateHealthy),
+				newStage("wave-3", testPipeline, 3, kargoapi.HealthStateUnhealthy),
+			},
+		},
+		{
+			name:  "Stages in lower waves are degraded",
+			stage: newStage("wave-2", testPipeline, 2, ""),
+			objects: []client.Object{
+				newStage("wave-1-b", testPipeline, 1, kargoapi.HealthStateUnhealthy),
+				newStage("wave-1-a", testPipeline, 1, kargoapi.HealthStateHealthy),
+				newStage("wave-0", testPipeline, 0, ""),
+			},
+			expected: []string{"wave-0", "wave-1-b"},
+		},
+		{
+			name:  "Stages of other pipelines are ignored",
+			stage: newStage("wave-1", testPipeline, 1, ""),
+			objects: []client.Object{
+				newStage("wave-0", "other-pipeline", 0, kargoapi.HealthStateUnhealthy),
+				newStage("no-pipeline", "", 0, kargoapi.HealthStateUnhealthy),
+			},
+		},
+		{
+			name:  "Stages in the same wave are ignored",
+			stage: newStage("wave-1-a", testPipeline, 1, ""),
+			objects: []client.Object{
+				newStage("wave-1-b", testPipeline, 1, kargoapi.HealthStateUnhealthy),
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := newFakeReconciler(t, fakeevent.NewEventRecorder(1), testCase.objects...)
+			stages, err := r.getUnhealthyLowerWaveStages(context.Background(), testCase.stage)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, stages)
+		})
+	}
+}
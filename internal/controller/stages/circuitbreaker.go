@@ -0,0 +1,126 @@
+package stages
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// circuitState describes the state of a Stage's circuit breaker.
+type circuitState string
+
+const (
+	// circuitClosed denotes a circuit that permits automatic Promotions.
+	circuitClosed circuitState = "Closed"
+	// circuitOpen denotes a circuit that suspends automatic Promotions until
+	// its cooldown has elapsed.
+	circuitOpen circuitState = "Open"
+	// circuitHalfOpen denotes a circuit whose cooldown has elapsed. A single
+	// automatic Promotion is permitted to determine whether the circuit should
+	// close or open again.
+	circuitHalfOpen circuitState = "HalfOpen"
+)
+
+// getCircuitState returns the state of the circuit breaker of the provided
+// Stage at the provided time, given the provided circuit breaker status. If
+// the circuit is open, the time remaining until it becomes half-open is also
+// returned.
+func getCircuitState(
+	stage *kargoapi.Stage,
+	status *kargoapi.CircuitBreakerStatus,
+	now time.Time,
+) (circuitState, time.Duration) {
+	if stage.Spec.CircuitBreaker == nil || status == nil || status.OpenedAt == nil {
+		return circuitClosed, 0
+	}
+	openUntil := status.OpenedAt.Add(stage.Spec.CircuitBreaker.GetCooldown())
+	if remaining := openUntil.Sub(now); remaining > 0 {
+		return circuitOpen, remaining
+	}
+	return circuitHalfOpen, 0
+}
+
+// syncCircuitBreaker returns the circuit breaker status of the provided Stage
+// updated with the outcome of the provided terminated Promotion. Consecutive
+// failed or errored Promotions are counted and the circuit is opened once
+// their number reaches the Stage's threshold. A successful Promotion closes
+// the circuit. An event is recorded on the Stage whenever the circuit opens or
+// closes. If the Stage does not specify a circuit breaker, nil is returned.
+func (r *reconciler) syncCircuitBreaker(
+	stage *kargoapi.Stage,
+	status *kargoapi.CircuitBreakerStatus,
+	promo kargoapi.PromotionReference,
+) *kargoapi.CircuitBreakerStatus {
+	if stage.Spec.CircuitBreaker == nil {
+		return nil
+	}
+	if promo.Status == nil {
+		return status
+	}
+
+	switch promo.Status.Phase {
+	case kargoapi.PromotionPhaseSucceeded:
+		if status != nil && status.OpenedAt != nil {
+			r.recordCircuitEvent(
+				stage,
+				corev1.EventTypeNormal,
+				kargoapi.EventReasonCircuitClosed,
+				"Circuit closed after Promotion %q succeeded; automatic Promotions resumed",
+				promo.Name,
+			)
+		}
+		return nil
+	case kargoapi.PromotionPhaseFailed, kargoapi.PromotionPhaseErrored:
+		status = status.DeepCopy()
+		if status == nil {
+			status = &kargoapi.CircuitBreakerStatus{}
+		}
+		status.ConsecutiveFailures++
+		if status.ConsecutiveFailures < stage.Spec.CircuitBreaker.GetThreshold() {
+			return status
+		}
+		now := r.nowFn()
+		if state, _ := getCircuitState(stage, status, now); state == circuitOpen {
+			return status
+		}
+		status.OpenedAt = &metav1.Time{Time: now}
+		r.recordCircuitEvent(
+			stage,
+			corev1.EventTypeWarning,
+			kargoapi.EventReasonCircuitOpened,
+			"Circuit opened after %d consecutive failed Promotions; automatic "+
+				"Promotions suspended for %s",
+			status.ConsecutiveFailures,
+			stage.Spec.CircuitBreaker.GetCooldown(),
+		)
+		return status
+	default:
+		return status
+	}
+}
+
+// recordCircuitEvent records an event on the provided Stage describing a
+// transition of its circuit breaker.
+func (r *reconciler) recordCircuitEvent(
+	s *kargoapi.Stage,
+	eventType string,
+	reason string,
+	messageFmt string,
+	args ...any,
+) {
+	r.recorder.AnnotatedEventf(
+		s,
+		map[string]string{
+			kargoapi.AnnotationKeyEventActor:     kargoapi.FormatEventControllerActor(r.cfg.Name()),
+			kargoapi.AnnotationKeyEventProject:   s.Namespace,
+			kargoapi.AnnotationKeyEventStageName: s.Name,
+		},
+		eventType,
+		reason,
+		messageFmt,
+		args...,
+	)
+}
@@ -0,0 +1,260 @@
+package stages
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
+)
+
+func TestGetCircuitState(t *testing.T) {
+	now := fakeNow()
+	testStage := &kargoapi.Stage{
+		Spec: kargoapi.StageSpec{
+			CircuitBreaker: &kargoapi.CircuitBreaker{
+				Cooldown: metav1.Duration{Duration: 10 * time.Minute},
+			},
+		},
+	}
+	testCases := []struct {
+		name              string
+		stage             *kargoapi.Stage
+		status            *kargoapi.CircuitBreakerStatus
+		expectedState     circuitState
+		expectedRemaining time.Duration
+	}{
+		{
+			name:  "no circuit breaker",
+			stage: &kargoapi.Stage{},
+			status: &kargoapi.CircuitBreakerStatus{
+				OpenedAt: &metav1.Time{Time: now},
+			},
+			expectedState: circuitClosed,
+		},
+		{
+			name:          "no status",
+			stage:         testStage,
+			expectedState: circuitClosed,
+		},
+		{
+			name:  "circuit not opened",
+			stage: testStage,
+			status: &kargoapi.CircuitBreakerStatus{
+				ConsecutiveFailures: 2,
+			},
+			expectedState: circuitClosed,
+		},
+		{
+			name:  "cooldown not elapsed",
+			stage: testStage,
+			status: &kargoapi.CircuitBreakerStatus{
+				ConsecutiveFailures: 3,
+				OpenedAt:            &metav1.Time{Time: now.Add(-time.Minute)},
+			},
+			expectedState:     circuitOpen,
+			expectedRemaining: 9 * time.Minute,
+		},
+		{
+			name:  "cooldown elapsed",
+			stage: testStage,
+			status: &kargoapi.CircuitBreakerStatus{
+				ConsecutiveFailures: 3,
+				OpenedAt:            &metav1.Time{Time: now.Add(-10 * time.Minute)},
+			},
+			expectedState: circuitHalfOpen,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			state, remaining := getCircuitState(testCase.stage, testCase.status, now)
+			require.Equal(t, testCase.expectedState, state)
+			require.Equal(t, testCase.expectedRemaining, remaining)
+		})
+	}
+}
+
+func TestSyncCircuitBreaker(t *testing.T) {
+	now := fakeNow()
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+		Spec: kargoapi.StageSpec{
+			CircuitBreaker: &kargoapi.CircuitBreaker{
+				Threshold: 3,
+				Cooldown:  metav1.Duration{Duration: 10 * time.Minute},
+			},
+		},
+	}
+	promoWithPhase := func(phase kargoapi.PromotionPhase) kargoapi.PromotionReference {
+		return kargoapi.PromotionReference{
+			Name:   "fake-promotion",
+			Status: &kargoapi.PromotionStatus{Phase: phase},
+		}
+	}
+	testCases := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		status     *kargoapi.CircuitBreakerStatus
+		promo      kargoapi.PromotionReference
+		assertions func(*testing.T, *fakeevent.EventRecorder, *kargoapi.CircuitBreakerStatus)
+	}{
+		{
+			name: "no circuit breaker",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{},
+			},
+			status: &kargoapi.CircuitBreakerStatus{ConsecutiveFailures: 2},
+			promo:  promoWithPhase(kargoapi.PromotionPhaseFailed),
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				status *kargoapi.CircuitBreakerStatus,
+			) {
+				require.Nil(t, status)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name:  "failure below threshold",
+			stage: testStage,
+			status: &kargoapi.CircuitBreakerStatus{
+				ConsecutiveFailures: 1,
+			},
+			promo: promoWithPhase(kargoapi.PromotionPhaseErrored),
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				status *kargoapi.CircuitBreakerStatus,
+			) {
+				require.Equal(t, &kargoapi.CircuitBreakerStatus{ConsecutiveFailures: 2}, status)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name:  "failure reaching threshold opens circuit",
+			stage: testStage,
+			status: &kargoapi.CircuitBreakerStatus{
+				ConsecutiveFailures: 2,
+			},
+			promo: promoWithPhase(kargoapi.PromotionPhaseFailed),
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				status *kargoapi.CircuitBreakerStatus,
+			) {
+				require.Equal(t, &kargoapi.CircuitBreakerStatus{
+					ConsecutiveFailures: 3,
+					OpenedAt:            &metav1.Time{Time: now},
+				}, status)
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, corev1.EventTypeWarning, event.EventType)
+				require.Equal(t, kargoapi.EventReasonCircuitOpened, event.Reason)
+				require.Equal(
+					t,
+					"Circuit opened after 3 consecutive failed Promotions; automatic "+
+						"Promotions suspended for 10m0s",
+					event.Message,
+				)
+				require.Equal(t, "fake-namespace", event.Annotations[kargoapi.AnnotationKeyEventProject])
+				require.Equal(t, "fake-stage", event.Annotations[kargoapi.AnnotationKeyEventStageName])
+			},
+		},
+		{
+			name:  "failure while open leaves circuit open",
+			stage: testStage,
+			status: &kargoapi.CircuitBreakerStatus{
+				ConsecutiveFailures: 3,
+				OpenedAt:            &metav1.Time{Time: now.Add(-time.Minute)},
+			},
+			promo: promoWithPhase(kargoapi.PromotionPhaseFailed),
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				status *kargoapi.CircuitBreakerStatus,
+			) {
+				require.Equal(t, &kargoapi.CircuitBreakerStatus{
+					ConsecutiveFailures: 4,
+					OpenedAt:            &metav1.Time{Time: now.Add(-time.Minute)},
+				}, status)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name:  "failure while half-open opens circuit again",
+			stage: testStage,
+			status: &kargoapi.CircuitBreakerStatus{
+				ConsecutiveFailures: 3,
+				OpenedAt:            &metav1.Time{Time: now.Add(-time.Hour)},
+			},
+			promo: promoWithPhase(kargoapi.PromotionPhaseFailed),
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				status *kargoapi.CircuitBreakerStatus,
+			) {
+				require.Equal(t, &kargoapi.CircuitBreakerStatus{
+					ConsecutiveFailures: 4,
+					OpenedAt:            &metav1.Time{Time: now},
+				}, status)
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonCircuitOpened, event.Reason)
+			},
+		},
+		{
+			name:  "success while half-open closes circuit",
+			stage: testStage,
+			status: &kargoapi.CircuitBreakerStatus{
+				ConsecutiveFailures: 3,
+				OpenedAt:            &metav1.Time{Time: now.Add(-time.Hour)},
+			},
+			promo: promoWithPhase(kargoapi.PromotionPhaseSucceeded),
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				status *kargoapi.CircuitBreakerStatus,
+			) {
+				require.Nil(t, status)
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, corev1.EventTypeNormal, event.EventType)
+				require.Equal(t, kargoapi.EventReasonCircuitClosed, event.Reason)
+			},
+		},
+		{
+			name:  "success while closed resets failures",
+			stage: testStage,
+			status: &kargoapi.CircuitBreakerStatus{
+				ConsecutiveFailures: 2,
+			},
+			promo: promoWithPhase(kargoapi.PromotionPhaseSucceeded),
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				status *kargoapi.CircuitBreakerStatus,
+			) {
+				require.Nil(t, status)
+				require.Empty(t, recorder.Events)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := fakeevent.NewEventRecorder(1)
+			r := &reconciler{
+				recorder: recorder,
+				nowFn:    fakeNow,
+			}
+			status := r.syncCircuitBreaker(testCase.stage, testCase.status, testCase.promo)
+			testCase.assertions(t, recorder, status)
+		})
+	}
+}
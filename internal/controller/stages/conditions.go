@@ -0,0 +1,146 @@
+package stages
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/conditions"
+)
+
+const (
+	conditionReasonSynced     = "Synced"
+	conditionReasonSyncFailed = "SyncFailed"
+	conditionReasonPromoting  = "Promoting"
+)
+
+// buildConditions returns the conditions of the provided Stage updated to
+// reflect the provided new status and the outcome of the reconciliation that
+// produced it. The conditions of the provided Stage are not modified. Any
+// transitions are recorded as having occurred at the provided time.
+func buildConditions(
+	stage *kargoapi.Stage,
+	status kargoapi.StageStatus,
+	syncErr error,
+	now time.Time,
+) []metav1.Condition {
+	conds := stage.Status.DeepCopy().Conditions
+	set := func(
+		condType kargoapi.StageConditionType,
+		condStatus metav1.ConditionStatus,
+		reason string,
+		message string,
+	) {
+		conditions.SetCondition(&conds, metav1.Condition{
+			Type:               string(condType),
+			Status:             condStatus,
+			Reason:             reason,
+			Message:            message,
+			ObservedGeneration: stage.Generation,
+			LastTransitionTime: metav1.NewTime(now),
+		})
+	}
+
+	if syncErr != nil {
+		set(
+			kargoapi.StageConditionTypeSynced,
+			metav1.ConditionFalse,
+			conditionReasonSyncFailed,
+			syncErr.Error(),
+		)
+	} else {
+		set(
+			kargoapi.StageConditionTypeSynced,
+			metav1.ConditionTrue,
+			conditionReasonSynced,
+			"",
+		)
+	}
+
+	switch {
+	case status.CurrentPromotion != nil:
+		set(
+			kargoapi.StageConditionTypePromoted,
+			metav1.ConditionUnknown,
+			conditionReasonPromoting,
+			fmt.Sprintf("Promotion %q is running", status.CurrentPromotion.Name),
+		)
+	case status.LastPromotion != nil && status.LastPromotion.Status != nil &&
+		status.LastPromotion.Status.Phase.IsTerminal():
+		promo := status.LastPromotion
+		condStatus := metav1.ConditionFalse
+		message := promo.Status.Message
+		if promo.Status.Phase == kargoapi.PromotionPhaseSucceeded {
+			condStatus = metav1.ConditionTrue
+			message = fmt.Sprintf("Promotion %q succeeded", promo.Name)
+		}
+		set(
+			kargoapi.StageConditionTypePromoted,
+			condStatus,
+			"Promotion"+string(promo.Status.Phase),
+			message,
+		)
+	default:
+		conditions.RemoveCondition(&conds, string(kargoapi.StageConditionTypePromoted))
+	}
+
+	if stage.Spec.CircuitBreaker == nil {
+		conditions.RemoveCondition(&conds, string(kargoapi.StageConditionTypeCircuitOpen))
+	} else {
+		switch state, remaining := getCircuitState(stage, status.CircuitBreaker, now); state {
+		case circuitOpen:
+			set(
+				kargoapi.StageConditionTypeCircuitOpen,
+				metav1.ConditionTrue,
+				"Circuit"+string(state),
+				fmt.Sprintf(
+					"Circuit opened after %d consecutive failed Promotions; automatic "+
+						"Promotions are suspended until %s",
+					status.CircuitBreaker.ConsecutiveFailures,
+					now.Add(remaining).UTC().Format(time.RFC3339),
+				),
+			)
+		case circuitHalfOpen:
+			set(
+				kargoapi.StageConditionTypeCircuitOpen,
+				metav1.ConditionFalse,
+				"Circuit"+string(state),
+				"Cooldown elapsed; the next automatic Promotion determines whether "+
+					"the circuit closes",
+			)
+		default:
+			set(
+				kargoapi.StageConditionTypeCircuitOpen,
+				metav1.ConditionFalse,
+				"Circuit"+string(state),
+				"Automatic Promotions are permitted",
+			)
+		}
+	}
+
+	if status.Health == nil {
+		conditions.RemoveCondition(&conds, string(kargoapi.StageConditionTypeHealthy))
+		conditions.RemoveCondition(&conds, string(kargoapi.StageConditionTypeDegraded))
+		return conds
+	}
+	healthy, degraded := metav1.ConditionFalse, metav1.ConditionFalse
+	switch status.Health.Status {
+	case kargoapi.HealthStateHealthy:
+		healthy = metav1.ConditionTrue
+	case kargoapi.HealthStateUnhealthy:
+		degraded = metav1.ConditionTrue
+	case kargoapi.HealthStateUnknown:
+		healthy, degraded = metav1.ConditionUnknown, metav1.ConditionUnknown
+	}
+	reason := string(status.Health.Status)
+	if reason == "" {
+		reason = string(kargoapi.HealthStateUnknown)
+	}
+	message := strings.Join(status.Health.Issues, "; ")
+	set(kargoapi.StageConditionTypeHealthy, healthy, reason, message)
+	set(kargoapi.StageConditionTypeDegraded, degraded, reason, message)
+	return conds
+}
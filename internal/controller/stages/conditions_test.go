@@ -0,0 +1,310 @@
+package stages
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/conditions"
+)
+
+func TestBuildConditions(t *testing.T) {
+	now := fakeNow()
+	earlier := metav1.NewTime(now.Add(-time.Hour))
+	testCases := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		status     kargoapi.StageStatus
+		syncErr    error
+		assertions func(*testing.T, []metav1.Condition)
+	}{
+		{
+			name: "synced without health or Promotions",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+			},
+			assertions: func(t *testing.T, conds []metav1.Condition) {
+				require.Equal(
+					t,
+					[]metav1.Condition{{
+						Type:               string(kargoapi.StageConditionTypeSynced),
+						Status:             metav1.ConditionTrue,
+						Reason:             conditionReasonSynced,
+						ObservedGeneration: 2,
+						LastTransitionTime: metav1.NewTime(now),
+					}},
+					conds,
+				)
+			},
+		},
+		{
+			name: "sync failed",
+			stage: &kargoapi.Stage{
+				Status: kargoapi.StageStatus{
+					Conditions: []metav1.Condition{{
+						Type:               string(kargoapi.StageConditionTypeSynced),
+						Status:             metav1.ConditionTrue,
+						Reason:             conditionReasonSynced,
+						LastTransitionTime: earlier,
+					}},
+				},
+			},
+			syncErr: errors.New("something went wrong"),
+			assertions: func(t *testing.T, conds []metav1.Condition) {
+				cond := conditions.GetCondition(conds, string(kargoapi.StageConditionTypeSynced))
+				require.NotNil(t, cond)
+				require.Equal(t, metav1.ConditionFalse, cond.Status)
+				require.Equal(t, conditionReasonSyncFailed, cond.Reason)
+				require.Equal(t, "something went wrong", cond.Message)
+				require.Equal(t, metav1.NewTime(now), cond.LastTransitionTime)
+			},
+		},
+		{
+			name: "healthy Stage remains healthy",
+			stage: &kargoapi.Stage{
+				Status: kargoapi.StageStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(kargoapi.StageConditionTypeHealthy),
+							Status:             metav1.ConditionTrue,
+							Reason:             string(kargoapi.HealthStateHealthy),
+							LastTransitionTime: earlier,
+						},
+						{
+							Type:               string(kargoapi.StageConditionTypeDegraded),
+							Status:             metav1.ConditionFalse,
+							Reason:             string(kargoapi.HealthStateHealthy),
+							LastTransitionTime: earlier,
+						},
+					},
+				},
+			},
+			status: kargoapi.StageStatus{
+				Health: &kargoapi.Health{Status: kargoapi.HealthStateHealthy},
+			},
+			assertions: func(t *testing.T, conds []metav1.Condition) {
+				healthy := conditions.GetCondition(conds, string(kargoapi.StageConditionTypeHealthy))
+				require.NotNil(t, healthy)
+				require.Equal(t, metav1.ConditionTrue, healthy.Status)
+				require.Equal(t, earlier, healthy.LastTransitionTime)
+				degraded := conditions.GetCondition(conds, string(kargoapi.StageConditionTypeDegraded))
+				require.NotNil(t, degraded)
+				require.Equal(t, metav1.ConditionFalse, degraded.Status)
+				require.Equal(t, earlier, degraded.LastTransitionTime)
+			},
+		},
+		{
+			name: "healthy Stage becomes degraded",
+			stage: &kargoapi.Stage{
+				Status: kargoapi.StageStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(kargoapi.StageConditionTypeHealthy),
+							Status:             metav1.ConditionTrue,
+							Reason:             string(kargoapi.HealthStateHealthy),
+							LastTransitionTime: earlier,
+						},
+						{
+							Type:               string(kargoapi.StageConditionTypeDegraded),
+							Status:             metav1.ConditionFalse,
+							Reason:             string(kargoapi.HealthStateHealthy),
+							LastTransitionTime: earlier,
+						},
+					},
+				},
+			},
+			status: kargoapi.StageStatus{
+				Health: &kargoapi.Health{
+					Status: kargoapi.HealthStateUnhealthy,
+					Issues: []string{"fake issue", "another fake issue"},
+				},
+			},
+			assertions: func(t *testing.T, conds []metav1.Condition) {
+				healthy := conditions.GetCondition(conds, string(kargoapi.StageConditionTypeHealthy))
+				require.NotNil(t, healthy)
+				require.Equal(t, metav1.ConditionFalse, healthy.Status)
+				require.Equal(t, string(kargoapi.HealthStateUnhealthy), healthy.Reason)
+				require.Equal(t, "fake issue; another fake issue", healthy.Message)
+				require.Equal(t, metav1.NewTime(now), healthy.LastTransitionTime)
+				degraded := conditions.GetCondition(conds, string(kargoapi.StageConditionTypeDegraded))
+				require.NotNil(t, degraded)
+				require.Equal(t, metav1.ConditionTrue, degraded.Status)
+				require.Equal(t, metav1.NewTime(now), degraded.LastTransitionTime)
+			},
+		},
+		{
+			name: "health no longer known",
+			stage: &kargoapi.Stage{
+				Status: kargoapi.StageStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:   string(kargoapi.StageConditionTypeHealthy),
+							Status: metav1.ConditionTrue,
+						},
+						{
+							Type:   string(kargoapi.StageConditionTypeDegraded),
+							Status: metav1.ConditionFalse,
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, conds []metav1.Condition) {
+				require.Nil(t, conditions.GetCondition(conds, string(kargoapi.StageConditionTypeHealthy)))
+				require.Nil(t, conditions.GetCondition(conds, string(kargoapi.StageConditionTypeDegraded)))
+			},
+		},
+		{
+			name:  "Promotion running",
+			stage: &kargoapi.Stage{},
+			status: kargoapi.StageStatus{
+				CurrentPromotion: &kargoapi.PromotionReference{Name: "fake-promotion"},
+			},
+			assertions: func(t *testing.T, conds []metav1.Condition) {
+				cond := conditions.GetCondition(conds, string(kargoapi.StageConditionTypePromoted))
+				require.NotNil(t, cond)
+				require.Equal(t, metav1.ConditionUnknown, cond.Status)
+				require.Equal(t, conditionReasonPromoting, cond.Reason)
+			},
+		},
+		{
+			name:  "last Promotion succeeded",
+			stage: &kargoapi.Stage{},
+			status: kargoapi.StageStatus{
+				LastPromotion: &kargoapi.PromotionReference{
+					Name: "fake-promotion",
+					Status: &kargoapi.PromotionStatus{
+						Phase: kargoapi.PromotionPhaseSucceeded,
+					},
+				},
+			},
+			assertions: func(t *testing.T, conds []metav1.Condition) {
+				cond := conditions.GetCondition(conds, string(kargoapi.StageConditionTypePromoted))
+				require.NotNil(t, cond)
+				require.Equal(t, metav1.ConditionTrue, cond.Status)
+				require.Equal(t, "PromotionSucceeded", cond.Reason)
+			},
+		},
+		{
+			name:  "last Promotion failed",
+			stage: &kargoapi.Stage{},
+			status: kargoapi.StageStatus{
+				LastPromotion: &kargoapi.PromotionReference{
+					Name: "fake-promotion",
+					Status: &kargoapi.PromotionStatus{
+						Phase:   kargoapi.PromotionPhaseFailed,
+						Message: "something went wrong",
+					},
+				},
+			},
+			assertions: func(t *testing.T, conds []metav1.Condition) {
+				cond := conditions.GetCondition(conds, string(kargoapi.StageConditionTypePromoted))
+				require.NotNil(t, cond)
+				require.Equal(t, metav1.ConditionFalse, cond.Status)
+				require.Equal(t, "PromotionFailed", cond.Reason)
+				require.Equal(t, "something went wrong", cond.Message)
+			},
+		},
+		{
+			name: "circuit open",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					CircuitBreaker: &kargoapi.CircuitBreaker{
+						Cooldown: metav1.Duration{Duration: 2 * time.Hour},
+					},
+				},
+			},
+			status: kargoapi.StageStatus{
+				CircuitBreaker: &kargoapi.CircuitBreakerStatus{
+					ConsecutiveFailures: 3,
+					OpenedAt:            &earlier,
+				},
+			},
+			assertions: func(t *testing.T, conds []metav1.Condition) {
+				cond := conditions.GetCondition(conds, string(kargoapi.StageConditionTypeCircuitOpen))
+				require.NotNil(t, cond)
+				require.Equal(t, metav1.ConditionTrue, cond.Status)
+				require.Equal(t, "CircuitOpen", cond.Reason)
+				require.Contains(t, cond.Message, "after 3 consecutive failed Promotions")
+				require.Contains(
+					t,
+					cond.Message,
+					now.Add(time.Hour).UTC().Format(time.RFC3339),
+				)
+			},
+		},
+		{
+			name: "circuit half-open",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					CircuitBreaker: &kargoapi.CircuitBreaker{
+						Cooldown: metav1.Duration{Duration: time.Minute},
+					},
+				},
+			},
+			status: kargoapi.StageStatus{
+				CircuitBreaker: &kargoapi.CircuitBreakerStatus{
+					ConsecutiveFailures: 3,
+					OpenedAt:            &earlier,
+				},
+			},
+			assertions: func(t *testing.T, conds []metav1.Condition) {
+				cond := conditions.GetCondition(conds, string(kargoapi.StageConditionTypeCircuitOpen))
+				require.NotNil(t, cond)
+				require.Equal(t, metav1.ConditionFalse, cond.Status)
+				require.Equal(t, "CircuitHalfOpen", cond.Reason)
+			},
+		},
+		{
+			name: "circuit closes",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					CircuitBreaker: &kargoapi.CircuitBreaker{},
+				},
+				Status: kargoapi.StageStatus{
+					Conditions: []metav1.Condition{{
+						Type:               string(kargoapi.StageConditionTypeCircuitOpen),
+						Status:             metav1.ConditionTrue,
+						Reason:             "CircuitOpen",
+						LastTransitionTime: earlier,
+					}},
+				},
+			},
+			assertions: func(t *testing.T, conds []metav1.Condition) {
+				cond := conditions.GetCondition(conds, string(kargoapi.StageConditionTypeCircuitOpen))
+				require.NotNil(t, cond)
+				require.Equal(t, metav1.ConditionFalse, cond.Status)
+				require.Equal(t, "CircuitClosed", cond.Reason)
+				require.Equal(t, metav1.NewTime(now), cond.LastTransitionTime)
+			},
+		},
+		{
+			name: "circuit breaker no longer specified",
+			stage: &kargoapi.Stage{
+				Status: kargoapi.StageStatus{
+					Conditions: []metav1.Condition{{
+						Type:   string(kargoapi.StageConditionTypeCircuitOpen),
+						Status: metav1.ConditionTrue,
+					}},
+				},
+			},
+			assertions: func(t *testing.T, conds []metav1.Condition) {
+				require.Nil(t, conditions.GetCondition(conds, string(kargoapi.StageConditionTypeCircuitOpen)))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			original := testCase.stage.Status.DeepCopy()
+			testCase.assertions(
+				t,
+				buildConditions(testCase.stage, testCase.status, testCase.syncErr, now),
+			)
+			// The Stage itself should not have been modified
+			require.Equal(t, original, &testCase.stage.Status)
+		})
+	}
+}
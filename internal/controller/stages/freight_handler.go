@@ -0,0 +1,111 @@
+package stages
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	authzv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/httpapi"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// AvailableFreightHandlerPattern is the http.ServeMux pattern with which the
+// handler returned by NewAvailableFreightHandler is expected to be registered.
+const AvailableFreightHandlerPattern = "GET /stages/{namespace}/{name}/freight"
+
+// NewAvailableFreightHandler returns an http.Handler that responds with a JSON
+// array of all Freight available to the Stage identified by the namespace and
+// name path values of the request, including Freight manually approved for the
+// Stage. Requests are authorized using the provided httpapi.Authorizer and the
+// user they are made on behalf of must be permitted to get the Stage.
+func NewAvailableFreightHandler(
+	c client.Client,
+	authorizer httpapi.Authorizer,
+) http.Handler {
+	h := &availableFreightHandler{
+		client:     c,
+		authorizer: authorizer,
+	}
+	r := &reconciler{
+		listFreightFn: c.List,
+	}
+	h.getAvailableFreightFn = r.getAvailableFreight
+	return h
+}
+
+type availableFreightHandler struct {
+	client     client.Client
+	authorizer httpapi.Authorizer
+	// These behaviors are overridable for testing purposes:
+	getAvailableFreightFn func(
+		ctx context.Context,
+		stage *kargoapi.Stage,
+		includeApproved bool,
+	) ([]kargoapi.Freight, error)
+}
+
+func (a *availableFreightHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := logging.LoggerFromContext(ctx)
+
+	namespace := r.PathValue("namespace")
+	name := r.PathValue("name")
+
+	if !a.authorizer.Authorize(
+		w,
+		r,
+		authzv1.ResourceAttributes{
+			Group:     kargoapi.GroupVersion.Group,
+			Resource:  "stages",
+			Name:      name,
+			Verb:      "get",
+			Namespace: namespace,
+		},
+	) {
+		return
+	}
+
+	stage, err := kargoapi.GetStage(
+		ctx,
+		a.client,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      name,
+		},
+	)
+	if err != nil {
+		logger.Error(err, "error getting Stage")
+		http.Error(w, "error getting Stage", http.StatusInternalServerError)
+		return
+	}
+	if stage == nil {
+		http.Error(
+			w,
+			fmt.Sprintf("Stage %q not found in namespace %q", name, namespace),
+			http.StatusNotFound,
+		)
+		return
+	}
+
+	freight, err := a.getAvailableFreightFn(ctx, stage, true)
+	if err != nil {
+		logger.Error(err, "error getting available Freight")
+		http.Error(w, "error getting available Freight", http.StatusInternalServerError)
+		return
+	}
+	if freight == nil {
+		// Respond with an empty array rather than null.
+		freight = []kargoapi.Freight{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(w).Encode(freight); err != nil {
+		logger.Error(err, "error encoding available Freight")
+	}
+}
@@ -0,0 +1,187 @@
+package stages
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	authzv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/httpapi"
+)
+
+func TestNewAvailableFreightHandler(t *testing.T) {
+	h, ok := NewAvailableFreightHandler(
+		fake.NewFakeClient(),
+		&httpapi.FakeAuthorizer{},
+	).(*availableFreightHandler)
+	require.True(t, ok)
+	require.NotNil(t, h.client)
+	require.NotNil(t, h.authorizer)
+	require.NotNil(t, h.getAvailableFreightFn)
+}
+
+func TestAvailableFreightHandler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+
+	// allowed permits getting the test Stage and nothing else.
+	allowed := &httpapi.FakeAuthorizer{
+		AuthorizeFn: func(w http.ResponseWriter, _ *http.Request, attrs authzv1.ResourceAttributes) bool {
+			if attrs.Group != kargoapi.GroupVersion.Group ||
+				attrs.Resource != "stages" ||
+				attrs.Verb != "get" ||
+				attrs.Namespace != "fake-namespace" {
+				http.Error(w, "forbidden", http.StatusForbidden)
+				return false
+			}
+			return true
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		path       string
+		handler    *availableFreightHandler
+		assertions func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "request not authorized",
+			path: "/stages/other-namespace/fake-stage/freight",
+			handler: &availableFreightHandler{
+				authorizer: allowed,
+			},
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, rr.Code)
+			},
+		},
+		{
+			name: "Stage not found",
+			path: "/stages/fake-namespace/other-stage/freight",
+			handler: &availableFreightHandler{
+				client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testStage).
+					Build(),
+				authorizer: allowed,
+			},
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, rr.Code)
+				require.Contains(t, rr.Body.String(), `Stage "other-stage" not found`)
+			},
+		},
+		{
+			name: "error getting available Freight",
+			path: "/stages/fake-namespace/fake-stage/freight",
+			handler: &availableFreightHandler{
+				client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testStage).
+					Build(),
+				authorizer: allowed,
+				getAvailableFreightFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					bool,
+				) ([]kargoapi.Freight, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, rr.Code)
+			},
+		},
+		{
+			name: "no available Freight",
+			path: "/stages/fake-namespace/fake-stage/freight",
+			handler: &availableFreightHandler{
+				client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testStage).
+					Build(),
+				authorizer: allowed,
+				getAvailableFreightFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					bool,
+				) ([]kargoapi.Freight, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, rr.Code)
+				require.JSONEq(t, "[]", rr.Body.String())
+			},
+		},
+		{
+			name: "success",
+			path: "/stages/fake-namespace/fake-stage/freight",
+			handler: &availableFreightHandler{
+				client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testStage).
+					Build(),
+				authorizer: allowed,
+				getAvailableFreightFn: func(
+					_ context.Context,
+					stage *kargoapi.Stage,
+					includeApproved bool,
+				) ([]kargoapi.Freight, error) {
+					if stage.Name != "fake-stage" || !includeApproved {
+						return nil, errors.New("unexpected arguments")
+					}
+					return []kargoapi.Freight{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "fake-namespace",
+								Name:      "fake-freight-1",
+							},
+							Images: []kargoapi.Image{{RepoURL: "example/image", Tag: "v1.0.0"}},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "fake-namespace",
+								Name:      "fake-freight-2",
+							},
+							Images: []kargoapi.Image{{RepoURL: "example/image", Tag: "v1.1.0"}},
+						},
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, rr *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, rr.Code)
+				require.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+				var freight []kargoapi.Freight
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &freight))
+				require.Len(t, freight, 2)
+				require.Equal(t, "fake-freight-1", freight[0].Name)
+				require.Equal(t, "v1.1.0", freight[1].Images[0].Tag)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.Handle(AvailableFreightHandlerPattern, testCase.handler)
+			req := httptest.NewRequest(http.MethodGet, testCase.path, nil)
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, req)
+			testCase.assertions(t, rr)
+		})
+	}
+}
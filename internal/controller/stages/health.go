@@ -0,0 +1,465 @@
+package stages
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libargocd "github.com/akuity/kargo/internal/argocd"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kubeclient"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// repairHealth re-assesses the health of the provided Stage the first time it
+// is reconciled after the controller has started, if the health recorded in
+// the Stage's status is missing or older than the configured staleness
+// threshold. This repairs health left incomplete or outdated by a previous
+// instance of the controller that stopped, or crashed, before it finished
+// assessing it. The re-assessed health is written to the Stage's status
+// immediately, so that it does not depend on the outcome of the rest of the
+// reconciliation.
+func (r *reconciler) repairHealth(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) error {
+	key := types.NamespacedName{
+		Namespace: stage.Namespace,
+		Name:      stage.Name,
+	}
+	r.healthRepairedMu.Lock()
+	_, repaired := r.healthRepaired[key]
+	r.healthRepairedMu.Unlock()
+	if repaired {
+		return nil
+	}
+
+	if currentFC := stage.Status.FreightHistory.Current(); currentFC != nil && len(currentFC.Freight) > 0 {
+		if health := stage.Status.Health; health == nil || health.CheckedAt == nil ||
+			r.nowFn().Sub(health.CheckedAt.Time) >= r.cfg.HealthStalenessThreshold {
+			logging.LoggerFromContext(ctx).Debug("repairing stale Stage health")
+			status := r.syncStageHealth(ctx, stage)
+			if err := kubeclient.JSONPatchStatus(ctx, r.kargoClient, stage, func(s *kargoapi.StageStatus) {
+				s.Health = status.Health
+			}); err != nil {
+				return fmt.Errorf("error updating Stage health: %w", err)
+			}
+		}
+	}
+
+	r.healthRepairedMu.Lock()
+	defer r.healthRepairedMu.Unlock()
+	r.healthRepaired[key] = struct{}{}
+	return nil
+}
+
+// forgetHealthRepair forgets that the health of the specified Stage has been
+// repaired.
+func (r *reconciler) forgetHealthRepair(key types.NamespacedName) {
+	r.healthRepairedMu.Lock()
+	defer r.healthRepairedMu.Unlock()
+	delete(r.healthRepaired, key)
+}
+
+// assessHealth evaluates the health of the provided Stage and records the time
+// at which it was assessed. If no health checks are applicable to the Stage,
+// nil is returned.
+func (r *reconciler) assessHealth(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) *kargoapi.Health {
+	health := r.evaluateHealth(ctx, stage)
+	if health != nil {
+		health.CheckedAt = ptr.To(metav1.NewTime(r.nowFn()))
+	}
+	return health
+}
+
+// evaluateHealth assesses the health of the provided Stage. The health of any
+// Argo CD Applications updated by the Stage is combined with the outcome of
+// the Stage's Argo CD Application, HTTP, and Pod image health checks, with the
+// overall health being the most severe of all. If no health checks are applicable to
+// the Stage, nil is returned.
+func (r *reconciler) evaluateHealth(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) *kargoapi.Health {
+	health := r.appHealth.EvaluateHealth(ctx, stage)
+	checks := stage.Spec.HealthChecks
+	if checks == nil ||
+		(len(checks.HTTPChecks) == 0 && len(checks.ArgoCDApps) == 0 && len(checks.PodImages) == 0) {
+		return health
+	}
+	if health == nil {
+		health = &kargoapi.Health{
+			Status: kargoapi.HealthStateHealthy,
+		}
+	}
+	if len(checks.ArgoCDApps) > 0 {
+		appsHealth := r.checkArgoCDHealthFn(ctx, checks.ArgoCDApps)
+		health.Status = health.Status.Merge(appsHealth.Status)
+		health.ArgoCDApps = append(health.ArgoCDApps, appsHealth.ArgoCDApps...)
+		health.Issues = append(health.Issues, appsHealth.Issues...)
+	}
+	if len(checks.HTTPChecks) > 0 {
+		state, issues := r.runHTTPHealthChecks(ctx, checks.HTTPChecks)
+		health.Status = health.Status.Merge(state)
+		health.Issues = append(health.Issues, issues...)
+	}
+	for _, check := range checks.PodImages {
+		state, err := r.checkPodImageHealthFn(ctx, stage, check)
+		health.Status = health.Status.Merge(state)
+		if err != nil {
+			health.Issues = append(health.Issues, err.Error())
+		}
+	}
+	return health
+}
+
+// runHTTPHealthChecks performs the provided HTTPHealthChecks concurrently,
+// under a single deadline of kargoapi.MaxHTTPHealthCheckTimeout, so that the
+// time taken to assess the health of a Stage does not grow with the number of
+// checks. The most severe outcome of all checks is returned along with the
+// issues reported by the checks, in the order in which the checks were
+// specified.
+func (r *reconciler) runHTTPHealthChecks(
+	ctx context.Context,
+	checks []kargoapi.HTTPHealthCheck,
+) (kargoapi.HealthState, []string) {
+	ctx, cancel := context.WithTimeout(ctx, kargoapi.MaxHTTPHealthCheckTimeout)
+	defer cancel()
+
+	states := make([]kargoapi.HealthState, len(checks))
+	errs := make([]error, len(checks))
+	var g errgroup.Group
+	for i, check := range checks {
+		g.Go(func() error {
+			states[i], errs[i] = r.checkHTTPHealthFn(ctx, check)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	state := kargoapi.HealthStateHealthy
+	var issues []string
+	for i := range checks {
+		state = state.Merge(states[i])
+		if errs[i] != nil {
+			issues = append(issues, errs[i].Error())
+		}
+	}
+	return state, issues
+}
+
+// checkHTTPHealth probes the endpoint described by the provided
+// HTTPHealthCheck. HealthStateHealthy is returned if the endpoint responds
+// with the expected status code within the check's timeout. Otherwise,
+// HealthStateUnhealthy is returned along with an error explaining why.
+func (r *reconciler) checkHTTPHealth(
+	ctx context.Context,
+	check kargoapi.HTTPHealthCheck,
+) (kargoapi.HealthState, error) {
+	ctx, cancel := context.WithTimeout(ctx, check.GetTimeout())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, check.URL, nil)
+	if err != nil {
+		return kargoapi.HealthStateUnhealthy,
+			fmt.Errorf("error building HTTP health check request for %q: %w", check.URL, err)
+	}
+
+	httpClient := http.DefaultClient
+	if check.InsecureSkipTLSVerify {
+		transport := http.DefaultTransport.(*http.Transport).Clone() // nolint: forcetypeassert
+		transport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}
+		httpClient = &http.Client{Transport: transport}
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return kargoapi.HealthStateUnhealthy,
+			fmt.Errorf("error performing HTTP health check of %q: %w", check.URL, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != check.GetExpectedStatusCode() {
+		return kargoapi.HealthStateUnhealthy, fmt.Errorf(
+			"HTTP health check of %q returned status code %d; expected %d",
+			check.URL,
+			res.StatusCode,
+			check.GetExpectedStatusCode(),
+		)
+	}
+	return kargoapi.HealthStateHealthy, nil
+}
+
+// checkArgoCDHealth assesses the health of the Argo CD Applications described
+// by the provided ArgoCDAppStatusChecks, as reported by Argo CD itself. The
+// returned Health is HealthStateHealthy only if every Application is Healthy
+// and has its required sync status. If any Application is not, the returned
+// Health is HealthStateUnhealthy. If any Application cannot be found, its
+// health is unknown. The returned Health also mirrors the health and sync
+// status of each Application.
+func (r *reconciler) checkArgoCDHealth(
+	ctx context.Context,
+	checks []kargoapi.ArgoCDAppStatusCheck,
+) *kargoapi.Health {
+	if r.argocdClient == nil {
+		return &kargoapi.Health{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				"Argo CD integration is disabled; cannot assess the health or sync status of Argo CD Applications",
+			},
+		}
+	}
+
+	health := &kargoapi.Health{
+		Status:     kargoapi.HealthStateHealthy,
+		ArgoCDApps: make([]kargoapi.ArgoCDAppStatus, 0, len(checks)),
+	}
+	for _, check := range checks {
+		namespace := check.Namespace
+		if namespace == "" {
+			namespace = libargocd.Namespace()
+		}
+		appStatus := kargoapi.ArgoCDAppStatus{
+			Namespace: namespace,
+			Name:      check.Name,
+			HealthStatus: kargoapi.ArgoCDAppHealthStatus{
+				Status: kargoapi.ArgoCDAppHealthStateUnknown,
+			},
+			SyncStatus: kargoapi.ArgoCDAppSyncStatus{
+				Status: kargoapi.ArgoCDAppSyncStateUnknown,
+			},
+		}
+
+		app, err := r.getArgoCDAppFn(ctx, r.argocdClient, namespace, check.Name)
+		if err == nil && app == nil {
+			err = fmt.Errorf(
+				"unable to find Argo CD Application %q in namespace %q",
+				check.Name,
+				namespace,
+			)
+		}
+		if err != nil {
+			health.Status = health.Status.Merge(kargoapi.HealthStateUnknown)
+			health.Issues = append(health.Issues, err.Error())
+			health.ArgoCDApps = append(health.ArgoCDApps, appStatus)
+			continue
+		}
+
+		// Mirror the health and sync status of the Argo CD Application.
+		if app.Status.Health.Status != "" {
+			appStatus.HealthStatus = kargoapi.ArgoCDAppHealthStatus{
+				Status:  kargoapi.ArgoCDAppHealthState(app.Status.Health.Status),
+				Message: app.Status.Health.Message,
+			}
+		}
+		if app.Status.Sync.Status != "" {
+			appStatus.SyncStatus = kargoapi.ArgoCDAppSyncStatus{
+				Status:    kargoapi.ArgoCDAppSyncState(app.Status.Sync.Status),
+				Revision:  app.Status.Sync.Revision,
+				Revisions: app.Status.Sync.Revisions,
+			}
+		}
+		health.ArgoCDApps = append(health.ArgoCDApps, appStatus)
+
+		if required := check.GetRequiredSyncStatus(); appStatus.SyncStatus.Status != required {
+			health.Status = health.Status.Merge(kargoapi.HealthStateUnhealthy)
+			health.Issues = append(health.Issues, fmt.Sprintf(
+				"Argo CD Application %q in namespace %q has sync status %q; expected %q",
+				check.Name,
+				namespace,
+				appStatus.SyncStatus.Status,
+				required,
+			))
+		}
+		if app.Status.Health.Status != argocd.HealthStatusHealthy {
+			health.Status = health.Status.Merge(kargoapi.HealthStateUnhealthy)
+			health.Issues = append(health.Issues, fmt.Sprintf(
+				"Argo CD Application %q in namespace %q has health status %q",
+				check.Name,
+				namespace,
+				appStatus.HealthStatus.Status,
+			))
+		}
+	}
+	return health
+}
+
+// checkPodImageHealth verifies that the Pods described by the provided
+// PodImageHealthCheck run the images referenced by the Stage's current
+// Freight. Only containers running an image from a repository referenced by
+// the Freight are considered. HealthStateHealthy is returned once every such
+// container runs the referenced image, compared by digest when the Freight
+// specifies one and by tag otherwise. While any Pod is still terminating,
+// starting, or running a different image, HealthStateProgressing is returned
+// along with an error describing the first such Pod. If no Pods, or no
+// containers running referenced images, are found, HealthStateUnhealthy is
+// returned.
+func (r *reconciler) checkPodImageHealth(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	check kargoapi.PodImageHealthCheck,
+) (kargoapi.HealthState, error) {
+	namespace := check.Namespace
+	if namespace == "" {
+		namespace = stage.Namespace
+	}
+
+	// Index the images of the current Freight by normalized repository name,
+	// so that e.g. "nginx" and "docker.io/library/nginx" are considered equal.
+	images := map[string]kargoapi.Image{}
+	for _, ref := range stage.Status.FreightHistory.Current().References() {
+		for _, image := range ref.Images {
+			repo, err := name.NewRepository(image.RepoURL)
+			if err != nil {
+				return kargoapi.HealthStateUnknown,
+					fmt.Errorf("error parsing image repository URL %q: %w", image.RepoURL, err)
+			}
+			images[repo.Name()] = image
+		}
+	}
+	if len(images) == 0 {
+		return kargoapi.HealthStateUnknown, fmt.Errorf(
+			"current Freight of Stage does not reference any images to compare Pods in namespace %q against",
+			namespace,
+		)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&check.Selector)
+	if err != nil {
+		return kargoapi.HealthStateUnknown, fmt.Errorf("error parsing Pod selector: %w", err)
+	}
+	if selector.Empty() {
+		return kargoapi.HealthStateUnknown, errors.New("Pod selector must not be empty")
+	}
+
+	pods := &corev1.PodList{}
+	if err = r.listPodsFn(
+		ctx,
+		pods,
+		client.InNamespace(namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return kargoapi.HealthStateUnknown,
+			fmt.Errorf("error listing Pods in namespace %q: %w", namespace, err)
+	}
+	if len(pods.Items) == 0 {
+		return kargoapi.HealthStateUnhealthy, fmt.Errorf(
+			"no Pods in namespace %q match selector %q",
+			namespace,
+			selector.String(),
+		)
+	}
+
+	var checked int
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp != nil {
+			return kargoapi.HealthStateProgressing, fmt.Errorf(
+				"Pod %q in namespace %q is terminating",
+				pod.Name,
+				namespace,
+			)
+		}
+		if len(pod.Status.ContainerStatuses) == 0 {
+			return kargoapi.HealthStateProgressing, fmt.Errorf(
+				"Pod %q in namespace %q has not yet reported the status of its containers",
+				pod.Name,
+				namespace,
+			)
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			ref, err := name.ParseReference(status.Image)
+			if err != nil {
+				// The image cannot be one referenced by the Freight.
+				continue
+			}
+			image, ok := images[ref.Context().Name()]
+			if !ok {
+				continue
+			}
+			checked++
+			if !containerRunsImage(status, ref, image) {
+				return kargoapi.HealthStateProgressing, fmt.Errorf(
+					"container %q of Pod %q in namespace %q runs image %q; expected %q",
+					status.Name,
+					pod.Name,
+					namespace,
+					containerImage(status),
+					expectedImage(image),
+				)
+			}
+		}
+	}
+	if checked == 0 {
+		return kargoapi.HealthStateUnhealthy, fmt.Errorf(
+			"no containers of Pods in namespace %q matching selector %q run images referenced by the current Freight",
+			namespace,
+			selector.String(),
+		)
+	}
+	return kargoapi.HealthStateHealthy, nil
+}
+
+// containerRunsImage returns true if the container with the provided status
+// runs the provided image. When the image specifies a digest, the digest
+// resolved by the container runtime is compared against it, which detects
+// containers still running a stale image pulled for the same tag. Otherwise,
+// the tag of the container's image, parsed into the provided reference, is
+// compared against that of the image.
+func containerRunsImage(
+	status corev1.ContainerStatus,
+	ref name.Reference,
+	image kargoapi.Image,
+) bool {
+	if image.Digest != "" {
+		return containerImageDigest(status) == image.Digest
+	}
+	tag, ok := ref.(name.Tag)
+	return ok && tag.TagStr() == image.Tag
+}
+
+// containerImageDigest returns the digest of the image run by the container
+// with the provided status, or an empty string if it is unknown.
+func containerImageDigest(status corev1.ContainerStatus) string {
+	for _, ref := range []string{status.ImageID, status.Image} {
+		if i := strings.LastIndex(ref, "@"); i >= 0 {
+			return ref[i+1:]
+		}
+	}
+	return ""
+}
+
+// containerImage returns the most specific reference to the image run by the
+// container with the provided status.
+func containerImage(status corev1.ContainerStatus) string {
+	if digest := containerImageDigest(status); digest != "" &&
+		!strings.HasSuffix(status.Image, "@"+digest) {
+		return status.Image + "@" + digest
+	}
+	return status.Image
+}
+
+// expectedImage returns a reference to the provided image, using its digest
+// when specified and its tag otherwise.
+func expectedImage(image kargoapi.Image) string {
+	if image.Digest != "" {
+		return image.RepoURL + "@" + image.Digest
+	}
+	return image.RepoURL + ":" + image.Tag
+}
@@ -2,8 +2,24 @@ package stages
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
 )
 
 type mockAppHealthEvaluator struct {
@@ -16,3 +32,915 @@ func (m *mockAppHealthEvaluator) EvaluateHealth(
 ) *kargoapi.Health {
 	return m.Health
 }
+
+func TestRepairHealth(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	testOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	testFreightHistory := kargoapi.FreightHistory{{
+		Freight: map[string]kargoapi.FreightReference{
+			testOrigin.String(): {Name: "fake-freight", Origin: testOrigin},
+		},
+	}}
+	testKey := types.NamespacedName{
+		Namespace: "fake-namespace",
+		Name:      "fake-stage",
+	}
+
+	testCases := []struct {
+		name       string
+		status     kargoapi.StageStatus
+		repaired   bool
+		noStage    bool
+		assertions func(*testing.T, *kargoapi.Health, bool, error)
+	}{
+		{
+			name: "already repaired since startup",
+			status: kargoapi.StageStatus{
+				FreightHistory: testFreightHistory,
+			},
+			repaired: true,
+			assertions: func(t *testing.T, health *kargoapi.Health, repaired bool, err error) {
+				require.NoError(t, err)
+				require.Nil(t, health)
+				require.True(t, repaired)
+			},
+		},
+		{
+			name: "no current Freight",
+			assertions: func(t *testing.T, health *kargoapi.Health, repaired bool, err error) {
+				require.NoError(t, err)
+				require.Nil(t, health)
+				require.True(t, repaired)
+			},
+		},
+		{
+			name: "health is recent",
+			status: kargoapi.StageStatus{
+				FreightHistory: testFreightHistory,
+				Health: &kargoapi.Health{
+					Status:    kargoapi.HealthStateHealthy,
+					CheckedAt: ptr.To(metav1.NewTime(fakeTime.Add(-time.Minute))),
+				},
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health, repaired bool, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, health)
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.True(t, repaired)
+			},
+		},
+		{
+			name: "health is missing",
+			status: kargoapi.StageStatus{
+				FreightHistory: testFreightHistory,
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health, repaired bool, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, health)
+				require.Equal(t, kargoapi.HealthStateUnhealthy, health.Status)
+				require.NotNil(t, health.CheckedAt)
+				require.True(t, fakeTime.Equal(health.CheckedAt.Time))
+				require.True(t, repaired)
+			},
+		},
+		{
+			name: "health is stale",
+			status: kargoapi.StageStatus{
+				FreightHistory: testFreightHistory,
+				Health: &kargoapi.Health{
+					Status:    kargoapi.HealthStateHealthy,
+					CheckedAt: ptr.To(metav1.NewTime(fakeTime.Add(-time.Hour))),
+				},
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health, repaired bool, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, health)
+				require.Equal(t, kargoapi.HealthStateUnhealthy, health.Status)
+				require.True(t, fakeTime.Equal(health.CheckedAt.Time))
+				require.True(t, repaired)
+			},
+		},
+		{
+			name: "error updating Stage health",
+			status: kargoapi.StageStatus{
+				FreightHistory: testFreightHistory,
+			},
+			noStage: true,
+			assertions: func(t *testing.T, _ *kargoapi.Health, repaired bool, err error) {
+				require.ErrorContains(t, err, "error updating Stage health")
+				// The repair should be attempted again next time
+				require.False(t, repaired)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			stage := &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testKey.Namespace,
+					Name:      testKey.Name,
+				},
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: testCase.status,
+			}
+			clientBuilder := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithStatusSubresource(&kargoapi.Stage{})
+			if !testCase.noStage {
+				clientBuilder = clientBuilder.WithObjects(stage.DeepCopy())
+			}
+			c := clientBuilder.Build()
+			r := &reconciler{
+				kargoClient: c,
+				cfg: ReconcilerConfig{
+					HealthStalenessThreshold: 10 * time.Minute,
+				},
+				appHealth: &mockAppHealthEvaluator{
+					Health: &kargoapi.Health{Status: kargoapi.HealthStateUnhealthy},
+				},
+				healthRepaired: map[types.NamespacedName]struct{}{},
+				nowFn:          fakeNow,
+			}
+			if testCase.repaired {
+				r.healthRepaired[testKey] = struct{}{}
+			}
+
+			err := r.repairHealth(context.Background(), stage)
+
+			var health *kargoapi.Health
+			if !testCase.noStage {
+				updated := &kargoapi.Stage{}
+				require.NoError(t, c.Get(context.Background(), testKey, updated))
+				health = updated.Status.Health
+			}
+			_, repaired := r.healthRepaired[testKey]
+			testCase.assertions(t, health, repaired, err)
+		})
+	}
+}
+
+func TestEvaluateHealth(t *testing.T) {
+	testHTTPChecks := &kargoapi.HealthChecks{
+		HTTPChecks: []kargoapi.HTTPHealthCheck{
+			{URL: "https://example.com/a"},
+			{URL: "https://example.com/b"},
+		},
+	}
+	testCases := []struct {
+		name              string
+		appHealth         *kargoapi.Health
+		healthChecks      *kargoapi.HealthChecks
+		checkHTTPHealthFn func(
+			context.Context,
+			kargoapi.HTTPHealthCheck,
+		) (kargoapi.HealthState, error)
+		checkArgoCDHealthFn func(
+			context.Context,
+			[]kargoapi.ArgoCDAppStatusCheck,
+		) *kargoapi.Health
+		checkPodImageHealthFn func(
+			context.Context,
+			*kargoapi.Stage,
+			kargoapi.PodImageHealthCheck,
+		) (kargoapi.HealthState, error)
+		expected *kargoapi.Health
+	}{
+		{
+			name: "no health checks",
+		},
+		{
+			name: "no HTTP health checks",
+			appHealth: &kargoapi.Health{
+				Status: kargoapi.HealthStateProgressing,
+			},
+			expected: &kargoapi.Health{
+				Status: kargoapi.HealthStateProgressing,
+			},
+		},
+		{
+			name:         "all HTTP health checks healthy",
+			healthChecks: testHTTPChecks,
+			checkHTTPHealthFn: func(
+				context.Context,
+				kargoapi.HTTPHealthCheck,
+			) (kargoapi.HealthState, error) {
+				return kargoapi.HealthStateHealthy, nil
+			},
+			expected: &kargoapi.Health{
+				Status: kargoapi.HealthStateHealthy,
+			},
+		},
+		{
+			name: "worst of all checks prevails",
+			appHealth: &kargoapi.Health{
+				Status: kargoapi.HealthStateProgressing,
+			},
+			healthChecks: testHTTPChecks,
+			checkHTTPHealthFn: func(
+				_ context.Context,
+				check kargoapi.HTTPHealthCheck,
+			) (kargoapi.HealthState, error) {
+				if check.URL == "https://example.com/b" {
+					return kargoapi.HealthStateUnhealthy, errors.New("something went wrong")
+				}
+				return kargoapi.HealthStateHealthy, nil
+			},
+			expected: &kargoapi.Health{
+				Status: kargoapi.HealthStateUnhealthy,
+				Issues: []string{"something went wrong"},
+			},
+		},
+		{
+			name: "Argo CD Application health checks are combined",
+			appHealth: &kargoapi.Health{
+				Status: kargoapi.HealthStateHealthy,
+				ArgoCDApps: []kargoapi.ArgoCDAppStatus{{
+					Namespace: "argocd",
+					Name:      "updated-app",
+				}},
+			},
+			healthChecks: &kargoapi.HealthChecks{
+				ArgoCDApps: []kargoapi.ArgoCDAppStatusCheck{{Name: "checked-app"}},
+			},
+			checkArgoCDHealthFn: func(
+				context.Context,
+				[]kargoapi.ArgoCDAppStatusCheck,
+			) *kargoapi.Health {
+				return &kargoapi.Health{
+					Status: kargoapi.HealthStateUnhealthy,
+					ArgoCDApps: []kargoapi.ArgoCDAppStatus{{
+						Namespace: "argocd",
+						Name:      "checked-app",
+					}},
+					Issues: []string{"something went wrong"},
+				}
+			},
+			expected: &kargoapi.Health{
+				Status: kargoapi.HealthStateUnhealthy,
+				ArgoCDApps: []kargoapi.ArgoCDAppStatus{
+					{
+						Namespace: "argocd",
+						Name:      "updated-app",
+					},
+					{
+						Namespace: "argocd",
+						Name:      "checked-app",
+					},
+				},
+				Issues: []string{"something went wrong"},
+			},
+		},
+		{
+			name: "Pod image health checks are combined",
+			appHealth: &kargoapi.Health{
+				Status: kargoapi.HealthStateHealthy,
+			},
+			healthChecks: &kargoapi.HealthChecks{
+				PodImages: []kargoapi.PodImageHealthCheck{{
+					Selector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "fake-app"},
+					},
+				}},
+			},
+			checkPodImageHealthFn: func(
+				context.Context,
+				*kargoapi.Stage,
+				kargoapi.PodImageHealthCheck,
+			) (kargoapi.HealthState, error) {
+				return kargoapi.HealthStateProgressing, errors.New("something is in progress")
+			},
+			expected: &kargoapi.Health{
+				Status: kargoapi.HealthStateProgressing,
+				Issues: []string{"something is in progress"},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				appHealth:             &mockAppHealthEvaluator{Health: testCase.appHealth},
+				checkHTTPHealthFn:     testCase.checkHTTPHealthFn,
+				checkArgoCDHealthFn:   testCase.checkArgoCDHealthFn,
+				checkPodImageHealthFn: testCase.checkPodImageHealthFn,
+			}
+			health := r.evaluateHealth(
+				context.Background(),
+				&kargoapi.Stage{
+					Spec: kargoapi.StageSpec{
+						HealthChecks: testCase.healthChecks,
+					},
+				},
+			)
+			require.Equal(t, testCase.expected, health)
+		})
+	}
+}
+
+func TestRunHTTPHealthChecks(t *testing.T) {
+	checks := []kargoapi.HTTPHealthCheck{
+		{URL: "https://example.com/a"},
+		{URL: "https://example.com/b"},
+		{URL: "https://example.com/c"},
+	}
+	// Every check waits for all the others to have started, which can only
+	// happen if they are performed concurrently.
+	var started sync.WaitGroup
+	started.Add(len(checks))
+	allStarted := make(chan struct{})
+	go func() {
+		started.Wait()
+		close(allStarted)
+	}()
+	r := &reconciler{
+		checkHTTPHealthFn: func(
+			ctx context.Context,
+			check kargoapi.HTTPHealthCheck,
+		) (kargoapi.HealthState, error) {
+			deadline, ok := ctx.Deadline()
+			require.True(t, ok)
+			require.LessOrEqual(t, time.Until(deadline), kargoapi.MaxHTTPHealthCheckTimeout)
+			started.Done()
+			select {
+			case <-allStarted:
+			case <-time.After(5 * time.Second):
+				return kargoapi.HealthStateUnknown, errors.New("checks were not concurrent")
+			}
+			if check.URL == "https://example.com/a" {
+				return kargoapi.HealthStateHealthy, nil
+			}
+			return kargoapi.HealthStateUnhealthy, errors.New(check.URL)
+		},
+	}
+	state, issues := r.runHTTPHealthChecks(context.Background(), checks)
+	require.Equal(t, kargoapi.HealthStateUnhealthy, state)
+	require.Equal(t, []string{"https://example.com/b", "https://example.com/c"}, issues)
+}
+
+func TestCheckHTTPHealth(t *testing.T) {
+	testCases := []struct {
+		name       string
+		handler    http.HandlerFunc
+		check      func(url string) kargoapi.HTTPHealthCheck
+		assertions func(*testing.T, kargoapi.HealthState, error)
+	}{
+		{
+			name: "expected status code",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			check: func(url string) kargoapi.HTTPHealthCheck {
+				return kargoapi.HTTPHealthCheck{URL: url}
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.HealthStateHealthy, state)
+			},
+		},
+		{
+			name: "unexpected status code",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			check: func(url string) kargoapi.HTTPHealthCheck {
+				return kargoapi.HTTPHealthCheck{URL: url}
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, "returned status code 500; expected 200")
+				require.Equal(t, kargoapi.HealthStateUnhealthy, state)
+			},
+		},
+		{
+			name: "custom expected status code",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			},
+			check: func(url string) kargoapi.HTTPHealthCheck {
+				return kargoapi.HTTPHealthCheck{
+					URL:                url,
+					ExpectedStatusCode: http.StatusNoContent,
+				}
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.HealthStateHealthy, state)
+			},
+		},
+		{
+			name: "timeout",
+			handler: func(_ http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+				case <-time.After(5 * time.Second):
+				}
+			},
+			check: func(url string) kargoapi.HTTPHealthCheck {
+				return kargoapi.HTTPHealthCheck{
+					URL:            url,
+					TimeoutSeconds: 1,
+				}
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, "error performing HTTP health check")
+				require.ErrorIs(t, err, context.DeadlineExceeded)
+				require.Equal(t, kargoapi.HealthStateUnhealthy, state)
+			},
+		},
+		{
+			name: "connection refused",
+			check: func(string) kargoapi.HTTPHealthCheck {
+				// Start and immediately stop a server to obtain the address of a
+				// port nothing is listening on.
+				srv := httptest.NewServer(http.NotFoundHandler())
+				url := srv.URL
+				srv.Close()
+				return kargoapi.HTTPHealthCheck{URL: url}
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, "error performing HTTP health check")
+				require.Equal(t, kargoapi.HealthStateUnhealthy, state)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var url string
+			if testCase.handler != nil {
+				srv := httptest.NewServer(testCase.handler)
+				t.Cleanup(srv.Close)
+				url = srv.URL
+			}
+			state, err := (&reconciler{}).checkHTTPHealth(
+				context.Background(),
+				testCase.check(url),
+			)
+			testCase.assertions(t, state, err)
+		})
+	}
+}
+
+func TestCheckArgoCDHealth(t *testing.T) {
+	newApp := func(
+		health argocd.HealthStatusCode,
+		sync argocd.SyncStatusCode,
+	) *argocd.Application {
+		return &argocd.Application{
+			Status: argocd.ApplicationStatus{
+				Health: argocd.HealthStatus{Status: health},
+				Sync:   argocd.SyncStatus{Status: sync, Revision: "fake-revision"},
+			},
+		}
+	}
+	testCases := []struct {
+		name           string
+		argocdClient   client.Client
+		checks         []kargoapi.ArgoCDAppStatusCheck
+		getArgoCDAppFn func(
+			ctx context.Context,
+			ctrlRuntimeClient client.Client,
+			namespace string,
+			name string,
+		) (*argocd.Application, error)
+		assertions func(*testing.T, *kargoapi.Health)
+	}{
+		{
+			name:   "Argo CD integration disabled",
+			checks: []kargoapi.ArgoCDAppStatusCheck{{Name: "fake-app"}},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+				require.Len(t, health.Issues, 1)
+				require.Contains(t, health.Issues[0], "Argo CD integration is disabled")
+			},
+		},
+		{
+			name:         "error getting Application",
+			argocdClient: fake.NewClientBuilder().Build(),
+			checks:       []kargoapi.ArgoCDAppStatusCheck{{Name: "fake-app"}},
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+				require.Equal(t, []string{"something went wrong"}, health.Issues)
+				require.Len(t, health.ArgoCDApps, 1)
+				require.Equal(
+					t,
+					kargoapi.ArgoCDAppHealthStateUnknown,
+					health.ArgoCDApps[0].HealthStatus.Status,
+				)
+			},
+		},
+		{
+			name:         "Application not found",
+			argocdClient: fake.NewClientBuilder().Build(),
+			checks: []kargoapi.ArgoCDAppStatusCheck{{
+				Name:      "fake-app",
+				Namespace: "fake-namespace",
+			}},
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return nil, nil
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnknown, health.Status)
+				require.Equal(
+					t,
+					[]string{`unable to find Argo CD Application "fake-app" in namespace "fake-namespace"`},
+					health.Issues,
+				)
+			},
+		},
+		{
+			name:         "degraded Application",
+			argocdClient: fake.NewClientBuilder().Build(),
+			checks:       []kargoapi.ArgoCDAppStatusCheck{{Name: "fake-app"}},
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return newApp(argocd.HealthStatusDegraded, argocd.SyncStatusCodeSynced), nil
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, health.Status)
+				require.Len(t, health.Issues, 1)
+				require.Contains(t, health.Issues[0], `has health status "Degraded"`)
+				require.Equal(
+					t,
+					kargoapi.ArgoCDAppHealthStateDegraded,
+					health.ArgoCDApps[0].HealthStatus.Status,
+				)
+			},
+		},
+		{
+			name:         "progressing Application",
+			argocdClient: fake.NewClientBuilder().Build(),
+			checks:       []kargoapi.ArgoCDAppStatusCheck{{Name: "fake-app"}},
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return newApp(argocd.HealthStatusProgressing, argocd.SyncStatusCodeSynced), nil
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, health.Status)
+				require.Len(t, health.Issues, 1)
+				require.Contains(t, health.Issues[0], `has health status "Progressing"`)
+			},
+		},
+		{
+			name:         "out of sync Application",
+			argocdClient: fake.NewClientBuilder().Build(),
+			checks:       []kargoapi.ArgoCDAppStatusCheck{{Name: "fake-app"}},
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return newApp(argocd.HealthStatusHealthy, argocd.SyncStatusCodeOutOfSync), nil
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, health.Status)
+				require.Len(t, health.Issues, 1)
+				require.Contains(
+					t,
+					health.Issues[0],
+					`has sync status "OutOfSync"; expected "Synced"`,
+				)
+			},
+		},
+		{
+			name:         "out of sync Application with OutOfSync required",
+			argocdClient: fake.NewClientBuilder().Build(),
+			checks: []kargoapi.ArgoCDAppStatusCheck{{
+				Name:               "fake-app",
+				RequiredSyncStatus: kargoapi.ArgoCDAppSyncStateOutOfSync,
+			}},
+			getArgoCDAppFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+			) (*argocd.Application, error) {
+				return newApp(argocd.HealthStatusHealthy, argocd.SyncStatusCodeOutOfSync), nil
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.Empty(t, health.Issues)
+			},
+		},
+		{
+			name:         "healthy Applications",
+			argocdClient: fake.NewClientBuilder().Build(),
+			checks: []kargoapi.ArgoCDAppStatusCheck{
+				{Name: "fake-app"},
+				{Name: "other-app", Namespace: "other-namespace"},
+			},
+			getArgoCDAppFn: func(
+				_ context.Context,
+				_ client.Client,
+				namespace string,
+				name string,
+			) (*argocd.Application, error) {
+				app := newApp(argocd.HealthStatusHealthy, argocd.SyncStatusCodeSynced)
+				app.Namespace = namespace
+				app.Name = name
+				return app, nil
+			},
+			assertions: func(t *testing.T, health *kargoapi.Health) {
+				require.Equal(t, kargoapi.HealthStateHealthy, health.Status)
+				require.Empty(t, health.Issues)
+				require.Equal(
+					t,
+					[]kargoapi.ArgoCDAppStatus{
+						{
+							Namespace: "argocd",
+							Name:      "fake-app",
+							HealthStatus: kargoapi.ArgoCDAppHealthStatus{
+								Status: kargoapi.ArgoCDAppHealthStateHealthy,
+							},
+							SyncStatus: kargoapi.ArgoCDAppSyncStatus{
+								Status:   kargoapi.ArgoCDAppSyncStateSynced,
+								Revision: "fake-revision",
+							},
+						},
+						{
+							Namespace: "other-namespace",
+							Name:      "other-app",
+							HealthStatus: kargoapi.ArgoCDAppHealthStatus{
+								Status: kargoapi.ArgoCDAppHealthStateHealthy,
+							},
+							SyncStatus: kargoapi.ArgoCDAppSyncStatus{
+								Status:   kargoapi.ArgoCDAppSyncStateSynced,
+								Revision: "fake-revision",
+							},
+						},
+					},
+					health.ArgoCDApps,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				argocdClient:   testCase.argocdClient,
+				getArgoCDAppFn: testCase.getArgoCDAppFn,
+			}
+			testCase.assertions(
+				t,
+				r.checkArgoCDHealth(context.Background(), testCase.checks),
+			)
+		})
+	}
+}
+
+func TestCheckPodImageHealth(t *testing.T) {
+	const testDigest = "sha256:3a5d82f42a4b1bd4f4b3ce3b1c3d7f0d5e42d9c2e9df3a18bb3f2f1a0b2c4d6e"
+	const oldDigest = "sha256:0f3e2c1b5a4d6e8f7a9b0c1d2e3f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e1f"
+
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+		},
+		Status: kargoapi.StageStatus{
+			FreightHistory: kargoapi.FreightHistory{{
+				Freight: map[string]kargoapi.FreightReference{
+					"Warehouse/fake-warehouse": {
+						Images: []kargoapi.Image{
+							{
+								RepoURL: "nginx",
+								Tag:     "1.27.0",
+								Digest:  testDigest,
+							},
+							{
+								RepoURL: "ghcr.io/example/app",
+								Tag:     "v1.0.0",
+							},
+						},
+					},
+				},
+			}},
+		},
+	}
+	testCheck := kargoapi.PodImageHealthCheck{
+		Selector: metav1.LabelSelector{
+			MatchLabels: map[string]string{"app": "fake-app"},
+		},
+	}
+	podWithStatuses := func(name string, statuses ...corev1.ContainerStatus) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      name,
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: statuses,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		check      kargoapi.PodImageHealthCheck
+		listPodsFn func(context.Context, client.ObjectList, ...client.ListOption) error
+		assertions func(*testing.T, kargoapi.HealthState, error)
+	}{
+		{
+			name:  "no current Freight",
+			stage: &kargoapi.Stage{},
+			check: testCheck,
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, "does not reference any images")
+				require.Equal(t, kargoapi.HealthStateUnknown, state)
+			},
+		},
+		{
+			name:  "empty selector",
+			stage: testStage,
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, "Pod selector must not be empty")
+				require.Equal(t, kargoapi.HealthStateUnknown, state)
+			},
+		},
+		{
+			name:  "error listing Pods",
+			stage: testStage,
+			check: testCheck,
+			listPodsFn: func(context.Context, client.ObjectList, ...client.ListOption) error {
+				return errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, "error listing Pods")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Equal(t, kargoapi.HealthStateUnknown, state)
+			},
+		},
+		{
+			name:  "no Pods found",
+			stage: testStage,
+			check: testCheck,
+			listPodsFn: func(context.Context, client.ObjectList, ...client.ListOption) error {
+				return nil
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, `no Pods in namespace "fake-namespace" match selector "app=fake-app"`)
+				require.Equal(t, kargoapi.HealthStateUnhealthy, state)
+			},
+		},
+		{
+			name:  "Pod is terminating",
+			stage: testStage,
+			check: testCheck,
+			listPodsFn: func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+				pod := podWithStatuses("old-pod")
+				pod.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+				list.(*corev1.PodList).Items = []corev1.Pod{pod} // nolint: forcetypeassert
+				return nil
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, `Pod "old-pod" in namespace "fake-namespace" is terminating`)
+				require.Equal(t, kargoapi.HealthStateProgressing, state)
+			},
+		},
+		{
+			name:  "Pod has not started",
+			stage: testStage,
+			check: testCheck,
+			listPodsFn: func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+				list.(*corev1.PodList).Items = []corev1.Pod{ // nolint: forcetypeassert
+					podWithStatuses("new-pod"),
+				}
+				return nil
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, "has not yet reported the status of its containers")
+				require.Equal(t, kargoapi.HealthStateProgressing, state)
+			},
+		},
+		{
+			name:  "container runs stale image with same tag",
+			stage: testStage,
+			check: testCheck,
+			listPodsFn: func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+				list.(*corev1.PodList).Items = []corev1.Pod{ // nolint: forcetypeassert
+					podWithStatuses("fake-pod", corev1.ContainerStatus{
+						Name:    "nginx",
+						Image:   "docker.io/library/nginx:1.27.0",
+						ImageID: "docker.io/library/nginx@" + oldDigest,
+					}),
+				}
+				return nil
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, `container "nginx" of Pod "fake-pod"`)
+				require.ErrorContains(t, err, "expected \"nginx@"+testDigest+"\"")
+				require.Equal(t, kargoapi.HealthStateProgressing, state)
+			},
+		},
+		{
+			name:  "container runs image with different tag",
+			stage: testStage,
+			check: testCheck,
+			listPodsFn: func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+				list.(*corev1.PodList).Items = []corev1.Pod{ // nolint: forcetypeassert
+					podWithStatuses("fake-pod", corev1.ContainerStatus{
+						Name:  "app",
+						Image: "ghcr.io/example/app:v0.9.0",
+					}),
+				}
+				return nil
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, `runs image "ghcr.io/example/app:v0.9.0"; expected "ghcr.io/example/app:v1.0.0"`)
+				require.Equal(t, kargoapi.HealthStateProgressing, state)
+			},
+		},
+		{
+			name:  "no containers run referenced images",
+			stage: testStage,
+			check: testCheck,
+			listPodsFn: func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+				list.(*corev1.PodList).Items = []corev1.Pod{ // nolint: forcetypeassert
+					podWithStatuses("fake-pod", corev1.ContainerStatus{
+						Name:  "other",
+						Image: "ghcr.io/example/other:v1.0.0",
+					}),
+				}
+				return nil
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.ErrorContains(t, err, "no containers of Pods")
+				require.Equal(t, kargoapi.HealthStateUnhealthy, state)
+			},
+		},
+		{
+			name:  "all containers run referenced images",
+			stage: testStage,
+			check: testCheck,
+			listPodsFn: func(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+				listOpts := &client.ListOptions{}
+				listOpts.ApplyOptions(opts)
+				if listOpts.Namespace != "fake-namespace" {
+					return errors.New("unexpected namespace")
+				}
+				list.(*corev1.PodList).Items = []corev1.Pod{ // nolint: forcetypeassert
+					podWithStatuses(
+						"fake-pod-1",
+						corev1.ContainerStatus{
+							Name:    "nginx",
+							Image:   "nginx:1.27.0",
+							ImageID: "docker-pullable://nginx@" + testDigest,
+						},
+						corev1.ContainerStatus{
+							Name:  "app",
+							Image: "ghcr.io/example/app:v1.0.0",
+						},
+						corev1.ContainerStatus{
+							Name:  "sidecar",
+							Image: "ghcr.io/example/sidecar:v2.0.0",
+						},
+					),
+					podWithStatuses("fake-pod-2", corev1.ContainerStatus{
+						Name:  "nginx",
+						Image: "index.docker.io/library/nginx@" + testDigest,
+					}),
+				}
+				return nil
+			},
+			assertions: func(t *testing.T, state kargoapi.HealthState, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.HealthStateHealthy, state)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := &reconciler{
+				listPodsFn: testCase.listPodsFn,
+			}
+			state, err := r.checkPodImageHealth(context.Background(), testCase.stage, testCase.check)
+			testCase.assertions(t, state, err)
+		})
+	}
+}
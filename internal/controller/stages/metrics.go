@@ -0,0 +1,145 @@
+package stages
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const (
+	syncResultSuccess = "success"
+	syncResultFailure = "failure"
+)
+
+// metrics holds the Prometheus collectors used to instrument the Stage
+// reconciler. All methods are safe to call on a nil *metrics, in which case
+// they do nothing.
+type metrics struct {
+	syncTotal         *prometheus.CounterVec
+	promotionDuration *prometheus.HistogramVec
+	healthStatus      *prometheus.GaugeVec
+	availableFreight  *prometheus.GaugeVec
+}
+
+// newMetrics returns a new set of Stage reconciler metrics registered with the
+// provided prometheus.Registerer.
+func newMetrics(registerer prometheus.Registerer) *metrics {
+	m := &metrics{
+		syncTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "kargo_stage_sync_total",
+				Help: "Number of Stage reconciliations, partitioned by result.",
+			},
+			[]string{"namespace", "stage", "result"},
+		),
+		promotionDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "kargo_promotion_duration_seconds",
+				Help:    "Time from creation to completion of Promotions to a Stage.",
+				Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+			},
+			[]string{"namespace", "stage"},
+		),
+		healthStatus: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "kargo_stage_health_status",
+				Help: "Health of a Stage. The series for the current health status " +
+					"has a value of 1, while all others have a value of 0.",
+			},
+			[]string{"namespace", "stage", "status"},
+		),
+		availableFreight: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "kargo_stage_available_freight",
+				Help: "Number of pieces of Freight available for auto-promotion to a Stage.",
+			},
+			[]string{"namespace", "stage"},
+		),
+	}
+	registerer.MustRegister(
+		m.syncTotal,
+		m.promotionDuration,
+		m.healthStatus,
+		m.availableFreight,
+	)
+	return m
+}
+
+// recordSync counts a reconciliation of the provided Stage as successful if
+// err is nil and as failed otherwise.
+func (m *metrics) recordSync(stage *kargoapi.Stage, err error) {
+	if m == nil {
+		return
+	}
+	result := syncResultSuccess
+	if err != nil {
+		result = syncResultFailure
+	}
+	m.syncTotal.WithLabelValues(stage.Namespace, stage.Name, result).Inc()
+}
+
+// recordPromotion observes the duration of a terminated Promotion to the
+// provided Stage. Promotions that have not recorded a finish time are ignored.
+func (m *metrics) recordPromotion(
+	stage *kargoapi.Stage,
+	createdAt metav1.Time,
+	finishedAt *metav1.Time,
+) {
+	if m == nil || finishedAt == nil {
+		return
+	}
+	duration := finishedAt.Sub(createdAt.Time)
+	if duration < 0 {
+		duration = 0
+	}
+	m.promotionDuration.WithLabelValues(stage.Namespace, stage.Name).
+		Observe(duration.Seconds())
+}
+
+// recordHealth records the provided health of the provided Stage. A nil health
+// removes any previously recorded health of the Stage.
+func (m *metrics) recordHealth(stage *kargoapi.Stage, health *kargoapi.Health) {
+	if m == nil {
+		return
+	}
+	m.healthStatus.DeletePartialMatch(
+		prometheus.Labels{"namespace": stage.Namespace, "stage": stage.Name},
+	)
+	if health == nil {
+		return
+	}
+	for _, status := range []kargoapi.HealthState{
+		kargoapi.HealthStateHealthy,
+		kargoapi.HealthStateUnhealthy,
+		kargoapi.HealthStateProgressing,
+		kargoapi.HealthStateUnknown,
+	} {
+		var value float64
+		if health.Status == status {
+			value = 1
+		}
+		m.healthStatus.WithLabelValues(stage.Namespace, stage.Name, string(status)).Set(value)
+	}
+}
+
+// recordAvailableFreight records the number of pieces of Freight available
+// for auto-promotion to the provided Stage.
+func (m *metrics) recordAvailableFreight(stage *kargoapi.Stage, count int) {
+	if m == nil {
+		return
+	}
+	m.availableFreight.WithLabelValues(stage.Namespace, stage.Name).Set(float64(count))
+}
+
+// forget removes all series recorded for the specified Stage.
+func (m *metrics) forget(namespace, name string) {
+	if m == nil {
+		return
+	}
+	labels := prometheus.Labels{"namespace": namespace, "stage": name}
+	m.syncTotal.DeletePartialMatch(labels)
+	m.promotionDuration.DeletePartialMatch(labels)
+	m.healthStatus.DeletePartialMatch(labels)
+	m.availableFreight.DeletePartialMatch(labels)
+}
@@ -0,0 +1,155 @@
+package stages
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewMetrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	m := newMetrics(registry)
+	require.NotNil(t, m.syncTotal)
+	require.NotNil(t, m.promotionDuration)
+	require.NotNil(t, m.healthStatus)
+	require.NotNil(t, m.availableFreight)
+}
+
+func TestMetricsRecordSync(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	m := newMetrics(prometheus.NewRegistry())
+
+	m.recordSync(testStage, nil)
+	m.recordSync(testStage, nil)
+	m.recordSync(testStage, errors.New("something went wrong"))
+
+	require.Equal(
+		t,
+		float64(2),
+		testutil.ToFloat64(
+			m.syncTotal.WithLabelValues("fake-namespace", "fake-stage", syncResultSuccess),
+		),
+	)
+	require.Equal(
+		t,
+		float64(1),
+		testutil.ToFloat64(
+			m.syncTotal.WithLabelValues("fake-namespace", "fake-stage", syncResultFailure),
+		),
+	)
+}
+
+func TestMetricsRecordPromotion(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	m := newMetrics(prometheus.NewRegistry())
+
+	createdAt := metav1.NewTime(fakeTime)
+	m.recordPromotion(testStage, createdAt, nil)
+	require.Equal(t, 0, testutil.CollectAndCount(m.promotionDuration))
+
+	m.recordPromotion(testStage, createdAt, ptr.To(metav1.NewTime(fakeTime.Add(30*time.Second))))
+	require.Equal(t, 1, testutil.CollectAndCount(m.promotionDuration))
+}
+
+func TestMetricsRecordHealth(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	m := newMetrics(prometheus.NewRegistry())
+
+	m.recordHealth(testStage, &kargoapi.Health{Status: kargoapi.HealthStateUnhealthy})
+	require.Equal(
+		t,
+		float64(1),
+		testutil.ToFloat64(
+			m.healthStatus.WithLabelValues(
+				"fake-namespace",
+				"fake-stage",
+				string(kargoapi.HealthStateUnhealthy),
+			),
+		),
+	)
+	require.Equal(
+		t,
+		float64(0),
+		testutil.ToFloat64(
+			m.healthStatus.WithLabelValues(
+				"fake-namespace",
+				"fake-stage",
+				string(kargoapi.HealthStateHealthy),
+			),
+		),
+	)
+
+	m.recordHealth(testStage, nil)
+	require.Equal(t, 0, testutil.CollectAndCount(m.healthStatus))
+}
+
+func TestMetricsRecordAvailableFreight(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	m := newMetrics(prometheus.NewRegistry())
+
+	m.recordAvailableFreight(testStage, 3)
+	require.Equal(
+		t,
+		float64(3),
+		testutil.ToFloat64(m.availableFreight.WithLabelValues("fake-namespace", "fake-stage")),
+	)
+}
+
+func TestMetricsForget(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	m := newMetrics(prometheus.NewRegistry())
+	m.recordSync(testStage, nil)
+	m.recordHealth(testStage, &kargoapi.Health{Status: kargoapi.HealthStateHealthy})
+	m.recordAvailableFreight(testStage, 1)
+
+	m.forget(testStage.Namespace, testStage.Name)
+
+	require.Equal(t, 0, testutil.CollectAndCount(m.syncTotal))
+	require.Equal(t, 0, testutil.CollectAndCount(m.healthStatus))
+	require.Equal(t, 0, testutil.CollectAndCount(m.availableFreight))
+}
+
+func TestMetricsNil(t *testing.T) {
+	var m *metrics
+	require.NotPanics(t, func() {
+		testStage := &kargoapi.Stage{}
+		m.recordSync(testStage, nil)
+		m.recordPromotion(testStage, metav1.Time{}, ptr.To(metav1.NewTime(fakeTime)))
+		m.recordHealth(testStage, &kargoapi.Health{})
+		m.recordAvailableFreight(testStage, 1)
+		m.forget("", "")
+	})
+}
@@ -0,0 +1,235 @@
+package stages
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"text/template"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// notificationWebhookTimeout is the maximum amount of time spent delivering a
+// single notification to a notification webhook.
+const notificationWebhookTimeout = 10 * time.Second
+
+// notificationTemplateData is the data against which the body template of a
+// notification webhook is rendered.
+type notificationTemplateData struct {
+	// Project is the name of the Project the Stage belongs to.
+	Project string
+	// Stage is the name of the Stage.
+	Stage string
+	// Promotion is the name of the successful Promotion.
+	Promotion string
+	// Freight is the FreightCollection that was promoted.
+	Freight *kargoapi.FreightCollection
+	// ChartChanges lists the changes made to the Helm charts referenced by the
+	// promoted Freight since the versions previously used by the Stage.
+	ChartChanges []kargoapi.ChartChange
+}
+
+// webhookNotifier delivers notifications of successful Promotions to the
+// notification webhooks of a Stage. Deliveries happen asynchronously and their
+// outcome is kept in memory until it is written to the Stage's status by a
+// subsequent reconciliation. All methods are safe to call on a nil
+// *webhookNotifier, in which case they do nothing.
+type webhookNotifier struct {
+	httpClient *http.Client
+	timeout    time.Duration
+
+	// deliveries holds the outcome of the last delivery to each notification
+	// webhook of each Stage, indexed by URL.
+	deliveries   map[types.NamespacedName]map[string]kargoapi.WebhookDeliveryStatus
+	deliveriesMu sync.Mutex
+
+	// wg tracks deliveries that are in progress.
+	wg sync.WaitGroup
+
+	nowFn func() time.Time
+}
+
+// newWebhookNotifier returns a new webhookNotifier.
+func newWebhookNotifier() *webhookNotifier {
+	return &webhookNotifier{
+		httpClient: &http.Client{},
+		timeout:    notificationWebhookTimeout,
+		deliveries: map[types.NamespacedName]map[string]kargoapi.WebhookDeliveryStatus{},
+		nowFn:      time.Now,
+	}
+}
+
+// notify asynchronously delivers a notification of the provided successful
+// Promotion to each of the provided Stage's notification webhooks. It does not
+// wait for the deliveries to complete. Failed deliveries are logged and
+// recorded, but are otherwise ignored.
+func (n *webhookNotifier) notify(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo kargoapi.PromotionReference,
+) {
+	if n == nil || len(stage.Spec.NotificationWebhooks) == 0 {
+		return
+	}
+	logger := logging.LoggerFromContext(ctx)
+
+	key := types.NamespacedName{
+		Namespace: stage.Namespace,
+		Name:      stage.Name,
+	}
+	data := notificationTemplateData{
+		Project:   stage.Namespace,
+		Stage:     stage.Name,
+		Promotion: promo.Name,
+	}
+	if promo.Status != nil {
+		data.Freight = promo.Status.FreightCollection.DeepCopy()
+		data.ChartChanges = slices.Clone(promo.Status.ChartChanges)
+	}
+
+	for _, webhook := range stage.Spec.NotificationWebhooks {
+		webhook := *webhook.DeepCopy()
+		n.wg.Add(1)
+		go func() {
+			defer n.wg.Done()
+			status := n.deliver(webhook, data)
+			if !status.Succeeded {
+				logger.Info(
+					"warning: failed to deliver notification webhook",
+					"url", webhook.URL,
+					"promotion", promo.Name,
+					"reason", status.Message,
+				)
+			}
+			n.deliveriesMu.Lock()
+			defer n.deliveriesMu.Unlock()
+			if n.deliveries[key] == nil {
+				n.deliveries[key] = map[string]kargoapi.WebhookDeliveryStatus{}
+			}
+			n.deliveries[key][webhook.URL] = status
+		}()
+	}
+}
+
+// deliver renders the body of the notification using the provided data and
+// sends it to the provided notification webhook. It returns the outcome of the
+// delivery.
+func (n *webhookNotifier) deliver(
+	webhook kargoapi.WebhookConfig,
+	data notificationTemplateData,
+) kargoapi.WebhookDeliveryStatus {
+	status := kargoapi.WebhookDeliveryStatus{
+		URL:         webhook.URL,
+		Promotion:   data.Promotion,
+		DeliveredAt: ptr.To(metav1.NewTime(n.nowFn())),
+	}
+
+	var body io.Reader
+	if webhook.BodyTemplate != "" {
+		tmpl, err := template.New("body").Option("missingkey=error").Parse(webhook.BodyTemplate)
+		if err != nil {
+			status.Message = fmt.Sprintf("error parsing body template: %s", err)
+			return status
+		}
+		buf := &bytes.Buffer{}
+		if err = tmpl.Execute(buf, data); err != nil {
+			status.Message = fmt.Sprintf("error rendering body template: %s", err)
+			return status
+		}
+		body = buf
+	}
+
+	method := webhook.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, webhook.URL, body)
+	if err != nil {
+		status.Message = fmt.Sprintf("error creating request: %s", err)
+		return status
+	}
+	for k, v := range webhook.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		status.Message = fmt.Sprintf("error sending request: %s", err)
+		return status
+	}
+	defer resp.Body.Close()
+	status.StatusCode = int32(resp.StatusCode) // nolint: gosec
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		status.Message = fmt.Sprintf("received unexpected HTTP status %d", resp.StatusCode)
+		return status
+	}
+	status.Succeeded = true
+	return status
+}
+
+// syncStatuses returns the provided delivery statuses of the provided Stage's
+// notification webhooks, updated with the outcome of any deliveries that have
+// completed since. Statuses of webhooks that are no longer specified by the
+// Stage are dropped.
+func (n *webhookNotifier) syncStatuses(
+	stage *kargoapi.Stage,
+	statuses []kargoapi.WebhookDeliveryStatus,
+) []kargoapi.WebhookDeliveryStatus {
+	if n == nil {
+		return statuses
+	}
+
+	n.deliveriesMu.Lock()
+	deliveries := make(map[string]kargoapi.WebhookDeliveryStatus)
+	for url, delivery := range n.deliveries[types.NamespacedName{
+		Namespace: stage.Namespace,
+		Name:      stage.Name,
+	}] {
+		deliveries[url] = *delivery.DeepCopy()
+	}
+	n.deliveriesMu.Unlock()
+
+	var newStatuses []kargoapi.WebhookDeliveryStatus
+	seen := map[string]struct{}{}
+	for _, webhook := range stage.Spec.NotificationWebhooks {
+		if _, ok := seen[webhook.URL]; ok {
+			continue
+		}
+		seen[webhook.URL] = struct{}{}
+		if delivery, ok := deliveries[webhook.URL]; ok {
+			newStatuses = append(newStatuses, delivery)
+			continue
+		}
+		for _, status := range statuses {
+			if status.URL == webhook.URL {
+				newStatuses = append(newStatuses, status)
+				break
+			}
+		}
+	}
+	return newStatuses
+}
+
+// forget removes the outcome of all deliveries to the notification webhooks of
+// the specified Stage.
+func (n *webhookNotifier) forget(key types.NamespacedName) {
+	if n == nil {
+		return
+	}
+	n.deliveriesMu.Lock()
+	defer n.deliveriesMu.Unlock()
+	delete(n.deliveries, key)
+}
@@ -0,0 +1,262 @@
+package stages
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestWebhookNotifierNotify(t *testing.T) {
+	type request struct {
+		method string
+		header http.Header
+		body   string
+	}
+
+	testCases := []struct {
+		name       string
+		webhook    kargoapi.WebhookConfig
+		statusCode int
+		assertions func(*testing.T, []request, kargoapi.WebhookDeliveryStatus)
+	}{
+		{
+			name: "success with rendered body",
+			webhook: kargoapi.WebhookConfig{
+				Headers: map[string]string{
+					"Content-Type": "application/json",
+				},
+				BodyTemplate: `{"text":"{{ .Promotion }} promoted {{ .Freight.ID }} to ` +
+					`{{ .Project }}/{{ .Stage }}"}`,
+			},
+			statusCode: http.StatusOK,
+			assertions: func(t *testing.T, reqs []request, status kargoapi.WebhookDeliveryStatus) {
+				require.Len(t, reqs, 1)
+				require.Equal(t, http.MethodPost, reqs[0].method)
+				require.Equal(t, "application/json", reqs[0].header.Get("Content-Type"))
+				require.Equal(
+					t,
+					`{"text":"fake-promotion promoted fake-id to fake-namespace/fake-stage"}`,
+					reqs[0].body,
+				)
+
+				require.True(t, status.Succeeded)
+				require.Equal(t, int32(http.StatusOK), status.StatusCode)
+				require.Equal(t, "fake-promotion", status.Promotion)
+				require.Equal(t, ptr.To(metav1.NewTime(fakeTime)), status.DeliveredAt)
+				require.Empty(t, status.Message)
+			},
+		},
+		{
+			name: "success with chart changes",
+			webhook: kargoapi.WebhookConfig{
+				BodyTemplate: `{{ range .ChartChanges }}{{ .Name }} {{ .Version }}: ` +
+					`{{ .Description }};{{ end }}`,
+			},
+			statusCode: http.StatusOK,
+			assertions: func(t *testing.T, reqs []request, status kargoapi.WebhookDeliveryStatus) {
+				require.Len(t, reqs, 1)
+				require.Equal(t, "fake-chart 1.1.0: Added a feature;", reqs[0].body)
+				require.True(t, status.Succeeded)
+			},
+		},
+		{
+			name: "success with GET and no body",
+			webhook: kargoapi.WebhookConfig{
+				Method: http.MethodGet,
+			},
+			statusCode: http.StatusNoContent,
+			assertions: func(t *testing.T, reqs []request, status kargoapi.WebhookDeliveryStatus) {
+				require.Len(t, reqs, 1)
+				require.Equal(t, http.MethodGet, reqs[0].method)
+				require.Empty(t, reqs[0].body)
+
+				require.True(t, status.Succeeded)
+				require.Equal(t, int32(http.StatusNoContent), status.StatusCode)
+			},
+		},
+		{
+			name:       "unexpected status code",
+			webhook:    kargoapi.WebhookConfig{},
+			statusCode: http.StatusInternalServerError,
+			assertions: func(t *testing.T, reqs []request, status kargoapi.WebhookDeliveryStatus) {
+				require.Len(t, reqs, 1)
+
+				require.False(t, status.Succeeded)
+				require.Equal(t, int32(http.StatusInternalServerError), status.StatusCode)
+				require.Equal(t, "received unexpected HTTP status 500", status.Message)
+			},
+		},
+		{
+			name: "error parsing body template",
+			webhook: kargoapi.WebhookConfig{
+				BodyTemplate: "{{ .Stage",
+			},
+			assertions: func(t *testing.T, reqs []request, status kargoapi.WebhookDeliveryStatus) {
+				require.Empty(t, reqs)
+
+				require.False(t, status.Succeeded)
+				require.Contains(t, status.Message, "error parsing body template")
+			},
+		},
+		{
+			name: "error rendering body template",
+			webhook: kargoapi.WebhookConfig{
+				BodyTemplate: "{{ .Environment }}",
+			},
+			assertions: func(t *testing.T, reqs []request, status kargoapi.WebhookDeliveryStatus) {
+				require.Empty(t, reqs)
+
+				require.False(t, status.Succeeded)
+				require.Contains(t, status.Message, "error rendering body template")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var reqs []request
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				reqs = append(reqs, request{
+					method: r.Method,
+					header: r.Header,
+					body:   string(body),
+				})
+				w.WriteHeader(testCase.statusCode)
+			}))
+			t.Cleanup(server.Close)
+
+			webhook := testCase.webhook
+			webhook.URL = server.URL
+			stage := &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					NotificationWebhooks: []kargoapi.WebhookConfig{webhook},
+				},
+			}
+
+			n := newWebhookNotifier()
+			n.nowFn = fakeNow
+			n.notify(
+				context.Background(),
+				stage,
+				kargoapi.PromotionReference{
+					Name: "fake-promotion",
+					Status: &kargoapi.PromotionStatus{
+						Phase: kargoapi.PromotionPhaseSucceeded,
+						FreightCollection: &kargoapi.FreightCollection{
+							ID: "fake-id",
+						},
+						ChartChanges: []kargoapi.ChartChange{{
+							RepoURL:     "https://charts.example.com",
+							Name:        "fake-chart",
+							Version:     "1.1.0",
+							Description: "Added a feature",
+						}},
+					},
+				},
+			)
+			n.wg.Wait()
+
+			statuses := n.syncStatuses(stage, nil)
+			require.Len(t, statuses, 1)
+			require.Equal(t, server.URL, statuses[0].URL)
+			testCase.assertions(t, reqs, statuses[0])
+		})
+	}
+}
+
+func TestWebhookNotifierSyncStatuses(t *testing.T) {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+		Spec: kargoapi.StageSpec{
+			NotificationWebhooks: []kargoapi.WebhookConfig{
+				{URL: "https://example.com/a"},
+				{URL: "https://example.com/b"},
+				{URL: "https://example.com/a"},
+				{URL: "https://example.com/c"},
+			},
+		},
+	}
+
+	n := newWebhookNotifier()
+	n.deliveries[types.NamespacedName{Namespace: "fake-namespace", Name: "fake-stage"}] =
+		map[string]kargoapi.WebhookDeliveryStatus{
+			"https://example.com/a": {
+				URL:       "https://example.com/a",
+				Promotion: "fake-promotion-2",
+				Succeeded: true,
+			},
+		}
+
+	statuses := n.syncStatuses(
+		stage,
+		[]kargoapi.WebhookDeliveryStatus{
+			{
+				URL:       "https://example.com/a",
+				Promotion: "fake-promotion-1",
+			},
+			{
+				URL:       "https://example.com/b",
+				Promotion: "fake-promotion-1",
+				Succeeded: true,
+			},
+			{
+				// No longer specified by the Stage
+				URL:       "https://example.com/d",
+				Promotion: "fake-promotion-1",
+			},
+		},
+	)
+	require.Equal(
+		t,
+		[]kargoapi.WebhookDeliveryStatus{
+			{
+				URL:       "https://example.com/a",
+				Promotion: "fake-promotion-2",
+				Succeeded: true,
+			},
+			{
+				URL:       "https://example.com/b",
+				Promotion: "fake-promotion-1",
+				Succeeded: true,
+			},
+		},
+		statuses,
+	)
+
+	n.forget(types.NamespacedName{Namespace: "fake-namespace", Name: "fake-stage"})
+	require.Empty(t, n.deliveries)
+}
+
+func TestWebhookNotifierNil(t *testing.T) {
+	var n *webhookNotifier
+	require.NotPanics(t, func() {
+		stage := &kargoapi.Stage{
+			Spec: kargoapi.StageSpec{
+				NotificationWebhooks: []kargoapi.WebhookConfig{
+					{URL: "https://example.com"},
+				},
+			},
+		}
+		n.notify(context.Background(), stage, kargoapi.PromotionReference{})
+		statuses := []kargoapi.WebhookDeliveryStatus{{URL: "https://example.com"}}
+		require.Equal(t, statuses, n.syncStatuses(stage, statuses))
+		n.forget(types.NamespacedName{})
+	})
+}
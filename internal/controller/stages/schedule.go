@@ -0,0 +1,145 @@
+package stages
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kargo"
+	"github.com/akuity/kargo/internal/kubeclient"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// getPromotionSchedule evaluates the promotion schedule of the provided Stage
+// at the provided time. It returns whether a scheduled Promotion is due, given
+// the time at which the Stage was last scheduled to be promoted, along with
+// the time at which the Stage is next scheduled to be promoted. If the Stage
+// does not specify a promotion schedule, no Promotion is due and a nil time is
+// returned.
+func getPromotionSchedule(
+	stage *kargoapi.Stage,
+	scheduled *metav1.Time,
+	now time.Time,
+) (bool, *metav1.Time, error) {
+	if stage.Spec.PromotionSchedule == "" {
+		return false, nil, nil
+	}
+	schedule, err := cron.ParseStandard(stage.Spec.PromotionSchedule)
+	if err != nil {
+		return false, nil, fmt.Errorf("error parsing promotion schedule: %w", err)
+	}
+	due := scheduled != nil && !now.Before(scheduled.Time)
+	next := schedule.Next(now.UTC())
+	if next.IsZero() {
+		return due, nil, nil
+	}
+	return due, &metav1.Time{Time: next}, nil
+}
+
+// promoteOnSchedule creates a Promotion of the newest Freight available to the
+// provided Stage from each of its origins. Unlike auto-promotion, this happens
+// even if the Freight is already the Stage's current Freight. An origin is only
+// skipped if a Promotion of its newest Freight to the Stage is already
+// underway.
+func (r *reconciler) promoteOnSchedule(ctx context.Context, stage *kargoapi.Stage) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	availableFreight, err := r.getAvailableFreightByOriginFn(ctx, stage, true)
+	if err != nil {
+		return fmt.Errorf(
+			"error finding latest Freight for Stage %q in namespace %q: %w",
+			stage.Name,
+			stage.Namespace,
+			err,
+		)
+	}
+
+	for origin, freight := range availableFreight {
+		if len(freight) == 0 {
+			logger.Debug("no Freight from origin available for scheduled promotion", "origin", origin)
+			continue
+		}
+
+		slices.SortFunc(freight, func(lhs, rhs kargoapi.Freight) int {
+			return rhs.CreationTimestamp.Time.Compare(lhs.CreationTimestamp.Time)
+		})
+		latestFreight := freight[0]
+
+		freightLogger := logger.WithValues("origin", origin, "freight", latestFreight.Name)
+
+		if ok, err := r.isAutoPromotionExpressionSatisfied(ctx, stage, &latestFreight); err != nil {
+			return err
+		} else if !ok {
+			freightLogger.Debug("Freight does not satisfy the Stage's auto-promotion expression")
+			continue
+		}
+
+		promos := kargoapi.PromotionList{}
+		if err = r.listPromosFn(
+			ctx,
+			&promos,
+			&client.ListOptions{
+				Namespace: stage.Namespace,
+				FieldSelector: fields.OneTermEqualSelector(
+					kubeclient.PromotionsByStageAndFreightIndexField,
+					kubeclient.StageAndFreightKey(stage.Name, latestFreight.Name),
+				),
+			},
+		); err != nil {
+			return fmt.Errorf(
+				"error listing existing Promotions for Freight %q in namespace %q: %w",
+				latestFreight.Name,
+				stage.Namespace,
+				err,
+			)
+		}
+		if slices.ContainsFunc(promos.Items, func(promo kargoapi.Promotion) bool {
+			return !promo.Status.Phase.IsTerminal()
+		}) {
+			freightLogger.Debug("Promotion of Freight is already underway")
+			continue
+		}
+
+		freightLogger.Debug("promoting Freight to Stage on schedule")
+		promo := kargo.NewPromotion(ctx, *stage, latestFreight.Name)
+		if err = r.createPromotionFn(ctx, &promo); err != nil {
+			return fmt.Errorf(
+				"error creating Promotion of Stage %q in namespace %q to Freight %q: %w",
+				stage.Name,
+				stage.Namespace,
+				latestFreight.Name,
+				err,
+			)
+		}
+
+		r.recorder.AnnotatedEventf(
+			&promo,
+			kargoapi.NewPromotionEventAnnotations(
+				ctx,
+				kargoapi.FormatEventControllerActor(r.cfg.Name()),
+				&promo,
+				&latestFreight,
+			),
+			corev1.EventTypeNormal,
+			kargoapi.EventReasonPromotionCreated,
+			"Promoted Freight from origin %q for Stage %q on schedule",
+			origin,
+			promo.Spec.Stage,
+		)
+
+		freightLogger.Debug(
+			"created Promotion resource",
+			"promotion", promo.Name,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,350 @@
+package stages
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
+)
+
+func TestGetPromotionSchedule(t *testing.T) {
+	now := fakeNow()
+	testCases := []struct {
+		name       string
+		schedule   string
+		scheduled  *metav1.Time
+		assertions func(*testing.T, bool, *metav1.Time, error)
+	}{
+		{
+			name: "no schedule",
+			// A leftover scheduled time should be cleared
+			scheduled: &metav1.Time{Time: now.Add(-time.Hour)},
+			assertions: func(t *testing.T, due bool, next *metav1.Time, err error) {
+				require.NoError(t, err)
+				require.False(t, due)
+				require.Nil(t, next)
+			},
+		},
+		{
+			name:     "invalid schedule",
+			schedule: "every night",
+			assertions: func(t *testing.T, _ bool, _ *metav1.Time, err error) {
+				require.ErrorContains(t, err, "error parsing promotion schedule")
+			},
+		},
+		{
+			name:     "not previously scheduled",
+			schedule: "30 1 * * *",
+			assertions: func(t *testing.T, due bool, next *metav1.Time, err error) {
+				require.NoError(t, err)
+				require.False(t, due)
+				require.Equal(t, now.Add(90*time.Minute), next.Time)
+			},
+		},
+		{
+			name:      "scheduled in the future",
+			schedule:  "30 1 * * *",
+			scheduled: &metav1.Time{Time: now.Add(90 * time.Minute)},
+			assertions: func(t *testing.T, due bool, next *metav1.Time, err error) {
+				require.NoError(t, err)
+				require.False(t, due)
+				require.Equal(t, now.Add(90*time.Minute), next.Time)
+			},
+		},
+		{
+			name:      "scheduled now",
+			schedule:  "@daily",
+			scheduled: &metav1.Time{Time: now},
+			assertions: func(t *testing.T, due bool, next *metav1.Time, err error) {
+				require.NoError(t, err)
+				require.True(t, due)
+				require.Equal(t, now.AddDate(0, 0, 1), next.Time)
+			},
+		},
+		{
+			name:      "scheduled in the past",
+			schedule:  "@hourly",
+			scheduled: &metav1.Time{Time: now.Add(-3 * time.Hour)},
+			assertions: func(t *testing.T, due bool, next *metav1.Time, err error) {
+				require.NoError(t, err)
+				require.True(t, due)
+				require.Equal(t, now.Add(time.Hour), next.Time)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			stage := &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionSchedule: testCase.schedule,
+				},
+			}
+			due, next, err := getPromotionSchedule(stage, testCase.scheduled, now)
+			testCase.assertions(t, due, next, err)
+		})
+	}
+}
+
+func TestPromoteOnSchedule(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	testFreight := map[string][]kargoapi.Freight{
+		"Warehouse/fake-warehouse": {
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "fake-freight-1",
+					CreationTimestamp: metav1.NewTime(fakeTime.Add(-time.Hour)),
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "fake-freight-2",
+					CreationTimestamp: metav1.NewTime(fakeTime),
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		name                    string
+		autoPromotionExpression string
+		reconciler              *reconciler
+		assertions              func(*testing.T, *fakeevent.EventRecorder, []string, error)
+	}{
+		{
+			name: "error getting available Freight",
+			reconciler: &reconciler{
+				getAvailableFreightByOriginFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, _ []string, err error) {
+				require.ErrorContains(t, err, "error finding latest Freight")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name:                    "Freight does not satisfy auto-promotion expression",
+			autoPromotionExpression: `freight.metadata.name != "fake-freight-2"`,
+			reconciler: &reconciler{
+				getAvailableFreightByOriginFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return testFreight, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				promoted []string,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Empty(t, promoted)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name:                    "auto-promotion expression cannot be evaluated for Freight",
+			autoPromotionExpression: `freight.images[0].tag == "v1.0.0"`,
+			reconciler: &reconciler{
+				getAvailableFreightByOriginFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return testFreight, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				promoted []string,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Empty(t, promoted)
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonAutoPromotionExpressionFailed, event.Reason)
+			},
+		},
+		{
+			name: "error listing Promotions",
+			reconciler: &reconciler{
+				getAvailableFreightByOriginFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return testFreight, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, _ []string, err error) {
+				require.ErrorContains(t, err, "error listing existing Promotions")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "Promotion already underway",
+			reconciler: &reconciler{
+				getAvailableFreightByOriginFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return testFreight, nil
+				},
+				listPromosFn: func(
+					_ context.Context,
+					obj client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := obj.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{
+						{
+							Status: kargoapi.PromotionStatus{
+								Phase: kargoapi.PromotionPhaseSucceeded,
+							},
+						},
+						{
+							Status: kargoapi.PromotionStatus{
+								Phase: kargoapi.PromotionPhaseRunning,
+							},
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				promoted []string,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Empty(t, promoted)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "error creating Promotion",
+			reconciler: &reconciler{
+				getAvailableFreightByOriginFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return testFreight, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, _ []string, err error) {
+				require.ErrorContains(t, err, "error creating Promotion")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "success",
+			reconciler: &reconciler{
+				getAvailableFreightByOriginFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return testFreight, nil
+				},
+				listPromosFn: func(
+					_ context.Context,
+					obj client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					// A previous, completed Promotion of the same Freight does not
+					// prevent it from being promoted again.
+					promos, ok := obj.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{
+						{
+							Status: kargoapi.PromotionStatus{
+								Phase: kargoapi.PromotionPhaseSucceeded,
+							},
+						},
+					}
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				promoted []string,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"fake-freight-2"}, promoted)
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonPromotionCreated, event.Reason)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := fakeevent.NewEventRecorder(10)
+			testCase.reconciler.recorder = recorder
+			var promoted []string
+			if testCase.reconciler.createPromotionFn == nil {
+				testCase.reconciler.createPromotionFn = func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					promo, ok := obj.(*kargoapi.Promotion)
+					require.True(t, ok)
+					promoted = append(promoted, promo.Spec.Freight)
+					return nil
+				}
+			}
+			stage := testStage.DeepCopy()
+			stage.Spec.AutoPromotionExpression = testCase.autoPromotionExpression
+			err := testCase.reconciler.promoteOnSchedule(context.Background(), stage)
+			testCase.assertions(t, recorder, promoted, err)
+		})
+	}
+}
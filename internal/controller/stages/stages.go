@@ -2,12 +2,15 @@ package stages
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -21,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
@@ -29,6 +33,7 @@ import (
 	"github.com/akuity/kargo/internal/controller"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
 	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
+	"github.com/akuity/kargo/internal/expression"
 	"github.com/akuity/kargo/internal/kargo"
 	"github.com/akuity/kargo/internal/kubeclient"
 	libEvent "github.com/akuity/kargo/internal/kubernetes/event"
@@ -37,9 +42,14 @@ import (
 
 // ReconcilerConfig represents configuration for the stage reconciler.
 type ReconcilerConfig struct {
+	controller.WatchedNamespaces
 	ShardName                    string `envconfig:"SHARD_NAME"`
 	RolloutsIntegrationEnabled   bool   `envconfig:"ROLLOUTS_INTEGRATION_ENABLED"`
 	RolloutsControllerInstanceID string `envconfig:"ROLLOUTS_CONTROLLER_INSTANCE_ID"`
+	// HealthStalenessThreshold is the age beyond which the recorded health of a
+	// Stage is repaired when the Stage is first reconciled after the controller
+	// has started.
+	HealthStalenessThreshold time.Duration `envconfig:"HEALTH_STALENESS_THRESHOLD" default:"10m"`
 }
 
 func (c ReconcilerConfig) Name() string {
@@ -65,6 +75,26 @@ type reconciler struct {
 
 	cfg ReconcilerConfig
 
+	metrics *metrics
+
+	notifier *webhookNotifier
+
+	// syncFailures tracks the number of consecutive failed reconciliations of
+	// each Stage. It is used to compute delays for Stages that specify a sync
+	// backoff policy.
+	syncFailures   map[types.NamespacedName]int
+	syncFailuresMu sync.Mutex
+
+	// healthRepaired tracks the Stages whose health has been repaired, if
+	// necessary, since the controller started. See repairHealth.
+	healthRepaired   map[types.NamespacedName]struct{}
+	healthRepairedMu sync.Mutex
+
+	// pendingPromotionEvents holds the newly terminated Promotions of each
+	// Stage for which events are yet to be recorded. See syncPromotions.
+	pendingPromotionEvents   map[types.NamespacedName][]kargoapi.PromotionReference
+	pendingPromotionEventsMu sync.Mutex
+
 	// The following behaviors are overridable for testing purposes:
 
 	// Promotion-related:
@@ -93,6 +123,35 @@ type reconciler struct {
 
 	appHealth libargocd.ApplicationHealthEvaluator
 
+	checkHTTPHealthFn func(
+		context.Context,
+		kargoapi.HTTPHealthCheck,
+	) (kargoapi.HealthState, error)
+
+	checkArgoCDHealthFn func(
+		context.Context,
+		[]kargoapi.ArgoCDAppStatusCheck,
+	) *kargoapi.Health
+
+	getArgoCDAppFn func(
+		ctx context.Context,
+		ctrlRuntimeClient client.Client,
+		namespace string,
+		name string,
+	) (*argocd.Application, error)
+
+	checkPodImageHealthFn func(
+		context.Context,
+		*kargoapi.Stage,
+		kargoapi.PodImageHealthCheck,
+	) (kargoapi.HealthState, error)
+
+	listPodsFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+
 	// Freight verification:
 
 	startVerificationFn func(
@@ -191,6 +250,12 @@ type reconciler struct {
 		...client.CreateOption,
 	) error
 
+	clearPromoteFreightRequestFn func(
+		context.Context,
+		client.Client,
+		*kargoapi.Stage,
+	) error
+
 	// Discovering Freight:
 
 	getAvailableFreightFn func(
@@ -228,6 +293,7 @@ func SetupReconcilerWithManager(
 	ctx context.Context,
 	kargoMgr manager.Manager,
 	argocdMgr manager.Manager,
+	argocdRemoteClients libargocd.RemoteClients,
 	cfg ReconcilerConfig,
 ) error {
 	// Index Promotions by Stage
@@ -273,6 +339,11 @@ func SetupReconcilerWithManager(
 		return fmt.Errorf("index Stages by Argo CD Applications: %w", err)
 	}
 
+	// Index Stages by PromotionTemplate
+	if err := kubeclient.IndexStagesByPromotionTemplate(ctx, kargoMgr); err != nil {
+		return fmt.Errorf("index Stages by PromotionTemplate: %w", err)
+	}
+
 	// Index Stages by AnalysisRun
 	if err := kubeclient.IndexStagesByAnalysisRun(ctx, kargoMgr, cfg.ShardName); err != nil {
 		return fmt.Errorf("index Stages by Argo Rollouts AnalysisRun: %w", err)
@@ -311,17 +382,21 @@ func SetupReconcilerWithManager(
 				kargo.RefreshRequested{},
 				kargo.ReverifyRequested{},
 				kargo.AbortRequested{},
+				kargo.PromoteFreightRequested{},
 			),
 		).
 		WithEventFilter(shardPredicate).
+		WithEventFilter(cfg.WatchedNamespaces.Predicate()).
 		WithOptions(controller.CommonOptions()).
 		Build(
 			newReconciler(
 				kargoMgr.GetClient(),
 				argocdClient,
+				argocdRemoteClients,
 				libEvent.NewRecorder(ctx, kargoMgr.GetScheme(), kargoMgr.GetClient(), cfg.Name()),
 				cfg,
 				shardRequirement,
+				ctrlmetrics.Registry,
 			),
 		)
 	if err != nil {
@@ -391,6 +466,21 @@ func SetupReconcilerWithManager(
 		return fmt.Errorf("unable to watch Freight: %w", err)
 	}
 
+	// Watch PromotionTemplates and enqueue the Stages referencing them
+	promotionTemplateHandler := &promotionTemplateEventHandler[*kargoapi.PromotionTemplate]{
+		kargoClient:   kargoMgr.GetClient(),
+		shardSelector: shardSelector,
+	}
+	if err := c.Watch(
+		source.Kind(
+			kargoMgr.GetCache(),
+			&kargoapi.PromotionTemplate{},
+			promotionTemplateHandler,
+		),
+	); err != nil {
+		return fmt.Errorf("unable to watch PromotionTemplates: %w", err)
+	}
+
 	// If Argo CD integration is disabled, this manager will be nil and we won't
 	// care about this watch anyway.
 	if argocdMgr != nil {
@@ -432,20 +522,28 @@ func SetupReconcilerWithManager(
 func newReconciler(
 	kargoClient client.Client,
 	argocdClient client.Client,
+	argocdRemoteClients libargocd.RemoteClients,
 	recorder record.EventRecorder,
 	cfg ReconcilerConfig,
 	shardRequirement *labels.Requirement,
+	metricsRegisterer prometheus.Registerer,
 ) *reconciler {
 	r := &reconciler{
 		kargoClient:  kargoClient,
 		argocdClient: argocdClient,
 		recorder:     recorder,
 		cfg:          cfg,
+		metrics:      newMetrics(metricsRegisterer),
+		notifier:     newWebhookNotifier(),
 		appHealth: libargocd.NewApplicationHealthEvaluator(
 			kargoClient,
 			argocdClient,
+			argocdRemoteClients,
 		),
-		shardRequirement: shardRequirement,
+		shardRequirement:       shardRequirement,
+		syncFailures:           map[types.NamespacedName]int{},
+		healthRepaired:         map[types.NamespacedName]struct{}{},
+		pendingPromotionEvents: map[types.NamespacedName][]kargoapi.PromotionReference{},
 	}
 	// The following default behaviors are overridable for testing purposes:
 	// Promotion-related:
@@ -453,6 +551,12 @@ func newReconciler(
 	r.syncPromotionsFn = r.syncPromotions
 	r.listPromosFn = r.kargoClient.List
 	r.getPromotionsForStageFn = r.getPromotionsForStage
+	// Health checks:
+	r.checkHTTPHealthFn = r.checkHTTPHealth
+	r.checkArgoCDHealthFn = r.checkArgoCDHealth
+	r.getArgoCDAppFn = argocd.GetApplication
+	r.checkPodImageHealthFn = r.checkPodImageHealth
+	r.listPodsFn = r.kargoClient.List
 	// Freight verification:
 	r.startVerificationFn = r.startVerification
 	r.abortVerificationFn = r.abortVerification
@@ -470,6 +574,7 @@ func newReconciler(
 	r.isAutoPromotionPermittedFn = r.isAutoPromotionPermitted
 	r.getProjectFn = kargoapi.GetProject
 	r.createPromotionFn = kargoClient.Create
+	r.clearPromoteFreightRequestFn = kargoapi.ClearStagePromoteFreightRequest
 	// Discovering Freight:
 	r.getAvailableFreightFn = r.getAvailableFreight
 	r.getAvailableFreightByOriginFn = r.getAvailableFreightByOrigin
@@ -492,6 +597,13 @@ func (r *reconciler) Reconcile(
 		"stage", req.NamespacedName.Name,
 	)
 	ctx = logging.ContextWithLogger(ctx, logger)
+
+	// Stages may be enqueued by watches of other resources that are not subject
+	// to the watched namespaces predicate.
+	if !r.cfg.IsWatched(req.NamespacedName.Namespace) {
+		logger.Debug("ignoring Stage in namespace that is not watched")
+		return ctrl.Result{}, nil
+	}
 	logger.Debug("reconciling Stage")
 
 	// Find the Stage
@@ -502,6 +614,10 @@ func (r *reconciler) Reconcile(
 	if stage == nil {
 		// Ignore if not found. This can happen if the Stage was deleted after the
 		// current reconciliation request was issued.
+		r.forgetSyncFailures(req.NamespacedName)
+		r.forgetHealthRepair(req.NamespacedName)
+		r.metrics.forget(req.NamespacedName.Namespace, req.NamespacedName.Name)
+		r.notifier.forget(req.NamespacedName)
 		return ctrl.Result{}, nil // Do not requeue
 	}
 
@@ -511,6 +627,10 @@ func (r *reconciler) Reconcile(
 	}
 	logger.Debug("found Stage")
 
+	// Keep a copy of the health of the Stage as of the previous reconciliation,
+	// so that transitions can be reported once the new health is known.
+	previousHealth := stage.Status.Health.DeepCopy()
+
 	var newStatus kargoapi.StageStatus
 	if stage.DeletionTimestamp != nil {
 		newStatus, err = r.syncStageDelete(ctx, stage)
@@ -522,14 +642,31 @@ func (r *reconciler) Reconcile(
 	} else {
 		if _, err = kargoapi.EnsureFinalizer(ctx, r.kargoClient, stage); err != nil {
 			newStatus = stage.Status
+		} else if stage.Spec.PromotionMechanisms, err =
+			kargoapi.ResolvePromotionMechanisms(ctx, r.kargoClient, stage); err != nil {
+			// The Stage's PromotionMechanisms may be described, in part or in
+			// full, by a PromotionTemplate, which must exist.
+			newStatus = stage.Status
 		} else {
-			if stage.Spec.PromotionMechanisms == nil {
+			switch {
+			case stage.Spec.PromotionMechanisms == nil:
 				newStatus, err = r.syncControlFlowStage(ctx, stage)
-			} else {
+			case kargoapi.HealthCheckOnlyAnnotationValue(stage.GetAnnotations()):
+				newStatus = r.syncStageHealth(ctx, stage)
+			default:
+				if repairErr := r.repairHealth(ctx, stage); repairErr != nil {
+					// Not fatal, as the health of the Stage is re-assessed by
+					// syncNormalStage anyway.
+					logger.Error(repairErr, "error repairing Stage health")
+				}
 				newStatus, err = r.syncNormalStage(ctx, stage)
 			}
 		}
 	}
+	r.metrics.recordHealth(stage, newStatus.Health)
+	// Keep the compact representation of the Freight history in step with the
+	// Freight history, whichever part of the sync changed the latter.
+	newStatus.FreightHistoryDeltas = buildFreightHistoryDeltas(ctx, newStatus.FreightHistory)
 	if err != nil {
 		newStatus.Message = err.Error()
 		logger.Error(err, "error syncing Stage")
@@ -543,16 +680,31 @@ func (r *reconciler) Reconcile(
 		newStatus.Message = ""
 	}
 
+	// Record the outcome of any notifications delivered since the last
+	// reconciliation.
+	newStatus.NotificationWebhooks = r.notifier.syncStatuses(stage, newStatus.NotificationWebhooks)
+
 	// Record the current refresh token as having been handled.
 	if token, ok := kargoapi.RefreshAnnotationValue(stage.GetAnnotations()); ok {
 		newStatus.LastHandledRefresh = token
 	}
 
-	updateErr := kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
+	newStatus.Conditions = buildConditions(stage, newStatus, err, r.nowFn())
+
+	updateErr := kubeclient.JSONPatchStatus(ctx, r.kargoClient, stage, func(status *kargoapi.StageStatus) {
 		*status = newStatus
 	})
+	promoEvents := r.takePendingPromotionEvents(req.NamespacedName)
 	if updateErr != nil {
 		logger.Error(updateErr, "error updating Stage status")
+	} else {
+		// Only report a health transition or newly terminated Promotions once
+		// they have been persisted, to prevent them from being reported again by
+		// the next reconciliation.
+		r.recordHealthEvent(stage, previousHealth, newStatus.Health)
+		for _, promo := range promoEvents {
+			r.recordPromotionEvent(stage, promo)
+		}
 	}
 
 	// If we had no error, but couldn't update, then we DO have an error. But we
@@ -561,18 +713,95 @@ func (r *reconciler) Reconcile(
 	if err == nil {
 		err = updateErr
 	}
+	r.metrics.recordSync(stage, err)
 	logger.Debug("done reconciling Stage")
 
 	// If we do have an error at this point, return it so controller runtime
-	// retries with a progressive backoff.
+	// retries with a progressive backoff, unless the Stage specifies its own
+	// backoff policy.
 	if err != nil {
-		return ctrl.Result{}, err
+		return r.resultForSyncError(ctx, stage, err)
 	}
+	r.forgetSyncFailures(req.NamespacedName)
 
 	// Everything succeeded, look for new changes on the defined interval.
 	//
 	// TODO: Make this configurable
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	requeueAfter := 5 * time.Minute
+	// If the Stage's circuit is open, make sure to look again as soon as its
+	// cooldown has elapsed.
+	if state, remaining := getCircuitState(
+		stage,
+		newStatus.CircuitBreaker,
+		r.nowFn(),
+	); state == circuitOpen && remaining < requeueAfter {
+		requeueAfter = remaining
+	}
+	// If the Stage is scheduled to be promoted before then, make sure to look
+	// again in time.
+	if next := newStatus.NextScheduledPromotion; next != nil {
+		if untilNext := next.Sub(r.nowFn()); untilNext < requeueAfter {
+			requeueAfter = max(untilNext, time.Second)
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// buildFreightHistoryDeltas returns the compact representation of the provided
+// FreightHistory that is recorded in StageStatus.FreightHistoryDeltas. As the
+// FreightHistory itself remains authoritative, a failure to build it is logged
+// and nil is returned instead of failing the reconciliation.
+func buildFreightHistoryDeltas(
+	ctx context.Context,
+	history kargoapi.FreightHistory,
+) []kargoapi.FreightCollectionDelta {
+	deltas, err := history.Deltas()
+	if err != nil {
+		logging.LoggerFromContext(ctx).Error(err, "error building Freight history deltas")
+		return nil
+	}
+	return deltas
+}
+
+// resultForSyncError records a failed reconciliation of the provided Stage and
+// returns the result of the reconciliation. If the Stage specifies a sync
+// backoff policy, the result requeues the Stage after a delay computed from
+// the number of consecutive failures and the error is swallowed, as it has
+// already been logged and recorded in the Stage's status. Otherwise, the error
+// is returned so that controller runtime retries with its own backoff.
+func (r *reconciler) resultForSyncError(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	err error,
+) (ctrl.Result, error) {
+	key := types.NamespacedName{
+		Namespace: stage.Namespace,
+		Name:      stage.Name,
+	}
+	r.syncFailuresMu.Lock()
+	r.syncFailures[key]++
+	failures := r.syncFailures[key]
+	r.syncFailuresMu.Unlock()
+
+	policy, ok := kargoapi.SyncBackoffPolicyAnnotationValue(stage.GetAnnotations())
+	if !ok {
+		return ctrl.Result{}, err
+	}
+	delay := policy.Delay(failures)
+	logging.LoggerFromContext(ctx).Debug(
+		"backing off before reconciling Stage again",
+		"failures", failures,
+		"delay", delay,
+	)
+	return ctrl.Result{RequeueAfter: delay}, nil
+}
+
+// forgetSyncFailures resets the number of consecutive failed reconciliations
+// of the specified Stage.
+func (r *reconciler) forgetSyncFailures(key types.NamespacedName) {
+	r.syncFailuresMu.Lock()
+	defer r.syncFailuresMu.Unlock()
+	delete(r.syncFailures, key)
 }
 
 func (r *reconciler) syncControlFlowStage(
@@ -646,6 +875,31 @@ func (r *reconciler) syncControlFlowStage(
 	return status, nil
 }
 
+// syncStageHealth re-assesses the health of the Argo CD Applications
+// associated with the provided Stage and returns a copy of the Stage's status
+// in which only the health has been updated. Unlike syncNormalStage, it does
+// not sync Promotions, perform verification, alter the Stage's phase or
+// Freight history, or trigger auto-promotion, which makes it suitable for
+// isolating health flapping.
+func (r *reconciler) syncStageHealth(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) kargoapi.StageStatus {
+	logger := logging.LoggerFromContext(ctx)
+	status := *stage.Status.DeepCopy()
+	status.Health = nil
+	if currentFC := status.FreightHistory.Current(); currentFC == nil || len(currentFC.Freight) == 0 {
+		logger.Debug("Stage has no current Freight; no health checks to perform")
+		return status
+	}
+	if status.Health = r.assessHealth(ctx, stage); status.Health != nil {
+		logger.WithValues("health", status.Health.Status).Debug("Stage health assessed")
+	} else {
+		logger.Debug("Stage health deemed not applicable")
+	}
+	return status
+}
+
 func (r *reconciler) syncNormalStage(
 	ctx context.Context,
 	stage *kargoapi.Stage,
@@ -660,7 +914,7 @@ func (r *reconciler) syncNormalStage(
 	if status, syncErr = r.syncPromotionsFn(ctx, stage, status); syncErr != nil {
 		return status, syncErr
 	}
-	if err := kubeclient.PatchStatus(ctx, r.kargoClient, stage, func(s *kargoapi.StageStatus) {
+	if err := kubeclient.JSONPatchStatus(ctx, r.kargoClient, stage, func(s *kargoapi.StageStatus) {
 		*s = status
 	}); err != nil {
 		return status, err
@@ -681,9 +935,9 @@ func (r *reconciler) syncNormalStage(
 		)
 	} else {
 		// Always check the health of the Argo CD Applications associated with the
-		// Stage. This is regardless of the phase of the Stage, as the health of the
-		// Argo CD Applications is always relevant.
-		if status.Health = r.appHealth.EvaluateHealth(
+		// Stage and of any HTTP endpoints it specifies. This is regardless of the
+		// phase of the Stage, as their health is always relevant.
+		if status.Health = r.assessHealth(
 			ctx,
 			stage,
 		); status.Health != nil {
@@ -867,6 +1121,35 @@ func (r *reconciler) syncNormalStage(
 		return status, nil
 	}
 
+	// A request to promote a specific piece of Freight is honored regardless of
+	// whether auto-promotion is permitted.
+	if err := r.promoteRequestedFreight(ctx, stage, status.FreightHistory.Current()); err != nil {
+		return status, err
+	}
+
+	// The time at which the Stage is next scheduled to be promoted is only
+	// reported while scheduled Promotions are not suspended. It is computed
+	// again below once it is known that they are not.
+	lastScheduledPromotion := status.NextScheduledPromotion
+	status.NextScheduledPromotion = nil
+
+	// Auto-promotion is suspended while the Stage is pinned to a specific piece
+	// of Freight, regardless of whether it is otherwise permitted.
+	if stage.Spec.PinnedFreight != "" {
+		logger.Debug(
+			"Stage is pinned; auto-promotion is suspended",
+			"pinnedFreight", stage.Spec.PinnedFreight,
+		)
+		if err := r.recordPinnedFreightSupersededEvents(
+			ctx,
+			stage,
+			status.FreightHistory.Current(),
+		); err != nil {
+			return status, err
+		}
+		return status, nil
+	}
+
 	logger.Debug("checking if auto-promotion is permitted...")
 	if permitted, err := r.isAutoPromotionPermittedFn(ctx, stage.Namespace, stage.Name); err != nil {
 		return status, fmt.Errorf(
@@ -880,6 +1163,49 @@ func (r *reconciler) syncNormalStage(
 		return status, nil
 	}
 
+	// Auto-promotion is suspended while the Stage's circuit is open. Once the
+	// circuit is half-open, a single Promotion is permitted to determine
+	// whether it should close or open again.
+	switch state, remaining := getCircuitState(stage, status.CircuitBreaker, r.nowFn()); {
+	case state == circuitOpen:
+		logger.Debug(
+			"circuit is open; auto-promotion is suspended",
+			"remaining", remaining,
+		)
+		return status, nil
+	case state == circuitHalfOpen && status.CurrentPromotion != nil:
+		logger.Debug("circuit is half-open and a Promotion is already in progress")
+		return status, nil
+	}
+
+	// A Stage with a promotion schedule is only promoted at the scheduled times,
+	// and then to the latest available Freight, even if it is already current.
+	if stage.Spec.PromotionSchedule != "" {
+		scheduledPromotionDue, nextScheduledPromotion, err := getPromotionSchedule(
+			stage,
+			lastScheduledPromotion,
+			r.nowFn(),
+		)
+		if err != nil {
+			return status, err
+		}
+		status.NextScheduledPromotion = nextScheduledPromotion
+		if !scheduledPromotionDue {
+			logger.Debug(
+				"no scheduled Promotion is due",
+				"nextScheduledPromotion", status.NextScheduledPromotion,
+			)
+			return status, nil
+		}
+		if err = r.promoteOnSchedule(ctx, stage); err != nil {
+			// Keep the time of the missed scheduled Promotion so that it is
+			// retried by the next reconciliation.
+			status.NextScheduledPromotion = lastScheduledPromotion
+			return status, err
+		}
+		return status, nil
+	}
+
 	// If we get to here, auto-promotion is permitted. Time to go looking for new
 	// Freight...
 	availableFreight, err := r.getAvailableFreightByOriginFn(ctx, stage, true)
@@ -892,6 +1218,12 @@ func (r *reconciler) syncNormalStage(
 		)
 	}
 
+	var availableCount int
+	for _, freight := range availableFreight {
+		availableCount += len(freight)
+	}
+	r.metrics.recordAvailableFreight(stage, availableCount)
+
 	// Get the current Freight to run further comparisons against.
 	currentFreight := status.FreightHistory.Current()
 
@@ -923,6 +1255,13 @@ func (r *reconciler) syncNormalStage(
 			}
 		}
 
+		if ok, err := r.isAutoPromotionExpressionSatisfied(ctx, stage, &latestFreight); err != nil {
+			return status, err
+		} else if !ok {
+			freightLogger.Debug("Freight does not satisfy the Stage's auto-promotion expression")
+			continue
+		}
+
 		// If a promotion already exists for this Stage + Freight, then we're
 		// disqualified from auto-promotion for this origin.
 		promos := kargoapi.PromotionList{}
@@ -987,6 +1326,244 @@ func (r *reconciler) syncNormalStage(
 	return status, nil
 }
 
+// isAutoPromotionExpressionSatisfied returns whether the provided Freight
+// satisfies the auto-promotion expression of the provided Stage, if any. An
+// expression that is valid, but cannot be evaluated for the provided Freight,
+// e.g. because it refers to an image the Freight does not reference, is not
+// satisfied. As this only concerns the provided Freight, it does not fail the
+// sync of the Stage. Instead, it is logged and recorded in a Warning event.
+func (r *reconciler) isAutoPromotionExpressionSatisfied(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight *kargoapi.Freight,
+) (bool, error) {
+	if stage.Spec.AutoPromotionExpression == "" {
+		return true, nil
+	}
+	ok, err := expression.EvaluateAutoPromotion(
+		stage.Spec.AutoPromotionExpression,
+		stage,
+		freight,
+	)
+	var evalErr *expression.EvaluationError
+	if errors.As(err, &evalErr) {
+		logging.LoggerFromContext(ctx).Error(
+			err, "error evaluating auto-promotion expression; Freight will not be promoted",
+			"freight", freight.Name,
+		)
+		r.recorder.AnnotatedEventf(
+			stage,
+			map[string]string{
+				kargoapi.AnnotationKeyEventActor:       kargoapi.FormatEventControllerActor(r.cfg.Name()),
+				kargoapi.AnnotationKeyEventProject:     stage.Namespace,
+				kargoapi.AnnotationKeyEventStageName:   stage.Name,
+				kargoapi.AnnotationKeyEventFreightName: freight.Name,
+			},
+			corev1.EventTypeWarning,
+			kargoapi.EventReasonAutoPromotionExpressionFailed,
+			"Auto-promotion expression could not be evaluated for Freight %q; it will not "+
+				"be promoted automatically: %s",
+			freight.Name,
+			err,
+		)
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf(
+			"error evaluating auto-promotion expression of Stage %q in namespace %q "+
+				"for Freight %q: %w",
+			stage.Name,
+			stage.Namespace,
+			freight.Name,
+			err,
+		)
+	}
+	return ok, nil
+}
+
+// recordPinnedFreightSupersededEvents records a Warning event for the provided
+// pinned Stage for every origin from which Freight newer than the Stage's
+// current Freight is available, so that operators are made aware of the
+// Freight that is being held back by the pin.
+func (r *reconciler) recordPinnedFreightSupersededEvents(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	currentFC *kargoapi.FreightCollection,
+) error {
+	availableFreight, err := r.getAvailableFreightByOriginFn(ctx, stage, true)
+	if err != nil {
+		return fmt.Errorf(
+			"error finding latest Freight for Stage %q in namespace %q: %w",
+			stage.Name,
+			stage.Namespace,
+			err,
+		)
+	}
+	for origin, freight := range availableFreight {
+		if len(freight) == 0 {
+			continue
+		}
+		latestFreight := slices.MaxFunc(freight, func(lhs, rhs kargoapi.Freight) int {
+			return lhs.CreationTimestamp.Time.Compare(rhs.CreationTimestamp.Time)
+		})
+		if latestFreight.Name == stage.Spec.PinnedFreight {
+			continue
+		}
+		if currentFC != nil {
+			if freightRef, ok := currentFC.Freight[origin]; ok &&
+				freightRef.Name == latestFreight.Name {
+				continue
+			}
+		}
+		r.recorder.AnnotatedEventf(
+			stage,
+			map[string]string{
+				kargoapi.AnnotationKeyEventActor:       kargoapi.FormatEventControllerActor(r.cfg.Name()),
+				kargoapi.AnnotationKeyEventProject:     stage.Namespace,
+				kargoapi.AnnotationKeyEventStageName:   stage.Name,
+				kargoapi.AnnotationKeyEventFreightName: latestFreight.Name,
+			},
+			corev1.EventTypeWarning,
+			kargoapi.EventReasonPinnedFreightSuperseded,
+			"Stage is pinned to Freight %q; newer Freight %q from origin %q will not be "+
+				"promoted automatically",
+			stage.Spec.PinnedFreight,
+			latestFreight.Name,
+			origin,
+		)
+	}
+	return nil
+}
+
+// promoteRequestedFreight creates a Promotion of the provided Stage to the
+// Freight named by the Stage's AnnotationKeyPromoteFreight annotation, if
+// present, and then removes the annotation. If the requested Freight is
+// already current in the Stage, or a Promotion of the Stage to that Freight is
+// already pending or running, the annotation is removed without creating a
+// Promotion. Because only such Promotions are considered, Freight that was
+// previously promoted to the Stage can be requested again to roll the Stage
+// back to it. If the requested Freight does not exist, an error is returned
+// and the annotation is left in place so that the request can be corrected.
+func (r *reconciler) promoteRequestedFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	currentFC *kargoapi.FreightCollection,
+) error {
+	freightName, ok := kargoapi.PromoteFreightAnnotationValue(stage.GetAnnotations())
+	if !ok {
+		return nil
+	}
+	logger := logging.LoggerFromContext(ctx).WithValues("freight", freightName)
+
+	clearRequest := func() error {
+		if err := r.clearPromoteFreightRequestFn(ctx, r.kargoClient, stage); err != nil {
+			return fmt.Errorf(
+				"error clearing request to promote Freight %q to Stage %q in namespace %q: %w",
+				freightName,
+				stage.Name,
+				stage.Namespace,
+				err,
+			)
+		}
+		return nil
+	}
+
+	if currentFC != nil {
+		for _, ref := range currentFC.Freight {
+			if ref.Name == freightName {
+				logger.Debug("requested Freight is already current in the Stage")
+				return clearRequest()
+			}
+		}
+	}
+
+	freight, err := r.getFreightFn(
+		ctx,
+		r.kargoClient,
+		types.NamespacedName{
+			Namespace: stage.Namespace,
+			Name:      freightName,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"error getting Freight %q in namespace %q: %w",
+			freightName,
+			stage.Namespace,
+			err,
+		)
+	}
+	if freight == nil {
+		return fmt.Errorf(
+			"Freight %q requested for promotion to Stage %q not found in namespace %q",
+			freightName,
+			stage.Name,
+			stage.Namespace,
+		)
+	}
+
+	promos := kargoapi.PromotionList{}
+	if err = r.listPromosFn(
+		ctx,
+		&promos,
+		&client.ListOptions{
+			Namespace: stage.Namespace,
+			FieldSelector: fields.OneTermEqualSelector(
+				kubeclient.PromotionsByStageAndFreightIndexField,
+				kubeclient.StageAndFreightKey(stage.Name, freightName),
+			),
+		},
+	); err != nil {
+		return fmt.Errorf(
+			"error listing existing Promotions for Freight %q in namespace %q: %w",
+			freightName,
+			stage.Namespace,
+			err,
+		)
+	}
+	for _, promo := range promos.Items {
+		if !promo.Status.Phase.IsTerminal() {
+			logger.Debug("Promotion already in progress for requested Freight")
+			return clearRequest()
+		}
+	}
+
+	logger.Debug("promoting requested Freight to Stage")
+	promo := kargo.NewPromotion(ctx, *stage, freightName)
+	if err = r.createPromotionFn(ctx, &promo); err != nil {
+		return fmt.Errorf(
+			"error creating Promotion of Stage %q in namespace %q to Freight %q: %w",
+			stage.Name,
+			stage.Namespace,
+			freightName,
+			err,
+		)
+	}
+
+	r.recorder.AnnotatedEventf(
+		&promo,
+		kargoapi.NewPromotionEventAnnotations(
+			ctx,
+			kargoapi.FormatEventControllerActor(r.cfg.Name()),
+			&promo,
+			freight,
+		),
+		corev1.EventTypeNormal,
+		kargoapi.EventReasonPromotionCreated,
+		"Promoted Freight %q to Stage %q as requested by annotation %q",
+		freightName,
+		promo.Spec.Stage,
+		kargoapi.AnnotationKeyPromoteFreight,
+	)
+
+	logger.Debug(
+		"created Promotion resource",
+		"promotion", promo.Name,
+	)
+
+	return clearRequest()
+}
+
 // syncPromotions determines the current state of the Stage and its Freight by
 // examining the Promotions that have been created for the Stage. It returns the
 // updated Stage status.
@@ -1049,6 +1626,7 @@ func (r *reconciler) syncPromotions(
 
 		if promo.Status.Phase.IsTerminal() {
 			logger.WithValues("promotion", promo.Name).Debug("found new terminated Promotion")
+			r.metrics.recordPromotion(stage, promo.CreationTimestamp, promo.Status.FinishedAt)
 			info := kargoapi.PromotionReference{
 				Name:       promo.Name,
 				Status:     promo.Status.DeepCopy(),
@@ -1074,17 +1652,70 @@ func (r *reconciler) syncPromotions(
 	for _, p := range newPromotions {
 		promo := p
 		status.LastPromotion = &promo
+		status.CircuitBreaker = r.syncCircuitBreaker(stage, status.CircuitBreaker, promo)
 		if promo.Status.Phase == kargoapi.PromotionPhaseSucceeded {
-			status.FreightHistory.Record(status.LastPromotion.Status.FreightCollection)
+			if promo.Status.DryRun {
+				// A dry-run Promotion did not change anything, so it is recorded
+				// as the last Promotion without altering the Freight history.
+				logger.WithValues("promotion", promo.Name).Debug(
+					"not recording Freight from dry-run Promotion in Freight history",
+				)
+			} else {
+				status.FreightHistory.RecordWithLimit(
+					stage.Spec.GetFreightHistoryLimit(),
+					status.LastPromotion.Status.FreightCollection,
+				)
+				r.notifier.notify(ctx, stage, promo)
+			}
 			if status.CurrentPromotion == nil {
 				status.Phase = kargoapi.StagePhaseSteady
 			}
 		}
 	}
 
+	// Events for the newly terminated Promotions are only recorded once the
+	// updated status has been persisted. Otherwise, the same Promotions would
+	// be discovered and reported again by the next reconciliation.
+	r.setPendingPromotionEvents(stage, newPromotions)
+
 	return status, nil
 }
 
+// setPendingPromotionEvents sets the newly terminated Promotions of the
+// provided Stage for which events are to be recorded once its status has been
+// persisted.
+func (r *reconciler) setPendingPromotionEvents(
+	stage *kargoapi.Stage,
+	promos []kargoapi.PromotionReference,
+) {
+	key := types.NamespacedName{
+		Namespace: stage.Namespace,
+		Name:      stage.Name,
+	}
+	r.pendingPromotionEventsMu.Lock()
+	defer r.pendingPromotionEventsMu.Unlock()
+	if len(promos) == 0 {
+		delete(r.pendingPromotionEvents, key)
+		return
+	}
+	if r.pendingPromotionEvents == nil {
+		r.pendingPromotionEvents = map[types.NamespacedName][]kargoapi.PromotionReference{}
+	}
+	r.pendingPromotionEvents[key] = promos
+}
+
+// takePendingPromotionEvents returns and forgets the newly terminated
+// Promotions of the specified Stage for which events are yet to be recorded.
+func (r *reconciler) takePendingPromotionEvents(
+	key types.NamespacedName,
+) []kargoapi.PromotionReference {
+	r.pendingPromotionEventsMu.Lock()
+	defer r.pendingPromotionEventsMu.Unlock()
+	promos := r.pendingPromotionEvents[key]
+	delete(r.pendingPromotionEvents, key)
+	return promos
+}
+
 func (r *reconciler) syncStageDelete(
 	ctx context.Context,
 	stage *kargoapi.Stage,
@@ -1396,11 +2027,12 @@ func (r *reconciler) getAvailableFreight(
 			availableFreight = append(availableFreight, freight.Items...)
 		}
 		// Get Freight verified in upstream Stages
+		var verifiedFreight []kargoapi.Freight
 		for _, upstream := range req.Sources.Stages {
-			var verifiedFreight kargoapi.FreightList
+			var freight kargoapi.FreightList
 			if err := r.listFreightFn(
 				ctx,
-				&verifiedFreight,
+				&freight,
 				&client.ListOptions{
 					Namespace: stage.Namespace,
 					FieldSelector: fields.OneTermEqualSelector(
@@ -1416,8 +2048,12 @@ func (r *reconciler) getAvailableFreight(
 					err,
 				)
 			}
-			availableFreight = append(availableFreight, verifiedFreight.Items...)
+			verifiedFreight = append(verifiedFreight, freight.Items...)
 		}
+		availableFreight = append(
+			availableFreight,
+			filterVerifiedFreight(verifiedFreight, req.Sources)...,
+		)
 	}
 
 	if includeApproved {
@@ -1496,11 +2132,12 @@ func (r *reconciler) getAvailableFreightByOrigin(
 		}
 
 		// Get Freight verified in upstream Stages
+		var verifiedFreight []kargoapi.Freight
 		for _, upstream := range req.Sources.Stages {
-			var verifiedFreight kargoapi.FreightList
+			var freight kargoapi.FreightList
 			if err := r.listFreightFn(
 				ctx,
-				&verifiedFreight,
+				&freight,
 				&client.ListOptions{
 					Namespace: stage.Namespace,
 					FieldSelector: fields.AndSelectors(
@@ -1525,8 +2162,12 @@ func (r *reconciler) getAvailableFreightByOrigin(
 				)
 			}
 
-			availableFreight[originID] = append(availableFreight[originID], verifiedFreight.Items...)
+			verifiedFreight = append(verifiedFreight, freight.Items...)
 		}
+		availableFreight[originID] = append(
+			availableFreight[originID],
+			filterVerifiedFreight(verifiedFreight, req.Sources)...,
+		)
 
 		if includeApproved {
 			var approvedFreight kargoapi.FreightList
@@ -1574,6 +2215,33 @@ func (r *reconciler) getAvailableFreightByOrigin(
 	return availableFreight, nil
 }
 
+// filterVerifiedFreight de-duplicates the provided Freight, which was found to
+// be verified in at least one of the upstream Stages specified by the provided
+// FreightSources, and returns only the Freight that is available according to
+// the FreightSources' availability strategy.
+func filterVerifiedFreight(
+	freight []kargoapi.Freight,
+	sources kargoapi.FreightSources,
+) []kargoapi.Freight {
+	slices.SortFunc(freight, func(lhs, rhs kargoapi.Freight) int {
+		return strings.Compare(lhs.Name, rhs.Name)
+	})
+	freight = slices.CompactFunc(freight, func(lhs, rhs kargoapi.Freight) bool {
+		return lhs.Name == rhs.Name
+	})
+	if sources.AvailabilityStrategy != kargoapi.FreightAvailabilityStrategyAll {
+		return freight
+	}
+	return slices.DeleteFunc(freight, func(f kargoapi.Freight) bool {
+		for _, upstream := range sources.Stages {
+			if _, ok := f.Status.VerifiedIn[upstream]; !ok {
+				return true
+			}
+		}
+		return false
+	})
+}
+
 func (r *reconciler) recordFreightVerificationEvent(
 	s *kargoapi.Stage,
 	fr *kargoapi.Freight,
@@ -1630,6 +2298,76 @@ func (r *reconciler) recordFreightVerificationEvent(
 	r.recorder.AnnotatedEventf(fr, annotations, corev1.EventTypeNormal, reason, message)
 }
 
+// recordPromotionEvent records an event on the provided Stage for the
+// provided newly terminated Promotion. Successful dry-run Promotions did not
+// change the Stage and are therefore not reported.
+func (r *reconciler) recordPromotionEvent(s *kargoapi.Stage, promo kargoapi.PromotionReference) {
+	annotations := map[string]string{
+		kargoapi.AnnotationKeyEventActor:         kargoapi.FormatEventControllerActor(r.cfg.Name()),
+		kargoapi.AnnotationKeyEventProject:       s.Namespace,
+		kargoapi.AnnotationKeyEventStageName:     s.Name,
+		kargoapi.AnnotationKeyEventPromotionName: promo.Name,
+	}
+	var freightName string
+	if promo.Freight != nil {
+		freightName = promo.Freight.Name
+		annotations[kargoapi.AnnotationKeyEventFreightName] = freightName
+	}
+
+	switch promo.Status.Phase {
+	case kargoapi.PromotionPhaseSucceeded:
+		if promo.Status.DryRun {
+			return
+		}
+		r.recorder.AnnotatedEventf(
+			s,
+			annotations,
+			corev1.EventTypeNormal,
+			kargoapi.EventReasonPromoted,
+			"Stage promoted to Freight %q",
+			freightName,
+		)
+	case kargoapi.PromotionPhaseFailed, kargoapi.PromotionPhaseErrored:
+		message := fmt.Sprintf("Promotion %q of Freight %q failed", promo.Name, freightName)
+		if promo.Status.Message != "" {
+			message = fmt.Sprintf("%s: %s", message, promo.Status.Message)
+		}
+		r.recorder.AnnotatedEventf(
+			s,
+			annotations,
+			corev1.EventTypeWarning,
+			kargoapi.EventReasonPromotionFailed,
+			"%s",
+			message,
+		)
+	}
+}
+
+// recordHealthEvent records an event on the provided Stage if its health
+// transitioned from Healthy to Unhealthy.
+func (r *reconciler) recordHealthEvent(s *kargoapi.Stage, previous, current *kargoapi.Health) {
+	if previous == nil || previous.Status != kargoapi.HealthStateHealthy ||
+		current == nil || current.Status != kargoapi.HealthStateUnhealthy {
+		return
+	}
+	message := "Stage health degraded from Healthy to Unhealthy"
+	if len(current.Issues) > 0 {
+		message = fmt.Sprintf("%s: %s", message, strings.Join(current.Issues, "; "))
+	}
+	r.recorder.AnnotatedEventf(
+		s,
+		map[string]string{
+			kargoapi.AnnotationKeyEventActor:     kargoapi.FormatEventControllerActor(r.cfg.Name()),
+			kargoapi.AnnotationKeyEventProject:   s.Namespace,
+			kargoapi.AnnotationKeyEventStageName: s.Name,
+		},
+		corev1.EventTypeWarning,
+		kargoapi.EventReasonHealthDegraded,
+		"%s",
+		message,
+	)
+}
+
 func buildFreightSummary(requested int, current *kargoapi.FreightCollection) string {
 	if current == nil {
 		return fmt.Sprintf("0/%d Fulfilled", requested)
@@ -9,19 +9,24 @@ import (
 	"time"
 
 	"github.com/oklog/ulid/v2"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libargocd "github.com/akuity/kargo/internal/argocd"
 	"github.com/akuity/kargo/internal/controller"
 	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/kubeclient"
 	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
 )
@@ -41,21 +46,34 @@ func TestNewReconciler(t *testing.T) {
 	r := newReconciler(
 		kubeClient,
 		kubeClient,
+		libargocd.NewRemoteClients(&credentials.FakeDB{}),
 		recorder,
 		testCfg,
 		requirement,
+		prometheus.NewRegistry(),
 	)
 	require.Equal(t, testCfg, r.cfg)
 	require.NotNil(t, r.kargoClient)
 	require.NotNil(t, r.argocdClient)
 	require.NotNil(t, r.recorder)
 	require.NotNil(t, r.appHealth)
+	require.NotNil(t, r.metrics)
+	require.NotNil(t, r.notifier)
+	require.NotNil(t, r.syncFailures)
+	require.NotNil(t, r.healthRepaired)
+	require.NotNil(t, r.pendingPromotionEvents)
 	// Assert that all overridable behaviors were initialized to a default:
 	// Loop guard:
 	require.NotNil(t, r.nowFn)
 	require.NotNil(t, r.getPromotionsForStageFn)
 	require.NotNil(t, r.listPromosFn)
 	require.NotNil(t, r.syncPromotionsFn)
+	// Health checks:
+	require.NotNil(t, r.checkHTTPHealthFn)
+	require.NotNil(t, r.checkArgoCDHealthFn)
+	require.NotNil(t, r.getArgoCDAppFn)
+	require.NotNil(t, r.checkPodImageHealthFn)
+	require.NotNil(t, r.listPodsFn)
 	// Freight verification:
 	require.NotNil(t, r.startVerificationFn)
 	require.NotNil(t, r.getVerificationInfoFn)
@@ -80,6 +98,96 @@ func TestNewReconciler(t *testing.T) {
 	require.NotNil(t, r.clearAnalysisRunsFn)
 }
 
+func TestResultForSyncError(t *testing.T) {
+	testErr := errors.New("something went wrong")
+
+	t.Run("without sync backoff policy", func(t *testing.T) {
+		r := &reconciler{syncFailures: map[types.NamespacedName]int{}}
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-stage",
+			},
+		}
+		result, err := r.resultForSyncError(context.Background(), stage, testErr)
+		require.ErrorIs(t, err, testErr)
+		require.Zero(t, result.RequeueAfter)
+	})
+
+	t.Run("with sync backoff policy", func(t *testing.T) {
+		r := &reconciler{syncFailures: map[types.NamespacedName]int{}}
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "fake-stage",
+				Annotations: map[string]string{
+					kargoapi.AnnotationKeySyncBackoffPolicy: `{"initialDelay":"10s","multiplier":3,"maxDelay":"2m"}`,
+				},
+			},
+		}
+		key := types.NamespacedName{
+			Namespace: stage.Namespace,
+			Name:      stage.Name,
+		}
+
+		requeueAfter := func() time.Duration {
+			result, err := r.resultForSyncError(context.Background(), stage, testErr)
+			require.NoError(t, err)
+			return result.RequeueAfter
+		}
+
+		// Delays grow with each consecutive failure, up to the maximum
+		require.Equal(t, 10*time.Second, requeueAfter())
+		require.Equal(t, 30*time.Second, requeueAfter())
+		require.Equal(t, 90*time.Second, requeueAfter())
+		require.Equal(t, 2*time.Minute, requeueAfter())
+		require.Equal(t, 2*time.Minute, requeueAfter())
+		require.Equal(t, 5, r.syncFailures[key])
+
+		// A successful reconciliation starts over from the initial delay
+		r.forgetSyncFailures(key)
+		require.NotContains(t, r.syncFailures, key)
+		require.Equal(t, 10*time.Second, requeueAfter())
+	})
+}
+
+func TestReconcileIgnoresUnwatchedNamespace(t *testing.T) {
+	var gotStage bool
+	r := &reconciler{
+		kargoClient: fake.NewClientBuilder().WithInterceptorFuncs(
+			interceptor.Funcs{
+				Get: func(
+					context.Context,
+					client.WithWatch,
+					client.ObjectKey,
+					client.Object,
+					...client.GetOption,
+				) error {
+					gotStage = true
+					return nil
+				},
+			},
+		).Build(),
+		cfg: ReconcilerConfig{
+			WatchedNamespaces: controller.WatchedNamespaces{
+				NamespacePrefix: "team-a-",
+			},
+		},
+	}
+	res, err := r.Reconcile(
+		context.Background(),
+		ctrl.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: "team-b-dev",
+				Name:      "fake-stage",
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, ctrl.Result{}, res)
+	require.False(t, gotStage)
+}
+
 func TestSyncControlFlowStage(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -1203,14 +1311,21 @@ func TestSyncNormalStage(t *testing.T) {
 		},
 
 		{
-			name: "error getting available Freight",
+			name: "circuit is open",
 			stage: &kargoapi.Stage{
 				Spec: kargoapi.StageSpec{
 					RequestedFreight:    []kargoapi.FreightRequest{{}},
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					CircuitBreaker: &kargoapi.CircuitBreaker{
+						Cooldown: metav1.Duration{Duration: time.Hour},
+					},
 				},
 				Status: kargoapi.StageStatus{
 					Phase: kargoapi.StagePhaseSteady,
+					CircuitBreaker: &kargoapi.CircuitBreakerStatus{
+						ConsecutiveFailures: 3,
+						OpenedAt:            &metav1.Time{Time: fakeNow().Add(-time.Minute)},
+					},
 					FreightHistory: kargoapi.FreightHistory{
 						{
 							Freight: map[string]kargoapi.FreightReference{
@@ -1241,6 +1356,13 @@ func TestSyncNormalStage(t *testing.T) {
 				) (bool, error) {
 					return true, nil
 				},
+				getAvailableFreightByOriginFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return nil, errors.New("auto-promotion should be suspended")
+				},
 				getFreightFn: func(
 					context.Context,
 					client.Client,
@@ -1248,11 +1370,6 @@ func TestSyncNormalStage(t *testing.T) {
 				) (*kargoapi.Freight, error) {
 					return &kargoapi.Freight{}, nil
 				},
-				getAvailableFreightByOriginFn: func(
-					context.Context, *kargoapi.Stage, bool,
-				) (map[string][]kargoapi.Freight, error) {
-					return nil, errors.New("something went wrong")
-				},
 			},
 			assertions: func(
 				t *testing.T,
@@ -1261,8 +1378,7 @@ func TestSyncNormalStage(t *testing.T) {
 				newStatus kargoapi.StageStatus,
 				err error,
 			) {
-				require.ErrorContains(t, err, "something went wrong")
-				require.ErrorContains(t, err, "error finding latest Freight for Stage")
+				require.NoError(t, err)
 
 				// Status should be returned unchanged
 				require.Equal(t, initialStatus, newStatus)
@@ -1273,11 +1389,12 @@ func TestSyncNormalStage(t *testing.T) {
 		},
 
 		{
-			name: "no Freight found",
+			name: "error getting available Freight while Stage is pinned",
 			stage: &kargoapi.Stage{
 				Spec: kargoapi.StageSpec{
 					RequestedFreight:    []kargoapi.FreightRequest{{}},
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					PinnedFreight:       "pinned-freight",
 				},
 				Status: kargoapi.StageStatus{
 					Phase: kargoapi.StagePhaseSteady,
@@ -1285,14 +1402,10 @@ func TestSyncNormalStage(t *testing.T) {
 						{
 							Freight: map[string]kargoapi.FreightReference{
 								testOrigin.String(): {
+									Name:   "pinned-freight",
 									Origin: testOrigin,
 								},
 							},
-							VerificationHistory: []kargoapi.VerificationInfo{
-								{
-									Phase: kargoapi.VerificationPhaseSuccessful,
-								},
-							},
 						},
 					},
 				},
@@ -1306,59 +1419,58 @@ func TestSyncNormalStage(t *testing.T) {
 					return status, nil
 				},
 				appHealth: &mockAppHealthEvaluator{},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return false, nil
+				},
 				getFreightFn: func(
 					context.Context,
 					client.Client,
 					types.NamespacedName,
 				) (*kargoapi.Freight, error) {
-					return nil, nil
-				},
-				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
-					return true, nil
+					return &kargoapi.Freight{}, nil
 				},
 				isAutoPromotionPermittedFn: func(
 					context.Context,
 					string,
 					string,
 				) (bool, error) {
-					return true, nil
+					return false, errors.New("auto-promotion should not be considered")
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("no Promotion should be created")
 				},
 				getAvailableFreightByOriginFn: func(
-					context.Context, *kargoapi.Stage, bool,
+					context.Context,
+					*kargoapi.Stage,
+					bool,
 				) (map[string][]kargoapi.Freight, error) {
-					return nil, nil
+					return nil, errors.New("something went wrong")
 				},
 			},
 			assertions: func(
 				t *testing.T,
 				recorder *fakeevent.EventRecorder,
-				initialStatus kargoapi.StageStatus,
-				newStatus kargoapi.StageStatus,
+				_ kargoapi.StageStatus,
+				_ kargoapi.StageStatus,
 				err error,
 			) {
-				require.NoError(t, err)
-
-				// Status should be returned unchanged
-				require.Equal(t, initialStatus, newStatus)
-
-				// No events should have been recorded
+				require.ErrorContains(t, err, "something went wrong")
+				require.ErrorContains(t, err, "error finding latest Freight for Stage")
 				require.Empty(t, recorder.Events)
 			},
 		},
 
 		{
-			name: "Stage already has latest Freight",
+			name: "Stage is pinned and has latest Freight",
 			stage: &kargoapi.Stage{
 				Spec: kargoapi.StageSpec{
-					RequestedFreight: []kargoapi.FreightRequest{
-						{
-							Origin: kargoapi.FreightOrigin{
-								Kind: kargoapi.FreightOriginKindWarehouse,
-								Name: testOrigin.Name,
-							},
-						},
-					},
+					RequestedFreight:    []kargoapi.FreightRequest{{}},
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					PinnedFreight:       "pinned-freight",
 				},
 				Status: kargoapi.StageStatus{
 					Phase: kargoapi.StagePhaseSteady,
@@ -1366,7 +1478,7 @@ func TestSyncNormalStage(t *testing.T) {
 						{
 							Freight: map[string]kargoapi.FreightReference{
 								testOrigin.String(): {
-									Name:   "fake-freight-id",
+									Name:   "pinned-freight",
 									Origin: testOrigin,
 								},
 							},
@@ -1386,28 +1498,38 @@ func TestSyncNormalStage(t *testing.T) {
 				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
 					return false, nil
 				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
 				isAutoPromotionPermittedFn: func(
 					context.Context,
 					string,
 					string,
 				) (bool, error) {
-					return true, nil
+					return false, errors.New("auto-promotion should not be considered")
 				},
-				getFreightFn: func(
+				createPromotionFn: func(
 					context.Context,
-					client.Client,
-					types.NamespacedName,
-				) (*kargoapi.Freight, error) {
-					return &kargoapi.Freight{}, nil
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("no Promotion should be created")
 				},
 				getAvailableFreightByOriginFn: func(
-					context.Context, *kargoapi.Stage, bool,
+					context.Context,
+					*kargoapi.Stage,
+					bool,
 				) (map[string][]kargoapi.Freight, error) {
 					return map[string][]kargoapi.Freight{
 						testOrigin.String(): {
 							{
 								ObjectMeta: metav1.ObjectMeta{
-									Name: "fake-freight-id",
+									Name:              "pinned-freight",
+									CreationTimestamp: metav1.NewTime(fakeTime.Add(0)),
 								},
 							},
 						},
@@ -1432,11 +1554,12 @@ func TestSyncNormalStage(t *testing.T) {
 		},
 
 		{
-			name: "Promotion already exists",
+			name: "Stage is pinned and newer Freight is available",
 			stage: &kargoapi.Stage{
 				Spec: kargoapi.StageSpec{
 					RequestedFreight:    []kargoapi.FreightRequest{{}},
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					PinnedFreight:       "pinned-freight",
 				},
 				Status: kargoapi.StageStatus{
 					Phase: kargoapi.StagePhaseSteady,
@@ -1444,6 +1567,7 @@ func TestSyncNormalStage(t *testing.T) {
 						{
 							Freight: map[string]kargoapi.FreightReference{
 								testOrigin.String(): {
+									Name:   "pinned-freight",
 									Origin: testOrigin,
 								},
 							},
@@ -1463,47 +1587,49 @@ func TestSyncNormalStage(t *testing.T) {
 				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
 					return false, nil
 				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
 				isAutoPromotionPermittedFn: func(
 					context.Context,
 					string,
 					string,
 				) (bool, error) {
-					return true, nil
+					return false, errors.New("auto-promotion should not be considered")
 				},
-				getFreightFn: func(
+				createPromotionFn: func(
 					context.Context,
-					client.Client,
-					types.NamespacedName,
-				) (*kargoapi.Freight, error) {
-					return &kargoapi.Freight{
-						ObjectMeta: metav1.ObjectMeta{
-							Name: "fake-freight-id",
-						},
-					}, nil
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("no Promotion should be created")
 				},
 				getAvailableFreightByOriginFn: func(
-					context.Context, *kargoapi.Stage, bool,
+					context.Context,
+					*kargoapi.Stage,
+					bool,
 				) (map[string][]kargoapi.Freight, error) {
 					return map[string][]kargoapi.Freight{
 						testOrigin.String(): {
 							{
 								ObjectMeta: metav1.ObjectMeta{
-									Name: "fake-freight-id",
+									Name:              "pinned-freight",
+									CreationTimestamp: metav1.NewTime(fakeTime.Add(0)),
+								},
+							},
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:              "newer-freight",
+									CreationTimestamp: metav1.NewTime(fakeTime.Add(time.Hour)),
 								},
 							},
 						},
 					}, nil
 				},
-				listPromosFn: func(
-					_ context.Context,
-					obj client.ObjectList,
-					_ ...client.ListOption,
-				) error {
-					promos, ok := obj.(*kargoapi.PromotionList)
-					require.True(t, ok)
-					promos.Items = []kargoapi.Promotion{{}}
-					return nil
-				},
 			},
 			assertions: func(
 				t *testing.T,
@@ -1517,13 +1643,22 @@ func TestSyncNormalStage(t *testing.T) {
 				// Status should be returned unchanged
 				require.Equal(t, initialStatus, newStatus)
 
-				// No events should have been recorded
-				require.Empty(t, recorder.Events)
+				// A warning about the newer Freight should have been recorded
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, corev1.EventTypeWarning, event.EventType)
+				require.Equal(t, kargoapi.EventReasonPinnedFreightSuperseded, event.Reason)
+				require.Equal(
+					t,
+					"newer-freight",
+					event.Annotations[kargoapi.AnnotationKeyEventFreightName],
+				)
+				require.Contains(t, event.Message, `pinned to Freight "pinned-freight"`)
 			},
 		},
 
 		{
-			name: "error listing Promotions",
+			name: "auto-promotion resumes once Stage is unpinned",
 			stage: &kargoapi.Stage{
 				Spec: kargoapi.StageSpec{
 					RequestedFreight:    []kargoapi.FreightRequest{{}},
@@ -1535,6 +1670,7 @@ func TestSyncNormalStage(t *testing.T) {
 						{
 							Freight: map[string]kargoapi.FreightReference{
 								testOrigin.String(): {
+									Name:   "pinned-freight",
 									Origin: testOrigin,
 								},
 							},
@@ -1554,6 +1690,13 @@ func TestSyncNormalStage(t *testing.T) {
 				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
 					return false, nil
 				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
 				isAutoPromotionPermittedFn: func(
 					context.Context,
 					string,
@@ -1561,25 +1704,23 @@ func TestSyncNormalStage(t *testing.T) {
 				) (bool, error) {
 					return true, nil
 				},
-				getFreightFn: func(
-					context.Context,
-					client.Client,
-					types.NamespacedName,
-				) (*kargoapi.Freight, error) {
-					return &kargoapi.Freight{
-						ObjectMeta: metav1.ObjectMeta{
-							Name: "fake-freight-id",
-						},
-					}, nil
-				},
 				getAvailableFreightByOriginFn: func(
-					context.Context, *kargoapi.Stage, bool,
+					context.Context,
+					*kargoapi.Stage,
+					bool,
 				) (map[string][]kargoapi.Freight, error) {
 					return map[string][]kargoapi.Freight{
 						testOrigin.String(): {
 							{
 								ObjectMeta: metav1.ObjectMeta{
-									Name: "fake-freight-id",
+									Name:              "pinned-freight",
+									CreationTimestamp: metav1.NewTime(fakeTime.Add(0)),
+								},
+							},
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:              "newer-freight",
+									CreationTimestamp: metav1.NewTime(fakeTime.Add(time.Hour)),
 								},
 							},
 						},
@@ -1590,28 +1731,39 @@ func TestSyncNormalStage(t *testing.T) {
 					client.ObjectList,
 					...client.ListOption,
 				) error {
-					return errors.New("something went wrong")
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
 				},
 			},
 			assertions: func(
 				t *testing.T,
 				recorder *fakeevent.EventRecorder,
-				initialStatus kargoapi.StageStatus,
-				newStatus kargoapi.StageStatus,
+				_ kargoapi.StageStatus,
+				_ kargoapi.StageStatus,
 				err error,
 			) {
-				require.ErrorContains(t, err, "something went wrong")
-
-				// Status should be returned unchanged
-				require.Equal(t, initialStatus, newStatus)
+				require.NoError(t, err)
 
-				// No events should have been recorded
-				require.Empty(t, recorder.Events)
+				// Auto-promotion of the newer Freight should have been recorded
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonPromotionCreated, event.Reason)
+				require.Equal(
+					t,
+					"newer-freight",
+					event.Annotations[kargoapi.AnnotationKeyEventFreightName],
+				)
 			},
 		},
 
 		{
-			name: "error creating Promotion",
+			name: "error getting available Freight",
 			stage: &kargoapi.Stage{
 				Spec: kargoapi.StageSpec{
 					RequestedFreight:    []kargoapi.FreightRequest{{}},
@@ -1654,38 +1806,12 @@ func TestSyncNormalStage(t *testing.T) {
 					client.Client,
 					types.NamespacedName,
 				) (*kargoapi.Freight, error) {
-					return &kargoapi.Freight{
-						ObjectMeta: metav1.ObjectMeta{
-							Name: "fake-freight-id",
-						},
-					}, nil
+					return &kargoapi.Freight{}, nil
 				},
 				getAvailableFreightByOriginFn: func(
 					context.Context, *kargoapi.Stage, bool,
 				) (map[string][]kargoapi.Freight, error) {
-					return map[string][]kargoapi.Freight{
-						testOrigin.String(): {
-							{
-								ObjectMeta: metav1.ObjectMeta{
-									Name: "fake-freight-id",
-								},
-							},
-						},
-					}, nil
-				},
-				listPromosFn: func(
-					context.Context,
-					client.ObjectList,
-					...client.ListOption,
-				) error {
-					return nil
-				},
-				createPromotionFn: func(
-					context.Context,
-					client.Object,
-					...client.CreateOption,
-				) error {
-					return errors.New("something went wrong")
+					return nil, errors.New("something went wrong")
 				},
 			},
 			assertions: func(
@@ -1696,7 +1822,7 @@ func TestSyncNormalStage(t *testing.T) {
 				err error,
 			) {
 				require.ErrorContains(t, err, "something went wrong")
-				require.ErrorContains(t, err, "error creating Promotion of Stage")
+				require.ErrorContains(t, err, "error finding latest Freight for Stage")
 
 				// Status should be returned unchanged
 				require.Equal(t, initialStatus, newStatus)
@@ -1707,15 +1833,11 @@ func TestSyncNormalStage(t *testing.T) {
 		},
 
 		{
-			name: "skip event recording if no verification performed",
+			name: "no Freight found",
 			stage: &kargoapi.Stage{
-				ObjectMeta: metav1.ObjectMeta{
-					Generation: 42,
-					Name:       "fake-stage",
-				},
 				Spec: kargoapi.StageSpec{
+					RequestedFreight:    []kargoapi.FreightRequest{{}},
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
-					Verification:        &kargoapi.Verification{},
 				},
 				Status: kargoapi.StageStatus{
 					Phase: kargoapi.StagePhaseSteady,
@@ -1743,27 +1865,85 @@ func TestSyncNormalStage(t *testing.T) {
 				) (kargoapi.StageStatus, error) {
 					return status, nil
 				},
-				appHealth: &mockAppHealthEvaluator{
-					Health: &kargoapi.Health{
-						Status: kargoapi.HealthStateHealthy,
-					},
+				appHealth: &mockAppHealthEvaluator{},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, nil
 				},
-				getVerificationInfoFn: func(
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return true, nil
+				},
+				isAutoPromotionPermittedFn: func(
 					context.Context,
-					*kargoapi.Stage,
-					*kargoapi.VerificationInfo,
-				) (*kargoapi.VerificationInfo, error) {
-					return &kargoapi.VerificationInfo{
-						Phase: kargoapi.VerificationPhaseSuccessful,
-						AnalysisRun: &kargoapi.AnalysisRunReference{
-							Name:      "fake-analysis-run",
-							Namespace: "fake-namespace",
-							Phase:     string(rollouts.AnalysisPhaseSuccessful),
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getAvailableFreightByOriginFn: func(
+					context.Context, *kargoapi.Stage, bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+
+				// No events should have been recorded
+				require.Empty(t, recorder.Events)
+			},
+		},
+
+		{
+			name: "Stage already has latest Freight",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: testOrigin.Name,
+							},
 						},
-					}, nil
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseSteady,
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Name:   "fake-freight-id",
+									Origin: testOrigin,
+								},
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
 				},
+				appHealth: &mockAppHealthEvaluator{},
 				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
-					// No updates are performed
 					return false, nil
 				},
 				isAutoPromotionPermittedFn: func(
@@ -1771,59 +1951,40 @@ func TestSyncNormalStage(t *testing.T) {
 					string,
 					string,
 				) (bool, error) {
-					return false, nil
+					return true, nil
 				},
 				getFreightFn: func(
 					context.Context,
 					client.Client,
 					types.NamespacedName,
 				) (*kargoapi.Freight, error) {
-					return &kargoapi.Freight{
-						ObjectMeta: metav1.ObjectMeta{
-							Name: "fake-freight-id",
-						},
-						Status: kargoapi.FreightStatus{
-							VerifiedIn: map[string]kargoapi.VerifiedStage{
-								"fake-stage": {},
-							},
-						},
-					}, nil
+					return &kargoapi.Freight{}, nil
 				},
-				getAvailableFreightFn: func(
+				getAvailableFreightByOriginFn: func(
 					context.Context, *kargoapi.Stage, bool,
-				) ([]kargoapi.Freight, error) {
-					return []kargoapi.Freight{{
-						ObjectMeta: metav1.ObjectMeta{
-							Name: "fake-freight-id",
+				) (map[string][]kargoapi.Freight, error) {
+					return map[string][]kargoapi.Freight{
+						testOrigin.String(): {
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "fake-freight-id",
+								},
+							},
 						},
-					}}, nil
-				},
-				listPromosFn: func(
-					context.Context,
-					client.ObjectList,
-					...client.ListOption,
-				) error {
-					return nil
-				},
-				createPromotionFn: func(
-					context.Context,
-					client.Object,
-					...client.CreateOption,
-				) error {
-					return nil
+					}, nil
 				},
 			},
 			assertions: func(
 				t *testing.T,
 				recorder *fakeevent.EventRecorder,
-				_ kargoapi.StageStatus,
+				initialStatus kargoapi.StageStatus,
 				newStatus kargoapi.StageStatus,
 				err error,
 			) {
 				require.NoError(t, err)
 
 				// Status should be returned unchanged
-				require.Equal(t, kargoapi.StagePhaseSteady, newStatus.Phase)
+				require.Equal(t, initialStatus, newStatus)
 
 				// No events should have been recorded
 				require.Empty(t, recorder.Events)
@@ -1831,36 +1992,23 @@ func TestSyncNormalStage(t *testing.T) {
 		},
 
 		{
-			name: "success",
-			// Note: In this final case, we will also assert than anything that should
-			// have been cleared or modified in the Stage's status was.
+			name: "scheduled Promotion not yet due",
 			stage: &kargoapi.Stage{
-				ObjectMeta: metav1.ObjectMeta{
-					Generation: 42,
-				},
 				Spec: kargoapi.StageSpec{
-					RequestedFreight:    []kargoapi.FreightRequest{{}},
+					RequestedFreight:    []kargoapi.FreightRequest{{Origin: testOrigin}},
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
-					Verification:        &kargoapi.Verification{},
+					PromotionSchedule:   "0 2 * * *",
 				},
 				Status: kargoapi.StageStatus{
-					Phase: kargoapi.StagePhaseVerifying,
+					Phase: kargoapi.StagePhaseSteady,
 					FreightHistory: kargoapi.FreightHistory{
 						{
 							Freight: map[string]kargoapi.FreightReference{
 								testOrigin.String(): {
+									Name:   "fake-freight-id",
 									Origin: testOrigin,
 								},
 							},
-							VerificationHistory: []kargoapi.VerificationInfo{
-								{
-									Phase: kargoapi.VerificationPhasePending,
-									AnalysisRun: &kargoapi.AnalysisRunReference{
-										Name:      "fake-analysis-run",
-										Namespace: "fake-namespace",
-									},
-								},
-							},
 						},
 					},
 				},
@@ -1873,41 +2021,79 @@ func TestSyncNormalStage(t *testing.T) {
 				) (kargoapi.StageStatus, error) {
 					return status, nil
 				},
-				appHealth: &mockAppHealthEvaluator{
-					Health: &kargoapi.Health{
-						Status: kargoapi.HealthStateHealthy,
-					},
+				appHealth: &mockAppHealthEvaluator{},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return false, nil
 				},
-				getAnalysisRunFn: func(
+				isAutoPromotionPermittedFn: func(
 					context.Context,
-					client.Client,
-					types.NamespacedName,
-				) (*rollouts.AnalysisRun, error) {
-					return &rollouts.AnalysisRun{
-						ObjectMeta: metav1.ObjectMeta{
-							Namespace: "fake-namespace",
-							Name:      "fake-analysis-run",
-						},
-					}, nil
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
 				},
-				getVerificationInfoFn: func(
-					context.Context,
-					*kargoapi.Stage,
-					*kargoapi.VerificationInfo,
-				) (*kargoapi.VerificationInfo, error) {
-					return &kargoapi.VerificationInfo{
-						StartTime:  ptr.To(metav1.NewTime(fakeTime)),
-						FinishTime: ptr.To(metav1.NewTime(fakeTime)),
-						Phase:      kargoapi.VerificationPhaseSuccessful,
-						AnalysisRun: &kargoapi.AnalysisRunReference{
-							Name:      "fake-analysis-run",
-							Namespace: "fake-namespace",
-							Phase:     string(rollouts.AnalysisPhaseSuccessful),
+				getAvailableFreightByOriginFn: func(
+					context.Context, *kargoapi.Stage, bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return nil, errors.New("unexpected search for Freight")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				// The first scheduled time should have been recorded
+				require.NotNil(t, newStatus.NextScheduledPromotion)
+				require.Equal(
+					t,
+					fakeTime.Add(2*time.Hour),
+					newStatus.NextScheduledPromotion.Time,
+				)
+
+				// No events should have been recorded
+				require.Empty(t, recorder.Events)
+			},
+		},
+
+		{
+			name: "scheduled Promotion due",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight:    []kargoapi.FreightRequest{{Origin: testOrigin}},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					PromotionSchedule:   "0 0 * * *",
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseSteady,
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Name:   "fake-freight-id",
+									Origin: testOrigin,
+								},
+							},
 						},
-					}, nil
+					},
+					NextScheduledPromotion: &metav1.Time{Time: fakeTime},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
 				},
+				appHealth: &mockAppHealthEvaluator{},
 				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
-					return true, nil
+					return false, nil
 				},
 				isAutoPromotionPermittedFn: func(
 					context.Context,
@@ -1916,20 +2102,10 @@ func TestSyncNormalStage(t *testing.T) {
 				) (bool, error) {
 					return true, nil
 				},
-				getFreightFn: func(
-					context.Context,
-					client.Client,
-					types.NamespacedName,
-				) (*kargoapi.Freight, error) {
-					return &kargoapi.Freight{
-						ObjectMeta: metav1.ObjectMeta{
-							Name: "fake-freight-id",
-						},
-					}, nil
-				},
 				getAvailableFreightByOriginFn: func(
 					context.Context, *kargoapi.Stage, bool,
 				) (map[string][]kargoapi.Freight, error) {
+					// The latest available Freight is already current
 					return map[string][]kargoapi.Freight{
 						testOrigin.String(): {
 							{
@@ -1948,10 +2124,13 @@ func TestSyncNormalStage(t *testing.T) {
 					return nil
 				},
 				createPromotionFn: func(
-					context.Context,
-					client.Object,
-					...client.CreateOption,
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
 				) error {
+					promo, ok := obj.(*kargoapi.Promotion)
+					require.True(t, ok)
+					require.Equal(t, "fake-freight-id", promo.Spec.Freight)
 					return nil
 				},
 			},
@@ -1964,123 +2143,1503 @@ func TestSyncNormalStage(t *testing.T) {
 			) {
 				require.NoError(t, err)
 
-				require.Equal(t, int64(42), newStatus.ObservedGeneration) // Set
-				require.Equal(t, kargoapi.StagePhaseSteady, newStatus.Phase)
-				require.NotNil(t, newStatus.Health) // Set
-
+				// The next scheduled time should have been recorded
+				require.NotNil(t, newStatus.NextScheduledPromotion)
 				require.Equal(
 					t,
-					&kargoapi.VerificationInfo{
-						StartTime:  ptr.To(metav1.NewTime(fakeTime)),
-						FinishTime: ptr.To(metav1.NewTime(fakeTime)),
-						Phase:      kargoapi.VerificationPhaseSuccessful,
-						AnalysisRun: &kargoapi.AnalysisRunReference{
-							Name:      "fake-analysis-run",
-							Namespace: "fake-namespace",
-							Phase:     string(rollouts.AnalysisPhaseSuccessful),
+					fakeTime.AddDate(0, 0, 1),
+					newStatus.NextScheduledPromotion.Time,
+				)
+
+				// The scheduled Promotion should have been recorded as an event
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonPromotionCreated, event.Reason)
+			},
+		},
+
+		{
+			name: "scheduled Promotions suspended",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight:    []kargoapi.FreightRequest{{Origin: testOrigin}},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					PromotionSchedule:   "0 0 * * *",
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseSteady,
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Name:   "fake-freight-id",
+									Origin: testOrigin,
+								},
+							},
 						},
 					},
-					newStatus.FreightHistory.Current().VerificationHistory.Current(),
-				)
+					NextScheduledPromotion: &metav1.Time{Time: fakeTime},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
+				},
+				appHealth: &mockAppHealthEvaluator{},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return false, nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
 
-				// Two events should have been recorded:
-				require.Len(t, recorder.Events, 2)
+				// No next scheduled time should be reported
+				require.Nil(t, newStatus.NextScheduledPromotion)
 
-				// Successful verification should have been recorded as an event
-				event := <-recorder.Events
-				require.Equal(t, kargoapi.EventReasonFreightVerificationSucceeded, event.Reason)
-				require.Equal(t,
-					fakeTime.Format(time.RFC3339),
-					event.Annotations[kargoapi.AnnotationKeyEventVerificationStartTime],
-				)
-				require.Equal(t,
-					fakeTime.Format(time.RFC3339),
-					event.Annotations[kargoapi.AnnotationKeyEventVerificationFinishTime],
+				// No events should have been recorded
+				require.Empty(t, recorder.Events)
+			},
+		},
+
+		{
+			name: "error creating scheduled Promotion",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight:    []kargoapi.FreightRequest{{Origin: testOrigin}},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					PromotionSchedule:   "0 0 * * *",
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseSteady,
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Name:   "fake-freight-id",
+									Origin: testOrigin,
+								},
+							},
+						},
+					},
+					NextScheduledPromotion: &metav1.Time{Time: fakeTime},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
+				},
+				appHealth: &mockAppHealthEvaluator{},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return false, nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getAvailableFreightByOriginFn: func(
+					context.Context, *kargoapi.Stage, bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return map[string][]kargoapi.Freight{
+						testOrigin.String(): {
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "fake-freight-id",
+								},
+							},
+						},
+					}, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.ErrorContains(t, err, "something went wrong")
+
+				// The missed scheduled time should have been kept
+				require.Equal(
+					t,
+					initialStatus.NextScheduledPromotion,
+					newStatus.NextScheduledPromotion,
 				)
 
-				// Auto-promotion should have been recorded as an event
-				event = <-recorder.Events
-				require.Equal(t, kargoapi.EventReasonPromotionCreated, event.Reason)
+				// No events should have been recorded
+				require.Empty(t, recorder.Events)
 			},
 		},
 
 		{
-			name: "success with multiple Freight requests",
+			name: "Promotion already exists",
 			stage: &kargoapi.Stage{
 				Spec: kargoapi.StageSpec{
-					RequestedFreight:    []kargoapi.FreightRequest{{}, {}},
+					RequestedFreight:    []kargoapi.FreightRequest{{}},
 					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
 				},
 				Status: kargoapi.StageStatus{
 					Phase: kargoapi.StagePhaseSteady,
 					FreightHistory: kargoapi.FreightHistory{
 						{
-							Freight: map[string]kargoapi.FreightReference{
-								testOrigin.String(): {
-									Origin: testOrigin,
-								},
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Origin: testOrigin,
+								},
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
+				},
+				appHealth: &mockAppHealthEvaluator{},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return false, nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				getAvailableFreightByOriginFn: func(
+					context.Context, *kargoapi.Stage, bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return map[string][]kargoapi.Freight{
+						testOrigin.String(): {
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "fake-freight-id",
+								},
+							},
+						},
+					}, nil
+				},
+				listPromosFn: func(
+					_ context.Context,
+					obj client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := obj.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{{}}
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+
+				// No events should have been recorded
+				require.Empty(t, recorder.Events)
+			},
+		},
+
+		{
+			name: "Freight does not satisfy auto-promotion expression",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight:        []kargoapi.FreightRequest{{}},
+					PromotionMechanisms:     &kargoapi.PromotionMechanisms{},
+					AutoPromotionExpression: `freight.alias != "fake-alias"`,
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseSteady,
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Name:   "current-freight",
+									Origin: testOrigin,
+								},
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
+				},
+				appHealth: &mockAppHealthEvaluator{},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return false, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getAvailableFreightByOriginFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return map[string][]kargoapi.Freight{
+						testOrigin.String(): {
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:              "current-freight",
+									CreationTimestamp: metav1.NewTime(fakeTime.Add(0)),
+								},
+							},
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:              "newer-freight",
+									CreationTimestamp: metav1.NewTime(fakeTime.Add(time.Hour)),
+								},
+								Alias: "fake-alias",
+							},
+						},
+					}, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ kargoapi.StageStatus,
+				_ kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				// No Promotion should have been created
+				require.Empty(t, recorder.Events)
+			},
+		},
+
+		{
+			name: "auto-promotion expression cannot be evaluated for Freight",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight:        []kargoapi.FreightRequest{{}},
+					PromotionMechanisms:     &kargoapi.PromotionMechanisms{},
+					AutoPromotionExpression: `freight.images[0].tag == "v1.0.0"`,
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseSteady,
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Name:   "current-freight",
+									Origin: testOrigin,
+								},
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
+				},
+				appHealth: &mockAppHealthEvaluator{},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return false, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getAvailableFreightByOriginFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return map[string][]kargoapi.Freight{
+						testOrigin.String(): {
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:              "current-freight",
+									CreationTimestamp: metav1.NewTime(fakeTime.Add(0)),
+								},
+							},
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:              "newer-freight",
+									CreationTimestamp: metav1.NewTime(fakeTime.Add(time.Hour)),
+								},
+								Alias: "fake-alias",
+							},
+						},
+					}, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("Freight should not have been promoted")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ kargoapi.StageStatus,
+				_ kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				// The Freight is skipped rather than promoted.
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, corev1.EventTypeWarning, event.EventType)
+				require.Equal(t, kargoapi.EventReasonAutoPromotionExpressionFailed, event.Reason)
+			},
+		},
+
+		{
+			name: "auto-promotion expression cannot be compiled",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight:        []kargoapi.FreightRequest{{}},
+					PromotionMechanisms:     &kargoapi.PromotionMechanisms{},
+					AutoPromotionExpression: `freight.alias ==`,
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseSteady,
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Name:   "current-freight",
+									Origin: testOrigin,
+								},
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
+				},
+				appHealth: &mockAppHealthEvaluator{},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return false, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getAvailableFreightByOriginFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return map[string][]kargoapi.Freight{
+						testOrigin.String(): {
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:              "current-freight",
+									CreationTimestamp: metav1.NewTime(fakeTime.Add(0)),
+								},
+							},
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:              "newer-freight",
+									CreationTimestamp: metav1.NewTime(fakeTime.Add(time.Hour)),
+								},
+								Alias: "fake-alias",
+							},
+						},
+					}, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ kargoapi.StageStatus,
+				_ kargoapi.StageStatus,
+				err error,
+			) {
+				require.ErrorContains(t, err, "error evaluating auto-promotion expression")
+				require.ErrorContains(t, err, "error compiling expression")
+				require.Empty(t, recorder.Events)
+			},
+		},
+
+		{
+			name: "error listing Promotions",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight:    []kargoapi.FreightRequest{{}},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseSteady,
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Origin: testOrigin,
+								},
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
+				},
+				appHealth: &mockAppHealthEvaluator{},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return false, nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				getAvailableFreightByOriginFn: func(
+					context.Context, *kargoapi.Stage, bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return map[string][]kargoapi.Freight{
+						testOrigin.String(): {
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "fake-freight-id",
+								},
+							},
+						},
+					}, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.ErrorContains(t, err, "something went wrong")
+
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+
+				// No events should have been recorded
+				require.Empty(t, recorder.Events)
+			},
+		},
+
+		{
+			name: "error creating Promotion",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight:    []kargoapi.FreightRequest{{}},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseSteady,
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Origin: testOrigin,
+								},
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
+				},
+				appHealth: &mockAppHealthEvaluator{},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return false, nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				getAvailableFreightByOriginFn: func(
+					context.Context, *kargoapi.Stage, bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return map[string][]kargoapi.Freight{
+						testOrigin.String(): {
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "fake-freight-id",
+								},
+							},
+						},
+					}, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				initialStatus kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.ErrorContains(t, err, "error creating Promotion of Stage")
+
+				// Status should be returned unchanged
+				require.Equal(t, initialStatus, newStatus)
+
+				// No events should have been recorded
+				require.Empty(t, recorder.Events)
+			},
+		},
+
+		{
+			name: "skip event recording if no verification performed",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 42,
+					Name:       "fake-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					Verification:        &kargoapi.Verification{},
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseSteady,
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Origin: testOrigin,
+								},
+							},
+							VerificationHistory: []kargoapi.VerificationInfo{
+								{
+									Phase: kargoapi.VerificationPhaseSuccessful,
+								},
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
+				},
+				appHealth: &mockAppHealthEvaluator{
+					Health: &kargoapi.Health{
+						Status: kargoapi.HealthStateHealthy,
+					},
+				},
+				getVerificationInfoFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.VerificationInfo,
+				) (*kargoapi.VerificationInfo, error) {
+					return &kargoapi.VerificationInfo{
+						Phase: kargoapi.VerificationPhaseSuccessful,
+						AnalysisRun: &kargoapi.AnalysisRunReference{
+							Name:      "fake-analysis-run",
+							Namespace: "fake-namespace",
+							Phase:     string(rollouts.AnalysisPhaseSuccessful),
+						},
+					}, nil
+				},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					// No updates are performed
+					return false, nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return false, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+						Status: kargoapi.FreightStatus{
+							VerifiedIn: map[string]kargoapi.VerifiedStage{
+								"fake-stage": {},
+							},
+						},
+					}, nil
+				},
+				getAvailableFreightFn: func(
+					context.Context, *kargoapi.Stage, bool,
+				) ([]kargoapi.Freight, error) {
+					return []kargoapi.Freight{{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}}, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				// Status should be returned unchanged
+				require.Equal(t, kargoapi.StagePhaseSteady, newStatus.Phase)
+
+				// No events should have been recorded
+				require.Empty(t, recorder.Events)
+			},
+		},
+
+		{
+			name: "success",
+			// Note: In this final case, we will also assert than anything that should
+			// have been cleared or modified in the Stage's status was.
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 42,
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight:    []kargoapi.FreightRequest{{}},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+					Verification:        &kargoapi.Verification{},
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseVerifying,
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Origin: testOrigin,
+								},
+							},
+							VerificationHistory: []kargoapi.VerificationInfo{
+								{
+									Phase: kargoapi.VerificationPhasePending,
+									AnalysisRun: &kargoapi.AnalysisRunReference{
+										Name:      "fake-analysis-run",
+										Namespace: "fake-namespace",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
+				},
+				appHealth: &mockAppHealthEvaluator{
+					Health: &kargoapi.Health{
+						Status: kargoapi.HealthStateHealthy,
+					},
+				},
+				getAnalysisRunFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*rollouts.AnalysisRun, error) {
+					return &rollouts.AnalysisRun{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-namespace",
+							Name:      "fake-analysis-run",
+						},
+					}, nil
+				},
+				getVerificationInfoFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.VerificationInfo,
+				) (*kargoapi.VerificationInfo, error) {
+					return &kargoapi.VerificationInfo{
+						StartTime:  ptr.To(metav1.NewTime(fakeTime)),
+						FinishTime: ptr.To(metav1.NewTime(fakeTime)),
+						Phase:      kargoapi.VerificationPhaseSuccessful,
+						AnalysisRun: &kargoapi.AnalysisRunReference{
+							Name:      "fake-analysis-run",
+							Namespace: "fake-namespace",
+							Phase:     string(rollouts.AnalysisPhaseSuccessful),
+						},
+					}, nil
+				},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return true, nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "fake-freight-id",
+						},
+					}, nil
+				},
+				getAvailableFreightByOriginFn: func(
+					context.Context, *kargoapi.Stage, bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return map[string][]kargoapi.Freight{
+						testOrigin.String(): {
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "fake-freight-id",
+								},
+							},
+						},
+					}, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				require.Equal(t, int64(42), newStatus.ObservedGeneration) // Set
+				require.Equal(t, kargoapi.StagePhaseSteady, newStatus.Phase)
+				require.NotNil(t, newStatus.Health) // Set
+
+				require.Equal(
+					t,
+					&kargoapi.VerificationInfo{
+						StartTime:  ptr.To(metav1.NewTime(fakeTime)),
+						FinishTime: ptr.To(metav1.NewTime(fakeTime)),
+						Phase:      kargoapi.VerificationPhaseSuccessful,
+						AnalysisRun: &kargoapi.AnalysisRunReference{
+							Name:      "fake-analysis-run",
+							Namespace: "fake-namespace",
+							Phase:     string(rollouts.AnalysisPhaseSuccessful),
+						},
+					},
+					newStatus.FreightHistory.Current().VerificationHistory.Current(),
+				)
+
+				// Two events should have been recorded:
+				require.Len(t, recorder.Events, 2)
+
+				// Successful verification should have been recorded as an event
+				event := <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonFreightVerificationSucceeded, event.Reason)
+				require.Equal(t,
+					fakeTime.Format(time.RFC3339),
+					event.Annotations[kargoapi.AnnotationKeyEventVerificationStartTime],
+				)
+				require.Equal(t,
+					fakeTime.Format(time.RFC3339),
+					event.Annotations[kargoapi.AnnotationKeyEventVerificationFinishTime],
+				)
+
+				// Auto-promotion should have been recorded as an event
+				event = <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonPromotionCreated, event.Reason)
+			},
+		},
+
+		{
+			name: "success with multiple Freight requests",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight:    []kargoapi.FreightRequest{{}, {}},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: kargoapi.StageStatus{
+					Phase: kargoapi.StagePhaseSteady,
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Origin: testOrigin,
+								},
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				syncPromotionsFn: func(
+					_ context.Context,
+					_ *kargoapi.Stage,
+					status kargoapi.StageStatus,
+				) (kargoapi.StageStatus, error) {
+					return status, nil
+				},
+				appHealth: &mockAppHealthEvaluator{},
+				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
+					return false, nil
+				},
+				isAutoPromotionPermittedFn: func(
+					context.Context,
+					string,
+					string,
+				) (bool, error) {
+					return true, nil
+				},
+				getAvailableFreightByOriginFn: func(
+					context.Context, *kargoapi.Stage, bool,
+				) (map[string][]kargoapi.Freight, error) {
+					return map[string][]kargoapi.Freight{
+						testOrigin.String(): {
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									CreationTimestamp: metav1.NewTime(fakeTime),
+									Name:              "fake-freight-1",
+									Namespace:         "fake-namespace",
+								},
+							},
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									CreationTimestamp: metav1.NewTime(fakeTime.Add(time.Hour)),
+									Name:              "fake-freight-2",
+									Namespace:         "fake-namespace",
+								},
+							},
+						},
+						(&kargoapi.FreightOrigin{
+							Kind: kargoapi.FreightOriginKindWarehouse,
+							Name: "fake-warehouse-2",
+						}).String(): {
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									CreationTimestamp: metav1.NewTime(fakeTime.Add(-1 * time.Hour)),
+									Name:              "fake-freight-3",
+									Namespace:         "fake-namespace",
+								},
+							},
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									CreationTimestamp: metav1.NewTime(fakeTime),
+									Name:              "fake-freight-4",
+									Namespace:         "fake-namespace",
+								},
+							},
+						},
+					}, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				_ kargoapi.StageStatus,
+				newStatus kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				require.Equal(t, kargoapi.StagePhaseSteady, newStatus.Phase)
+
+				// Two events should have been recorded:
+				require.Len(t, recorder.Events, 2)
+
+				// Two auto-promotions should have been recorded as events
+				event := <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonPromotionCreated, event.Reason)
+
+				event = <-recorder.Events
+				require.Equal(t, kargoapi.EventReasonPromotionCreated, event.Reason)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := fakeevent.NewEventRecorder(10)
+			testCase.reconciler.nowFn = fakeNow
+			testCase.reconciler.recorder = recorder
+			newStatus, err := testCase.reconciler.syncNormalStage(
+				context.Background(),
+				testCase.stage,
+			)
+			testCase.assertions(t, recorder, testCase.stage.Status, newStatus, err)
+		})
+	}
+}
+
+func TestSyncStageHealth(t *testing.T) {
+	testOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	testStatus := kargoapi.StageStatus{
+		Phase: kargoapi.StagePhaseSteady,
+		FreightHistory: kargoapi.FreightHistory{
+			{
+				Freight: map[string]kargoapi.FreightReference{
+					testOrigin.String(): {Name: "newer-freight", Origin: testOrigin},
+				},
+				VerificationHistory: []kargoapi.VerificationInfo{{
+					ID:    "fake-id",
+					Phase: kargoapi.VerificationPhaseSuccessful,
+				}},
+			},
+			{
+				Freight: map[string]kargoapi.FreightReference{
+					testOrigin.String(): {Name: "older-freight", Origin: testOrigin},
+				},
+			},
+		},
+		Health: &kargoapi.Health{Status: kargoapi.HealthStateHealthy},
+		LastPromotion: &kargoapi.PromotionReference{
+			Name: "fake-promotion",
+		},
+	}
+	testCases := []struct {
+		name       string
+		status     kargoapi.StageStatus
+		health     *kargoapi.Health
+		assertions func(t *testing.T, initialStatus, newStatus kargoapi.StageStatus)
+	}{
+		{
+			name:   "no current Freight",
+			status: kargoapi.StageStatus{Phase: kargoapi.StagePhaseNotApplicable},
+			health: &kargoapi.Health{Status: kargoapi.HealthStateUnhealthy},
+			assertions: func(t *testing.T, initialStatus, newStatus kargoapi.StageStatus) {
+				require.Nil(t, newStatus.Health)
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+		{
+			name:   "health not applicable",
+			status: testStatus,
+			assertions: func(t *testing.T, initialStatus, newStatus kargoapi.StageStatus) {
+				require.Nil(t, newStatus.Health)
+
+				// Status should be otherwise unchanged
+				newStatus.Health = initialStatus.Health
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+		{
+			name:   "health changed",
+			status: testStatus,
+			health: &kargoapi.Health{Status: kargoapi.HealthStateUnhealthy},
+			assertions: func(t *testing.T, initialStatus, newStatus kargoapi.StageStatus) {
+				require.Equal(
+					t,
+					&kargoapi.Health{
+						Status:    kargoapi.HealthStateUnhealthy,
+						CheckedAt: ptr.To(metav1.NewTime(fakeTime)),
+					},
+					newStatus.Health,
+				)
+
+				// Status, including the order of the Freight history, should be
+				// otherwise unchanged
+				newStatus.Health = initialStatus.Health
+				require.Equal(t, initialStatus, newStatus)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			stage := &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyHealthCheckOnly: kargoapi.AnnotationValueTrue,
+					},
+				},
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+				Status: *testCase.status.DeepCopy(),
+			}
+			r := &reconciler{
+				appHealth: &mockAppHealthEvaluator{Health: testCase.health},
+				nowFn:     fakeNow,
+			}
+			newStatus := r.syncStageHealth(context.Background(), stage)
+			testCase.assertions(t, testCase.status, newStatus)
+			// The Stage itself must not have been mutated
+			require.Equal(t, testCase.status, stage.Status)
+		})
+	}
+}
+
+func TestPromoteRequestedFreight(t *testing.T) {
+	testOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	testCurrentFC := &kargoapi.FreightCollection{
+		Freight: map[string]kargoapi.FreightReference{
+			testOrigin.String(): {Name: "current-freight", Origin: testOrigin},
+		},
+	}
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		reconciler  *reconciler
+		assertions  func(t *testing.T, recorder *fakeevent.EventRecorder, cleared bool, err error)
+	}{
+		{
+			name: "no promotion requested",
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, errors.New("should not be called")
+				},
+			},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder, cleared bool, err error) {
+				require.NoError(t, err)
+				require.False(t, cleared)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "requested Freight is already current",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyPromoteFreight: "current-freight",
+			},
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, errors.New("should not be called")
+				},
+			},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder, cleared bool, err error) {
+				require.NoError(t, err)
+				require.True(t, cleared)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "error getting requested Freight",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyPromoteFreight: "fake-freight",
+			},
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder, cleared bool, err error) {
+				require.ErrorContains(t, err, "error getting Freight")
+				require.ErrorContains(t, err, "something went wrong")
+				require.False(t, cleared)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "requested Freight not found",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyPromoteFreight: "fake-freight",
+			},
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder, cleared bool, err error) {
+				require.ErrorContains(t, err, `Freight "fake-freight" requested for promotion`)
+				require.ErrorContains(t, err, "not found")
+				// The request is left in place so that it can be corrected
+				require.False(t, cleared)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "Promotion already in progress",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyPromoteFreight: "fake-freight",
+			},
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				listPromosFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{{}}
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("should not be called")
+				},
+			},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder, cleared bool, err error) {
+				require.NoError(t, err)
+				require.True(t, cleared)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "error creating Promotion",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyPromoteFreight: "fake-freight",
+			},
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				listPromosFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder, cleared bool, err error) {
+				require.ErrorContains(t, err, "error creating Promotion")
+				require.ErrorContains(t, err, "something went wrong")
+				require.False(t, cleared)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "previously promoted Freight is promoted again",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyPromoteFreight: "fake-freight",
+			},
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				listPromosFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					promos, ok := objList.(*kargoapi.PromotionList)
+					require.True(t, ok)
+					promos.Items = []kargoapi.Promotion{
+						{
+							Status: kargoapi.PromotionStatus{
+								Phase: kargoapi.PromotionPhaseSucceeded,
 							},
 						},
-					},
+					}
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
 				},
 			},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder, cleared bool, err error) {
+				require.NoError(t, err)
+				require.True(t, cleared)
+				require.Len(t, recorder.Events, 1)
+			},
+		},
+		{
+			name: "requested Freight is promoted",
+			annotations: map[string]string{
+				kargoapi.AnnotationKeyPromoteFreight: "fake-freight",
+			},
 			reconciler: &reconciler{
-				syncPromotionsFn: func(
+				getFreightFn: func(
 					_ context.Context,
-					_ *kargoapi.Stage,
-					status kargoapi.StageStatus,
-				) (kargoapi.StageStatus, error) {
-					return status, nil
-				},
-				appHealth: &mockAppHealthEvaluator{},
-				verifyFreightInStageFn: func(context.Context, string, string, string) (bool, error) {
-					return false, nil
-				},
-				isAutoPromotionPermittedFn: func(
-					context.Context,
-					string,
-					string,
-				) (bool, error) {
-					return true, nil
-				},
-				getAvailableFreightByOriginFn: func(
-					context.Context, *kargoapi.Stage, bool,
-				) (map[string][]kargoapi.Freight, error) {
-					return map[string][]kargoapi.Freight{
-						testOrigin.String(): {
-							{
-								ObjectMeta: metav1.ObjectMeta{
-									CreationTimestamp: metav1.NewTime(fakeTime),
-									Name:              "fake-freight-1",
-									Namespace:         "fake-namespace",
-								},
-							},
-							{
-								ObjectMeta: metav1.ObjectMeta{
-									CreationTimestamp: metav1.NewTime(fakeTime.Add(time.Hour)),
-									Name:              "fake-freight-2",
-									Namespace:         "fake-namespace",
-								},
-							},
-						},
-						(&kargoapi.FreightOrigin{
-							Kind: kargoapi.FreightOriginKindWarehouse,
-							Name: "fake-warehouse-2",
-						}).String(): {
-							{
-								ObjectMeta: metav1.ObjectMeta{
-									CreationTimestamp: metav1.NewTime(fakeTime.Add(-1 * time.Hour)),
-									Name:              "fake-freight-3",
-									Namespace:         "fake-namespace",
-								},
-							},
-							{
-								ObjectMeta: metav1.ObjectMeta{
-									CreationTimestamp: metav1.NewTime(fakeTime),
-									Name:              "fake-freight-4",
-									Namespace:         "fake-namespace",
-								},
-							},
+					_ client.Client,
+					key types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: key.Namespace,
+							Name:      key.Name,
 						},
 					}, nil
 				},
@@ -2092,46 +3651,51 @@ func TestSyncNormalStage(t *testing.T) {
 					return nil
 				},
 				createPromotionFn: func(
-					context.Context,
-					client.Object,
-					...client.CreateOption,
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
 				) error {
+					promo, ok := obj.(*kargoapi.Promotion)
+					if !ok || promo.Spec.Stage != "fake-stage" || promo.Spec.Freight != "fake-freight" {
+						return errors.New("unexpected Promotion")
+					}
 					return nil
 				},
 			},
-			assertions: func(
-				t *testing.T,
-				recorder *fakeevent.EventRecorder,
-				_ kargoapi.StageStatus,
-				newStatus kargoapi.StageStatus,
-				err error,
-			) {
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder, cleared bool, err error) {
 				require.NoError(t, err)
-
-				require.Equal(t, kargoapi.StagePhaseSteady, newStatus.Phase)
-
-				// Two events should have been recorded:
-				require.Len(t, recorder.Events, 2)
-
-				// Two auto-promotions should have been recorded as events
+				require.True(t, cleared)
+				require.Len(t, recorder.Events, 1)
 				event := <-recorder.Events
 				require.Equal(t, kargoapi.EventReasonPromotionCreated, event.Reason)
-
-				event = <-recorder.Events
-				require.Equal(t, kargoapi.EventReasonPromotionCreated, event.Reason)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			recorder := fakeevent.NewEventRecorder(10)
-			testCase.reconciler.nowFn = fakeNow
 			testCase.reconciler.recorder = recorder
-			newStatus, err := testCase.reconciler.syncNormalStage(
+			var cleared bool
+			testCase.reconciler.clearPromoteFreightRequestFn = func(
+				context.Context,
+				client.Client,
+				*kargoapi.Stage,
+			) error {
+				cleared = true
+				return nil
+			}
+			err := testCase.reconciler.promoteRequestedFreight(
 				context.Background(),
-				testCase.stage,
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "fake-namespace",
+						Name:        "fake-stage",
+						Annotations: testCase.annotations,
+					},
+				},
+				testCurrentFC,
 			)
-			testCase.assertions(t, recorder, testCase.stage.Status, newStatus, err)
+			testCase.assertions(t, recorder, cleared, err)
 		})
 	}
 }
@@ -2150,6 +3714,7 @@ func TestReconciler_syncPromotions(t *testing.T) {
 	testCases := []struct {
 		name          string
 		reconciler    *reconciler
+		stage         *kargoapi.Stage
 		initialStatus kargoapi.StageStatus
 		assertions    func(*testing.T, kargoapi.StageStatus, error)
 	}{
@@ -2286,18 +3851,192 @@ func TestReconciler_syncPromotions(t *testing.T) {
 						},
 						{
 							ObjectMeta: metav1.ObjectMeta{
-								Name: "fake-promotion." + ulidOneHourAgo.String(),
+								Name: "fake-promotion." + ulidOneHourAgo.String(),
+							},
+							Status: kargoapi.PromotionStatus{
+								Phase: kargoapi.PromotionPhaseFailed,
+								Freight: &kargoapi.FreightReference{
+									Name:   "fake-freight-2",
+									Origin: testOrigin,
+								},
+								FreightCollection: &kargoapi.FreightCollection{
+									Freight: map[string]kargoapi.FreightReference{
+										testOrigin.String(): {
+											Name:   "fake-freight-2",
+											Origin: testOrigin,
+										},
+									},
+								},
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.StageStatus, err error) {
+				require.NoError(t, err)
+
+				require.Equal(t, kargoapi.StagePhaseSteady, status.Phase)
+				require.Nil(t, status.CurrentPromotion)
+
+				status.LastPromotion.FinishedAt = nil
+
+				// Last Promotion should be the latest Terminated Promotion
+				require.Equal(t, &kargoapi.PromotionReference{
+					Name: "fake-promotion." + ulidOneMinuteAgo.String(),
+					Status: &kargoapi.PromotionStatus{
+						Phase: kargoapi.PromotionPhaseErrored,
+						Freight: &kargoapi.FreightReference{
+							Name:   "fake-freight-3",
+							Origin: testOrigin,
+						},
+						FreightCollection: &kargoapi.FreightCollection{
+							Freight: map[string]kargoapi.FreightReference{
+								testOrigin.String(): {
+									Name:   "fake-freight-3",
+									Origin: testOrigin,
+								},
+							},
+						},
+					},
+					Freight: &kargoapi.FreightReference{
+						Name:   "fake-freight-3",
+						Origin: testOrigin,
+					},
+				}, status.LastPromotion)
+
+				current := status.FreightHistory.Current()
+				require.NotNil(t, current)
+				require.Contains(t, current.Freight, testOrigin.String())
+
+				// Current Freight should be the Freight of the last Succeeded Promotion
+				require.Equal(
+					t,
+					kargoapi.FreightReference{
+						Name:   "fake-freight-1",
+						Origin: testOrigin,
+					},
+					current.Freight[testOrigin.String()],
+				)
+			},
+		},
+		{
+			name: "new Succeeded Promotion",
+			reconciler: &reconciler{
+				getPromotionsForStageFn: func(context.Context, string, string) ([]kargoapi.Promotion, error) {
+					return []kargoapi.Promotion{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-promotion." + ulidOneMinuteAgo.String(),
+							},
+							Status: kargoapi.PromotionStatus{
+								Phase:      kargoapi.PromotionPhaseSucceeded,
+								FinishedAt: &metav1.Time{Time: now},
+								Freight: &kargoapi.FreightReference{
+									Name:   "fake-freight",
+									Origin: testOrigin,
+								},
+								FreightCollection: &kargoapi.FreightCollection{
+									ID: "fake-id",
+									Freight: map[string]kargoapi.FreightReference{
+										testOrigin.String(): {
+											Name:   "fake-freight",
+											Origin: testOrigin,
+										},
+									},
+								},
+							},
+						},
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.StageStatus, err error) {
+				require.NoError(t, err)
+
+				// Fields displayed by kubectl get stages are populated
+				require.NotNil(t, status.LastPromotion)
+				require.Equal(t, &metav1.Time{Time: now}, status.LastPromotion.FinishedAt)
+				require.Equal(t, "fake-id", status.FreightHistory.Current().ID)
+			},
+		},
+		{
+			name: "new dry-run Promotion",
+			reconciler: &reconciler{
+				getPromotionsForStageFn: func(context.Context, string, string) ([]kargoapi.Promotion, error) {
+					return []kargoapi.Promotion{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-promotion." + ulidOneMinuteAgo.String(),
+							},
+							Status: kargoapi.PromotionStatus{
+								Phase:  kargoapi.PromotionPhaseSucceeded,
+								DryRun: true,
+								Freight: &kargoapi.FreightReference{
+									Name:   "fake-freight-2",
+									Origin: testOrigin,
+								},
+								FreightCollection: &kargoapi.FreightCollection{
+									Freight: map[string]kargoapi.FreightReference{
+										testOrigin.String(): {
+											Name:   "fake-freight-2",
+											Origin: testOrigin,
+										},
+									},
+								},
+							},
+						},
+					}, nil
+				},
+			},
+			initialStatus: kargoapi.StageStatus{
+				FreightHistory: kargoapi.FreightHistory{
+					{
+						Freight: map[string]kargoapi.FreightReference{
+							testOrigin.String(): {
+								Name:   "fake-freight-1",
+								Origin: testOrigin,
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.StageStatus, err error) {
+				require.NoError(t, err)
+
+				require.Equal(t, kargoapi.StagePhaseSteady, status.Phase)
+
+				// The dry-run Promotion should be recorded as the last Promotion...
+				require.NotNil(t, status.LastPromotion)
+				require.Equal(t, "fake-promotion."+ulidOneMinuteAgo.String(), status.LastPromotion.Name)
+				require.True(t, status.LastPromotion.Status.DryRun)
+
+				// ...without altering the Freight history.
+				require.Len(t, status.FreightHistory, 1)
+				require.Equal(
+					t,
+					"fake-freight-1",
+					status.FreightHistory.Current().Freight[testOrigin.String()].Name,
+				)
+			},
+		},
+		{
+			name: "new Terminated Promotion exceeding Freight history limit",
+			reconciler: &reconciler{
+				getPromotionsForStageFn: func(context.Context, string, string) ([]kargoapi.Promotion, error) {
+					return []kargoapi.Promotion{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "fake-promotion." + ulidOneMinuteAgo.String(),
 							},
 							Status: kargoapi.PromotionStatus{
-								Phase: kargoapi.PromotionPhaseFailed,
+								Phase: kargoapi.PromotionPhaseSucceeded,
 								Freight: &kargoapi.FreightReference{
-									Name:   "fake-freight-2",
+									Name:   "fake-freight-3",
 									Origin: testOrigin,
 								},
 								FreightCollection: &kargoapi.FreightCollection{
 									Freight: map[string]kargoapi.FreightReference{
 										testOrigin.String(): {
-											Name:   "fake-freight-2",
+											Name:   "fake-freight-3",
 											Origin: testOrigin,
 										},
 									},
@@ -2307,50 +4046,45 @@ func TestReconciler_syncPromotions(t *testing.T) {
 					}, nil
 				},
 			},
-			assertions: func(t *testing.T, status kargoapi.StageStatus, err error) {
-				require.NoError(t, err)
-
-				require.Equal(t, kargoapi.StagePhaseSteady, status.Phase)
-				require.Nil(t, status.CurrentPromotion)
-
-				status.LastPromotion.FinishedAt = nil
-
-				// Last Promotion should be the latest Terminated Promotion
-				require.Equal(t, &kargoapi.PromotionReference{
-					Name: "fake-promotion." + ulidOneMinuteAgo.String(),
-					Status: &kargoapi.PromotionStatus{
-						Phase: kargoapi.PromotionPhaseErrored,
-						Freight: &kargoapi.FreightReference{
-							Name:   "fake-freight-3",
-							Origin: testOrigin,
-						},
-						FreightCollection: &kargoapi.FreightCollection{
-							Freight: map[string]kargoapi.FreightReference{
-								testOrigin.String(): {
-									Name:   "fake-freight-3",
-									Origin: testOrigin,
-								},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					FreightHistoryLimit: 2,
+				},
+			},
+			initialStatus: kargoapi.StageStatus{
+				FreightHistory: kargoapi.FreightHistory{
+					{
+						Freight: map[string]kargoapi.FreightReference{
+							testOrigin.String(): {
+								Name:   "fake-freight-2",
+								Origin: testOrigin,
 							},
 						},
 					},
-					Freight: &kargoapi.FreightReference{
-						Name:   "fake-freight-3",
-						Origin: testOrigin,
+					{
+						Freight: map[string]kargoapi.FreightReference{
+							testOrigin.String(): {
+								Name:   "fake-freight-1",
+								Origin: testOrigin,
+							},
+						},
 					},
-				}, status.LastPromotion)
-
-				current := status.FreightHistory.Current()
-				require.NotNil(t, current)
-				require.Contains(t, current.Freight, testOrigin.String())
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.StageStatus, err error) {
+				require.NoError(t, err)
 
-				// Current Freight should be the Freight of the last Succeeded Promotion
+				// The oldest entry should have been removed to respect the limit.
+				require.Len(t, status.FreightHistory, 2)
 				require.Equal(
 					t,
-					kargoapi.FreightReference{
-						Name:   "fake-freight-1",
-						Origin: testOrigin,
-					},
-					current.Freight[testOrigin.String()],
+					"fake-freight-3",
+					status.FreightHistory[0].Freight[testOrigin.String()].Name,
+				)
+				require.Equal(
+					t,
+					"fake-freight-2",
+					status.FreightHistory[1].Freight[testOrigin.String()].Name,
 				)
 			},
 		},
@@ -2413,12 +4147,216 @@ func TestReconciler_syncPromotions(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
+			stage := testCase.stage
+			if stage == nil {
+				stage = &kargoapi.Stage{}
+			}
+			recorder := fakeevent.NewEventRecorder(10)
+			testCase.reconciler.recorder = recorder
 			status, err := testCase.reconciler.syncPromotions(
 				context.Background(),
-				&kargoapi.Stage{},
+				stage,
 				testCase.initialStatus,
 			)
 			testCase.assertions(t, status, err)
+			// Events for newly terminated Promotions are deferred until the
+			// Stage's status has been persisted
+			require.Empty(t, recorder.Events)
+		})
+	}
+}
+
+func TestPendingPromotionEvents(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	testKey := types.NamespacedName{
+		Namespace: testStage.Namespace,
+		Name:      testStage.Name,
+	}
+	testPromos := []kargoapi.PromotionReference{
+		{Name: "fake-promotion-1"},
+		{Name: "fake-promotion-2"},
+	}
+	r := &reconciler{}
+
+	// Nothing is pending before a sync
+	require.Empty(t, r.takePendingPromotionEvents(testKey))
+
+	r.setPendingPromotionEvents(testStage, testPromos)
+	require.Equal(t, testPromos, r.takePendingPromotionEvents(testKey))
+	// Taking the pending Promotions forgets them
+	require.Empty(t, r.takePendingPromotionEvents(testKey))
+
+	// A sync that finds no newly terminated Promotions clears any left over
+	r.setPendingPromotionEvents(testStage, testPromos)
+	r.setPendingPromotionEvents(testStage, nil)
+	require.Empty(t, r.takePendingPromotionEvents(testKey))
+}
+
+func TestRecordPromotionEvent(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	testCases := []struct {
+		name       string
+		promo      kargoapi.PromotionReference
+		assertions func(*testing.T, *fakeevent.EventRecorder)
+	}{
+		{
+			name: "Promotion succeeded",
+			promo: kargoapi.PromotionReference{
+				Name:    "fake-promotion",
+				Freight: &kargoapi.FreightReference{Name: "fake-freight"},
+				Status: &kargoapi.PromotionStatus{
+					Phase: kargoapi.PromotionPhaseSucceeded,
+				},
+			},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder) {
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, corev1.EventTypeNormal, event.EventType)
+				require.Equal(t, kargoapi.EventReasonPromoted, event.Reason)
+				require.Equal(t, `Stage promoted to Freight "fake-freight"`, event.Message)
+				require.Equal(t, "fake-promotion", event.Annotations[kargoapi.AnnotationKeyEventPromotionName])
+				require.Equal(t, "fake-freight", event.Annotations[kargoapi.AnnotationKeyEventFreightName])
+				require.Equal(t, "fake-stage", event.Annotations[kargoapi.AnnotationKeyEventStageName])
+			},
+		},
+		{
+			name: "dry-run Promotion succeeded",
+			promo: kargoapi.PromotionReference{
+				Name:    "fake-promotion",
+				Freight: &kargoapi.FreightReference{Name: "fake-freight"},
+				Status: &kargoapi.PromotionStatus{
+					Phase:  kargoapi.PromotionPhaseSucceeded,
+					DryRun: true,
+				},
+			},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder) {
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "Promotion failed",
+			promo: kargoapi.PromotionReference{
+				Name:    "fake-promotion",
+				Freight: &kargoapi.FreightReference{Name: "fake-freight"},
+				Status: &kargoapi.PromotionStatus{
+					Phase:   kargoapi.PromotionPhaseFailed,
+					Message: "something went wrong",
+				},
+			},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder) {
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, corev1.EventTypeWarning, event.EventType)
+				require.Equal(t, kargoapi.EventReasonPromotionFailed, event.Reason)
+				require.Equal(
+					t,
+					`Promotion "fake-promotion" of Freight "fake-freight" failed: something went wrong`,
+					event.Message,
+				)
+			},
+		},
+		{
+			name: "Promotion errored",
+			promo: kargoapi.PromotionReference{
+				Name:    "fake-promotion",
+				Freight: &kargoapi.FreightReference{Name: "fake-freight"},
+				Status: &kargoapi.PromotionStatus{
+					Phase: kargoapi.PromotionPhaseErrored,
+				},
+			},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder) {
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, corev1.EventTypeWarning, event.EventType)
+				require.Equal(t, kargoapi.EventReasonPromotionFailed, event.Reason)
+				require.Equal(t, `Promotion "fake-promotion" of Freight "fake-freight" failed`, event.Message)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := fakeevent.NewEventRecorder(1)
+			r := &reconciler{recorder: recorder}
+			r.recordPromotionEvent(testStage, testCase.promo)
+			testCase.assertions(t, recorder)
+		})
+	}
+}
+
+func TestRecordHealthEvent(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+	}
+	testCases := []struct {
+		name       string
+		previous   *kargoapi.Health
+		current    *kargoapi.Health
+		assertions func(*testing.T, *fakeevent.EventRecorder)
+	}{
+		{
+			name:    "no previous health",
+			current: &kargoapi.Health{Status: kargoapi.HealthStateUnhealthy},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder) {
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name:     "health unchanged",
+			previous: &kargoapi.Health{Status: kargoapi.HealthStateUnhealthy},
+			current:  &kargoapi.Health{Status: kargoapi.HealthStateUnhealthy},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder) {
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name:     "health improved",
+			previous: &kargoapi.Health{Status: kargoapi.HealthStateUnhealthy},
+			current:  &kargoapi.Health{Status: kargoapi.HealthStateHealthy},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder) {
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name:     "health degraded",
+			previous: &kargoapi.Health{Status: kargoapi.HealthStateHealthy},
+			current: &kargoapi.Health{
+				Status: kargoapi.HealthStateUnhealthy,
+				Issues: []string{"fake-issue-1", "fake-issue-2"},
+			},
+			assertions: func(t *testing.T, recorder *fakeevent.EventRecorder) {
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, corev1.EventTypeWarning, event.EventType)
+				require.Equal(t, kargoapi.EventReasonHealthDegraded, event.Reason)
+				require.Equal(
+					t,
+					"Stage health degraded from Healthy to Unhealthy: fake-issue-1; fake-issue-2",
+					event.Message,
+				)
+				require.Equal(t, "fake-namespace", event.Annotations[kargoapi.AnnotationKeyEventProject])
+				require.Equal(t, "fake-stage", event.Annotations[kargoapi.AnnotationKeyEventStageName])
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := fakeevent.NewEventRecorder(1)
+			r := &reconciler{recorder: recorder}
+			r.recordHealthEvent(testStage, testCase.previous, testCase.current)
+			testCase.assertions(t, recorder)
 		})
 	}
 }
@@ -3105,6 +5043,42 @@ func TestGetAvailableFreight(t *testing.T) {
 }
 
 func TestGetAvailableFreightByOrigin(t *testing.T) {
+	// fake-freight-1 is verified in both upstream Stages and fake-freight-2 in
+	// only one of them.
+	duplicateFreight := []client.Object{
+		&kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-freight-1",
+				Namespace: "fake-namespace",
+			},
+			Origin: kargoapi.FreightOrigin{
+				Kind: kargoapi.FreightOriginKindWarehouse,
+				Name: "fake-warehouse",
+			},
+			Status: kargoapi.FreightStatus{
+				VerifiedIn: map[string]kargoapi.VerifiedStage{
+					"fake-upstream-1": {},
+					"fake-upstream-2": {},
+				},
+			},
+		},
+		&kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "fake-freight-2",
+				Namespace: "fake-namespace",
+			},
+			Origin: kargoapi.FreightOrigin{
+				Kind: kargoapi.FreightOriginKindWarehouse,
+				Name: "fake-warehouse",
+			},
+			Status: kargoapi.FreightStatus{
+				VerifiedIn: map[string]kargoapi.VerifiedStage{
+					"fake-upstream-2": {},
+				},
+			},
+		},
+	}
+
 	testCases := []struct {
 		name            string
 		stage           *kargoapi.Stage
@@ -3652,6 +5626,107 @@ func TestGetAvailableFreightByOrigin(t *testing.T) {
 				require.Len(t, freight, 1)
 			},
 		},
+		{
+			name: "Freight verified in multiple upstream Stages is only included once",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-stage",
+					Namespace: "fake-namespace",
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: "fake-warehouse",
+							},
+							Sources: kargoapi.FreightSources{
+								Stages: []string{"fake-upstream-1", "fake-upstream-2"},
+							},
+						},
+					},
+				},
+			},
+			objects: duplicateFreight,
+			assertions: func(t *testing.T, result map[string][]kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Len(t, result, 1)
+
+				var found []string
+				for _, f := range result["Warehouse/fake-warehouse"] {
+					found = append(found, f.Name)
+				}
+				require.Equal(t, []string{"fake-freight-1", "fake-freight-2"}, found)
+			},
+		},
+		{
+			name: "Freight verified in any upstream Stage with OneOf strategy",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-stage",
+					Namespace: "fake-namespace",
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: "fake-warehouse",
+							},
+							Sources: kargoapi.FreightSources{
+								Stages:               []string{"fake-upstream-1", "fake-upstream-2"},
+								AvailabilityStrategy: kargoapi.FreightAvailabilityStrategyOneOf,
+							},
+						},
+					},
+				},
+			},
+			objects: duplicateFreight,
+			assertions: func(t *testing.T, result map[string][]kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Len(t, result, 1)
+
+				var found []string
+				for _, f := range result["Warehouse/fake-warehouse"] {
+					found = append(found, f.Name)
+				}
+				require.Equal(t, []string{"fake-freight-1", "fake-freight-2"}, found)
+			},
+		},
+		{
+			name: "Freight must be verified in all upstream Stages with All strategy",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-stage",
+					Namespace: "fake-namespace",
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: "fake-warehouse",
+							},
+							Sources: kargoapi.FreightSources{
+								Stages:               []string{"fake-upstream-1", "fake-upstream-2"},
+								AvailabilityStrategy: kargoapi.FreightAvailabilityStrategyAll,
+							},
+						},
+					},
+				},
+			},
+			objects: duplicateFreight,
+			assertions: func(t *testing.T, result map[string][]kargoapi.Freight, err error) {
+				require.NoError(t, err)
+				require.Len(t, result, 1)
+
+				var found []string
+				for _, f := range result["Warehouse/fake-warehouse"] {
+					found = append(found, f.Name)
+				}
+				require.Equal(t, []string{"fake-freight-1"}, found)
+			},
+		},
 	}
 
 	s := runtime.NewScheme()
@@ -3743,6 +5818,36 @@ func TestBuildFreightSummary(t *testing.T) {
 	}
 }
 
+func TestBuildFreightHistoryDeltas(t *testing.T) {
+	t.Run("empty history", func(t *testing.T) {
+		require.Nil(t, buildFreightHistoryDeltas(context.Background(), nil))
+	})
+
+	t.Run("history with multiple entries", func(t *testing.T) {
+		history := kargoapi.FreightHistory{
+			{
+				Freight: map[string]kargoapi.FreightReference{
+					"Warehouse/fake-warehouse": {Name: "fake-freight-2"},
+				},
+			},
+			{
+				Freight: map[string]kargoapi.FreightReference{
+					"Warehouse/fake-warehouse": {Name: "fake-freight-1"},
+				},
+			},
+		}
+		deltas := buildFreightHistoryDeltas(context.Background(), history)
+		require.Len(t, deltas, len(history))
+		require.NotNil(t, deltas[1].Baseline)
+		require.NotEmpty(t, deltas[0].Patch)
+		for i := range history {
+			collection, err := kargoapi.ReconstructFreightCollection(deltas, i)
+			require.NoError(t, err)
+			require.Equal(t, *history[i], collection)
+		}
+	})
+}
+
 func fakeNow() time.Time {
 	return fakeTime
 }
@@ -20,6 +20,20 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
+const (
+	// freightArgStage is the name of an AnalysisTemplate argument that, if
+	// declared without a value, is set to the name of the Stage being verified.
+	freightArgStage = "kargo-stage"
+	// freightArgFreight is the name of an AnalysisTemplate argument that, if
+	// declared without a value, is set to a comma-separated list of the names of
+	// the Freight being verified.
+	freightArgFreight = "kargo-freight"
+	// freightArgImages is the name of an AnalysisTemplate argument that, if
+	// declared without a value, is set to a comma-separated list of references
+	// to the container images in the Freight being verified.
+	freightArgImages = "kargo-images"
+)
+
 // startVerification starts a verification for the given Stage. If the Stage
 // does not have a reverification annotation, it checks if there is an existing
 // AnalysisRun for the Stage and Freight. If there is, it returns the status of
@@ -392,14 +406,15 @@ func (r *reconciler) buildAnalysisRun(
 		return nil, fmt.Errorf("error flattening templates: %w", err)
 	}
 
-	// Merge the args from the template with the args from the Stage
-	rolloutsArgs := make([]rollouts.Argument, len(stage.Spec.Verification.Args))
-	for i, argument := range stage.Spec.Verification.Args {
+	// Merge the args from the template with args describing the Freight and
+	// the args from the Stage. Args from the Stage take precedence.
+	rolloutsArgs := freightArgs(stage, freightCol)
+	for _, argument := range stage.Spec.Verification.Args {
 		arg := argument // Avoid implicit memory aliasing
-		rolloutsArgs[i] = rollouts.Argument{
+		rolloutsArgs = append(rolloutsArgs, rollouts.Argument{
 			Name:  arg.Name,
 			Value: &arg.Value,
-		}
+		})
 	}
 	mergedArgs, err := mergeArgs(rolloutsArgs, template.Spec.Args)
 	if err != nil {
@@ -462,6 +477,43 @@ func (r *reconciler) buildAnalysisRun(
 	return ar, nil
 }
 
+// freightArgs returns AnalysisRun arguments describing the Stage and the
+// Freight being verified. These are only applied to arguments that are declared
+// by the AnalysisTemplate(s) and may be overridden by the Stage's own args.
+func freightArgs(
+	stage *kargoapi.Stage,
+	freightCol *kargoapi.FreightCollection,
+) []rollouts.Argument {
+	freightNames := make([]string, 0, len(freightCol.Freight))
+	var images []string
+	for _, freightRef := range freightCol.Freight {
+		freightNames = append(freightNames, freightRef.Name)
+		for _, image := range freightRef.Images {
+			if image.Tag != "" {
+				images = append(images, fmt.Sprintf("%s:%s", image.RepoURL, image.Tag))
+			} else {
+				images = append(images, fmt.Sprintf("%s@%s", image.RepoURL, image.Digest))
+			}
+		}
+	}
+	sort.Strings(freightNames)
+	sort.Strings(images)
+	return []rollouts.Argument{
+		{
+			Name:  freightArgStage,
+			Value: ptr.To(stage.Name),
+		},
+		{
+			Name:  freightArgFreight,
+			Value: ptr.To(strings.Join(freightNames, ",")),
+		},
+		{
+			Name:  freightArgImages,
+			Value: ptr.To(strings.Join(images, ",")),
+		},
+	}
+}
+
 func flattenTemplates(
 	templates []*rollouts.AnalysisTemplate,
 ) (*rollouts.AnalysisTemplate, error) {
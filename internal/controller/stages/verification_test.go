@@ -1091,6 +1091,75 @@ func TestBuildAnalysisRun(t *testing.T) {
 				}, ar.Spec.Args)
 			},
 		},
+		{
+			name: "Sets declared Freight args",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					Verification: &kargoapi.Verification{
+						Args: []kargoapi.AnalysisRunArgument{
+							{
+								Name:  freightArgImages,
+								Value: "overwrite",
+							},
+						},
+					},
+				},
+			},
+			templates: []*rollouts.AnalysisTemplate{
+				{
+					Spec: rollouts.AnalysisTemplateSpec{
+						Args: []rollouts.Argument{
+							{
+								Name: freightArgStage,
+							},
+							{
+								Name: freightArgFreight,
+							},
+							{
+								Name: freightArgImages,
+							},
+						},
+					},
+				},
+			},
+			reconciler: &reconciler{
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return testFreight, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *kargoapi.Stage,
+				_ []*rollouts.AnalysisTemplate,
+				ar *rollouts.AnalysisRun,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(t, ar)
+
+				require.Equal(t, []rollouts.Argument{
+					{
+						Name:  freightArgStage,
+						Value: ptr.To("fake-stage"),
+					},
+					{
+						Name:  freightArgFreight,
+						Value: ptr.To(testFreight.Name),
+					},
+					{
+						Name:  freightArgImages,
+						Value: ptr.To("overwrite"),
+					},
+				}, ar.Spec.Args)
+			},
+		},
 		{
 			name: "Sets owner reference to Freight",
 			stage: &kargoapi.Stage{
@@ -1237,6 +1306,63 @@ func TestBuildAnalysisRun(t *testing.T) {
 	}
 }
 
+func TestFreightArgs(t *testing.T) {
+	freightCol := &kargoapi.FreightCollection{}
+	freightCol.UpdateOrPush(
+		kargoapi.FreightReference{
+			Name: "freight-b",
+			Origin: kargoapi.FreightOrigin{
+				Kind: kargoapi.FreightOriginKindWarehouse,
+				Name: "warehouse-b",
+			},
+			Images: []kargoapi.Image{
+				{
+					RepoURL: "fake-registry/image-b",
+					Digest:  "sha256:fake-digest",
+				},
+			},
+		},
+		kargoapi.FreightReference{
+			Name: "freight-a",
+			Origin: kargoapi.FreightOrigin{
+				Kind: kargoapi.FreightOriginKindWarehouse,
+				Name: "warehouse-a",
+			},
+			Images: []kargoapi.Image{
+				{
+					RepoURL: "fake-registry/image-a",
+					Tag:     "v1.0.0",
+				},
+			},
+		},
+	)
+	require.Equal(
+		t,
+		[]rollouts.Argument{
+			{
+				Name:  freightArgStage,
+				Value: ptr.To("fake-stage"),
+			},
+			{
+				Name:  freightArgFreight,
+				Value: ptr.To("freight-a,freight-b"),
+			},
+			{
+				Name:  freightArgImages,
+				Value: ptr.To("fake-registry/image-a:v1.0.0,fake-registry/image-b@sha256:fake-digest"),
+			},
+		},
+		freightArgs(
+			&kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-stage",
+				},
+			},
+			freightCol,
+		),
+	)
+}
+
 func TestFlattenTemplates(t *testing.T) {
 	metric := func(name, successCondition string) rollouts.Metric {
 		return rollouts.Metric{
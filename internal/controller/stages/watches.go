@@ -286,6 +286,100 @@ func (c *createdFreightEventHandler[T]) Update(
 	// No-op
 }
 
+// promotionTemplateEventHandler is an event handler that enqueues Stages
+// referencing a PromotionTemplate whenever that PromotionTemplate is created,
+// updated, or deleted, so that those Stages can reconcile using the resulting
+// PromotionMechanisms.
+type promotionTemplateEventHandler[T any] struct {
+	kargoClient   client.Client
+	shardSelector labels.Selector
+}
+
+// Create implements TypedEventHandler.
+func (p *promotionTemplateEventHandler[T]) Create(
+	ctx context.Context,
+	e event.TypedCreateEvent[T],
+	wq workqueue.RateLimitingInterface,
+) {
+	p.enqueueStages(ctx, any(e.Object).(*kargoapi.PromotionTemplate), wq) // nolint: forcetypeassert
+}
+
+// Delete implements TypedEventHandler.
+func (p *promotionTemplateEventHandler[T]) Delete(
+	ctx context.Context,
+	e event.TypedDeleteEvent[T],
+	wq workqueue.RateLimitingInterface,
+) {
+	p.enqueueStages(ctx, any(e.Object).(*kargoapi.PromotionTemplate), wq) // nolint: forcetypeassert
+}
+
+// Generic implements TypedEventHandler.
+func (p *promotionTemplateEventHandler[T]) Generic(
+	context.Context,
+	event.TypedGenericEvent[T],
+	workqueue.RateLimitingInterface,
+) {
+	// No-op
+}
+
+// Update implements TypedEventHandler.
+func (p *promotionTemplateEventHandler[T]) Update(
+	ctx context.Context,
+	e event.TypedUpdateEvent[T],
+	wq workqueue.RateLimitingInterface,
+) {
+	oldTemplate := any(e.ObjectOld).(*kargoapi.PromotionTemplate) // nolint: forcetypeassert
+	newTemplate := any(e.ObjectNew).(*kargoapi.PromotionTemplate) // nolint: forcetypeassert
+	if oldTemplate.Generation == newTemplate.Generation {
+		return
+	}
+	p.enqueueStages(ctx, newTemplate, wq)
+}
+
+func (p *promotionTemplateEventHandler[T]) enqueueStages(
+	ctx context.Context,
+	template *kargoapi.PromotionTemplate,
+	wq workqueue.RateLimitingInterface,
+) {
+	logger := logging.LoggerFromContext(ctx)
+	stages := kargoapi.StageList{}
+	if err := p.kargoClient.List(
+		ctx,
+		&stages,
+		&client.ListOptions{
+			Namespace: template.Namespace,
+			FieldSelector: fields.OneTermEqualSelector(
+				kubeclient.StagesByPromotionTemplateIndexField,
+				template.Name,
+			),
+			LabelSelector: p.shardSelector,
+		},
+	); err != nil {
+		logger.Error(
+			err, "Failed to list Stages referencing PromotionTemplate",
+			"promotionTemplate", template.Name,
+			"namespace", template.Namespace,
+		)
+		return
+	}
+	for _, stage := range stages.Items {
+		wq.Add(
+			reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: stage.Namespace,
+					Name:      stage.Name,
+				},
+			},
+		)
+		logger.Debug(
+			"enqueued Stage for reconciliation",
+			"namespace", stage.Namespace,
+			"stage", stage.Name,
+			"promotionTemplate", template.Name,
+		)
+	}
+}
+
 // updatedArgoCDAppHandler is an event handler that enqueues Stages associated
 // with an Argo CD Application whenever that Application's health or sync status
 // changes, so that those Stages can reconcile.
@@ -5,10 +5,21 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
 	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
+	"github.com/akuity/kargo/internal/kubeclient"
 )
 
 func TestAppHealthOrSyncStatusChanged(t *testing.T) {
@@ -192,3 +203,149 @@ func TestAnalysisRunPhaseChanged(t *testing.T) {
 		})
 	}
 }
+
+func TestPromotionTemplateEventHandler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "referencing-stage",
+			},
+			Spec: kargoapi.StageSpec{
+				PromotionTemplateRef: &corev1.LocalObjectReference{
+					Name: "fake-template",
+				},
+			},
+		},
+		&kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "other-stage",
+			},
+			Spec: kargoapi.StageSpec{
+				PromotionTemplateRef: &corev1.LocalObjectReference{
+					Name: "other-template",
+				},
+			},
+		},
+		&kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "other-project",
+				Name:      "referencing-stage",
+			},
+			Spec: kargoapi.StageSpec{
+				PromotionTemplateRef: &corev1.LocalObjectReference{
+					Name: "fake-template",
+				},
+			},
+		},
+	).WithIndex(
+		&kargoapi.Stage{},
+		kubeclient.StagesByPromotionTemplateIndexField,
+		func(obj client.Object) []string {
+			stage := obj.(*kargoapi.Stage) // nolint: forcetypeassert
+			if stage.Spec.PromotionTemplateRef == nil {
+				return nil
+			}
+			return []string{stage.Spec.PromotionTemplateRef.Name}
+		},
+	).Build()
+
+	h := &promotionTemplateEventHandler[*kargoapi.PromotionTemplate]{
+		kargoClient:   c,
+		shardSelector: labels.Everything(),
+	}
+
+	template := &kargoapi.PromotionTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "fake-project",
+			Name:       "fake-template",
+			Generation: 1,
+		},
+	}
+	updatedTemplate := template.DeepCopy()
+	updatedTemplate.Generation = 2
+
+	testCases := []struct {
+		name     string
+		fire     func(workqueue.RateLimitingInterface)
+		expected int
+	}{
+		{
+			name: "PromotionTemplate created",
+			fire: func(wq workqueue.RateLimitingInterface) {
+				h.Create(
+					context.Background(),
+					event.TypedCreateEvent[*kargoapi.PromotionTemplate]{Object: template},
+					wq,
+				)
+			},
+			expected: 1,
+		},
+		{
+			name: "PromotionTemplate deleted",
+			fire: func(wq workqueue.RateLimitingInterface) {
+				h.Delete(
+					context.Background(),
+					event.TypedDeleteEvent[*kargoapi.PromotionTemplate]{Object: template},
+					wq,
+				)
+			},
+			expected: 1,
+		},
+		{
+			name: "PromotionTemplate spec updated",
+			fire: func(wq workqueue.RateLimitingInterface) {
+				h.Update(
+					context.Background(),
+					event.TypedUpdateEvent[*kargoapi.PromotionTemplate]{
+						ObjectOld: template,
+						ObjectNew: updatedTemplate,
+					},
+					wq,
+				)
+			},
+			expected: 1,
+		},
+		{
+			name: "PromotionTemplate metadata updated",
+			fire: func(wq workqueue.RateLimitingInterface) {
+				h.Update(
+					context.Background(),
+					event.TypedUpdateEvent[*kargoapi.PromotionTemplate]{
+						ObjectOld: template,
+						ObjectNew: template.DeepCopy(),
+					},
+					wq,
+				)
+			},
+			expected: 0,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			wq := workqueue.NewRateLimitingQueue(
+				workqueue.DefaultControllerRateLimiter(),
+			)
+			defer wq.ShutDown()
+			testCase.fire(wq)
+			require.Equal(t, testCase.expected, wq.Len())
+			if testCase.expected > 0 {
+				item, _ := wq.Get()
+				require.Equal(
+					t,
+					reconcile.Request{
+						NamespacedName: types.NamespacedName{
+							Namespace: "fake-project",
+							Name:      "referencing-stage",
+						},
+					},
+					item,
+				)
+			}
+		})
+	}
+}
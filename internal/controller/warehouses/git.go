@@ -10,7 +10,10 @@ import (
 	"time"
 
 	"github.com/Masterminds/semver/v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
@@ -22,6 +25,10 @@ const (
 	regexpPrefix = "regexp:"
 	regexPrefix  = "regex:"
 	globPrefix   = "glob:"
+
+	// knownHostsConfigMapKey is the key of a ConfigMap referenced by a Git
+	// subscription that holds the content of an SSH known_hosts file.
+	knownHostsConfigMapKey = "known_hosts"
 )
 
 type pathSelector func(path string) (bool, error)
@@ -57,35 +64,40 @@ func (r *reconciler) discoverCommits(
 		var repoCreds *git.RepoCredentials
 		if ok {
 			repoCreds = &git.RepoCredentials{
-				Username:      creds.Username,
-				Password:      creds.Password,
-				SSHPrivateKey: creds.SSHPrivateKey,
+				Username:                creds.Username,
+				Password:                creds.Password,
+				SSHPrivateKey:           creds.SSHPrivateKey,
+				SSHPrivateKeyPassphrase: creds.SSHPrivateKeyPassphrase,
+				SSHKnownHosts:           creds.SSHKnownHosts,
 			}
 			logger.Debug("obtained credentials for git repo")
 		} else {
 			logger.Debug("found no credentials for git repo")
 		}
 
-		// Clone the Git repository.
-		cloneOpts := &git.CloneOptions{
-			Branch:                sub.Branch,
-			SingleBranch:          true,
-			Filter:                git.FilterBlobless,
-			InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+		if sub.KnownHostsConfigMapRef != nil {
+			knownHosts, err := r.getKnownHostsFn(ctx, namespace, sub)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"error obtaining known hosts for git repo %q: %w",
+					sub.RepoURL,
+					err,
+				)
+			}
+			if repoCreds == nil {
+				repoCreds = &git.RepoCredentials{}
+			}
+			repoCreds.SSHKnownHosts = knownHosts
+			logger.Debug("obtained known hosts for git repo")
 		}
-		repo, err := r.gitCloneFn(
-			sub.RepoURL,
-			&git.ClientOptions{
-				Credentials: repoCreds,
-			},
-			cloneOpts,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to clone git repo %q: %w", sub.RepoURL, err)
+
+		clientOpts := &git.ClientOptions{
+			Credentials: repoCreds,
 		}
 
 		// Enrich the logger with additional fields for this subscription.
 		logger = logger.WithValues(gitDiscoveryLogFields(sub))
+		subCtx := logging.ContextWithLogger(ctx, logger)
 
 		// Discover commits based on the subscription's commit selection strategy.
 		var discovered []kargoapi.DiscoveredCommit
@@ -93,6 +105,10 @@ func (r *reconciler) discoverCommits(
 		case kargoapi.CommitSelectionStrategyLexical,
 			kargoapi.CommitSelectionStrategyNewestTag,
 			kargoapi.CommitSelectionStrategySemVer:
+			repo, err := r.cloneRepo(subCtx, sub, sub.Branch, clientOpts)
+			if err != nil {
+				return nil, err
+			}
 			tags, err := r.discoverTagsFn(repo, sub)
 			if err != nil {
 				return nil, fmt.Errorf("error listing tags from git repo %q: %w", sub.RepoURL, err)
@@ -119,29 +135,9 @@ func (r *reconciler) discoverCommits(
 				)
 			}
 		default:
-			commits, err := r.discoverBranchHistoryFn(repo, sub)
-			if err != nil {
-				return nil, fmt.Errorf("error listing commits from git repo %q: %w", sub.RepoURL, err)
-			}
-
-			for _, meta := range commits {
-				discovered = append(discovered, kargoapi.DiscoveredCommit{
-					ID:     meta.ID,
-					Branch: sub.Branch,
-					// A decent subject length for a commit message is 50 characters
-					// (based on the 50/72 rule). We are nice people, and allow a
-					// bit more. But not an excessive amount, to minimize the risk of
-					// exceeding the maximum size of the object in the API server.
-					Subject:     shortenString(meta.Subject, 80),
-					Author:      meta.Author,
-					Committer:   meta.Committer,
-					CreatorDate: &metav1.Time{Time: meta.CommitDate},
-				})
-				logger.Trace(
-					"discovered commit from branch",
-					"commit", meta.ID,
-					"creatorDate", meta.CommitDate.Format(time.RFC3339),
-				)
+			var err error
+			if discovered, err = r.discoverBranchCommits(subCtx, sub, clientOpts); err != nil {
+				return nil, err
 			}
 		}
 
@@ -166,6 +162,190 @@ func (r *reconciler) discoverCommits(
 	return results, nil
 }
 
+// getKnownHosts returns the content of the known_hosts key of the ConfigMap
+// referenced by the provided subscription. An error is returned if the
+// ConfigMap or the key does not exist, or if the known hosts do not include a
+// key for the host of the subscription's repository, so that the host is never
+// left unverified.
+func (r *reconciler) getKnownHosts(
+	ctx context.Context,
+	namespace string,
+	sub kargoapi.GitSubscription,
+) (string, error) {
+	name := sub.KnownHostsConfigMapRef.Name
+	cm := &corev1.ConfigMap{}
+	if err := r.client.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      name,
+		},
+		cm,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", fmt.Errorf(
+				"known hosts ConfigMap %q not found in namespace %q",
+				name,
+				namespace,
+			)
+		}
+		return "", fmt.Errorf(
+			"error getting known hosts ConfigMap %q in namespace %q: %w",
+			name,
+			namespace,
+			err,
+		)
+	}
+	knownHosts := cm.Data[knownHostsConfigMapKey]
+	if strings.TrimSpace(knownHosts) == "" {
+		return "", fmt.Errorf(
+			"known hosts ConfigMap %q in namespace %q has no %q key",
+			name,
+			namespace,
+			knownHostsConfigMapKey,
+		)
+	}
+	// The host that is connected to is that of the repository's mirror, if it
+	// has one.
+	if err := git.VerifyKnownHosts(
+		r.cfg.GitMirrors.RewriteURL(sub.RepoURL),
+		knownHosts,
+	); err != nil {
+		return "", fmt.Errorf(
+			"error verifying known hosts from ConfigMap %q in namespace %q: %w",
+			name,
+			namespace,
+			err,
+		)
+	}
+	return knownHosts, nil
+}
+
+// cloneRepo clones the specified branch of the repository referenced by the
+// provided subscription, or of its mirror if one is configured. If the
+// subscription specifies a shallow clone depth and the shallow clone fails, as
+// it will if the server does not support shallow clones, a full clone is
+// performed instead. If the subscription specifies sparse checkout paths, only
+// those paths are checked out and only commits that modify them are discovered.
+func (r *reconciler) cloneRepo(
+	ctx context.Context,
+	sub kargoapi.GitSubscription,
+	branch string,
+	clientOpts *git.ClientOptions,
+) (git.Repo, error) {
+	cloneOpts := &git.CloneOptions{
+		Branch:                branch,
+		SingleBranch:          true,
+		Depth:                 uint(sub.ShallowCloneDepth),
+		Filter:                git.FilterBlobless,
+		SparseCheckoutPaths:   sub.SparseCheckoutPaths,
+		InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+	}
+	repoURL := r.cfg.GitMirrors.RewriteURL(sub.RepoURL)
+	if repoURL != sub.RepoURL {
+		logging.LoggerFromContext(ctx).Debug(
+			"cloning git repo from mirror",
+			"mirror", repoURL,
+		)
+	}
+	repo, err := r.gitCloneFn(repoURL, clientOpts, cloneOpts)
+	if err != nil && cloneOpts.Depth > 0 {
+		// Not all servers support shallow clones. Fall back to a full clone.
+		logging.LoggerFromContext(ctx).Info(
+			"shallow clone of git repo failed; falling back to a full clone",
+			"depth", cloneOpts.Depth,
+			"error", err.Error(),
+		)
+		if repo != nil {
+			_ = repo.Close()
+		}
+		cloneOpts.Depth = 0
+		repo, err = r.gitCloneFn(repoURL, clientOpts, cloneOpts)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone git repo %q: %w", sub.RepoURL, err)
+	}
+	return repo, nil
+}
+
+// discoverBranchCommits discovers commits from each of the branches the
+// provided subscription references. Commits found on more than one branch are
+// only included once, attributed to the first branch they were found on. When
+// the subscription references more than one branch, the commits from all of
+// them are sorted in descending order of their creation date and clipped to
+// the subscription's discovery limit.
+func (r *reconciler) discoverBranchCommits(
+	ctx context.Context,
+	sub kargoapi.GitSubscription,
+	clientOpts *git.ClientOptions,
+) ([]kargoapi.DiscoveredCommit, error) {
+	logger := logging.LoggerFromContext(ctx)
+	branches := getSubscribedBranches(sub)
+	var discovered []kargoapi.DiscoveredCommit
+	seen := make(map[string]struct{})
+	for _, branch := range branches {
+		repo, err := r.cloneRepo(ctx, sub, branch, clientOpts)
+		if err != nil {
+			return nil, err
+		}
+		branchSub := sub
+		branchSub.Branch = branch
+		commits, err := r.discoverBranchHistoryFn(repo, branchSub)
+		if err != nil {
+			return nil, fmt.Errorf("error listing commits from git repo %q: %w", sub.RepoURL, err)
+		}
+
+		for _, meta := range commits {
+			if _, ok := seen[meta.ID]; ok {
+				continue
+			}
+			seen[meta.ID] = struct{}{}
+			discovered = append(discovered, kargoapi.DiscoveredCommit{
+				ID:     meta.ID,
+				Branch: branch,
+				// A decent subject length for a commit message is 50 characters
+				// (based on the 50/72 rule). We are nice people, and allow a
+				// bit more. But not an excessive amount, to minimize the risk of
+				// exceeding the maximum size of the object in the API server.
+				Subject:     shortenString(meta.Subject, 80),
+				Author:      meta.Author,
+				Committer:   meta.Committer,
+				CreatorDate: &metav1.Time{Time: meta.CommitDate},
+			})
+			logger.Trace(
+				"discovered commit from branch",
+				"branch", branch,
+				"commit", meta.ID,
+				"creatorDate", meta.CommitDate.Format(time.RFC3339),
+			)
+		}
+	}
+	if len(branches) > 1 {
+		slices.SortStableFunc(discovered, func(a, b kargoapi.DiscoveredCommit) int {
+			return b.CreatorDate.Compare(a.CreatorDate.Time)
+		})
+		discovered = trimSlice(discovered, int(sub.DiscoveryLimit))
+	}
+	return discovered, nil
+}
+
+// getSubscribedBranches returns the branches the provided subscription
+// references, without duplicates. The branch referenced by the Branch field
+// comes first. If the subscription references no branches at all, a single
+// empty string is returned, denoting the repository's default branch.
+func getSubscribedBranches(sub kargoapi.GitSubscription) []string {
+	branches := make([]string, 0, len(sub.Branches)+1)
+	if sub.Branch != "" || len(sub.Branches) == 0 {
+		branches = append(branches, sub.Branch)
+	}
+	for _, branch := range sub.Branches {
+		if !slices.Contains(branches, branch) {
+			branches = append(branches, branch)
+		}
+	}
+	return branches
+}
+
 // discoverBranchHistory returns a list of commits from the given Git repository
 // that match the given subscription's branch selection criteria. It returns the
 // list of commits that match the criteria, sorted in descending order. If the
@@ -498,6 +678,9 @@ func gitDiscoveryLogFields(sub kargoapi.GitSubscription) []any {
 	if sub.Branch != "" {
 		f = append(f, "branch", sub.Branch)
 	}
+	if len(sub.Branches) > 0 {
+		f = append(f, "branches", sub.Branches)
+	}
 	switch sub.CommitSelectionStrategy {
 	case kargoapi.CommitSelectionStrategySemVer:
 		f = append(
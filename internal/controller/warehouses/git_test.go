@@ -2,12 +2,21 @@ package warehouses
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	"errors"
+	"fmt"
 	"regexp"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/git"
@@ -15,6 +24,7 @@ import (
 )
 
 func TestDiscoverCommits(t *testing.T) {
+	testNow := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 	testCases := []struct {
 		name       string
 		reconciler *reconciler
@@ -37,6 +47,78 @@ func TestDiscoverCommits(t *testing.T) {
 				require.ErrorContains(t, err, "something went wrong")
 			},
 		},
+		{
+			name: "shallow clone depth is passed to clone",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				gitCloneFn: func(_ string, _ *git.ClientOptions, opts *git.CloneOptions) (git.Repo, error) {
+					if opts.Depth != 5 {
+						return nil, errors.New("unexpected depth")
+					}
+					return nil, nil
+				},
+				discoverBranchHistoryFn: func(git.Repo, kargoapi.GitSubscription) ([]git.CommitMetadata, error) {
+					return nil, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{
+					RepoURL:           "fake-repo",
+					ShallowCloneDepth: 5,
+				}},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.GitDiscoveryResult, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "sparse checkout paths are passed to clone",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				gitCloneFn: func(_ string, _ *git.ClientOptions, opts *git.CloneOptions) (git.Repo, error) {
+					if !slices.Equal(opts.SparseCheckoutPaths, []string{"apps/foo"}) {
+						return nil, errors.New("unexpected sparse checkout paths")
+					}
+					return nil, nil
+				},
+				discoverBranchHistoryFn: func(git.Repo, kargoapi.GitSubscription) ([]git.CommitMetadata, error) {
+					return nil, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{
+					RepoURL:             "fake-repo",
+					SparseCheckoutPaths: []string{"apps/foo"},
+				}},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.GitDiscoveryResult, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "falls back to full clone if shallow clone fails",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				gitCloneFn: func(_ string, _ *git.ClientOptions, opts *git.CloneOptions) (git.Repo, error) {
+					if opts.Depth > 0 {
+						return nil, errors.New("shallow clones are not supported")
+					}
+					return nil, nil
+				},
+				discoverBranchHistoryFn: func(git.Repo, kargoapi.GitSubscription) ([]git.CommitMetadata, error) {
+					return nil, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{
+					RepoURL:           "fake-repo",
+					ShallowCloneDepth: 5,
+				}},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.GitDiscoveryResult, err error) {
+				require.NoError(t, err)
+			},
+		},
 		{
 			name: "error obtaining credentials",
 			reconciler: &reconciler{
@@ -59,6 +141,99 @@ func TestDiscoverCommits(t *testing.T) {
 				require.ErrorContains(t, err, "something went wrong")
 			},
 		},
+		{
+			name: "error obtaining known hosts",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				getKnownHostsFn: func(
+					context.Context,
+					string,
+					kargoapi.GitSubscription,
+				) (string, error) {
+					return "", errors.New("something went wrong")
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{
+					RepoURL:                "git@github.com:example/repo.git",
+					KnownHostsConfigMapRef: &corev1.LocalObjectReference{Name: "known-hosts"},
+				}},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.GitDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error obtaining known hosts for git repo")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "known hosts are passed to clone",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				getKnownHostsFn: func(
+					context.Context,
+					string,
+					kargoapi.GitSubscription,
+				) (string, error) {
+					return "fake-known-hosts", nil
+				},
+				gitCloneFn: func(_ string, opts *git.ClientOptions, _ *git.CloneOptions) (git.Repo, error) {
+					if opts.Credentials == nil || opts.Credentials.SSHKnownHosts != "fake-known-hosts" {
+						return nil, errors.New("unexpected known hosts")
+					}
+					return nil, nil
+				},
+				discoverBranchHistoryFn: func(git.Repo, kargoapi.GitSubscription) ([]git.CommitMetadata, error) {
+					return nil, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{
+					RepoURL:                "git@github.com:example/repo.git",
+					KnownHostsConfigMapRef: &corev1.LocalObjectReference{Name: "known-hosts"},
+				}},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.GitDiscoveryResult, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "clones from mirror",
+			reconciler: &reconciler{
+				cfg: ReconcilerConfig{
+					GitMirrors: git.Mirrors{{
+						OriginalURL: "https://github.com/example/repo.git",
+						MirrorURL:   "https://git.example.com/mirrors/repo.git",
+					}},
+				},
+				credentialsDB: &credentials.FakeDB{},
+				gitCloneFn: func(repoURL string, _ *git.ClientOptions, _ *git.CloneOptions) (git.Repo, error) {
+					if repoURL != "https://git.example.com/mirrors/repo.git" {
+						return nil, fmt.Errorf("unexpected repo URL %q", repoURL)
+					}
+					return nil, nil
+				},
+				discoverTagsFn: func(git.Repo, kargoapi.GitSubscription) ([]git.TagMetadata, error) {
+					return []git.TagMetadata{{Tag: "v1.0.0"}}, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{
+					RepoURL:                 "https://github.com/example/repo.git",
+					CommitSelectionStrategy: kargoapi.CommitSelectionStrategySemVer,
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.GitDiscoveryResult, err error) {
+				require.NoError(t, err)
+				// Results are attributed to the original URL.
+				require.Equal(t, []kargoapi.GitDiscoveryResult{
+					{
+						RepoURL: "https://github.com/example/repo.git",
+						Commits: []kargoapi.DiscoveredCommit{
+							{Tag: "v1.0.0", CreatorDate: &metav1.Time{}},
+						},
+					},
+				}, results)
+			},
+		},
 		{
 			name: "discovers tags",
 			reconciler: &reconciler{
@@ -167,6 +342,86 @@ func TestDiscoverCommits(t *testing.T) {
 				require.ErrorContains(t, err, "something went wrong")
 			},
 		},
+		{
+			name: "discovers newest commits across multiple branches",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				gitCloneFn: func(_ string, _ *git.ClientOptions, opts *git.CloneOptions) (git.Repo, error) {
+					if opts.Branch == "" {
+						return nil, errors.New("unexpected clone of default branch")
+					}
+					return nil, nil
+				},
+				discoverBranchHistoryFn: func(_ git.Repo, sub kargoapi.GitSubscription) ([]git.CommitMetadata, error) {
+					switch sub.Branch {
+					case "release-1":
+						return []git.CommitMetadata{
+							{ID: "abc", CommitDate: testNow.Add(-time.Hour)},
+							{ID: "shared", CommitDate: testNow.Add(-3 * time.Hour)},
+						}, nil
+					case "release-2":
+						return []git.CommitMetadata{
+							{ID: "xyz", CommitDate: testNow},
+							{ID: "shared", CommitDate: testNow.Add(-3 * time.Hour)},
+						}, nil
+					}
+					return nil, errors.New("unexpected branch")
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{
+					RepoURL:  "fake-repo",
+					Branches: []string{"release-1", "release-2"},
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.GitDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.GitDiscoveryResult{
+					{
+						RepoURL: "fake-repo",
+						Commits: []kargoapi.DiscoveredCommit{
+							{ID: "xyz", Branch: "release-2", CreatorDate: &metav1.Time{Time: testNow}},
+							{
+								ID:          "abc",
+								Branch:      "release-1",
+								CreatorDate: &metav1.Time{Time: testNow.Add(-time.Hour)},
+							},
+							// Reachable from both branches, but only discovered once
+							{
+								ID:          "shared",
+								Branch:      "release-1",
+								CreatorDate: &metav1.Time{Time: testNow.Add(-3 * time.Hour)},
+							},
+						},
+					},
+				}, results)
+			},
+		},
+		{
+			name: "one of multiple branches does not exist",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				gitCloneFn: func(_ string, _ *git.ClientOptions, opts *git.CloneOptions) (git.Repo, error) {
+					if opts.Branch == "missing" {
+						return nil, errors.New("remote branch missing not found")
+					}
+					return nil, nil
+				},
+				discoverBranchHistoryFn: func(git.Repo, kargoapi.GitSubscription) ([]git.CommitMetadata, error) {
+					return []git.CommitMetadata{{ID: "abc"}}, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{
+					RepoURL:  "fake-repo",
+					Branches: []string{"main", "missing"},
+				}},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.GitDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "failed to clone git repo")
+				require.ErrorContains(t, err, "remote branch missing not found")
+			},
+		},
 		{
 			name: "discovers for multiple subscriptions",
 			reconciler: &reconciler{
@@ -228,6 +483,131 @@ func TestDiscoverCommits(t *testing.T) {
 	}
 }
 
+func TestGetKnownHosts(t *testing.T) {
+	pubKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostKey, err := ssh.NewPublicKey(pubKey)
+	require.NoError(t, err)
+
+	testSub := kargoapi.GitSubscription{
+		RepoURL:                "git@github.com:example/repo.git",
+		KnownHostsConfigMapRef: &corev1.LocalObjectReference{Name: "known-hosts"},
+	}
+	newConfigMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-ns",
+				Name:      "known-hosts",
+			},
+			Data: data,
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		configMap  *corev1.ConfigMap
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "ConfigMap not found",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(
+					t, err, `known hosts ConfigMap "known-hosts" not found in namespace "fake-ns"`,
+				)
+			},
+		},
+		{
+			name:      "known_hosts key missing",
+			configMap: newConfigMap(map[string]string{"other": "data"}),
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, `has no "known_hosts" key`)
+			},
+		},
+		{
+			name: "invalid known hosts",
+			configMap: newConfigMap(map[string]string{
+				"known_hosts": "github.com ssh-ed25519 not-a-key",
+			}),
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error verifying known hosts")
+				require.ErrorContains(t, err, "error parsing known hosts")
+			},
+		},
+		{
+			name: "host key absent",
+			configMap: newConfigMap(map[string]string{
+				"known_hosts": knownhosts.Line([]string{"gitlab.com"}, hostKey),
+			}),
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error verifying known hosts")
+				require.ErrorContains(t, err, `do not include a key for host "github.com"`)
+			},
+		},
+		{
+			name: "valid known hosts",
+			configMap: newConfigMap(map[string]string{
+				"known_hosts": knownhosts.Line([]string{"github.com"}, hostKey),
+			}),
+			assertions: func(t *testing.T, knownHosts string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, knownhosts.Line([]string{"github.com"}, hostKey), knownHosts)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder()
+			if testCase.configMap != nil {
+				c.WithObjects(testCase.configMap)
+			}
+			r := &reconciler{client: c.Build()}
+			knownHosts, err := r.getKnownHosts(context.Background(), "fake-ns", testSub)
+			testCase.assertions(t, knownHosts, err)
+		})
+	}
+}
+
+func TestGetSubscribedBranches(t *testing.T) {
+	testCases := []struct {
+		name     string
+		sub      kargoapi.GitSubscription
+		expected []string
+	}{
+		{
+			name:     "no branches",
+			expected: []string{""},
+		},
+		{
+			name:     "empty list of branches",
+			sub:      kargoapi.GitSubscription{Branches: []string{}},
+			expected: []string{""},
+		},
+		{
+			name:     "only branch",
+			sub:      kargoapi.GitSubscription{Branch: "main"},
+			expected: []string{"main"},
+		},
+		{
+			name:     "only branches",
+			sub:      kargoapi.GitSubscription{Branches: []string{"release-1", "release-2"}},
+			expected: []string{"release-1", "release-2"},
+		},
+		{
+			name: "branch and overlapping branches",
+			sub: kargoapi.GitSubscription{
+				Branch:   "main",
+				Branches: []string{"release-1", "main", "release-1"},
+			},
+			expected: []string{"main", "release-1"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, getSubscribedBranches(testCase.sub))
+		})
+	}
+}
+
 func TestDiscoverBranchHistory(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -2,7 +2,13 @@ package warehouses
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
@@ -10,6 +16,11 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
+// chartRepoDiscoveryTimeout bounds how long discovery of chart versions from a
+// single chart repository may take before the next repository (if any) is
+// tried.
+const chartRepoDiscoveryTimeout = time.Minute
+
 func (r *reconciler) discoverCharts(
 	ctx context.Context,
 	namespace string,
@@ -29,53 +40,129 @@ func (r *reconciler) discoverCharts(
 			logger = logger.WithValues("chart", sub.Name)
 		}
 
-		creds, ok, err := r.credentialsDB.Get(ctx, namespace, credentials.TypeHelm, sub.RepoURL)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"error obtaining credentials for chart repository %q: %w",
-				sub.RepoURL,
-				err,
-			)
-		}
-		var helmCreds *helm.Credentials
-		if ok {
-			helmCreds = &helm.Credentials{
-				Username: creds.Username,
-				Password: creds.Password,
-			}
-			logger.Debug("obtained credentials for chart repo")
-		} else {
-			logger.Debug("found no credentials for chart repo")
-		}
-
 		// Enrich the logger with additional fields for this subscription.
 		if sub.SemverConstraint != "" {
 			logger = logger.WithValues("semverConstraint", sub.SemverConstraint)
 		}
 
-		// Discover versions of the chart based on the semver constraint.
-		versions, err := r.discoverChartVersionsFn(ctx, sub.RepoURL, sub.Name, sub.SemverConstraint, helmCreds)
-		if err != nil {
+		// Discover versions of the chart based on the semver constraint. If the
+		// primary repository cannot be reached, fall back to any additional
+		// repositories in the order they were specified.
+		repoURLs := make([]string, 0, 1+len(sub.FallbackRepoURLs))
+		repoURLs = append(repoURLs, sub.RepoURL)
+		repoURLs = append(repoURLs, sub.FallbackRepoURLs...)
+		var versions []string
+		var servedBy string
+		var servedByCreds *helm.Credentials
+		var errs []error
+		for _, repoURL := range repoURLs {
+			repoLogger := logger.WithValues("servingRepoURL", repoURL)
+			creds, ok, err := credentials.GetHelmCredentials(
+				ctx,
+				r.credentialsDB,
+				namespace,
+				repoURL,
+			)
+			if err != nil {
+				return nil, err
+			}
+			var helmCreds *helm.Credentials
+			if ok {
+				helmCreds = &helm.Credentials{
+					Username: creds.Username,
+					Password: creds.Password,
+				}
+				repoLogger.Debug("obtained credentials for chart repo")
+			} else {
+				repoLogger.Debug("found no credentials for chart repo")
+			}
+
+			discoveryCtx, cancel := context.WithTimeout(ctx, chartRepoDiscoveryTimeout)
+			versions, err = r.discoverChartVersionsFn(
+				discoveryCtx,
+				repoURL,
+				sub.Name,
+				sub.SemverConstraint,
+				helmCreds,
+			)
+			cancel()
+			if err == nil {
+				servedBy = repoURL
+				servedByCreds = helmCreds
+				break
+			}
+			errs = append(errs, err)
+			if len(errs) < len(repoURLs) {
+				repoLogger.Error(
+					err,
+					"error discovering chart versions; failing over to next chart repository",
+				)
+			}
+		}
+		if servedBy == "" {
 			if sub.Name == "" {
 				return nil, fmt.Errorf(
 					"error discovering latest chart versions in repository %q: %w",
 					sub.RepoURL,
-					err,
+					errors.Join(errs...),
 				)
 			}
 			return nil, fmt.Errorf(
 				"error discovering latest chart versions for chart %q in repository %q: %w",
 				sub.Name,
 				sub.RepoURL,
-				err,
+				errors.Join(errs...),
 			)
 		}
 
+		// Discard any versions whose provenance cannot be verified. Because this
+		// entails downloading each version of the chart, verification stops once
+		// enough versions have been verified to satisfy the discovery limit.
+		if sub.VerifyProvenance && len(versions) > 0 {
+			if sub.ProvenanceKeySecretRef == nil {
+				return nil, fmt.Errorf(
+					"no provenance key Secret specified for chart %q in repository %q",
+					sub.Name,
+					sub.RepoURL,
+				)
+			}
+			keyring, err := r.getProvenanceKeyRingFn(ctx, namespace, *sub.ProvenanceKeySecretRef)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"error obtaining provenance keyring for chart %q in repository %q: %w",
+					sub.Name,
+					sub.RepoURL,
+					err,
+				)
+			}
+			verifyCtx, cancel := context.WithTimeout(ctx, chartRepoDiscoveryTimeout)
+			versions, err = r.verifyChartVersionsFn(
+				verifyCtx,
+				servedBy,
+				sub.Name,
+				versions,
+				int(sub.DiscoveryLimit),
+				keyring,
+				servedByCreds,
+			)
+			cancel()
+			if err != nil {
+				return nil, fmt.Errorf(
+					"error verifying provenance of chart %q in repository %q: %w",
+					sub.Name,
+					servedBy,
+					err,
+				)
+			}
+			logger.Debug("verified provenance of chart versions", "count", len(versions))
+		}
+
 		if len(versions) == 0 {
 			results = append(results, kargoapi.ChartDiscoveryResult{
 				RepoURL:          sub.RepoURL,
 				Name:             sub.Name,
 				SemverConstraint: sub.SemverConstraint,
+				ServedBy:         servedBy,
 			})
 			logger.Debug("discovered no chart versions")
 			continue
@@ -86,6 +173,7 @@ func (r *reconciler) discoverCharts(
 			Name:             sub.Name,
 			SemverConstraint: sub.SemverConstraint,
 			Versions:         trimSlice(versions, int(sub.DiscoveryLimit)),
+			ServedBy:         servedBy,
 		})
 		logger.Debug(
 			"discovered chart versions",
@@ -96,6 +184,49 @@ func (r *reconciler) discoverCharts(
 	return results, nil
 }
 
+// getProvenanceKeyRing returns the GPG keyring, used to verify the provenance
+// of chart versions, that is held by the referenced key of a Secret in the
+// specified namespace.
+func (r *reconciler) getProvenanceKeyRing(
+	ctx context.Context,
+	namespace string,
+	ref kargoapi.SecretKeyReference,
+) ([]byte, error) {
+	secret := &corev1.Secret{}
+	if err := r.client.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      ref.Name,
+		},
+		secret,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf(
+				"provenance key Secret %q not found in namespace %q",
+				ref.Name,
+				namespace,
+			)
+		}
+		return nil, fmt.Errorf(
+			"error getting provenance key Secret %q in namespace %q: %w",
+			ref.Name,
+			namespace,
+			err,
+		)
+	}
+	keyring := secret.Data[ref.Key]
+	if len(keyring) == 0 {
+		return nil, fmt.Errorf(
+			"provenance key Secret %q in namespace %q has no %q key",
+			ref.Name,
+			namespace,
+			ref.Key,
+		)
+	}
+	return keyring, nil
+}
+
 // trimSlice returns a slice of any type with a maximum length of limit.
 // If the input slice is shorter than limit or limit is less than or equal to
 // zero, the input slice is returned unmodified.
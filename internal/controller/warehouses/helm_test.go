@@ -3,9 +3,15 @@ package warehouses
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
@@ -79,6 +85,7 @@ func TestDiscoverCharts(t *testing.T) {
 						RepoURL:  "https://example.com",
 						Name:     "fake-chart",
 						Versions: []string{"1.1.0", "1.0.0"},
+						ServedBy: "https://example.com",
 					},
 				}, results)
 			},
@@ -107,8 +114,9 @@ func TestDiscoverCharts(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, []kargoapi.ChartDiscoveryResult{
 					{
-						RepoURL: "https://example.com",
-						Name:    "fake-chart",
+						RepoURL:  "https://example.com",
+						Name:     "fake-chart",
+						ServedBy: "https://example.com",
 					},
 				}, results)
 			},
@@ -136,6 +144,73 @@ func TestDiscoverCharts(t *testing.T) {
 				require.Empty(t, results)
 			},
 		},
+		{
+			name: "fails over to fallback chart repository",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverChartVersionsFn: func(
+					_ context.Context,
+					repoURL string,
+					_ string,
+					_ string,
+					_ *helm.Credentials,
+				) ([]string, error) {
+					if repoURL != "https://mirror-2.example.com" {
+						return nil, fmt.Errorf("something went wrong")
+					}
+					return []string{"1.1.0", "1.0.0"}, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Chart: &kargoapi.ChartSubscription{
+					RepoURL: "https://example.com",
+					Name:    "fake-chart",
+					FallbackRepoURLs: []string{
+						"https://mirror-1.example.com",
+						"https://mirror-2.example.com",
+					},
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ChartDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.ChartDiscoveryResult{
+					{
+						RepoURL:  "https://example.com",
+						Name:     "fake-chart",
+						Versions: []string{"1.1.0", "1.0.0"},
+						ServedBy: "https://mirror-2.example.com",
+					},
+				}, results)
+			},
+		},
+		{
+			name: "error discovering chart versions from all chart repositories",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverChartVersionsFn: func(
+					_ context.Context,
+					repoURL string,
+					_ string,
+					_ string,
+					_ *helm.Credentials,
+				) ([]string, error) {
+					return nil, fmt.Errorf("%s is unreachable", repoURL)
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Chart: &kargoapi.ChartSubscription{
+					RepoURL:          "https://example.com",
+					Name:             "fake-chart",
+					FallbackRepoURLs: []string{"https://mirror.example.com"},
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ChartDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error discovering latest chart versions for chart")
+				require.ErrorContains(t, err, "https://example.com is unreachable")
+				require.ErrorContains(t, err, "https://mirror.example.com is unreachable")
+				require.Empty(t, results)
+			},
+		},
 		{
 			name: "error discovering chart versions with chart name",
 			reconciler: &reconciler{
@@ -161,6 +236,192 @@ func TestDiscoverCharts(t *testing.T) {
 				require.Empty(t, results)
 			},
 		},
+		{
+			name: "verifies provenance of chart versions",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{
+							Username: "fake-username",
+							Password: "fake-password",
+						}, true, nil
+					},
+				},
+				discoverChartVersionsFn: func(
+					context.Context,
+					string,
+					string,
+					string,
+					*helm.Credentials,
+				) ([]string, error) {
+					return []string{"1.2.0", "1.1.0", "1.0.0"}, nil
+				},
+				getProvenanceKeyRingFn: func(
+					context.Context,
+					string,
+					kargoapi.SecretKeyReference,
+				) ([]byte, error) {
+					return []byte("fake-keyring"), nil
+				},
+				verifyChartVersionsFn: func(
+					_ context.Context,
+					repoURL string,
+					chart string,
+					versions []string,
+					limit int,
+					keyring []byte,
+					creds *helm.Credentials,
+				) ([]string, error) {
+					if repoURL != "https://example.com" || chart != "fake-chart" || limit != 1 ||
+						string(keyring) != "fake-keyring" || creds == nil ||
+						creds.Username != "fake-username" {
+						return nil, fmt.Errorf("unexpected arguments")
+					}
+					return versions[1:2], nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Chart: &kargoapi.ChartSubscription{
+					RepoURL:          "https://example.com",
+					Name:             "fake-chart",
+					DiscoveryLimit:   1,
+					VerifyProvenance: true,
+					ProvenanceKeySecretRef: &kargoapi.SecretKeyReference{
+						Name: "provenance-key",
+						Key:  "pubring.gpg",
+					},
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ChartDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.ChartDiscoveryResult{
+					{
+						RepoURL:  "https://example.com",
+						Name:     "fake-chart",
+						Versions: []string{"1.1.0"},
+						ServedBy: "https://example.com",
+					},
+				}, results)
+			},
+		},
+		{
+			name: "no provenance key Secret specified",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverChartVersionsFn: func(
+					context.Context,
+					string,
+					string,
+					string,
+					*helm.Credentials,
+				) ([]string, error) {
+					return []string{"1.0.0"}, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Chart: &kargoapi.ChartSubscription{
+					RepoURL:          "https://example.com",
+					Name:             "fake-chart",
+					VerifyProvenance: true,
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ChartDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "no provenance key Secret specified")
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "error obtaining provenance keyring",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverChartVersionsFn: func(
+					context.Context,
+					string,
+					string,
+					string,
+					*helm.Credentials,
+				) ([]string, error) {
+					return []string{"1.0.0"}, nil
+				},
+				getProvenanceKeyRingFn: func(
+					context.Context,
+					string,
+					kargoapi.SecretKeyReference,
+				) ([]byte, error) {
+					return nil, fmt.Errorf("something went wrong")
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Chart: &kargoapi.ChartSubscription{
+					RepoURL:          "https://example.com",
+					Name:             "fake-chart",
+					VerifyProvenance: true,
+					ProvenanceKeySecretRef: &kargoapi.SecretKeyReference{
+						Name: "provenance-key",
+						Key:  "pubring.gpg",
+					},
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ChartDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error obtaining provenance keyring")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "error verifying provenance of chart versions",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverChartVersionsFn: func(
+					context.Context,
+					string,
+					string,
+					string,
+					*helm.Credentials,
+				) ([]string, error) {
+					return []string{"1.0.0"}, nil
+				},
+				getProvenanceKeyRingFn: func(
+					context.Context,
+					string,
+					kargoapi.SecretKeyReference,
+				) ([]byte, error) {
+					return []byte("fake-keyring"), nil
+				},
+				verifyChartVersionsFn: func(
+					context.Context,
+					string,
+					string,
+					[]string,
+					int,
+					[]byte,
+					*helm.Credentials,
+				) ([]string, error) {
+					return nil, fmt.Errorf("something went wrong")
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Chart: &kargoapi.ChartSubscription{
+					RepoURL:          "https://example.com",
+					Name:             "fake-chart",
+					VerifyProvenance: true,
+					ProvenanceKeySecretRef: &kargoapi.SecretKeyReference{
+						Name: "provenance-key",
+						Key:  "pubring.gpg",
+					},
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ChartDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error verifying provenance of chart")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, results)
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -174,3 +435,121 @@ func TestDiscoverCharts(t *testing.T) {
 		})
 	}
 }
+
+func TestDiscoverChartsFailsOverWhenPrimaryRepoTimesOut(t *testing.T) {
+	primary := httptest.NewServer(
+		http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			// Never respond; wait for the client to give up.
+			<-r.Context().Done()
+		}),
+	)
+	t.Cleanup(primary.Close)
+
+	fallback := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/index.yaml" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(`entries:
+  fake-chart:
+    - version: 1.0.0
+    - version: 1.1.0
+`))
+		}),
+	)
+	t.Cleanup(fallback.Close)
+
+	r := &reconciler{
+		credentialsDB: &credentials.FakeDB{},
+		discoverChartVersionsFn: func(
+			ctx context.Context,
+			repoURL string,
+			chart string,
+			semverConstraint string,
+			creds *helm.Credentials,
+		) ([]string, error) {
+			ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+			return helm.DiscoverChartVersions(ctx, repoURL, chart, semverConstraint, creds)
+		},
+	}
+
+	results, err := r.discoverCharts(
+		context.Background(),
+		"fake-namespace",
+		[]kargoapi.RepoSubscription{
+			{Chart: &kargoapi.ChartSubscription{
+				RepoURL:          primary.URL,
+				Name:             "fake-chart",
+				FallbackRepoURLs: []string{fallback.URL},
+			}},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []kargoapi.ChartDiscoveryResult{
+		{
+			RepoURL:  primary.URL,
+			Name:     "fake-chart",
+			Versions: []string{"1.1.0", "1.0.0"},
+			ServedBy: fallback.URL,
+		},
+	}, results)
+}
+
+func TestGetProvenanceKeyRing(t *testing.T) {
+	testRef := kargoapi.SecretKeyReference{
+		Name: "provenance-key",
+		Key:  "pubring.gpg",
+	}
+	newSecret := func(data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-ns",
+				Name:      "provenance-key",
+			},
+			Data: data,
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		secret     *corev1.Secret
+		assertions func(*testing.T, []byte, error)
+	}{
+		{
+			name: "Secret not found",
+			assertions: func(t *testing.T, _ []byte, err error) {
+				require.ErrorContains(
+					t, err, `provenance key Secret "provenance-key" not found in namespace "fake-ns"`,
+				)
+			},
+		},
+		{
+			name:   "key missing",
+			secret: newSecret(map[string][]byte{"other": []byte("data")}),
+			assertions: func(t *testing.T, _ []byte, err error) {
+				require.ErrorContains(t, err, `has no "pubring.gpg" key`)
+			},
+		},
+		{
+			name:   "success",
+			secret: newSecret(map[string][]byte{"pubring.gpg": []byte("fake-keyring")}),
+			assertions: func(t *testing.T, keyring []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []byte("fake-keyring"), keyring)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder()
+			if testCase.secret != nil {
+				c.WithObjects(testCase.secret)
+			}
+			r := &reconciler{client: c.Build()}
+			keyring, err := r.getProvenanceKeyRing(context.Background(), "fake-ns", testRef)
+			testCase.assertions(t, keyring, err)
+		})
+	}
+}
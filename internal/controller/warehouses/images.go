@@ -2,10 +2,14 @@ package warehouses
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
@@ -14,6 +18,10 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
+// caBundleConfigMapKey is the key of a ConfigMap referenced by an image
+// subscription that holds PEM-encoded CA certificates.
+const caBundleConfigMapKey = "ca.crt"
+
 // discoverImages discovers the latest suitable images for the given image
 // subscriptions. It returns a list of image discovery results, one for each
 // subscription.
@@ -44,19 +52,33 @@ func (r *reconciler) discoverImages(
 		var regCreds *image.Credentials
 		if ok {
 			regCreds = &image.Credentials{
-				Username: creds.Username,
-				Password: creds.Password,
+				Username:      creds.Username,
+				Password:      creds.Password,
+				IdentityToken: creds.IdentityToken,
+				RegistryToken: creds.RegistryToken,
 			}
 			logger.Debug("obtained credentials for image repo")
 		} else {
 			logger.Debug("found no credentials for image repo")
 		}
 
+		var caBundle []byte
+		if sub.CABundleConfigMapRef != nil {
+			if caBundle, err = r.getCABundleFn(ctx, namespace, sub); err != nil {
+				return nil, fmt.Errorf(
+					"error obtaining CA bundle for image repo %q: %w",
+					sub.RepoURL,
+					err,
+				)
+			}
+			logger.Debug("obtained CA bundle for image repo")
+		}
+
 		// Enrich the logger with additional fields for this subscription.
 		logger = logger.WithValues(imageDiscoveryLogFields(sub))
 
 		// Discover the latest suitable images.
-		images, err := r.discoverImageRefsFn(ctx, sub, regCreds)
+		images, err := r.discoverImageRefsFn(ctx, sub, regCreds, caBundle)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"error discovering latest images %q: %w",
@@ -104,8 +126,9 @@ func (r *reconciler) discoverImageRefs(
 	ctx context.Context,
 	sub kargoapi.ImageSubscription,
 	creds *image.Credentials,
+	caBundle []byte,
 ) ([]image.Image, error) {
-	imageSelector, err := imageSelectorForSubscription(sub, creds)
+	imageSelector, err := imageSelectorForSubscription(sub, creds, caBundle)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"error creating image selector for image %q: %w",
@@ -125,6 +148,60 @@ func (r *reconciler) discoverImageRefs(
 	return images, nil
 }
 
+// getCABundle returns the content of the ca.crt key of the ConfigMap
+// referenced by the provided subscription. An error is returned if the
+// ConfigMap or the key does not exist, or if the key holds no valid
+// PEM-encoded certificates.
+func (r *reconciler) getCABundle(
+	ctx context.Context,
+	namespace string,
+	sub kargoapi.ImageSubscription,
+) ([]byte, error) {
+	name := sub.CABundleConfigMapRef.Name
+	cm := &corev1.ConfigMap{}
+	if err := r.client.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      name,
+		},
+		cm,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf(
+				"CA bundle ConfigMap %q not found in namespace %q",
+				name,
+				namespace,
+			)
+		}
+		return nil, fmt.Errorf(
+			"error getting CA bundle ConfigMap %q in namespace %q: %w",
+			name,
+			namespace,
+			err,
+		)
+	}
+	caBundle := cm.Data[caBundleConfigMapKey]
+	if strings.TrimSpace(caBundle) == "" {
+		return nil, fmt.Errorf(
+			"CA bundle ConfigMap %q in namespace %q has no %q key",
+			name,
+			namespace,
+			caBundleConfigMapKey,
+		)
+	}
+	if !x509.NewCertPool().AppendCertsFromPEM([]byte(caBundle)) {
+		return nil, fmt.Errorf(
+			"%q key of CA bundle ConfigMap %q in namespace %q holds no valid "+
+				"PEM-encoded certificates",
+			caBundleConfigMapKey,
+			name,
+			namespace,
+		)
+	}
+	return []byte(caBundle), nil
+}
+
 const (
 	githubURLPrefix = "https://github.com"
 )
@@ -139,6 +216,13 @@ func (r *reconciler) getImageSourceURL(gitRepoURL, tag string) string {
 }
 
 func imageDiscoveryLogFields(sub kargoapi.ImageSubscription) []any {
+	if sub.LatestByBuildDate {
+		return []any{
+			"latestByBuildDate", true,
+			"platformConstrained", sub.Platform != "",
+			"tagConstrained", sub.AllowTags != "" || sub.DenyTags != "" || len(sub.IgnoreTags) > 0,
+		}
+	}
 	f := []any{
 		"imageSelectionStrategy", sub.ImageSelectionStrategy,
 		"platformConstrained", sub.Platform != "",
@@ -152,7 +236,7 @@ func imageDiscoveryLogFields(sub kargoapi.ImageSubscription) []any {
 	case kargoapi.ImageSelectionStrategyLexical, kargoapi.ImageSelectionStrategyNewestBuild:
 		f = append(
 			f,
-			"tagConstrained", sub.AllowTags != "" || len(sub.IgnoreTags) > 0,
+			"tagConstrained", sub.AllowTags != "" || sub.DenyTags != "" || len(sub.IgnoreTags) > 0,
 		)
 	}
 	return f
@@ -161,18 +245,27 @@ func imageDiscoveryLogFields(sub kargoapi.ImageSubscription) []any {
 func imageSelectorForSubscription(
 	sub kargoapi.ImageSubscription,
 	creds *image.Credentials,
+	caBundle []byte,
 ) (image.Selector, error) {
+	strategy := image.SelectionStrategy(sub.ImageSelectionStrategy)
+	if sub.LatestByBuildDate {
+		// Build dates are only considered by the NewestBuild strategy
+		strategy = image.SelectionStrategyNewestBuild
+	}
 	return image.NewSelector(
 		sub.RepoURL,
-		image.SelectionStrategy(sub.ImageSelectionStrategy),
+		strategy,
 		&image.SelectorOptions{
 			Constraint:            sub.SemverConstraint,
 			AllowRegex:            sub.AllowTags,
+			DenyRegex:             sub.DenyTags,
 			Ignore:                sub.IgnoreTags,
 			Platform:              sub.Platform,
 			Creds:                 creds,
 			InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+			CABundle:              caBundle,
 			DiscoveryLimit:        int(sub.DiscoveryLimit),
+			ByBuildDate:           sub.LatestByBuildDate,
 		},
 	)
 }
@@ -2,10 +2,16 @@ package warehouses
 
 import (
 	"context"
+	"encoding/pem"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
@@ -52,6 +58,71 @@ func TestDiscoverImages(t *testing.T) {
 				require.Empty(t, results)
 			},
 		},
+		{
+			name: "error obtaining CA bundle",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				getCABundleFn: func(
+					context.Context,
+					string,
+					kargoapi.ImageSubscription,
+				) ([]byte, error) {
+					return nil, fmt.Errorf("something went wrong")
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Image: &kargoapi.ImageSubscription{
+					RepoURL:              "fake-repo",
+					CABundleConfigMapRef: &corev1.LocalObjectReference{Name: "ca-bundle"},
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ImageDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error obtaining CA bundle for image repo")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "discovers image references using CA bundle",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				getCABundleFn: func(
+					context.Context,
+					string,
+					kargoapi.ImageSubscription,
+				) ([]byte, error) {
+					return []byte("fake-ca-bundle"), nil
+				},
+				discoverImageRefsFn: func(
+					_ context.Context,
+					_ kargoapi.ImageSubscription,
+					_ *image.Credentials,
+					caBundle []byte,
+				) ([]image.Image, error) {
+					if string(caBundle) != "fake-ca-bundle" {
+						return nil, fmt.Errorf("unexpected CA bundle %q", caBundle)
+					}
+					return []image.Image{{Tag: "xyz"}}, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{Image: &kargoapi.ImageSubscription{
+					RepoURL:              "fake-repo",
+					CABundleConfigMapRef: &corev1.LocalObjectReference{Name: "ca-bundle"},
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.ImageDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.ImageDiscoveryResult{
+					{
+						RepoURL: "fake-repo",
+						References: []kargoapi.DiscoveredImageReference{
+							{Tag: "xyz"},
+						},
+					},
+				}, results)
+			},
+		},
 		{
 			name: "discovers image references",
 			reconciler: &reconciler{
@@ -60,6 +131,7 @@ func TestDiscoverImages(t *testing.T) {
 					context.Context,
 					kargoapi.ImageSubscription,
 					*image.Credentials,
+					[]byte,
 				) ([]image.Image, error) {
 					return []image.Image{
 						{Tag: "xyz"},
@@ -93,6 +165,7 @@ func TestDiscoverImages(t *testing.T) {
 					context.Context,
 					kargoapi.ImageSubscription,
 					*image.Credentials,
+					[]byte,
 				) ([]image.Image, error) {
 					return nil, fmt.Errorf("something went wrong")
 				},
@@ -113,6 +186,7 @@ func TestDiscoverImages(t *testing.T) {
 					context.Context,
 					kargoapi.ImageSubscription,
 					*image.Credentials,
+					[]byte,
 				) ([]image.Image, error) {
 					return nil, nil
 				},
@@ -145,6 +219,77 @@ func TestDiscoverImages(t *testing.T) {
 	}
 }
 
+func TestGetCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+	caBundle := string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	}))
+
+	testSub := kargoapi.ImageSubscription{
+		RepoURL:              "registry.example.com/example/image",
+		CABundleConfigMapRef: &corev1.LocalObjectReference{Name: "ca-bundle"},
+	}
+	newConfigMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-ns",
+				Name:      "ca-bundle",
+			},
+			Data: data,
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		configMap  *corev1.ConfigMap
+		assertions func(*testing.T, []byte, error)
+	}{
+		{
+			name: "ConfigMap not found",
+			assertions: func(t *testing.T, _ []byte, err error) {
+				require.ErrorContains(
+					t, err, `CA bundle ConfigMap "ca-bundle" not found in namespace "fake-ns"`,
+				)
+			},
+		},
+		{
+			name:      "ca.crt key missing",
+			configMap: newConfigMap(map[string]string{"other": "data"}),
+			assertions: func(t *testing.T, _ []byte, err error) {
+				require.ErrorContains(t, err, `has no "ca.crt" key`)
+			},
+		},
+		{
+			name:      "invalid CA bundle",
+			configMap: newConfigMap(map[string]string{"ca.crt": "not a certificate"}),
+			assertions: func(t *testing.T, _ []byte, err error) {
+				require.ErrorContains(t, err, "holds no valid PEM-encoded certificates")
+			},
+		},
+		{
+			name:      "valid CA bundle",
+			configMap: newConfigMap(map[string]string{"ca.crt": caBundle}),
+			assertions: func(t *testing.T, bundle []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, caBundle, string(bundle))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder()
+			if testCase.configMap != nil {
+				c.WithObjects(testCase.configMap)
+			}
+			r := &reconciler{client: c.Build()}
+			bundle, err := r.getCABundle(context.Background(), "fake-ns", testSub)
+			testCase.assertions(t, bundle, err)
+		})
+	}
+}
+
 func TestGetImageSourceURL(t *testing.T) {
 	const testURLPrefix = "fake-url-prefix"
 	testCases := []struct {
@@ -0,0 +1,136 @@
+package warehouses
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// duePolls indicates which types of a Warehouse's subscriptions are due to be
+// polled for new artifacts.
+type duePolls struct {
+	git    bool
+	images bool
+	charts bool
+}
+
+// getPollingIntervals returns the intervals at which the Git, image, and chart
+// subscriptions of the provided Warehouse, respectively, should be polled.
+// Types of subscriptions without an interval of their own are polled at the
+// Warehouse's reconciliation interval.
+func getPollingIntervals(
+	warehouse *kargoapi.Warehouse,
+) (time.Duration, time.Duration, time.Duration) {
+	git := warehouse.Spec.Interval.Duration
+	images := warehouse.Spec.Interval.Duration
+	charts := warehouse.Spec.Interval.Duration
+	if intervals := warehouse.Spec.PollingIntervals; intervals != nil {
+		if intervals.GitIntervalSeconds > 0 {
+			git = time.Duration(intervals.GitIntervalSeconds) * time.Second
+		}
+		if intervals.ImageIntervalSeconds > 0 {
+			images = time.Duration(intervals.ImageIntervalSeconds) * time.Second
+		}
+		if intervals.ChartIntervalSeconds > 0 {
+			charts = time.Duration(intervals.ChartIntervalSeconds) * time.Second
+		}
+	}
+	return git, images, charts
+}
+
+// getDuePolls returns which types of the provided Warehouse's subscriptions
+// are due to be polled at the provided time. A type of subscription is due if
+// its polling interval has elapsed since it was last polled. All types are due
+// if the Warehouse's spec has changed since it was last reconciled, if a
+// refresh of the Warehouse was requested, or if no artifacts have been
+// discovered yet.
+func getDuePolls(warehouse *kargoapi.Warehouse, now time.Time) duePolls {
+	status := warehouse.Status
+	token, refresh := kargoapi.RefreshAnnotationValue(warehouse.GetAnnotations())
+	if status.LastPolled == nil || status.DiscoveredArtifacts == nil ||
+		status.ObservedGeneration != warehouse.Generation ||
+		(refresh && token != status.LastHandledRefresh) {
+		return duePolls{git: true, images: true, charts: true}
+	}
+	isDue := func(lastPolled *metav1.Time, interval time.Duration) bool {
+		return lastPolled == nil || !now.Before(lastPolled.Add(interval))
+	}
+	gitInterval, imageInterval, chartInterval := getPollingIntervals(warehouse)
+	return duePolls{
+		git:    isDue(status.LastPolled.Git, gitInterval),
+		images: isDue(status.LastPolled.Image, imageInterval),
+		charts: isDue(status.LastPolled.Chart, chartInterval),
+	}
+}
+
+// updateLastPolled returns a copy of the provided poll times in which the
+// types of subscriptions that were polled are recorded as having been polled
+// at the provided time.
+func updateLastPolled(
+	lastPolled *kargoapi.SubscriptionPollTimes,
+	polled duePolls,
+	now time.Time,
+) *kargoapi.SubscriptionPollTimes {
+	updated := lastPolled.DeepCopy()
+	if updated == nil {
+		updated = &kargoapi.SubscriptionPollTimes{}
+	}
+	polledAt := metav1.NewTime(now)
+	if polled.git {
+		updated.Git = polledAt.DeepCopy()
+	}
+	if polled.images {
+		updated.Image = polledAt.DeepCopy()
+	}
+	if polled.charts {
+		updated.Chart = polledAt.DeepCopy()
+	}
+	return updated
+}
+
+// getNextPollIn returns how long from the provided time the next type of the
+// provided Warehouse's subscriptions will be due to be polled, given the
+// provided poll times. Only types of subscriptions the Warehouse actually has
+// are considered. If it has none, the Warehouse's reconciliation interval is
+// returned.
+func getNextPollIn(
+	warehouse *kargoapi.Warehouse,
+	lastPolled *kargoapi.SubscriptionPollTimes,
+	now time.Time,
+) time.Duration {
+	if lastPolled == nil {
+		return warehouse.Spec.Interval.Duration
+	}
+	var hasGit, hasImages, hasCharts bool
+	for _, sub := range warehouse.Spec.Subscriptions {
+		hasGit = hasGit || sub.Git != nil
+		hasImages = hasImages || sub.Image != nil
+		hasCharts = hasCharts || sub.Chart != nil
+	}
+	gitInterval, imageInterval, chartInterval := getPollingIntervals(warehouse)
+	var next *time.Duration
+	consider := func(has bool, polledAt *metav1.Time, interval time.Duration) {
+		if !has {
+			return
+		}
+		in := interval
+		if polledAt != nil {
+			in = polledAt.Add(interval).Sub(now)
+		}
+		if in < 0 {
+			in = 0
+		}
+		if next == nil || in < *next {
+			next = &in
+		}
+	}
+	consider(hasGit, lastPolled.Git, gitInterval)
+	consider(hasImages, lastPolled.Image, imageInterval)
+	consider(hasCharts, lastPolled.Chart, chartInterval)
+	if next == nil {
+		return warehouse.Spec.Interval.Duration
+	}
+	return *next
+}
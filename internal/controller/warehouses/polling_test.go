@@ -0,0 +1,292 @@
+package warehouses
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestGetDuePolls(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	minutesAgo := func(minutes int) *metav1.Time {
+		return &metav1.Time{Time: now.Add(-time.Duration(minutes) * time.Minute)}
+	}
+	testSpec := kargoapi.WarehouseSpec{
+		Interval: metav1.Duration{Duration: 10 * time.Minute},
+		PollingIntervals: &kargoapi.PollingIntervals{
+			ImageIntervalSeconds: 60,
+		},
+	}
+	testCases := []struct {
+		name      string
+		warehouse *kargoapi.Warehouse
+		expected  duePolls
+	}{
+		{
+			name: "never polled",
+			warehouse: &kargoapi.Warehouse{
+				Spec: testSpec,
+			},
+			expected: duePolls{git: true, images: true, charts: true},
+		},
+		{
+			name: "spec changed",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec:       testSpec,
+				Status: kargoapi.WarehouseStatus{
+					ObservedGeneration:  1,
+					DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{},
+					LastPolled: &kargoapi.SubscriptionPollTimes{
+						Git:   minutesAgo(0),
+						Image: minutesAgo(0),
+						Chart: minutesAgo(0),
+					},
+				},
+			},
+			expected: duePolls{git: true, images: true, charts: true},
+		},
+		{
+			name: "refresh requested",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyRefresh: "new",
+					},
+				},
+				Spec: testSpec,
+				Status: kargoapi.WarehouseStatus{
+					LastHandledRefresh:  "old",
+					DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{},
+					LastPolled: &kargoapi.SubscriptionPollTimes{
+						Git:   minutesAgo(0),
+						Image: minutesAgo(0),
+						Chart: minutesAgo(0),
+					},
+				},
+			},
+			expected: duePolls{git: true, images: true, charts: true},
+		},
+		{
+			name: "only image interval elapsed",
+			warehouse: &kargoapi.Warehouse{
+				Spec: testSpec,
+				Status: kargoapi.WarehouseStatus{
+					DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{},
+					LastPolled: &kargoapi.SubscriptionPollTimes{
+						Git:   minutesAgo(5),
+						Image: minutesAgo(5),
+						Chart: minutesAgo(5),
+					},
+				},
+			},
+			expected: duePolls{images: true},
+		},
+		{
+			name: "all intervals elapsed",
+			warehouse: &kargoapi.Warehouse{
+				Spec: testSpec,
+				Status: kargoapi.WarehouseStatus{
+					DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{},
+					LastPolled: &kargoapi.SubscriptionPollTimes{
+						Git:   minutesAgo(10),
+						Image: minutesAgo(10),
+						Chart: minutesAgo(15),
+					},
+				},
+			},
+			expected: duePolls{git: true, images: true, charts: true},
+		},
+		{
+			name: "type never polled",
+			warehouse: &kargoapi.Warehouse{
+				Spec: testSpec,
+				Status: kargoapi.WarehouseStatus{
+					DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{},
+					LastPolled: &kargoapi.SubscriptionPollTimes{
+						Git:   minutesAgo(0),
+						Image: minutesAgo(0),
+					},
+				},
+			},
+			expected: duePolls{charts: true},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, getDuePolls(testCase.warehouse, now))
+		})
+	}
+}
+
+func TestUpdateLastPolled(t *testing.T) {
+	earlier := metav1.NewTime(time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC))
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	lastPolled := &kargoapi.SubscriptionPollTimes{
+		Git:   earlier.DeepCopy(),
+		Image: earlier.DeepCopy(),
+	}
+	updated := updateLastPolled(lastPolled, duePolls{images: true, charts: true}, now)
+	require.Equal(
+		t,
+		&kargoapi.SubscriptionPollTimes{
+			Git:   earlier.DeepCopy(),
+			Image: &metav1.Time{Time: now},
+			Chart: &metav1.Time{Time: now},
+		},
+		updated,
+	)
+	// The original should not have been modified
+	require.Equal(t, earlier.DeepCopy(), lastPolled.Image)
+	require.Nil(t, lastPolled.Chart)
+}
+
+func TestGetNextPollIn(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	polledAt := &metav1.Time{Time: now.Add(-30 * time.Second)}
+	testSpec := kargoapi.WarehouseSpec{
+		Interval: metav1.Duration{Duration: 10 * time.Minute},
+		PollingIntervals: &kargoapi.PollingIntervals{
+			ImageIntervalSeconds: 60,
+		},
+	}
+	testCases := []struct {
+		name          string
+		subscriptions []kargoapi.RepoSubscription
+		lastPolled    *kargoapi.SubscriptionPollTimes
+		expected      time.Duration
+	}{
+		{
+			name:     "never polled",
+			expected: 10 * time.Minute,
+		},
+		{
+			name:       "no subscriptions",
+			lastPolled: &kargoapi.SubscriptionPollTimes{},
+			expected:   10 * time.Minute,
+		},
+		{
+			name: "shortest interval of subscribed types",
+			subscriptions: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{}},
+				{Image: &kargoapi.ImageSubscription{}},
+			},
+			lastPolled: &kargoapi.SubscriptionPollTimes{
+				Git:   polledAt,
+				Image: polledAt,
+			},
+			expected: 30 * time.Second,
+		},
+		{
+			name: "types without subscriptions are ignored",
+			subscriptions: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{}},
+			},
+			lastPolled: &kargoapi.SubscriptionPollTimes{
+				Git:   polledAt,
+				Image: polledAt,
+			},
+			expected: 9*time.Minute + 30*time.Second,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			warehouse := &kargoapi.Warehouse{Spec: *testSpec.DeepCopy()}
+			warehouse.Spec.Subscriptions = testCase.subscriptions
+			require.Equal(
+				t,
+				testCase.expected,
+				getNextPollIn(warehouse, testCase.lastPolled, now),
+			)
+		})
+	}
+}
+
+func TestSyncWarehousePollsOnlyDueSubscriptions(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	earlier := &metav1.Time{Time: now.Add(-5 * time.Minute)}
+	var gitPolls, imagePolls, chartPolls int
+	r := &reconciler{
+		nowFn: func() time.Time { return now },
+		discoverCommitsFn: func(
+			context.Context,
+			string,
+			[]kargoapi.RepoSubscription,
+		) ([]kargoapi.GitDiscoveryResult, error) {
+			gitPolls++
+			return nil, nil
+		},
+		discoverImagesFn: func(
+			context.Context,
+			string,
+			[]kargoapi.RepoSubscription,
+		) ([]kargoapi.ImageDiscoveryResult, error) {
+			imagePolls++
+			return []kargoapi.ImageDiscoveryResult{{RepoURL: "new-image"}}, nil
+		},
+		discoverChartsFn: func(
+			context.Context,
+			string,
+			[]kargoapi.RepoSubscription,
+		) ([]kargoapi.ChartDiscoveryResult, error) {
+			chartPolls++
+			return nil, nil
+		},
+	}
+	r.discoverArtifactsFn = r.discoverArtifacts
+
+	status, err := r.syncWarehouse(
+		context.Background(),
+		&kargoapi.Warehouse{
+			Spec: kargoapi.WarehouseSpec{
+				Interval: metav1.Duration{Duration: 10 * time.Minute},
+				PollingIntervals: &kargoapi.PollingIntervals{
+					ImageIntervalSeconds: 60,
+				},
+				FreightCreationPolicy: kargoapi.FreightCreationPolicyManual,
+			},
+			Status: kargoapi.WarehouseStatus{
+				DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+					Git:    []kargoapi.GitDiscoveryResult{{RepoURL: "old-repo"}},
+					Images: []kargoapi.ImageDiscoveryResult{{RepoURL: "old-image"}},
+					Charts: []kargoapi.ChartDiscoveryResult{{RepoURL: "old-chart"}},
+				},
+				LastPolled: &kargoapi.SubscriptionPollTimes{
+					Git:   earlier,
+					Image: earlier,
+					Chart: earlier,
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	require.Zero(t, gitPolls)
+	require.Equal(t, 1, imagePolls)
+	require.Zero(t, chartPolls)
+
+	// Artifacts of types that were not polled are retained
+	require.Equal(
+		t,
+		&kargoapi.DiscoveredArtifacts{
+			Git:    []kargoapi.GitDiscoveryResult{{RepoURL: "old-repo"}},
+			Images: []kargoapi.ImageDiscoveryResult{{RepoURL: "new-image"}},
+			Charts: []kargoapi.ChartDiscoveryResult{{RepoURL: "old-chart"}},
+		},
+		status.DiscoveredArtifacts,
+	)
+	require.Equal(
+		t,
+		&kargoapi.SubscriptionPollTimes{
+			Git:   earlier,
+			Image: &metav1.Time{Time: now},
+			Chart: earlier,
+		},
+		status.LastPolled,
+	)
+}
@@ -3,8 +3,12 @@ package warehouses
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/kelseyhightower/envconfig"
+	"golang.org/x/sync/errgroup"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -22,26 +26,66 @@ import (
 	"github.com/akuity/kargo/internal/logging"
 )
 
+// ReconcilerConfig represents configuration for the warehouse reconciler.
+type ReconcilerConfig struct {
+	controller.WatchedNamespaces
+	ShardName string `envconfig:"SHARD_NAME"`
+	// GitMirrors maps the URLs of Git repositories to the URLs of mirrors that
+	// they are cloned from instead.
+	GitMirrors git.Mirrors `envconfig:"GIT_MIRRORS"`
+	// ImageRegistryRateLimits overrides the default rate limits of requests to
+	// specific image registries.
+	ImageRegistryRateLimits image.RateLimits `envconfig:"IMAGE_REGISTRY_RATE_LIMITS"`
+}
+
+func ReconcilerConfigFromEnv() ReconcilerConfig {
+	cfg := ReconcilerConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
 // reconciler reconciles Warehouse resources.
 type reconciler struct {
+	cfg                        ReconcilerConfig
 	client                     client.Client
 	credentialsDB              credentials.Database
 	imageSourceURLFnsByBaseURL map[string]func(string, string) string
 
 	// The following behaviors are overridable for testing purposes:
 
-	discoverArtifactsFn func(context.Context, *kargoapi.Warehouse) (*kargoapi.DiscoveredArtifacts, error)
+	nowFn func() time.Time
+
+	discoverArtifactsFn func(context.Context, *kargoapi.Warehouse, duePolls) (*kargoapi.DiscoveredArtifacts, error)
 
 	discoverCommitsFn func(context.Context, string, []kargoapi.RepoSubscription) ([]kargoapi.GitDiscoveryResult, error)
 
 	discoverImagesFn func(context.Context, string, []kargoapi.RepoSubscription) ([]kargoapi.ImageDiscoveryResult, error)
 
-	discoverImageRefsFn func(context.Context, kargoapi.ImageSubscription, *image.Credentials) ([]image.Image, error)
+	discoverImageRefsFn func(
+		context.Context,
+		kargoapi.ImageSubscription,
+		*image.Credentials,
+		[]byte,
+	) ([]image.Image, error)
+
+	getCABundleFn func(context.Context, string, kargoapi.ImageSubscription) ([]byte, error)
 
 	discoverChartsFn func(context.Context, string, []kargoapi.RepoSubscription) ([]kargoapi.ChartDiscoveryResult, error)
 
 	discoverChartVersionsFn func(context.Context, string, string, string, *helm.Credentials) ([]string, error)
 
+	getProvenanceKeyRingFn func(context.Context, string, kargoapi.SecretKeyReference) ([]byte, error)
+
+	verifyChartVersionsFn func(
+		context.Context,
+		string,
+		string,
+		[]string,
+		int,
+		[]byte,
+		*helm.Credentials,
+	) ([]string, error)
+
 	buildFreightFromLatestArtifactsFn func(string, *kargoapi.DiscoveredArtifacts) (*kargoapi.Freight, error)
 
 	gitCloneFn func(string, *git.ClientOptions, *git.CloneOptions) (git.Repo, error)
@@ -56,6 +100,10 @@ type reconciler struct {
 
 	getDiffPathsForCommitIDFn func(repo git.Repo, commitID string) ([]string, error)
 
+	getKnownHostsFn func(context.Context, string, kargoapi.GitSubscription) (string, error)
+
+	getFreightFn func(context.Context, client.Client, types.NamespacedName) (*kargoapi.Freight, error)
+
 	createFreightFn func(context.Context, client.Object, ...client.CreateOption) error
 }
 
@@ -64,10 +112,11 @@ type reconciler struct {
 func SetupReconcilerWithManager(
 	mgr manager.Manager,
 	credentialsDB credentials.Database,
-	shardName string,
+	cfg ReconcilerConfig,
 ) error {
+	image.SetRateLimits(cfg.ImageRegistryRateLimits)
 
-	shardPredicate, err := controller.GetShardPredicate(shardName)
+	shardPredicate, err := controller.GetShardPredicate(cfg.ShardName)
 	if err != nil {
 		return fmt.Errorf("error creating shard selector predicate: %w", err)
 	}
@@ -89,8 +138,9 @@ func SetupReconcilerWithManager(
 			),
 		).
 		WithEventFilter(shardPredicate).
+		WithEventFilter(cfg.WatchedNamespaces.Predicate()).
 		WithOptions(controller.CommonOptions()).
-		Complete(newReconciler(mgr.GetClient(), credentialsDB)); err != nil {
+		Complete(newReconciler(mgr.GetClient(), credentialsDB, cfg)); err != nil {
 		return fmt.Errorf("error building Warehouse reconciler: %w", err)
 	}
 	return nil
@@ -99,29 +149,37 @@ func SetupReconcilerWithManager(
 func newReconciler(
 	kubeClient client.Client,
 	credentialsDB credentials.Database,
+	cfg ReconcilerConfig,
 ) *reconciler {
 	r := &reconciler{
+		cfg:                     cfg,
 		client:                  kubeClient,
 		credentialsDB:           credentialsDB,
 		gitCloneFn:              git.Clone,
 		discoverChartVersionsFn: helm.DiscoverChartVersions,
+		verifyChartVersionsFn:   helm.VerifyChartVersions,
 		imageSourceURLFnsByBaseURL: map[string]func(string, string) string{
 			githubURLPrefix: getGithubImageSourceURL,
 		},
+		getFreightFn:    kargoapi.GetFreight,
 		createFreightFn: kubeClient.Create,
+		nowFn:           time.Now,
 	}
 
 	r.discoverArtifactsFn = r.discoverArtifacts
 	r.discoverCommitsFn = r.discoverCommits
 	r.discoverImagesFn = r.discoverImages
 	r.discoverImageRefsFn = r.discoverImageRefs
+	r.getCABundleFn = r.getCABundle
 	r.discoverChartsFn = r.discoverCharts
+	r.getProvenanceKeyRingFn = r.getProvenanceKeyRing
 	r.buildFreightFromLatestArtifactsFn = r.buildFreightFromLatestArtifacts
 	r.listCommitsFn = r.listCommits
 	r.listTagsFn = r.listTags
 	r.discoverBranchHistoryFn = r.discoverBranchHistory
 	r.discoverTagsFn = r.discoverTags
 	r.getDiffPathsForCommitIDFn = r.getDiffPathsForCommitID
+	r.getKnownHostsFn = r.getKnownHosts
 	return r
 }
 
@@ -183,14 +241,22 @@ func (r *reconciler) Reconcile(
 		return ctrl.Result{}, err
 	}
 
-	// Everything succeeded, look for new changes on the defined interval.
-	return ctrl.Result{RequeueAfter: warehouse.Spec.Interval.Duration}, nil
+	// Everything succeeded, look for new changes once the next type of
+	// subscription is due to be polled.
+	return ctrl.Result{
+		RequeueAfter: getNextPollIn(warehouse, newStatus.LastPolled, r.nowFn()),
+	}, nil
 }
 
 func (r *reconciler) syncWarehouse(
 	ctx context.Context,
 	warehouse *kargoapi.Warehouse,
 ) (kargoapi.WarehouseStatus, error) {
+	// Determine which types of subscriptions are due to be polled before the
+	// status is updated, as doing so depends on the previous status.
+	now := r.nowFn()
+	due := getDuePolls(warehouse, now)
+
 	status := *warehouse.Status.DeepCopy()
 	status.ObservedGeneration = warehouse.Generation
 	status.Message = "" // Clear any previous error
@@ -203,12 +269,13 @@ func (r *reconciler) syncWarehouse(
 	logger := logging.LoggerFromContext(ctx)
 
 	// Discover the latest artifacts.
-	discoveredArtifacts, err := r.discoverArtifactsFn(ctx, warehouse)
+	discoveredArtifacts, err := r.discoverArtifactsFn(ctx, warehouse, due)
 	if err != nil {
 		return status, fmt.Errorf("error discovering artifacts: %w", err)
 	}
 	logger.Debug("discovered latest artifacts")
 	status.DiscoveredArtifacts = discoveredArtifacts
+	status.LastPolled = updateLastPolled(status.LastPolled, due, now)
 
 	// Automatically create a Freight from the latest discovered artifacts
 	// if the Warehouse is configured to do so.
@@ -221,6 +288,43 @@ func (r *reconciler) syncWarehouse(
 			Kind: kargoapi.FreightOriginKindWarehouse,
 			Name: warehouse.Name,
 		}
+		// The ID is derived from the origin and contents of the Freight, so it
+		// must only be generated once both are known. Freight equivalent to
+		// Freight that was created previously then has the same ID and is
+		// recognized below as already existing.
+		freight.Name = freight.GenerateID()
+
+		// Unless all artifacts are compared, Freight that differs from the
+		// Freight most recently produced by this Warehouse only in ignored
+		// artifacts is not considered new.
+		mode := warehouse.Spec.FreightEqualityMode
+		if mode != "" && mode != kargoapi.FreightEqualityModeStrict &&
+			status.LastFreightID != "" && status.LastFreightID != freight.Name {
+			lastFreight, err := r.getFreightFn(
+				ctx,
+				r.client,
+				types.NamespacedName{
+					Namespace: warehouse.Namespace,
+					Name:      status.LastFreightID,
+				},
+			)
+			if err != nil {
+				return status, fmt.Errorf(
+					"error getting Freight %q in namespace %q: %w",
+					status.LastFreightID,
+					warehouse.Namespace,
+					err,
+				)
+			}
+			if lastFreight != nil && freightEqual(mode, lastFreight, freight) {
+				logger.Debug(
+					"latest artifacts do not differ from last Freight in compared artifacts",
+					"freight", lastFreight.Name,
+					"freightEqualityMode", mode,
+				)
+				return status, nil
+			}
+		}
 
 		if err = r.createFreightFn(ctx, freight); client.IgnoreAlreadyExists(err) != nil {
 			return status, fmt.Errorf(
@@ -242,23 +346,67 @@ func (r *reconciler) syncWarehouse(
 	return status, nil
 }
 
+// discoverArtifacts discovers the latest artifacts from those types of the
+// provided Warehouse's subscriptions that are due to be polled. For all other
+// types, the artifacts discovered previously are retained.
 func (r *reconciler) discoverArtifacts(
 	ctx context.Context,
 	warehouse *kargoapi.Warehouse,
+	due duePolls,
 ) (*kargoapi.DiscoveredArtifacts, error) {
-	commits, err := r.discoverCommitsFn(ctx, warehouse.Namespace, warehouse.Spec.Subscriptions)
-	if err != nil {
-		return nil, fmt.Errorf("error discovering commits: %w", err)
+	var (
+		commits []kargoapi.GitDiscoveryResult
+		images  []kargoapi.ImageDiscoveryResult
+		charts  []kargoapi.ChartDiscoveryResult
+	)
+	if previous := warehouse.Status.DiscoveredArtifacts.DeepCopy(); previous != nil {
+		commits, images, charts = previous.Git, previous.Images, previous.Charts
 	}
 
-	images, err := r.discoverImagesFn(ctx, warehouse.Namespace, warehouse.Spec.Subscriptions)
-	if err != nil {
-		return nil, fmt.Errorf("error discovering images: %w", err)
+	// Discover commits, images, and charts concurrently. If discovery of any
+	// one kind of artifact fails, the context for the others is canceled.
+	g, ctx := errgroup.WithContext(ctx)
+	if due.git {
+		g.Go(func() error {
+			var err error
+			if commits, err = r.discoverCommitsFn(
+				ctx,
+				warehouse.Namespace,
+				warehouse.Spec.Subscriptions,
+			); err != nil {
+				return fmt.Errorf("error discovering commits: %w", err)
+			}
+			return nil
+		})
 	}
-
-	charts, err := r.discoverChartsFn(ctx, warehouse.Namespace, warehouse.Spec.Subscriptions)
-	if err != nil {
-		return nil, fmt.Errorf("error discovering charts: %w", err)
+	if due.images {
+		g.Go(func() error {
+			var err error
+			if images, err = r.discoverImagesFn(
+				ctx,
+				warehouse.Namespace,
+				warehouse.Spec.Subscriptions,
+			); err != nil {
+				return fmt.Errorf("error discovering images: %w", err)
+			}
+			return nil
+		})
+	}
+	if due.charts {
+		g.Go(func() error {
+			var err error
+			if charts, err = r.discoverChartsFn(
+				ctx,
+				warehouse.Namespace,
+				warehouse.Spec.Subscriptions,
+			); err != nil {
+				return fmt.Errorf("error discovering charts: %w", err)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
 	}
 
 	return &kargoapi.DiscoveredArtifacts{
@@ -268,6 +416,29 @@ func (r *reconciler) discoverArtifacts(
 	}, nil
 }
 
+// freightEqual returns true if the provided Freight do not differ in the
+// artifacts compared under the provided FreightEqualityMode.
+func freightEqual(mode kargoapi.FreightEqualityMode, a, b *kargoapi.Freight) bool {
+	// Freight IDs are derived from a canonical representation of their
+	// artifacts, so comparing the IDs of Freight reduced to the compared
+	// artifacts compares those artifacts.
+	reduce := func(f *kargoapi.Freight) *kargoapi.Freight {
+		reduced := &kargoapi.Freight{Origin: f.Origin}
+		switch mode {
+		case kargoapi.FreightEqualityModeCommitsOnly:
+			reduced.Commits = f.Commits
+		case kargoapi.FreightEqualityModeImagesOnly:
+			reduced.Images = f.Images
+		default:
+			reduced.Commits = f.Commits
+			reduced.Images = f.Images
+			reduced.Charts = f.Charts
+		}
+		return reduced
+	}
+	return reduce(a).GenerateID() == reduce(b).GenerateID()
+}
+
 func (r *reconciler) buildFreightFromLatestArtifacts(
 	namespace string,
 	artifacts *kargoapi.DiscoveredArtifacts,
@@ -327,8 +498,5 @@ func (r *reconciler) buildFreightFromLatestArtifacts(
 		})
 	}
 
-	// Generate a unique ID for the Freight based on its contents.
-	freight.Name = freight.GenerateID()
-
 	return freight, nil
 }
@@ -3,12 +3,16 @@ package warehouses
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -21,6 +25,7 @@ func TestNewReconciler(t *testing.T) {
 	e := newReconciler(
 		kubeClient,
 		&credentials.FakeDB{},
+		ReconcilerConfig{},
 	)
 	require.NotNil(t, e.client)
 	require.NotNil(t, e.credentialsDB)
@@ -37,7 +42,11 @@ func TestNewReconciler(t *testing.T) {
 	require.NotNil(t, e.discoverBranchHistoryFn)
 	require.NotNil(t, e.discoverTagsFn)
 	require.NotNil(t, e.getDiffPathsForCommitIDFn)
+	require.NotNil(t, e.getKnownHostsFn)
+	require.NotNil(t, e.getCABundleFn)
+	require.NotNil(t, e.getFreightFn)
 	require.NotNil(t, e.createFreightFn)
+	require.NotNil(t, e.nowFn)
 }
 
 func TestSyncWarehouse(t *testing.T) {
@@ -50,7 +59,12 @@ func TestSyncWarehouse(t *testing.T) {
 		{
 			name: "error discovering latest artifacts",
 			reconciler: &reconciler{
-				discoverArtifactsFn: func(context.Context, *kargoapi.Warehouse) (*kargoapi.DiscoveredArtifacts, error) {
+				nowFn: time.Now,
+				discoverArtifactsFn: func(
+					context.Context,
+					*kargoapi.Warehouse,
+					duePolls,
+				) (*kargoapi.DiscoveredArtifacts, error) {
 					return nil, errors.New("something went wrong")
 				},
 			},
@@ -71,9 +85,11 @@ func TestSyncWarehouse(t *testing.T) {
 		{
 			name: "Freight build error",
 			reconciler: &reconciler{
+				nowFn: time.Now,
 				discoverArtifactsFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
+					duePolls,
 				) (*kargoapi.DiscoveredArtifacts, error) {
 					return &kargoapi.DiscoveredArtifacts{}, nil
 				},
@@ -95,9 +111,11 @@ func TestSyncWarehouse(t *testing.T) {
 		{
 			name: "Freight for latest artifacts already exists",
 			reconciler: &reconciler{
+				nowFn: time.Now,
 				discoverArtifactsFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
+					duePolls,
 				) (*kargoapi.DiscoveredArtifacts, error) {
 					return &kargoapi.DiscoveredArtifacts{}, nil
 				},
@@ -143,9 +161,11 @@ func TestSyncWarehouse(t *testing.T) {
 		{
 			name: "error creating Freight",
 			reconciler: &reconciler{
+				nowFn: time.Now,
 				discoverArtifactsFn: func(
 					context.Context,
 					*kargoapi.Warehouse,
+					duePolls,
 				) (*kargoapi.DiscoveredArtifacts, error) {
 					return &kargoapi.DiscoveredArtifacts{}, nil
 				},
@@ -179,7 +199,12 @@ func TestSyncWarehouse(t *testing.T) {
 		{
 			name: "automatic Freight creation",
 			reconciler: &reconciler{
-				discoverArtifactsFn: func(context.Context, *kargoapi.Warehouse) (*kargoapi.DiscoveredArtifacts, error) {
+				nowFn: time.Now,
+				discoverArtifactsFn: func(
+					context.Context,
+					*kargoapi.Warehouse,
+					duePolls,
+				) (*kargoapi.DiscoveredArtifacts, error) {
 					return &kargoapi.DiscoveredArtifacts{}, nil
 				},
 				buildFreightFromLatestArtifactsFn: func(
@@ -213,10 +238,239 @@ func TestSyncWarehouse(t *testing.T) {
 			},
 		},
 
+		{
+			name: "Freight ID is derived from origin and contents",
+			reconciler: &reconciler{
+				nowFn: time.Now,
+				discoverArtifactsFn: func(
+					context.Context,
+					*kargoapi.Warehouse,
+					duePolls,
+				) (*kargoapi.DiscoveredArtifacts, error) {
+					return &kargoapi.DiscoveredArtifacts{}, nil
+				},
+				buildFreightFromLatestArtifactsFn: func(
+					string,
+					*kargoapi.DiscoveredArtifacts,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-namespace",
+						},
+						Commits: []kargoapi.GitCommit{{
+							RepoURL: "fake-repo",
+							ID:      "fake-commit",
+						}},
+					}, nil
+				},
+				createFreightFn: func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.CreateOption,
+				) error {
+					expected := (&kargoapi.Freight{
+						Origin: kargoapi.FreightOrigin{
+							Kind: kargoapi.FreightOriginKindWarehouse,
+							Name: "fake-warehouse",
+						},
+						Commits: []kargoapi.GitCommit{{
+							RepoURL: "fake-repo",
+							ID:      "fake-commit",
+						}},
+					}).GenerateID()
+					if obj.GetName() != expected {
+						return fmt.Errorf("unexpected Freight name %q", obj.GetName())
+					}
+					return nil
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-warehouse",
+				},
+				Spec: kargoapi.WarehouseSpec{
+					FreightCreationPolicy: kargoapi.FreightCreationPolicyAutomatic,
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, status.LastFreightID)
+			},
+		},
+
+		{
+			name: "error getting last Freight",
+			reconciler: &reconciler{
+				nowFn: time.Now,
+				discoverArtifactsFn: func(
+					context.Context,
+					*kargoapi.Warehouse,
+					duePolls,
+				) (*kargoapi.DiscoveredArtifacts, error) {
+					return &kargoapi.DiscoveredArtifacts{}, nil
+				},
+				buildFreightFromLatestArtifactsFn: func(
+					string,
+					*kargoapi.DiscoveredArtifacts,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{}, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					FreightCreationPolicy: kargoapi.FreightCreationPolicyAutomatic,
+					FreightEqualityMode:   kargoapi.FreightEqualityModeCommitsOnly,
+				},
+				Status: kargoapi.WarehouseStatus{
+					LastFreightID: "last-freight",
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.ErrorContains(t, err, "error getting Freight")
+				require.Equal(t, "last-freight", status.LastFreightID)
+			},
+		},
+
+		{
+			name: "Freight differing only in ignored artifacts is not created",
+			reconciler: &reconciler{
+				nowFn: time.Now,
+				discoverArtifactsFn: func(
+					context.Context,
+					*kargoapi.Warehouse,
+					duePolls,
+				) (*kargoapi.DiscoveredArtifacts, error) {
+					return &kargoapi.DiscoveredArtifacts{}, nil
+				},
+				buildFreightFromLatestArtifactsFn: func(
+					string,
+					*kargoapi.DiscoveredArtifacts,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Commits: []kargoapi.GitCommit{{RepoURL: "fake-repo", ID: "fake-commit"}},
+						Images:  []kargoapi.Image{{RepoURL: "fake-image", Tag: "v2.0.0"}},
+					}, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{Name: "last-freight"},
+						Origin: kargoapi.FreightOrigin{
+							Kind: kargoapi.FreightOriginKindWarehouse,
+							Name: "fake-warehouse",
+						},
+						Commits: []kargoapi.GitCommit{{RepoURL: "fake-repo", ID: "fake-commit"}},
+						Images:  []kargoapi.Image{{RepoURL: "fake-image", Tag: "v1.0.0"}},
+					}, nil
+				},
+				createFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("unexpected creation of Freight")
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-warehouse",
+				},
+				Spec: kargoapi.WarehouseSpec{
+					FreightCreationPolicy: kargoapi.FreightCreationPolicyAutomatic,
+					FreightEqualityMode:   kargoapi.FreightEqualityModeCommitsOnly,
+				},
+				Status: kargoapi.WarehouseStatus{
+					LastFreightID: "last-freight",
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "last-freight", status.LastFreightID)
+			},
+		},
+
+		{
+			name: "Freight differing in compared artifacts is created",
+			reconciler: &reconciler{
+				nowFn: time.Now,
+				discoverArtifactsFn: func(
+					context.Context,
+					*kargoapi.Warehouse,
+					duePolls,
+				) (*kargoapi.DiscoveredArtifacts, error) {
+					return &kargoapi.DiscoveredArtifacts{}, nil
+				},
+				buildFreightFromLatestArtifactsFn: func(
+					string,
+					*kargoapi.DiscoveredArtifacts,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						Commits: []kargoapi.GitCommit{{RepoURL: "fake-repo", ID: "fake-commit"}},
+						Images:  []kargoapi.Image{{RepoURL: "fake-image", Tag: "v2.0.0"}},
+					}, nil
+				},
+				getFreightFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{Name: "last-freight"},
+						Origin: kargoapi.FreightOrigin{
+							Kind: kargoapi.FreightOriginKindWarehouse,
+							Name: "fake-warehouse",
+						},
+						Commits: []kargoapi.GitCommit{{RepoURL: "fake-repo", ID: "fake-commit"}},
+						Images:  []kargoapi.Image{{RepoURL: "fake-image", Tag: "v1.0.0"}},
+					}, nil
+				},
+				createFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "fake-warehouse",
+				},
+				Spec: kargoapi.WarehouseSpec{
+					FreightCreationPolicy: kargoapi.FreightCreationPolicyAutomatic,
+					FreightEqualityMode:   kargoapi.FreightEqualityModeImagesOnly,
+				},
+				Status: kargoapi.WarehouseStatus{
+					LastFreightID: "last-freight",
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, status.LastFreightID)
+				require.NotEqual(t, "last-freight", status.LastFreightID)
+			},
+		},
+
 		{
 			name: "manual Freight creation",
 			reconciler: &reconciler{
-				discoverArtifactsFn: func(context.Context, *kargoapi.Warehouse) (*kargoapi.DiscoveredArtifacts, error) {
+				nowFn: time.Now,
+				discoverArtifactsFn: func(
+					context.Context,
+					*kargoapi.Warehouse,
+					duePolls,
+				) (*kargoapi.DiscoveredArtifacts, error) {
 					return &kargoapi.DiscoveredArtifacts{}, nil
 				},
 			},
@@ -235,7 +489,12 @@ func TestSyncWarehouse(t *testing.T) {
 		{
 			name: "updates refresh request status value",
 			reconciler: &reconciler{
-				discoverArtifactsFn: func(context.Context, *kargoapi.Warehouse) (*kargoapi.DiscoveredArtifacts, error) {
+				nowFn: time.Now,
+				discoverArtifactsFn: func(
+					context.Context,
+					*kargoapi.Warehouse,
+					duePolls,
+				) (*kargoapi.DiscoveredArtifacts, error) {
 					return &kargoapi.DiscoveredArtifacts{}, nil
 				},
 			},
@@ -261,7 +520,12 @@ func TestSyncWarehouse(t *testing.T) {
 		{
 			name: "updates observed generation",
 			reconciler: &reconciler{
-				discoverArtifactsFn: func(context.Context, *kargoapi.Warehouse) (*kargoapi.DiscoveredArtifacts, error) {
+				nowFn: time.Now,
+				discoverArtifactsFn: func(
+					context.Context,
+					*kargoapi.Warehouse,
+					duePolls,
+				) (*kargoapi.DiscoveredArtifacts, error) {
 					return &kargoapi.DiscoveredArtifacts{}, nil
 				},
 			},
@@ -285,7 +549,12 @@ func TestSyncWarehouse(t *testing.T) {
 		{
 			name: "clears previous error message",
 			reconciler: &reconciler{
-				discoverArtifactsFn: func(context.Context, *kargoapi.Warehouse) (*kargoapi.DiscoveredArtifacts, error) {
+				nowFn: time.Now,
+				discoverArtifactsFn: func(
+					context.Context,
+					*kargoapi.Warehouse,
+					duePolls,
+				) (*kargoapi.DiscoveredArtifacts, error) {
 					return &kargoapi.DiscoveredArtifacts{}, nil
 				},
 			},
@@ -312,6 +581,51 @@ func TestSyncWarehouse(t *testing.T) {
 	}
 }
 
+func TestFreightEqual(t *testing.T) {
+	baseFreight := &kargoapi.Freight{
+		Origin: kargoapi.FreightOrigin{
+			Kind: kargoapi.FreightOriginKindWarehouse,
+			Name: "fake-warehouse",
+		},
+		Commits: []kargoapi.GitCommit{{RepoURL: "fake-repo", ID: "fake-commit"}},
+		Images:  []kargoapi.Image{{RepoURL: "fake-image", Tag: "v1.0.0"}},
+		Charts:  []kargoapi.Chart{{RepoURL: "fake-chart-repo", Name: "fake-chart", Version: "1.0.0"}},
+	}
+	newCommit := baseFreight.DeepCopy()
+	newCommit.Commits[0].ID = "new-commit"
+	newImage := baseFreight.DeepCopy()
+	newImage.Images[0].Tag = "v2.0.0"
+	newChart := baseFreight.DeepCopy()
+	newChart.Charts[0].Version = "2.0.0"
+
+	testCases := []struct {
+		mode      kargoapi.FreightEqualityMode
+		freight   *kargoapi.Freight
+		wantEqual bool
+	}{
+		{kargoapi.FreightEqualityModeStrict, baseFreight.DeepCopy(), true},
+		{kargoapi.FreightEqualityModeStrict, newCommit, false},
+		{kargoapi.FreightEqualityModeStrict, newImage, false},
+		{kargoapi.FreightEqualityModeStrict, newChart, false},
+		{"", newChart, false},
+		{kargoapi.FreightEqualityModeCommitsOnly, newCommit, false},
+		{kargoapi.FreightEqualityModeCommitsOnly, newImage, true},
+		{kargoapi.FreightEqualityModeCommitsOnly, newChart, true},
+		{kargoapi.FreightEqualityModeImagesOnly, newCommit, true},
+		{kargoapi.FreightEqualityModeImagesOnly, newImage, false},
+		{kargoapi.FreightEqualityModeImagesOnly, newChart, true},
+	}
+	for _, testCase := range testCases {
+		t.Run(string(testCase.mode), func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.wantEqual,
+				freightEqual(testCase.mode, baseFreight, testCase.freight),
+			)
+		})
+	}
+}
+
 func TestDiscoverArtifacts(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -327,6 +641,18 @@ func TestDiscoverArtifacts(t *testing.T) {
 				) ([]kargoapi.GitDiscoveryResult, error) {
 					return nil, errors.New("something went wrong")
 				},
+				discoverImagesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ImageDiscoveryResult, error) {
+					return []kargoapi.ImageDiscoveryResult{}, nil
+				},
+				discoverChartsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ChartDiscoveryResult, error) {
+					return []kargoapi.ChartDiscoveryResult{}, nil
+				},
 			},
 			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
 				require.ErrorContains(t, err, "something went wrong")
@@ -349,6 +675,12 @@ func TestDiscoverArtifacts(t *testing.T) {
 				) ([]kargoapi.ImageDiscoveryResult, error) {
 					return nil, errors.New("something went wrong")
 				},
+				discoverChartsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ChartDiscoveryResult, error) {
+					return []kargoapi.ChartDiscoveryResult{}, nil
+				},
 			},
 			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
 				require.ErrorContains(t, err, "something went wrong")
@@ -384,6 +716,55 @@ func TestDiscoverArtifacts(t *testing.T) {
 				require.Nil(t, discoveredArtifacts)
 			},
 		},
+		{
+			name: "discovery is performed concurrently",
+			reconciler: func() *reconciler {
+				// Each fn blocks until all three have been invoked. If they were
+				// invoked sequentially, the first would time out waiting.
+				var started sync.WaitGroup
+				started.Add(3)
+				waitForOthers := func(ctx context.Context) error {
+					started.Done()
+					done := make(chan struct{})
+					go func() {
+						started.Wait()
+						close(done)
+					}()
+					select {
+					case <-done:
+						return nil
+					case <-ctx.Done():
+						return ctx.Err()
+					case <-time.After(5 * time.Second):
+						return errors.New("not invoked concurrently")
+					}
+				}
+				return &reconciler{
+					discoverCommitsFn: func(
+						ctx context.Context, _ string,
+						_ []kargoapi.RepoSubscription,
+					) ([]kargoapi.GitDiscoveryResult, error) {
+						return []kargoapi.GitDiscoveryResult{}, waitForOthers(ctx)
+					},
+					discoverImagesFn: func(
+						ctx context.Context, _ string,
+						_ []kargoapi.RepoSubscription,
+					) ([]kargoapi.ImageDiscoveryResult, error) {
+						return []kargoapi.ImageDiscoveryResult{}, waitForOthers(ctx)
+					},
+					discoverChartsFn: func(
+						ctx context.Context, _ string,
+						_ []kargoapi.RepoSubscription,
+					) ([]kargoapi.ChartDiscoveryResult, error) {
+						return []kargoapi.ChartDiscoveryResult{}, waitForOthers(ctx)
+					},
+				}
+			}(),
+			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, discoveredArtifacts)
+			},
+		},
 		{
 			name: "success",
 			reconciler: &reconciler{
@@ -432,6 +813,7 @@ func TestDiscoverArtifacts(t *testing.T) {
 			discoveredArtifacts, err := testCase.reconciler.discoverArtifacts(
 				context.TODO(),
 				&kargoapi.Warehouse{},
+				duePolls{git: true, images: true, charts: true},
 			)
 			testCase.assertions(t, discoveredArtifacts, err)
 		})
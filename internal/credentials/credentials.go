@@ -11,6 +11,10 @@ const (
 	FieldRepoURLIsRegex = "repoURLIsRegex"
 	FieldUsername       = "username"
 	FieldPassword       = "password"
+
+	FieldSSHPrivateKey           = "sshPrivateKey"
+	FieldSSHPrivateKeyPassphrase = "sshPrivateKeyPassphrase"
+	FieldSSHKnownHosts           = "sshKnownHosts"
 )
 
 // Type is a string type used to represent a type of Credentials.
@@ -28,6 +32,11 @@ const (
 	TypeHelm Type = "helm"
 	// TypeImage represents credentials for an image repository.
 	TypeImage Type = "image"
+	// TypeTicketing represents credentials for a ticketing system.
+	TypeTicketing Type = "ticketing"
+	// TypeArgoCD represents credentials for an Argo CD instance running in a
+	// remote cluster.
+	TypeArgoCD Type = "argocd"
 )
 
 // Credentials generically represents any type of repository credential.
@@ -41,6 +50,19 @@ type Credentials struct {
 	// SSHPrivateKey is a private key that can be used for access to some remote
 	// repository. This is primarily applicable for Git repositories.
 	SSHPrivateKey string
+	// SSHPrivateKeyPassphrase is the passphrase protecting SSHPrivateKey, if
+	// any.
+	SSHPrivateKeyPassphrase string
+	// SSHKnownHosts, if specified, is the content of a known_hosts file against
+	// which the host key of a remote repository accessed using SSHPrivateKey is
+	// verified. If not specified, host key verification is skipped.
+	SSHKnownHosts string
+	// IdentityToken is an OAuth2 refresh token that some image registries
+	// accept, in place of a username and password, in exchange for access.
+	IdentityToken string
+	// RegistryToken is a bearer token that can be presented to some image
+	// registry as is, in place of a username and password.
+	RegistryToken string
 }
 
 type Helper func(
@@ -4,6 +4,10 @@ import "context"
 
 // Database is an interface for a Credentials store.
 type Database interface {
+	// Get returns Credentials of the specified type for the specified
+	// repository. Only credentials belonging to the specified Project namespace,
+	// or to a globally shared location configured by the operator, may be
+	// returned. Implementations must return an error if namespace is empty.
 	Get(
 		ctx context.Context,
 		namespace string,
@@ -0,0 +1,49 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// GetHelmCredentials looks up Helm credentials for the specified chart
+// repository in the provided Database. For repositories within an OCI
+// registry, if no credentials are found for the repository itself, credentials
+// for the registry as a whole (i.e. for a URL of the form
+// oci://<registry hostname>) are looked up instead. This permits a single set
+// of credentials to be used for every repository within the same registry.
+func GetHelmCredentials(
+	ctx context.Context,
+	db Database,
+	namespace string,
+	repoURL string,
+) (Credentials, bool, error) {
+	creds, ok, err := db.Get(ctx, namespace, TypeHelm, repoURL)
+	if err != nil {
+		return creds, false, fmt.Errorf(
+			"error obtaining credentials for chart repository %q: %w",
+			repoURL,
+			err,
+		)
+	}
+	if ok || !strings.HasPrefix(repoURL, "oci://") {
+		return creds, ok, nil
+	}
+	u, err := url.Parse(repoURL)
+	if err != nil || u.Host == "" {
+		return creds, false, nil
+	}
+	registryURL := "oci://" + u.Host
+	if registryURL == repoURL {
+		return creds, false, nil
+	}
+	if creds, ok, err = db.Get(ctx, namespace, TypeHelm, registryURL); err != nil {
+		return creds, false, fmt.Errorf(
+			"error obtaining credentials for chart registry %q: %w",
+			registryURL,
+			err,
+		)
+	}
+	return creds, ok, nil
+}
@@ -0,0 +1,97 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHelmCredentials(t *testing.T) {
+	testCases := []struct {
+		name       string
+		repoURL    string
+		creds      map[string]Credentials
+		getErr     error
+		assertions func(*testing.T, Credentials, bool, error)
+	}{
+		{
+			name:    "error obtaining credentials",
+			repoURL: "oci://fake-registry/fake-org/fake-chart",
+			getErr:  fmt.Errorf("something went wrong"),
+			assertions: func(t *testing.T, _ Credentials, _ bool, err error) {
+				require.ErrorContains(t, err, "error obtaining credentials for chart repository")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name:    "credentials found for repository",
+			repoURL: "oci://fake-registry/fake-org/fake-chart",
+			creds: map[string]Credentials{
+				"oci://fake-registry/fake-org/fake-chart": {Username: "repo-user"},
+				"oci://fake-registry":                     {Username: "registry-user"},
+			},
+			assertions: func(t *testing.T, creds Credentials, ok bool, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Equal(t, "repo-user", creds.Username)
+			},
+		},
+		{
+			name:    "credentials found for OCI registry",
+			repoURL: "oci://fake-registry/fake-org/fake-chart",
+			creds: map[string]Credentials{
+				"oci://fake-registry": {Username: "registry-user"},
+			},
+			assertions: func(t *testing.T, creds Credentials, ok bool, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Equal(t, "registry-user", creds.Username)
+			},
+		},
+		{
+			name:    "registry is not consulted for classic repositories",
+			repoURL: "https://fake-registry/fake-org",
+			creds: map[string]Credentials{
+				"oci://fake-registry": {Username: "registry-user"},
+			},
+			assertions: func(t *testing.T, _ Credentials, ok bool, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+			},
+		},
+		{
+			name:    "no credentials found",
+			repoURL: "oci://fake-registry/fake-org/fake-chart",
+			assertions: func(t *testing.T, _ Credentials, ok bool, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			creds, ok, err := GetHelmCredentials(
+				context.Background(),
+				&FakeDB{
+					GetFn: func(
+						_ context.Context,
+						_ string,
+						_ Type,
+						repoURL string,
+					) (Credentials, bool, error) {
+						if testCase.getErr != nil {
+							return Credentials{}, false, testCase.getErr
+						}
+						creds, ok := testCase.creds[repoURL]
+						return creds, ok, nil
+					},
+				},
+				"fake-namespace",
+				testCase.repoURL,
+			)
+			testCase.assertions(t, creds, ok, err)
+		})
+	}
+}
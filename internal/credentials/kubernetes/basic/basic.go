@@ -9,7 +9,8 @@ import (
 )
 
 // SecretToCreds is an implementation of credentials.Helper that simply extracts
-// a username, password, and SSH private key from a secret.
+// a username, password, and SSH private key, along with the
+// passphrase and known hosts that accompany the latter, from a secret.
 func SecretToCreds(
 	_ context.Context,
 	_ string,
@@ -23,9 +24,11 @@ func SecretToCreds(
 	}
 
 	creds := &credentials.Credentials{
-		Username:      string(secret.Data["username"]),
-		Password:      string(secret.Data["password"]),
-		SSHPrivateKey: string(secret.Data["sshPrivateKey"]),
+		Username:                string(secret.Data[credentials.FieldUsername]),
+		Password:                string(secret.Data[credentials.FieldPassword]),
+		SSHPrivateKey:           string(secret.Data[credentials.FieldSSHPrivateKey]),
+		SSHPrivateKeyPassphrase: string(secret.Data[credentials.FieldSSHPrivateKeyPassphrase]),
+		SSHKnownHosts:           string(secret.Data[credentials.FieldSSHKnownHosts]),
 	}
 	if (creds.Username != "" && creds.Password != "") ||
 		creds.SSHPrivateKey != "" {
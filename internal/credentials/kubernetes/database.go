@@ -2,7 +2,10 @@ package kubernetes
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 
@@ -14,6 +17,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/credentials/kubernetes/basic"
+	"github.com/akuity/kargo/internal/credentials/kubernetes/dockerconfig"
 	"github.com/akuity/kargo/internal/credentials/kubernetes/ecr"
 	"github.com/akuity/kargo/internal/credentials/kubernetes/gar"
 	"github.com/akuity/kargo/internal/credentials/kubernetes/github"
@@ -35,12 +39,35 @@ type database struct {
 // of the credentials.Database interface.
 type DatabaseConfig struct {
 	GlobalCredentialsNamespaces []string `envconfig:"GLOBAL_CREDENTIALS_NAMESPACES" default:""`
+	// PermittedCredentialsNamespaces optionally restricts the Project
+	// namespaces in which credentials may be found. When empty, credentials may
+	// be found in any Project namespace. Namespaces listed in
+	// GlobalCredentialsNamespaces are always permitted.
+	PermittedCredentialsNamespaces []string `envconfig:"PERMITTED_CREDENTIALS_NAMESPACES" default:""`
+	// CredentialsLabelSelector is an optional label selector that Secrets must
+	// match, in addition to bearing the credential type label, to be considered
+	// as credentials.
+	CredentialsLabelSelector string `envconfig:"CREDENTIALS_LABEL_SELECTOR"`
+	// DefaultCredentialsSecretName optionally names a Secret that, if it exists
+	// in a Project namespace and bears the requested credential type label, is
+	// used as a last resort when no other credentials are found for a
+	// repository in that Project. This is opt-in because a default credential
+	// can mask a misconfigured or missing repository-specific credential.
+	DefaultCredentialsSecretName string `envconfig:"DEFAULT_CREDENTIALS_SECRET_NAME"`
 }
 
 func DatabaseConfigFromEnv() DatabaseConfig {
 	cfg := DatabaseConfig{}
 	envconfig.MustProcess("", &cfg)
 	sort.StringSlice(cfg.GlobalCredentialsNamespaces).Sort()
+	sort.StringSlice(cfg.PermittedCredentialsNamespaces).Sort()
+	if _, err := labels.Parse(cfg.CredentialsLabelSelector); err != nil {
+		panic(fmt.Errorf(
+			"error parsing credentials label selector %q: %w",
+			cfg.CredentialsLabelSelector,
+			err,
+		))
+	}
 	return cfg
 }
 
@@ -54,6 +81,7 @@ func NewDatabase(
 ) credentials.Database {
 	credentialHelpers := []credentials.Helper{
 		basic.SecretToCreds,
+		dockerconfig.SecretToCreds,
 		ecr.NewAccessKeyCredentialHelper(),
 		ecr.NewManagedIdentityCredentialHelper(ctx),
 		gar.NewServiceAccountKeyCredentialHelper(),
@@ -79,6 +107,13 @@ func (k *database) Get(
 	credType credentials.Type,
 	repoURL string,
 ) (credentials.Credentials, bool, error) {
+	// Credentials are always scoped to a Project namespace. Listing Secrets
+	// without a namespace would search every namespace in the cluster.
+	if namespace == "" {
+		return credentials.Credentials{}, false,
+			errors.New("a namespace is required to look up credentials")
+	}
+
 	// If we are dealing with an insecure HTTP endpoint (of any type),
 	// refuse to return any credentials
 	if strings.HasPrefix(repoURL, "http://") {
@@ -92,14 +127,21 @@ func (k *database) Get(
 	var secret *corev1.Secret
 	var err error
 
-	// Check namespace for credentials
-	if secret, err = k.getCredentialsSecret(
-		ctx,
-		namespace,
-		credType,
-		repoURL,
-	); err != nil {
-		return credentials.Credentials{}, false, err
+	// Check namespace for credentials, if it is permitted to hold them
+	if k.isPermittedNamespace(namespace) {
+		if secret, err = k.getCredentialsSecret(
+			ctx,
+			namespace,
+			credType,
+			repoURL,
+		); err != nil {
+			return credentials.Credentials{}, false, err
+		}
+	} else {
+		logging.LoggerFromContext(ctx).Debug(
+			"not looking for credentials in namespace that is not permitted",
+			"namespace", namespace,
+		)
 	}
 
 	if secret == nil {
@@ -119,17 +161,121 @@ func (k *database) Get(
 		}
 	}
 
+	creds, err := k.secretToCreds(ctx, namespace, credType, repoURL, secret)
+	if err != nil {
+		return credentials.Credentials{}, false, err
+	}
+	if creds != nil {
+		return *creds, true, nil
+	}
+
+	if k.cfg.DefaultCredentialsSecretName == "" || !k.isPermittedNamespace(namespace) {
+		return credentials.Credentials{}, false, nil
+	}
+
+	// As a last resort, fall back to the Project's default credentials, if any
+	if secret, err = k.getDefaultCredentialsSecret(ctx, namespace, credType); err != nil {
+		return credentials.Credentials{}, false, err
+	}
+	if secret == nil {
+		return credentials.Credentials{}, false, nil
+	}
+	logging.LoggerFromContext(ctx).Debug(
+		"falling back to default credentials",
+		"namespace", namespace,
+		"secret", secret.Name,
+		"repoURL", repoURL,
+	)
+	if creds, err = k.secretToCreds(ctx, namespace, credType, repoURL, secret); err != nil {
+		return credentials.Credentials{}, false, err
+	}
+	if creds != nil {
+		return *creds, true, nil
+	}
+	return credentials.Credentials{}, false, nil
+}
+
+// secretToCreds returns the credentials that the first applicable credential
+// helper derives from the provided Secret, which may be nil. If no helper is
+// applicable, nil is returned.
+func (k *database) secretToCreds(
+	ctx context.Context,
+	namespace string,
+	credType credentials.Type,
+	repoURL string,
+	secret *corev1.Secret,
+) (*credentials.Credentials, error) {
 	for _, helper := range k.credentialHelpers {
 		creds, err := helper(ctx, namespace, credType, repoURL, secret)
 		if err != nil {
-			return credentials.Credentials{}, false, err
+			return nil, err
 		}
 		if creds != nil {
-			return *creds, true, nil
+			return creds, nil
 		}
 	}
+	return nil, nil
+}
 
-	return credentials.Credentials{}, false, nil
+// getDefaultCredentialsSecret returns the Secret in the specified namespace
+// that is named by DefaultCredentialsSecretName, if it exists and is labeled
+// with the specified credential type and matches any additionally configured
+// label selector. Otherwise, nil is returned.
+func (k *database) getDefaultCredentialsSecret(
+	ctx context.Context,
+	namespace string,
+	credType credentials.Type,
+) (*corev1.Secret, error) {
+	selector, err := k.credentialsSelector(credType)
+	if err != nil {
+		return nil, err
+	}
+	secret := &corev1.Secret{}
+	if err = k.kargoClient.Get(
+		ctx,
+		client.ObjectKey{
+			Namespace: namespace,
+			Name:      k.cfg.DefaultCredentialsSecretName,
+		},
+		secret,
+	); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	if !selector.Matches(labels.Set(secret.Labels)) {
+		return nil, nil
+	}
+	return secret, nil
+}
+
+// isPermittedNamespace returns true if credentials may be found in the
+// specified Project namespace.
+func (k *database) isPermittedNamespace(namespace string) bool {
+	return len(k.cfg.PermittedCredentialsNamespaces) == 0 ||
+		slices.Contains(k.cfg.PermittedCredentialsNamespaces, namespace)
+}
+
+// credentialsSelector returns a label selector matching Secrets that are
+// labeled with the specified credential type and that match any additionally
+// configured label selector.
+func (k *database) credentialsSelector(
+	credType credentials.Type,
+) (labels.Selector, error) {
+	selector := labels.Set(map[string]string{
+		kargoapi.CredentialTypeLabelKey: credType.String(),
+	}).AsSelector()
+	if k.cfg.CredentialsLabelSelector == "" {
+		return selector, nil
+	}
+	additional, err := labels.Parse(k.cfg.CredentialsLabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error parsing credentials label selector %q: %w",
+			k.cfg.CredentialsLabelSelector,
+			err,
+		)
+	}
+	reqs, _ := additional.Requirements()
+	return selector.Add(reqs...), nil
 }
 
 func (k *database) getCredentialsSecret(
@@ -138,17 +284,20 @@ func (k *database) getCredentialsSecret(
 	credType credentials.Type,
 	repoURL string,
 ) (*corev1.Secret, error) {
+	selector, err := k.credentialsSelector(credType)
+	if err != nil {
+		return nil, err
+	}
+
 	// List all secrets in the namespace that are labeled with the credential
-	// type.
+	// type and match any additionally configured label selector.
 	secrets := corev1.SecretList{}
-	if err := k.kargoClient.List(
+	if err = k.kargoClient.List(
 		ctx,
 		&secrets,
 		&client.ListOptions{
-			Namespace: namespace,
-			LabelSelector: labels.Set(map[string]string{
-				kargoapi.CredentialTypeLabelKey: credType.String(),
-			}).AsSelector(),
+			Namespace:     namespace,
+			LabelSelector: selector,
 		},
 	); err != nil {
 		return nil, err
@@ -160,6 +309,10 @@ func (k *database) getCredentialsSecret(
 		return secrets.Items[i].Name < secrets.Items[j].Name
 	})
 
+	// Image pull Secrets are matched against the registry hosting the image
+	// repository, so they need the repository URL as it was provided.
+	imageRepoURL := repoURL
+
 	// Normalize the repository URL. These normalizations should be safe even
 	// if not applicable to the URL type.
 	repoURL = helm.NormalizeChartRepositoryURL(git.NormalizeURL(repoURL))
@@ -171,13 +324,32 @@ func (k *database) getCredentialsSecret(
 	for _, secret := range secrets.Items {
 		secret := secret
 
-		if secret.Data == nil {
+		// Never consider a Secret from another namespace, even if the client
+		// returned one.
+		if secret.Namespace != namespace || secret.Data == nil {
 			continue
 		}
 
 		isRegex := string(secret.Data[credentials.FieldRepoURLIsRegex]) == "true"
 		urlBytes, ok := secret.Data[credentials.FieldRepoURL]
 		if !ok {
+			// An image pull Secret that does not specify a repository URL matches
+			// any image repository hosted by a registry it has an entry for.
+			if matchingSecret == nil && credType == credentials.TypeImage &&
+				secret.Type == corev1.SecretTypeDockerConfigJson {
+				cfg, err := dockerconfig.FromSecret(&secret)
+				if err != nil {
+					logger.Error(
+						err, "failed to parse image pull secret",
+						"namespace", namespace,
+						"secret", secret.Name,
+					)
+					continue
+				}
+				if cfg.HasRegistryFor(imageRepoURL) {
+					matchingSecret = &secret
+				}
+			}
 			continue
 		}
 
@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"context"
+	"encoding/base64"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -224,3 +225,491 @@ func TestGet(t *testing.T) {
 		})
 	}
 }
+
+func TestGetIsolatesNamespaces(t *testing.T) {
+	const (
+		testNamespaceA = "namespace-a"
+		testNamespaceB = "namespace-b"
+		testCredType   = credentials.TypeGit
+		testRepoURL    = "https://github.com/akuity/kargo"
+	)
+
+	testSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "credential",
+			Namespace: testNamespaceA,
+			Labels: map[string]string{
+				kargoapi.CredentialTypeLabelKey: testCredType.String(),
+			},
+		},
+		Data: map[string][]byte{
+			credentials.FieldRepoURL:  []byte(testRepoURL),
+			credentials.FieldUsername: []byte("fake-username"),
+			credentials.FieldPassword: []byte("fake-password"),
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		namespace  string
+		assertions func(*testing.T, credentials.Credentials, bool, error)
+	}{
+		{
+			name:      "no namespace",
+			namespace: "",
+			assertions: func(t *testing.T, _ credentials.Credentials, found bool, err error) {
+				require.ErrorContains(t, err, "a namespace is required")
+				require.False(t, found)
+			},
+		},
+		{
+			name:      "credential in same namespace is returned",
+			namespace: testNamespaceA,
+			assertions: func(t *testing.T, creds credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, "fake-username", creds.Username)
+			},
+		},
+		{
+			name:      "credential in another namespace is not returned",
+			namespace: testNamespaceB,
+			assertions: func(t *testing.T, creds credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.False(t, found)
+				require.Empty(t, creds)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			creds, found, err := NewDatabase(
+				context.Background(),
+				fake.NewClientBuilder().WithObjects(testSecret).Build(),
+				DatabaseConfig{},
+			).Get(
+				context.Background(),
+				testCase.namespace,
+				testCredType,
+				testRepoURL,
+			)
+			testCase.assertions(t, creds, found, err)
+		})
+	}
+}
+
+func TestGetWithRestrictedScope(t *testing.T) {
+	const (
+		testProjectNamespace = "fake-namespace"
+		testGlobalNamespace  = "another-fake-namespace"
+		testCredType         = credentials.TypeGit
+		testRepoURL          = "https://github.com/akuity/kargo"
+		testScopeLabelKey    = "kargo.akuity.io/managed"
+	)
+
+	newSecret := func(namespace, name string, lbls map[string]string) *corev1.Secret {
+		secretLabels := map[string]string{
+			kargoapi.CredentialTypeLabelKey: testCredType.String(),
+		}
+		for k, v := range lbls {
+			secretLabels[k] = v
+		}
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    secretLabels,
+			},
+			Data: map[string][]byte{
+				credentials.FieldRepoURL:  []byte(testRepoURL),
+				credentials.FieldUsername: []byte(name),
+				credentials.FieldPassword: []byte("fake-password"),
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		cfg        DatabaseConfig
+		secrets    []client.Object
+		assertions func(*testing.T, credentials.Credentials, bool, error)
+	}{
+		{
+			name: "Secret without required labels is ignored",
+			cfg: DatabaseConfig{
+				CredentialsLabelSelector: testScopeLabelKey + "=true",
+			},
+			secrets: []client.Object{
+				newSecret(testProjectNamespace, "unlabeled", nil),
+			},
+			assertions: func(t *testing.T, _ credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.False(t, found)
+			},
+		},
+		{
+			name: "Secret with required labels is used",
+			cfg: DatabaseConfig{
+				CredentialsLabelSelector: testScopeLabelKey + "=true",
+			},
+			secrets: []client.Object{
+				newSecret(testProjectNamespace, "a-unlabeled", nil),
+				newSecret(
+					testProjectNamespace,
+					"b-labeled",
+					map[string]string{testScopeLabelKey: "true"},
+				),
+			},
+			assertions: func(t *testing.T, creds credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, "b-labeled", creds.Username)
+			},
+		},
+		{
+			name: "Secret in namespace that is not permitted is ignored",
+			cfg: DatabaseConfig{
+				PermittedCredentialsNamespaces: []string{"some-other-namespace"},
+			},
+			secrets: []client.Object{
+				newSecret(testProjectNamespace, "out-of-scope", nil),
+			},
+			assertions: func(t *testing.T, _ credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.False(t, found)
+			},
+		},
+		{
+			name: "Secret in permitted namespace is used",
+			cfg: DatabaseConfig{
+				PermittedCredentialsNamespaces: []string{testProjectNamespace},
+			},
+			secrets: []client.Object{
+				newSecret(testProjectNamespace, "in-scope", nil),
+			},
+			assertions: func(t *testing.T, creds credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, "in-scope", creds.Username)
+			},
+		},
+		{
+			name: "global credentials namespace is always permitted",
+			cfg: DatabaseConfig{
+				GlobalCredentialsNamespaces:    []string{testGlobalNamespace},
+				PermittedCredentialsNamespaces: []string{"some-other-namespace"},
+			},
+			secrets: []client.Object{
+				newSecret(testProjectNamespace, "out-of-scope", nil),
+				newSecret(testGlobalNamespace, "global", nil),
+			},
+			assertions: func(t *testing.T, creds credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, "global", creds.Username)
+			},
+		},
+		{
+			name: "invalid label selector",
+			cfg: DatabaseConfig{
+				CredentialsLabelSelector: "=bogus",
+			},
+			secrets: []client.Object{
+				newSecret(testProjectNamespace, "unlabeled", nil),
+			},
+			assertions: func(t *testing.T, _ credentials.Credentials, _ bool, err error) {
+				require.ErrorContains(t, err, "error parsing credentials label selector")
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			creds, found, err := NewDatabase(
+				context.Background(),
+				fake.NewClientBuilder().WithObjects(testCase.secrets...).Build(),
+				testCase.cfg,
+			).Get(
+				context.Background(),
+				testProjectNamespace,
+				testCredType,
+				testRepoURL,
+			)
+			testCase.assertions(t, creds, found, err)
+		})
+	}
+}
+
+func TestGetWithDefaultCredentials(t *testing.T) {
+	const (
+		testProjectNamespace = "fake-namespace"
+		testCredType         = credentials.TypeGit
+		testRepoURL          = "https://github.com/akuity/kargo"
+		testDefaultName      = "default-creds"
+	)
+
+	newSecret := func(name, credType, repoURL string) *corev1.Secret {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testProjectNamespace,
+				Labels: map[string]string{
+					kargoapi.CredentialTypeLabelKey: credType,
+				},
+			},
+			Data: map[string][]byte{
+				credentials.FieldUsername: []byte(name),
+				credentials.FieldPassword: []byte("fake-password"),
+			},
+		}
+		if repoURL != "" {
+			secret.Data[credentials.FieldRepoURL] = []byte(repoURL)
+		}
+		return secret
+	}
+
+	testCases := []struct {
+		name       string
+		cfg        DatabaseConfig
+		secrets    []client.Object
+		assertions func(*testing.T, credentials.Credentials, bool, error)
+	}{
+		{
+			name: "default credentials not configured",
+			secrets: []client.Object{
+				newSecret(testDefaultName, testCredType.String(), ""),
+			},
+			assertions: func(t *testing.T, _ credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.False(t, found)
+			},
+		},
+		{
+			name: "default credentials do not exist",
+			cfg: DatabaseConfig{
+				DefaultCredentialsSecretName: testDefaultName,
+			},
+			assertions: func(t *testing.T, _ credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.False(t, found)
+			},
+		},
+		{
+			name: "default credentials used after specific lookup misses",
+			cfg: DatabaseConfig{
+				DefaultCredentialsSecretName: testDefaultName,
+			},
+			secrets: []client.Object{
+				newSecret("other-repo", testCredType.String(), "https://github.com/akuity/other"),
+				newSecret(testDefaultName, testCredType.String(), ""),
+			},
+			assertions: func(t *testing.T, creds credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, testDefaultName, creds.Username)
+			},
+		},
+		{
+			name: "specific credentials take precedence over default credentials",
+			cfg: DatabaseConfig{
+				DefaultCredentialsSecretName: testDefaultName,
+			},
+			secrets: []client.Object{
+				newSecret("specific", testCredType.String(), testRepoURL),
+				newSecret(testDefaultName, testCredType.String(), ""),
+			},
+			assertions: func(t *testing.T, creds credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, "specific", creds.Username)
+			},
+		},
+		{
+			name: "default credentials of another type are ignored",
+			cfg: DatabaseConfig{
+				DefaultCredentialsSecretName: testDefaultName,
+			},
+			secrets: []client.Object{
+				newSecret(testDefaultName, credentials.TypeHelm.String(), ""),
+			},
+			assertions: func(t *testing.T, _ credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.False(t, found)
+			},
+		},
+		{
+			name: "default credentials in namespace that is not permitted are ignored",
+			cfg: DatabaseConfig{
+				DefaultCredentialsSecretName:   testDefaultName,
+				PermittedCredentialsNamespaces: []string{"some-other-namespace"},
+			},
+			secrets: []client.Object{
+				newSecret(testDefaultName, testCredType.String(), ""),
+			},
+			assertions: func(t *testing.T, _ credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.False(t, found)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			creds, found, err := NewDatabase(
+				context.Background(),
+				fake.NewClientBuilder().WithObjects(testCase.secrets...).Build(),
+				testCase.cfg,
+			).Get(
+				context.Background(),
+				testProjectNamespace,
+				testCredType,
+				testRepoURL,
+			)
+			testCase.assertions(t, creds, found, err)
+		})
+	}
+}
+
+func TestGetWithImagePullSecrets(t *testing.T) {
+	const testNamespace = "fake-namespace"
+
+	testLabels := map[string]string{
+		kargoapi.CredentialTypeLabelKey: credentials.TypeImage.String(),
+	}
+	newPullSecret := func(name string, data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+				Labels:    testLabels,
+			},
+			Type: corev1.SecretTypeDockerConfigJson,
+			Data: data,
+		}
+	}
+	testPullSecret := newPullSecret("pull-secret", map[string][]byte{
+		corev1.DockerConfigJsonKey: []byte(
+			`{"auths":{` +
+				`"fake-registry.example.com":{"auth":"` +
+				base64.StdEncoding.EncodeToString([]byte("pull-username:pull-password")) + `"},` +
+				`"token-registry.example.com":{"registrytoken":"fake-registry-token"}` +
+				`}}`,
+		),
+	})
+
+	testCases := []struct {
+		name       string
+		secrets    []client.Object
+		repoURL    string
+		assertions func(*testing.T, credentials.Credentials, bool, error)
+	}{
+		{
+			name:    "basic auth from pull secret",
+			secrets: []client.Object{testPullSecret},
+			repoURL: "fake-registry.example.com/fake-image",
+			assertions: func(t *testing.T, creds credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(
+					t,
+					credentials.Credentials{Username: "pull-username", Password: "pull-password"},
+					creds,
+				)
+			},
+		},
+		{
+			name:    "token auth from pull secret",
+			secrets: []client.Object{testPullSecret},
+			repoURL: "token-registry.example.com/fake-image",
+			assertions: func(t *testing.T, creds credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(
+					t,
+					credentials.Credentials{RegistryToken: "fake-registry-token"},
+					creds,
+				)
+			},
+		},
+		{
+			name:    "pull secret without auth for registry",
+			secrets: []client.Object{testPullSecret},
+			repoURL: "other.example.com/fake-image",
+			assertions: func(t *testing.T, _ credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.False(t, found)
+			},
+		},
+		{
+			name: "pull secret with repo URL",
+			secrets: []client.Object{
+				newPullSecret("pull-secret", map[string][]byte{
+					credentials.FieldRepoURL: []byte("fake-registry.example.com/fake-image"),
+					corev1.DockerConfigJsonKey: []byte(
+						`{"auths":{"fake-registry.example.com":{"username":"pull-username","password":"pull-password"}}}`,
+					),
+				}),
+			},
+			repoURL: "fake-registry.example.com/fake-image",
+			assertions: func(t *testing.T, creds credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, "pull-username", creds.Username)
+			},
+		},
+		{
+			name: "basic credentials with repo URL take precedence over pull secret",
+			secrets: []client.Object{
+				testPullSecret,
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "repo-credentials",
+						Namespace: testNamespace,
+						Labels:    testLabels,
+					},
+					Data: map[string][]byte{
+						credentials.FieldRepoURL:  []byte("fake-registry.example.com/fake-image"),
+						credentials.FieldUsername: []byte("repo-username"),
+						credentials.FieldPassword: []byte("repo-password"),
+					},
+				},
+			},
+			repoURL: "fake-registry.example.com/fake-image",
+			assertions: func(t *testing.T, creds credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, "repo-username", creds.Username)
+			},
+		},
+		{
+			name: "invalid pull secret is ignored",
+			secrets: []client.Object{
+				newPullSecret("pull-secret", map[string][]byte{
+					corev1.DockerConfigJsonKey: []byte("{"),
+				}),
+			},
+			repoURL: "fake-registry.example.com/fake-image",
+			assertions: func(t *testing.T, _ credentials.Credentials, found bool, err error) {
+				require.NoError(t, err)
+				require.False(t, found)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			creds, found, err := NewDatabase(
+				context.Background(),
+				fake.NewClientBuilder().WithObjects(testCase.secrets...).Build(),
+				DatabaseConfig{},
+			).Get(
+				context.Background(),
+				testNamespace,
+				credentials.TypeImage,
+				testCase.repoURL,
+			)
+			testCase.assertions(t, creds, found, err)
+		})
+	}
+}
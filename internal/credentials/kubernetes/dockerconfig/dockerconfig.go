@@ -0,0 +1,151 @@
+package dockerconfig
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/akuity/kargo/internal/credentials"
+)
+
+// Config represents the contents of the .dockerconfigjson key of a Secret of
+// type kubernetes.io/dockerconfigjson.
+type Config struct {
+	Auths map[string]Entry `json:"auths"`
+}
+
+// Entry represents the credentials for a single registry in a Config.
+type Entry struct {
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	Auth          string `json:"auth,omitempty"`
+	IdentityToken string `json:"identitytoken,omitempty"`
+	RegistryToken string `json:"registrytoken,omitempty"`
+}
+
+// FromSecret returns the parsed contents of the provided Secret, which must be
+// of type kubernetes.io/dockerconfigjson.
+func FromSecret(secret *corev1.Secret) (*Config, error) {
+	if secret.Type != corev1.SecretTypeDockerConfigJson {
+		return nil, fmt.Errorf("Secret is not of type %q", corev1.SecretTypeDockerConfigJson)
+	}
+	cfg := &Config{}
+	if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], cfg); err != nil {
+		return nil, fmt.Errorf("error unmarshaling %s: %w", corev1.DockerConfigJsonKey, err)
+	}
+	return cfg, nil
+}
+
+// HasRegistryFor returns true if the Config contains an entry for the registry
+// hosting the specified image repository, regardless of whether that entry
+// holds any usable credentials.
+func (c *Config) HasRegistryFor(repoURL string) bool {
+	registry, err := registryFor(repoURL)
+	if err != nil {
+		return false
+	}
+	_, _, found := c.entryFor(registry)
+	return found
+}
+
+// CredentialsFor returns the credentials from the Config that apply to the
+// registry hosting the specified image repository. Entries may hold a username
+// and password, either directly or base64-encoded together in their auth
+// field, or an identity or registry token. Nil is returned if there are none.
+func (c *Config) CredentialsFor(repoURL string) (*credentials.Credentials, error) {
+	registry, err := registryFor(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	key, entry, found := c.entryFor(registry)
+	if !found {
+		return nil, nil
+	}
+	creds := &credentials.Credentials{
+		Username:      entry.Username,
+		Password:      entry.Password,
+		IdentityToken: entry.IdentityToken,
+		RegistryToken: entry.RegistryToken,
+	}
+	if creds.Username == "" && creds.Password == "" && entry.Auth != "" {
+		decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding credentials for registry %q: %w", key, err)
+		}
+		var ok bool
+		if creds.Username, creds.Password, ok = strings.Cut(string(decoded), ":"); !ok {
+			return nil, fmt.Errorf("credentials for registry %q are malformed", key)
+		}
+	}
+	if creds.Username == "" && creds.Password == "" &&
+		creds.IdentityToken == "" && creds.RegistryToken == "" {
+		return nil, nil
+	}
+	return creds, nil
+}
+
+// entryFor returns the key and Entry for the specified normalized registry, if
+// the Config contains one.
+func (c *Config) entryFor(registry string) (string, Entry, bool) {
+	for key, entry := range c.Auths {
+		if normalizeRegistry(key) == registry {
+			return key, entry, true
+		}
+	}
+	return "", Entry{}, false
+}
+
+// SecretToCreds is an implementation of credentials.Helper that extracts image
+// registry credentials from a Secret of type kubernetes.io/dockerconfigjson,
+// such as those commonly used as image pull Secrets.
+func SecretToCreds(
+	_ context.Context,
+	_ string,
+	credType credentials.Type,
+	repoURL string,
+	secret *corev1.Secret,
+) (*credentials.Credentials, error) {
+	if credType != credentials.TypeImage || secret == nil ||
+		secret.Type != corev1.SecretTypeDockerConfigJson {
+		// This helper can't handle this
+		return nil, nil
+	}
+	cfg, err := FromSecret(secret)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error parsing Secret %q in namespace %q: %w",
+			secret.Name,
+			secret.Namespace,
+			err,
+		)
+	}
+	return cfg.CredentialsFor(repoURL)
+}
+
+// registryFor returns the normalized address of the registry hosting the
+// specified image repository.
+func registryFor(repoURL string) (string, error) {
+	repo, err := name.NewRepository(repoURL)
+	if err != nil {
+		return "", fmt.Errorf("error parsing image repo URL %q: %w", repoURL, err)
+	}
+	return normalizeRegistry(repo.RegistryStr()), nil
+}
+
+// normalizeRegistry strips any scheme and path from the provided registry
+// address and maps the various aliases of Docker Hub to a single name.
+func normalizeRegistry(registry string) string {
+	registry = strings.TrimPrefix(registry, "https://")
+	registry = strings.TrimPrefix(registry, "http://")
+	registry, _, _ = strings.Cut(registry, "/")
+	switch registry {
+	case "docker.io", "registry-1.docker.io":
+		return name.DefaultRegistry
+	}
+	return registry
+}
@@ -0,0 +1,323 @@
+package dockerconfig
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/akuity/kargo/internal/credentials"
+)
+
+func TestConfigCredentialsFor(t *testing.T) {
+	testAuth := base64.StdEncoding.EncodeToString([]byte("fake-username:fake-password"))
+
+	testCases := []struct {
+		name       string
+		cfg        Config
+		repoURL    string
+		assertions func(*testing.T, *credentials.Credentials, error)
+	}{
+		{
+			name: "no matching registry",
+			cfg: Config{
+				Auths: map[string]Entry{
+					"other.example.com": {Username: "fake-username"},
+				},
+			},
+			repoURL: "fake-registry.example.com/fake-image",
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Nil(t, creds)
+			},
+		},
+		{
+			name: "matching registry without auth",
+			cfg: Config{
+				Auths: map[string]Entry{
+					"fake-registry.example.com": {},
+				},
+			},
+			repoURL: "fake-registry.example.com/fake-image",
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Nil(t, creds)
+			},
+		},
+		{
+			name: "username and password",
+			cfg: Config{
+				Auths: map[string]Entry{
+					"https://fake-registry.example.com": {
+						Username: "fake-username",
+						Password: "fake-password",
+					},
+				},
+			},
+			repoURL: "fake-registry.example.com/fake-image",
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&credentials.Credentials{Username: "fake-username", Password: "fake-password"},
+					creds,
+				)
+			},
+		},
+		{
+			name: "Docker Hub alias",
+			cfg: Config{
+				Auths: map[string]Entry{
+					"https://index.docker.io/v1/": {Auth: testAuth},
+				},
+			},
+			repoURL: "nginx",
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&credentials.Credentials{Username: "fake-username", Password: "fake-password"},
+					creds,
+				)
+			},
+		},
+		{
+			name: "identity token",
+			cfg: Config{
+				Auths: map[string]Entry{
+					"fake-registry.example.com": {
+						Auth:          base64.StdEncoding.EncodeToString([]byte("<token>:")),
+						IdentityToken: "fake-identity-token",
+					},
+				},
+			},
+			repoURL: "fake-registry.example.com/fake-image",
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&credentials.Credentials{
+						Username:      "<token>",
+						IdentityToken: "fake-identity-token",
+					},
+					creds,
+				)
+			},
+		},
+		{
+			name: "registry token",
+			cfg: Config{
+				Auths: map[string]Entry{
+					"fake-registry.example.com": {RegistryToken: "fake-registry-token"},
+				},
+			},
+			repoURL: "fake-registry.example.com/fake-image",
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&credentials.Credentials{RegistryToken: "fake-registry-token"},
+					creds,
+				)
+			},
+		},
+		{
+			name: "multiple registries",
+			cfg: Config{
+				Auths: map[string]Entry{
+					"other.example.com": {RegistryToken: "other-registry-token"},
+					"fake-registry.example.com": {
+						Username: "fake-username",
+						Password: "fake-password",
+					},
+					"https://index.docker.io/v1/": {Auth: testAuth},
+				},
+			},
+			repoURL: "other.example.com/fake-image",
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&credentials.Credentials{RegistryToken: "other-registry-token"},
+					creds,
+				)
+			},
+		},
+		{
+			name: "malformed auth",
+			cfg: Config{
+				Auths: map[string]Entry{
+					"fake-registry.example.com": {
+						Auth: base64.StdEncoding.EncodeToString([]byte("fake-username")),
+					},
+				},
+			},
+			repoURL: "fake-registry.example.com/fake-image",
+			assertions: func(t *testing.T, _ *credentials.Credentials, err error) {
+				require.ErrorContains(t, err, "are malformed")
+			},
+		},
+		{
+			name:    "invalid repo URL",
+			cfg:     Config{},
+			repoURL: "Invalid Repo",
+			assertions: func(t *testing.T, _ *credentials.Credentials, err error) {
+				require.ErrorContains(t, err, "error parsing image repo URL")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			creds, err := testCase.cfg.CredentialsFor(testCase.repoURL)
+			testCase.assertions(t, creds, err)
+		})
+	}
+}
+
+func TestConfigHasRegistryFor(t *testing.T) {
+	cfg := Config{
+		Auths: map[string]Entry{
+			"fake-registry.example.com": {},
+			"https://index.docker.io/v1/": {
+				Auth: base64.StdEncoding.EncodeToString([]byte("fake-username:fake-password")),
+			},
+		},
+	}
+	require.True(t, cfg.HasRegistryFor("fake-registry.example.com/fake-image"))
+	require.True(t, cfg.HasRegistryFor("docker.io/library/nginx"))
+	require.False(t, cfg.HasRegistryFor("other.example.com/fake-image"))
+	require.False(t, cfg.HasRegistryFor("Invalid Repo"))
+}
+
+func TestSecretToCreds(t *testing.T) {
+	testPullSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-pull-secret",
+		},
+		Type: corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(
+				`{"auths":{"fake-registry.example.com":{"auth":"` +
+					base64.StdEncoding.EncodeToString([]byte("fake-username:fake-password")) +
+					`"},"other.example.com":{"identitytoken":"fake-identity-token"}}}`,
+			),
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		credType   credentials.Type
+		repoURL    string
+		secret     *corev1.Secret
+		assertions func(*testing.T, *credentials.Credentials, error)
+	}{
+		{
+			name:     "cred type is not image",
+			credType: credentials.TypeGit,
+			repoURL:  "fake-registry.example.com/fake-image",
+			secret:   testPullSecret,
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Nil(t, creds)
+			},
+		},
+		{
+			name:     "no Secret",
+			credType: credentials.TypeImage,
+			repoURL:  "fake-registry.example.com/fake-image",
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Nil(t, creds)
+			},
+		},
+		{
+			name:     "Secret is not a pull secret",
+			credType: credentials.TypeImage,
+			repoURL:  "fake-registry.example.com/fake-image",
+			secret: &corev1.Secret{
+				Data: map[string][]byte{
+					credentials.FieldUsername: []byte("fake-username"),
+					credentials.FieldPassword: []byte("fake-password"),
+				},
+			},
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Nil(t, creds)
+			},
+		},
+		{
+			name:     "invalid pull secret",
+			credType: credentials.TypeImage,
+			repoURL:  "fake-registry.example.com/fake-image",
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-pull-secret",
+				},
+				Type: corev1.SecretTypeDockerConfigJson,
+				Data: map[string][]byte{
+					corev1.DockerConfigJsonKey: []byte("{"),
+				},
+			},
+			assertions: func(t *testing.T, _ *credentials.Credentials, err error) {
+				require.ErrorContains(
+					t, err, `error parsing Secret "fake-pull-secret" in namespace "fake-namespace"`,
+				)
+			},
+		},
+		{
+			name:     "no auth for registry",
+			credType: credentials.TypeImage,
+			repoURL:  "missing.example.com/fake-image",
+			secret:   testPullSecret,
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Nil(t, creds)
+			},
+		},
+		{
+			name:     "basic auth",
+			credType: credentials.TypeImage,
+			repoURL:  "fake-registry.example.com/fake-image",
+			secret:   testPullSecret,
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&credentials.Credentials{Username: "fake-username", Password: "fake-password"},
+					creds,
+				)
+			},
+		},
+		{
+			name:     "token auth",
+			credType: credentials.TypeImage,
+			repoURL:  "other.example.com/fake-image",
+			secret:   testPullSecret,
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&credentials.Credentials{IdentityToken: "fake-identity-token"},
+					creds,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			creds, err := SecretToCreds(
+				context.Background(),
+				"fake-namespace",
+				testCase.credType,
+				testCase.repoURL,
+				testCase.secret,
+			)
+			testCase.assertions(t, creds, err)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/patrickmn/go-cache"
 	corev1 "k8s.io/api/core/v1"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
 )
 
@@ -88,7 +89,12 @@ func (a *accessKeyCredentialHelper) getCredentials(
 		)
 	}
 
-	cacheKey := a.tokenCacheKey(region, accessKeyID, secretAccessKey)
+	cacheKey := a.tokenCacheKey(
+		region,
+		accessKeyID,
+		secretAccessKey,
+		secret.Annotations[kargoapi.AnnotationKeyCredentialVersion],
+	)
 
 	if entry, exists := a.tokenCache.Get(cacheKey); exists {
 		return decodeAuthToken(entry.(string)) // nolint: forcetypeassert
@@ -110,13 +116,20 @@ func (a *accessKeyCredentialHelper) getCredentials(
 }
 
 // tokenCacheKey returns a cache key for an ECR authorization token. The key is
-// a hash of the region, access key ID, and secret access key. Using a hash
-// ensures that the secret access key is not stored in plaintext in the cache.
-func (a *accessKeyCredentialHelper) tokenCacheKey(region, accessKeyID, secretAccessKey string) string {
+// a hash of the region, access key ID, secret access key, and credential
+// version. Using a hash ensures that the secret access key is not stored in
+// plaintext in the cache. Including the credential version ensures that a new
+// token is obtained when the credentials are marked as rotated.
+func (a *accessKeyCredentialHelper) tokenCacheKey(
+	region string,
+	accessKeyID string,
+	secretAccessKey string,
+	version string,
+) string {
 	return fmt.Sprintf(
 		"%x",
 		sha256.Sum256([]byte(
-			fmt.Sprintf("%s:%s:%s", region, accessKeyID, secretAccessKey),
+			fmt.Sprintf("%s:%s:%s:%s", region, accessKeyID, secretAccessKey, version),
 		)),
 	)
 }
@@ -9,7 +9,9 @@ import (
 	"github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
 )
 
@@ -28,7 +30,7 @@ func TestAccessKeyCredentialHelper(t *testing.T) {
 
 	warmTokenCache := cache.New(0, 0)
 	warmTokenCache.Set(
-		(&accessKeyCredentialHelper{}).tokenCacheKey(testRegion, testAccessKeyID, testSecretAccessKey),
+		(&accessKeyCredentialHelper{}).tokenCacheKey(testRegion, testAccessKeyID, testSecretAccessKey, ""),
 		testEncodedToken,
 		cache.DefaultExpiration,
 	)
@@ -159,6 +161,38 @@ func TestAccessKeyCredentialHelper(t *testing.T) {
 				require.Equal(t, testPassword, creds.Password)
 			},
 		},
+		{
+			name:     "cache hit; credential version rotated",
+			credType: credentials.TypeImage,
+			repoURL:  testRepoURL,
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyCredentialVersion: "2",
+					},
+				},
+				Data: map[string][]byte{
+					regionKey: []byte(testRegion),
+					idKey:     []byte(testAccessKeyID),
+					secretKey: []byte(testSecretAccessKey),
+				},
+			},
+			helper: &accessKeyCredentialHelper{
+				tokenCache: warmTokenCache,
+				getAuthTokenFn: func(context.Context, string, string, string) (string, error) {
+					return base64.StdEncoding.EncodeToString([]byte("fake-username:fake-rotated-password")), nil
+				},
+			},
+			assertions: func(t *testing.T, creds *credentials.Credentials, c *cache.Cache, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, creds)
+				require.Equal(t, "fake-rotated-password", creds.Password)
+				_, found := c.Get(
+					(&accessKeyCredentialHelper{}).tokenCacheKey(testRegion, testAccessKeyID, testSecretAccessKey, "2"),
+				)
+				require.True(t, found)
+			},
+		},
 		{
 			name:     "cache miss; error getting auth token",
 			credType: credentials.TypeImage,
@@ -204,7 +238,7 @@ func TestAccessKeyCredentialHelper(t *testing.T) {
 				require.Equal(t, testUsername, creds.Username)
 				require.Equal(t, testPassword, creds.Password)
 				_, found := c.Get(
-					(&accessKeyCredentialHelper{}).tokenCacheKey(testRegion, testAccessKeyID, testSecretAccessKey),
+					(&accessKeyCredentialHelper{}).tokenCacheKey(testRegion, testAccessKeyID, testSecretAccessKey, ""),
 				)
 				require.True(t, found)
 			},
@@ -232,7 +266,7 @@ func TestAccessKeyCredentialHelper(t *testing.T) {
 				require.Equal(t, testUsername, creds.Username)
 				require.Equal(t, testPassword, creds.Password)
 				_, found := c.Get(
-					(&accessKeyCredentialHelper{}).tokenCacheKey(testRegion, testAccessKeyID, testSecretAccessKey),
+					(&accessKeyCredentialHelper{}).tokenCacheKey(testRegion, testAccessKeyID, testSecretAccessKey, ""),
 				)
 				require.True(t, found)
 			},
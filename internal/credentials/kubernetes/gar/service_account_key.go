@@ -11,6 +11,7 @@ import (
 	"golang.org/x/oauth2/google"
 	corev1 "k8s.io/api/core/v1"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
 )
 
@@ -61,7 +62,10 @@ func (s *serviceAccountKeyCredentialHelper) getCredentials(
 		return nil, nil
 	}
 
-	cacheKey := s.tokenCacheKey(encodedServiceAccountKey)
+	cacheKey := s.tokenCacheKey(
+		encodedServiceAccountKey,
+		secret.Annotations[kargoapi.AnnotationKeyCredentialVersion],
+	)
 
 	if entry, exists := s.tokenCache.Get(cacheKey); exists {
 		return &credentials.Credentials{
@@ -89,12 +93,17 @@ func (s *serviceAccountKeyCredentialHelper) getCredentials(
 }
 
 // tokenCacheKey returns a cache key for a GCP access token. The key is a hash
-// of the provided (encoded) service account key. Using a hash ensures that a
-// decodable service account key is not stored in the cache.
-func (s *serviceAccountKeyCredentialHelper) tokenCacheKey(encodedServiceAccountKey string) string {
+// of the provided (encoded) service account key and credential version. Using
+// a hash ensures that a decodable service account key is not stored in the
+// cache. Including the credential version ensures that a new token is obtained
+// when the credentials are marked as rotated.
+func (s *serviceAccountKeyCredentialHelper) tokenCacheKey(
+	encodedServiceAccountKey string,
+	version string,
+) string {
 	return fmt.Sprintf(
 		"%x",
-		sha256.Sum256([]byte(encodedServiceAccountKey)),
+		sha256.Sum256([]byte(fmt.Sprintf("%s:%s", encodedServiceAccountKey, version))),
 	)
 }
 
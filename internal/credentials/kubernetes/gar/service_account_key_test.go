@@ -9,7 +9,9 @@ import (
 	"github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
 )
 
@@ -25,7 +27,7 @@ func TestServiceAccountKeyCredentialHelper(t *testing.T) {
 
 	warmTokenCache := cache.New(0, 0)
 	warmTokenCache.Set(
-		(&serviceAccountKeyCredentialHelper{}).tokenCacheKey(testEncodedServiceAccountKey),
+		(&serviceAccountKeyCredentialHelper{}).tokenCacheKey(testEncodedServiceAccountKey, ""),
 		testAccessToken,
 		cache.DefaultExpiration,
 	)
@@ -98,6 +100,36 @@ func TestServiceAccountKeyCredentialHelper(t *testing.T) {
 				require.Equal(t, testAccessToken, creds.Password)
 			},
 		},
+		{
+			name:     "cache hit; credential version rotated",
+			credType: credentials.TypeImage,
+			repoURL:  testRepoURL,
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyCredentialVersion: "2",
+					},
+				},
+				Data: map[string][]byte{
+					serviceAccountKeyKey: []byte(testEncodedServiceAccountKey),
+				},
+			},
+			helper: &serviceAccountKeyCredentialHelper{
+				tokenCache: warmTokenCache,
+				getAccessTokenFn: func(context.Context, string) (string, error) {
+					return "fake-rotated-access-token", nil
+				},
+			},
+			assertions: func(t *testing.T, creds *credentials.Credentials, c *cache.Cache, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, creds)
+				require.Equal(t, "fake-rotated-access-token", creds.Password)
+				_, found := c.Get(
+					(&serviceAccountKeyCredentialHelper{}).tokenCacheKey(testEncodedServiceAccountKey, "2"),
+				)
+				require.True(t, found)
+			},
+		},
 		{
 			name:     "cache miss; error getting access token",
 			credType: credentials.TypeImage,
@@ -139,7 +171,7 @@ func TestServiceAccountKeyCredentialHelper(t *testing.T) {
 				require.Equal(t, accessTokenUsername, creds.Username)
 				require.Equal(t, testAccessToken, creds.Password)
 				_, found := c.Get(
-					(&serviceAccountKeyCredentialHelper{}).tokenCacheKey(testEncodedServiceAccountKey),
+					(&serviceAccountKeyCredentialHelper{}).tokenCacheKey(testEncodedServiceAccountKey, ""),
 				)
 				require.True(t, found)
 			},
@@ -5,13 +5,16 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/jferrl/go-githubauth"
 	"github.com/patrickmn/go-cache"
+	"golang.org/x/oauth2"
 	corev1 "k8s.io/api/core/v1"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
 )
 
@@ -21,18 +24,27 @@ const (
 	privateKeyKey     = "githubAppPrivateKey"
 
 	accessTokenUsername = "kargo"
+
+	// accessTokenExpiryMargin is how long before its expiry an installation
+	// access token is evicted from the cache. This ensures a cached token is
+	// never handed out when it is about to expire.
+	accessTokenExpiryMargin = 5 * time.Minute
 )
 
 type appCredentialHelper struct {
 	tokenCache *cache.Cache
 
+	// transport is the http.RoundTripper used for requests to the GitHub API.
+	// If nil, http.DefaultTransport is used.
+	transport http.RoundTripper
+
 	// The following behaviors are overridable for testing purposes:
 
 	getAccessTokenFn func(
 		appID int64,
 		installationID int64,
 		encodedKey string,
-	) (string, error)
+	) (*oauth2.Token, error)
 }
 
 // NewAppCredentialHelper returns an implementation of credentials.Helper that
@@ -40,9 +52,9 @@ type appCredentialHelper struct {
 func NewAppCredentialHelper() credentials.Helper {
 	a := &appCredentialHelper{
 		tokenCache: cache.New(
-			// Access tokens live for one hour. We'll hang on to them for 40 minutes.
-			40*time.Minute, // Default ttl for each entry
-			time.Hour,      // Cleanup interval
+			// Each entry's ttl is derived from the expiry of the access token.
+			cache.NoExpiration, // Default ttl for each entry
+			time.Hour,          // Cleanup interval
 		),
 	}
 	a.getAccessTokenFn = a.getAccessToken
@@ -84,15 +96,21 @@ func (a *appCredentialHelper) getCredentials(
 	if err != nil {
 		return nil, fmt.Errorf("error parsing installation ID: %w", err)
 	}
-	return a.getUsernameAndPassword(appID, installationID, encodedPrivateKey)
+	return a.getUsernameAndPassword(
+		appID,
+		installationID,
+		encodedPrivateKey,
+		secret.Annotations[kargoapi.AnnotationKeyCredentialVersion],
+	)
 }
 
 func (a *appCredentialHelper) getUsernameAndPassword(
 	appID int64,
 	installationID int64,
 	encodedPrivateKey string,
+	version string,
 ) (*credentials.Credentials, error) {
-	cacheKey := a.tokenCacheKey(appID, installationID, encodedPrivateKey)
+	cacheKey := a.tokenCacheKey(appID, installationID, encodedPrivateKey, version)
 
 	if entry, exists := a.tokenCache.Get(cacheKey); exists {
 		return &credentials.Credentials{
@@ -106,48 +124,61 @@ func (a *appCredentialHelper) getUsernameAndPassword(
 		return nil, fmt.Errorf("error getting installation access token: %w", err)
 	}
 
-	// Cache the access token
-	a.tokenCache.Set(cacheKey, accessToken, cache.DefaultExpiration)
+	// Cache the access token until shortly before it expires. Tokens that are
+	// already about to expire are not cached at all.
+	if ttl := time.Until(accessToken.Expiry) - accessTokenExpiryMargin; ttl > 0 {
+		a.tokenCache.Set(cacheKey, accessToken.AccessToken, ttl)
+	}
 
 	return &credentials.Credentials{
 		Username: accessTokenUsername,
-		Password: accessToken,
+		Password: accessToken.AccessToken,
 	}, nil
 }
 
 // tokenCacheKey returns a cache key for an installation access token. The key is
-// a hash of the app ID, installation ID, and encoded private key. Using a
-// hash ensures that a decodable key is not stored in the cache.
+// a hash of the app ID, installation ID, encoded private key, and credential
+// version. Using a hash ensures that a decodable key is not stored in the
+// cache. Including the credential version ensures that a new token is obtained
+// when the credentials are marked as rotated.
 func (a *appCredentialHelper) tokenCacheKey(
 	appID int64,
 	installationID int64,
 	encodedPrivateKey string,
+	version string,
 ) string {
 	return fmt.Sprintf(
 		"%x",
 		sha256.Sum256([]byte(
-			fmt.Sprintf("%d:%d:%s", appID, installationID, encodedPrivateKey),
+			fmt.Sprintf("%d:%d:%s:%s", appID, installationID, encodedPrivateKey, version),
 		)),
 	)
 }
 
+// getAccessToken exchanges a JWT, signed using the provided base64-encoded PEM
+// private key of a GitHub App, for an access token for the specified
+// installation of that App.
 func (a *appCredentialHelper) getAccessToken(
 	appID int64,
 	installationID int64,
 	encodedPrivateKey string,
-) (string, error) {
+) (*oauth2.Token, error) {
 	decodedKey, err := base64.StdEncoding.DecodeString(encodedPrivateKey)
 	if err != nil {
-		return "", fmt.Errorf("error decoding private key: %w", err)
+		return nil, fmt.Errorf("error decoding private key: %w", err)
 	}
 	appTokenSource, err := githubauth.NewApplicationTokenSource(appID, decodedKey)
 	if err != nil {
-		return "", fmt.Errorf("error creating application token source: %w", err)
+		return nil, fmt.Errorf("error creating application token source: %w", err)
 	}
-	installationTokenSource := githubauth.NewInstallationTokenSource(installationID, appTokenSource)
+	installationTokenSource := githubauth.NewInstallationTokenSource(
+		installationID,
+		appTokenSource,
+		githubauth.WithHTTPClient(&http.Client{Transport: a.transport}),
+	)
 	token, err := installationTokenSource.Token()
 	if err != nil {
-		return "", fmt.Errorf("error getting installation access token: %w", err)
+		return nil, fmt.Errorf("error getting installation access token: %w", err)
 	}
-	return token.AccessToken, nil
+	return token, nil
 }
@@ -2,14 +2,28 @@ package github
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
 )
 
@@ -27,7 +41,7 @@ func TestAppCredentialHelper(t *testing.T) {
 
 	warmTokenCache := cache.New(0, 0)
 	warmTokenCache.Set(
-		(&appCredentialHelper{}).tokenCacheKey(testAppID, testInstallationID, testPrivateKey),
+		(&appCredentialHelper{}).tokenCacheKey(testAppID, testInstallationID, testPrivateKey, ""),
 		testAccessToken,
 		cache.DefaultExpiration,
 	)
@@ -129,6 +143,40 @@ func TestAppCredentialHelper(t *testing.T) {
 				require.Equal(t, testAccessToken, creds.Password)
 			},
 		},
+		{
+			name:     "cache hit; credential version rotated",
+			credType: credentials.TypeGit,
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyCredentialVersion: "2",
+					},
+				},
+				Data: map[string][]byte{
+					appIDKey:          []byte(testAppIDStr),
+					installationIDKey: []byte(testInstallationIDStr),
+					privateKeyKey:     []byte(testPrivateKey),
+				},
+			},
+			helper: &appCredentialHelper{
+				tokenCache: warmTokenCache,
+				getAccessTokenFn: func(int64, int64, string) (*oauth2.Token, error) {
+					return &oauth2.Token{
+						AccessToken: "fake-rotated-access-token",
+						Expiry:      time.Now().Add(time.Hour),
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, creds *credentials.Credentials, c *cache.Cache, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, creds)
+				require.Equal(t, "fake-rotated-access-token", creds.Password)
+				_, found := c.Get(
+					(&appCredentialHelper{}).tokenCacheKey(testAppID, testInstallationID, testPrivateKey, "2"),
+				)
+				require.True(t, found)
+			},
+		},
 		{
 			name:     "cache miss; error getting access token",
 			credType: credentials.TypeGit,
@@ -141,8 +189,8 @@ func TestAppCredentialHelper(t *testing.T) {
 			},
 			helper: &appCredentialHelper{
 				tokenCache: cache.New(0, 0),
-				getAccessTokenFn: func(int64, int64, string) (string, error) {
-					return "", fmt.Errorf("something went wrong")
+				getAccessTokenFn: func(int64, int64, string) (*oauth2.Token, error) {
+					return nil, fmt.Errorf("something went wrong")
 				},
 			},
 			assertions: func(t *testing.T, _ *credentials.Credentials, _ *cache.Cache, err error) {
@@ -162,15 +210,50 @@ func TestAppCredentialHelper(t *testing.T) {
 			},
 			helper: &appCredentialHelper{
 				tokenCache: cache.New(0, 0),
-				getAccessTokenFn: func(int64, int64, string) (string, error) {
-					return testAccessToken, nil
+				getAccessTokenFn: func(int64, int64, string) (*oauth2.Token, error) {
+					return &oauth2.Token{
+						AccessToken: testAccessToken,
+						Expiry:      time.Now().Add(time.Hour),
+					}, nil
 				},
 			},
-			assertions: func(t *testing.T, creds *credentials.Credentials, _ *cache.Cache, err error) {
+			assertions: func(t *testing.T, creds *credentials.Credentials, c *cache.Cache, err error) {
 				require.NoError(t, err)
 				require.NotNil(t, creds)
 				require.Equal(t, "kargo", creds.Username)
 				require.Equal(t, testAccessToken, creds.Password)
+				_, expiry, found := c.GetWithExpiration(
+					(&appCredentialHelper{}).tokenCacheKey(testAppID, testInstallationID, testPrivateKey, ""),
+				)
+				require.True(t, found)
+				// The token should be cached until five minutes before it expires
+				require.WithinDuration(t, time.Now().Add(55*time.Minute), expiry, time.Minute)
+			},
+		},
+		{
+			name:     "cache miss; access token about to expire",
+			credType: credentials.TypeGit,
+			secret: &corev1.Secret{
+				Data: map[string][]byte{
+					appIDKey:          []byte(testAppIDStr),
+					installationIDKey: []byte(testInstallationIDStr),
+					privateKeyKey:     []byte(testPrivateKey),
+				},
+			},
+			helper: &appCredentialHelper{
+				tokenCache: cache.New(0, 0),
+				getAccessTokenFn: func(int64, int64, string) (*oauth2.Token, error) {
+					return &oauth2.Token{
+						AccessToken: testAccessToken,
+						Expiry:      time.Now().Add(3 * time.Minute),
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, creds *credentials.Credentials, c *cache.Cache, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, creds)
+				require.Equal(t, testAccessToken, creds.Password)
+				require.Zero(t, c.ItemCount())
 			},
 		},
 	}
@@ -187,3 +270,121 @@ func TestAppCredentialHelper(t *testing.T) {
 		})
 	}
 }
+
+func TestAppCredentialHelperGetAccessToken(t *testing.T) {
+	const (
+		testAppID          int64 = 12345
+		testInstallationID int64 = 67890
+	)
+	testExpiry := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	encodedPrivateKey := base64.StdEncoding.EncodeToString(
+		pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+		}),
+	)
+
+	// This server mocks the GitHub API's installation access token endpoint
+	testServer := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost ||
+				r.URL.Path != fmt.Sprintf("/app/installations/%d/access_tokens", testInstallationID) {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			// The request must be authenticated using a JWT signed by the App
+			claims := &jwt.RegisteredClaims{}
+			if _, err := jwt.ParseWithClaims(
+				strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "),
+				claims,
+				func(*jwt.Token) (any, error) {
+					return &privateKey.PublicKey, nil
+				},
+			); err != nil || claims.Issuer != strconv.FormatInt(testAppID, 10) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprintf(
+				w,
+				`{"token":"fake-access-token","expires_at":%q}`,
+				testExpiry.Format(time.RFC3339),
+			)
+		}),
+	)
+	t.Cleanup(testServer.Close)
+	testServerURL, err := url.Parse(testServer.URL)
+	require.NoError(t, err)
+
+	helper := &appCredentialHelper{
+		// Redirect all requests to the GitHub API to the test server
+		transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req.URL.Scheme = testServerURL.Scheme
+			req.URL.Host = testServerURL.Host
+			return http.DefaultTransport.RoundTrip(req)
+		}),
+	}
+
+	testCases := []struct {
+		name           string
+		installationID int64
+		privateKey     string
+		assertions     func(*testing.T, *oauth2.Token, error)
+	}{
+		{
+			name:           "private key is not base64-encoded",
+			installationID: testInstallationID,
+			privateKey:     "not base64-encoded",
+			assertions: func(t *testing.T, _ *oauth2.Token, err error) {
+				require.ErrorContains(t, err, "error decoding private key")
+			},
+		},
+		{
+			name:           "private key is invalid",
+			installationID: testInstallationID,
+			privateKey:     base64.StdEncoding.EncodeToString([]byte("fake-private-key")),
+			assertions: func(t *testing.T, _ *oauth2.Token, err error) {
+				require.ErrorContains(t, err, "error creating application token source")
+			},
+		},
+		{
+			name:           "error exchanging JWT for access token",
+			installationID: 42,
+			privateKey:     encodedPrivateKey,
+			assertions: func(t *testing.T, _ *oauth2.Token, err error) {
+				require.ErrorContains(t, err, "error getting installation access token")
+				require.ErrorContains(t, err, "404")
+			},
+		},
+		{
+			name:           "success",
+			installationID: testInstallationID,
+			privateKey:     encodedPrivateKey,
+			assertions: func(t *testing.T, token *oauth2.Token, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-access-token", token.AccessToken)
+				require.True(t, testExpiry.Equal(token.Expiry))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			token, err := helper.getAccessToken(
+				testAppID,
+				testCase.installationID,
+				testCase.privateKey,
+			)
+			testCase.assertions(t, token, err)
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
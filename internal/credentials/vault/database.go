@@ -0,0 +1,366 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/kelseyhightower/envconfig"
+
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/helm"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	// AuthMethodToken authenticates to Vault using a static token.
+	AuthMethodToken = "token"
+	// AuthMethodKubernetes authenticates to Vault using Vault's Kubernetes auth
+	// method and the token of the controller's ServiceAccount.
+	AuthMethodKubernetes = "kubernetes"
+)
+
+// database is an implementation of the credentials.Database interface that
+// retrieves credentials stored in HashiCorp Vault.
+type database struct {
+	client       *api.Client
+	pathTemplate *template.Template
+	cfg          DatabaseConfig
+	// login obtains a new token from Vault. It is nil if the configured auth
+	// method does not support logging in again once a token has expired.
+	login func(context.Context) (*api.Secret, error)
+}
+
+// DatabaseConfig represents configuration for a Vault based implementation of
+// the credentials.Database interface.
+type DatabaseConfig struct {
+	// Enabled specifies whether credentials should be retrieved from Vault
+	// instead of from Kubernetes Secrets.
+	Enabled bool `envconfig:"VAULT_CREDENTIALS_ENABLED" default:"false"`
+	// Address is the address of the Vault server. When empty, the address is
+	// taken from the standard VAULT_ADDR environment variable.
+	Address string `envconfig:"VAULT_CREDENTIALS_ADDRESS"`
+	// PathTemplate is a Go template for the path of the Vault secret holding
+	// credentials for a repository. The template may reference the Project
+	// namespace, the credential type and the host of the repository URL as
+	// {{.Namespace}}, {{.Type}} and {{.Host}}. Secrets in both version 1 and
+	// version 2 KV secrets engines are supported.
+	PathTemplate string `envconfig:"VAULT_CREDENTIALS_PATH_TEMPLATE" default:"secret/data/kargo/{{.Namespace}}/{{.Host}}"`
+	// AuthMethod is the method used to authenticate to Vault. Supported values
+	// are "token" and "kubernetes".
+	AuthMethod string `envconfig:"VAULT_AUTH_METHOD" default:"token"`
+	// Token is the token used to authenticate to Vault when AuthMethod is
+	// "token". When empty, the token is taken from the standard VAULT_TOKEN
+	// environment variable.
+	Token string `envconfig:"VAULT_CREDENTIALS_TOKEN"`
+	// KubernetesAuthRole is the Vault role to log in as when AuthMethod is
+	// "kubernetes".
+	KubernetesAuthRole string `envconfig:"VAULT_KUBERNETES_AUTH_ROLE"`
+	// KubernetesAuthMountPath is the path at which Vault's Kubernetes auth
+	// method is mounted.
+	KubernetesAuthMountPath string `envconfig:"VAULT_KUBERNETES_AUTH_MOUNT_PATH" default:"kubernetes"`
+	// KubernetesServiceAccountTokenPath is the path to the ServiceAccount token
+	// that is exchanged for a Vault token when AuthMethod is "kubernetes".
+	KubernetesServiceAccountTokenPath string `envconfig:"VAULT_KUBERNETES_SERVICE_ACCOUNT_TOKEN_PATH" default:"/var/run/secrets/kubernetes.io/serviceaccount/token"`
+}
+
+func DatabaseConfigFromEnv() DatabaseConfig {
+	cfg := DatabaseConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// pathTemplateData is the data used to render DatabaseConfig.PathTemplate.
+type pathTemplateData struct {
+	Namespace string
+	Type      string
+	Host      string
+}
+
+// NewDatabase initializes and returns an implementation of the
+// credentials.Database interface that retrieves Credentials stored in HashiCorp
+// Vault. It authenticates to Vault before returning and, until the provided
+// context is canceled, keeps its token renewed, logging in again when the
+// token can no longer be renewed and the auth method permits it.
+func NewDatabase(
+	ctx context.Context,
+	cfg DatabaseConfig,
+) (credentials.Database, error) {
+	pathTemplate, err := template.New("path").Option("missingkey=error").
+		Parse(cfg.PathTemplate)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error parsing Vault path template %q: %w",
+			cfg.PathTemplate,
+			err,
+		)
+	}
+
+	vaultCfg := api.DefaultConfig()
+	if vaultCfg.Error != nil {
+		return nil, fmt.Errorf("error reading Vault configuration: %w", vaultCfg.Error)
+	}
+	if cfg.Address != "" {
+		vaultCfg.Address = cfg.Address
+	}
+	client, err := api.NewClient(vaultCfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing Vault client: %w", err)
+	}
+
+	d := &database{
+		client:       client,
+		pathTemplate: pathTemplate,
+		cfg:          cfg,
+	}
+
+	var authSecret *api.Secret
+	switch cfg.AuthMethod {
+	case AuthMethodToken:
+		if cfg.Token != "" {
+			client.SetToken(cfg.Token)
+		}
+		if client.Token() == "" {
+			return nil, errors.New("no Vault token was provided")
+		}
+		if authSecret, err = d.lookupToken(ctx); err != nil {
+			return nil, err
+		}
+	case AuthMethodKubernetes:
+		if cfg.KubernetesAuthRole == "" {
+			return nil, fmt.Errorf(
+				"a Vault role is required to use the %q auth method",
+				AuthMethodKubernetes,
+			)
+		}
+		d.login = d.kubernetesLogin
+		if authSecret, err = d.login(ctx); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported Vault auth method %q", cfg.AuthMethod)
+	}
+
+	go d.manageTokenLifecycle(ctx, authSecret)
+
+	return d, nil
+}
+
+func (d *database) Get(
+	ctx context.Context,
+	namespace string,
+	credType credentials.Type,
+	repoURL string,
+) (credentials.Credentials, bool, error) {
+	// Credentials are always scoped to a Project namespace. Rendering the path
+	// template without one could resolve to a path shared by all Projects.
+	if namespace == "" {
+		return credentials.Credentials{}, false,
+			errors.New("a namespace is required to look up credentials")
+	}
+
+	// If we are dealing with an insecure HTTP endpoint (of any type),
+	// refuse to return any credentials
+	if strings.HasPrefix(repoURL, "http://") {
+		logging.LoggerFromContext(ctx).Info(
+			"refused to get credentials for insecure HTTP endpoint",
+			"repoURL", repoURL,
+		)
+		return credentials.Credentials{}, false, nil
+	}
+
+	host, err := repoHost(repoURL)
+	if err != nil {
+		return credentials.Credentials{}, false, err
+	}
+
+	path := &bytes.Buffer{}
+	if err = d.pathTemplate.Execute(path, pathTemplateData{
+		Namespace: namespace,
+		Type:      credType.String(),
+		Host:      host,
+	}); err != nil {
+		return credentials.Credentials{}, false, fmt.Errorf(
+			"error rendering Vault path template: %w",
+			err,
+		)
+	}
+
+	secret, err := d.client.Logical().ReadWithContext(ctx, path.String())
+	if err != nil {
+		return credentials.Credentials{}, false, fmt.Errorf(
+			"error reading Vault secret at %q: %w",
+			path.String(),
+			err,
+		)
+	}
+	if secret == nil {
+		return credentials.Credentials{}, false, nil
+	}
+
+	creds, ok := secretToCreds(secret)
+	return creds, ok, nil
+}
+
+// secretToCreds extracts a username, password, and SSH private key from the
+// provided Vault secret. It returns false if the secret holds neither a
+// username and password nor an SSH private key.
+func secretToCreds(secret *api.Secret) (credentials.Credentials, bool) {
+	data := secret.Data
+	// Secrets in the KV version 2 secrets engine nest their data, alongside
+	// some metadata, in a "data" field.
+	if nested, ok := data["data"].(map[string]any); ok {
+		data = nested
+	}
+	creds := credentials.Credentials{
+		Username:                stringField(data, credentials.FieldUsername),
+		Password:                stringField(data, credentials.FieldPassword),
+		SSHPrivateKey:           stringField(data, credentials.FieldSSHPrivateKey),
+		SSHPrivateKeyPassphrase: stringField(data, credentials.FieldSSHPrivateKeyPassphrase),
+		SSHKnownHosts:           stringField(data, credentials.FieldSSHKnownHosts),
+	}
+	if (creds.Username != "" && creds.Password != "") ||
+		creds.SSHPrivateKey != "" {
+		return creds, true
+	}
+	return credentials.Credentials{}, false
+}
+
+func stringField(data map[string]any, key string) string {
+	val, _ := data[key].(string)
+	return val
+}
+
+// repoHost returns the host of the provided repository URL, which may be a
+// Git, Helm chart or image repository URL.
+func repoHost(repoURL string) (string, error) {
+	normalized := git.NormalizeURL(helm.NormalizeChartRepositoryURL(repoURL))
+	if !strings.Contains(normalized, "://") {
+		// Image and OCI chart repository URLs have no scheme
+		normalized = "//" + normalized
+	}
+	u, err := url.Parse(normalized)
+	if err != nil {
+		return "", fmt.Errorf("error parsing repository URL %q: %w", repoURL, err)
+	}
+	if u.Hostname() == "" {
+		return "", fmt.Errorf("could not determine host of repository URL %q", repoURL)
+	}
+	return u.Hostname(), nil
+}
+
+// kubernetesLogin exchanges the controller's ServiceAccount token for a Vault
+// token using Vault's Kubernetes auth method. The client is updated to use the
+// new token and the secret returned by Vault is returned.
+func (d *database) kubernetesLogin(ctx context.Context) (*api.Secret, error) {
+	jwt, err := os.ReadFile(d.cfg.KubernetesServiceAccountTokenPath)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error reading ServiceAccount token from %q: %w",
+			d.cfg.KubernetesServiceAccountTokenPath,
+			err,
+		)
+	}
+	secret, err := d.client.Logical().WriteWithContext(
+		ctx,
+		fmt.Sprintf("auth/%s/login", strings.Trim(d.cfg.KubernetesAuthMountPath, "/")),
+		map[string]any{
+			"role": d.cfg.KubernetesAuthRole,
+			"jwt":  strings.TrimSpace(string(jwt)),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error logging in to Vault: %w", err)
+	}
+	if secret == nil || secret.Auth == nil || secret.Auth.ClientToken == "" {
+		return nil, errors.New("no token was returned by Vault login")
+	}
+	d.client.SetToken(secret.Auth.ClientToken)
+	return secret, nil
+}
+
+// lookupToken looks up the client's current token and returns a secret
+// describing it that is suitable for use with a LifetimeWatcher.
+func (d *database) lookupToken(ctx context.Context) (*api.Secret, error) {
+	secret, err := d.client.Auth().Token().LookupSelfWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up Vault token: %w", err)
+	}
+	renewable, err := secret.TokenIsRenewable()
+	if err != nil {
+		return nil, fmt.Errorf("error determining if Vault token is renewable: %w", err)
+	}
+	ttl, err := secret.TokenTTL()
+	if err != nil {
+		return nil, fmt.Errorf("error determining Vault token TTL: %w", err)
+	}
+	return &api.Secret{
+		Auth: &api.SecretAuth{
+			ClientToken:   d.client.Token(),
+			Renewable:     renewable,
+			LeaseDuration: int(ttl.Seconds()),
+		},
+	}, nil
+}
+
+// manageTokenLifecycle renews the token described by the provided secret for
+// as long as Vault permits. When the token can no longer be renewed, a new one
+// is obtained by logging in again, if the auth method allows it. It returns
+// when the provided context is canceled.
+func (d *database) manageTokenLifecycle(ctx context.Context, authSecret *api.Secret) {
+	logger := logging.LoggerFromContext(ctx)
+	for {
+		// Tokens without a TTL, such as root tokens, never expire
+		if authSecret.Auth.LeaseDuration == 0 {
+			return
+		}
+		watcher, err := d.client.NewLifetimeWatcher(&api.LifetimeWatcherInput{
+			Secret: authSecret,
+		})
+		if err != nil {
+			logger.Error(err, "error watching Vault token lifetime")
+			return
+		}
+		go watcher.Start()
+		if !d.watchToken(ctx, watcher) {
+			return
+		}
+		if d.login == nil {
+			logger.Info("Vault token can no longer be renewed")
+			return
+		}
+		if authSecret, err = d.login(ctx); err != nil {
+			logger.Error(err, "error logging in to Vault again")
+			return
+		}
+		logger.Debug("logged in to Vault again")
+	}
+}
+
+// watchToken waits for the provided LifetimeWatcher to finish, logging any
+// renewals along the way. It returns false if the provided context was canceled
+// first.
+func (d *database) watchToken(ctx context.Context, watcher *api.LifetimeWatcher) bool {
+	defer watcher.Stop()
+	logger := logging.LoggerFromContext(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case err := <-watcher.DoneCh():
+			if err != nil {
+				logger.Error(err, "error renewing Vault token")
+			}
+			return true
+		case <-watcher.RenewCh():
+			logger.Debug("renewed Vault token")
+		}
+	}
+}
@@ -0,0 +1,380 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/internal/credentials"
+)
+
+const (
+	testToken     = "fake-token"
+	testNamespace = "fake-namespace"
+)
+
+// newTestVaultServer returns a server that mimics the parts of the Vault HTTP
+// API used by the database. Secrets are keyed by path, without the /v1/
+// prefix, and are returned verbatim as the data of a Vault secret.
+func newTestVaultServer(
+	t *testing.T,
+	secrets map[string]map[string]any,
+	loginHandler http.HandlerFunc,
+) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/auth/token/lookup-self", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != testToken {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		writeJSON(t, w, map[string]any{
+			"data": map[string]any{
+				"renewable": false,
+				"ttl":       0,
+			},
+		})
+	})
+	if loginHandler != nil {
+		mux.HandleFunc("/v1/auth/kubernetes/login", loginHandler)
+	}
+	mux.HandleFunc("/v1/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != testToken {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		data, ok := secrets[r.URL.Path[len("/v1/"):]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeJSON(t, w, map[string]any{"data": data})
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(body))
+}
+
+func TestNewDatabase(t *testing.T) {
+	srv := newTestVaultServer(t, nil, nil)
+	testCases := []struct {
+		name       string
+		cfg        DatabaseConfig
+		assertions func(*testing.T, credentials.Database, error)
+	}{
+		{
+			name: "invalid path template",
+			cfg: DatabaseConfig{
+				Address:      srv.URL,
+				PathTemplate: "secret/{{.Namespace",
+				AuthMethod:   AuthMethodToken,
+				Token:        testToken,
+			},
+			assertions: func(t *testing.T, _ credentials.Database, err error) {
+				require.ErrorContains(t, err, "error parsing Vault path template")
+			},
+		},
+		{
+			name: "unsupported auth method",
+			cfg: DatabaseConfig{
+				Address:      srv.URL,
+				PathTemplate: "secret/{{.Namespace}}",
+				AuthMethod:   "bogus",
+			},
+			assertions: func(t *testing.T, _ credentials.Database, err error) {
+				require.ErrorContains(t, err, "unsupported Vault auth method")
+			},
+		},
+		{
+			name: "kubernetes auth method without role",
+			cfg: DatabaseConfig{
+				Address:      srv.URL,
+				PathTemplate: "secret/{{.Namespace}}",
+				AuthMethod:   AuthMethodKubernetes,
+			},
+			assertions: func(t *testing.T, _ credentials.Database, err error) {
+				require.ErrorContains(t, err, "a Vault role is required")
+			},
+		},
+		{
+			name: "invalid token",
+			cfg: DatabaseConfig{
+				Address:      srv.URL,
+				PathTemplate: "secret/{{.Namespace}}",
+				AuthMethod:   AuthMethodToken,
+				Token:        "bogus-token",
+			},
+			assertions: func(t *testing.T, _ credentials.Database, err error) {
+				require.ErrorContains(t, err, "error looking up Vault token")
+			},
+		},
+		{
+			name: "success with token auth method",
+			cfg: DatabaseConfig{
+				Address:      srv.URL,
+				PathTemplate: "secret/{{.Namespace}}",
+				AuthMethod:   AuthMethodToken,
+				Token:        testToken,
+			},
+			assertions: func(t *testing.T, db credentials.Database, err error) {
+				require.NoError(t, err)
+				d, ok := db.(*database)
+				require.True(t, ok)
+				require.Equal(t, testToken, d.client.Token())
+				require.Nil(t, d.login)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			db, err := NewDatabase(ctx, testCase.cfg)
+			testCase.assertions(t, db, err)
+		})
+	}
+}
+
+func TestNewDatabaseWithKubernetesAuth(t *testing.T) {
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("fake-jwt\n"), 0600))
+
+	var logins atomic.Int32
+	srv := newTestVaultServer(
+		t,
+		map[string]map[string]any{
+			"secret/fake-namespace": {
+				"username": "fake-username",
+				"password": "fake-password",
+			},
+		},
+		func(w http.ResponseWriter, r *http.Request) {
+			req := map[string]string{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			if req["role"] != "fake-role" || req["jwt"] != "fake-jwt" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			logins.Add(1)
+			// A short-lived, non-renewable token forces the database to log in
+			// again almost immediately.
+			writeJSON(t, w, map[string]any{
+				"auth": map[string]any{
+					"client_token":   testToken,
+					"renewable":      false,
+					"lease_duration": 1,
+				},
+			})
+		},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	db, err := NewDatabase(ctx, DatabaseConfig{
+		Address:                           srv.URL,
+		PathTemplate:                      "secret/{{.Namespace}}",
+		AuthMethod:                        AuthMethodKubernetes,
+		KubernetesAuthRole:                "fake-role",
+		KubernetesAuthMountPath:           "kubernetes",
+		KubernetesServiceAccountTokenPath: tokenPath,
+	})
+	require.NoError(t, err)
+
+	creds, ok, err := db.Get(
+		ctx,
+		testNamespace,
+		credentials.TypeGit,
+		"https://github.com/akuity/kargo",
+	)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "fake-username", creds.Username)
+
+	require.Eventually(
+		t,
+		func() bool { return logins.Load() > 1 },
+		5*time.Second,
+		10*time.Millisecond,
+	)
+}
+
+func TestGet(t *testing.T) {
+	srv := newTestVaultServer(
+		t,
+		map[string]map[string]any{
+			// KV version 2
+			"secret/data/kargo/fake-namespace/github.com": {
+				"data": map[string]any{
+					"username": "fake-username",
+					"password": "fake-password",
+				},
+				"metadata": map[string]any{
+					"version": 1,
+				},
+			},
+			// KV version 1
+			"secret/data/kargo/fake-namespace/ghcr.io": {
+				"sshPrivateKey": "fake-key",
+			},
+			"secret/data/kargo/fake-namespace/gitlab.com": {
+				"username": "fake-username",
+			},
+		},
+		nil,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	db, err := NewDatabase(ctx, DatabaseConfig{
+		Address:      srv.URL,
+		PathTemplate: "secret/data/kargo/{{.Namespace}}/{{.Host}}",
+		AuthMethod:   AuthMethodToken,
+		Token:        testToken,
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		namespace  string
+		repoURL    string
+		assertions func(*testing.T, credentials.Credentials, bool, error)
+	}{
+		{
+			name:    "no namespace",
+			repoURL: "https://github.com/akuity/kargo.git",
+			assertions: func(t *testing.T, _ credentials.Credentials, ok bool, err error) {
+				require.ErrorContains(t, err, "a namespace is required")
+				require.False(t, ok)
+			},
+		},
+		{
+			name:      "secret in another namespace",
+			namespace: "other-namespace",
+			repoURL:   "https://github.com/akuity/kargo.git",
+			assertions: func(t *testing.T, _ credentials.Credentials, ok bool, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+			},
+		},
+		{
+			name:      "insecure HTTP endpoint",
+			namespace: testNamespace,
+			repoURL:   "http://github.com/akuity/kargo",
+			assertions: func(t *testing.T, _ credentials.Credentials, ok bool, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+			},
+		},
+		{
+			name:      "KV version 2 secret",
+			namespace: testNamespace,
+			repoURL:   "https://github.com/akuity/kargo.git",
+			assertions: func(t *testing.T, creds credentials.Credentials, ok bool, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Equal(
+					t,
+					credentials.Credentials{
+						Username: "fake-username",
+						Password: "fake-password",
+					},
+					creds,
+				)
+			},
+		},
+		{
+			name:      "KV version 1 secret",
+			namespace: testNamespace,
+			repoURL:   "ghcr.io/akuity/kargo",
+			assertions: func(t *testing.T, creds credentials.Credentials, ok bool, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Equal(
+					t,
+					credentials.Credentials{SSHPrivateKey: "fake-key"},
+					creds,
+				)
+			},
+		},
+		{
+			name:      "incomplete secret",
+			namespace: testNamespace,
+			repoURL:   "git@gitlab.com:akuity/kargo.git",
+			assertions: func(t *testing.T, _ credentials.Credentials, ok bool, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+			},
+		},
+		{
+			name:      "secret not found",
+			namespace: testNamespace,
+			repoURL:   "https://bitbucket.org/akuity/kargo",
+			assertions: func(t *testing.T, _ credentials.Credentials, ok bool, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			creds, ok, err := db.Get(
+				ctx,
+				testCase.namespace,
+				credentials.TypeGit,
+				testCase.repoURL,
+			)
+			testCase.assertions(t, creds, ok, err)
+		})
+	}
+}
+
+func TestRepoHost(t *testing.T) {
+	testCases := []struct {
+		repoURL  string
+		expected string
+	}{
+		{
+			repoURL:  "https://github.com/akuity/kargo.git",
+			expected: "github.com",
+		},
+		{
+			repoURL:  "ssh://git@github.com:2222/akuity/kargo.git",
+			expected: "github.com",
+		},
+		{
+			repoURL:  "git@github.com:akuity/kargo.git",
+			expected: "github.com",
+		},
+		{
+			repoURL:  "ghcr.io/akuity/kargo",
+			expected: "ghcr.io",
+		},
+		{
+			repoURL:  "oci://ghcr.io/akuity/kargo-charts/kargo",
+			expected: "ghcr.io",
+		},
+		{
+			repoURL:  "localhost:5000/kargo",
+			expected: "localhost",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.repoURL, func(t *testing.T) {
+			host, err := repoHost(testCase.repoURL)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, host)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package expression
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const (
+	// FreightVar is the name of the variable through which an auto-promotion
+	// expression accesses the Freight that is a candidate for promotion.
+	FreightVar = "freight"
+	// StageVar is the name of the variable through which an auto-promotion
+	// expression accesses the Stage the Freight would be promoted to.
+	StageVar = "stage"
+)
+
+// EvaluationError is returned by EvaluateAutoPromotion when a valid
+// expression cannot be evaluated against a particular Stage and piece of
+// Freight, e.g. because it indexes into a list that is empty for that Freight.
+type EvaluationError struct {
+	err error
+}
+
+func (e *EvaluationError) Error() string {
+	return fmt.Sprintf("error evaluating expression: %s", e.err)
+}
+
+func (e *EvaluationError) Unwrap() error {
+	return e.err
+}
+
+// autoPromotionEnv returns the CEL environment in which auto-promotion
+// expressions are compiled and evaluated.
+func autoPromotionEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable(FreightVar, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(StageVar, cel.MapType(cel.StringType, cel.DynType)),
+	)
+}
+
+// CompileAutoPromotion compiles the provided auto-promotion expression and
+// returns a program that can be evaluated using EvaluateAutoPromotion. An error
+// is returned if the expression is not valid CEL or if it cannot evaluate to a
+// boolean.
+func CompileAutoPromotion(expr string) (cel.Program, error) {
+	env, err := autoPromotionEnv()
+	if err != nil {
+		return nil, fmt.Errorf("error creating CEL environment: %w", err)
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	if t := ast.OutputType(); !t.IsExactType(types.BoolType) &&
+		!t.IsExactType(types.DynType) {
+		return nil, fmt.Errorf(
+			"expression must evaluate to a bool, but evaluates to %s",
+			t.String(),
+		)
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CEL program: %w", err)
+	}
+	return prg, nil
+}
+
+// EvaluateAutoPromotion evaluates the provided auto-promotion expression
+// against the provided Stage and the Freight that is a candidate for
+// promotion to it. The Freight and Stage are exposed to the expression as the
+// freight and stage variables, respectively, using the same field names as
+// their JSON representations. The commits, images, and charts of the Freight
+// are always present, even when empty. It returns whether the Freight may be
+// automatically promoted to the Stage. An error is returned if the expression
+// cannot be compiled. If the expression cannot be evaluated against the
+// provided Stage and Freight, or does not evaluate to a boolean for them, an
+// *EvaluationError is returned.
+func EvaluateAutoPromotion(
+	expr string,
+	stage *kargoapi.Stage,
+	freight *kargoapi.Freight,
+) (bool, error) {
+	prg, err := CompileAutoPromotion(expr)
+	if err != nil {
+		return false, fmt.Errorf("error compiling expression: %w", err)
+	}
+	freightVal, err := runtime.DefaultUnstructuredConverter.ToUnstructured(freight)
+	if err != nil {
+		return false, fmt.Errorf("error converting Freight: %w", err)
+	}
+	for _, key := range []string{"commits", "images", "charts"} {
+		if _, ok := freightVal[key]; !ok {
+			freightVal[key] = []any{}
+		}
+	}
+	stageVal, err := runtime.DefaultUnstructuredConverter.ToUnstructured(stage)
+	if err != nil {
+		return false, fmt.Errorf("error converting Stage: %w", err)
+	}
+	out, _, err := prg.Eval(map[string]any{
+		FreightVar: freightVal,
+		StageVar:   stageVal,
+	})
+	if err != nil {
+		return false, &EvaluationError{err: err}
+	}
+	res, ok := out.Value().(bool)
+	if !ok {
+		return false, &EvaluationError{
+			err: fmt.Errorf(
+				"expression must evaluate to a bool, but evaluated to %s",
+				out.Type().TypeName(),
+			),
+		}
+	}
+	return res, nil
+}
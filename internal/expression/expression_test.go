@@ -0,0 +1,182 @@
+package expression
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestCompileAutoPromotion(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expr       string
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "invalid syntax",
+			expr: `freight.alias ==`,
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "Syntax error")
+			},
+		},
+		{
+			name: "undeclared variable",
+			expr: `warehouse.name == "fake-warehouse"`,
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "undeclared reference")
+			},
+		},
+		{
+			name: "expression evaluates to a non-bool type",
+			expr: `size(freight.images)`,
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "must evaluate to a bool")
+			},
+		},
+		{
+			name: "success",
+			expr: `freight.images.all(i, !i.tag.contains("-rc"))`,
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := CompileAutoPromotion(testCase.expr)
+			testCase.assertions(t, err)
+		})
+	}
+}
+
+func TestEvaluateAutoPromotion(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-stage",
+			Labels: map[string]string{
+				"tier": "prod",
+			},
+		},
+	}
+	testFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-freight",
+		},
+		Alias: "fake-alias",
+		Origin: kargoapi.FreightOrigin{
+			Kind: kargoapi.FreightOriginKindWarehouse,
+			Name: "fake-warehouse",
+		},
+		Images: []kargoapi.Image{
+			{
+				RepoURL: "fake-repo",
+				Tag:     "v1.2.3",
+			},
+		},
+	}
+	testRCFreight := testFreight.DeepCopy()
+	testRCFreight.Images[0].Tag = "v1.3.0-rc.1"
+
+	testCases := []struct {
+		name       string
+		expr       string
+		freight    *kargoapi.Freight
+		assertions func(*testing.T, bool, error)
+	}{
+		{
+			name:    "expression evaluates to true",
+			expr:    `freight.images.all(i, !i.tag.contains("-rc"))`,
+			freight: testFreight,
+			assertions: func(t *testing.T, ok bool, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+			},
+		},
+		{
+			name:    "expression evaluates to false",
+			expr:    `freight.images.all(i, !i.tag.contains("-rc"))`,
+			freight: testRCFreight,
+			assertions: func(t *testing.T, ok bool, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+			},
+		},
+		{
+			name:    "Freight without commits or charts",
+			expr:    `size(freight.commits) == 0 && size(freight.charts) == 0`,
+			freight: testFreight,
+			assertions: func(t *testing.T, ok bool, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+			},
+		},
+		{
+			name:    "invalid expression",
+			expr:    `freight.alias ==`,
+			freight: testFreight,
+			assertions: func(t *testing.T, _ bool, err error) {
+				require.ErrorContains(t, err, "error compiling expression")
+				var evalErr *EvaluationError
+				require.False(t, errors.As(err, &evalErr))
+			},
+		},
+		{
+			name:    "expression evaluates to a non-bool value",
+			expr:    `freight.alias`,
+			freight: testFreight,
+			assertions: func(t *testing.T, _ bool, err error) {
+				require.ErrorContains(t, err, "must evaluate to a bool")
+				var evalErr *EvaluationError
+				require.ErrorAs(t, err, &evalErr)
+			},
+		},
+		{
+			name:    "expression compares values of different types",
+			expr:    `freight.alias > 1`,
+			freight: testFreight,
+			assertions: func(t *testing.T, _ bool, err error) {
+				require.ErrorContains(t, err, "error evaluating expression")
+				var evalErr *EvaluationError
+				require.ErrorAs(t, err, &evalErr)
+			},
+		},
+		{
+			name:    "expression indexes into an empty list",
+			expr:    `freight.charts[0].version == "1.0.0"`,
+			freight: testFreight,
+			assertions: func(t *testing.T, _ bool, err error) {
+				require.ErrorContains(t, err, "error evaluating expression")
+				var evalErr *EvaluationError
+				require.ErrorAs(t, err, &evalErr)
+			},
+		},
+		{
+			name: "complex multi-field predicate",
+			expr: `stage.metadata.labels.tier == "prod" &&
+				freight.origin.name == "fake-warehouse" &&
+				freight.alias.startsWith("fake-") &&
+				freight.images.exists(i, i.repoURL == "fake-repo" && i.tag.matches("^v1\\.2\\.[0-9]+$"))`,
+			freight: testFreight,
+			assertions: func(t *testing.T, ok bool, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ok, err := EvaluateAutoPromotion(
+				testCase.expr,
+				testStage,
+				testCase.freight,
+			)
+			testCase.assertions(t, ok, err)
+		})
+	}
+}
@@ -41,6 +41,12 @@ type CollectorConfig struct {
 	// MinFreightDeletionAge specifies the minimum age Freight must be before
 	// considered eligible for garbage collection.
 	MinFreightDeletionAge time.Duration `envconfig:"MIN_FREIGHT_DELETION_AGE" default:"336h"` // 2 weeks
+	// MinOrphanedResourceDeletionAge specifies the minimum age Jobs and
+	// ConfigMaps labeled with the ID of a FreightCollection that no longer
+	// appears in the history of any Stage must be before considered eligible
+	// for garbage collection. This spares resources belonging to Promotions
+	// that are still in progress.
+	MinOrphanedResourceDeletionAge time.Duration `envconfig:"MIN_ORPHANED_RESOURCE_DELETION_AGE" default:"24h"`
 }
 
 // CollectorConfigFromEnv returns a CollectorConfig populated from environment
@@ -131,6 +137,20 @@ type collector struct {
 		client.Object,
 		...client.DeleteOption,
 	) error
+
+	cleanProjectFreightCollectionResourcesFn func(context.Context, string) error
+
+	listFreightCollectionResourcesFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+
+	deleteFreightCollectionResourceFn func(
+		context.Context,
+		client.Object,
+		...client.DeleteOption,
+	) error
 }
 
 // NewCollector initializes and returns an implementation of the Collector
@@ -152,6 +172,9 @@ func NewCollector(kubeClient client.Client, cfg CollectorConfig) Collector {
 	c.listFreightFn = kubeClient.List
 	c.listStagesFn = kubeClient.List
 	c.deleteFreightFn = kubeClient.Delete
+	c.cleanProjectFreightCollectionResourcesFn = c.cleanProjectFreightCollectionResources
+	c.listFreightCollectionResourcesFn = kubeClient.List
+	c.deleteFreightCollectionResourceFn = kubeClient.Delete
 	return c
 }
 
@@ -31,6 +31,9 @@ func TestNewCollector(t *testing.T) {
 	require.NotNil(t, c.listFreightFn)
 	require.NotNil(t, c.listStagesFn)
 	require.NotNil(t, c.deleteFreightFn)
+	require.NotNil(t, c.cleanProjectFreightCollectionResourcesFn)
+	require.NotNil(t, c.listFreightCollectionResourcesFn)
+	require.NotNil(t, c.deleteFreightCollectionResourceFn)
 }
 
 func TestRun(t *testing.T) {
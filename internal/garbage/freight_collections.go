@@ -0,0 +1,114 @@
+package garbage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// cleanProjectFreightCollectionResources deletes all Jobs and ConfigMaps in
+// the specified Project that are labeled with the ID of a FreightCollection
+// that no longer appears in the FreightHistory of any Stage in the Project,
+// i.e. resources created while promoting Freight that have since been trimmed
+// from the history of every Stage. Resources younger than some configurable
+// minimum age are spared, as they may belong to a Promotion that is still in
+// progress and whose FreightCollection has not yet been recorded.
+func (c *collector) cleanProjectFreightCollectionResources(
+	ctx context.Context,
+	project string,
+) error {
+	logger := logging.LoggerFromContext(ctx).WithValues("project", project)
+
+	stages := kargoapi.StageList{}
+	if err := c.listStagesFn(
+		ctx,
+		&stages,
+		client.InNamespace(project),
+	); err != nil {
+		return fmt.Errorf("error listing Stages in Project %q: %w", project, err)
+	}
+
+	activeIDs := map[string]struct{}{}
+	for _, stage := range stages.Items {
+		for _, freightCol := range stage.Status.FreightHistory {
+			if freightCol != nil && freightCol.ID != "" {
+				activeIDs[freightCol.ID] = struct{}{}
+			}
+		}
+	}
+
+	listOpts := []client.ListOption{
+		client.InNamespace(project),
+		client.HasLabels{kargoapi.FreightCollectionLabelKey},
+	}
+
+	jobs := batchv1.JobList{}
+	if err := c.listFreightCollectionResourcesFn(ctx, &jobs, listOpts...); err != nil {
+		return fmt.Errorf(
+			"error listing FreightCollection Jobs in Project %q: %w",
+			project,
+			err,
+		)
+	}
+	configMaps := corev1.ConfigMapList{}
+	if err := c.listFreightCollectionResourcesFn(ctx, &configMaps, listOpts...); err != nil {
+		return fmt.Errorf(
+			"error listing FreightCollection ConfigMaps in Project %q: %w",
+			project,
+			err,
+		)
+	}
+
+	objs := make([]client.Object, 0, len(jobs.Items)+len(configMaps.Items))
+	for i := range jobs.Items {
+		objs = append(objs, &jobs.Items[i])
+	}
+	for i := range configMaps.Items {
+		objs = append(objs, &configMaps.Items[i])
+	}
+
+	var deleteErrCount int
+	for _, obj := range objs {
+		freightColID := obj.GetLabels()[kargoapi.FreightCollectionLabelKey]
+		if _, active := activeIDs[freightColID]; active {
+			continue // Still in use
+		}
+		if time.Since(obj.GetCreationTimestamp().Time) <
+			c.cfg.MinOrphanedResourceDeletionAge {
+			continue // Not old enough
+		}
+		objLogger := logger.WithValues(
+			"kind", fmt.Sprintf("%T", obj),
+			"name", obj.GetName(),
+			"freightCollection", freightColID,
+		)
+		if err := c.deleteFreightCollectionResourceFn(
+			ctx,
+			obj,
+			// Ensure the Pods of Jobs are deleted along with them
+			client.PropagationPolicy(metav1.DeletePropagationBackground),
+		); client.IgnoreNotFound(err) != nil {
+			objLogger.Error(err, "error deleting orphaned FreightCollection resource")
+			deleteErrCount++
+		} else {
+			objLogger.Debug("deleted orphaned FreightCollection resource")
+		}
+	}
+
+	if deleteErrCount > 0 {
+		return fmt.Errorf(
+			"error deleting one or more orphaned FreightCollection resources in Project %q",
+			project,
+		)
+	}
+
+	return nil
+}
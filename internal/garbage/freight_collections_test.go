@@ -0,0 +1,209 @@
+package garbage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestCleanProjectFreightCollectionResources(t *testing.T) {
+	const testProject = "fake-project"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	oldEnough := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	tooYoung := metav1.NewTime(time.Now())
+
+	newObjectMeta := func(
+		name string,
+		freightColID string,
+		created metav1.Time,
+	) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Namespace:         testProject,
+			Name:              name,
+			CreationTimestamp: created,
+			Labels: map[string]string{
+				kargoapi.FreightCollectionLabelKey: freightColID,
+			},
+		}
+	}
+
+	newTestObjects := func() []client.Object {
+		return []client.Object{
+			&kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testProject,
+					Name:      "fake-stage",
+				},
+				Status: kargoapi.StageStatus{
+					FreightHistory: kargoapi.FreightHistory{
+						{ID: "active-freight-collection"},
+						{ID: "older-active-freight-collection"},
+					},
+				},
+			},
+			&batchv1.Job{
+				ObjectMeta: newObjectMeta("active-job", "active-freight-collection", oldEnough),
+			},
+			&corev1.ConfigMap{
+				ObjectMeta: newObjectMeta(
+					"older-active-config-map",
+					"older-active-freight-collection",
+					oldEnough,
+				),
+			},
+			&batchv1.Job{
+				ObjectMeta: newObjectMeta("orphaned-job", "trimmed-freight-collection", oldEnough),
+			},
+			&corev1.ConfigMap{
+				ObjectMeta: newObjectMeta(
+					"orphaned-config-map",
+					"trimmed-freight-collection",
+					oldEnough,
+				),
+			},
+			&batchv1.Job{
+				ObjectMeta: newObjectMeta("in-progress-job", "new-freight-collection", tooYoung),
+			},
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         testProject,
+					Name:              "unlabeled-config-map",
+					CreationTimestamp: oldEnough,
+				},
+			},
+			&batchv1.Job{
+				ObjectMeta: func() metav1.ObjectMeta {
+					objMeta := newObjectMeta("other-project-job", "trimmed-freight-collection", oldEnough)
+					objMeta.Namespace = "other-project"
+					return objMeta
+				}(),
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		setup      func(*collector)
+		assertions func(*testing.T, client.Client, error)
+	}{
+		{
+			name: "error listing Stages",
+			setup: func(c *collector) {
+				c.listStagesFn = func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				}
+			},
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, "error listing Stages in Project")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error listing resources",
+			setup: func(c *collector) {
+				c.listFreightCollectionResourcesFn = func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				}
+			},
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, "error listing FreightCollection Jobs in Project")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error deleting resources",
+			setup: func(c *collector) {
+				c.deleteFreightCollectionResourceFn = func(
+					context.Context,
+					client.Object,
+					...client.DeleteOption,
+				) error {
+					return errors.New("something went wrong")
+				}
+			},
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(
+					t, err, "error deleting one or more orphaned FreightCollection resources",
+				)
+			},
+		},
+		{
+			name: "success",
+			assertions: func(t *testing.T, c client.Client, err error) {
+				require.NoError(t, err)
+
+				exists := func(obj client.Object, namespace, name string) bool {
+					getErr := c.Get(
+						context.Background(),
+						client.ObjectKey{Namespace: namespace, Name: name},
+						obj,
+					)
+					if apierrors.IsNotFound(getErr) {
+						return false
+					}
+					require.NoError(t, getErr)
+					return true
+				}
+
+				// Resources for FreightCollections still in a Stage's history are
+				// preserved
+				require.True(t, exists(&batchv1.Job{}, testProject, "active-job"))
+				require.True(t, exists(&corev1.ConfigMap{}, testProject, "older-active-config-map"))
+				// Orphaned resources are deleted
+				require.False(t, exists(&batchv1.Job{}, testProject, "orphaned-job"))
+				require.False(t, exists(&corev1.ConfigMap{}, testProject, "orphaned-config-map"))
+				// Orphaned resources that are too young are preserved
+				require.True(t, exists(&batchv1.Job{}, testProject, "in-progress-job"))
+				// Unlabeled resources and resources in other Projects are ignored
+				require.True(t, exists(&corev1.ConfigMap{}, testProject, "unlabeled-config-map"))
+				require.True(t, exists(&batchv1.Job{}, "other-project", "other-project-job"))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			kubeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(newTestObjects()...).
+				Build()
+			c, ok := NewCollector(
+				kubeClient,
+				CollectorConfig{MinOrphanedResourceDeletionAge: time.Hour},
+			).(*collector)
+			require.True(t, ok)
+			if testCase.setup != nil {
+				testCase.setup(c)
+			}
+			testCase.assertions(
+				t,
+				kubeClient,
+				c.cleanProjectFreightCollectionResources(context.Background(), testProject),
+			)
+		})
+	}
+}
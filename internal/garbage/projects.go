@@ -58,5 +58,16 @@ func (c *collector) cleanProject(ctx context.Context, project string) error {
 		)
 	}
 
+	if err := c.cleanProjectFreightCollectionResourcesFn(ctx, project); err != nil {
+		errs = append(
+			errs,
+			fmt.Errorf(
+				"error cleaning FreightCollection resources in Project %q: %w",
+				project,
+				err,
+			),
+		)
+	}
+
 	return errors.Join(errs...)
 }
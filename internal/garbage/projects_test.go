@@ -90,7 +90,7 @@ func TestCleanProject(t *testing.T) {
 		assertions func(*testing.T, error)
 	}{
 		{
-			name: "errors cleaning Promotions and Freight",
+			name: "errors cleaning Promotions, Freight, and FreightCollection resources",
 			collector: &collector{
 				cleanProjectPromotionsFn: func(context.Context, string) error {
 					return errors.New("something went wrong")
@@ -98,12 +98,17 @@ func TestCleanProject(t *testing.T) {
 				cleanProjectFreightFn: func(context.Context, string) error {
 					return errors.New("something else went wrong")
 				},
+				cleanProjectFreightCollectionResourcesFn: func(context.Context, string) error {
+					return errors.New("yet another thing went wrong")
+				},
 			},
 			assertions: func(t *testing.T, err error) {
 				require.ErrorContains(t, err, "error cleaning Promotions in Project")
 				require.ErrorContains(t, err, "something went wrong")
 				require.ErrorContains(t, err, "error cleaning Freight in Project")
 				require.ErrorContains(t, err, "something else went wrong")
+				require.ErrorContains(t, err, "error cleaning FreightCollection resources in Project")
+				require.ErrorContains(t, err, "yet another thing went wrong")
 			},
 		},
 		{
@@ -115,6 +120,9 @@ func TestCleanProject(t *testing.T) {
 				cleanProjectFreightFn: func(context.Context, string) error {
 					return nil
 				},
+				cleanProjectFreightCollectionResourcesFn: func(context.Context, string) error {
+					return nil
+				},
 			},
 			assertions: func(t *testing.T, err error) {
 				require.NoError(t, err)
@@ -0,0 +1,160 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// ChangesAnnotationKey is the key of the chart annotation that, by Artifact
+// Hub convention, lists the changes introduced by a version of a chart.
+const ChangesAnnotationKey = "artifacthub.io/changes"
+
+// ChartChange describes a single change introduced by a version of a chart.
+type ChartChange struct {
+	// Version is the version of the chart that introduced the change.
+	Version string
+	// Description describes the change.
+	Description string
+}
+
+// String returns a human-readable representation of the ChartChange.
+func (c ChartChange) String() string {
+	return fmt.Sprintf("%s: %s", c.Version, c.Description)
+}
+
+// GetChartChanges connects to the specified Helm chart repository and
+// retrieves the changes introduced by every version of the specified chart
+// that is newer than fromVersion and no newer than toVersion. Changes are
+// extracted from each version's artifacthub.io/changes annotation and are
+// returned in ascending order by version.
+//
+// Only classic chart repositories (using HTTP/S) list chart annotations in
+// their index. For repositories within an OCI registry, nil is returned.
+//
+// The credentials argument may be nil for public repositories, but must be
+// non-nil for private repositories.
+func GetChartChanges(
+	ctx context.Context,
+	repoURL string,
+	chart string,
+	fromVersion string,
+	toVersion string,
+	creds *Credentials,
+) ([]ChartChange, error) {
+	if !strings.HasPrefix(repoURL, "http://") && !strings.HasPrefix(repoURL, "https://") {
+		return nil, nil
+	}
+	from, err := semver.NewVersion(fromVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing chart version %q: %w", fromVersion, err)
+	}
+	to, err := semver.NewVersion(toVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing chart version %q: %w", toVersion, err)
+	}
+	if !to.GreaterThan(from) {
+		return nil, nil
+	}
+
+	entries, err := getClassicRepoIndexEntries(ctx, repoURL, chart, creds)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving index entries of chart %q from repository %q: %w",
+			chart,
+			repoURL,
+			err,
+		)
+	}
+
+	type versionedEntry struct {
+		version *semver.Version
+		entry   classicRepoIndexEntry
+	}
+	relevant := make([]versionedEntry, 0, len(entries))
+	for _, entry := range entries {
+		version, err := semver.NewVersion(entry.Version)
+		if err != nil {
+			continue
+		}
+		if version.GreaterThan(from) && !version.GreaterThan(to) {
+			relevant = append(relevant, versionedEntry{version: version, entry: entry})
+		}
+	}
+	sort.Slice(relevant, func(i, j int) bool {
+		return relevant[i].version.LessThan(relevant[j].version)
+	})
+
+	var changes []ChartChange
+	for _, r := range relevant {
+		descriptions, err := extractChangesFromAnnotations(r.entry.Annotations)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error extracting changes from version %q of chart %q: %w",
+				r.entry.Version,
+				chart,
+				err,
+			)
+		}
+		for _, description := range descriptions {
+			changes = append(changes, ChartChange{
+				Version:     r.entry.Version,
+				Description: description,
+			})
+		}
+	}
+	return changes, nil
+}
+
+// extractChangesFromAnnotations returns descriptions of the changes listed in
+// the artifacthub.io/changes annotation of a chart, if present. Each change
+// may be listed either as a plain string or as an object with kind and
+// description fields.
+func extractChangesFromAnnotations(annotations map[string]string) ([]string, error) {
+	raw, ok := annotations[ChangesAnnotationKey]
+	if !ok || strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+	var entries []yaml.Node
+	if err := yaml.Unmarshal([]byte(raw), &entries); err != nil {
+		return nil, fmt.Errorf(
+			"error unmarshaling %s annotation: %w",
+			ChangesAnnotationKey,
+			err,
+		)
+	}
+	changes := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		switch entry.Kind {
+		case yaml.ScalarNode:
+			if entry.Value != "" {
+				changes = append(changes, entry.Value)
+			}
+		case yaml.MappingNode:
+			change := struct {
+				Kind        string `yaml:"kind"`
+				Description string `yaml:"description"`
+			}{}
+			if err := entry.Decode(&change); err != nil {
+				return nil, fmt.Errorf(
+					"error decoding entry of %s annotation: %w",
+					ChangesAnnotationKey,
+					err,
+				)
+			}
+			if change.Description == "" {
+				continue
+			}
+			if change.Kind != "" {
+				changes = append(changes, fmt.Sprintf("%s: %s", change.Kind, change.Description))
+			} else {
+				changes = append(changes, change.Description)
+			}
+		}
+	}
+	return changes, nil
+}
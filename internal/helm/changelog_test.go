@@ -0,0 +1,183 @@
+package helm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetChartChanges(t *testing.T) {
+	testServer := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/fake-repo/index.yaml" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(`entries:
+  fake-chart:
+  - version: 1.3.0
+    annotations:
+      artifacthub.io/changes: |
+        - kind: fixed
+          description: Fixed a bug
+  - version: 1.2.0
+    annotations:
+      artifacthub.io/changes: |
+        - Added a feature
+        - Added another feature
+  - version: 1.1.0
+    annotations:
+      artifacthub.io/changes: |
+        - Something that is already deployed
+  - version: not-semver
+`))
+		}),
+	)
+	t.Cleanup(testServer.Close)
+
+	testCases := []struct {
+		name        string
+		repoURL     string
+		fromVersion string
+		toVersion   string
+		assertions  func(*testing.T, []ChartChange, error)
+	}{
+		{
+			name:        "OCI repository",
+			repoURL:     "oci://fake-registry/fake-chart",
+			fromVersion: "1.1.0",
+			toVersion:   "1.3.0",
+			assertions: func(t *testing.T, changes []ChartChange, err error) {
+				require.NoError(t, err)
+				require.Nil(t, changes)
+			},
+		},
+		{
+			name:        "invalid from version",
+			repoURL:     testServer.URL + "/fake-repo",
+			fromVersion: "bogus",
+			toVersion:   "1.3.0",
+			assertions: func(t *testing.T, _ []ChartChange, err error) {
+				require.ErrorContains(t, err, "error parsing chart version")
+			},
+		},
+		{
+			name:        "to version is not newer",
+			repoURL:     testServer.URL + "/fake-repo",
+			fromVersion: "1.3.0",
+			toVersion:   "1.1.0",
+			assertions: func(t *testing.T, changes []ChartChange, err error) {
+				require.NoError(t, err)
+				require.Nil(t, changes)
+			},
+		},
+		{
+			name:        "error retrieving index",
+			repoURL:     testServer.URL + "/non-existent-repo",
+			fromVersion: "1.1.0",
+			toVersion:   "1.3.0",
+			assertions: func(t *testing.T, _ []ChartChange, err error) {
+				require.ErrorContains(t, err, "received unexpected HTTP 404")
+			},
+		},
+		{
+			name:        "success",
+			repoURL:     testServer.URL + "/fake-repo",
+			fromVersion: "1.1.0",
+			toVersion:   "1.3.0",
+			assertions: func(t *testing.T, changes []ChartChange, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]ChartChange{
+						{Version: "1.2.0", Description: "Added a feature"},
+						{Version: "1.2.0", Description: "Added another feature"},
+						{Version: "1.3.0", Description: "fixed: Fixed a bug"},
+					},
+					changes,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			changes, err := GetChartChanges(
+				context.Background(),
+				testCase.repoURL,
+				"fake-chart",
+				testCase.fromVersion,
+				testCase.toVersion,
+				nil,
+			)
+			testCase.assertions(t, changes, err)
+		})
+	}
+}
+
+func TestExtractChangesFromAnnotations(t *testing.T) {
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		assertions  func(*testing.T, []string, error)
+	}{
+		{
+			name: "no changes annotation",
+			annotations: map[string]string{
+				"category": "fake",
+			},
+			assertions: func(t *testing.T, changes []string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, changes)
+			},
+		},
+		{
+			name: "invalid changes annotation",
+			annotations: map[string]string{
+				ChangesAnnotationKey: "this isn't a list",
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "error unmarshaling")
+			},
+		},
+		{
+			name: "changes as plain strings",
+			annotations: map[string]string{
+				ChangesAnnotationKey: "- Added a feature\n- Fixed a bug\n",
+			},
+			assertions: func(t *testing.T, changes []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"Added a feature", "Fixed a bug"}, changes)
+			},
+		},
+		{
+			name: "changes as objects",
+			annotations: map[string]string{
+				ChangesAnnotationKey: `- kind: added
+  description: Added a feature
+  links:
+  - name: GitHub Issue
+    url: https://github.com/akuity/kargo/issues/1
+- description: Changed something
+- kind: removed
+`,
+			},
+			assertions: func(t *testing.T, changes []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{"added: Added a feature", "Changed something"},
+					changes,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			changes, err := extractChangesFromAnnotations(testCase.annotations)
+			testCase.assertions(t, changes, err)
+		})
+	}
+}
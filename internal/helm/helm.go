@@ -47,7 +47,7 @@ func DiscoverChartVersions(
 	var err error
 	switch {
 	case strings.HasPrefix(repoURL, "http://"), strings.HasPrefix(repoURL, "https://"):
-		versions, err = getChartVersionsFromClassicRepo(repoURL, chart, creds)
+		versions, err = getChartVersionsFromClassicRepo(ctx, repoURL, chart, creds)
 	case strings.HasPrefix(repoURL, "oci://"):
 		versions, err = getChartVersionsFromOCIRepo(ctx, repoURL, creds)
 	default:
@@ -62,21 +62,34 @@ func DiscoverChartVersions(
 		)
 	}
 
-	semvers := versionsToSemVerCollection(versions)
-	if len(semvers) == 0 {
-		return nil, nil
+	if versions, err = selectChartVersions(versions, semverConstraint); err != nil {
+		return nil, fmt.Errorf(
+			"error filtering versions of chart %q from repository %q: %w",
+			chart,
+			repoURL,
+			err,
+		)
 	}
+	return versions, nil
+}
 
+// selectChartVersions returns those of the provided versions that are valid
+// semantic versions satisfying the provided semver constraint, sorted from
+// latest to earliest. When the constraint is empty, every valid semantic
+// version is returned. As is usual for semver constraints, pre-release
+// versions only satisfy a constraint that itself includes a pre-release. Nil
+// is returned if no version satisfies the constraint.
+func selectChartVersions(versions []string, semverConstraint string) ([]string, error) {
+	semvers := versionsToSemVerCollection(versions)
 	if semverConstraint != "" {
+		var err error
 		if semvers, err = filterSemVers(semvers, semverConstraint); err != nil {
-			return nil, fmt.Errorf(
-				"error filtering versions of chart %q from repository %q: %w",
-				chart,
-				repoURL,
-				err,
-			)
+			return nil, err
 		}
 	}
+	if len(semvers) == 0 {
+		return nil, nil
+	}
 
 	// NB: semver.Collection sorts in ascending order by default. We want to
 	// return the versions in descending order.
@@ -91,12 +104,48 @@ func DiscoverChartVersions(
 // https://. Provided credentials may be nil for public repositories, but must
 // be non-nil for private repositories.
 func getChartVersionsFromClassicRepo(
+	ctx context.Context,
 	repoURL string,
 	chart string,
 	creds *Credentials,
 ) ([]string, error) {
+	entries, err := getClassicRepoIndexEntries(ctx, repoURL, chart, creds)
+	if err != nil {
+		return nil, err
+	}
+	if entries == nil {
+		return nil, nil
+	}
+	versions := make([]string, len(entries))
+	for i, entry := range entries {
+		versions[i] = entry.Version
+	}
+	return versions, nil
+}
+
+// classicRepoIndexEntry represents a single version of a chart as listed in a
+// classic (HTTP/S) chart repository's index. It only includes the fields that
+// are relevant to this package.
+type classicRepoIndexEntry struct {
+	Version     string            `json:"version,omitempty"`
+	URLs        []string          `json:"urls,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// getClassicRepoIndexEntries connects to the classic (HTTP/S) chart repository
+// specified by repoURL and retrieves the index entries for all available
+// versions of the specified chart. If the chart is not found in the
+// repository's index, nil is returned. The provided repoURL MUST begin with
+// protocol http:// or https://. Provided credentials may be nil for public
+// repositories, but must be non-nil for private repositories.
+func getClassicRepoIndexEntries(
+	ctx context.Context,
+	repoURL string,
+	chart string,
+	creds *Credentials,
+) ([]classicRepoIndexEntry, error) {
 	indexURL := fmt.Sprintf("%s/index.yaml", strings.TrimSuffix(repoURL, "/"))
-	req, err := http.NewRequest(http.MethodGet, indexURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, indexURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("error preparing HTTP/S request to %q: %w", indexURL, err)
 	}
@@ -120,22 +169,12 @@ func getChartVersionsFromClassicRepo(
 		return nil, fmt.Errorf("error reading repository index from %q: %w", indexURL, err)
 	}
 	index := struct {
-		Entries map[string][]struct {
-			Version string `json:"version,omitempty"`
-		} `json:"entries,omitempty"`
+		Entries map[string][]classicRepoIndexEntry `json:"entries,omitempty"`
 	}{}
 	if err = yaml.Unmarshal(resBodyBytes, &index); err != nil {
 		return nil, fmt.Errorf("error unmarshaling repository index from %q: %w", indexURL, err)
 	}
-	entries, ok := index.Entries[chart]
-	if !ok {
-		return nil, nil
-	}
-	versions := make([]string, len(entries))
-	for i, entry := range entries {
-		versions[i] = entry.Version
-	}
-	return versions, nil
+	return index.Entries[chart], nil
 }
 
 // getChartVersionsFromOCIRepo connects to the OCI repository specified by
@@ -284,8 +323,9 @@ func Login(homePath, repository string, credentials Credentials) error {
 // provided chartPath. The homePath is used to set the HOME environment variable,
 // as well as the XDG_* environment variables. This ensures that Helm uses the
 // provided homePath as its configuration directory, and allows for isolation.
-func UpdateChartDependencies(homePath, chartPath string) error {
-	cmd := exec.Command("helm", "dependency", "update", chartPath)
+// Helm is killed if the provided context is canceled before it exits.
+func UpdateChartDependencies(ctx context.Context, homePath, chartPath string) error {
+	cmd := exec.CommandContext(ctx, "helm", "dependency", "update", chartPath)
 	cmd.Env = append(cmd.Env, os.Environ()...)
 	cmd.Env = append(cmd.Env, helmEnv(homePath)...)
 	if _, err := libExec.Exec(cmd); err != nil {
@@ -83,6 +83,7 @@ func TestGetChartVersionsFromClassicRepo(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			versions, err := getChartVersionsFromClassicRepo(
+				context.Background(),
 				testCase.repoURL,
 				testCase.chart,
 				nil,
@@ -218,6 +219,81 @@ func TestSemVerCollectionToVersions(t *testing.T) {
 	}
 }
 
+func TestSelectChartVersions(t *testing.T) {
+	testVersions := []string{
+		"0.9.0",
+		"1.0.0",
+		"1.5.0",
+		"1.9.9",
+		"2.0.0-rc.1",
+		"2.0.0",
+		"2.1.0",
+		"not-a-version",
+	}
+
+	testCases := []struct {
+		name          string
+		versions      []string
+		constraint    string
+		expected      []string
+		expectedError string
+	}{
+		{
+			name:       "range constraint",
+			versions:   testVersions,
+			constraint: ">=1.0.0 <2.0.0",
+			expected:   []string{"1.9.9", "1.5.0", "1.0.0"},
+		},
+		{
+			name:       "pre-release versions are excluded",
+			versions:   testVersions,
+			constraint: ">=2.0.0",
+			expected:   []string{"2.1.0", "2.0.0"},
+		},
+		{
+			name:       "pre-release versions included by constraint",
+			versions:   testVersions,
+			constraint: ">=2.0.0-0 <2.1.0-0",
+			expected:   []string{"2.0.0", "2.0.0-rc.1"},
+		},
+		{
+			name:     "empty constraint",
+			versions: testVersions,
+			expected: []string{
+				"2.1.0", "2.0.0", "2.0.0-rc.1", "1.9.9", "1.5.0", "1.0.0", "0.9.0",
+			},
+		},
+		{
+			name:       "no version satisfies constraint",
+			versions:   testVersions,
+			constraint: ">=3.0.0",
+			expected:   nil,
+		},
+		{
+			name:     "no versions",
+			versions: nil,
+			expected: nil,
+		},
+		{
+			name:          "invalid constraint",
+			versions:      testVersions,
+			constraint:    "invalid",
+			expectedError: "error parsing constraint",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			versions, err := selectChartVersions(tc.versions, tc.constraint)
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, versions)
+		})
+	}
+}
+
 func TestFilterSemVers(t *testing.T) {
 	testCases := []struct {
 		name             string
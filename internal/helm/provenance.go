@@ -0,0 +1,225 @@
+package helm
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"golang.org/x/crypto/openpgp"           // nolint: staticcheck
+	"golang.org/x/crypto/openpgp/clearsign" // nolint: staticcheck
+	"gopkg.in/yaml.v3"
+
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// errFileNotFound is returned by getRepoFile when the requested file does not
+// exist in the repository.
+var errFileNotFound = errors.New("file not found")
+
+// VerifyChartVersions connects to the specified classic (HTTP/S) chart
+// repository and returns, in the order provided, those of the provided
+// versions of the specified chart whose provenance can be verified using the
+// provided GPG keyring, which may be either binary or ASCII-armored.
+//
+// A version's provenance is verified if the provenance (.prov) file published
+// alongside the chart was signed using a key from the keyring and records the
+// SHA-256 checksum of the chart. Versions that are unsigned, whose signature
+// cannot be verified, or whose checksum does not match are skipped. Versions
+// are verified one at a time and, if limit is greater than zero, verification
+// stops once that many versions have been verified.
+//
+// The credentials argument may be nil for public repositories, but must be
+// non-nil for private repositories.
+//
+// It returns an error if the keyring is invalid, if the repository URL does
+// not point to a classic chart repository, or if the repository cannot be
+// reached, but it does not return an error if no versions could be verified.
+func VerifyChartVersions(
+	ctx context.Context,
+	repoURL string,
+	chart string,
+	versions []string,
+	limit int,
+	keyring []byte,
+	creds *Credentials,
+) ([]string, error) {
+	if !strings.HasPrefix(repoURL, "http://") && !strings.HasPrefix(repoURL, "https://") {
+		return nil, fmt.Errorf(
+			"provenance of charts in repository %q cannot be verified; only classic "+
+				"(HTTP/S) chart repositories are supported",
+			repoURL,
+		)
+	}
+	keys, err := readKeyRing(keyring)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := getClassicRepoIndexEntries(ctx, repoURL, chart, creds)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving index entries of chart %q from repository %q: %w",
+			chart,
+			repoURL,
+			err,
+		)
+	}
+	entriesByVersion := make(map[string]classicRepoIndexEntry, len(entries))
+	for _, entry := range entries {
+		entriesByVersion[entry.Version] = entry
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	verified := make([]string, 0, len(versions))
+	for _, version := range versions {
+		if limit > 0 && len(verified) >= limit {
+			break
+		}
+		entry, ok := entriesByVersion[version]
+		if !ok || len(entry.URLs) == 0 {
+			logger.Debug("skipping chart version not listed in repository index", "version", version)
+			continue
+		}
+		chartURL, err := resolveChartURL(repoURL, entry.URLs[0])
+		if err != nil {
+			return nil, err
+		}
+		chartBytes, err := getRepoFile(ctx, chartURL, creds)
+		if err != nil {
+			return nil, fmt.Errorf("error downloading chart from %q: %w", chartURL, err)
+		}
+		provBytes, err := getRepoFile(ctx, chartURL+".prov", creds)
+		if errors.Is(err, errFileNotFound) {
+			logger.Debug("skipping chart version without provenance file", "version", version)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error downloading provenance file from %q: %w", chartURL+".prov", err)
+		}
+		if err = verifyProvenance(
+			keys,
+			path.Base(chartURL),
+			chartBytes,
+			provBytes,
+		); err != nil {
+			logger.Debug(
+				"skipping chart version whose provenance could not be verified",
+				"version", version,
+				"reason", err.Error(),
+			)
+			continue
+		}
+		verified = append(verified, version)
+	}
+	return verified, nil
+}
+
+// readKeyRing parses the provided GPG keyring, which may be either
+// ASCII-armored or binary.
+func readKeyRing(keyring []byte) (openpgp.EntityList, error) {
+	keys, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(keyring))
+	if err != nil {
+		if keys, err = openpgp.ReadKeyRing(bytes.NewReader(keyring)); err != nil {
+			return nil, fmt.Errorf("error reading GPG keyring: %w", err)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("GPG keyring contains no keys")
+	}
+	return keys, nil
+}
+
+// verifyProvenance verifies that the provided provenance file was signed using
+// a key from the provided keyring and that it records the SHA-256 checksum of
+// the provided chart, which is expected to be listed under the provided file
+// name.
+func verifyProvenance(
+	keys openpgp.EntityList,
+	chartFileName string,
+	chart []byte,
+	prov []byte,
+) error {
+	block, _ := clearsign.Decode(prov)
+	if block == nil {
+		return errors.New("provenance file is not a clearsigned message")
+	}
+	if _, err := openpgp.CheckDetachedSignature(
+		keys,
+		bytes.NewReader(block.Bytes),
+		block.ArmoredSignature.Body,
+	); err != nil {
+		return fmt.Errorf("error verifying signature of provenance file: %w", err)
+	}
+
+	// The signed message holds the chart's metadata, followed by a separator,
+	// followed by the checksums of the files it describes.
+	parts := strings.SplitN(string(block.Plaintext), "\n...\n", 2)
+	if len(parts) != 2 {
+		return errors.New("provenance file does not list any file checksums")
+	}
+	sums := struct {
+		Files map[string]string `json:"files,omitempty"`
+	}{}
+	if err := yaml.Unmarshal([]byte(parts[1]), &sums); err != nil {
+		return fmt.Errorf("error unmarshaling file checksums from provenance file: %w", err)
+	}
+	sum, ok := sums.Files[chartFileName]
+	if !ok {
+		return fmt.Errorf("provenance file does not list a checksum for %q", chartFileName)
+	}
+	actual := sha256.Sum256(chart)
+	if sum != "sha256:"+hex.EncodeToString(actual[:]) {
+		return fmt.Errorf("checksum of %q does not match the one in the provenance file", chartFileName)
+	}
+	return nil
+}
+
+// resolveChartURL resolves a chart URL, as listed in a classic (HTTP/S) chart
+// repository's index, against the URL of the repository. Chart URLs may be
+// either absolute or relative to the repository.
+func resolveChartURL(repoURL, chartURL string) (string, error) {
+	base, err := url.Parse(strings.TrimSuffix(repoURL, "/") + "/")
+	if err != nil {
+		return "", fmt.Errorf("error parsing repository URL %q: %w", repoURL, err)
+	}
+	ref, err := url.Parse(chartURL)
+	if err != nil {
+		return "", fmt.Errorf("error parsing chart URL %q: %w", chartURL, err)
+	}
+	return base.ResolveReference(ref).String(), nil
+}
+
+// getRepoFile downloads the file at the specified URL of a classic (HTTP/S)
+// chart repository. If the file does not exist, errFileNotFound is returned.
+// Provided credentials may be nil for public repositories, but must be non-nil
+// for private repositories.
+func getRepoFile(ctx context.Context, fileURL string, creds *Credentials) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error preparing HTTP/S request to %q: %w", fileURL, err)
+	}
+	if creds != nil {
+		req.SetBasicAuth(creds.Username, creds.Password)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, errFileNotFound
+	default:
+		return nil, fmt.Errorf("received unexpected HTTP %d", res.StatusCode)
+	}
+	return io.ReadAll(res.Body)
+}
@@ -0,0 +1,214 @@
+package helm
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/openpgp"           // nolint: staticcheck
+	"golang.org/x/crypto/openpgp/armor"     // nolint: staticcheck
+	"golang.org/x/crypto/openpgp/clearsign" // nolint: staticcheck
+)
+
+func TestVerifyChartVersions(t *testing.T) {
+	signer, err := openpgp.NewEntity("fake-signer", "", "signer@example.com", nil)
+	require.NoError(t, err)
+	otherSigner, err := openpgp.NewEntity("other-signer", "", "other@example.com", nil)
+	require.NoError(t, err)
+
+	binaryKeyRing := &bytes.Buffer{}
+	require.NoError(t, signer.Serialize(binaryKeyRing))
+	armoredKeyRing := &bytes.Buffer{}
+	w, err := armor.Encode(armoredKeyRing, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, signer.Serialize(w))
+	require.NoError(t, w.Close())
+
+	files := map[string][]byte{}
+	testServer := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/fake-repo/charts/fake-chart-0.9.0.tgz.prov" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			file, ok := files[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(file)
+		}),
+	)
+	t.Cleanup(testServer.Close)
+	testRepoURL := testServer.URL + "/fake-repo"
+
+	files["/fake-repo/index.yaml"] = []byte(fmt.Sprintf(`entries:
+  fake-chart:
+  - version: 1.4.0
+    urls:
+    - charts/fake-chart-1.4.0.tgz
+  - version: 1.3.0
+    urls:
+    - charts/fake-chart-1.3.0.tgz
+  - version: 1.2.0
+    urls:
+    - charts/fake-chart-1.2.0.tgz
+  - version: 1.1.0
+    urls:
+    - charts/fake-chart-1.1.0.tgz
+  - version: 1.0.0
+    urls:
+    - %s/charts/fake-chart-1.0.0.tgz
+  - version: 0.9.0
+    urls:
+    - charts/fake-chart-0.9.0.tgz
+`, testRepoURL))
+	for _, version := range []string{"1.4.0", "1.3.0", "1.2.0", "1.1.0", "1.0.0", "0.9.0"} {
+		name := fmt.Sprintf("fake-chart-%s.tgz", version)
+		chart := []byte("fake chart " + version)
+		files["/fake-repo/charts/"+name] = chart
+		switch version {
+		case "1.4.0":
+			// Signed using a key that is not in the keyring
+			files["/fake-repo/charts/"+name+".prov"] = signProvenance(t, otherSigner, version, name, chart)
+		case "1.2.0":
+			// Unsigned
+		case "1.1.0":
+			// Signed, but for different chart contents
+			files["/fake-repo/charts/"+name+".prov"] =
+				signProvenance(t, signer, version, name, []byte("tampered chart"))
+		default:
+			files["/fake-repo/charts/"+name+".prov"] = signProvenance(t, signer, version, name, chart)
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		repoURL    string
+		versions   []string
+		limit      int
+		keyring    []byte
+		assertions func(*testing.T, []string, error)
+	}{
+		{
+			name:    "OCI repository",
+			repoURL: "oci://fake-registry.example.com/fake-chart",
+			keyring: armoredKeyRing.Bytes(),
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "only classic (HTTP/S) chart repositories are supported")
+			},
+		},
+		{
+			name:    "invalid keyring",
+			repoURL: testRepoURL,
+			keyring: []byte("not a keyring"),
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "error reading GPG keyring")
+			},
+		},
+		{
+			name:    "error retrieving index",
+			repoURL: testServer.URL + "/missing-repo",
+			keyring: armoredKeyRing.Bytes(),
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "error retrieving index entries")
+			},
+		},
+		{
+			name:     "error downloading provenance file",
+			repoURL:  testRepoURL,
+			versions: []string{"1.3.0", "0.9.0"},
+			keyring:  armoredKeyRing.Bytes(),
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "error downloading provenance file")
+				require.ErrorContains(t, err, "unexpected HTTP 500")
+			},
+		},
+		{
+			name:     "only verified versions are returned",
+			repoURL:  testRepoURL,
+			versions: []string{"1.4.0", "1.3.0", "1.2.0", "1.1.0", "1.0.0", "0.5.0"},
+			keyring:  armoredKeyRing.Bytes(),
+			assertions: func(t *testing.T, versions []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"1.3.0", "1.0.0"}, versions)
+			},
+		},
+		{
+			name:     "binary keyring",
+			repoURL:  testRepoURL,
+			versions: []string{"1.4.0", "1.3.0", "1.2.0", "1.1.0", "1.0.0"},
+			keyring:  binaryKeyRing.Bytes(),
+			assertions: func(t *testing.T, versions []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"1.3.0", "1.0.0"}, versions)
+			},
+		},
+		{
+			name:     "verification stops at limit",
+			repoURL:  testRepoURL,
+			versions: []string{"1.4.0", "1.3.0", "1.2.0", "1.1.0", "1.0.0", "0.9.0"},
+			limit:    1,
+			keyring:  armoredKeyRing.Bytes(),
+			assertions: func(t *testing.T, versions []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"1.3.0"}, versions)
+			},
+		},
+		{
+			name:     "no verified versions",
+			repoURL:  testRepoURL,
+			versions: []string{"1.4.0", "1.2.0", "1.1.0"},
+			keyring:  armoredKeyRing.Bytes(),
+			assertions: func(t *testing.T, versions []string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, versions)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			versions, err := VerifyChartVersions(
+				context.Background(),
+				testCase.repoURL,
+				"fake-chart",
+				testCase.versions,
+				testCase.limit,
+				testCase.keyring,
+				nil,
+			)
+			testCase.assertions(t, versions, err)
+		})
+	}
+}
+
+// signProvenance returns a provenance file, signed by the provided entity,
+// recording the checksum of the provided chart under the provided file name.
+func signProvenance(
+	t *testing.T,
+	signer *openpgp.Entity,
+	version string,
+	fileName string,
+	chart []byte,
+) []byte {
+	sum := sha256.Sum256(chart)
+	prov := &bytes.Buffer{}
+	w, err := clearsign.Encode(prov, signer.PrivateKey, nil)
+	require.NoError(t, err)
+	_, err = fmt.Fprintf(
+		w,
+		"apiVersion: v2\nname: fake-chart\nversion: %s\n\n...\nfiles:\n  %s: sha256:%s\n",
+		version,
+		fileName,
+		hex.EncodeToString(sum[:]),
+	)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return prov.Bytes()
+}
@@ -9,4 +9,10 @@ type Credentials struct {
 	// Password, when combined with the principal identified by the Username
 	// field, can be used for reading from some image repository.
 	Password string
+	// IdentityToken is an OAuth2 refresh token that is exchanged for access to
+	// some image repository, in place of Username and Password.
+	IdentityToken string
+	// RegistryToken is a bearer token that is presented to some image
+	// repository as is, in place of Username and Password.
+	RegistryToken string
 }
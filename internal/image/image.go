@@ -11,6 +11,9 @@ type Image struct {
 	Tag       string
 	Digest    string
 	CreatedAt *time.Time
+	// BuildDate is the build date recorded in the image's manifest, if any. It
+	// is not necessarily the same as CreatedAt.
+	BuildDate *time.Time
 	semVer    *semver.Version
 }
 
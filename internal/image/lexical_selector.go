@@ -14,6 +14,7 @@ import (
 type lexicalSelector struct {
 	repoClient     *repositoryClient
 	allowRegex     *regexp.Regexp
+	denyRegex      *regexp.Regexp
 	ignore         []string
 	platform       *platformConstraint
 	discoveryLimit int
@@ -24,6 +25,7 @@ type lexicalSelector struct {
 func newLexicalSelector(
 	repoClient *repositoryClient,
 	allowRegex *regexp.Regexp,
+	denyRegex *regexp.Regexp,
 	ignore []string,
 	platform *platformConstraint,
 	discoveryLimit int,
@@ -31,6 +33,7 @@ func newLexicalSelector(
 	return &lexicalSelector{
 		repoClient:     repoClient,
 		allowRegex:     allowRegex,
+		denyRegex:      denyRegex,
 		ignore:         ignore,
 		platform:       platform,
 		discoveryLimit: discoveryLimit,
@@ -99,8 +102,8 @@ func (l *lexicalSelector) Select(ctx context.Context) ([]Image, error) {
 }
 
 // selectTags retrieves all tags from the repository and filters them based on
-// the allowRegex and ignore fields of the lexicalSelector. If no tags match
-// the criteria, nil is returned.
+// the allowRegex, denyRegex, and ignore fields of the lexicalSelector. If no
+// tags match the criteria, nil is returned.
 func (l *lexicalSelector) selectTags(ctx context.Context) ([]string, error) {
 	logger := logging.LoggerFromContext(ctx)
 
@@ -114,10 +117,12 @@ func (l *lexicalSelector) selectTags(ctx context.Context) ([]string, error) {
 	}
 	logger.Trace("got all tags")
 
-	if l.allowRegex != nil || len(l.ignore) > 0 {
+	if l.allowRegex != nil || l.denyRegex != nil || len(l.ignore) > 0 {
 		matchedTags := make([]string, 0, len(tags))
 		for _, tag := range tags {
-			if allowsTag(tag, l.allowRegex) && !ignoresTag(tag, l.ignore) {
+			if allowsTag(tag, l.allowRegex) &&
+				!deniesTag(tag, l.denyRegex) &&
+				!ignoresTag(tag, l.ignore) {
 				matchedTags = append(matchedTags, tag)
 			}
 		}
@@ -1,29 +1,108 @@
 package image
 
 import (
+	"context"
 	"regexp"
 	"testing"
 
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/stretchr/testify/require"
 )
 
 func TestNewLexicalSelector(t *testing.T) {
 	testAllowRegex := regexp.MustCompile("fake-regex")
+	testDenyRegex := regexp.MustCompile("fake-deny-regex")
 	testIgnore := []string{"fake-ignore"}
 	testPlatform := &platformConstraint{
 		os:   "linux",
 		arch: "amd64",
 	}
 	testDiscoveryLimit := 10
-	s := newLexicalSelector(nil, testAllowRegex, testIgnore, testPlatform, testDiscoveryLimit)
+	s := newLexicalSelector(
+		nil,
+		testAllowRegex,
+		testDenyRegex,
+		testIgnore,
+		testPlatform,
+		testDiscoveryLimit,
+	)
 	selector, ok := s.(*lexicalSelector)
 	require.True(t, ok)
 	require.Equal(t, testAllowRegex, selector.allowRegex)
+	require.Equal(t, testDenyRegex, selector.denyRegex)
 	require.Equal(t, testIgnore, selector.ignore)
 	require.Equal(t, testPlatform, selector.platform)
 	require.Equal(t, testDiscoveryLimit, selector.discoveryLimit)
 }
 
+func TestLexicalSelectorSelectTags(t *testing.T) {
+	testRepoRef, err := name.ParseReference("fake-url")
+	require.NoError(t, err)
+	testTags := []string{
+		"main-1",
+		"main-2",
+		"main-3-rc1",
+		"feature-1",
+		"feature-2-rc1",
+	}
+	testCases := []struct {
+		name       string
+		allowRegex *regexp.Regexp
+		denyRegex  *regexp.Regexp
+		expected   []string
+	}{
+		{
+			name:     "empty patterns",
+			expected: []string{"main-3-rc1", "main-2", "main-1", "feature-2-rc1", "feature-1"},
+		},
+		{
+			name:       "allow pattern only",
+			allowRegex: regexp.MustCompile("^main-"),
+			expected:   []string{"main-3-rc1", "main-2", "main-1"},
+		},
+		{
+			name:      "deny pattern only",
+			denyRegex: regexp.MustCompile("-rc"),
+			expected:  []string{"main-2", "main-1", "feature-1"},
+		},
+		{
+			name:       "overlapping allow and deny patterns",
+			allowRegex: regexp.MustCompile("^main-"),
+			denyRegex:  regexp.MustCompile("-rc"),
+			expected:   []string{"main-2", "main-1"},
+		},
+		{
+			name:       "deny pattern excludes every allowed tag",
+			allowRegex: regexp.MustCompile("^main-"),
+			denyRegex:  regexp.MustCompile("^main-"),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := newLexicalSelector(
+				&repositoryClient{
+					repoRef: testRepoRef,
+					remoteListFn: func(
+						name.Repository,
+						...remote.Option,
+					) ([]string, error) {
+						return testTags, nil
+					},
+				},
+				testCase.allowRegex,
+				testCase.denyRegex,
+				nil,
+				nil,
+				0,
+			)
+			tags, err := s.(*lexicalSelector).selectTags(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, tags)
+		})
+	}
+}
+
 func TestSortTagsLexically(t *testing.T) {
 	tags := []string{"a", "z", "b", "y", "c", "x", "d", "w", "e", "v"}
 	sortTagsLexically(tags)
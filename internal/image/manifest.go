@@ -0,0 +1,21 @@
+package image
+
+import "context"
+
+// CheckManifest verifies that the manifest of the image identified by the
+// provided tag or digest can be retrieved from the specified repository using
+// the provided credentials, which may be nil. An error is returned if it
+// cannot, for instance because the image does not exist or because the
+// credentials do not grant access to it.
+func CheckManifest(
+	ctx context.Context,
+	repoURL string,
+	tagOrDigest string,
+	creds *Credentials,
+) error {
+	client, err := newRepositoryClient(repoURL, false, nil, creds)
+	if err != nil {
+		return err
+	}
+	return client.headManifest(ctx, tagOrDigest)
+}
@@ -0,0 +1,84 @@
+package image
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckManifest(t *testing.T) {
+	server := httptest.NewServer(ggcrregistry.New())
+	t.Cleanup(server.Close)
+	repoURL := strings.TrimPrefix(server.URL, "http://") + "/fake-image"
+
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	ref, err := name.ParseReference(repoURL + ":v1.0.0")
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, img))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		repoURL     string
+		tagOrDigest string
+		assertions  func(*testing.T, error)
+	}{
+		{
+			name:        "pullable image by tag",
+			repoURL:     repoURL,
+			tagOrDigest: "v1.0.0",
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:        "pullable image by digest",
+			repoURL:     repoURL,
+			tagOrDigest: digest.String(),
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:        "unpullable image with unknown tag",
+			repoURL:     repoURL,
+			tagOrDigest: "v2.0.0",
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, `error getting manifest for "v2.0.0"`)
+			},
+		},
+		{
+			name:        "unpullable image from unknown repository",
+			repoURL:     strings.TrimPrefix(server.URL, "http://") + "/unknown-image",
+			tagOrDigest: "v1.0.0",
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, `error getting manifest for "v1.0.0"`)
+			},
+		},
+		{
+			name:        "invalid repository URL",
+			repoURL:     "INVALID::",
+			tagOrDigest: "v1.0.0",
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "error parsing image repo URL")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				CheckManifest(context.Background(), testCase.repoURL, testCase.tagOrDigest, nil),
+			)
+		})
+	}
+}
@@ -16,9 +16,11 @@ import (
 type newestBuildSelector struct {
 	repoClient     *repositoryClient
 	allowRegex     *regexp.Regexp
+	denyRegex      *regexp.Regexp
 	ignore         []string
 	platform       *platformConstraint
 	discoveryLimit int
+	byBuildDate    bool
 }
 
 // newNewestBuildSelector returns an implementation of the Selector interface
@@ -26,16 +28,20 @@ type newestBuildSelector struct {
 func newNewestBuildSelector(
 	repoClient *repositoryClient,
 	allowRegex *regexp.Regexp,
+	denyRegex *regexp.Regexp,
 	ignore []string,
 	platform *platformConstraint,
 	discoveryLimit int,
+	byBuildDate bool,
 ) Selector {
 	return &newestBuildSelector{
 		repoClient:     repoClient,
 		allowRegex:     allowRegex,
+		denyRegex:      denyRegex,
 		ignore:         ignore,
 		platform:       platform,
 		discoveryLimit: discoveryLimit,
+		byBuildDate:    byBuildDate,
 	}
 }
 
@@ -47,6 +53,7 @@ func (n *newestBuildSelector) Select(ctx context.Context) ([]Image, error) {
 		"selectionStrategy", SelectionStrategyNewestBuild,
 		"platformConstrained", n.platform != nil,
 		"discoveryLimit", n.discoveryLimit,
+		"byBuildDate", n.byBuildDate,
 	)
 	logger.Trace("discovering images")
 
@@ -101,6 +108,9 @@ func (n *newestBuildSelector) Select(ctx context.Context) ([]Image, error) {
 		}
 
 		discoveredImage.Tag = image.Tag
+		if n.byBuildDate {
+			discoveredImage.CreatedAt = image.CreatedAt
+		}
 		discoveredImages = append(discoveredImages, *discoveredImage)
 
 		logger.Trace(
@@ -136,10 +146,12 @@ func (n *newestBuildSelector) selectImages(ctx context.Context) ([]Image, error)
 	}
 	logger.Trace("got all tags")
 
-	if n.allowRegex != nil || len(n.ignore) > 0 {
+	if n.allowRegex != nil || n.denyRegex != nil || len(n.ignore) > 0 {
 		matchedTags := make([]string, 0, len(tags))
 		for _, tag := range tags {
-			if allowsTag(tag, n.allowRegex) && !ignoresTag(tag, n.ignore) {
+			if allowsTag(tag, n.allowRegex) &&
+				!deniesTag(tag, n.denyRegex) &&
+				!ignoresTag(tag, n.ignore) {
 				matchedTags = append(matchedTags, tag)
 			}
 		}
@@ -164,6 +176,13 @@ func (n *newestBuildSelector) selectImages(ctx context.Context) ([]Image, error)
 		return nil, nil
 	}
 
+	if n.byBuildDate {
+		if images = withBuildDates(images); len(images) == 0 {
+			logger.Trace("no images have a build date")
+			return nil, nil
+		}
+	}
+
 	logger.Trace("sorting images by date")
 	sortImagesByDate(images)
 	return images, nil
@@ -244,6 +263,20 @@ func (n *newestBuildSelector) getImagesByTags(
 	return images, nil
 }
 
+// withBuildDates returns those of the provided images that have a build date,
+// with their CreatedAt fields set to it so that they are ordered by their build
+// dates. The provided slice is reused.
+func withBuildDates(images []Image) []Image {
+	dated := images[:0]
+	for _, image := range images {
+		if image.BuildDate != nil {
+			image.CreatedAt = image.BuildDate
+			dated = append(dated, image)
+		}
+	}
+	return dated
+}
+
 // sortImagesByDate sorts the provided images in place, in chronologically
 // descending order, breaking ties lexically by tag.
 func sortImagesByDate(images []Image) {
@@ -1,28 +1,124 @@
 package image
 
 import (
+	"context"
 	"regexp"
 	"testing"
 	"time"
 
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/stretchr/testify/require"
 )
 
 func TestNewNewestBuildSelector(t *testing.T) {
 	testAllowRegex := regexp.MustCompile("fake-regex")
+	testDenyRegex := regexp.MustCompile("fake-deny-regex")
 	testIgnore := []string{"fake-ignore"}
 	testPlatform := &platformConstraint{
 		os:   "linux",
 		arch: "amd64",
 	}
 	testDiscoveryLimit := 10
-	s := newNewestBuildSelector(nil, testAllowRegex, testIgnore, testPlatform, testDiscoveryLimit)
+	s := newNewestBuildSelector(
+		nil,
+		testAllowRegex,
+		testDenyRegex,
+		testIgnore,
+		testPlatform,
+		testDiscoveryLimit,
+		true,
+	)
 	selector, ok := s.(*newestBuildSelector)
 	require.True(t, ok)
 	require.Equal(t, testAllowRegex, selector.allowRegex)
+	require.Equal(t, testDenyRegex, selector.denyRegex)
 	require.Equal(t, testIgnore, selector.ignore)
 	require.Equal(t, testPlatform, selector.platform)
 	require.Equal(t, testDiscoveryLimit, selector.discoveryLimit)
+	require.True(t, selector.byBuildDate)
+}
+
+func TestNewestBuildSelectorSelectByBuildDate(t *testing.T) {
+	// The creation dates recorded in the images' configurations are in the
+	// opposite order of their build dates and must not affect the result.
+	manifests := map[string]struct {
+		created   time.Time
+		buildDate string
+	}{
+		"alpha": {
+			created:   time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC),
+			buildDate: "2024-03-01T12:00:00Z",
+		},
+		"bravo": {
+			created:   time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC),
+			buildDate: "2024-03-02T09:00:00+02:00",
+		},
+		"charlie": {
+			created:   time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC),
+			buildDate: "20240302T080000Z",
+		},
+		"delta": {
+			created:   time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+			buildDate: "2024-03-01T18:30:00.5-0000",
+		},
+		"echo": {
+			// No build date
+			created: time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	repoClient := &repositoryClient{
+		registry: &registry{name: "fake-registry"},
+		repoURL:  "fake-url",
+		remoteListFn: func(name.Repository, ...remote.Option) ([]string, error) {
+			return []string{"alpha", "bravo", "charlie", "delta", "echo"}, nil
+		},
+	}
+	repoClient.repoRef, _ = name.ParseReference(repoClient.repoURL)
+	repoClient.remoteGetFn = func(ref name.Reference, _ ...remote.Option) (*remote.Descriptor, error) {
+		return &remote.Descriptor{
+			Descriptor: v1.Descriptor{
+				Digest: v1.Hash{Algorithm: "sha256", Hex: ref.Identifier()},
+			},
+		}, nil
+	}
+	repoClient.getImageFromRemoteDescFn = func(
+		_ context.Context,
+		desc *remote.Descriptor,
+		_ *platformConstraint,
+	) (*Image, error) {
+		m := manifests[desc.Digest.Hex]
+		manifest := &v1.Manifest{}
+		if m.buildDate != "" {
+			manifest.Annotations = map[string]string{buildDateAnnotation: m.buildDate}
+		}
+		return repoClient.getImageFromV1Image(
+			desc.Digest.String(),
+			&mockImage{
+				configFile: &v1.ConfigFile{Created: v1.Time{Time: m.created}},
+				manifest:   manifest,
+			},
+			nil,
+		)
+	}
+
+	images, err := newNewestBuildSelector(
+		repoClient, nil, nil, nil, nil, 0, true,
+	).Select(context.Background())
+	require.NoError(t, err)
+
+	tags := make([]string, len(images))
+	for i, img := range images {
+		tags[i] = img.Tag
+	}
+	require.Equal(t, []string{"charlie", "bravo", "delta", "alpha"}, tags)
+	require.Equal(
+		t,
+		time.Date(2024, time.March, 2, 8, 0, 0, 0, time.UTC),
+		*images[0].CreatedAt,
+	)
 }
 
 func TestSortImagesByDate(t *testing.T) {
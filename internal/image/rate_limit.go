@@ -0,0 +1,108 @@
+package image
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"golang.org/x/time/rate"
+)
+
+// RateLimit is a limit on the number of requests that may be made to an image
+// registry within a period of time.
+type RateLimit struct {
+	// Requests is the number of requests permitted within Period.
+	Requests int
+	// Period is the period of time within which Requests are permitted.
+	Period time.Duration
+}
+
+// limiter returns a new rate.Limiter that enforces the RateLimit. Up to
+// Requests requests are permitted in a burst, after which permission to make
+// further requests is regained evenly over the course of Period.
+func (r RateLimit) limiter() *rate.Limiter {
+	return rate.NewLimiter(r.limit(), r.Requests)
+}
+
+// limit returns the rate at which permission to make requests is regained.
+func (r RateLimit) limit() rate.Limit {
+	return rate.Every(r.Period / time.Duration(r.Requests))
+}
+
+// RateLimits maps the hostnames of image registries to the rate limits of
+// requests to them.
+type RateLimits map[string]RateLimit
+
+// Decode implements envconfig.Decoder. The value is a comma-separated list of
+// entries of the form <registry>=<requests>/<period>, where period is any
+// duration understood by time.ParseDuration. For example:
+// docker.io=100/6h,ghcr.io=1000/1h. Registry hostnames are normalized, so
+// that, for instance, docker.io and index.docker.io both refer to Docker Hub.
+func (r *RateLimits) Decode(value string) error {
+	limits := RateLimits{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		host, limit, ok := strings.Cut(entry, "=")
+		if !ok {
+			return fmt.Errorf(
+				"registry rate limit %q is not of the form <registry>=<requests>/<period>",
+				entry,
+			)
+		}
+		reg, err := name.NewRegistry(strings.TrimSpace(host))
+		if err != nil {
+			return fmt.Errorf("error parsing registry of rate limit %q: %w", entry, err)
+		}
+		requestsStr, periodStr, ok := strings.Cut(strings.TrimSpace(limit), "/")
+		if !ok {
+			return fmt.Errorf(
+				"registry rate limit %q is not of the form <registry>=<requests>/<period>",
+				entry,
+			)
+		}
+		requests, err := strconv.Atoi(requestsStr)
+		if err != nil || requests <= 0 {
+			return fmt.Errorf(
+				"number of requests of registry rate limit %q must be a positive integer",
+				entry,
+			)
+		}
+		period, err := time.ParseDuration(periodStr)
+		if err != nil || period <= 0 {
+			return fmt.Errorf(
+				"period of registry rate limit %q must be a positive duration",
+				entry,
+			)
+		}
+		limits[reg.RegistryStr()] = RateLimit{
+			Requests: requests,
+			Period:   period,
+		}
+	}
+	if len(limits) == 0 {
+		limits = nil
+	}
+	*r = limits
+	return nil
+}
+
+// SetRateLimits overrides the default rate limits of requests to the image
+// registries the provided rate limits are specified for. Registries that have
+// already been accessed are updated in place, so requests that are already
+// waiting for permission are subject to the new rate limits.
+func SetRateLimits(limits RateLimits) {
+	registriesMu.Lock()
+	defer registriesMu.Unlock()
+	rateLimits = limits
+	for imagePrefix, limit := range limits {
+		if reg, ok := registries[imagePrefix]; ok {
+			reg.rateLimiter.SetLimit(limit.limit())
+			reg.rateLimiter.SetBurst(limit.Requests)
+		}
+	}
+}
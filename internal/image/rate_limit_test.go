@@ -0,0 +1,149 @@
+package image
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestRateLimitsDecode(t *testing.T) {
+	testCases := []struct {
+		name       string
+		value      string
+		assertions func(*testing.T, RateLimits, error)
+	}{
+		{
+			name:  "empty",
+			value: "",
+			assertions: func(t *testing.T, limits RateLimits, err error) {
+				require.NoError(t, err)
+				require.Nil(t, limits)
+			},
+		},
+		{
+			name:  "missing registry",
+			value: "100/6h",
+			assertions: func(t *testing.T, _ RateLimits, err error) {
+				require.ErrorContains(t, err, "is not of the form")
+			},
+		},
+		{
+			name:  "missing period",
+			value: "docker.io=100",
+			assertions: func(t *testing.T, _ RateLimits, err error) {
+				require.ErrorContains(t, err, "is not of the form")
+			},
+		},
+		{
+			name:  "invalid number of requests",
+			value: "docker.io=0/6h",
+			assertions: func(t *testing.T, _ RateLimits, err error) {
+				require.ErrorContains(t, err, "must be a positive integer")
+			},
+		},
+		{
+			name:  "invalid period",
+			value: "docker.io=100/forever",
+			assertions: func(t *testing.T, _ RateLimits, err error) {
+				require.ErrorContains(t, err, "must be a positive duration")
+			},
+		},
+		{
+			name:  "success",
+			value: "docker.io=100/6h, ghcr.io=1000/1h,",
+			assertions: func(t *testing.T, limits RateLimits, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					RateLimits{
+						// Docker Hub's hostname is normalized
+						"index.docker.io": {Requests: 100, Period: 6 * time.Hour},
+						"ghcr.io":         {Requests: 1000, Period: time.Hour},
+					},
+					limits,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var limits RateLimits
+			err := limits.Decode(testCase.value)
+			testCase.assertions(t, limits, err)
+		})
+	}
+}
+
+func TestSetRateLimits(t *testing.T) {
+	const testPrefix = "rate-limited.example.com"
+	existingReg := getRegistry("existing.example.com")
+	t.Cleanup(func() {
+		SetRateLimits(nil)
+		registriesMu.Lock()
+		defer registriesMu.Unlock()
+		delete(registries, testPrefix)
+		delete(registries, existingReg.imagePrefix)
+	})
+
+	SetRateLimits(RateLimits{
+		existingReg.imagePrefix: {Requests: 100, Period: 6 * time.Hour},
+		testPrefix:              {Requests: 10, Period: time.Minute},
+	})
+
+	// Registries that were already accessed are updated in place
+	require.Equal(t, rate.Every(216*time.Second), existingReg.rateLimiter.Limit())
+	require.Equal(t, 100, existingReg.rateLimiter.Burst())
+
+	// Registries that are accessed later are subject to the rate limit
+	reg := getRegistry(testPrefix)
+	require.Equal(t, rate.Every(6*time.Second), reg.rateLimiter.Limit())
+	require.Equal(t, 10, reg.rateLimiter.Burst())
+
+	// Other registries are subject to the default rate limit
+	reg = getRegistry("unlimited.example.com")
+	t.Cleanup(func() {
+		registriesMu.Lock()
+		defer registriesMu.Unlock()
+		delete(registries, reg.imagePrefix)
+	})
+	require.Equal(t, rate.Limit(20), reg.rateLimiter.Limit())
+}
+
+func TestRateLimitedRoundTripper(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+	t.Cleanup(server.Close)
+
+	roundTripper := &rateLimitedRoundTripper{
+		limiter:              RateLimit{Requests: 2, Period: time.Hour}.limiter(),
+		internalRoundTripper: http.DefaultTransport,
+	}
+	newRequest := func(ctx context.Context) *http.Request {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		return req
+	}
+
+	// Requests within the limit are made immediately
+	for i := 0; i < 2; i++ {
+		res, err := roundTripper.RoundTrip(newRequest(context.Background()))
+		require.NoError(t, err)
+		require.NoError(t, res.Body.Close())
+		require.Equal(t, http.StatusOK, res.StatusCode)
+	}
+
+	// Requests exceeding the limit are held back until permitted, so a request
+	// that cannot be permitted before its context expires fails
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err := roundTripper.RoundTrip(newRequest(ctx))
+	require.ErrorContains(t, err, "error waiting for registry rate limit")
+}
@@ -6,7 +6,7 @@ import (
 
 	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/patrickmn/go-cache"
-	"go.uber.org/ratelimit"
+	"golang.org/x/time/rate"
 )
 
 // dockerRegistry is registry configuration for Docker Hub.
@@ -18,7 +18,7 @@ var dockerRegistry = &registry{
 		30*time.Minute, // Default ttl for each entry
 		time.Hour,      // Cleanup interval
 	),
-	rateLimiter: ratelimit.New(10),
+	rateLimiter: rate.NewLimiter(10, 10),
 }
 
 var (
@@ -29,7 +29,11 @@ var (
 		"":                         dockerRegistry,
 		dockerRegistry.imagePrefix: dockerRegistry,
 	}
-	// registriesMu is for preventing concurrent access to the registries map.
+	// rateLimits holds rate limits, indexed by image prefix, that override the
+	// default rate limits of registries.
+	rateLimits RateLimits
+	// registriesMu is for preventing concurrent access to the registries and
+	// rateLimits maps.
 	registriesMu sync.Mutex
 )
 
@@ -40,11 +44,17 @@ type registry struct {
 	imagePrefix      string
 	defaultNamespace string
 	imageCache       *cache.Cache
-	rateLimiter      ratelimit.Limiter
+	rateLimiter      *rate.Limiter
 }
 
-// newRegistry initializes and returns a new registry.
+// newRegistry initializes and returns a new registry. Requests to the registry
+// are limited by the rate limit configured for its image prefix, if any, and
+// otherwise by a default rate limit.
 func newRegistry(imagePrefix string) *registry {
+	rateLimiter := rate.NewLimiter(20, 20)
+	if limit, ok := rateLimits[imagePrefix]; ok {
+		rateLimiter = limit.limiter()
+	}
 	return &registry{
 		name:        imagePrefix,
 		imagePrefix: imagePrefix,
@@ -52,8 +62,7 @@ func newRegistry(imagePrefix string) *registry {
 			30*time.Minute, // Default ttl for each entry
 			time.Hour,      // Cleanup interval
 		),
-		// TODO: Make this configurable.
-		rateLimiter: ratelimit.New(20),
+		rateLimiter: rateLimiter,
 	}
 }
 
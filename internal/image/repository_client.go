@@ -3,9 +3,11 @@ package image
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/google/go-containerregistry/pkg/authn"
@@ -15,8 +17,8 @@ import (
 	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/patrickmn/go-cache"
-	"go.uber.org/ratelimit"
 	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
 
 	"github.com/akuity/kargo/internal/logging"
 )
@@ -30,8 +32,25 @@ const (
 	maxMetadataConcurrency = 1000
 
 	unknown = "unknown"
+
+	// buildDateAnnotation is the key of the pre-defined OCI annotation that
+	// records the date and time at which an image was built.
+	buildDateAnnotation = "org.opencontainers.image.created"
 )
 
+// buildDateLayouts are the layouts in which the value of the
+// buildDateAnnotation is parsed, in order of preference. The OCI image spec
+// calls for RFC 3339, but other ISO 8601 representations are common in the
+// wild. Fractional seconds are accepted by all layouts that include seconds,
+// and values without a time zone are assumed to be in UTC.
+var buildDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05",
+	"20060102T150405Z0700",
+	"2006-01-02",
+}
+
 var metaSem = semaphore.NewWeighted(maxMetadataConcurrency)
 
 // repositoryClient is a client for retrieving information from a specific image
@@ -78,14 +97,19 @@ type repositoryClient struct {
 	remoteListFn func(name.Repository, ...remote.Option) ([]string, error)
 
 	remoteGetFn func(name.Reference, ...remote.Option) (*remote.Descriptor, error)
+
+	remoteHeadFn func(name.Reference, ...remote.Option) (*v1.Descriptor, error)
 }
 
 // newRepositoryClient parses the provided repository URL to infer registry
 // information and image name. This information is used to initialize and
-// return a new repository client.
+// return a new repository client. If a PEM-encoded CA bundle is provided, the
+// repository's TLS certificate is verified against the certificates it holds
+// in addition to the system's trusted CAs.
 func newRepositoryClient(
 	repoURL string,
 	insecureSkipTLSVerify bool,
+	caBundle []byte,
 	creds *Credentials,
 ) (*repositoryClient, error) {
 	repoRef, err := name.ParseReference(repoURL)
@@ -94,20 +118,24 @@ func newRepositoryClient(
 	}
 	reg := getRegistry(repoRef.Context().RegistryStr())
 
-	httpTransport := cleanhttp.DefaultTransport()
-	if insecureSkipTLSVerify {
-		httpTransport.TLSClientConfig = &tls.Config{
-			InsecureSkipVerify: insecureSkipTLSVerify, // nolint: gosec
-		}
+	httpTransport, err := newHTTPTransport(insecureSkipTLSVerify, caBundle)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error configuring TLS for image repo URL %s: %w",
+			repoURL,
+			err,
+		)
 	}
 
 	if creds == nil {
 		creds = &Credentials{}
 	}
-	var auth authn.Authenticator = &authn.Basic{
-		Username: creds.Username,
-		Password: creds.Password,
-	}
+	auth := authn.FromConfig(authn.AuthConfig{
+		Username:      creds.Username,
+		Password:      creds.Password,
+		IdentityToken: creds.IdentityToken,
+		RegistryToken: creds.RegistryToken,
+	})
 
 	r := &repositoryClient{
 		registry: reg,
@@ -129,10 +157,44 @@ func newRepositoryClient(
 	r.getImageFromV1ImageFn = r.getImageFromV1Image
 	r.remoteListFn = remote.List
 	r.remoteGetFn = remote.Get
+	r.remoteHeadFn = remote.Head
 
 	return r, nil
 }
 
+// newHTTPTransport returns a new HTTP transport for connecting to an image
+// registry. If insecureSkipTLSVerify is true, the registry's TLS certificate is
+// not verified at all. Otherwise, if a PEM-encoded CA bundle is provided, the
+// registry's TLS certificate is verified against the certificates it holds in
+// addition to the system's trusted CAs.
+func newHTTPTransport(
+	insecureSkipTLSVerify bool,
+	caBundle []byte,
+) (*http.Transport, error) {
+	httpTransport := cleanhttp.DefaultTransport()
+	if insecureSkipTLSVerify {
+		httpTransport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: insecureSkipTLSVerify, // nolint: gosec
+		}
+		return httpTransport, nil
+	}
+	if len(caBundle) == 0 {
+		return httpTransport, nil
+	}
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+	if !rootCAs.AppendCertsFromPEM(caBundle) {
+		return nil, errors.New("CA bundle contains no valid PEM-encoded certificates")
+	}
+	httpTransport.TLSClientConfig = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    rootCAs,
+	}
+	return httpTransport, nil
+}
+
 func (r *repositoryClient) getTags(ctx context.Context) ([]string, error) {
 	opts := append(r.remoteOptions, remote.WithContext(ctx))
 	tags, err := r.remoteListFn(r.repoRef.Context(), opts...)
@@ -142,6 +204,24 @@ func (r *repositoryClient) getTags(ctx context.Context) ([]string, error) {
 	return tags, nil
 }
 
+// headManifest verifies that the manifest of the image identified by the
+// provided tag or digest can be retrieved from the repository. Only the
+// manifest's descriptor is retrieved.
+func (r *repositoryClient) headManifest(ctx context.Context, tagOrDigest string) error {
+	var ref name.Reference = r.repoRef.Context().Tag(tagOrDigest)
+	if strings.Contains(tagOrDigest, ":") {
+		ref = r.repoRef.Context().Digest(tagOrDigest)
+	}
+	opts := append(r.remoteOptions, remote.WithContext(ctx))
+	if _, err := r.remoteHeadFn(ref, opts...); err != nil {
+		return fmt.Errorf(
+			"error getting manifest for %q from repo URL %s: %w",
+			tagOrDigest, r.repoURL, err,
+		)
+	}
+	return nil
+}
+
 // getImageByTag retrieves an Image by tag. This function uses no cache since
 // tags can be mutable.
 func (r *repositoryClient) getImageByTag(
@@ -328,6 +408,11 @@ func (r *repositoryClient) getImageFromV1ImageIndex(
 			)
 		}
 		img.Digest = digest
+		// A build date recorded by the index takes precedence over one recorded
+		// by the image itself.
+		if buildDate := getBuildDate(idxManifest.Annotations); buildDate != nil {
+			img.BuildDate = buildDate
+		}
 		return img, nil
 	}
 
@@ -335,8 +420,11 @@ func (r *repositoryClient) getImageFromV1ImageIndex(
 
 	// Manifest lists and indices don't have a createdAt timestamp, and we had no
 	// platform constraint, so we'll follow ALL the references to find the most
-	// recently pushed manifest's createdAt timestamp.
+	// recently pushed manifest's createdAt timestamp. Unless the index records a
+	// build date of its own, the same goes for the build date.
 	var createdAt *time.Time
+	buildDate := getBuildDate(idxManifest.Annotations)
+	idxHasBuildDate := buildDate != nil
 	for _, ref := range refs {
 		img, err := r.getImageByDigestFn(ctx, ref.Digest.String(), platform)
 		if err != nil {
@@ -351,10 +439,15 @@ func (r *repositoryClient) getImageFromV1ImageIndex(
 		if createdAt == nil || img.CreatedAt.After(*createdAt) {
 			createdAt = img.CreatedAt
 		}
+		if !idxHasBuildDate && img.BuildDate != nil &&
+			(buildDate == nil || img.BuildDate.After(*buildDate)) {
+			buildDate = img.BuildDate
+		}
 	}
 	return &Image{
 		Digest:    digest,
 		CreatedAt: createdAt,
+		BuildDate: buildDate,
 	}, nil
 }
 
@@ -377,23 +470,52 @@ func (r *repositoryClient) getImageFromV1Image(
 		// This image doesn't match the platform constraint.
 		return nil, nil
 	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error getting manifest for image with digest %s: %w",
+			digest, err,
+		)
+	}
 	return &Image{
 		Digest:    digest,
 		CreatedAt: &cfg.Created.Time,
+		BuildDate: getBuildDate(manifest.Annotations),
 	}, nil
 }
 
+// getBuildDate returns the build date recorded by the buildDateAnnotation in
+// the provided manifest annotations. It returns nil if the annotation is
+// absent or its value cannot be parsed.
+func getBuildDate(annotations map[string]string) *time.Time {
+	value := strings.TrimSpace(annotations[buildDateAnnotation])
+	if value == "" {
+		return nil
+	}
+	for _, layout := range buildDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			t = t.UTC()
+			return &t
+		}
+	}
+	return nil
+}
+
 // rateLimitedRoundTripper is a rate limited implementation of
 // http.RoundTripper.
 type rateLimitedRoundTripper struct {
-	limiter              ratelimit.Limiter
+	limiter              *rate.Limiter
 	internalRoundTripper http.RoundTripper
 }
 
-// RoundTrip implements the http.RoundTripper interface.
+// RoundTrip implements the http.RoundTripper interface. It blocks until the
+// rate limit permits the request to be made or the request's context is
+// canceled, whichever comes first.
 func (r *rateLimitedRoundTripper) RoundTrip(
 	req *http.Request,
 ) (*http.Response, error) {
-	r.limiter.Take()
+	if err := r.limiter.Wait(req.Context()); err != nil {
+		return nil, fmt.Errorf("error waiting for registry rate limit: %w", err)
+	}
 	return r.internalRoundTripper.RoundTrip(req)
 }
@@ -2,7 +2,10 @@ package image
 
 import (
 	"context"
+	"encoding/pem"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -16,7 +19,7 @@ import (
 )
 
 func TestNewRepository(t *testing.T) {
-	client, err := newRepositoryClient("debian", false, nil)
+	client, err := newRepositoryClient("debian", false, nil, nil)
 	require.NoError(t, err)
 	require.NotNil(t, client)
 	require.NotNil(t, client.registry)
@@ -30,6 +33,74 @@ func TestNewRepository(t *testing.T) {
 	require.NotNil(t, client.getImageFromV1ImageFn)
 	require.NotNil(t, client.remoteListFn)
 	require.NotNil(t, client.remoteGetFn)
+	require.NotNil(t, client.remoteHeadFn)
+}
+
+func TestNewHTTPTransport(t *testing.T) {
+	server := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+	t.Cleanup(server.Close)
+	serverCA := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	})
+
+	testCases := []struct {
+		name                  string
+		insecureSkipTLSVerify bool
+		caBundle              []byte
+		assertions            func(*testing.T, *http.Transport, error)
+	}{
+		{
+			name:     "invalid CA bundle",
+			caBundle: []byte("not a certificate"),
+			assertions: func(t *testing.T, _ *http.Transport, err error) {
+				require.ErrorContains(t, err, "CA bundle contains no valid PEM-encoded certificates")
+			},
+		},
+		{
+			name: "no CA bundle; certificate not trusted",
+			assertions: func(t *testing.T, transport *http.Transport, err error) {
+				require.NoError(t, err)
+				_, err = (&http.Client{Transport: transport}).Get(server.URL)
+				require.ErrorContains(t, err, "certificate signed by unknown authority")
+			},
+		},
+		{
+			name:     "CA bundle trusts certificate",
+			caBundle: serverCA,
+			assertions: func(t *testing.T, transport *http.Transport, err error) {
+				require.NoError(t, err)
+				res, err := (&http.Client{Transport: transport}).Get(server.URL)
+				require.NoError(t, err)
+				defer res.Body.Close()
+				require.Equal(t, http.StatusOK, res.StatusCode)
+			},
+		},
+		{
+			name:                  "insecure skip TLS verify",
+			insecureSkipTLSVerify: true,
+			assertions: func(t *testing.T, transport *http.Transport, err error) {
+				require.NoError(t, err)
+				res, err := (&http.Client{Transport: transport}).Get(server.URL)
+				require.NoError(t, err)
+				defer res.Body.Close()
+				require.Equal(t, http.StatusOK, res.StatusCode)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			transport, err := newHTTPTransport(
+				testCase.insecureSkipTLSVerify,
+				testCase.caBundle,
+			)
+			testCase.assertions(t, transport, err)
+		})
+	}
 }
 
 func TestGetImageByTag(t *testing.T) {
@@ -239,6 +310,66 @@ func TestGetImageByDigest(t *testing.T) {
 	}
 }
 
+func TestHeadManifest(t *testing.T) {
+	const testRepoURL = "fake-url"
+	const testDigest = "sha256:b2487a28589657b318e0d63110056e11564e73b9fd3ec4c4afba5542f9d07d46"
+
+	testRepoRef, err := name.ParseReference(testRepoURL)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		tagOrDigest string
+		headErr     error
+		assertions  func(*testing.T, name.Reference, error)
+	}{
+		{
+			name:        "error getting manifest",
+			tagOrDigest: "fake-tag",
+			headErr:     errors.New("something went wrong"),
+			assertions: func(t *testing.T, _ name.Reference, err error) {
+				require.ErrorContains(t, err, `error getting manifest for "fake-tag"`)
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name:        "success by tag",
+			tagOrDigest: "fake-tag",
+			assertions: func(t *testing.T, ref name.Reference, err error) {
+				require.NoError(t, err)
+				require.IsType(t, name.Tag{}, ref)
+				require.Equal(t, "fake-tag", ref.Identifier())
+			},
+		},
+		{
+			name:        "success by digest",
+			tagOrDigest: testDigest,
+			assertions: func(t *testing.T, ref name.Reference, err error) {
+				require.NoError(t, err)
+				require.IsType(t, name.Digest{}, ref)
+				require.Equal(t, testDigest, ref.Identifier())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var headRef name.Reference
+			client := &repositoryClient{
+				repoRef: testRepoRef,
+				remoteHeadFn: func(
+					ref name.Reference,
+					_ ...remote.Option,
+				) (*v1.Descriptor, error) {
+					headRef = ref
+					return &v1.Descriptor{}, testCase.headErr
+				},
+			}
+			err := client.headManifest(context.Background(), testCase.tagOrDigest)
+			testCase.assertions(t, headRef, err)
+		})
+	}
+}
+
 func TestGetImageFromRemoteDesc(t *testing.T) {
 	testImage := Image{
 		CreatedAt: ptr.To(time.Now().UTC()),
@@ -514,6 +645,85 @@ func TestImageFromV1ImageIndex(t *testing.T) {
 				require.Equal(t, testImage, *img)
 			},
 		},
+		{
+			name: "without platform constraint, newest build date of images",
+			idx: &mockImageIndex{
+				indexManifest: &v1.IndexManifest{
+					Manifests: []v1.Descriptor{
+						{
+							Digest: v1.Hash{Algorithm: "sha256", Hex: "older"},
+							Platform: &v1.Platform{
+								OS:           "linux",
+								Architecture: "amd64",
+							},
+						},
+						{
+							Digest: v1.Hash{Algorithm: "sha256", Hex: "newer"},
+							Platform: &v1.Platform{
+								OS:           "linux",
+								Architecture: "arm64",
+							},
+						},
+					},
+				},
+			},
+			client: &repositoryClient{
+				getImageByDigestFn: func(
+					_ context.Context, digest string, _ *platformConstraint,
+				) (*Image, error) {
+					buildDate := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+					if digest == "sha256:newer" {
+						buildDate = buildDate.Add(time.Hour)
+					}
+					return &Image{
+						CreatedAt: testImage.CreatedAt,
+						BuildDate: &buildDate,
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, img *Image, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					ptr.To(time.Date(2024, time.March, 1, 13, 0, 0, 0, time.UTC)),
+					img.BuildDate,
+				)
+			},
+		},
+		{
+			name: "without platform constraint, build date of index",
+			idx: &mockImageIndex{
+				indexManifest: &v1.IndexManifest{
+					Annotations: map[string]string{
+						buildDateAnnotation: "2024-02-01T00:00:00Z",
+					},
+					Manifests: []v1.Descriptor{{
+						Platform: &v1.Platform{
+							OS:           "linux",
+							Architecture: "amd64",
+						},
+					}},
+				},
+			},
+			client: &repositoryClient{
+				getImageByDigestFn: func(
+					context.Context, string, *platformConstraint,
+				) (*Image, error) {
+					return &Image{
+						CreatedAt: testImage.CreatedAt,
+						BuildDate: ptr.To(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)),
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, img *Image, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					ptr.To(time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)),
+					img.BuildDate,
+				)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -542,6 +752,7 @@ func TestGetImageFromV1Image(t *testing.T) {
 			name: "no platform constraint",
 			img: &mockImage{
 				configFile: &v1.ConfigFile{},
+				manifest:   &v1.Manifest{},
 			},
 			client: &repositoryClient{},
 			assertions: func(t *testing.T, img *Image, err error) {
@@ -576,6 +787,7 @@ func TestGetImageFromV1Image(t *testing.T) {
 					OS:           "linux",
 					Architecture: "amd64",
 				},
+				manifest: &v1.Manifest{},
 			},
 			platform: &platformConstraint{
 				os:   "linux",
@@ -587,6 +799,38 @@ func TestGetImageFromV1Image(t *testing.T) {
 				require.NotNil(t, img)
 				require.NotEmpty(t, img.Digest)
 				require.NotNil(t, img.CreatedAt)
+				require.Nil(t, img.BuildDate)
+			},
+		},
+		{
+			name: "error getting manifest",
+			img: &mockImage{
+				configFile: &v1.ConfigFile{},
+			},
+			client: &repositoryClient{},
+			assertions: func(t *testing.T, _ *Image, err error) {
+				require.ErrorContains(t, err, "error getting manifest for image")
+			},
+		},
+		{
+			name: "with build date",
+			img: &mockImage{
+				configFile: &v1.ConfigFile{},
+				manifest: &v1.Manifest{
+					Annotations: map[string]string{
+						buildDateAnnotation: "2024-03-01T12:00:00Z",
+					},
+				},
+			},
+			client: &repositoryClient{},
+			assertions: func(t *testing.T, img *Image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, img)
+				require.Equal(
+					t,
+					ptr.To(time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)),
+					img.BuildDate,
+				)
 			},
 		},
 	}
@@ -602,6 +846,67 @@ func TestGetImageFromV1Image(t *testing.T) {
 	}
 }
 
+func TestGetBuildDate(t *testing.T) {
+	expected := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		value    string
+		expected *time.Time
+	}{
+		{
+			name: "annotation absent",
+		},
+		{
+			name:  "not a date",
+			value: "yesterday",
+		},
+		{
+			name:     "RFC 3339 in UTC",
+			value:    "2024-03-01T12:00:00Z",
+			expected: &expected,
+		},
+		{
+			name:     "RFC 3339 with offset",
+			value:    "2024-03-01T14:00:00+02:00",
+			expected: &expected,
+		},
+		{
+			name:     "RFC 3339 with fractional seconds",
+			value:    "2024-03-01T12:00:00.123456789Z",
+			expected: ptr.To(expected.Add(123456789 * time.Nanosecond)),
+		},
+		{
+			name:     "ISO 8601 with offset without colon",
+			value:    "2024-03-01T07:00:00-0500",
+			expected: &expected,
+		},
+		{
+			name:     "ISO 8601 without time zone",
+			value:    "2024-03-01T12:00:00",
+			expected: &expected,
+		},
+		{
+			name:     "ISO 8601 basic format",
+			value:    "20240301T120000Z",
+			expected: &expected,
+		},
+		{
+			name:     "ISO 8601 date only",
+			value:    "2024-03-01",
+			expected: ptr.To(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var annotations map[string]string
+			if testCase.value != "" {
+				annotations = map[string]string{buildDateAnnotation: testCase.value}
+			}
+			require.Equal(t, testCase.expected, getBuildDate(annotations))
+		})
+	}
+}
+
 type mockImageIndex struct {
 	indexManifest *v1.IndexManifest
 }
@@ -636,6 +941,7 @@ func (m *mockImageIndex) ImageIndex(v1.Hash) (v1.ImageIndex, error) {
 
 type mockImage struct {
 	configFile *v1.ConfigFile
+	manifest   *v1.Manifest
 }
 
 func (m *mockImage) Layers() ([]v1.Layer, error) {
@@ -667,7 +973,10 @@ func (m *mockImage) Digest() (v1.Hash, error) {
 }
 
 func (m *mockImage) Manifest() (*v1.Manifest, error) {
-	return nil, errNotImplemented
+	if m.manifest == nil {
+		return nil, errNotImplemented
+	}
+	return m.manifest, nil
 }
 
 func (m *mockImage) RawManifest() ([]byte, error) {
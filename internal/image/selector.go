@@ -53,6 +53,10 @@ type SelectorOptions struct {
 	// AllowRegex is an optional regular expression that can be used to constrain
 	// image selection based on eligible tags.
 	AllowRegex string
+	// DenyRegex is an optional regular expression that can be used to constrain
+	// image selection based on ineligible tags. It takes precedence over
+	// AllowRegex.
+	DenyRegex string
 	// Ignore is an optional list of tags that should explicitly be ignored when
 	// selecting an image.
 	Ignore []string
@@ -66,11 +70,22 @@ type SelectorOptions struct {
 	// InsecureSkipTLSVerify is an optional flag, that if set to true, will
 	// disable verification of the image repository's TLS certificate.
 	InsecureSkipTLSVerify bool
+	// CABundle is an optional PEM-encoded bundle of CA certificates against
+	// which the image repository's TLS certificate is verified in addition to
+	// the system's trusted CAs.
+	CABundle []byte
 	// DiscoveryLimit is an optional limit on the number of images that can be
 	// discovered by the Selector. The limit is applied after filtering images
-	// based on the AllowRegex and Ignore fields. If the limit is zero, all
+	// based on the AllowRegex, DenyRegex, and Ignore fields. If the limit is zero, all
 	// discovered images will be returned.
 	DiscoveryLimit int
+	// ByBuildDate is an optional flag that, if set to true, causes
+	// SelectionStrategyNewestBuild to order images by the build dates recorded
+	// in their manifests' org.opencontainers.image.created annotations instead
+	// of by the creation dates recorded in their configurations. Images without
+	// a build date are then not eligible for selection. Other strategies ignore
+	// this flag.
+	ByBuildDate bool
 }
 
 // NewSelector returns some implementation of the Selector interface that
@@ -97,6 +112,18 @@ func NewSelector(
 		}
 	}
 
+	var denyRegex *regexp.Regexp
+	if opts.DenyRegex != "" {
+		var err error
+		if denyRegex, err = regexp.Compile(opts.DenyRegex); err != nil {
+			return nil, fmt.Errorf(
+				"error compiling regular expression %q: %w",
+				opts.DenyRegex,
+				err,
+			)
+		}
+	}
+
 	var platform *platformConstraint
 	if opts.Platform != "" {
 		p, err := parsePlatformConstraint(opts.Platform)
@@ -106,7 +133,12 @@ func NewSelector(
 		platform = &p
 	}
 
-	repoClient, err := newRepositoryClient(repoURL, opts.InsecureSkipTLSVerify, opts.Creds)
+	repoClient, err := newRepositoryClient(
+		repoURL,
+		opts.InsecureSkipTLSVerify,
+		opts.CABundle,
+		opts.Creds,
+	)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"error creating repository client for image %q: %w",
@@ -122,6 +154,7 @@ func NewSelector(
 		return newLexicalSelector(
 			repoClient,
 			allowRegex,
+			denyRegex,
 			opts.Ignore,
 			platform,
 			opts.DiscoveryLimit,
@@ -130,14 +163,17 @@ func NewSelector(
 		return newNewestBuildSelector(
 			repoClient,
 			allowRegex,
+			denyRegex,
 			opts.Ignore,
 			platform,
 			opts.DiscoveryLimit,
+			opts.ByBuildDate,
 		), nil
 	case SelectionStrategySemVer, "":
 		return newSemVerSelector(
 			repoClient,
 			allowRegex,
+			denyRegex,
 			opts.Ignore,
 			opts.Constraint,
 			platform,
@@ -157,6 +193,15 @@ func allowsTag(tag string, allowRegex *regexp.Regexp) bool {
 	return allowRegex.MatchString(tag)
 }
 
+// deniesTag returns true if the given tag matches the given regular expression.
+// It returns false otherwise, including when the regular expression is nil.
+func deniesTag(tag string, denyRegex *regexp.Regexp) bool {
+	if denyRegex == nil {
+		return false
+	}
+	return denyRegex.MatchString(tag)
+}
+
 // ignoresTag returns true if the given tag is in the given list of ignored
 // tags. It returns false otherwise.
 func ignoresTag(tag string, ignore []string) bool {
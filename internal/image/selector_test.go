@@ -25,6 +25,16 @@ func TestNewSelector(t *testing.T) {
 				require.ErrorContains(t, err, "error compiling regular expression")
 			},
 		},
+		{
+			name:    "invalid deny regex",
+			repoURL: "debian",
+			opts: &SelectorOptions{
+				DenyRegex: "(invalid", // Invalid regex due to unclosed parenthesis
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error compiling regular expression")
+			},
+		},
 		{
 			name:    "invalid platform constraint",
 			repoURL: "debian",
@@ -127,6 +137,42 @@ func TestAllowsTag(t *testing.T) {
 	}
 }
 
+func TestDeniesTag(t *testing.T) {
+	testCases := []struct {
+		name      string
+		tag       string
+		denyRegex *regexp.Regexp
+		denied    bool
+	}{
+		{
+			name:   "no deny regex",
+			tag:    "1.0.0-rc1",
+			denied: false,
+		},
+		{
+			name:      "tag isn't denied",
+			tag:       "1.0.0",
+			denyRegex: regexp.MustCompile("-rc"),
+			denied:    false,
+		},
+		{
+			name:      "tag is denied",
+			tag:       "1.0.0-rc1",
+			denyRegex: regexp.MustCompile("-rc"),
+			denied:    true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.denied,
+				deniesTag(testCase.tag, testCase.denyRegex),
+			)
+		})
+	}
+}
+
 func TestIgnoresTag(t *testing.T) {
 	testIgnore := []string{"ignore-me"}
 	testCases := []struct {
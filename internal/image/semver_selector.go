@@ -15,6 +15,7 @@ import (
 type semVerSelector struct {
 	repoClient     *repositoryClient
 	allowRegex     *regexp.Regexp
+	denyRegex      *regexp.Regexp
 	ignore         []string
 	constraint     *semver.Constraints
 	platform       *platformConstraint
@@ -26,6 +27,7 @@ type semVerSelector struct {
 func newSemVerSelector(
 	repoClient *repositoryClient,
 	allowRegex *regexp.Regexp,
+	denyRegex *regexp.Regexp,
 	ignore []string,
 	constraint string,
 	platform *platformConstraint,
@@ -45,6 +47,7 @@ func newSemVerSelector(
 	return &semVerSelector{
 		repoClient:     repoClient,
 		allowRegex:     allowRegex,
+		denyRegex:      denyRegex,
 		ignore:         ignore,
 		constraint:     semverConstraint,
 		platform:       platform,
@@ -128,7 +131,9 @@ func (s *semVerSelector) selectImages(ctx context.Context) ([]Image, error) {
 
 	images := make([]Image, 0, len(tags))
 	for _, tag := range tags {
-		if allowsTag(tag, s.allowRegex) && !ignoresTag(tag, s.ignore) {
+		if allowsTag(tag, s.allowRegex) &&
+			!deniesTag(tag, s.denyRegex) &&
+			!ignoresTag(tag, s.ignore) {
 			var sv *semver.Version
 			if sv, err = semver.NewVersion(tag); err != nil {
 				continue // tag wasn't a semantic version
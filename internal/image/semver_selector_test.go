@@ -1,14 +1,18 @@
 package image
 
 import (
+	"context"
 	"regexp"
 	"testing"
 
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/stretchr/testify/require"
 )
 
 func TestNewSemVerSelector(t *testing.T) {
 	testAllowRegex := regexp.MustCompile("fake-regex")
+	testDenyRegex := regexp.MustCompile("fake-deny-regex")
 	testIgnore := []string{"fake-ignore"}
 	testPlatform := &platformConstraint{
 		os:   "linux",
@@ -27,6 +31,20 @@ func TestNewSemVerSelector(t *testing.T) {
 				require.ErrorContains(t, err, "error parsing semver constraint")
 			},
 		},
+		{
+			name:       "incomplete semver constraint",
+			constraint: ">=1.2.0 <",
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error parsing semver constraint")
+			},
+		},
+		{
+			name:       "semver constraint with too many version components",
+			constraint: "1.2.3.4",
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error parsing semver constraint")
+			},
+		},
 		{
 			name:       "no semver constraint",
 			constraint: "",
@@ -35,6 +53,7 @@ func TestNewSemVerSelector(t *testing.T) {
 				selector, ok := s.(*semVerSelector)
 				require.True(t, ok)
 				require.Equal(t, testAllowRegex, selector.allowRegex)
+				require.Equal(t, testDenyRegex, selector.denyRegex)
 				require.Equal(t, testIgnore, selector.ignore)
 				require.Nil(t, selector.constraint)
 				require.Equal(t, testPlatform, selector.platform)
@@ -49,6 +68,7 @@ func TestNewSemVerSelector(t *testing.T) {
 				selector, ok := s.(*semVerSelector)
 				require.True(t, ok)
 				require.Equal(t, testAllowRegex, selector.allowRegex)
+				require.Equal(t, testDenyRegex, selector.denyRegex)
 				require.Equal(t, testIgnore, selector.ignore)
 				require.NotNil(t, selector.constraint)
 				require.Equal(t, testPlatform, selector.platform)
@@ -61,6 +81,7 @@ func TestNewSemVerSelector(t *testing.T) {
 			s, err := newSemVerSelector(
 				nil,
 				testAllowRegex,
+				testDenyRegex,
 				testIgnore,
 				testCase.constraint,
 				testPlatform,
@@ -71,6 +92,83 @@ func TestNewSemVerSelector(t *testing.T) {
 	}
 }
 
+func TestSemVerSelectorSelectImages(t *testing.T) {
+	testRepoRef, err := name.ParseReference("fake-url")
+	require.NoError(t, err)
+	testTags := []string{
+		"1.1.9",
+		"1.2.0-alpha",
+		"1.2.0",
+		"v1.5.0",
+		"1.9.9",
+		"2.0.0-rc.1",
+		"2.0.0",
+		"not-semver",
+	}
+	testCases := []struct {
+		name       string
+		constraint string
+		expected   []string
+	}{
+		{
+			name:     "no semver constraint",
+			expected: []string{"2.0.0", "2.0.0-rc.1", "1.9.9", "v1.5.0", "1.2.0", "1.2.0-alpha", "1.1.9"},
+		},
+		{
+			name:       "inclusive lower bound and exclusive upper bound",
+			constraint: ">=1.2.0 <2.0.0",
+			expected:   []string{"1.9.9", "v1.5.0", "1.2.0"},
+		},
+		{
+			name:       "exclusive lower bound and inclusive upper bound",
+			constraint: ">1.2.0 <=2.0.0",
+			expected:   []string{"2.0.0", "1.9.9", "v1.5.0"},
+		},
+		{
+			name:       "exact version",
+			constraint: "=1.9.9",
+			expected:   []string{"1.9.9"},
+		},
+		{
+			name:       "no tags satisfy constraint",
+			constraint: ">=3.0.0",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := newSemVerSelector(
+				&repositoryClient{
+					repoRef: testRepoRef,
+					remoteListFn: func(
+						name.Repository,
+						...remote.Option,
+					) ([]string, error) {
+						return testTags, nil
+					},
+				},
+				nil,
+				nil,
+				nil,
+				testCase.constraint,
+				nil,
+				0,
+			)
+			require.NoError(t, err)
+			images, err := s.(*semVerSelector).selectImages(context.Background())
+			require.NoError(t, err)
+			if testCase.expected == nil {
+				require.Nil(t, images)
+				return
+			}
+			tags := make([]string, len(images))
+			for i, image := range images {
+				tags[i] = image.Tag
+			}
+			require.Equal(t, testCase.expected, tags)
+		})
+	}
+}
+
 func TestSortImagesBySemver(t *testing.T) {
 	images := []Image{
 		newImage("5.0.0", "", nil),
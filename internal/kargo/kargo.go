@@ -238,3 +238,25 @@ func (p AbortRequested) Update(e event.UpdateEvent) bool {
 	}
 	return false
 }
+
+// PromoteFreightRequested is a predicate that returns true if the promote
+// freight annotation has been set on a resource, or the Freight it names has
+// changed compared to the previous state.
+type PromoteFreightRequested struct {
+	predicate.Funcs
+}
+
+// Update returns true if the promote freight annotation has been set on the
+// new object, or if the Freight it names has changed compared to the old
+// object.
+func (p PromoteFreightRequested) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return false
+	}
+
+	if newVal, newOk := kargoapi.PromoteFreightAnnotationValue(e.ObjectNew.GetAnnotations()); newOk {
+		oldVal, _ := kargoapi.PromoteFreightAnnotationValue(e.ObjectOld.GetAnnotations())
+		return newVal != oldVal
+	}
+	return false
+}
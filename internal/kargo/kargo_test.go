@@ -644,3 +644,81 @@ func TestAbortRequested_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestPromoteFreightRequested_Update(t *testing.T) {
+	newStage := func(freight string) *kargoapi.Stage {
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{},
+			},
+		}
+		if freight != "" {
+			stage.Annotations[kargoapi.AnnotationKeyPromoteFreight] = freight
+		}
+		return stage
+	}
+	tests := []struct {
+		name      string
+		oldObject client.Object
+		newObject client.Object
+		want      bool
+	}{
+		{
+			name:      "no old or new object",
+			oldObject: nil,
+			newObject: nil,
+			want:      false,
+		},
+		{
+			name:      "no old object",
+			oldObject: nil,
+			newObject: newStage("foo"),
+			want:      false,
+		},
+		{
+			name:      "no new object",
+			oldObject: newStage("foo"),
+			newObject: nil,
+			want:      false,
+		},
+		{
+			name:      "no promote freight annotation",
+			oldObject: newStage(""),
+			newObject: newStage(""),
+			want:      false,
+		},
+		{
+			name:      "promote freight annotation set on new object",
+			oldObject: newStage(""),
+			newObject: newStage("foo"),
+			want:      true,
+		},
+		{
+			name:      "promote freight annotation removed from new object",
+			oldObject: newStage("foo"),
+			newObject: newStage(""),
+			want:      false,
+		},
+		{
+			name:      "promote freight annotation changed",
+			oldObject: newStage("foo"),
+			newObject: newStage("bar"),
+			want:      true,
+		},
+		{
+			name:      "promote freight annotation unchanged",
+			oldObject: newStage("foo"),
+			newObject: newStage("foo"),
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := PromoteFreightRequested{}
+			require.Equal(t, tt.want, p.Update(event.UpdateEvent{
+				ObjectOld: tt.oldObject,
+				ObjectNew: tt.newObject,
+			}))
+		})
+	}
+}
@@ -32,6 +32,7 @@ const (
 	StagesByAnalysisRunIndexField        = "analysisRun"
 	StagesByArgoCDApplicationsIndexField = "applications"
 	StagesByFreightIndexField            = "freight"
+	StagesByPromotionTemplateIndexField  = "promotionTemplate"
 	StagesByUpstreamStagesIndexField     = "upstreamStages"
 	StagesByWarehouseIndexField          = "warehouse"
 
@@ -132,16 +133,24 @@ func IndexStagesByArgoCDApplications(ctx context.Context, mgr ctrl.Manager, shar
 		ctx,
 		&kargoapi.Stage{},
 		StagesByArgoCDApplicationsIndexField,
-		indexStagesByArgoCDApplications(shardName))
+		indexStagesByArgoCDApplications(ctx, mgr.GetClient(), shardName))
 }
 
 // indexStagesByArgoCDApplications returns a client.IndexerFunc that indexes
-// Stages by the Argo CD Applications they are associated with.
+// Stages by the Argo CD Applications they are associated with, either because
+// the Stage updates them or because it checks their health. The updates of a
+// PromotionTemplate referenced by the Stage are taken into account.
 //
 // When the provided shardName is non-empty, only Stages labeled with the
 // provided shardName are indexed. When the provided shardName is empty, only
 // Stages not labeled with a shardName are indexed.
-func indexStagesByArgoCDApplications(shardName string) client.IndexerFunc {
+func indexStagesByArgoCDApplications(
+	ctx context.Context,
+	c client.Client,
+	shardName string,
+) client.IndexerFunc {
+	logger := logging.LoggerFromContext(ctx)
+
 	return func(obj client.Object) []string {
 		// Return early if:
 		//
@@ -157,16 +166,38 @@ func indexStagesByArgoCDApplications(shardName string) client.IndexerFunc {
 		}
 
 		stage := obj.(*kargoapi.Stage) // nolint: forcetypeassert
-		if stage.Spec.PromotionMechanisms == nil || len(stage.Spec.PromotionMechanisms.ArgoCDAppUpdates) == 0 {
-			return nil
+		var apps []string
+		mechs, err := kargoapi.ResolvePromotionMechanisms(ctx, c, stage)
+		if err != nil {
+			// Fall back to the Stage's own PromotionMechanisms so that the
+			// Applications it references directly are still indexed.
+			logger.Error(
+				err, "error resolving PromotionMechanisms while indexing Stage by "+
+					"Argo CD Applications",
+				"stage", stage.Name,
+				"namespace", stage.Namespace,
+			)
+			mechs = stage.Spec.PromotionMechanisms
 		}
-		apps := make([]string, len(stage.Spec.PromotionMechanisms.ArgoCDAppUpdates))
-		for i, appCheck := range stage.Spec.PromotionMechanisms.ArgoCDAppUpdates {
-			namespace := appCheck.AppNamespace
-			if namespace == "" {
-				namespace = libargocd.Namespace()
+		if mechs != nil {
+			for _, appUpdate := range mechs.ArgoCDAppUpdates {
+				namespace := appUpdate.AppNamespace
+				if namespace == "" {
+					namespace = libargocd.Namespace()
+				}
+				apps = append(apps, fmt.Sprintf("%s:%s", namespace, appUpdate.AppName))
+			}
+		}
+		// Applications whose health is checked, but which are not updated by the
+		// Stage, also affect the Stage's health.
+		if stage.Spec.HealthChecks != nil {
+			for _, appCheck := range stage.Spec.HealthChecks.ArgoCDApps {
+				namespace := appCheck.Namespace
+				if namespace == "" {
+					namespace = libargocd.Namespace()
+				}
+				apps = append(apps, fmt.Sprintf("%s:%s", namespace, appCheck.Name))
 			}
-			apps[i] = fmt.Sprintf("%s:%s", namespace, appCheck.AppName)
 		}
 		return apps
 	}
@@ -282,14 +313,25 @@ func indexRunningPromotionsByArgoCDApplications(
 			return nil
 		}
 
-		if stage.Spec.PromotionMechanisms == nil || len(stage.Spec.PromotionMechanisms.ArgoCDAppUpdates) == 0 {
+		mechs, err := kargoapi.ResolvePromotionMechanisms(ctx, c, &stage)
+		if err != nil {
+			logger.Error(
+				err, "failed to index running Promotion by Argo CD Applications; "+
+					"can not resolve PromotionMechanisms of Stage",
+				"promo", promo.Name,
+				"namespace", promo.Namespace,
+			)
+			return nil
+		}
+
+		if mechs == nil || len(mechs.ArgoCDAppUpdates) == 0 {
 			// If the Stage has no Argo CD Application promotion mechanisms,
 			// then we have nothing to index.
 			return nil
 		}
 
-		res := make([]string, len(stage.Spec.PromotionMechanisms.ArgoCDAppUpdates))
-		for i, appUpdate := range stage.Spec.PromotionMechanisms.ArgoCDAppUpdates {
+		res := make([]string, len(mechs.ArgoCDAppUpdates))
+		for i, appUpdate := range mechs.ArgoCDAppUpdates {
 			namespace := appUpdate.AppNamespace
 			if namespace == "" {
 				namespace = libargocd.Namespace()
@@ -498,6 +540,31 @@ func indexStagesByWarehouse(obj client.Object) []string {
 	return warehouses
 }
 
+// IndexStagesByPromotionTemplate sets up indexing of Stages by the
+// PromotionTemplate they reference.
+//
+// It configures the manager's field indexer to allow querying Stages using the
+// StagesByPromotionTemplateIndexField selector.
+func IndexStagesByPromotionTemplate(ctx context.Context, mgr ctrl.Manager) error {
+	return mgr.GetFieldIndexer().IndexField(
+		ctx,
+		&kargoapi.Stage{},
+		StagesByPromotionTemplateIndexField,
+		indexStagesByPromotionTemplate,
+	)
+}
+
+// indexStagesByPromotionTemplate is a client.IndexerFunc that indexes Stages by
+// the PromotionTemplate they reference.
+func indexStagesByPromotionTemplate(obj client.Object) []string {
+	stage := obj.(*kargoapi.Stage) // nolint: forcetypeassert
+	if stage.Spec.PromotionTemplateRef == nil ||
+		stage.Spec.PromotionTemplateRef.Name == "" {
+		return nil
+	}
+	return []string{stage.Spec.PromotionTemplateRef.Name}
+}
+
 // IndexServiceAccountsByOIDCEmail sets up indexing of ServiceAccounts by their
 // OIDC email annotations.
 //
@@ -344,11 +344,138 @@ func TestIndexStagesByArgoCDApplications(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:                "Stage checks the health of Applications",
+			controllerShardName: "",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								AppNamespace: "fake-namespace",
+								AppName:      "fake-app",
+							},
+						},
+					},
+					HealthChecks: &kargoapi.HealthChecks{
+						ArgoCDApps: []kargoapi.ArgoCDAppStatusCheck{
+							{
+								Namespace: "fake-namespace",
+								Name:      "checked-app",
+							},
+							{
+								Name: "other-checked-app",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, res []string) {
+				require.Equal(
+					t,
+					[]string{
+						"fake-namespace:fake-app",
+						"fake-namespace:checked-app",
+						"argocd:other-checked-app",
+					},
+					res,
+				)
+			},
+		},
+		{
+			name:                "Stage references a PromotionTemplate",
+			controllerShardName: "",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "fake-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					PromotionTemplateRef: &corev1.LocalObjectReference{
+						Name: "fake-template",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								AppNamespace: "fake-namespace",
+								AppName:      "fake-app",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, res []string) {
+				require.Equal(
+					t,
+					[]string{
+						"fake-namespace:template-app",
+						"fake-namespace:fake-app",
+					},
+					res,
+				)
+			},
+		},
+		{
+			name:                "Stage references a missing PromotionTemplate",
+			controllerShardName: "",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "fake-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					PromotionTemplateRef: &corev1.LocalObjectReference{
+						Name: "missing-template",
+					},
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								AppNamespace: "fake-namespace",
+								AppName:      "fake-app",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, res []string) {
+				require.Equal(
+					t,
+					[]string{
+						"fake-namespace:fake-app",
+					},
+					res,
+				)
+			},
+		},
 	}
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&kargoapi.PromotionTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "fake-template",
+			},
+			Spec: kargoapi.PromotionTemplateSpec{
+				PromotionMechanisms: kargoapi.PromotionMechanisms{
+					ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+						{
+							AppNamespace: "fake-namespace",
+							AppName:      "template-app",
+						},
+					},
+				},
+			},
+		},
+	).Build()
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			res := indexStagesByArgoCDApplications(tc.controllerShardName)(tc.stage)
+			res := indexStagesByArgoCDApplications(
+				context.TODO(),
+				c,
+				tc.controllerShardName,
+			)(tc.stage)
 			tc.assertions(t, res)
 		})
 	}
@@ -428,6 +555,7 @@ func TestIndexRunningPromotionsByArgoCDApplications(t *testing.T) {
 		name      string
 		obj       client.Object
 		stage     client.Object
+		template  client.Object
 		shardName string
 		expected  []string
 	}{
@@ -526,6 +654,74 @@ func TestIndexRunningPromotionsByArgoCDApplications(t *testing.T) {
 				fmt.Sprintf("%s:%s", argocd.Namespace(), "fake-app-name-default-namespace"),
 			},
 		},
+		{
+			name: "Related Promotion Stage references a PromotionTemplate",
+			obj: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Stage: "fake-stage",
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase: kargoapi.PromotionPhaseRunning,
+				},
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-stage",
+					Namespace: "fake-namespace",
+				},
+				Spec: kargoapi.StageSpec{
+					PromotionTemplateRef: &corev1.LocalObjectReference{
+						Name: "fake-template",
+					},
+				},
+			},
+			template: &kargoapi.PromotionTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-template",
+					Namespace: "fake-namespace",
+				},
+				Spec: kargoapi.PromotionTemplateSpec{
+					PromotionMechanisms: kargoapi.PromotionMechanisms{
+						ArgoCDAppUpdates: []kargoapi.ArgoCDAppUpdate{
+							{
+								AppNamespace: "fake-app-namespace",
+								AppName:      "fake-app-name",
+							},
+						},
+					},
+				},
+			},
+			expected: []string{"fake-app-namespace:fake-app-name"},
+		},
+		{
+			name: "Related Promotion Stage references a missing PromotionTemplate",
+			obj: &kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Stage: "fake-stage",
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase: kargoapi.PromotionPhaseRunning,
+				},
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "fake-stage",
+					Namespace: "fake-namespace",
+				},
+				Spec: kargoapi.StageSpec{
+					PromotionTemplateRef: &corev1.LocalObjectReference{
+						Name: "fake-template",
+					},
+				},
+			},
+			expected: nil,
+		},
 		{
 			name: "Can not find related Promotion Stage",
 			obj: &kargoapi.Promotion{
@@ -551,6 +747,9 @@ func TestIndexRunningPromotionsByArgoCDApplications(t *testing.T) {
 			if testCase.stage != nil {
 				c.WithObjects(testCase.stage)
 			}
+			if testCase.template != nil {
+				c.WithObjects(testCase.template)
+			}
 
 			require.Equal(
 				t,
@@ -855,6 +1054,40 @@ func TestIndexStagesByWarehouse(t *testing.T) {
 	}
 }
 
+func TestIndexStagesByPromotionTemplate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		stage    *kargoapi.Stage
+		expected []string
+	}{
+		{
+			name:     "Stage does not reference a PromotionTemplate",
+			stage:    &kargoapi.Stage{},
+			expected: nil,
+		},
+		{
+			name: "Stage references a PromotionTemplate",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionTemplateRef: &corev1.LocalObjectReference{
+						Name: "fake-template",
+					},
+				},
+			},
+			expected: []string{"fake-template"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				indexStagesByPromotionTemplate(testCase.stage),
+			)
+		})
+	}
+}
+
 func TestIndexServiceAccountsOIDCEmail(t *testing.T) {
 	testCases := []struct {
 		name     string
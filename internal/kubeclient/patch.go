@@ -3,6 +3,10 @@ package kubeclient
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
 	"k8s.io/apimachinery/pkg/types"
@@ -56,3 +60,196 @@ func PatchStatus[T HasStatus[S], S any](
 	}
 	return kubeClient.Status().Patch(ctx, resource, client.RawPatch(types.MergePatchType, patch))
 }
+
+// JSONPatchStatus evaluates changes applied by the callback to the status of a
+// resource and, if there are any, patches the resource's status using a JSON
+// patch. Unlike PatchStatus, which replaces any list that has changed in its
+// entirety, JSONPatchStatus only writes the entries of a list that have
+// changed. Entries that were added to the front of a list and dropped from the
+// end of it, as happens when a bounded history is appended to, are written
+// without rewriting the entries in between. This makes it preferable for
+// resources with long lists in their status.
+//
+// Because operations address list entries by index, they would corrupt the
+// status if applied to a version of the resource other than the one they were
+// computed from. The patch is therefore prefixed with an operation that tests
+// the resource's resourceVersion, causing it to be rejected in its entirety if
+// the resource has been modified in the meantime.
+func JSONPatchStatus[T HasStatus[S], S any](
+	ctx context.Context,
+	kubeClient client.Client,
+	resource T,
+	update func(status S),
+) error {
+	originalJSON, err := json.Marshal(resource.GetStatus())
+	if err != nil {
+		return err
+	}
+
+	var updated S
+	if err = json.Unmarshal(originalJSON, &updated); err != nil {
+		return err
+	}
+	update(updated)
+
+	modifiedJSON, err := json.Marshal(updated)
+	if err != nil {
+		return err
+	}
+
+	ops, err := createJSONPatch("/status", originalJSON, modifiedJSON)
+	if err != nil {
+		return err
+	}
+	if len(ops) == 0 {
+		return nil
+	}
+
+	if resourceVersion := resource.GetResourceVersion(); resourceVersion != "" {
+		ops = append(
+			[]jsonPatchOperation{{
+				Op:    "test",
+				Path:  "/metadata/resourceVersion",
+				Value: resourceVersion,
+			}},
+			ops...,
+		)
+	}
+
+	patch, err := json.Marshal(ops)
+	if err != nil {
+		return err
+	}
+	return kubeClient.Status().Patch(ctx, resource, client.RawPatch(types.JSONPatchType, patch))
+}
+
+// jsonPatchOperation is a single operation of a JSON patch, as described by
+// RFC 6902.
+type jsonPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+// createJSONPatch returns the JSON patch operations that transform the
+// original JSON document into the modified one, with all paths prefixed by the
+// provided path.
+func createJSONPatch(
+	path string,
+	originalJSON []byte,
+	modifiedJSON []byte,
+) ([]jsonPatchOperation, error) {
+	var original, modified any
+	if err := json.Unmarshal(originalJSON, &original); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(modifiedJSON, &modified); err != nil {
+		return nil, err
+	}
+	if originalMap, ok := original.(map[string]any); ok && len(originalMap) == 0 {
+		// The document may not exist at all in the resource, so no operation
+		// addressing anything within it can be relied upon.
+		if reflect.DeepEqual(original, modified) {
+			return nil, nil
+		}
+		return []jsonPatchOperation{{Op: "add", Path: path, Value: modified}}, nil
+	}
+	return diffJSON(path, original, modified, nil), nil
+}
+
+// diffJSON appends to the provided operations those that transform the
+// original value at the provided path into the modified one.
+func diffJSON(
+	path string,
+	original any,
+	modified any,
+	ops []jsonPatchOperation,
+) []jsonPatchOperation {
+	if reflect.DeepEqual(original, modified) {
+		return ops
+	}
+	switch o := original.(type) {
+	case map[string]any:
+		m, ok := modified.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(o)+len(m))
+		for k := range o {
+			keys = append(keys, k)
+		}
+		for k := range m {
+			if _, ok := o[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			keyPath := path + "/" + escapeJSONPointerToken(k)
+			ov, inOriginal := o[k]
+			mv, inModified := m[k]
+			switch {
+			case !inModified:
+				ops = append(ops, jsonPatchOperation{Op: "remove", Path: keyPath})
+			case !inOriginal:
+				ops = append(ops, jsonPatchOperation{Op: "add", Path: keyPath, Value: mv})
+			default:
+				ops = diffJSON(keyPath, ov, mv, ops)
+			}
+		}
+		return ops
+	case []any:
+		m, ok := modified.([]any)
+		if !ok {
+			break
+		}
+		return diffJSONArray(path, o, m, ops)
+	}
+	return append(ops, jsonPatchOperation{Op: "replace", Path: path, Value: modified})
+}
+
+// diffJSONArray appends to the provided operations those that transform the
+// original array at the provided path into the modified one. If the modified
+// array consists of new entries followed by the leading entries of the
+// original array, only the new entries are added and the dropped entries
+// removed. Otherwise, the arrays are compared entry by entry.
+func diffJSONArray(
+	path string,
+	original []any,
+	modified []any,
+	ops []jsonPatchOperation,
+) []jsonPatchOperation {
+	for added := 1; added < len(modified); added++ {
+		kept := len(modified) - added
+		if kept > len(original) || !reflect.DeepEqual(modified[added:], original[:kept]) {
+			continue
+		}
+		for i := len(original) - 1; i >= kept; i-- {
+			ops = append(ops, jsonPatchOperation{Op: "remove", Path: fmt.Sprintf("%s/%d", path, i)})
+		}
+		for i := 0; i < added; i++ {
+			ops = append(
+				ops,
+				jsonPatchOperation{Op: "add", Path: fmt.Sprintf("%s/%d", path, i), Value: modified[i]},
+			)
+		}
+		return ops
+	}
+	common := min(len(original), len(modified))
+	for i := 0; i < common; i++ {
+		ops = diffJSON(fmt.Sprintf("%s/%d", path, i), original[i], modified[i], ops)
+	}
+	for i := len(original) - 1; i >= common; i-- {
+		ops = append(ops, jsonPatchOperation{Op: "remove", Path: fmt.Sprintf("%s/%d", path, i)})
+	}
+	for i := common; i < len(modified); i++ {
+		ops = append(ops, jsonPatchOperation{Op: "add", Path: fmt.Sprintf("%s/%d", path, i), Value: modified[i]})
+	}
+	return ops
+}
+
+// escapeJSONPointerToken escapes the provided token for use in a JSON pointer,
+// as described by RFC 6901.
+func escapeJSONPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,262 @@
+package kubeclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestCreateJSONPatch(t *testing.T) {
+	testCases := []struct {
+		name       string
+		original   string
+		modified   string
+		assertions func(*testing.T, []jsonPatchOperation, error)
+	}{
+		{
+			name:     "invalid original",
+			original: "{",
+			modified: "{}",
+			assertions: func(t *testing.T, _ []jsonPatchOperation, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:     "no changes",
+			original: `{"phase":"Steady","history":[{"id":"a"}]}`,
+			modified: `{"phase":"Steady","history":[{"id":"a"}]}`,
+			assertions: func(t *testing.T, ops []jsonPatchOperation, err error) {
+				require.NoError(t, err)
+				require.Empty(t, ops)
+			},
+		},
+		{
+			name:     "empty original",
+			original: `{}`,
+			modified: `{"phase":"Steady"}`,
+			assertions: func(t *testing.T, ops []jsonPatchOperation, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]jsonPatchOperation{
+						{Op: "add", Path: "/status", Value: map[string]any{"phase": "Steady"}},
+					},
+					ops,
+				)
+			},
+		},
+		{
+			name:     "fields added, changed, and removed",
+			original: `{"phase":"Steady","message":"error","items":{"Warehouse/a":"x"}}`,
+			modified: `{"phase":"Promoting","health":"Healthy","items":{"Warehouse/a":"y"}}`,
+			assertions: func(t *testing.T, ops []jsonPatchOperation, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]jsonPatchOperation{
+						{Op: "add", Path: "/status/health", Value: "Healthy"},
+						{Op: "replace", Path: "/status/items/Warehouse~1a", Value: "y"},
+						{Op: "remove", Path: "/status/message"},
+						{Op: "replace", Path: "/status/phase", Value: "Promoting"},
+					},
+					ops,
+				)
+			},
+		},
+		{
+			name:     "entry added to front of list",
+			original: `{"history":[{"id":"b"},{"id":"a"}]}`,
+			modified: `{"history":[{"id":"c"},{"id":"b"},{"id":"a"}]}`,
+			assertions: func(t *testing.T, ops []jsonPatchOperation, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]jsonPatchOperation{
+						{Op: "add", Path: "/status/history/0", Value: map[string]any{"id": "c"}},
+					},
+					ops,
+				)
+			},
+		},
+		{
+			name:     "entry added to front of bounded list",
+			original: `{"history":[{"id":"c"},{"id":"b"},{"id":"a"}]}`,
+			modified: `{"history":[{"id":"e"},{"id":"d"},{"id":"c"}]}`,
+			assertions: func(t *testing.T, ops []jsonPatchOperation, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]jsonPatchOperation{
+						{Op: "remove", Path: "/status/history/2"},
+						{Op: "remove", Path: "/status/history/1"},
+						{Op: "add", Path: "/status/history/0", Value: map[string]any{"id": "e"}},
+						{Op: "add", Path: "/status/history/1", Value: map[string]any{"id": "d"}},
+					},
+					ops,
+				)
+			},
+		},
+		{
+			name:     "single entry of list changed",
+			original: `{"history":[{"id":"b","phase":"Running"},{"id":"a"}]}`,
+			modified: `{"history":[{"id":"b","phase":"Successful"},{"id":"a"}]}`,
+			assertions: func(t *testing.T, ops []jsonPatchOperation, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]jsonPatchOperation{
+						{Op: "replace", Path: "/status/history/0/phase", Value: "Successful"},
+					},
+					ops,
+				)
+			},
+		},
+		{
+			name:     "entries removed from end of list",
+			original: `{"history":["c","b","a"]}`,
+			modified: `{"history":["c"]}`,
+			assertions: func(t *testing.T, ops []jsonPatchOperation, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]jsonPatchOperation{
+						{Op: "remove", Path: "/status/history/2"},
+						{Op: "remove", Path: "/status/history/1"},
+					},
+					ops,
+				)
+			},
+		},
+		{
+			name:     "type changed",
+			original: `{"health":{"status":"Healthy"}}`,
+			modified: `{"health":["Healthy"]}`,
+			assertions: func(t *testing.T, ops []jsonPatchOperation, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]jsonPatchOperation{
+						{Op: "replace", Path: "/status/health", Value: []any{"Healthy"}},
+					},
+					ops,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ops, err := createJSONPatch(
+				"/status",
+				[]byte(testCase.original),
+				[]byte(testCase.modified),
+			)
+			testCase.assertions(t, ops, err)
+		})
+	}
+}
+
+func TestJSONPatchStatus(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	newFreightCollection := func(name string) kargoapi.FreightCollection {
+		fc := kargoapi.FreightCollection{}
+		fc.UpdateOrPush(kargoapi.FreightReference{
+			Name: name,
+			Origin: kargoapi.FreightOrigin{
+				Kind: kargoapi.FreightOriginKindWarehouse,
+				Name: "fake-warehouse",
+			},
+		})
+		return fc
+	}
+
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
+		},
+		Status: kargoapi.StageStatus{
+			Phase: kargoapi.StagePhaseSteady,
+			FreightHistory: kargoapi.FreightHistory{
+				ptrToFreightCollection(newFreightCollection("fake-freight-2")),
+				ptrToFreightCollection(newFreightCollection("fake-freight-1")),
+			},
+		},
+	}
+
+	var patches []string
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(stage).
+		WithStatusSubresource(stage).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourcePatch: func(
+				ctx context.Context,
+				c client.Client,
+				subResourceName string,
+				obj client.Object,
+				patch client.Patch,
+				opts ...client.SubResourcePatchOption,
+			) error {
+				require.Equal(t, types.JSONPatchType, patch.Type())
+				data, err := patch.Data(obj)
+				require.NoError(t, err)
+				patches = append(patches, string(data))
+				return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+			},
+		}).
+		Build()
+
+	// No changes should result in no patch
+	err := JSONPatchStatus(context.Background(), c, stage, func(*kargoapi.StageStatus) {})
+	require.NoError(t, err)
+	require.Empty(t, patches)
+
+	newFC := newFreightCollection("fake-freight-3")
+	err = JSONPatchStatus(context.Background(), c, stage, func(status *kargoapi.StageStatus) {
+		status.FreightHistory.Record(&newFC)
+	})
+	require.NoError(t, err)
+	require.Len(t, patches, 1)
+	// Only the new entry is written
+	require.NotContains(t, patches[0], "fake-freight-2")
+	require.NotContains(t, patches[0], "fake-freight-1")
+	require.Contains(t, patches[0], "fake-freight-3")
+
+	updated := &kargoapi.Stage{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(stage), updated))
+	require.Equal(t, kargoapi.StagePhaseSteady, updated.Status.Phase)
+	require.Len(t, updated.Status.FreightHistory, 3)
+	require.Equal(t, newFC.ID, updated.Status.FreightHistory.Current().ID)
+
+	// The patch is guarded by the resourceVersion it was computed from
+	require.Contains(
+		t,
+		patches[0],
+		`{"op":"test","path":"/metadata/resourceVersion","value":"`,
+	)
+
+	// A patch computed from a stale version of the resource is rejected
+	stale := stage.DeepCopy()
+	stale.ResourceVersion = "1"
+	err = JSONPatchStatus(context.Background(), c, stale, func(status *kargoapi.StageStatus) {
+		status.FreightHistory = status.FreightHistory[1:]
+	})
+	require.Error(t, err)
+	require.NoError(t, c.Get(context.Background(), client.ObjectKeyFromObject(stage), updated))
+	require.Len(t, updated.Status.FreightHistory, 3)
+}
+
+func ptrToFreightCollection(fc kargoapi.FreightCollection) *kargoapi.FreightCollection {
+	return &fc
+}
@@ -0,0 +1,135 @@
+package kustomize
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SetComponentImages updates the entries of the images section of the
+// kustomization file of the Kustomize component in the specified directory
+// that correspond to the provided fully-qualified image references, much like
+// `kustomize edit set image` would. Unlike `kustomize edit set image`, no
+// entries are added for images the component does not already list, as a
+// component is typically concerned with only a few images. The image
+// references that corresponded to at least one entry are returned. The
+// specified directory must already exist and contain a kustomization file.
+func SetComponentImages(dir string, fqImageRefs ...string) ([]string, error) {
+	file, err := findKustomizationFile(dir)
+	if err != nil {
+		return nil, err
+	}
+	fileBytes, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file %q: %w", file, err)
+	}
+	doc := &yaml.Node{}
+	if err = yaml.Unmarshal(fileBytes, doc); err != nil {
+		return nil, fmt.Errorf("error unmarshaling file %q: %w", file, err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("file %q does not contain a kustomization", file)
+	}
+	images := getMappingValue(doc.Content[0], "images")
+	if images == nil || images.Kind != yaml.SequenceNode {
+		return nil, nil
+	}
+	var updatedRefs []string
+	for _, fqImageRef := range fqImageRefs {
+		name, tag, digest := parseImageRef(fqImageRef)
+		var updated bool
+		for _, entry := range images.Content {
+			if entry.Kind != yaml.MappingNode {
+				continue
+			}
+			if n := getMappingValue(entry, "name"); n == nil || n.Value != name {
+				continue
+			}
+			// Just like `kustomize edit set image`, a tag and a digest are
+			// mutually exclusive.
+			if digest != "" {
+				setMappingValue(entry, "digest", digest)
+				deleteMappingValue(entry, "newTag")
+			} else {
+				setMappingValue(entry, "newTag", tag)
+				deleteMappingValue(entry, "digest")
+			}
+			updated = true
+		}
+		if updated {
+			updatedRefs = append(updatedRefs, fqImageRef)
+		}
+	}
+	if len(updatedRefs) == 0 {
+		return nil, nil
+	}
+	outBuf := &bytes.Buffer{}
+	encoder := yaml.NewEncoder(outBuf)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(doc); err != nil {
+		return nil, fmt.Errorf("error marshaling file %q: %w", file, err)
+	}
+	if err = encoder.Close(); err != nil {
+		return nil, fmt.Errorf("error marshaling file %q: %w", file, err)
+	}
+	if err = os.WriteFile(file, outBuf.Bytes(), 0600); err != nil {
+		return nil, fmt.Errorf("error writing file %q: %w", file, err)
+	}
+	return updatedRefs, nil
+}
+
+// parseImageRef splits the provided fully-qualified image reference, of the
+// form accepted by `kustomize edit set image`, into an image name and either a
+// tag or a digest.
+func parseImageRef(fqImageRef string) (name, tag, digest string) {
+	if name, digest, found := strings.Cut(fqImageRef, "@"); found {
+		return name, "", digest
+	}
+	// A colon preceding the last slash separates a registry host from its port
+	// rather than an image name from its tag.
+	if i := strings.LastIndex(fqImageRef, ":"); i > strings.LastIndex(fqImageRef, "/") {
+		return fqImageRef[:i], fqImageRef[i+1:], ""
+	}
+	return fqImageRef, "", ""
+}
+
+// getMappingValue returns the value of the specified key of the provided
+// mapping node or nil if the key is not found.
+func getMappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setMappingValue sets the specified key of the provided mapping node to the
+// provided string, adding the key if it is not found.
+func setMappingValue(mapping *yaml.Node, key, value string) {
+	if node := getMappingValue(mapping, key); node != nil {
+		node.Kind = yaml.ScalarNode
+		node.Tag = "!!str"
+		node.Value = value
+		return
+	}
+	mapping.Content = append(
+		mapping.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value},
+	)
+}
+
+// deleteMappingValue removes the specified key, if found, from the provided
+// mapping node.
+func deleteMappingValue(mapping *yaml.Node, key string) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package kustomize
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetComponentImages(t *testing.T) {
+	const testComponent = `apiVersion: kustomize.config.k8s.io/v1alpha1
+kind: Component
+resources:
+- sidecar.yaml
+images:
+# The sidecar injected by this component
+- name: fake-registry.io:5000/fake-sidecar
+  newTag: "1.0"
+- name: fake-exporter
+  digest: sha256:abc
+`
+	testCases := []struct {
+		name          string
+		component     string
+		fqImageRefs   []string
+		skipWriteFile bool
+		assertions    func(t *testing.T, dir string, updatedRefs []string, err error)
+	}{
+		{
+			name:          "no kustomization file",
+			skipWriteFile: true,
+			fqImageRefs:   []string{"fake-exporter:2.0"},
+			assertions: func(t *testing.T, _ string, _ []string, err error) {
+				require.ErrorContains(t, err, "no kustomization file found")
+			},
+		},
+		{
+			name: "no images section",
+			component: `apiVersion: kustomize.config.k8s.io/v1alpha1
+kind: Component
+resources:
+- sidecar.yaml
+`,
+			fqImageRefs: []string{"fake-exporter:2.0"},
+			assertions: func(t *testing.T, dir string, updatedRefs []string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, updatedRefs)
+				fileBytes, err := os.ReadFile(filepath.Join(dir, "kustomization.yaml"))
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`apiVersion: kustomize.config.k8s.io/v1alpha1
+kind: Component
+resources:
+- sidecar.yaml
+`,
+					string(fileBytes),
+				)
+			},
+		},
+		{
+			name:        "no image listed by component",
+			component:   testComponent,
+			fqImageRefs: []string{"fake-image:2.0"},
+			assertions: func(t *testing.T, dir string, updatedRefs []string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, updatedRefs)
+				fileBytes, err := os.ReadFile(filepath.Join(dir, "kustomization.yaml"))
+				require.NoError(t, err)
+				require.Equal(t, testComponent, string(fileBytes))
+			},
+		},
+		{
+			name:      "success",
+			component: testComponent,
+			fqImageRefs: []string{
+				"fake-image:2.0",
+				"fake-registry.io:5000/fake-sidecar@sha256:def",
+				"fake-exporter:2.0",
+			},
+			assertions: func(t *testing.T, dir string, updatedRefs []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"fake-registry.io:5000/fake-sidecar@sha256:def",
+						"fake-exporter:2.0",
+					},
+					updatedRefs,
+				)
+				fileBytes, err := os.ReadFile(filepath.Join(dir, "kustomization.yaml"))
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`apiVersion: kustomize.config.k8s.io/v1alpha1
+kind: Component
+resources:
+  - sidecar.yaml
+images:
+  # The sidecar injected by this component
+  - name: fake-registry.io:5000/fake-sidecar
+    digest: sha256:def
+  - name: fake-exporter
+    newTag: "2.0"
+`,
+					string(fileBytes),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if !testCase.skipWriteFile {
+				require.NoError(
+					t,
+					os.WriteFile(
+						filepath.Join(dir, "kustomization.yaml"),
+						[]byte(testCase.component),
+						0600,
+					),
+				)
+			}
+			updatedRefs, err := SetComponentImages(dir, testCase.fqImageRefs...)
+			testCase.assertions(t, dir, updatedRefs, err)
+		})
+	}
+}
+
+func TestParseImageRef(t *testing.T) {
+	testCases := []struct {
+		fqImageRef string
+		name       string
+		tag        string
+		digest     string
+	}{
+		{
+			fqImageRef: "fake-image",
+			name:       "fake-image",
+		},
+		{
+			fqImageRef: "fake-image:1.0",
+			name:       "fake-image",
+			tag:        "1.0",
+		},
+		{
+			fqImageRef: "fake-image@sha256:abc",
+			name:       "fake-image",
+			digest:     "sha256:abc",
+		},
+		{
+			fqImageRef: "fake-registry.io:5000/fake-image",
+			name:       "fake-registry.io:5000/fake-image",
+		},
+		{
+			fqImageRef: "fake-registry.io:5000/fake-image:1.0",
+			name:       "fake-registry.io:5000/fake-image",
+			tag:        "1.0",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.fqImageRef, func(t *testing.T) {
+			name, tag, digest := parseImageRef(testCase.fqImageRef)
+			require.Equal(t, testCase.name, name)
+			require.Equal(t, testCase.tag, tag)
+			require.Equal(t, testCase.digest, digest)
+		})
+	}
+}
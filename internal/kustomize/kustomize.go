@@ -1,27 +1,28 @@
 package kustomize
 
 import (
+	"context"
 	"os"
 	"os/exec"
 
 	libExec "github.com/akuity/kargo/internal/exec"
 )
 
-// SetImage runs `kustomize edit set image ...` in the specified directory.
-// The specified directory must already exist and contain a kustomization.yaml
-// file.
-func SetImage(dir, fqImageRef string) error {
-	_, err := libExec.Exec(buildSetImageCmd(dir, fqImageRef))
+// SetImage runs `kustomize edit set image ...` in the specified directory. All
+// provided image references are set by a single invocation of Kustomize. The
+// specified directory must already exist and contain a kustomization.yaml
+// file. Kustomize is killed if the provided context is canceled before it
+// exits.
+func SetImage(ctx context.Context, dir string, fqImageRefs ...string) error {
+	_, err := libExec.Exec(buildSetImageCmd(ctx, dir, fqImageRefs...))
 	return err
 }
 
-func buildSetImageCmd(dir, fqImageRef string) *exec.Cmd {
-	cmd := exec.Command( // nolint: gosec
+func buildSetImageCmd(ctx context.Context, dir string, fqImageRefs ...string) *exec.Cmd {
+	cmd := exec.CommandContext( // nolint: gosec
+		ctx,
 		"kustomize",
-		"edit",
-		"set",
-		"image",
-		fqImageRef,
+		append([]string{"edit", "set", "image"}, fqImageRefs...)...,
 	)
 	cmd.Env = append(cmd.Env, os.Environ()...)
 	cmd.Dir = dir
@@ -1,6 +1,7 @@
 package kustomize
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -10,7 +11,7 @@ import (
 func TestBuildSetImageCmd(t *testing.T) {
 	const testDir = "/some-dir"
 	const testImageRef = "some-image:some-tag"
-	cmd := buildSetImageCmd(testDir, testImageRef)
+	cmd := buildSetImageCmd(context.Background(), testDir, testImageRef)
 	require.NotNil(t, cmd)
 	require.True(t, strings.HasSuffix(cmd.Path, "kustomize"))
 	require.Equal(
@@ -26,3 +27,24 @@ func TestBuildSetImageCmd(t *testing.T) {
 	)
 	require.Equal(t, testDir, cmd.Dir)
 }
+
+func TestBuildSetImageCmdWithMultipleImages(t *testing.T) {
+	const testDir = "/some-dir"
+	const testImageRef = "some-image:some-tag"
+	const testOtherImageRef = "some-other-image@sha256:abc"
+	cmd := buildSetImageCmd(context.Background(), testDir, testImageRef, testOtherImageRef)
+	require.NotNil(t, cmd)
+	require.Equal(
+		t,
+		[]string{
+			"kustomize",
+			"edit",
+			"set",
+			"image",
+			testImageRef,
+			testOtherImageRef,
+		},
+		cmd.Args,
+	)
+	require.Equal(t, testDir, cmd.Dir)
+}
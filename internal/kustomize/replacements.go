@@ -0,0 +1,96 @@
+package kustomize
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	libYAML "github.com/akuity/kargo/internal/yaml"
+)
+
+// kustomizationFileNames are the names Kustomize recognizes for a
+// kustomization file, in order of precedence.
+var kustomizationFileNames = []string{
+	"kustomization.yaml",
+	"kustomization.yml",
+	"Kustomization",
+}
+
+// SetConfigMapLiteral sets the value of the literal with the specified key in
+// the specified ConfigMap of the configMapGenerator section of the
+// kustomization file in the specified directory. This is useful when the
+// literal serves as the source of one or more of the kustomization's
+// replacements, as updating the literal alone is then sufficient to update
+// every field the replacements target. The specified directory must already
+// exist and contain a kustomization file, the ConfigMap must already be listed
+// in its configMapGenerator section, and the ConfigMap must already define a
+// literal with the specified key. All comments and style choices in the
+// kustomization file are preserved.
+func SetConfigMapLiteral(dir, configMap, key, value string) error {
+	file, err := findKustomizationFile(dir)
+	if err != nil {
+		return err
+	}
+	fileBytes, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("error reading file %q: %w", file, err)
+	}
+	literalKey, err := findConfigMapLiteral(fileBytes, configMap, key)
+	if err != nil {
+		return fmt.Errorf("error updating file %q: %w", file, err)
+	}
+	return libYAML.SetStringsInFile(
+		file,
+		map[string]string{literalKey: fmt.Sprintf("%s=%s", key, value)},
+	)
+}
+
+// findKustomizationFile returns the path to the kustomization file in the
+// specified directory.
+func findKustomizationFile(dir string) (string, error) {
+	for _, name := range kustomizationFileNames {
+		file := filepath.Join(dir, name)
+		if _, err := os.Stat(file); err == nil {
+			return file, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("error checking for file %q: %w", file, err)
+		}
+	}
+	return "", fmt.Errorf("no kustomization file found in directory %q", dir)
+}
+
+// findConfigMapLiteral returns a key of the form accepted by
+// libYAML.SetStringsInFile that addresses the literal with the specified key
+// in the specified ConfigMap of the configMapGenerator section of the provided
+// kustomization file.
+func findConfigMapLiteral(fileBytes []byte, configMap, key string) (string, error) {
+	kustomization := struct {
+		ConfigMapGenerator []struct {
+			Name     string   `yaml:"name"`
+			Literals []string `yaml:"literals"`
+		} `yaml:"configMapGenerator"`
+	}{}
+	if err := yaml.Unmarshal(fileBytes, &kustomization); err != nil {
+		return "", fmt.Errorf("error unmarshaling kustomization: %w", err)
+	}
+	for i, generator := range kustomization.ConfigMapGenerator {
+		if generator.Name != configMap {
+			continue
+		}
+		for j, literal := range generator.Literals {
+			if k, _, _ := strings.Cut(literal, "="); k == key {
+				return fmt.Sprintf("configMapGenerator.%d.literals.%d", i, j), nil
+			}
+		}
+		return "", fmt.Errorf(
+			"ConfigMap %q in configMapGenerator has no literal with key %q",
+			configMap,
+			key,
+		)
+	}
+	return "", fmt.Errorf("no ConfigMap %q found in configMapGenerator", configMap)
+}
@@ -0,0 +1,131 @@
+package kustomize
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetConfigMapLiteral(t *testing.T) {
+	const testKustomization = `resources:
+- deployment.yaml
+configMapGenerator:
+- name: other-config
+  literals:
+  - IMAGE=other-image:1.0.0
+- name: release-config
+  literals:
+  - LOG_LEVEL=info
+  # The image below is propagated by replacements
+  - IMAGE=fake-image:1.0.0
+replacements:
+- source:
+    kind: ConfigMap
+    name: release-config
+    fieldPath: data.IMAGE
+  targets:
+  - select:
+      kind: Deployment
+    fieldPaths:
+    - spec.template.spec.containers.[name=app].image
+    - spec.template.spec.initContainers.[name=migrate].image
+`
+	testCases := []struct {
+		name          string
+		fileName      string
+		configMap     string
+		key           string
+		assertions    func(t *testing.T, dir string, err error)
+		skipWriteFile bool
+	}{
+		{
+			name:          "no kustomization file",
+			skipWriteFile: true,
+			configMap:     "release-config",
+			key:           "IMAGE",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "no kustomization file found")
+			},
+		},
+		{
+			name:      "ConfigMap not found",
+			fileName:  "kustomization.yaml",
+			configMap: "bogus-config",
+			key:       "IMAGE",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, `no ConfigMap "bogus-config" found`)
+			},
+		},
+		{
+			name:      "literal not found",
+			fileName:  "kustomization.yaml",
+			configMap: "release-config",
+			key:       "BOGUS",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, `has no literal with key "BOGUS"`)
+			},
+		},
+		{
+			name:      "success",
+			fileName:  "kustomization.yml",
+			configMap: "release-config",
+			key:       "IMAGE",
+			assertions: func(t *testing.T, dir string, err error) {
+				require.NoError(t, err)
+				fileBytes, err := os.ReadFile(filepath.Join(dir, "kustomization.yml"))
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`resources:
+- deployment.yaml
+configMapGenerator:
+- name: other-config
+  literals:
+  - IMAGE=other-image:1.0.0
+- name: release-config
+  literals:
+  - LOG_LEVEL=info
+  # The image below is propagated by replacements
+  - IMAGE=fake-image:2.0.0
+replacements:
+- source:
+    kind: ConfigMap
+    name: release-config
+    fieldPath: data.IMAGE
+  targets:
+  - select:
+      kind: Deployment
+    fieldPaths:
+    - spec.template.spec.containers.[name=app].image
+    - spec.template.spec.initContainers.[name=migrate].image
+`,
+					string(fileBytes),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if !testCase.skipWriteFile {
+				require.NoError(
+					t,
+					os.WriteFile(
+						filepath.Join(dir, testCase.fileName),
+						[]byte(testKustomization),
+						0600,
+					),
+				)
+			}
+			err := SetConfigMapLiteral(
+				dir,
+				testCase.configMap,
+				testCase.key,
+				"fake-image:2.0.0",
+			)
+			testCase.assertions(t, dir, err)
+		})
+	}
+}
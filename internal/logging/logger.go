@@ -23,6 +23,17 @@ const (
 	TraceLevel = Level(logrus.TraceLevel)
 )
 
+// Format is the format in which log entries are written.
+type Format string
+
+const (
+	// ConsoleFormat writes log entries as human-readable text.
+	ConsoleFormat Format = "console"
+	// JSONFormat writes each log entry as a JSON object, with the message,
+	// level, and all key-value pairs as fields of the object.
+	JSONFormat Format = "json"
+)
+
 type loggerContextKey struct{}
 
 var globalLogger *Logger
@@ -43,6 +54,13 @@ func init() {
 	}
 	logrusLogger.SetLevel(level)
 
+	formatStr := os.GetEnv("LOG_FORMAT", string(ConsoleFormat))
+	formatter, err := newFormatter(Format(formatStr))
+	if err != nil {
+		panic(err)
+	}
+	logrusLogger.SetFormatter(formatter)
+
 	logrLogger := logrusr.New(logrusLogger)
 	globalLogger = &Logger{}
 	globalLogger.callStackHelper, globalLogger.logger = logrLogger.WithCallStackHelper()
@@ -56,6 +74,18 @@ func init() {
 	runtimelog.SetLogger(globalLogger.logger)
 }
 
+// newFormatter returns a logrus.Formatter for the provided Format.
+func newFormatter(format Format) (logrus.Formatter, error) {
+	switch format {
+	case ConsoleFormat:
+		return &logrus.TextFormatter{}, nil
+	case JSONFormat:
+		return &logrus.JSONFormatter{}, nil
+	default:
+		return nil, fmt.Errorf("invalid log format %q", format)
+	}
+}
+
 // Logger is a wrapper around logr.Logger that provides a more ergonomic API.
 // This is heavily inspired by a similar wrapper from
 // https://github.com/kubernetes-sigs/cluster-api-provider-aws
@@ -1,9 +1,14 @@
 package logging
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"testing"
 
+	"github.com/bombsimon/logrusr/v4"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,3 +29,66 @@ func TestLoggerFromContext(t *testing.T) {
 	ctx := context.WithValue(context.Background(), loggerContextKey{}, testLogger)
 	require.Same(t, testLogger, LoggerFromContext(ctx))
 }
+
+func TestNewFormatter(t *testing.T) {
+	testCases := []struct {
+		name       string
+		format     Format
+		assertions func(*testing.T, logrus.Formatter, error)
+	}{
+		{
+			name:   "console",
+			format: ConsoleFormat,
+			assertions: func(t *testing.T, formatter logrus.Formatter, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &logrus.TextFormatter{}, formatter)
+			},
+		},
+		{
+			name:   "json",
+			format: JSONFormat,
+			assertions: func(t *testing.T, formatter logrus.Formatter, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &logrus.JSONFormatter{}, formatter)
+			},
+		},
+		{
+			name:   "invalid",
+			format: "bogus",
+			assertions: func(t *testing.T, _ logrus.Formatter, err error) {
+				require.ErrorContains(t, err, `invalid log format "bogus"`)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			formatter, err := newFormatter(testCase.format)
+			testCase.assertions(t, formatter, err)
+		})
+	}
+}
+
+func TestJSONFormat(t *testing.T) {
+	formatter, err := newFormatter(JSONFormat)
+	require.NoError(t, err)
+	buf := &bytes.Buffer{}
+	logrusLogger := logrus.New()
+	logrusLogger.SetOutput(buf)
+	logrusLogger.SetFormatter(formatter)
+	logger := Wrap(logrusr.New(logrusLogger))
+
+	logger.WithValues("namespace", "fake-namespace", "stage", "fake-stage").Error(
+		errors.New("something went wrong"),
+		"error promoting Freight",
+		"freight", "fake-freight",
+	)
+
+	entry := map[string]any{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	require.Equal(t, "error promoting Freight", entry["msg"])
+	require.Equal(t, "error", entry["level"])
+	require.Equal(t, "fake-namespace", entry["namespace"])
+	require.Equal(t, "fake-stage", entry["stage"])
+	require.Equal(t, "fake-freight", entry["freight"])
+	require.Equal(t, "something went wrong", entry["error"])
+}
@@ -0,0 +1,218 @@
+package sops
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	sigyaml "sigs.k8s.io/yaml"
+
+	libExec "github.com/akuity/kargo/internal/exec"
+)
+
+const (
+	// fileSuffix is the suffix of the names of files that are decrypted and
+	// re-encrypted by DecryptFiles and EncryptFiles.
+	fileSuffix = ".sops.yaml"
+	// configFileName is the name of the SOPS configuration file, which shares
+	// the suffix of encrypted files, but is not itself encrypted.
+	configFileName = ".sops.yaml"
+)
+
+// File is a SOPS-encrypted YAML file that has been decrypted in place.
+type File struct {
+	// Path is the absolute path to the file.
+	Path string
+	// encrypted is the content of the file before it was decrypted.
+	encrypted []byte
+	// decrypted is the content of the file after it was decrypted.
+	decrypted []byte
+	// metadata is the SOPS metadata of the file before it was decrypted.
+	metadata metadata
+}
+
+// metadata is the subset of the metadata SOPS stores alongside encrypted
+// values that is needed to re-encrypt a file the way it was encrypted.
+type metadata struct {
+	Age               []ageKey `json:"age"`
+	EncryptedRegex    string   `json:"encrypted_regex"`
+	UnencryptedRegex  string   `json:"unencrypted_regex"`
+	EncryptedSuffix   string   `json:"encrypted_suffix"`
+	UnencryptedSuffix string   `json:"unencrypted_suffix"`
+}
+
+// ageKey is the metadata of an age recipient a file is encrypted for.
+type ageKey struct {
+	Recipient string `json:"recipient"`
+}
+
+// DecryptFiles decrypts, in place, every file beneath the specified directory
+// whose name ends in .sops.yaml using the provided age private key. The SOPS
+// configuration file (.sops.yaml) and .git directories are skipped. The
+// decrypted files are returned so they can later be re-encrypted using
+// EncryptFiles. If decrypting any file fails, every file decrypted up to that
+// point is restored to its encrypted content.
+func DecryptFiles(ctx context.Context, dir string, ageKey string) ([]*File, error) {
+	var files []*File
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.Name() == configFileName || !strings.HasSuffix(d.Name(), fileSuffix) {
+			return nil
+		}
+		file, err := decryptFile(ctx, path, ageKey)
+		if err != nil {
+			return err
+		}
+		files = append(files, file)
+		return nil
+	})
+	if err != nil {
+		for _, file := range files {
+			_ = file.restore()
+		}
+		return nil, err
+	}
+	return files, nil
+}
+
+// EncryptFiles re-encrypts, in place, the provided files previously decrypted
+// by DecryptFiles. Each file is encrypted for the same age recipients it was
+// originally encrypted for. Files whose decrypted content is unchanged are
+// restored to their original encrypted content instead, so that they are not
+// needlessly modified.
+func EncryptFiles(ctx context.Context, files []*File) error {
+	for _, file := range files {
+		if err := file.encrypt(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decryptFile decrypts the file at the specified path in place using the
+// provided age private key.
+func decryptFile(ctx context.Context, path string, ageKey string) (*File, error) {
+	encrypted, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading SOPS-encrypted file %q: %w", path, err)
+	}
+	file := &File{
+		Path:      path,
+		encrypted: encrypted,
+	}
+	if file.metadata, err = parseMetadata(encrypted); err != nil {
+		return nil, fmt.Errorf("error parsing SOPS metadata of file %q: %w", path, err)
+	}
+	if len(file.metadata.Age) == 0 {
+		return nil, fmt.Errorf("file %q is not encrypted for any age recipients", path)
+	}
+	if _, err = libExec.Exec(buildDecryptCmd(ctx, path, ageKey)); err != nil {
+		return nil, fmt.Errorf("error decrypting file %q: %w", path, err)
+	}
+	if file.decrypted, err = os.ReadFile(path); err != nil {
+		_ = file.restore()
+		return nil, fmt.Errorf("error reading decrypted file %q: %w", path, err)
+	}
+	return file, nil
+}
+
+// encrypt re-encrypts the file in place for the age recipients it was
+// originally encrypted for. If the decrypted content of the file is unchanged,
+// the original encrypted content is restored instead.
+func (f *File) encrypt(ctx context.Context) error {
+	content, err := os.ReadFile(f.Path)
+	if err != nil {
+		return fmt.Errorf("error reading decrypted file %q: %w", f.Path, err)
+	}
+	if bytes.Equal(content, f.decrypted) {
+		return f.restore()
+	}
+	if _, err = libExec.Exec(buildEncryptCmd(ctx, f.Path, f.metadata)); err != nil {
+		return fmt.Errorf("error encrypting file %q: %w", f.Path, err)
+	}
+	return nil
+}
+
+// restore writes the original encrypted content of the file back to it.
+func (f *File) restore() error {
+	if err := os.WriteFile(f.Path, f.encrypted, 0600); err != nil {
+		return fmt.Errorf("error restoring encrypted file %q: %w", f.Path, err)
+	}
+	return nil
+}
+
+// parseMetadata parses the SOPS metadata of the provided encrypted YAML.
+func parseMetadata(encrypted []byte) (metadata, error) {
+	doc := struct {
+		SOPS *metadata `json:"sops"`
+	}{}
+	if err := sigyaml.Unmarshal(encrypted, &doc); err != nil {
+		return metadata{}, err
+	}
+	if doc.SOPS == nil {
+		return metadata{}, errors.New("no SOPS metadata found")
+	}
+	return *doc.SOPS, nil
+}
+
+func buildDecryptCmd(ctx context.Context, path string, ageKey string) *exec.Cmd {
+	cmd := exec.CommandContext( // nolint: gosec
+		ctx,
+		"sops",
+		"--decrypt",
+		"--input-type", "yaml",
+		"--output-type", "yaml",
+		"--in-place",
+		path,
+	)
+	cmd.Env = append(cmd.Env, os.Environ()...)
+	cmd.Env = append(cmd.Env, "SOPS_AGE_KEY="+ageKey)
+	cmd.Dir = filepath.Dir(path)
+	return cmd
+}
+
+func buildEncryptCmd(ctx context.Context, path string, md metadata) *exec.Cmd {
+	recipients := make([]string, len(md.Age))
+	for i, age := range md.Age {
+		recipients[i] = age.Recipient
+	}
+	args := []string{
+		"--encrypt",
+		"--input-type", "yaml",
+		"--output-type", "yaml",
+		"--age", strings.Join(recipients, ","),
+	}
+	// Preserve which values of the file are encrypted.
+	for _, opt := range []struct {
+		flag  string
+		value string
+	}{
+		{"--encrypted-regex", md.EncryptedRegex},
+		{"--unencrypted-regex", md.UnencryptedRegex},
+		{"--encrypted-suffix", md.EncryptedSuffix},
+		{"--unencrypted-suffix", md.UnencryptedSuffix},
+	} {
+		if opt.value != "" {
+			args = append(args, opt.flag, opt.value)
+		}
+	}
+	args = append(args, "--in-place", path)
+	cmd := exec.CommandContext(ctx, "sops", args...) // nolint: gosec
+	cmd.Env = append(cmd.Env, os.Environ()...)
+	cmd.Dir = filepath.Dir(path)
+	return cmd
+}
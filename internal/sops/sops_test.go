@@ -0,0 +1,206 @@
+package sops
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sigyaml "sigs.k8s.io/yaml"
+
+	libExec "github.com/akuity/kargo/internal/exec"
+)
+
+// The following age key pair was generated for testing purposes only.
+const (
+	testAgeKey       = "AGE-SECRET-KEY-13JMLWYFYG4DD4EVZ3X7Z27C0A8LNJ3KEW9K4V0PN8JP3U9ZSMP6SZVUDAA"
+	testAgeRecipient = "age1kser9jcuam82zkw2xkss9nwpmptkk3p6rf7p4cl668exzllj2p4s7znpw8"
+)
+
+func TestBuildDecryptCmd(t *testing.T) {
+	cmd := buildDecryptCmd(context.Background(), "/some-dir/values.sops.yaml", testAgeKey)
+	require.NotNil(t, cmd)
+	require.True(t, strings.HasSuffix(cmd.Path, "sops"))
+	require.Equal(
+		t,
+		[]string{
+			"sops",
+			"--decrypt",
+			"--input-type", "yaml",
+			"--output-type", "yaml",
+			"--in-place",
+			"/some-dir/values.sops.yaml",
+		},
+		cmd.Args,
+	)
+	require.Contains(t, cmd.Env, "SOPS_AGE_KEY="+testAgeKey)
+	require.Equal(t, "/some-dir", cmd.Dir)
+}
+
+func TestBuildEncryptCmd(t *testing.T) {
+	md := metadata{
+		Age:            []ageKey{{Recipient: testAgeRecipient}, {Recipient: "age1other"}},
+		EncryptedRegex: "^password$",
+	}
+	cmd := buildEncryptCmd(context.Background(), "/some-dir/values.sops.yaml", md)
+	require.NotNil(t, cmd)
+	require.Equal(
+		t,
+		[]string{
+			"sops",
+			"--encrypt",
+			"--input-type", "yaml",
+			"--output-type", "yaml",
+			"--age", testAgeRecipient + ",age1other",
+			"--encrypted-regex", "^password$",
+			"--in-place",
+			"/some-dir/values.sops.yaml",
+		},
+		cmd.Args,
+	)
+	for _, env := range cmd.Env {
+		require.False(t, strings.HasPrefix(env, "SOPS_AGE_KEY="))
+	}
+	require.Equal(t, "/some-dir", cmd.Dir)
+}
+
+func TestParseMetadata(t *testing.T) {
+	testCases := []struct {
+		name       string
+		content    string
+		assertions func(*testing.T, metadata, error)
+	}{
+		{
+			name:    "invalid YAML",
+			content: "{",
+			assertions: func(t *testing.T, _ metadata, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:    "no SOPS metadata",
+			content: "foo: bar\n",
+			assertions: func(t *testing.T, _ metadata, err error) {
+				require.ErrorContains(t, err, "no SOPS metadata found")
+			},
+		},
+		{
+			name: "SOPS metadata",
+			content: `foo: ENC[AES256_GCM,data:abc,type:str]
+sops:
+  age:
+  - recipient: ` + testAgeRecipient + `
+    enc: fake
+  encrypted_regex: ^foo$
+  version: 3.9.0
+`,
+			assertions: func(t *testing.T, md metadata, err error) {
+				require.NoError(t, err)
+				require.Len(t, md.Age, 1)
+				require.Equal(t, testAgeRecipient, md.Age[0].Recipient)
+				require.Equal(t, "^foo$", md.EncryptedRegex)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			md, err := parseMetadata([]byte(testCase.content))
+			testCase.assertions(t, md, err)
+		})
+	}
+}
+
+func TestDecryptFiles(t *testing.T) {
+	t.Run("skips config and unrelated files", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ".sops.yaml"), []byte("creation_rules: []\n"), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "values.yaml"), []byte("foo: bar\n"), 0600))
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, ".git"), 0700))
+		require.NoError(
+			t,
+			os.WriteFile(filepath.Join(dir, ".git", "ignored.sops.yaml"), []byte("foo: bar\n"), 0600),
+		)
+		files, err := DecryptFiles(context.Background(), dir, testAgeKey)
+		require.NoError(t, err)
+		require.Empty(t, files)
+	})
+
+	t.Run("file without SOPS metadata", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "values.sops.yaml"), []byte("foo: bar\n"), 0600))
+		_, err := DecryptFiles(context.Background(), dir, testAgeKey)
+		require.ErrorContains(t, err, "error parsing SOPS metadata")
+		require.ErrorContains(t, err, "no SOPS metadata found")
+	})
+}
+
+func TestEncryptFilesRestoresUnchangedFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "values.sops.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("foo: bar\n"), 0600))
+	files := []*File{{
+		Path:      path,
+		encrypted: []byte("foo: ENC[AES256_GCM,data:abc,type:str]\n"),
+		decrypted: []byte("foo: bar\n"),
+	}}
+	require.NoError(t, EncryptFiles(context.Background(), files))
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "foo: ENC[AES256_GCM,data:abc,type:str]\n", string(content))
+}
+
+func TestRoundTrip(t *testing.T) {
+	if _, err := exec.LookPath("sops"); err != nil {
+		t.Skip("sops is not installed")
+	}
+	ctx := context.Background()
+
+	plaintext, err := os.ReadFile(filepath.Join("testdata", "values.yaml"))
+	require.NoError(t, err)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "values.sops.yaml")
+	require.NoError(t, os.WriteFile(path, plaintext, 0600))
+	cmd := exec.CommandContext( // nolint: gosec
+		ctx,
+		"sops",
+		"--encrypt",
+		"--age", testAgeRecipient,
+		"--in-place",
+		path,
+	)
+	_, err = libExec.Exec(cmd)
+	require.NoError(t, err)
+	encrypted, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(encrypted), "not-a-real-password")
+
+	// Decrypting yields the original content.
+	files, err := DecryptFiles(ctx, dir, testAgeKey)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	decrypted, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.YAMLEq(t, string(plaintext), string(decrypted))
+
+	// Re-encrypting a modified file encrypts the modification.
+	modified := strings.ReplaceAll(string(decrypted), "v1.0.0", "v1.1.0")
+	require.NoError(t, os.WriteFile(path, []byte(modified), 0600))
+	require.NoError(t, EncryptFiles(ctx, files))
+	reencrypted, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(reencrypted), "not-a-real-password")
+	md, err := parseMetadata(reencrypted)
+	require.NoError(t, err)
+	require.Equal(t, testAgeRecipient, md.Age[0].Recipient)
+
+	files, err = DecryptFiles(ctx, dir, testAgeKey)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	decrypted, err = os.ReadFile(path)
+	require.NoError(t, err)
+	values := map[string]any{}
+	require.NoError(t, sigyaml.Unmarshal(decrypted, &values))
+	require.Equal(t, "v1.1.0", values["image"].(map[string]any)["tag"]) // nolint: forcetypeassert
+}
@@ -0,0 +1,49 @@
+package validation
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// ValidateProjectSpec validates the provided ProjectSpec beyond what can be
+// expressed by its declarative validations. The returned errors are relative to
+// the provided field path.
+func ValidateProjectSpec(
+	f *field.Path,
+	spec *kargoapi.ProjectSpec,
+) field.ErrorList {
+	if spec == nil { // nil spec is valid
+		return nil
+	}
+	return validatePromotionPolicies(
+		f.Child("promotionPolicies"),
+		spec.PromotionPolicies,
+	)
+}
+
+func validatePromotionPolicies(
+	f *field.Path,
+	promotionPolicies []kargoapi.PromotionPolicy,
+) field.ErrorList {
+	stageNames := make(map[string]struct{}, len(promotionPolicies))
+	for _, promotionPolicy := range promotionPolicies {
+		if _, found := stageNames[promotionPolicy.Stage]; found {
+			return field.ErrorList{
+				field.Invalid(
+					f,
+					promotionPolicies,
+					fmt.Sprintf(
+						"multiple %s reference stage %s",
+						f.String(),
+						promotionPolicy.Stage,
+					),
+				),
+			}
+		}
+		stageNames[promotionPolicy.Stage] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestValidateProjectSpec(t *testing.T) {
+	testCases := []struct {
+		name       string
+		spec       *kargoapi.ProjectSpec
+		assertions func(*testing.T, *kargoapi.ProjectSpec, field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(t *testing.T, _ *kargoapi.ProjectSpec, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name: "invalid",
+			spec: &kargoapi.ProjectSpec{
+				// Has two conflicting PromotionPolicies...
+				PromotionPolicies: []kargoapi.PromotionPolicy{
+					{Stage: "fake-stage"},
+					{Stage: "fake-stage"},
+				},
+			},
+			assertions: func(t *testing.T, spec *kargoapi.ProjectSpec, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.promotionPolicies",
+							BadValue: spec.PromotionPolicies,
+							Detail:   "multiple spec.promotionPolicies reference stage fake-stage",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "valid",
+			spec: &kargoapi.ProjectSpec{
+				PromotionPolicies: []kargoapi.PromotionPolicy{
+					{Stage: "fake-stage"},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.ProjectSpec, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				testCase.spec,
+				ValidateProjectSpec(field.NewPath("spec"), testCase.spec),
+			)
+		})
+	}
+}
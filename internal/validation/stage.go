@@ -0,0 +1,280 @@
+package validation
+
+import (
+	"fmt"
+	"net/mail"
+	"text/template"
+
+	"github.com/robfig/cron/v3"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/expression"
+)
+
+// ValidateStageSpec validates the provided StageSpec beyond what can be
+// expressed by its declarative validations. The returned errors are relative to
+// the provided field path.
+func ValidateStageSpec(
+	f *field.Path,
+	spec *kargoapi.StageSpec,
+) field.ErrorList {
+	if spec == nil { // nil spec is caught by declarative validations
+		return nil
+	}
+	errs := validateRequestedFreight(f.Child("requestedFreight"), spec.RequestedFreight)
+	errs = append(
+		errs,
+		validatePromotionSchedule(f.Child("promotionSchedule"), spec.PromotionSchedule)...,
+	)
+	errs = append(
+		errs,
+		validateAutoPromotionExpression(
+			f.Child("autoPromotionExpression"),
+			spec.AutoPromotionExpression,
+		)...,
+	)
+	if spec.PromotionTemplateRef != nil && spec.PromotionMechanisms != nil {
+		// PromotionMechanisms that are merged with those of a PromotionTemplate
+		// may consist solely of overrides, so they need not define any updates.
+		return append(
+			errs,
+			validateGitRepoUpdates(
+				f.Child("promotionMechanisms", "gitRepoUpdates"),
+				spec.PromotionMechanisms.GitRepoUpdates,
+			)...,
+		)
+	}
+	return append(
+		errs,
+		validatePromotionMechanisms(
+			f.Child("promotionMechanisms"),
+			spec.PromotionMechanisms,
+		)...,
+	)
+}
+
+func validateRequestedFreight(
+	f *field.Path,
+	reqs []kargoapi.FreightRequest,
+) field.ErrorList {
+	// Make sure every request names at least one source. Requests may combine
+	// Direct with upstream Stages, in which case Freight available from either
+	// source is eligible for promotion.
+	var errs field.ErrorList
+	for i, req := range reqs {
+		if !req.Sources.Direct && len(req.Sources.Stages) == 0 {
+			sourcesPath := f.Index(i).Child("sources")
+			errs = append(
+				errs,
+				field.Required(
+					sourcesPath,
+					fmt.Sprintf(
+						"%s must be true or %s must be non-empty",
+						sourcesPath.Child("direct").String(),
+						sourcesPath.Child("stages").String(),
+					),
+				),
+			)
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	// Make sure the same origin is not requested multiple times
+	seenOrigins := make(map[string]struct{}, len(reqs))
+	for _, req := range reqs {
+		if _, seen := seenOrigins[req.Origin.String()]; seen {
+			return field.ErrorList{
+				field.Invalid(
+					f,
+					reqs,
+					fmt.Sprintf(
+						"freight with origin %s requested multiple times in %s",
+						req.Origin.String(),
+						f.String(),
+					),
+				),
+			}
+		}
+		seenOrigins[req.Origin.String()] = struct{}{}
+	}
+	return nil
+}
+
+func validatePromotionSchedule(f *field.Path, schedule string) field.ErrorList {
+	if schedule == "" {
+		return nil
+	}
+	if _, err := cron.ParseStandard(schedule); err != nil {
+		return field.ErrorList{field.Invalid(f, schedule, err.Error())}
+	}
+	return nil
+}
+
+func validateAutoPromotionExpression(f *field.Path, expr string) field.ErrorList {
+	if expr == "" {
+		return nil
+	}
+	if _, err := expression.CompileAutoPromotion(expr); err != nil {
+		return field.ErrorList{field.Invalid(f, expr, err.Error())}
+	}
+	return nil
+}
+
+func validatePromotionMechanisms(
+	f *field.Path,
+	promoMechs *kargoapi.PromotionMechanisms,
+) field.ErrorList {
+	if promoMechs == nil { // nil promoMechs is caught by declarative validations
+		return nil
+	}
+	// Must define at least one mechanism
+	if len(promoMechs.GitRepoUpdates) == 0 &&
+		len(promoMechs.ArgoCDAppUpdates) == 0 &&
+		len(promoMechs.FluxHelmReleaseUpdates) == 0 &&
+		len(promoMechs.KubernetesResourceUpdates) == 0 {
+		return field.ErrorList{
+			field.Invalid(
+				f,
+				promoMechs,
+				fmt.Sprintf(
+					"at least one of %s.gitRepoUpdates, %s.argoCDAppUpdates, "+
+						"%s.fluxHelmReleaseUpdates, or %s.kubernetesResourceUpdates "+
+						"must be non-empty",
+					f.String(),
+					f.String(),
+					f.String(),
+					f.String(),
+				),
+			),
+		}
+	}
+	return validateGitRepoUpdates(
+		f.Child("gitRepoUpdates"),
+		promoMechs.GitRepoUpdates,
+	)
+}
+
+func validateGitRepoUpdates(
+	f *field.Path,
+	updates []kargoapi.GitRepoUpdate,
+) field.ErrorList {
+	var errs field.ErrorList
+	for i, update := range updates {
+		errs = append(errs, validateGitRepoUpdate(f.Index(i), update)...)
+	}
+	return errs
+}
+
+func validateGitRepoUpdate(
+	f *field.Path,
+	update kargoapi.GitRepoUpdate,
+) field.ErrorList {
+	var count int
+	if update.Render != nil {
+		count++
+	}
+	if update.Kustomize != nil {
+		count++
+	}
+	if update.Helm != nil {
+		count++
+	}
+	if count > 1 {
+		return field.ErrorList{
+			field.Invalid(
+				f,
+				update,
+				fmt.Sprintf(
+					"no more than one of %s.render, or %s.kustomize, or %s.helm may "+
+						"be defined",
+					f.String(),
+					f.String(),
+					f.String(),
+				),
+			),
+		}
+	}
+	errs := validateHelmPromotionMechanism(f.Child("helm"), update.Helm)
+	if update.CommitMessageTemplate != "" {
+		if _, err := template.New("commitMessage").Parse(update.CommitMessageTemplate); err != nil {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Child("commitMessageTemplate"),
+					update.CommitMessageTemplate,
+					fmt.Sprintf("error parsing commit message template: %s", err),
+				),
+			)
+		}
+	}
+	if update.AuthorEmail != "" {
+		if addr, err := mail.ParseAddress(update.AuthorEmail); err != nil ||
+			addr.Address != update.AuthorEmail {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Child("authorEmail"),
+					update.AuthorEmail,
+					"must be a valid email address",
+				),
+			)
+		}
+	}
+	if update.SOPSDecryptBeforeApply && update.SOPSAgeKeySecretRef == nil {
+		errs = append(
+			errs,
+			field.Required(
+				f.Child("sopsAgeKeySecretRef"),
+				fmt.Sprintf(
+					"must be specified when %s is true",
+					f.Child("sopsDecryptBeforeApply").String(),
+				),
+			),
+		)
+	}
+	return errs
+}
+
+func validateHelmPromotionMechanism(
+	f *field.Path,
+	promoMech *kargoapi.HelmPromotionMechanism,
+) field.ErrorList {
+	if promoMech == nil {
+		return nil
+	}
+	// This mechanism must define at least one change to apply
+	if len(promoMech.Images) == 0 && len(promoMech.Charts) == 0 &&
+		len(promoMech.ValuesFiles) == 0 && promoMech.ValuesOverrides == nil &&
+		promoMech.UmbrellaChartPath == "" {
+		return field.ErrorList{
+			field.Invalid(
+				f,
+				promoMech,
+				fmt.Sprintf(
+					"at least one of %s.images, %s.charts or %s.valuesFiles must be "+
+						"non-empty or %s.valuesOverrides or %s.umbrellaChartPath must be defined",
+					f.String(),
+					f.String(),
+					f.String(),
+					f.String(),
+					f.String(),
+				),
+			),
+		}
+	}
+	if promoMech.ValuesOverrides != nil && promoMech.ValuesFilePath == "" {
+		return field.ErrorList{
+			field.Required(
+				f.Child("valuesFilePath"),
+				fmt.Sprintf(
+					"%s.valuesFilePath must be defined when %s.valuesOverrides is defined",
+					f.String(),
+					f.String(),
+				),
+			),
+		}
+	}
+	return nil
+}
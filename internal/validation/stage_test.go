@@ -0,0 +1,708 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestValidateStageSpec(t *testing.T) {
+	testFreightRequest := kargoapi.FreightRequest{
+		Origin: kargoapi.FreightOrigin{
+			Kind: kargoapi.FreightOriginKindWarehouse,
+			Name: "test-warehouse",
+		},
+		Sources: kargoapi.FreightSources{
+			Direct: true,
+		},
+	}
+	testCases := []struct {
+		name       string
+		spec       *kargoapi.StageSpec
+		assertions func(*testing.T, *kargoapi.StageSpec, field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(t *testing.T, _ *kargoapi.StageSpec, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "invalid",
+			spec: &kargoapi.StageSpec{
+				// Has multiple sources for one Freight origin...
+				RequestedFreight: []kargoapi.FreightRequest{
+					testFreightRequest,
+					testFreightRequest,
+				},
+				// Doesn't actually define any mechanisms...
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+			},
+			assertions: func(t *testing.T, spec *kargoapi.StageSpec, errs field.ErrorList) {
+				// We really want to see that all underlying errors have been bubbled up
+				// to this level and been aggregated.
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.requestedFreight",
+							BadValue: spec.RequestedFreight,
+							Detail: `freight with origin Warehouse/test-warehouse requested multiple ` +
+								"times in spec.requestedFreight",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.promotionMechanisms",
+							BadValue: spec.PromotionMechanisms,
+							Detail: "at least one of " +
+								"spec.promotionMechanisms.gitRepoUpdates, " +
+								"spec.promotionMechanisms.argoCDAppUpdates, " +
+								"spec.promotionMechanisms.fluxHelmReleaseUpdates, or " +
+								"spec.promotionMechanisms.kubernetesResourceUpdates must be non-empty",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			spec: &kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{
+					testFreightRequest,
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.StageSpec, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "valid overrides of PromotionTemplate",
+			spec: &kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{
+					testFreightRequest,
+				},
+				PromotionTemplateRef: &corev1.LocalObjectReference{
+					Name: "fake-template",
+				},
+				// Only overrides the image pull check of the PromotionTemplate...
+				PromotionMechanisms: &kargoapi.PromotionMechanisms{
+					ImagePullCheck: &kargoapi.ImagePullCheck{},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.StageSpec, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				testCase.spec,
+				ValidateStageSpec(
+					field.NewPath("spec"),
+					testCase.spec,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateRequestedFreight(t *testing.T) {
+	testFreightRequest := kargoapi.FreightRequest{
+		Origin: kargoapi.FreightOrigin{
+			Kind: kargoapi.FreightOriginKindWarehouse,
+			Name: "test-warehouse",
+		},
+		Sources: kargoapi.FreightSources{
+			Direct: true,
+		},
+	}
+	testCases := []struct {
+		name       string
+		reqs       []kargoapi.FreightRequest
+		assertions func(*testing.T, []kargoapi.FreightRequest, field.ErrorList)
+	}{
+		{
+			name: "Freight origin found multiple times",
+			reqs: []kargoapi.FreightRequest{
+				testFreightRequest,
+				testFreightRequest,
+			},
+			assertions: func(t *testing.T, reqs []kargoapi.FreightRequest, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "requestedFreight",
+							BadValue: reqs,
+							Detail: `freight with origin Warehouse/test-warehouse requested ` +
+								"multiple times in requestedFreight",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "Freight request without sources",
+			reqs: []kargoapi.FreightRequest{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "test-warehouse",
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.FreightRequest, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeRequired,
+							Field:    "requestedFreight[0].sources",
+							BadValue: "",
+							Detail: "requestedFreight[0].sources.direct must be true or " +
+								"requestedFreight[0].sources.stages must be non-empty",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "Freight request combining direct and upstream sources",
+			reqs: []kargoapi.FreightRequest{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "test-warehouse",
+					},
+					Sources: kargoapi.FreightSources{
+						Direct: true,
+						Stages: []string{"upstream-stage"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.FreightRequest, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "success",
+			reqs: []kargoapi.FreightRequest{
+				testFreightRequest,
+			},
+			assertions: func(t *testing.T, _ []kargoapi.FreightRequest, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				testCase.reqs,
+				validateRequestedFreight(
+					field.NewPath("requestedFreight"),
+					testCase.reqs,
+				),
+			)
+		})
+	}
+}
+
+func TestValidatePromotionSchedule(t *testing.T) {
+	testCases := []struct {
+		name       string
+		schedule   string
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "no schedule",
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name:     "invalid schedule",
+			schedule: "0 25 * * *",
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "promotionSchedule", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "above maximum (23)")
+			},
+		},
+		{
+			name:     "success",
+			schedule: "0 2 * * mon-fri",
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				validatePromotionSchedule(
+					field.NewPath("promotionSchedule"),
+					testCase.schedule,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateAutoPromotionExpression(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expr       string
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "no expression",
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name: "invalid syntax",
+			expr: `freight.images.all(i, `,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "autoPromotionExpression", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "Syntax error")
+			},
+		},
+		{
+			name: "expression does not evaluate to a bool",
+			expr: `"fake-string"`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Contains(t, errs[0].Detail, "must evaluate to a bool")
+			},
+		},
+		{
+			name: "success",
+			expr: `freight.images.all(i, !i.tag.contains("-rc"))`,
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				validateAutoPromotionExpression(
+					field.NewPath("autoPromotionExpression"),
+					testCase.expr,
+				),
+			)
+		})
+	}
+}
+
+func TestValidatePromotionMechanisms(t *testing.T) {
+	testCases := []struct {
+		name       string
+		promoMechs *kargoapi.PromotionMechanisms
+		assertions func(*testing.T, *kargoapi.PromotionMechanisms, field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(t *testing.T, _ *kargoapi.PromotionMechanisms, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "invalid",
+			// Does not define any mechanisms
+			promoMechs: &kargoapi.PromotionMechanisms{},
+			assertions: func(
+				t *testing.T,
+				promoMechs *kargoapi.PromotionMechanisms,
+				errs field.ErrorList,
+			) {
+				require.NotNil(t, errs)
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "promotionMechanisms",
+							BadValue: promoMechs,
+							Detail: "at least one of promotionMechanisms.gitRepoUpdates, " +
+								"promotionMechanisms.argoCDAppUpdates, " +
+								"promotionMechanisms.fluxHelmReleaseUpdates, or " +
+								"promotionMechanisms.kubernetesResourceUpdates must be non-empty",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				GitRepoUpdates: []kargoapi.GitRepoUpdate{
+					{
+						Kustomize: &kargoapi.KustomizePromotionMechanism{},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionMechanisms, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+
+		{
+			name: "valid with only Kubernetes resource updates",
+			promoMechs: &kargoapi.PromotionMechanisms{
+				KubernetesResourceUpdates: []kargoapi.KubernetesResourceUpdate{{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "fake-deployment",
+					JSONPatch:  `[{"op":"add","path":"/metadata/annotations/foo","value":"bar"}]`,
+				}},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.PromotionMechanisms, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				testCase.promoMechs,
+				validatePromotionMechanisms(
+					field.NewPath("promotionMechanisms"),
+					testCase.promoMechs,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateGitRepoUpdates(t *testing.T) {
+	testCases := []struct {
+		name       string
+		update     kargoapi.GitRepoUpdate
+		assertions func(*testing.T, kargoapi.GitRepoUpdate, field.ErrorList)
+	}{
+		{
+			name: "more than one config management tool specified",
+			update: kargoapi.GitRepoUpdate{
+				Render:    &kargoapi.KargoRenderPromotionMechanism{},
+				Kustomize: &kargoapi.KustomizePromotionMechanism{},
+				Helm:      &kargoapi.HelmPromotionMechanism{},
+			},
+			assertions: func(t *testing.T, update kargoapi.GitRepoUpdate, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "gitRepoUpdates[0]",
+							BadValue: update,
+							Detail: "no more than one of gitRepoUpdates[0].render, or " +
+								"gitRepoUpdates[0].kustomize, or gitRepoUpdates[0].helm " +
+								"may be defined",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			update: kargoapi.GitRepoUpdate{
+				Kustomize: &kargoapi.KustomizePromotionMechanism{},
+			},
+			assertions: func(t *testing.T, _ kargoapi.GitRepoUpdate, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				testCase.update,
+				validateGitRepoUpdates(
+					field.NewPath("gitRepoUpdates"),
+					[]kargoapi.GitRepoUpdate{
+						testCase.update,
+					},
+				),
+			)
+		})
+	}
+}
+
+func TestValidateGitRepoUpdate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		update     kargoapi.GitRepoUpdate
+		assertions func(*testing.T, kargoapi.GitRepoUpdate, field.ErrorList)
+	}{
+		{
+			name: "more than one config management tool specified",
+			update: kargoapi.GitRepoUpdate{
+				Render:    &kargoapi.KargoRenderPromotionMechanism{},
+				Kustomize: &kargoapi.KustomizePromotionMechanism{},
+				Helm:      &kargoapi.HelmPromotionMechanism{},
+			},
+			assertions: func(t *testing.T, update kargoapi.GitRepoUpdate, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "gitRepoUpdate",
+							BadValue: update,
+							Detail: "no more than one of gitRepoUpdate.render, or " +
+								"gitRepoUpdate.kustomize, or gitRepoUpdate.helm may be " +
+								"defined",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "invalid commit message template",
+			update: kargoapi.GitRepoUpdate{
+				Kustomize:             &kargoapi.KustomizePromotionMechanism{},
+				CommitMessageTemplate: "{{ .Stage ",
+			},
+			assertions: func(t *testing.T, _ kargoapi.GitRepoUpdate, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "gitRepoUpdate.commitMessageTemplate", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "error parsing commit message template")
+			},
+		},
+
+		{
+			name: "invalid author email",
+			update: kargoapi.GitRepoUpdate{
+				Kustomize:   &kargoapi.KustomizePromotionMechanism{},
+				AuthorEmail: "Test Pipeline <test-pipeline@example.com>",
+			},
+			assertions: func(t *testing.T, _ kargoapi.GitRepoUpdate, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "gitRepoUpdate.authorEmail",
+							BadValue: "Test Pipeline <test-pipeline@example.com>",
+							Detail:   "must be a valid email address",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "SOPS decryption without age key",
+			update: kargoapi.GitRepoUpdate{
+				Kustomize:              &kargoapi.KustomizePromotionMechanism{},
+				SOPSDecryptBeforeApply: true,
+			},
+			assertions: func(t *testing.T, _ kargoapi.GitRepoUpdate, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeRequired,
+							Field:    "gitRepoUpdate.sopsAgeKeySecretRef",
+							BadValue: "",
+							Detail:   "must be specified when gitRepoUpdate.sopsDecryptBeforeApply is true",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid",
+			update: kargoapi.GitRepoUpdate{
+				Kustomize:              &kargoapi.KustomizePromotionMechanism{},
+				CommitMessageTemplate:  "Promote {{ .Stage }}\n\n{{ .DefaultMessage }}",
+				AuthorName:             "Test Pipeline",
+				AuthorEmail:            "test-pipeline@example.com",
+				SOPSDecryptBeforeApply: true,
+				SOPSAgeKeySecretRef: &kargoapi.SecretKeyReference{
+					Name: "sops-age-key",
+					Key:  "key.txt",
+				},
+			},
+			assertions: func(t *testing.T, _ kargoapi.GitRepoUpdate, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				testCase.update,
+				validateGitRepoUpdate(
+					field.NewPath("gitRepoUpdate"),
+					testCase.update,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateHelmPromotionMechanism(t *testing.T) {
+	testCases := []struct {
+		name       string
+		promoMech  *kargoapi.HelmPromotionMechanism
+		assertions func(*testing.T, *kargoapi.HelmPromotionMechanism, field.ErrorList)
+	}{
+		{
+			name: "nil",
+			assertions: func(t *testing.T, _ *kargoapi.HelmPromotionMechanism, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+
+		{
+			name: "invalid",
+			// Doesn't define any changes
+			promoMech: &kargoapi.HelmPromotionMechanism{},
+			assertions: func(
+				t *testing.T,
+				promoMech *kargoapi.HelmPromotionMechanism,
+				errs field.ErrorList,
+			) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "helm",
+							BadValue: promoMech,
+							Detail: "at least one of helm.images, helm.charts or helm.valuesFiles " +
+								"must be non-empty or helm.valuesOverrides or helm.umbrellaChartPath " +
+								"must be defined",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "values overrides without values file path",
+			promoMech: &kargoapi.HelmPromotionMechanism{
+				ValuesOverrides: &runtime.RawExtension{
+					Raw: []byte(`{"replicas":2}`),
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.HelmPromotionMechanism, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeRequired,
+							Field:    "helm.valuesFilePath",
+							BadValue: "",
+							Detail: "helm.valuesFilePath must be defined when " +
+								"helm.valuesOverrides is defined",
+						},
+					},
+					errs,
+				)
+			},
+		},
+
+		{
+			name: "valid with only values overrides",
+			promoMech: &kargoapi.HelmPromotionMechanism{
+				ValuesFilePath: "values.yaml",
+				ValuesOverrides: &runtime.RawExtension{
+					Raw: []byte(`{"replicas":2}`),
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.HelmPromotionMechanism, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+
+		{
+			name: "valid with only values files",
+			promoMech: &kargoapi.HelmPromotionMechanism{
+				ValuesFiles: []kargoapi.HelmValuesFileUpdate{{
+					Path:    "values.yaml",
+					Updates: map[string]string{"replicas": "2"},
+				}},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.HelmPromotionMechanism, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+
+		{
+			name: "valid with only umbrella chart path",
+			promoMech: &kargoapi.HelmPromotionMechanism{
+				UmbrellaChartPath: "charts/umbrella",
+			},
+			assertions: func(t *testing.T, _ *kargoapi.HelmPromotionMechanism, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+
+		{
+			name: "valid",
+			promoMech: &kargoapi.HelmPromotionMechanism{
+				Images: []kargoapi.HelmImageUpdate{
+					{},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.HelmPromotionMechanism, errs field.ErrorList) {
+				require.Empty(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				testCase.promoMech,
+				validateHelmPromotionMechanism(
+					field.NewPath("helm"),
+					testCase.promoMech,
+				),
+			)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package validation
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// Validate validates the provided Kargo resource using the same logic as the
+// admission webhook for its kind, short of any checks that require access to a
+// cluster, such as the existence of referenced resources. It returns an error
+// produced by apierrors.NewInvalid if the resource is invalid. Resources of
+// kinds without such logic are always considered valid.
+func Validate(obj runtime.Object) error {
+	var name, kind string
+	var errs field.ErrorList
+	switch o := obj.(type) {
+	case *kargoapi.Project:
+		name, kind = o.Name, "Project"
+		errs = ValidateProjectSpec(field.NewPath("spec"), o.Spec)
+	case *kargoapi.Stage:
+		name, kind = o.Name, "Stage"
+		errs = ValidateStageSpec(field.NewPath("spec"), &o.Spec)
+	case *kargoapi.Warehouse:
+		name, kind = o.Name, "Warehouse"
+		errs = ValidateWarehouseSpec(field.NewPath("spec"), &o.Spec)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		schema.GroupKind{
+			Group: kargoapi.GroupVersion.Group,
+			Kind:  kind,
+		},
+		name,
+		errs,
+	)
+}
@@ -0,0 +1,272 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/helm"
+	"github.com/akuity/kargo/internal/image"
+)
+
+// ValidateWarehouseSpec validates the provided WarehouseSpec beyond what can be
+// expressed by its declarative validations. The returned errors are relative to
+// the provided field path.
+func ValidateWarehouseSpec(
+	f *field.Path,
+	spec *kargoapi.WarehouseSpec,
+) field.ErrorList {
+	if spec == nil { // nil spec is caught by declarative validations
+		return nil
+	}
+	return validateSubs(f.Child("subscriptions"), spec.Subscriptions)
+}
+
+func validateSubs(
+	f *field.Path,
+	subs []kargoapi.RepoSubscription,
+) field.ErrorList {
+	if len(subs) == 0 {
+		return nil
+	}
+	var errs field.ErrorList
+	seen := make(uniqueSubSet, len(subs))
+	for i, sub := range subs {
+		errs = append(errs, validateSub(f.Index(i), sub, seen)...)
+	}
+	return errs
+}
+
+func validateSub(
+	f *field.Path,
+	sub kargoapi.RepoSubscription,
+	seen uniqueSubSet,
+) field.ErrorList {
+	var errs field.ErrorList
+	var repoTypes int
+	if sub.Git != nil {
+		repoTypes++
+		errs = append(errs, validateGitSub(f.Child("git"), *sub.Git, seen)...)
+	}
+	if sub.Image != nil {
+		repoTypes++
+		errs = append(errs, validateImageSub(f.Child("image"), *sub.Image, seen)...)
+	}
+	if sub.Chart != nil {
+		repoTypes++
+		errs = append(errs, validateChartSub(f.Child("chart"), *sub.Chart, seen)...)
+	}
+	if repoTypes != 1 {
+		errs = append(
+			errs,
+			field.Invalid(
+				f,
+				sub,
+				fmt.Sprintf(
+					"exactly one of %s.git, %s.image, or %s.chart must be non-empty",
+					f.String(),
+					f.String(),
+					f.String(),
+				),
+			),
+		)
+	}
+	return errs
+}
+
+func validateGitSub(
+	f *field.Path,
+	sub kargoapi.GitSubscription,
+	seen uniqueSubSet,
+) field.ErrorList {
+	var errs field.ErrorList
+	if err := validateSemverConstraint(
+		f.Child("semverConstraint"),
+		sub.SemverConstraint,
+	); err != nil {
+		errs = append(errs, err)
+	}
+	if err := seen.addGit(sub, f); err != nil {
+		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
+	}
+	return errs
+}
+
+func validateImageSub(
+	f *field.Path,
+	sub kargoapi.ImageSubscription,
+	seen uniqueSubSet,
+) field.ErrorList {
+	var errs field.ErrorList
+	if err := validateSemverConstraint(
+		f.Child("semverConstraint"),
+		sub.SemverConstraint,
+	); err != nil {
+		errs = field.ErrorList{err}
+	}
+	if err := validateRegex(f.Child("allowTags"), sub.AllowTags); err != nil {
+		errs = append(errs, err)
+	}
+	if err := validateRegex(f.Child("denyTags"), sub.DenyTags); err != nil {
+		errs = append(errs, err)
+	}
+	if sub.Platform != "" {
+		if !image.ValidatePlatformConstraint(sub.Platform) {
+			errs = append(errs, field.Invalid(f.Child("platform"), sub.Platform, ""))
+		}
+	}
+	if err := seen.addImage(sub, f); err != nil {
+		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
+	}
+	return errs
+}
+
+func validateChartSub(
+	f *field.Path,
+	sub kargoapi.ChartSubscription,
+	seen uniqueSubSet,
+) field.ErrorList {
+	var errs field.ErrorList
+	if err := validateSemverConstraint(
+		f.Child("semverConstraint"),
+		sub.SemverConstraint,
+	); err != nil {
+		errs = append(errs, err)
+	}
+	if strings.HasPrefix(sub.RepoURL, "oci://") && sub.Name != "" {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("name"),
+				sub.Name,
+				"must be empty if repoURL starts with oci://",
+			),
+		)
+	}
+	isHTTP := strings.HasPrefix(sub.RepoURL, "http://") || strings.HasPrefix(sub.RepoURL, "https://")
+	if isHTTP && sub.Name == "" {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("name"),
+				sub.Name,
+				"must be non-empty if repoURL starts with http:// or https://",
+			),
+		)
+	}
+	for i, repoURL := range sub.FallbackRepoURLs {
+		// Because the same chart Name is used with every repository,
+		// fallback repositories must be of the same kind as the primary one.
+		fallbackIsHTTP := strings.HasPrefix(repoURL, "http://") ||
+			strings.HasPrefix(repoURL, "https://")
+		if fallbackIsHTTP != isHTTP {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Child("fallbackRepoURLs").Index(i),
+					repoURL,
+					"must use the same kind of chart repository as repoURL",
+				),
+			)
+		}
+	}
+	if sub.VerifyProvenance {
+		if !isHTTP {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Child("verifyProvenance"),
+					sub.VerifyProvenance,
+					"must be false unless repoURL starts with http:// or https://",
+				),
+			)
+		}
+		if sub.ProvenanceKeySecretRef == nil {
+			errs = append(
+				errs,
+				field.Required(
+					f.Child("provenanceKeySecretRef"),
+					fmt.Sprintf(
+						"must be specified when %s is true",
+						f.Child("verifyProvenance").String(),
+					),
+				),
+			)
+		}
+	}
+	if err := seen.addChart(sub, isHTTP, f); err != nil {
+		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
+	}
+	return errs
+}
+
+func validateSemverConstraint(
+	f *field.Path,
+	semverConstraint string,
+) *field.Error {
+	if semverConstraint == "" {
+		return nil
+	}
+	if _, err := semver.NewConstraint(semverConstraint); err != nil {
+		return field.Invalid(f, semverConstraint, "")
+	}
+	return nil
+}
+
+func validateRegex(f *field.Path, regex string) *field.Error {
+	if regex == "" {
+		return nil
+	}
+	if _, err := regexp.Compile(regex); err != nil {
+		return field.Invalid(f, regex, err.Error())
+	}
+	return nil
+}
+
+type subscriptionKey struct {
+	kind string
+	id   string
+}
+
+type uniqueSubSet map[subscriptionKey]*field.Path
+
+func (s uniqueSubSet) addGit(sub kargoapi.GitSubscription, p *field.Path) error {
+	k := subscriptionKey{kind: "git", id: git.NormalizeURL(sub.RepoURL)}
+	if _, exists := s[k]; exists {
+		return fmt.Errorf("subscription for Git repository already exists at %q", s[k])
+	}
+	s[k] = p
+	return nil
+}
+
+func (s uniqueSubSet) addImage(sub kargoapi.ImageSubscription, p *field.Path) error {
+	// The normalization of Helm chart repository URLs can also be used here
+	// to ensure the uniqueness of the image reference as it does the job of
+	// ensuring lower-casing, etc. without introducing unwanted side effects.
+	k := subscriptionKey{kind: "image", id: helm.NormalizeChartRepositoryURL(sub.RepoURL)}
+	if _, exists := s[k]; exists {
+		return fmt.Errorf("subscription for image repository already exists at %q", s[k])
+	}
+	s[k] = p
+	return nil
+}
+
+func (s uniqueSubSet) addChart(sub kargoapi.ChartSubscription, isHTTP bool, p *field.Path) error {
+	k := subscriptionKey{kind: "chart", id: helm.NormalizeChartRepositoryURL(sub.RepoURL)}
+	if isHTTP {
+		k.id = k.id + ":" + sub.Name
+	}
+	if _, exists := s[k]; exists {
+		if isHTTP {
+			return fmt.Errorf("subscription for chart %q already exists at %q", sub.Name, s[k])
+		}
+		return fmt.Errorf("subscription for chart already exists at %q", s[k])
+	}
+	s[k] = p
+	return nil
+}
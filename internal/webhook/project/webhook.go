@@ -20,6 +20,7 @@ import (
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/validation"
 )
 
 var (
@@ -89,7 +90,7 @@ func newWebhook(kubeClient client.Client, cfg WebhookConfig) *webhook {
 	w := &webhook{
 		cfg: cfg,
 	}
-	w.validateSpecFn = w.validateSpec
+	w.validateSpecFn = validation.ValidateProjectSpec
 	w.ensureNamespaceFn = w.ensureNamespace
 	w.ensureProjectAdminPermissionsFn = w.ensureProjectAdminPermissions
 	w.getNamespaceFn = kubeClient.Get
@@ -154,43 +155,6 @@ func (w *webhook) ValidateDelete(
 	return nil, nil
 }
 
-func (w *webhook) validateSpec(
-	f *field.Path,
-	spec *kargoapi.ProjectSpec,
-) field.ErrorList {
-	if spec == nil { // nil spec is valid
-		return nil
-	}
-	return w.validatePromotionPolicies(
-		f.Child("promotionPolicies"),
-		spec.PromotionPolicies,
-	)
-}
-
-func (w *webhook) validatePromotionPolicies(
-	f *field.Path,
-	promotionPolicies []kargoapi.PromotionPolicy,
-) field.ErrorList {
-	stageNames := make(map[string]struct{}, len(promotionPolicies))
-	for _, promotionPolicy := range promotionPolicies {
-		if _, found := stageNames[promotionPolicy.Stage]; found {
-			return field.ErrorList{
-				field.Invalid(
-					f,
-					promotionPolicies,
-					fmt.Sprintf(
-						"multiple %s reference stage %s",
-						f.String(),
-						promotionPolicy.Stage,
-					),
-				),
-			}
-		}
-		stageNames[promotionPolicy.Stage] = struct{}{}
-	}
-	return nil
-}
-
 // ensureNamespace is used to ensure the existence of a namespace with the same
 // name as the Project. If the namespace does not exist, it is created. If the
 // namespace exists, it is checked for any ownership conflicts with the Project
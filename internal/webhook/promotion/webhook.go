@@ -177,7 +177,7 @@ func (w *webhook) Default(ctx context.Context, obj runtime.Object) error {
 			promo.Namespace,
 		)
 	}
-	if stage.Spec.PromotionMechanisms == nil {
+	if stage.IsControlFlow() {
 		return fmt.Errorf(
 			"Stage %q in namespace %q has no PromotionMechanisms",
 			promo.Spec.Stage,
@@ -2,18 +2,23 @@ package stage
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authzv1 "k8s.io/api/authorization/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/validation"
 	libWebhook "github.com/akuity/kargo/internal/webhook"
 )
 
@@ -22,6 +27,10 @@ var (
 		Group: kargoapi.GroupVersion.Group,
 		Kind:  "Stage",
 	}
+	stageGroupResource = schema.GroupResource{
+		Group:    kargoapi.GroupVersion.Group,
+		Resource: "stages",
+	}
 )
 
 type webhook struct {
@@ -41,8 +50,28 @@ type webhook struct {
 
 	validateCreateOrUpdateFn func(*kargoapi.Stage) (admission.Warnings, error)
 
+	validateAutoPromotionFn func(context.Context, *kargoapi.Stage) error
+
+	validatePromotionTemplateRefFn func(context.Context, *kargoapi.Stage) error
+
+	getProjectFn func(context.Context, client.Client, string) (*kargoapi.Project, error)
+
+	getPromotionTemplateFn func(
+		context.Context,
+		client.Client,
+		types.NamespacedName,
+	) (*kargoapi.PromotionTemplate, error)
+
 	validateSpecFn func(*field.Path, *kargoapi.StageSpec) field.ErrorList
 
+	authorizePromoteFreightFn func(context.Context, *kargoapi.Stage) error
+
+	createSubjectAccessReviewFn func(
+		context.Context,
+		client.Object,
+		...client.CreateOption,
+	) error
+
 	isRequestFromKargoControlplaneFn libWebhook.IsRequestFromKargoControlplaneFn
 }
 
@@ -74,7 +103,13 @@ func newWebhook(
 	w.admissionRequestFromContextFn = admission.RequestFromContext
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.validateCreateOrUpdateFn = w.validateCreateOrUpdate
-	w.validateSpecFn = w.validateSpec
+	w.validateAutoPromotionFn = w.validateAutoPromotion
+	w.validatePromotionTemplateRefFn = w.validatePromotionTemplateRef
+	w.getProjectFn = kargoapi.GetProject
+	w.getPromotionTemplateFn = kargoapi.GetPromotionTemplate
+	w.validateSpecFn = validation.ValidateStageSpec
+	w.authorizePromoteFreightFn = w.authorizePromoteFreight
+	w.createSubjectAccessReviewFn = w.client.Create
 	w.isRequestFromKargoControlplaneFn =
 		libWebhook.IsRequestFromKargoControlplane(cfg.ControlplaneUserRegex)
 	return w
@@ -93,6 +128,16 @@ func (w *webhook) Default(ctx context.Context, obj runtime.Object) error {
 		delete(stage.Labels, kargoapi.ShardLabelKey)
 	}
 
+	// Sync the pipeline label to the convenience pipeline field
+	if stage.Spec.Pipeline != "" {
+		if stage.Labels == nil {
+			stage.Labels = make(map[string]string, 1)
+		}
+		stage.Labels[kargoapi.PipelineLabelKey] = stage.Spec.Pipeline
+	} else {
+		delete(stage.Labels, kargoapi.PipelineLabelKey)
+	}
+
 	req, err := w.admissionRequestFromContextFn(ctx)
 	if err != nil {
 		return fmt.Errorf("get admission request from context: %w", err)
@@ -158,16 +203,48 @@ func (w *webhook) ValidateCreate(
 		w.validateProjectFn(ctx, w.client, stageGroupKind, stage); err != nil {
 		return nil, err
 	}
-	return w.validateCreateOrUpdateFn(stage)
+	if _, ok := kargoapi.PromoteFreightAnnotationValue(stage.Annotations); ok {
+		if err := w.authorizePromoteFreightFn(ctx, stage); err != nil {
+			return nil, err
+		}
+	}
+	warnings, err := w.validateCreateOrUpdateFn(stage)
+	if err != nil {
+		return warnings, err
+	}
+	if err = w.validatePromotionTemplateRefFn(ctx, stage); err != nil {
+		return warnings, err
+	}
+	return warnings, w.validateAutoPromotionFn(ctx, stage)
 }
 
 func (w *webhook) ValidateUpdate(
-	_ context.Context,
-	_ runtime.Object,
+	ctx context.Context,
+	oldObj runtime.Object,
 	newObj runtime.Object,
 ) (admission.Warnings, error) {
 	stage := newObj.(*kargoapi.Stage) // nolint: forcetypeassert
-	return w.validateCreateOrUpdateFn(stage)
+	if freight, ok := kargoapi.PromoteFreightAnnotationValue(stage.Annotations); ok {
+		var oldFreight string
+		if oldStage, ok := oldObj.(*kargoapi.Stage); ok && oldStage != nil {
+			oldFreight, _ = kargoapi.PromoteFreightAnnotationValue(oldStage.Annotations)
+		}
+		// Only a new or changed request to promote Freight requires
+		// authorization.
+		if freight != oldFreight {
+			if err := w.authorizePromoteFreightFn(ctx, stage); err != nil {
+				return nil, err
+			}
+		}
+	}
+	warnings, err := w.validateCreateOrUpdateFn(stage)
+	if err != nil {
+		return warnings, err
+	}
+	if err = w.validatePromotionTemplateRefFn(ctx, stage); err != nil {
+		return warnings, err
+	}
+	return warnings, w.validateAutoPromotionFn(ctx, stage)
 }
 
 func (w *webhook) ValidateDelete(
@@ -178,149 +255,154 @@ func (w *webhook) ValidateDelete(
 	return nil, nil
 }
 
-func (w *webhook) validateCreateOrUpdate(
-	s *kargoapi.Stage,
-) (admission.Warnings, error) {
-	if errs := w.validateSpecFn(field.NewPath("spec"), &s.Spec); len(errs) > 0 {
-		return nil, apierrors.NewInvalid(stageGroupKind, s.Name, errs)
-	}
-	return nil, nil
-}
-
-func (w *webhook) validateSpec(
-	f *field.Path,
-	spec *kargoapi.StageSpec,
-) field.ErrorList {
-	if spec == nil { // nil spec is caught by declarative validations
-		return nil
-	}
-	errs := w.validateRequestedFreight(f.Child("requestedFreight"), spec.RequestedFreight)
-	return append(
-		errs,
-		w.validatePromotionMechanisms(
-			f.Child("promotionMechanisms"),
-			spec.PromotionMechanisms,
-		)...,
-	)
-}
+// authorizePromoteFreight verifies that the user responsible for the current
+// admission request is permitted to promote to the provided Stage. Requesting
+// the promotion of Freight through the AnnotationKeyPromoteFreight annotation
+// is equivalent to creating a Promotion, so the same permission is required.
+func (w *webhook) authorizePromoteFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) error {
+	logger := logging.LoggerFromContext(ctx)
 
-func (w *webhook) validateRequestedFreight(
-	f *field.Path,
-	reqs []kargoapi.FreightRequest,
-) field.ErrorList {
-	// Make sure the same origin is not requested multiple times
-	seenOrigins := make(map[string]struct{}, len(reqs))
-	for _, req := range reqs {
-		if _, seen := seenOrigins[req.Origin.String()]; seen {
-			return field.ErrorList{
-				field.Invalid(
-					f,
-					reqs,
-					fmt.Sprintf(
-						"freight with origin %s requested multiple times in %s",
-						req.Origin.String(),
-						f.String(),
-					),
-				),
-			}
-		}
-		seenOrigins[req.Origin.String()] = struct{}{}
+	req, err := w.admissionRequestFromContextFn(ctx)
+	if err != nil {
+		logger.Error(err, "")
+		return apierrors.NewForbidden(
+			stageGroupResource,
+			stage.Name,
+			errors.New(
+				"error retrieving admission request from context; refusing to "+
+					"accept request to promote Freight",
+			),
+		)
 	}
-	return nil
-}
 
-func (w *webhook) validatePromotionMechanisms(
-	f *field.Path,
-	promoMechs *kargoapi.PromotionMechanisms,
-) field.ErrorList {
-	if promoMechs == nil { // nil promoMechs is caught by declarative validations
-		return nil
+	accessReview := &authzv1.SubjectAccessReview{
+		Spec: authzv1.SubjectAccessReviewSpec{
+			User:   req.UserInfo.Username,
+			Groups: req.UserInfo.Groups,
+			ResourceAttributes: &authzv1.ResourceAttributes{
+				Group:     kargoapi.GroupVersion.Group,
+				Resource:  "stages",
+				Name:      stage.Name,
+				Verb:      "promote",
+				Namespace: stage.Namespace,
+			},
+		},
 	}
-	// Must define at least one mechanism
-	if len(promoMechs.GitRepoUpdates) == 0 &&
-		len(promoMechs.ArgoCDAppUpdates) == 0 {
-		return field.ErrorList{
-			field.Invalid(
-				f,
-				promoMechs,
-				fmt.Sprintf(
-					"at least one of %s.gitRepoUpdates or %s.argoCDAppUpdates must "+
-						"be non-empty",
-					f.String(),
-					f.String(),
-				),
+	if err := w.createSubjectAccessReviewFn(ctx, accessReview); err != nil {
+		logger.Error(err, "")
+		return apierrors.NewForbidden(
+			stageGroupResource,
+			stage.Name,
+			errors.New(
+				"error creating SubjectAccessReview; refusing to accept request "+
+					"to promote Freight",
 			),
-		}
+		)
 	}
-	return w.validateGitRepoUpdates(
-		f.Child("gitRepoUpdates"),
-		promoMechs.GitRepoUpdates,
-	)
-}
 
-func (w *webhook) validateGitRepoUpdates(
-	f *field.Path,
-	updates []kargoapi.GitRepoUpdate,
-) field.ErrorList {
-	var errs field.ErrorList
-	for i, update := range updates {
-		errs = append(errs, w.validateGitRepoUpdate(f.Index(i), update)...)
+	if !accessReview.Status.Allowed {
+		return apierrors.NewForbidden(
+			stageGroupResource,
+			stage.Name,
+			fmt.Errorf(
+				"subject %q is not permitted to request the promotion of Freight "+
+					"to Stage %q",
+				req.UserInfo.Username,
+				stage.Name,
+			),
+		)
 	}
-	return errs
+
+	return nil
 }
 
-func (w *webhook) validateGitRepoUpdate(
-	f *field.Path,
-	update kargoapi.GitRepoUpdate,
-) field.ErrorList {
-	var count int
-	if update.Render != nil {
-		count++
+// validateAutoPromotion verifies that a Stage without PromotionMechanisms or a
+// PromotionTemplate is not subject to a PromotionPolicy of its Project that
+// enables auto-promotion. Such a Stage is a control flow Stage, which cannot be
+// promoted to, so the policy could never take effect.
+func (w *webhook) validateAutoPromotion(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) error {
+	if !stage.IsControlFlow() {
+		return nil
 	}
-	if update.Kustomize != nil {
-		count++
+	project, err := w.getProjectFn(ctx, w.client, stage.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(
+			fmt.Errorf("error getting Project %q: %w", stage.Namespace, err),
+		)
 	}
-	if update.Helm != nil {
-		count++
+	// A missing Project is caught by validateProjectFn
+	if project == nil || project.Spec == nil {
+		return nil
 	}
-	if count > 1 {
-		return field.ErrorList{
-			field.Invalid(
-				f,
-				update,
-				fmt.Sprintf(
-					"no more than one of %s.render, or %s.kustomize, or %s.helm may "+
-						"be defined",
-					f.String(),
-					f.String(),
-					f.String(),
-				),
-			),
+	for _, policy := range project.Spec.PromotionPolicies {
+		if policy.Stage == stage.Name && policy.AutoPromotionEnabled {
+			return apierrors.NewInvalid(
+				stageGroupKind,
+				stage.Name,
+				field.ErrorList{
+					field.Required(
+						field.NewPath("spec", "promotionMechanisms"),
+						fmt.Sprintf(
+							"must be defined because auto-promotion is enabled for "+
+								"Stage %q by Project %q",
+							stage.Name,
+							project.Name,
+						),
+					),
+				},
+			)
 		}
 	}
-	return w.validateHelmPromotionMechanism(f.Child("helm"), update.Helm)
+	return nil
 }
 
-func (w *webhook) validateHelmPromotionMechanism(
-	f *field.Path,
-	promoMech *kargoapi.HelmPromotionMechanism,
-) field.ErrorList {
-	if promoMech == nil {
+// validatePromotionTemplateRef verifies that the PromotionTemplate referenced
+// by a Stage, if any, exists in the Stage's namespace.
+func (w *webhook) validatePromotionTemplateRef(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) error {
+	ref := stage.Spec.PromotionTemplateRef
+	if ref == nil {
 		return nil
 	}
-	// This mechanism must define at least one change to apply
-	if len(promoMech.Images) == 0 && len(promoMech.Charts) == 0 {
-		return field.ErrorList{
-			field.Invalid(
-				f,
-				promoMech,
-				fmt.Sprintf(
-					"at least one of %s.images or %s.charts must be non-empty",
-					f.String(),
-					f.String(),
+	template, err := w.getPromotionTemplateFn(
+		ctx,
+		w.client,
+		types.NamespacedName{
+			Namespace: stage.Namespace,
+			Name:      ref.Name,
+		},
+	)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if template == nil {
+		return apierrors.NewInvalid(
+			stageGroupKind,
+			stage.Name,
+			field.ErrorList{
+				field.NotFound(
+					field.NewPath("spec", "promotionTemplateRef", "name"),
+					ref.Name,
 				),
-			),
-		}
+			},
+		)
 	}
 	return nil
 }
+
+func (w *webhook) validateCreateOrUpdate(
+	s *kargoapi.Stage,
+) (admission.Warnings, error) {
+	if errs := w.validateSpecFn(field.NewPath("spec"), &s.Spec); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(stageGroupKind, s.Name, errs)
+	}
+	return nil, nil
+}
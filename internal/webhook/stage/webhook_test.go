@@ -9,9 +9,13 @@ import (
 	"github.com/stretchr/testify/require"
 	admissionv1 "k8s.io/api/admission/v1"
 	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -32,12 +36,19 @@ func TestNewWebhook(t *testing.T) {
 	require.NotNil(t, w.admissionRequestFromContextFn)
 	require.NotNil(t, w.validateProjectFn)
 	require.NotNil(t, w.validateCreateOrUpdateFn)
+	require.NotNil(t, w.validateAutoPromotionFn)
+	require.NotNil(t, w.validatePromotionTemplateRefFn)
+	require.NotNil(t, w.getProjectFn)
+	require.NotNil(t, w.getPromotionTemplateFn)
 	require.NotNil(t, w.validateSpecFn)
+	require.NotNil(t, w.authorizePromoteFreightFn)
+	require.NotNil(t, w.createSubjectAccessReviewFn)
 	require.NotNil(t, w.isRequestFromKargoControlplaneFn)
 }
 
 func TestDefault(t *testing.T) {
 	const testShardName = "fake-shard"
+	const testPipelineName = "fake-pipeline"
 	scheme := runtime.NewScheme()
 	require.NoError(t, kargoapi.AddToScheme(scheme))
 
@@ -119,6 +130,55 @@ func TestDefault(t *testing.T) {
 				require.False(t, ok)
 			},
 		},
+		{
+			name: "sync pipeline label to non-empty pipeline field",
+			webhook: &webhook{
+				admissionRequestFromContextFn: admission.RequestFromContext,
+				isRequestFromKargoControlplaneFn: func(admission.Request) bool {
+					return true
+				},
+			},
+			req: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+				},
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					Pipeline: testPipelineName,
+				},
+			},
+			assertions: func(t *testing.T, stage *kargoapi.Stage, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testPipelineName, stage.Labels[kargoapi.PipelineLabelKey])
+			},
+		},
+		{
+			name: "sync pipeline label to empty pipeline field",
+			webhook: &webhook{
+				admissionRequestFromContextFn: admission.RequestFromContext,
+				isRequestFromKargoControlplaneFn: func(admission.Request) bool {
+					return true
+				},
+			},
+			req: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+				},
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						kargoapi.PipelineLabelKey: testPipelineName,
+					},
+				},
+			},
+			assertions: func(t *testing.T, stage *kargoapi.Stage, err error) {
+				require.NoError(t, err)
+				_, ok := stage.Labels[kargoapi.PipelineLabelKey]
+				require.False(t, ok)
+			},
+		},
 		{
 			name: "set reverify actor when request doesn't come from kargo control plane",
 			webhook: &webhook{
@@ -838,6 +898,7 @@ func TestValidateCreate(t *testing.T) {
 	testCases := []struct {
 		name       string
 		webhook    *webhook
+		stage      *kargoapi.Stage
 		assertions func(*testing.T, error)
 	}{
 		{
@@ -857,6 +918,32 @@ func TestValidateCreate(t *testing.T) {
 				require.Equal(t, "something went wrong", err.Error())
 			},
 		},
+		{
+			name: "requester is not permitted to promote Freight",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				authorizePromoteFreightFn: func(context.Context, *kargoapi.Stage) error {
+					return errors.New("not permitted")
+				},
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyPromoteFreight: "fake-freight",
+					},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "not permitted")
+			},
+		},
 		{
 			name: "error validating stage",
 			webhook: &webhook{
@@ -879,6 +966,59 @@ func TestValidateCreate(t *testing.T) {
 				require.Equal(t, "something went wrong", err.Error())
 			},
 		},
+		{
+			name: "error validating PromotionTemplate reference",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				validateCreateOrUpdateFn: func(
+					*kargoapi.Stage,
+				) (admission.Warnings, error) {
+					return nil, nil
+				},
+				validatePromotionTemplateRefFn: func(context.Context, *kargoapi.Stage) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "error validating auto-promotion",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				validateCreateOrUpdateFn: func(
+					*kargoapi.Stage,
+				) (admission.Warnings, error) {
+					return nil, nil
+				},
+				validatePromotionTemplateRefFn: func(context.Context, *kargoapi.Stage) error {
+					return nil
+				},
+				validateAutoPromotionFn: func(context.Context, *kargoapi.Stage) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.Error(t, err)
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
 		{
 			name: "success",
 			webhook: &webhook{
@@ -895,6 +1035,12 @@ func TestValidateCreate(t *testing.T) {
 				) (admission.Warnings, error) {
 					return nil, nil
 				},
+				validatePromotionTemplateRefFn: func(context.Context, *kargoapi.Stage) error {
+					return nil
+				},
+				validateAutoPromotionFn: func(context.Context, *kargoapi.Stage) error {
+					return nil
+				},
 			},
 			assertions: func(t *testing.T, err error) {
 				require.NoError(t, err)
@@ -903,21 +1049,80 @@ func TestValidateCreate(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			_, err := testCase.webhook.ValidateCreate(
-				context.Background(),
-				&kargoapi.Stage{},
-			)
+			stage := testCase.stage
+			if stage == nil {
+				stage = &kargoapi.Stage{}
+			}
+			_, err := testCase.webhook.ValidateCreate(context.Background(), stage)
 			testCase.assertions(t, err)
 		})
 	}
 }
 
 func TestValidateUpdate(t *testing.T) {
+	newFakeStage := func(freight string) *kargoapi.Stage {
+		stage := &kargoapi.Stage{}
+		if freight != "" {
+			stage.Annotations = map[string]string{
+				kargoapi.AnnotationKeyPromoteFreight: freight,
+			}
+		}
+		return stage
+	}
+	notPermitted := func(context.Context, *kargoapi.Stage) error {
+		return errors.New("not permitted")
+	}
 	testCases := []struct {
 		name       string
 		webhook    *webhook
+		oldStage   *kargoapi.Stage
+		newStage   *kargoapi.Stage
 		assertions func(*testing.T, error)
 	}{
+		{
+			name: "requester is not permitted to request promotion of Freight",
+			webhook: &webhook{
+				authorizePromoteFreightFn: notPermitted,
+			},
+			oldStage: newFakeStage(""),
+			newStage: newFakeStage("fake-freight"),
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "not permitted")
+			},
+		},
+		{
+			name: "requester is not permitted to change requested Freight",
+			webhook: &webhook{
+				authorizePromoteFreightFn: notPermitted,
+			},
+			oldStage: newFakeStage("fake-freight"),
+			newStage: newFakeStage("other-freight"),
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "not permitted")
+			},
+		},
+		{
+			name: "unchanged request to promote Freight is not re-authorized",
+			webhook: &webhook{
+				authorizePromoteFreightFn: notPermitted,
+				validateCreateOrUpdateFn: func(
+					*kargoapi.Stage,
+				) (admission.Warnings, error) {
+					return nil, nil
+				},
+				validatePromotionTemplateRefFn: func(context.Context, *kargoapi.Stage) error {
+					return nil
+				},
+				validateAutoPromotionFn: func(context.Context, *kargoapi.Stage) error {
+					return nil
+				},
+			},
+			oldStage: newFakeStage("fake-freight"),
+			newStage: newFakeStage("fake-freight"),
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
 		{
 			name: "error validating stage",
 			webhook: &webhook{
@@ -940,6 +1145,12 @@ func TestValidateUpdate(t *testing.T) {
 				) (admission.Warnings, error) {
 					return nil, nil
 				},
+				validatePromotionTemplateRefFn: func(context.Context, *kargoapi.Stage) error {
+					return nil
+				},
+				validateAutoPromotionFn: func(context.Context, *kargoapi.Stage) error {
+					return nil
+				},
 			},
 			assertions: func(t *testing.T, err error) {
 				require.NoError(t, err)
@@ -948,10 +1159,14 @@ func TestValidateUpdate(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
+			newStage := testCase.newStage
+			if newStage == nil {
+				newStage = &kargoapi.Stage{}
+			}
 			_, err := testCase.webhook.ValidateUpdate(
 				context.Background(),
-				nil,
-				&kargoapi.Stage{},
+				testCase.oldStage,
+				newStage,
 			)
 			testCase.assertions(t, err)
 		})
@@ -964,6 +1179,116 @@ func TestValidateDelete(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestAuthorizePromoteFreight(t *testing.T) {
+	testCases := []struct {
+		name                          string
+		admissionRequestFromContextFn func(
+			context.Context,
+		) (admission.Request, error)
+		createSubjectAccessReviewFn func(
+			context.Context,
+			client.Object,
+			...client.CreateOption,
+		) error
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "error getting admission request bound to context",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(
+					t, err, "error retrieving admission request from context; refusing to",
+				)
+			},
+		},
+		{
+			name: "error creating subject access review",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			createSubjectAccessReviewFn: func(
+				context.Context,
+				client.Object,
+				...client.CreateOption,
+			) error {
+				return errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "error creating SubjectAccessReview")
+			},
+		},
+		{
+			name: "subject is not authorized",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			createSubjectAccessReviewFn: func(
+				_ context.Context,
+				obj client.Object,
+				_ ...client.CreateOption,
+			) error {
+				obj.(*authzv1.SubjectAccessReview).Status.Allowed = false // nolint: forcetypeassert
+				return nil
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "is not permitted")
+			},
+		},
+		{
+			name: "subject is authorized",
+			admissionRequestFromContextFn: func(
+				context.Context,
+			) (admission.Request, error) {
+				return admission.Request{}, nil
+			},
+			createSubjectAccessReviewFn: func(
+				_ context.Context,
+				obj client.Object,
+				_ ...client.CreateOption,
+			) error {
+				review := obj.(*authzv1.SubjectAccessReview) // nolint: forcetypeassert
+				if review.Spec.ResourceAttributes.Verb != "promote" ||
+					review.Spec.ResourceAttributes.Name != "fake-stage" {
+					return errors.New("unexpected SubjectAccessReview")
+				}
+				review.Status.Allowed = true
+				return nil
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				admissionRequestFromContextFn: testCase.admissionRequestFromContextFn,
+				createSubjectAccessReviewFn:   testCase.createSubjectAccessReviewFn,
+			}
+			testCase.assertions(
+				t,
+				w.authorizePromoteFreight(
+					context.Background(),
+					&kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-stage",
+							Namespace: "fake-namespace",
+						},
+					},
+				),
+			)
+		})
+	}
+}
+
 func TestValidateCreateOrUpdate(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -1007,391 +1332,237 @@ func TestValidateCreateOrUpdate(t *testing.T) {
 	}
 }
 
-func TestValidateSpec(t *testing.T) {
-	testFreightRequest := kargoapi.FreightRequest{
-		Origin: kargoapi.FreightOrigin{
-			Kind: kargoapi.FreightOriginKindWarehouse,
-			Name: "test-warehouse",
-		},
-	}
+func TestValidateAutoPromotion(t *testing.T) {
 	testCases := []struct {
 		name       string
-		spec       *kargoapi.StageSpec
-		assertions func(*testing.T, *kargoapi.StageSpec, field.ErrorList)
+		webhook    *webhook
+		stage      *kargoapi.Stage
+		assertions func(*testing.T, error)
 	}{
 		{
-			name: "nil",
-			assertions: func(t *testing.T, _ *kargoapi.StageSpec, errs field.ErrorList) {
-				require.Nil(t, errs)
+			name:    "promotion mechanisms defined",
+			webhook: &webhook{},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionMechanisms: &kargoapi.PromotionMechanisms{},
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
 			},
 		},
-
 		{
-			name: "invalid",
-			spec: &kargoapi.StageSpec{
-				// Has multiple sources for one Freight origin...
-				RequestedFreight: []kargoapi.FreightRequest{
-					testFreightRequest,
-					testFreightRequest,
-				},
-				// Doesn't actually define any mechanisms...
-				PromotionMechanisms: &kargoapi.PromotionMechanisms{},
-			},
-			assertions: func(t *testing.T, spec *kargoapi.StageSpec, errs field.ErrorList) {
-				// We really want to see that all underlying errors have been bubbled up
-				// to this level and been aggregated.
-				require.Equal(
-					t,
-					field.ErrorList{
-						{
-							Type:     field.ErrorTypeInvalid,
-							Field:    "spec.requestedFreight",
-							BadValue: spec.RequestedFreight,
-							Detail: `freight with origin Warehouse/test-warehouse requested multiple ` +
-								"times in spec.requestedFreight",
-						},
-						{
-							Type:     field.ErrorTypeInvalid,
-							Field:    "spec.promotionMechanisms",
-							BadValue: spec.PromotionMechanisms,
-							Detail: "at least one of " +
-								"spec.promotionMechanisms.gitRepoUpdates or " +
-								"spec.promotionMechanisms.argoCDAppUpdates must be non-empty",
-						},
+			name:    "PromotionTemplate referenced",
+			webhook: &webhook{},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					PromotionTemplateRef: &corev1.LocalObjectReference{
+						Name: "fake-template",
 					},
-					errs,
-				)
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
 			},
 		},
-
 		{
-			name: "valid",
-			spec: &kargoapi.StageSpec{
-				RequestedFreight: []kargoapi.FreightRequest{
-					testFreightRequest,
+			name: "error getting project",
+			webhook: &webhook{
+				getProjectFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (*kargoapi.Project, error) {
+					return nil, errors.New("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, _ *kargoapi.StageSpec, errs field.ErrorList) {
-				require.Nil(t, errs)
+			stage: &kargoapi.Stage{},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				statusErr, ok := err.(*apierrors.StatusError)
+				require.True(t, ok)
+				require.Equal(t, metav1.StatusReasonInternalError, statusErr.Status().Reason)
 			},
 		},
-	}
-	w := &webhook{}
-	for _, testCase := range testCases {
-		t.Run(testCase.name, func(t *testing.T) {
-			testCase.assertions(
-				t,
-				testCase.spec,
-				w.validateSpec(
-					field.NewPath("spec"),
-					testCase.spec,
-				),
-			)
-		})
-	}
-}
-
-func TestValidateRequestedFreight(t *testing.T) {
-	testFreightRequest := kargoapi.FreightRequest{
-		Origin: kargoapi.FreightOrigin{
-			Kind: kargoapi.FreightOriginKindWarehouse,
-			Name: "test-warehouse",
-		},
-	}
-	testCases := []struct {
-		name       string
-		reqs       []kargoapi.FreightRequest
-		assertions func(*testing.T, []kargoapi.FreightRequest, field.ErrorList)
-	}{
 		{
-			name: "Freight origin found multiple times",
-			reqs: []kargoapi.FreightRequest{
-				testFreightRequest,
-				testFreightRequest,
-			},
-			assertions: func(t *testing.T, reqs []kargoapi.FreightRequest, errs field.ErrorList) {
-				require.Equal(
-					t,
-					field.ErrorList{
-						{
-							Type:     field.ErrorTypeInvalid,
-							Field:    "requestedFreight",
-							BadValue: reqs,
-							Detail: `freight with origin Warehouse/test-warehouse requested ` +
-								"multiple times in requestedFreight",
+			name: "auto-promotion not enabled",
+			webhook: &webhook{
+				getProjectFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (*kargoapi.Project, error) {
+					return &kargoapi.Project{
+						Spec: &kargoapi.ProjectSpec{
+							PromotionPolicies: []kargoapi.PromotionPolicy{
+								{Stage: "fake-stage"},
+								{Stage: "other-stage", AutoPromotionEnabled: true},
+							},
 						},
-					},
-					errs,
-				)
+					}, nil
+				},
 			},
-		},
-
-		{
-			name: "success",
-			reqs: []kargoapi.FreightRequest{
-				testFreightRequest,
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-stage"},
 			},
-			assertions: func(t *testing.T, _ []kargoapi.FreightRequest, errs field.ErrorList) {
-				require.Nil(t, errs)
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
 			},
 		},
-	}
-	w := &webhook{}
-	for _, testCase := range testCases {
-		t.Run(testCase.name, func(t *testing.T) {
-			testCase.assertions(
-				t,
-				testCase.reqs,
-				w.validateRequestedFreight(
-					field.NewPath("requestedFreight"),
-					testCase.reqs,
-				),
-			)
-		})
-	}
-}
-
-func TestValidatePromotionMechanisms(t *testing.T) {
-	testCases := []struct {
-		name       string
-		promoMechs *kargoapi.PromotionMechanisms
-		assertions func(*testing.T, *kargoapi.PromotionMechanisms, field.ErrorList)
-	}{
 		{
-			name: "nil",
-			assertions: func(t *testing.T, _ *kargoapi.PromotionMechanisms, errs field.ErrorList) {
-				require.Nil(t, errs)
+			name: "auto-promotion enabled",
+			webhook: &webhook{
+				getProjectFn: func(
+					context.Context,
+					client.Client,
+					string,
+				) (*kargoapi.Project, error) {
+					return &kargoapi.Project{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-project"},
+						Spec: &kargoapi.ProjectSpec{
+							PromotionPolicies: []kargoapi.PromotionPolicy{
+								{Stage: "fake-stage", AutoPromotionEnabled: true},
+							},
+						},
+					}, nil
+				},
 			},
-		},
-
-		{
-			name: "invalid",
-			// Does not define any mechanisms
-			promoMechs: &kargoapi.PromotionMechanisms{},
-			assertions: func(
-				t *testing.T,
-				promoMechs *kargoapi.PromotionMechanisms,
-				errs field.ErrorList,
-			) {
-				require.NotNil(t, errs)
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-stage"},
+			},
+			assertions: func(t *testing.T, err error) {
+				statusErr, ok := err.(*apierrors.StatusError)
+				require.True(t, ok)
+				require.Equal(t, metav1.StatusReasonInvalid, statusErr.Status().Reason)
+				require.Len(t, statusErr.Status().Details.Causes, 1)
 				require.Equal(
 					t,
-					field.ErrorList{
-						{
-							Type:     field.ErrorTypeInvalid,
-							Field:    "promotionMechanisms",
-							BadValue: promoMechs,
-							Detail: "at least one of promotionMechanisms.gitRepoUpdates or " +
-								"promotionMechanisms.argoCDAppUpdates must be non-empty",
-						},
+					metav1.StatusCause{
+						Type:  metav1.CauseTypeFieldValueRequired,
+						Field: "spec.promotionMechanisms",
+						Message: "Required value: must be defined because auto-promotion " +
+							`is enabled for Stage "fake-stage" by Project "fake-project"`,
 					},
-					errs,
+					statusErr.Status().Details.Causes[0],
 				)
 			},
 		},
-
-		{
-			name: "valid",
-			promoMechs: &kargoapi.PromotionMechanisms{
-				GitRepoUpdates: []kargoapi.GitRepoUpdate{
-					{
-						Kustomize: &kargoapi.KustomizePromotionMechanism{},
-					},
-				},
-			},
-			assertions: func(t *testing.T, _ *kargoapi.PromotionMechanisms, errs field.ErrorList) {
-				require.Nil(t, errs)
-			},
-		},
 	}
-	w := &webhook{}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
 				t,
-				testCase.promoMechs,
-				w.validatePromotionMechanisms(
-					field.NewPath("promotionMechanisms"),
-					testCase.promoMechs,
-				),
+				testCase.webhook.validateAutoPromotion(context.Background(), testCase.stage),
 			)
 		})
 	}
 }
 
-func TestValidateGitRepoUpdates(t *testing.T) {
-	testCases := []struct {
-		name       string
-		update     kargoapi.GitRepoUpdate
-		assertions func(*testing.T, kargoapi.GitRepoUpdate, field.ErrorList)
-	}{
-		{
-			name: "more than one config management tool specified",
-			update: kargoapi.GitRepoUpdate{
-				Render:    &kargoapi.KargoRenderPromotionMechanism{},
-				Kustomize: &kargoapi.KustomizePromotionMechanism{},
-				Helm:      &kargoapi.HelmPromotionMechanism{},
-			},
-			assertions: func(t *testing.T, update kargoapi.GitRepoUpdate, errs field.ErrorList) {
-				require.Equal(
-					t,
-					field.ErrorList{
-						{
-							Type:     field.ErrorTypeInvalid,
-							Field:    "gitRepoUpdates[0]",
-							BadValue: update,
-							Detail: "no more than one of gitRepoUpdates[0].render, or " +
-								"gitRepoUpdates[0].kustomize, or gitRepoUpdates[0].helm " +
-								"may be defined",
-						},
-					},
-					errs,
-				)
-			},
+func TestValidatePromotionTemplateRef(t *testing.T) {
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-namespace",
+			Name:      "fake-stage",
 		},
-
-		{
-			name: "valid",
-			update: kargoapi.GitRepoUpdate{
-				Kustomize: &kargoapi.KustomizePromotionMechanism{},
-			},
-			assertions: func(t *testing.T, _ kargoapi.GitRepoUpdate, errs field.ErrorList) {
-				require.Nil(t, errs)
+		Spec: kargoapi.StageSpec{
+			PromotionTemplateRef: &corev1.LocalObjectReference{
+				Name: "fake-template",
 			},
 		},
 	}
-	w := &webhook{}
-	for _, testCase := range testCases {
-		t.Run(testCase.name, func(t *testing.T) {
-			testCase.assertions(
-				t,
-				testCase.update,
-				w.validateGitRepoUpdates(
-					field.NewPath("gitRepoUpdates"),
-					[]kargoapi.GitRepoUpdate{
-						testCase.update,
-					},
-				),
-			)
-		})
-	}
-}
-
-func TestValidateGitRepoUpdate(t *testing.T) {
 	testCases := []struct {
 		name       string
-		update     kargoapi.GitRepoUpdate
-		assertions func(*testing.T, kargoapi.GitRepoUpdate, field.ErrorList)
+		webhook    *webhook
+		stage      *kargoapi.Stage
+		assertions func(*testing.T, error)
 	}{
 		{
-			name: "more than one config management tool specified",
-			update: kargoapi.GitRepoUpdate{
-				Render:    &kargoapi.KargoRenderPromotionMechanism{},
-				Kustomize: &kargoapi.KustomizePromotionMechanism{},
-				Helm:      &kargoapi.HelmPromotionMechanism{},
-			},
-			assertions: func(t *testing.T, update kargoapi.GitRepoUpdate, errs field.ErrorList) {
-				require.Equal(
-					t,
-					field.ErrorList{
-						{
-							Type:     field.ErrorTypeInvalid,
-							Field:    "gitRepoUpdate",
-							BadValue: update,
-							Detail: "no more than one of gitRepoUpdate.render, or " +
-								"gitRepoUpdate.kustomize, or gitRepoUpdate.helm may be " +
-								"defined",
-						},
-					},
-					errs,
-				)
+			name:    "no PromotionTemplate referenced",
+			webhook: &webhook{},
+			stage:   &kargoapi.Stage{},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
 			},
 		},
-
 		{
-			name: "valid",
-			update: kargoapi.GitRepoUpdate{
-				Kustomize: &kargoapi.KustomizePromotionMechanism{},
+			name: "error getting PromotionTemplate",
+			webhook: &webhook{
+				getPromotionTemplateFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.PromotionTemplate, error) {
+					return nil, errors.New("something went wrong")
+				},
 			},
-			assertions: func(t *testing.T, _ kargoapi.GitRepoUpdate, errs field.ErrorList) {
-				require.Nil(t, errs)
+			stage: testStage,
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				statusErr, ok := err.(*apierrors.StatusError)
+				require.True(t, ok)
+				require.Equal(t, metav1.StatusReasonInternalError, statusErr.Status().Reason)
 			},
 		},
-	}
-	w := &webhook{}
-	for _, testCase := range testCases {
-		t.Run(testCase.name, func(t *testing.T) {
-			testCase.assertions(
-				t,
-				testCase.update,
-				w.validateGitRepoUpdate(
-					field.NewPath("gitRepoUpdate"),
-					testCase.update,
-				),
-			)
-		})
-	}
-}
-
-func TestValidateHelmPromotionMechanism(t *testing.T) {
-	testCases := []struct {
-		name       string
-		promoMech  *kargoapi.HelmPromotionMechanism
-		assertions func(*testing.T, *kargoapi.HelmPromotionMechanism, field.ErrorList)
-	}{
 		{
-			name: "nil",
-			assertions: func(t *testing.T, _ *kargoapi.HelmPromotionMechanism, errs field.ErrorList) {
-				require.Empty(t, errs)
+			name: "PromotionTemplate not found",
+			webhook: &webhook{
+				getPromotionTemplateFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.PromotionTemplate, error) {
+					return nil, nil
+				},
 			},
-		},
-
-		{
-			name: "invalid",
-			// Doesn't define any changes
-			promoMech: &kargoapi.HelmPromotionMechanism{},
-			assertions: func(
-				t *testing.T,
-				promoMech *kargoapi.HelmPromotionMechanism,
-				errs field.ErrorList,
-			) {
+			stage: testStage,
+			assertions: func(t *testing.T, err error) {
+				statusErr, ok := err.(*apierrors.StatusError)
+				require.True(t, ok)
+				require.Equal(t, metav1.StatusReasonInvalid, statusErr.Status().Reason)
+				require.Len(t, statusErr.Status().Details.Causes, 1)
 				require.Equal(
 					t,
-					field.ErrorList{
-						{
-							Type:     field.ErrorTypeInvalid,
-							Field:    "helm",
-							BadValue: promoMech,
-							Detail: "at least one of helm.images or helm.charts must be " +
-								"non-empty",
-						},
+					metav1.StatusCause{
+						Type:    metav1.CauseTypeFieldValueNotFound,
+						Field:   "spec.promotionTemplateRef.name",
+						Message: `Not found: "fake-template"`,
 					},
-					errs,
+					statusErr.Status().Details.Causes[0],
 				)
 			},
 		},
-
 		{
-			name: "valid",
-			promoMech: &kargoapi.HelmPromotionMechanism{
-				Images: []kargoapi.HelmImageUpdate{
-					{},
+			name: "PromotionTemplate found",
+			webhook: &webhook{
+				getPromotionTemplateFn: func(
+					_ context.Context,
+					_ client.Client,
+					namespacedName types.NamespacedName,
+				) (*kargoapi.PromotionTemplate, error) {
+					require.Equal(
+						t,
+						types.NamespacedName{
+							Namespace: "fake-namespace",
+							Name:      "fake-template",
+						},
+						namespacedName,
+					)
+					return &kargoapi.PromotionTemplate{}, nil
 				},
 			},
-			assertions: func(t *testing.T, _ *kargoapi.HelmPromotionMechanism, errs field.ErrorList) {
-				require.Empty(t, errs)
+			stage: testStage,
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
 			},
 		},
 	}
-	w := &webhook{}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			testCase.assertions(
 				t,
-				testCase.promoMech,
-				w.validateHelmPromotionMechanism(
-					field.NewPath("helm"),
-					testCase.promoMech,
+				testCase.webhook.validatePromotionTemplateRef(
+					context.Background(),
+					testCase.stage,
 				),
 			)
 		})
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -66,8 +67,16 @@ func SetStringsInBytes(
 		col   int
 		value string
 	}
+	// Keys are visited in lexical order so that if more than one key happens to
+	// address the same node, the outcome is the same every time.
+	keys := make([]string, 0, len(changes))
+	for k := range changes {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
 	changesByLine := map[int]change{}
-	for k, v := range changes {
+	for _, k := range keys {
+		v := changes[k]
 		keyPath := strings.Split(k, ".")
 		if found, line, col := findScalarNode(doc, keyPath); found {
 			changesByLine[line] = change{
@@ -115,6 +124,130 @@ func SetStringsInBytes(
 	return outBuf.Bytes(), nil
 }
 
+// HasKey returns whether the provided bytes contain a scalar node addressed by
+// the provided key. Keys are of the form <key 0>.<key 1>...<key n>. Integers
+// may be used as keys in cases where a specific node needs to be selected from
+// a sequence.
+func HasKey(inBytes []byte, key string) (bool, error) {
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal(inBytes, doc); err != nil {
+		return false, fmt.Errorf("error unmarshaling input: %w", err)
+	}
+	found, _, _ := findScalarNode(doc, strings.Split(key, "."))
+	return found, nil
+}
+
+// MergeIntoFile overwrites the specified file with the provided overrides
+// deep-merged into its contents. See MergeIntoBytes for details.
+func MergeIntoFile(file string, overrides map[string]any) error {
+	inBytes, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf(
+			"error reading file %q: %w",
+			file,
+			err,
+		)
+	}
+	outBytes, err := MergeIntoBytes(inBytes, overrides)
+	if err != nil {
+		return fmt.Errorf("error mutating bytes: %w", err)
+	}
+	// This file should always exist already, so the permissions we choose here
+	// don't really matter.
+	if err = os.WriteFile(file, outBytes, 0600); err != nil {
+		return fmt.Errorf(
+			"error writing mutated bytes to file %q: %w",
+			file,
+			err,
+		)
+	}
+	return nil
+}
+
+// MergeIntoBytes returns a copy of the provided bytes with the provided
+// overrides deep-merged into them. Where both the existing value and the
+// override for a given key are maps, they are merged recursively. Otherwise,
+// the override replaces the existing value or, if the key does not exist yet,
+// is added. Keys not present in the overrides are left untouched. Comments in
+// the input bytes are preserved wherever possible, but unlike with
+// SetStringsInBytes, other style choices may not be.
+func MergeIntoBytes(inBytes []byte, overrides map[string]any) ([]byte, error) {
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal(inBytes, doc); err != nil {
+		return nil, fmt.Errorf("error unmarshaling input: %w", err)
+	}
+	if doc.Kind == 0 {
+		// The input was empty
+		doc = &yaml.Node{
+			Kind:    yaml.DocumentNode,
+			Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}},
+		}
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("input is not a YAML map")
+	}
+	if err := mergeIntoNode(doc.Content[0], overrides); err != nil {
+		return nil, err
+	}
+	outBuf := &bytes.Buffer{}
+	enc := yaml.NewEncoder(outBuf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("error marshaling output: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("error marshaling output: %w", err)
+	}
+	return outBuf.Bytes(), nil
+}
+
+// mergeIntoNode deep-merges the provided overrides into the provided mapping
+// node.
+func mergeIntoNode(node *yaml.Node, overrides map[string]any) error {
+	// Keys are visited in lexical order so that any keys that need to be added
+	// are always added in the same order.
+	keys := make([]string, 0, len(overrides))
+	for k := range overrides {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		v := overrides[k]
+		valueIndex := -1
+		for i := 0; i < len(node.Content); i += 2 {
+			if node.Content[i].Value == k {
+				valueIndex = i + 1
+				break
+			}
+		}
+		if m, ok := v.(map[string]any); ok && valueIndex >= 0 &&
+			node.Content[valueIndex].Kind == yaml.MappingNode {
+			if err := mergeIntoNode(node.Content[valueIndex], m); err != nil {
+				return err
+			}
+			continue
+		}
+		valueNode := &yaml.Node{}
+		if err := valueNode.Encode(v); err != nil {
+			return fmt.Errorf("error encoding value for key %q: %w", k, err)
+		}
+		if valueIndex < 0 {
+			node.Content = append(
+				node.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: k},
+				valueNode,
+			)
+			continue
+		}
+		oldValueNode := node.Content[valueIndex]
+		valueNode.HeadComment = oldValueNode.HeadComment
+		valueNode.LineComment = oldValueNode.LineComment
+		valueNode.FootComment = oldValueNode.FootComment
+		node.Content[valueIndex] = valueNode
+	}
+	return nil
+}
+
 func findScalarNode(node *yaml.Node, keyPath []string) (bool, int, int) {
 	if len(keyPath) == 0 {
 		if node.Kind == yaml.ScalarNode {
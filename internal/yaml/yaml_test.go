@@ -60,6 +60,192 @@ characters:
 	}
 }
 
+func TestSetStringsInBytesWithOverlappingKeys(t *testing.T) {
+	inBytes := []byte(`
+characters:
+- name: Anakin
+  affiliation: Light side
+`)
+	// Both of these keys address the same node. The outcome must not depend on
+	// the order in which the map happens to be iterated over.
+	changes := map[string]string{
+		"characters.0.affiliation":  "Dark side",
+		"characters.00.affiliation": "Sith",
+	}
+	for i := 0; i < 50; i++ {
+		b, err := SetStringsInBytes(inBytes, changes)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			[]byte(`
+characters:
+- name: Anakin
+  affiliation: Sith
+`),
+			b,
+		)
+	}
+}
+
+func TestMergeIntoBytes(t *testing.T) {
+	testCases := []struct {
+		name       string
+		inBytes    []byte
+		overrides  map[string]any
+		assertions func(*testing.T, []byte, error)
+	}{
+		{
+			name: "invalid YAML",
+			// Note: This YAML is invalid because one line is indented with a tab
+			inBytes: []byte(`
+characters:
+- name: Anakin
+	affiliation: Light side
+`),
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "error unmarshaling input")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name:    "input is not a map",
+			inBytes: []byte("- Anakin\n- Obi-Wan\n"),
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "input is not a YAML map")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name:    "empty input",
+			inBytes: []byte(""),
+			overrides: map[string]any{
+				"replicas": 2,
+			},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "replicas: 2\n", string(bytes))
+			},
+		},
+		{
+			name: "success",
+			inBytes: []byte(`# Values for the test environment
+replicas: 1 # Scaled up in prod
+image:
+  repository: nginx
+  tag: 1.25.0
+resources:
+  limits:
+    cpu: 100m
+    memory: 128Mi
+ingress:
+  hosts:
+    - test.example.com
+`),
+			overrides: map[string]any{
+				"replicas": 3,
+				"resources": map[string]any{
+					"limits": map[string]any{
+						"memory": "256Mi",
+					},
+				},
+				"ingress": map[string]any{
+					"enabled": true,
+					"hosts":   []any{"a.example.com", "b.example.com"},
+				},
+				"podAnnotations": map[string]any{
+					"team": "platform",
+				},
+			},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`# Values for the test environment
+replicas: 3 # Scaled up in prod
+image:
+  repository: nginx
+  tag: 1.25.0
+resources:
+  limits:
+    cpu: 100m
+    memory: 256Mi
+ingress:
+  hosts:
+    - a.example.com
+    - b.example.com
+  enabled: true
+podAnnotations:
+  team: platform
+`,
+					string(bytes),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			b, err := MergeIntoBytes(testCase.inBytes, testCase.overrides)
+			testCase.assertions(t, b, err)
+		})
+	}
+}
+
+func TestHasKey(t *testing.T) {
+	yamlBytes := []byte(`
+image:
+  repository: nginx
+  tag: 1.25.0
+`)
+	testCases := []struct {
+		name       string
+		inBytes    []byte
+		key        string
+		assertions func(*testing.T, bool, error)
+	}{
+		{
+			name:    "invalid YAML",
+			inBytes: []byte("{"),
+			key:     "image.tag",
+			assertions: func(t *testing.T, _ bool, err error) {
+				require.ErrorContains(t, err, "error unmarshaling input")
+			},
+		},
+		{
+			name:    "key not found",
+			inBytes: yamlBytes,
+			key:     "image.digest",
+			assertions: func(t *testing.T, found bool, err error) {
+				require.NoError(t, err)
+				require.False(t, found)
+			},
+		},
+		{
+			name:    "key does not address a scalar node",
+			inBytes: yamlBytes,
+			key:     "image",
+			assertions: func(t *testing.T, found bool, err error) {
+				require.NoError(t, err)
+				require.False(t, found)
+			},
+		},
+		{
+			name:    "key found",
+			inBytes: yamlBytes,
+			key:     "image.tag",
+			assertions: func(t *testing.T, found bool, err error) {
+				require.NoError(t, err)
+				require.True(t, found)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			found, err := HasKey(testCase.inBytes, testCase.key)
+			testCase.assertions(t, found, err)
+		})
+	}
+}
+
 func TestFindScalarNode(t *testing.T) {
 	yamlBytes := []byte(`
 characters:
@@ -0,0 +1,87 @@
+// Package conditions provides helpers for maintaining the standard
+// []metav1.Condition field of a resource's status in accordance with
+// Kubernetes API conventions.
+package conditions
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetCondition returns a pointer to the condition of the provided type in the
+// provided conditions. If no such condition exists, nil is returned instead.
+func GetCondition(
+	conditions []metav1.Condition,
+	conditionType string,
+) *metav1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetCondition adds the provided condition to the provided conditions or, if
+// a condition of the same type already exists, updates it. The
+// LastTransitionTime of an existing condition is only updated when its status
+// changes. When it is updated or the condition is new, the LastTransitionTime
+// of the provided condition is used or, if it is unset, the current time. The
+// return value indicates whether the conditions were changed.
+func SetCondition(
+	conditions *[]metav1.Condition,
+	condition metav1.Condition,
+) bool {
+	if conditions == nil {
+		return false
+	}
+	if condition.LastTransitionTime.IsZero() {
+		condition.LastTransitionTime = metav1.Now()
+	}
+	existing := GetCondition(*conditions, condition.Type)
+	if existing == nil {
+		*conditions = append(*conditions, condition)
+		return true
+	}
+	var changed bool
+	if existing.Status != condition.Status {
+		existing.Status = condition.Status
+		existing.LastTransitionTime = condition.LastTransitionTime
+		changed = true
+	}
+	if existing.Reason != condition.Reason {
+		existing.Reason = condition.Reason
+		changed = true
+	}
+	if existing.Message != condition.Message {
+		existing.Message = condition.Message
+		changed = true
+	}
+	if existing.ObservedGeneration != condition.ObservedGeneration {
+		existing.ObservedGeneration = condition.ObservedGeneration
+		changed = true
+	}
+	return changed
+}
+
+// RemoveCondition removes the condition of the provided type from the
+// provided conditions. The return value indicates whether such a condition
+// existed.
+func RemoveCondition(
+	conditions *[]metav1.Condition,
+	conditionType string,
+) bool {
+	if conditions == nil || len(*conditions) == 0 {
+		return false
+	}
+	remaining := make([]metav1.Condition, 0, len(*conditions))
+	for _, condition := range *conditions {
+		if condition.Type != conditionType {
+			remaining = append(remaining, condition)
+		}
+	}
+	if len(remaining) == len(*conditions) {
+		return false
+	}
+	*conditions = remaining
+	return true
+}
@@ -0,0 +1,240 @@
+package conditions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetCondition(t *testing.T) {
+	testConditions := []metav1.Condition{
+		{Type: "Synced", Status: metav1.ConditionTrue},
+		{Type: "Healthy", Status: metav1.ConditionFalse},
+	}
+	testCases := []struct {
+		name          string
+		conditions    []metav1.Condition
+		conditionType string
+		assertions    func(*testing.T, *metav1.Condition)
+	}{
+		{
+			name:          "nil conditions",
+			conditionType: "Synced",
+			assertions: func(t *testing.T, condition *metav1.Condition) {
+				require.Nil(t, condition)
+			},
+		},
+		{
+			name:          "condition not found",
+			conditions:    testConditions,
+			conditionType: "Degraded",
+			assertions: func(t *testing.T, condition *metav1.Condition) {
+				require.Nil(t, condition)
+			},
+		},
+		{
+			name:          "condition found",
+			conditions:    testConditions,
+			conditionType: "Healthy",
+			assertions: func(t *testing.T, condition *metav1.Condition) {
+				require.NotNil(t, condition)
+				require.Equal(t, metav1.ConditionFalse, condition.Status)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				GetCondition(testCase.conditions, testCase.conditionType),
+			)
+		})
+	}
+}
+
+func TestSetCondition(t *testing.T) {
+	oldTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	newTime := metav1.NewTime(time.Now().Truncate(time.Second))
+	testCases := []struct {
+		name       string
+		conditions []metav1.Condition
+		condition  metav1.Condition
+		assertions func(*testing.T, []metav1.Condition, bool)
+	}{
+		{
+			name: "new condition",
+			condition: metav1.Condition{
+				Type:               "Synced",
+				Status:             metav1.ConditionTrue,
+				Reason:             "Synced",
+				LastTransitionTime: newTime,
+			},
+			assertions: func(t *testing.T, conditions []metav1.Condition, changed bool) {
+				require.True(t, changed)
+				require.Equal(
+					t,
+					[]metav1.Condition{{
+						Type:               "Synced",
+						Status:             metav1.ConditionTrue,
+						Reason:             "Synced",
+						LastTransitionTime: newTime,
+					}},
+					conditions,
+				)
+			},
+		},
+		{
+			name: "new condition without transition time",
+			condition: metav1.Condition{
+				Type:   "Synced",
+				Status: metav1.ConditionTrue,
+				Reason: "Synced",
+			},
+			assertions: func(t *testing.T, conditions []metav1.Condition, changed bool) {
+				require.True(t, changed)
+				require.Len(t, conditions, 1)
+				require.False(t, conditions[0].LastTransitionTime.IsZero())
+			},
+		},
+		{
+			name: "status changed",
+			conditions: []metav1.Condition{{
+				Type:               "Synced",
+				Status:             metav1.ConditionTrue,
+				Reason:             "Synced",
+				LastTransitionTime: oldTime,
+			}},
+			condition: metav1.Condition{
+				Type:               "Synced",
+				Status:             metav1.ConditionFalse,
+				Reason:             "SyncFailed",
+				Message:            "something went wrong",
+				LastTransitionTime: newTime,
+			},
+			assertions: func(t *testing.T, conditions []metav1.Condition, changed bool) {
+				require.True(t, changed)
+				require.Equal(
+					t,
+					[]metav1.Condition{{
+						Type:               "Synced",
+						Status:             metav1.ConditionFalse,
+						Reason:             "SyncFailed",
+						Message:            "something went wrong",
+						LastTransitionTime: newTime,
+					}},
+					conditions,
+				)
+			},
+		},
+		{
+			name: "only reason and message changed",
+			conditions: []metav1.Condition{{
+				Type:               "Healthy",
+				Status:             metav1.ConditionFalse,
+				Reason:             "Progressing",
+				LastTransitionTime: oldTime,
+			}},
+			condition: metav1.Condition{
+				Type:               "Healthy",
+				Status:             metav1.ConditionFalse,
+				Reason:             "Unhealthy",
+				Message:            "something is wrong",
+				ObservedGeneration: 2,
+				LastTransitionTime: newTime,
+			},
+			assertions: func(t *testing.T, conditions []metav1.Condition, changed bool) {
+				require.True(t, changed)
+				require.Equal(
+					t,
+					[]metav1.Condition{{
+						Type:               "Healthy",
+						Status:             metav1.ConditionFalse,
+						Reason:             "Unhealthy",
+						Message:            "something is wrong",
+						ObservedGeneration: 2,
+						// Not updated, because the status did not change
+						LastTransitionTime: oldTime,
+					}},
+					conditions,
+				)
+			},
+		},
+		{
+			name: "nothing changed",
+			conditions: []metav1.Condition{{
+				Type:               "Healthy",
+				Status:             metav1.ConditionTrue,
+				Reason:             "Healthy",
+				LastTransitionTime: oldTime,
+			}},
+			condition: metav1.Condition{
+				Type:   "Healthy",
+				Status: metav1.ConditionTrue,
+				Reason: "Healthy",
+			},
+			assertions: func(t *testing.T, conditions []metav1.Condition, changed bool) {
+				require.False(t, changed)
+				require.Equal(t, oldTime, conditions[0].LastTransitionTime)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			changed := SetCondition(&testCase.conditions, testCase.condition)
+			testCase.assertions(t, testCase.conditions, changed)
+		})
+	}
+}
+
+func TestRemoveCondition(t *testing.T) {
+	testCases := []struct {
+		name          string
+		conditions    []metav1.Condition
+		conditionType string
+		assertions    func(*testing.T, []metav1.Condition, bool)
+	}{
+		{
+			name:          "nil conditions",
+			conditionType: "Synced",
+			assertions: func(t *testing.T, conditions []metav1.Condition, removed bool) {
+				require.False(t, removed)
+				require.Nil(t, conditions)
+			},
+		},
+		{
+			name: "condition not found",
+			conditions: []metav1.Condition{
+				{Type: "Synced", Status: metav1.ConditionTrue},
+			},
+			conditionType: "Degraded",
+			assertions: func(t *testing.T, conditions []metav1.Condition, removed bool) {
+				require.False(t, removed)
+				require.Len(t, conditions, 1)
+			},
+		},
+		{
+			name: "condition found",
+			conditions: []metav1.Condition{
+				{Type: "Synced", Status: metav1.ConditionTrue},
+				{Type: "Degraded", Status: metav1.ConditionFalse},
+			},
+			conditionType: "Degraded",
+			assertions: func(t *testing.T, conditions []metav1.Condition, removed bool) {
+				require.True(t, removed)
+				require.Equal(
+					t,
+					[]metav1.Condition{{Type: "Synced", Status: metav1.ConditionTrue}},
+					conditions,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			removed := RemoveCondition(&testCase.conditions, testCase.conditionType)
+			testCase.assertions(t, testCase.conditions, removed)
+		})
+	}
+}
@@ -7,7 +7,11 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto2 } from "@bufbuild/protobuf";
-import { Duration, ListMeta, ObjectMeta, Time } from "../k8s.io/apimachinery/pkg/apis/meta/v1/generated_pb.js";
+import { JobTemplateSpec } from "../k8s.io/api/batch/v1/generated_pb.js";
+import { LocalObjectReference } from "../k8s.io/api/core/v1/generated_pb.js";
+import { Subject } from "../k8s.io/api/rbac/v1/generated_pb.js";
+import { Condition, Duration, LabelSelector, ListMeta, ObjectMeta, Time } from "../k8s.io/apimachinery/pkg/apis/meta/v1/generated_pb.js";
+import { RawExtension } from "../k8s.io/apimachinery/pkg/runtime/generated_pb.js";
 
 /**
  * AnalysisRunArgument represents an argument to be added to an AnalysisRun.
@@ -247,6 +251,61 @@ export class ApprovedStage extends Message<ApprovedStage> {
   }
 }
 
+/**
+ * ArgoCDAppHealthCheck describes a custom health check for an Argo CD
+ * Application resource.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ArgoCDAppHealthCheck
+ */
+export class ArgoCDAppHealthCheck extends Message<ArgoCDAppHealthCheck> {
+  /**
+   * JSONPath is a JSONPath expression (e.g. "{.status.operationState.phase}")
+   * that is evaluated against the Argo CD Application resource. If the
+   * expression is not enclosed in curly braces, they will be added.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string jsonPath = 1;
+   */
+  jsonPath?: string;
+
+  /**
+   * ExpectedValue is the value the JSONPath expression must evaluate to for
+   * the Argo CD Application resource to be considered healthy.
+   *
+   * @generated from field: optional string expectedValue = 2;
+   */
+  expectedValue?: string;
+
+  constructor(data?: PartialMessage<ArgoCDAppHealthCheck>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.ArgoCDAppHealthCheck";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "jsonPath", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "expectedValue", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArgoCDAppHealthCheck {
+    return new ArgoCDAppHealthCheck().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArgoCDAppHealthCheck {
+    return new ArgoCDAppHealthCheck().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArgoCDAppHealthCheck {
+    return new ArgoCDAppHealthCheck().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArgoCDAppHealthCheck | PlainMessage<ArgoCDAppHealthCheck> | undefined, b: ArgoCDAppHealthCheck | PlainMessage<ArgoCDAppHealthCheck> | undefined): boolean {
+    return proto2.util.equals(ArgoCDAppHealthCheck, a, b);
+  }
+}
+
 /**
  * ArgoCDAppHealthStatus describes the health of an ArgoCD Application.
  *
@@ -357,6 +416,75 @@ export class ArgoCDAppStatus extends Message<ArgoCDAppStatus> {
   }
 }
 
+/**
+ * ArgoCDAppStatusCheck describes an Argo CD Application whose health and
+ * sync status are checked when assessing the health of a Stage.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ArgoCDAppStatusCheck
+ */
+export class ArgoCDAppStatusCheck extends Message<ArgoCDAppStatusCheck> {
+  /**
+   * Name is the name of the Argo CD Application.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string name = 1;
+   */
+  name?: string;
+
+  /**
+   * Namespace is the namespace of the Argo CD Application. When left
+   * unspecified, the namespace of the Argo CD instance Kargo is configured to
+   * integrate with is used.
+   *
+   * +optional
+   *
+   * @generated from field: optional string namespace = 2;
+   */
+  namespace?: string;
+
+  /**
+   * RequiredSyncStatus is the sync status the Argo CD Application must have
+   * for the Stage to be considered healthy. When left unspecified, the
+   * Application must be Synced.
+   *
+   * +kubebuilder:default=Synced
+   * +kubebuilder:validation:Enum=Synced;OutOfSync
+   *
+   * @generated from field: optional string requiredSyncStatus = 3;
+   */
+  requiredSyncStatus?: string;
+
+  constructor(data?: PartialMessage<ArgoCDAppStatusCheck>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.ArgoCDAppStatusCheck";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "requiredSyncStatus", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArgoCDAppStatusCheck {
+    return new ArgoCDAppStatusCheck().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ArgoCDAppStatusCheck {
+    return new ArgoCDAppStatusCheck().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ArgoCDAppStatusCheck {
+    return new ArgoCDAppStatusCheck().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ArgoCDAppStatusCheck | PlainMessage<ArgoCDAppStatusCheck> | undefined, b: ArgoCDAppStatusCheck | PlainMessage<ArgoCDAppStatusCheck> | undefined): boolean {
+    return proto2.util.equals(ArgoCDAppStatusCheck, a, b);
+  }
+}
+
 /**
  * ArgoCDAppSyncStatus describes the sync status of an ArgoCD Application.
  *
@@ -459,6 +587,74 @@ export class ArgoCDAppUpdate extends Message<ArgoCDAppUpdate> {
    */
   sourceUpdates: ArgoCDSourceUpdate[] = [];
 
+  /**
+   * SourceIndex optionally identifies, by its zero-based index in the
+   * Application's spec.sources, the single source of a multi-source Argo CD
+   * Application that SourceUpdates are applied to. When left unspecified,
+   * SourceUpdates are applied to the Application's singular source or to each
+   * of its sources that they match. Promotions fail if the index is out of
+   * bounds of the Application's sources.
+   *
+   * +kubebuilder:validation:Minimum=0
+   * +optional
+   *
+   * @generated from field: optional int32 sourceIndex = 8;
+   */
+  sourceIndex?: number;
+
+  /**
+   * HealthCheck optionally describes an additional condition that must be
+   * satisfied by the specified Argo CD Application resource for it to be
+   * considered healthy. This is useful when an Application's readiness is
+   * encoded in fields other than its health and sync status.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ArgoCDAppHealthCheck healthCheck = 5;
+   */
+  healthCheck?: ArgoCDAppHealthCheck;
+
+  /**
+   * ArgoCDClusterName optionally identifies a remote cluster in which the
+   * specified Argo CD Application resource is managed. When specified, the
+   * Application is updated using credentials of type argocd whose repoURL is
+   * argocd://<cluster-name>. If left unspecified, the Application is managed by
+   * the Argo CD instance Kargo is configured to integrate with.
+   *
+   * +kubebuilder:validation:Optional
+   * +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$
+   *
+   * @generated from field: optional string argoCDClusterName = 6;
+   */
+  argoCDClusterName?: string;
+
+  /**
+   * ResourceType specifies the kind of Argo CD resource named by AppName.
+   * Application, the default, updates the sources of an individual
+   * Application. ApplicationSet updates the sources of the Application
+   * template of an ApplicationSet, so that all Applications generated from
+   * it are updated by the Argo CD ApplicationSet controller.
+   *
+   * +kubebuilder:default=Application
+   * +kubebuilder:validation:Enum=Application;ApplicationSet
+   *
+   * @generated from field: optional string resourceType = 7;
+   */
+  resourceType?: string;
+
+  /**
+   * SyncTimeout is the maximum amount of time to wait for a sync operation of
+   * the specified Argo CD Application resource to complete. A Promotion that
+   * is still waiting for the operation once it has been running for longer
+   * than this is marked as Failed. If unspecified, the Promotion waits for
+   * the operation to complete for as long as it takes.
+   *
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+   * +optional
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration syncTimeout = 9;
+   */
+  syncTimeout?: Duration;
+
   constructor(data?: PartialMessage<ArgoCDAppUpdate>) {
     super();
     proto2.util.initPartial(data, this);
@@ -471,6 +667,11 @@ export class ArgoCDAppUpdate extends Message<ArgoCDAppUpdate> {
     { no: 2, name: "appNamespace", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "origin", kind: "message", T: FreightOrigin, opt: true },
     { no: 3, name: "sourceUpdates", kind: "message", T: ArgoCDSourceUpdate, repeated: true },
+    { no: 8, name: "sourceIndex", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 5, name: "healthCheck", kind: "message", T: ArgoCDAppHealthCheck, opt: true },
+    { no: 6, name: "argoCDClusterName", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "resourceType", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 9, name: "syncTimeout", kind: "message", T: Duration, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ArgoCDAppUpdate {
@@ -882,6 +1083,134 @@ export class ArgoCDSourceUpdate extends Message<ArgoCDSourceUpdate> {
   }
 }
 
+/**
+ * CanaryPromotion describes how to expose the Freight being promoted to a Stage
+ * to only a percentage of traffic, using the canary strategy of an Argo Rollouts
+ * Rollout residing in the same cluster as Kargo, instead of shifting all
+ * traffic to it at once. The weight of the first setWeight step of the
+ * Rollout's canary strategy is set to the specified percentage, after which
+ * the Rollout's own steps, including any canary analysis, determine how it
+ * advances. The Promotion remains Running until the Rollout has been fully
+ * promoted and fails if the Rollout is aborted or becomes degraded. The
+ * Rollout must be annotated with kargo.akuity.io/authorized-stage:
+ * <project>:<stage> to permit the Stage to update it.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.CanaryPromotion
+ */
+export class CanaryPromotion extends Message<CanaryPromotion> {
+  /**
+   * Namespace is the namespace of the Rollout. When left unspecified, the
+   * Rollout is assumed to be in the Stage's namespace.
+   *
+   * @generated from field: optional string namespace = 1;
+   */
+  namespace?: string;
+
+  /**
+   * Rollout is the name of the Rollout. This is a required field.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string rollout = 2;
+   */
+  rollout?: string;
+
+  /**
+   * Weight is the percentage of traffic the canary receives before its
+   * analysis is awaited. This is a required field.
+   *
+   * +kubebuilder:validation:Minimum=1
+   * +kubebuilder:validation:Maximum=100
+   *
+   * @generated from field: optional int32 weight = 3;
+   */
+  weight?: number;
+
+  constructor(data?: PartialMessage<CanaryPromotion>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.CanaryPromotion";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "rollout", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "weight", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CanaryPromotion {
+    return new CanaryPromotion().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CanaryPromotion {
+    return new CanaryPromotion().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CanaryPromotion {
+    return new CanaryPromotion().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CanaryPromotion | PlainMessage<CanaryPromotion> | undefined, b: CanaryPromotion | PlainMessage<CanaryPromotion> | undefined): boolean {
+    return proto2.util.equals(CanaryPromotion, a, b);
+  }
+}
+
+/**
+ * ChangeApprovalCheck describes how to verify, using an external ticketing
+ * system, that an approved change request exists for a Promotion.
+ *
+ * The ticketing system (or an adapter in front of it) is queried with an HTTP
+ * GET request to the specified URL, with the project, stage, and freight query
+ * parameters identifying the Promotion. It is expected to respond with HTTP 404
+ * if no change request references the Promotion or with HTTP 200 and a JSON
+ * body of the form {"id": "CHG0001234", "approved": true} otherwise.
+ * Credentials for the ticketing system are looked up in the same manner as
+ * repository credentials, using Secrets labeled with
+ * kargo.akuity.io/cred-type: ticketing.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ChangeApprovalCheck
+ */
+export class ChangeApprovalCheck extends Message<ChangeApprovalCheck> {
+  /**
+   * URL is the URL of the endpoint to query for change requests. This is a
+   * required field.
+   *
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:Pattern=`^https?://(\w+([\.-]\w+)*@)?\w+([\.-]\w+)*(:[\d]+)?(/.*)?$`
+   *
+   * @generated from field: optional string url = 1;
+   */
+  url?: string;
+
+  constructor(data?: PartialMessage<ChangeApprovalCheck>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.ChangeApprovalCheck";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChangeApprovalCheck {
+    return new ChangeApprovalCheck().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChangeApprovalCheck {
+    return new ChangeApprovalCheck().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChangeApprovalCheck {
+    return new ChangeApprovalCheck().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChangeApprovalCheck | PlainMessage<ChangeApprovalCheck> | undefined, b: ChangeApprovalCheck | PlainMessage<ChangeApprovalCheck> | undefined): boolean {
+    return proto2.util.equals(ChangeApprovalCheck, a, b);
+  }
+}
+
 /**
  * Chart describes a specific version of a Helm chart.
  *
@@ -944,6 +1273,72 @@ export class Chart extends Message<Chart> {
   }
 }
 
+/**
+ * ChartChange describes a single change introduced by a version of a Helm
+ * chart.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ChartChange
+ */
+export class ChartChange extends Message<ChartChange> {
+  /**
+   * RepoURL is the URL of the chart repository.
+   *
+   * @generated from field: optional string repoURL = 1;
+   */
+  repoURL?: string;
+
+  /**
+   * Name is the name of the chart.
+   *
+   * @generated from field: optional string name = 2;
+   */
+  name?: string;
+
+  /**
+   * Version is the version of the chart that introduced the change.
+   *
+   * @generated from field: optional string version = 3;
+   */
+  version?: string;
+
+  /**
+   * Description describes the change.
+   *
+   * @generated from field: optional string description = 4;
+   */
+  description?: string;
+
+  constructor(data?: PartialMessage<ChartChange>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.ChartChange";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "version", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChartChange {
+    return new ChartChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChartChange {
+    return new ChartChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChartChange {
+    return new ChartChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChartChange | PlainMessage<ChartChange> | undefined, b: ChartChange | PlainMessage<ChartChange> | undefined): boolean {
+    return proto2.util.equals(ChartChange, a, b);
+  }
+}
+
 /**
  * ChartDiscoveryResult represents the result of a chart discovery operation for
  * a ChartSubscription.
@@ -989,6 +1384,18 @@ export class ChartDiscoveryResult extends Message<ChartDiscoveryResult> {
    */
   versions: string[] = [];
 
+  /**
+   * ServedBy is the URL of the chart repository from which the Versions were
+   * discovered. This differs from RepoURL when the repository specified by
+   * the ChartSubscription could not be reached and one of its fallback
+   * repositories was used instead.
+   *
+   * +optional
+   *
+   * @generated from field: optional string servedBy = 5;
+   */
+  servedBy?: string;
+
   constructor(data?: PartialMessage<ChartDiscoveryResult>) {
     super();
     proto2.util.initPartial(data, this);
@@ -1001,6 +1408,7 @@ export class ChartDiscoveryResult extends Message<ChartDiscoveryResult> {
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 3, name: "semverConstraint", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "versions", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "servedBy", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChartDiscoveryResult {
@@ -1082,6 +1490,48 @@ export class ChartSubscription extends Message<ChartSubscription> {
    */
   discoveryLimit?: number;
 
+  /**
+   * FallbackRepoURLs optionally specifies additional Helm chart repositories
+   * that serve the same chart(s) as the repository specified by the RepoURL
+   * field. When the repository specified by the RepoURL field cannot be
+   * reached, these repositories are tried in the order they are listed. The
+   * same rules that govern the use of the Name field with the RepoURL field
+   * apply to every repository in this list.
+   *
+   * +kubebuilder:validation:Optional
+   * +kubebuilder:validation:items:Pattern=`^(((https?)|(oci))://)([\w\d\.\-]+)(:[\d]+)?(/.*)*$`
+   *
+   * @generated from field: repeated string fallbackRepoURLs = 5;
+   */
+  fallbackRepoURLs: string[] = [];
+
+  /**
+   * VerifyProvenance specifies whether only chart versions whose provenance
+   * can be verified may be discovered. When true, the provenance (.prov) file
+   * published alongside each version of the chart is downloaded along with the
+   * chart itself, and the version is skipped unless the provenance file was
+   * signed using a key from the keyring referenced by the
+   * ProvenanceKeySecretRef field and records the checksum of the chart. This
+   * is only supported for classic chart repositories. This field is optional.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional bool verifyProvenance = 6;
+   */
+  verifyProvenance?: boolean;
+
+  /**
+   * ProvenanceKeySecretRef references a key of a Secret in the Warehouse's
+   * namespace that holds the GPG public keyring, either binary or
+   * ASCII-armored, used to verify the provenance of chart versions. This field
+   * is required when the VerifyProvenance field is true.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional SecretKeyReference provenanceKeySecretRef = 7;
+   */
+  provenanceKeySecretRef?: SecretKeyReference;
+
   constructor(data?: PartialMessage<ChartSubscription>) {
     super();
     proto2.util.initPartial(data, this);
@@ -1094,6 +1544,9 @@ export class ChartSubscription extends Message<ChartSubscription> {
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 3, name: "semverConstraint", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "discoveryLimit", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 5, name: "fallbackRepoURLs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "verifyProvenance", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 7, name: "provenanceKeySecretRef", kind: "message", T: SecretKeyReference, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChartSubscription {
@@ -1114,33 +1567,148 @@ export class ChartSubscription extends Message<ChartSubscription> {
 }
 
 /**
- * DiscoveredArtifacts holds the artifacts discovered by the Warehouse for its
- * subscriptions.
+ * CircuitBreaker describes when automatic Promotions to a Stage are suspended
+ * following repeated Promotion failures. Once Threshold consecutive Promotions
+ * to the Stage have failed, the circuit opens and no Promotions are
+ * automatically created for the Stage until Cooldown has elapsed. The circuit
+ * is then half-open: a single automatic Promotion is permitted, which closes
+ * the circuit if it succeeds or opens it again if it fails.
  *
- * @generated from message github.com.akuity.kargo.api.v1alpha1.DiscoveredArtifacts
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.CircuitBreaker
  */
-export class DiscoveredArtifacts extends Message<DiscoveredArtifacts> {
+export class CircuitBreaker extends Message<CircuitBreaker> {
   /**
-   * Git holds the commits discovered by the Warehouse for the Git
-   * subscriptions.
+   * Threshold is the number of consecutive failed Promotions after which the
+   * circuit opens. When left unspecified, the threshold is 3.
    *
-   * +optional
+   * +kubebuilder:default=3
+   * +kubebuilder:validation:Minimum=1
    *
-   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.GitDiscoveryResult git = 1;
+   * @generated from field: optional int32 threshold = 1;
    */
-  git: GitDiscoveryResult[] = [];
+  threshold?: number;
 
   /**
-   * Images holds the image references discovered by the Warehouse for the
-   * image subscriptions.
+   * Cooldown is how long the circuit remains open before an automatic
+   * Promotion is attempted again. When left unspecified, the cooldown is 10
+   * minutes.
    *
-   * +optional
+   * +kubebuilder:default="10m"
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
    *
-   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ImageDiscoveryResult images = 2;
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration cooldown = 2;
    */
-  images: ImageDiscoveryResult[] = [];
+  cooldown?: Duration;
 
-  /**
+  constructor(data?: PartialMessage<CircuitBreaker>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.CircuitBreaker";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "threshold", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 2, name: "cooldown", kind: "message", T: Duration, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CircuitBreaker {
+    return new CircuitBreaker().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CircuitBreaker {
+    return new CircuitBreaker().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CircuitBreaker {
+    return new CircuitBreaker().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CircuitBreaker | PlainMessage<CircuitBreaker> | undefined, b: CircuitBreaker | PlainMessage<CircuitBreaker> | undefined): boolean {
+    return proto2.util.equals(CircuitBreaker, a, b);
+  }
+}
+
+/**
+ * CircuitBreakerStatus describes the state of a Stage's circuit breaker.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.CircuitBreakerStatus
+ */
+export class CircuitBreakerStatus extends Message<CircuitBreakerStatus> {
+  /**
+   * ConsecutiveFailures is the number of Promotions to the Stage that have
+   * failed since the last successful Promotion.
+   *
+   * @generated from field: optional int32 consecutiveFailures = 1;
+   */
+  consecutiveFailures?: number;
+
+  /**
+   * OpenedAt is the time at which the circuit was last opened. It is unset
+   * while the circuit is closed.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time openedAt = 2;
+   */
+  openedAt?: Time;
+
+  constructor(data?: PartialMessage<CircuitBreakerStatus>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.CircuitBreakerStatus";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "consecutiveFailures", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 2, name: "openedAt", kind: "message", T: Time, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CircuitBreakerStatus {
+    return new CircuitBreakerStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CircuitBreakerStatus {
+    return new CircuitBreakerStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CircuitBreakerStatus {
+    return new CircuitBreakerStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CircuitBreakerStatus | PlainMessage<CircuitBreakerStatus> | undefined, b: CircuitBreakerStatus | PlainMessage<CircuitBreakerStatus> | undefined): boolean {
+    return proto2.util.equals(CircuitBreakerStatus, a, b);
+  }
+}
+
+/**
+ * DiscoveredArtifacts holds the artifacts discovered by the Warehouse for its
+ * subscriptions.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.DiscoveredArtifacts
+ */
+export class DiscoveredArtifacts extends Message<DiscoveredArtifacts> {
+  /**
+   * Git holds the commits discovered by the Warehouse for the Git
+   * subscriptions.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.GitDiscoveryResult git = 1;
+   */
+  git: GitDiscoveryResult[] = [];
+
+  /**
+   * Images holds the image references discovered by the Warehouse for the
+   * image subscriptions.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ImageDiscoveryResult images = 2;
+   */
+  images: ImageDiscoveryResult[] = [];
+
+  /**
    * Charts holds the charts discovered by the Warehouse for the chart
    * subscriptions.
    *
@@ -1353,6 +1921,103 @@ export class DiscoveredImageReference extends Message<DiscoveredImageReference>
   }
 }
 
+/**
+ * FluxHelmReleaseUpdate describes an update that should be applied to a Flux
+ * HelmRelease resource to incorporate Freight into a Stage. The version of the
+ * HelmRelease's chart is updated to the version of the specified chart found
+ * in the Freight. The HelmRelease must be annotated with
+ * kargo.akuity.io/authorized-stage: <project>:<stage> to permit the Stage to
+ * update it.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.FluxHelmReleaseUpdate
+ */
+export class FluxHelmReleaseUpdate extends Message<FluxHelmReleaseUpdate> {
+  /**
+   * Namespace is the namespace of the HelmRelease. When left unspecified,
+   * the HelmRelease is assumed to be in the Stage's namespace.
+   *
+   * @generated from field: optional string namespace = 1;
+   */
+  namespace?: string;
+
+  /**
+   * Name is the name of the HelmRelease. This is a required field.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string name = 2;
+   */
+  name?: string;
+
+  /**
+   * RepoURL along with the Chart field identifies the chart, as it appears
+   * in Freight, whose version the HelmRelease's chart is updated to. For
+   * charts stored in classic chart repositories, this is the URL of the
+   * repository. For charts stored in OCI registries, this is the full URL of
+   * the chart, including the oci:// prefix. This is a required field.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string repoURL = 3;
+   */
+  repoURL?: string;
+
+  /**
+   * Chart along with the RepoURL field identifies the chart, as it appears
+   * in Freight, whose version the HelmRelease's chart is updated to. This
+   * field should be left unspecified for charts stored in OCI registries.
+   *
+   * @generated from field: optional string chart = 4;
+   */
+  chart?: string;
+
+  /**
+   * Origin disambiguates the origin from which artifacts used by this promotion
+   * mechanism must have originated. This is especially useful in cases where a
+   * Stage may request Freight from multiples origins (e.g. multiple Warehouses)
+   * and some of those each reference different versions of artifacts from the
+   * same repository. This field is optional. When left unspecified, it will
+   * implicitly inherit the value of the enclosing PromotionMechanisms' Origin
+   * field. If that, too, is unspecified, Promotions will fail if there is ever
+   * ambiguity regarding from which piece of Freight an artifact is to be
+   * sourced.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.FreightOrigin origin = 5;
+   */
+  origin?: FreightOrigin;
+
+  constructor(data?: PartialMessage<FluxHelmReleaseUpdate>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.FluxHelmReleaseUpdate";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "chart", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "origin", kind: "message", T: FreightOrigin, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FluxHelmReleaseUpdate {
+    return new FluxHelmReleaseUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FluxHelmReleaseUpdate {
+    return new FluxHelmReleaseUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FluxHelmReleaseUpdate {
+    return new FluxHelmReleaseUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FluxHelmReleaseUpdate | PlainMessage<FluxHelmReleaseUpdate> | undefined, b: FluxHelmReleaseUpdate | PlainMessage<FluxHelmReleaseUpdate> | undefined): boolean {
+    return proto2.util.equals(FluxHelmReleaseUpdate, a, b);
+  }
+}
+
 /**
  * Freight represents a collection of versioned artifacts.
  *
@@ -1524,6 +2189,60 @@ export class FreightCollection extends Message<FreightCollection> {
   }
 }
 
+/**
+ * FreightCollectionDelta represents a FreightCollection in a
+ * FreightHistoryDeltas list, either in full or as the differences from the
+ * FreightCollection recorded before it.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.FreightCollectionDelta
+ */
+export class FreightCollectionDelta extends Message<FreightCollectionDelta> {
+  /**
+   * Baseline is the full FreightCollection. It is only specified by the oldest
+   * entry of a list.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.FreightCollection baseline = 1;
+   */
+  baseline?: FreightCollection;
+
+  /**
+   * Patch is a JSON merge patch (RFC 7386) that, applied to the
+   * FreightCollection recorded before this one, produces this one. It is
+   * specified by every entry of a list except the oldest.
+   *
+   * @generated from field: optional string patch = 2;
+   */
+  patch?: string;
+
+  constructor(data?: PartialMessage<FreightCollectionDelta>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.FreightCollectionDelta";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "baseline", kind: "message", T: FreightCollection, opt: true },
+    { no: 2, name: "patch", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightCollectionDelta {
+    return new FreightCollectionDelta().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FreightCollectionDelta {
+    return new FreightCollectionDelta().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FreightCollectionDelta {
+    return new FreightCollectionDelta().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FreightCollectionDelta | PlainMessage<FreightCollectionDelta> | undefined, b: FreightCollectionDelta | PlainMessage<FreightCollectionDelta> | undefined): boolean {
+    return proto2.util.equals(FreightCollectionDelta, a, b);
+  }
+}
+
 /**
  * FreightList is a list of Freight resources.
  *
@@ -1813,6 +2532,26 @@ export class FreightSources extends Message<FreightSources> {
    */
   stages: string[] = [];
 
+  /**
+   * AvailabilityStrategy specifies how Freight verified in the upstream Stages
+   * specified by the Stages field is determined to be available to the Stage
+   * when more than one upstream Stage is specified. Freight verified in more
+   * than one upstream Stage is only ever considered once. Valid values are:
+   *
+   * - OneOf: Freight is available once it has been verified in ANY of the
+   *   upstream Stages. This is the default.
+   * - All: Freight is only available once it has been verified in ALL of the
+   *   upstream Stages.
+   *
+   * This affects which Freight the Stage considers available, e.g. for
+   * auto-promotion. Freight approved for the Stage is always available.
+   *
+   * +kubebuilder:validation:Enum=OneOf;All
+   *
+   * @generated from field: optional string availabilityStrategy = 3;
+   */
+  availabilityStrategy?: string;
+
   constructor(data?: PartialMessage<FreightSources>) {
     super();
     proto2.util.initPartial(data, this);
@@ -1823,6 +2562,7 @@ export class FreightSources extends Message<FreightSources> {
   static readonly fields: FieldList = proto2.util.newFieldList(() => [
     { no: 1, name: "direct", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
     { no: 2, name: "stages", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "availabilityStrategy", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FreightSources {
@@ -2221,6 +2961,112 @@ export class GitRepoUpdate extends Message<GitRepoUpdate> {
    */
   helm?: HelmPromotionMechanism;
 
+  /**
+   * CommitMessageTemplate is a Go template rendered to produce the message of
+   * the commit made to the repository. The template is rendered against an
+   * object with the fields Project and Stage, which hold the names of the
+   * Stage's Project and the Stage, Freight, which holds the FreightCollection
+   * being promoted, Changes, which holds a summary of each change applied to
+   * the repository, and DefaultMessage, which holds the message used when no
+   * template is specified. When left unspecified, a message summarizing the
+   * changes is used.
+   *
+   * +optional
+   *
+   * @generated from field: optional string commitMessageTemplate = 10;
+   */
+  commitMessageTemplate?: string;
+
+  /**
+   * SigningKeySecretRef references a key of a Secret in the Stage's namespace
+   * holding an ASCII-armored GPG private key. When specified, commits made to
+   * the repository are signed with this key, which must not be protected by a
+   * passphrase. This field is optional.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.SecretKeyReference signingKeySecretRef = 11;
+   */
+  signingKeySecretRef?: SecretKeyReference;
+
+  /**
+   * AuthorName overrides the name of the author of commits made to the
+   * repository. When left unspecified, the name configured for the controller
+   * is used.
+   *
+   * +optional
+   *
+   * @generated from field: optional string authorName = 12;
+   */
+  authorName?: string;
+
+  /**
+   * AuthorEmail overrides the email address of the author of commits made to
+   * the repository. When left unspecified, the email address configured for
+   * the controller is used.
+   *
+   * +optional
+   *
+   * @generated from field: optional string authorEmail = 13;
+   */
+  authorEmail?: string;
+
+  /**
+   * ShallowCloneDepth optionally limits the number of commits fetched when
+   * cloning the repository to apply updates. This can considerably speed up
+   * Promotions for large repositories, but any commit that updates are based
+   * on must be within the specified depth of the head of its branch. If the
+   * remote repository does not support shallow clones, a full clone is
+   * performed instead. When left unspecified or set to zero, the full history
+   * of the repository is cloned.
+   *
+   * +kubebuilder:validation:Minimum=0
+   *
+   * @generated from field: optional int32 shallowCloneDepth = 14;
+   */
+  shallowCloneDepth?: number;
+
+  /**
+   * SOPSDecryptBeforeApply specifies whether files in the repository whose
+   * names end in .sops.yaml are decrypted using SOPS before configuration
+   * management tools update the repository. Modified files are re-encrypted
+   * for the age recipients they were originally encrypted for before changes
+   * are committed. When true, SOPSAgeKeySecretRef must also be specified.
+   *
+   * +optional
+   *
+   * @generated from field: optional bool sopsDecryptBeforeApply = 15;
+   */
+  sopsDecryptBeforeApply?: boolean;
+
+  /**
+   * SOPSAgeKeySecretRef references a key of a Secret in the Stage's namespace
+   * holding the age private key used to decrypt SOPS-encrypted files. It is
+   * required when SOPSDecryptBeforeApply is true.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.SecretKeyReference sopsAgeKeySecretRef = 16;
+   */
+  sopsAgeKeySecretRef?: SecretKeyReference;
+
+  /**
+   * EditOrder specifies the order in which the Kustomize or Helm edits making
+   * up the single commit to the repository are applied. Valid values are:
+   *
+   * - Lexical: Files, and Kustomize images within a single directory, are
+   *   updated in lexical order of their paths and names.
+   * - Listed: Files, and Kustomize images within a single directory, are
+   *   updated in the order in which they are first listed.
+   *
+   * Either way, promoting the same Freight always produces the same diff.
+   * When left unspecified, the default is Lexical.
+   *
+   * +kubebuilder:default=Lexical
+   * +optional
+   *
+   * @generated from field: optional string editOrder = 17;
+   */
+  editOrder?: string;
+
   constructor(data?: PartialMessage<GitRepoUpdate>) {
     super();
     proto2.util.initPartial(data, this);
@@ -2238,6 +3084,14 @@ export class GitRepoUpdate extends Message<GitRepoUpdate> {
     { no: 6, name: "render", kind: "message", T: KargoRenderPromotionMechanism, opt: true },
     { no: 7, name: "kustomize", kind: "message", T: KustomizePromotionMechanism, opt: true },
     { no: 8, name: "helm", kind: "message", T: HelmPromotionMechanism, opt: true },
+    { no: 10, name: "commitMessageTemplate", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 11, name: "signingKeySecretRef", kind: "message", T: SecretKeyReference, opt: true },
+    { no: 12, name: "authorName", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 13, name: "authorEmail", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 14, name: "shallowCloneDepth", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 15, name: "sopsDecryptBeforeApply", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 16, name: "sopsAgeKeySecretRef", kind: "message", T: SecretKeyReference, opt: true },
+    { no: 17, name: "editOrder", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitRepoUpdate {
@@ -2300,6 +3154,22 @@ export class GitSubscription extends Message<GitSubscription> {
    */
   branch?: string;
 
+  /**
+   * Branches optionally references additional branches of the repository.
+   * The value in this field only has any effect when the CommitSelectionStrategy
+   * is NewestFromBranch or left unspecified. Commits are discovered from each of
+   * these branches as well as from the branch referenced by the Branch field,
+   * if any, and the newest of them is selected. A commit that is found on more
+   * than one of these branches is only discovered once.
+   *
+   * +kubebuilder:validation:Optional
+   * +kubebuilder:validation:items:MinLength=1
+   * +kubebuilder:validation:items:Pattern=`^\w+([-/]\w+)*$`
+   *
+   * @generated from field: repeated string branches = 12;
+   */
+  branches: string[] = [];
+
   /**
    * SemverConstraint specifies constraints on what new tagged commits are
    * considered in determining the newest commit of interest. The value in this
@@ -2403,6 +3273,52 @@ export class GitSubscription extends Message<GitSubscription> {
    */
   discoveryLimit?: number;
 
+  /**
+   * ShallowCloneDepth optionally limits the number of commits fetched when
+   * cloning the repository to discover commits. This can considerably speed up
+   * discovery for large repositories, but commits beyond the specified depth
+   * cannot be discovered. If the remote repository does not support shallow
+   * clones, a full clone is performed instead. When left unspecified or set to
+   * zero, the full history of the repository is cloned.
+   *
+   * +kubebuilder:validation:Minimum=0
+   *
+   * @generated from field: optional int32 shallowCloneDepth = 11;
+   */
+  shallowCloneDepth?: number;
+
+  /**
+   * KnownHostsConfigMapRef references a ConfigMap in the Warehouse's namespace
+   * whose known_hosts key holds the content of an SSH known_hosts file. When
+   * specified, the key of the repository's host is verified against it whenever
+   * the repository is accessed over SSH, and discovery fails if the ConfigMap
+   * does not exist or does not include a key for the repository's host. Known
+   * hosts specified this way take precedence over any included in the
+   * repository's credentials.
+   *
+   * +optional
+   *
+   * @generated from field: optional k8s.io.api.core.v1.LocalObjectReference knownHostsConfigMapRef = 13;
+   */
+  knownHostsConfigMapRef?: LocalObjectReference;
+
+  /**
+   * SparseCheckoutPaths optionally lists the paths of directories, relative to
+   * the root of the repository, that are of interest to this subscription. This
+   * is useful for subscriptions to monorepos. When specified, the repository is
+   * cloned without file contents and only the specified directories are checked
+   * out. Only commits that modify files in at least one of the specified
+   * directories are discovered, so changes to unrelated directories do not
+   * trigger the production of new Freight. When not specified, the entire
+   * repository is checked out and all commits are discovered.
+   *
+   * +kubebuilder:validation:Optional
+   * +kubebuilder:validation:items:MinLength=1
+   *
+   * @generated from field: repeated string sparseCheckoutPaths = 14;
+   */
+  sparseCheckoutPaths: string[] = [];
+
   constructor(data?: PartialMessage<GitSubscription>) {
     super();
     proto2.util.initPartial(data, this);
@@ -2414,6 +3330,7 @@ export class GitSubscription extends Message<GitSubscription> {
     { no: 1, name: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 2, name: "commitSelectionStrategy", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 3, name: "branch", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 12, name: "branches", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 4, name: "semverConstraint", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 5, name: "allowTags", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 6, name: "ignoreTags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
@@ -2421,6 +3338,9 @@ export class GitSubscription extends Message<GitSubscription> {
     { no: 8, name: "includePaths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 9, name: "excludePaths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 10, name: "discoveryLimit", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 11, name: "shallowCloneDepth", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 13, name: "knownHostsConfigMapRef", kind: "message", T: LocalObjectReference, opt: true },
+    { no: 14, name: "sparseCheckoutPaths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitSubscription {
@@ -2441,33 +3361,122 @@ export class GitSubscription extends Message<GitSubscription> {
 }
 
 /**
- * Health describes the health of a Stage.
+ * HTTPHealthCheck describes an HTTP endpoint that is probed with a GET request
+ * when assessing the health of a Stage.
  *
- * @generated from message github.com.akuity.kargo.api.v1alpha1.Health
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.HTTPHealthCheck
  */
-export class Health extends Message<Health> {
+export class HTTPHealthCheck extends Message<HTTPHealthCheck> {
   /**
-   * Status describes the health of the Stage.
+   * URL is the URL of the endpoint to probe.
    *
-   * @generated from field: optional string status = 1;
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:Pattern=^https?://.+$
+   *
+   * @generated from field: optional string url = 1;
    */
-  status?: string;
+  url?: string;
 
   /**
-   * Issues clarifies why a Stage in any state other than Healthy is in that
-   * state. This field will always be the empty when a Stage is Healthy.
+   * ExpectedStatusCode is the status code the endpoint must respond with for
+   * the Stage to be considered healthy. When left unspecified, 200 is expected.
    *
-   * @generated from field: repeated string issues = 2;
+   * +kubebuilder:default=200
+   * +kubebuilder:validation:Minimum=100
+   * +kubebuilder:validation:Maximum=599
+   *
+   * @generated from field: optional int32 expectedStatusCode = 2;
    */
-  issues: string[] = [];
+  expectedStatusCode?: number;
 
   /**
-   * ArgoCDApps describes the current state of any related ArgoCD Applications.
+   * TimeoutSeconds is the number of seconds after which a probe of the
+   * endpoint is abandoned and the Stage is considered unhealthy. When left
+   * unspecified, the timeout is 10 seconds. It may not exceed 60 seconds.
    *
-   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ArgoCDAppStatus argoCDApps = 3;
+   * +kubebuilder:default=10
+   * +kubebuilder:validation:Minimum=1
+   * +kubebuilder:validation:Maximum=60
+   *
+   * @generated from field: optional int32 timeoutSeconds = 3;
+   */
+  timeoutSeconds?: number;
+
+  /**
+   * InsecureSkipTLSVerify specifies whether certificate verification errors
+   * should be ignored when probing the endpoint.
+   *
+   * @generated from field: optional bool insecureSkipTLSVerify = 4;
+   */
+  insecureSkipTLSVerify?: boolean;
+
+  constructor(data?: PartialMessage<HTTPHealthCheck>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.HTTPHealthCheck";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "expectedStatusCode", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 3, name: "timeoutSeconds", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 4, name: "insecureSkipTLSVerify", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HTTPHealthCheck {
+    return new HTTPHealthCheck().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HTTPHealthCheck {
+    return new HTTPHealthCheck().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HTTPHealthCheck {
+    return new HTTPHealthCheck().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HTTPHealthCheck | PlainMessage<HTTPHealthCheck> | undefined, b: HTTPHealthCheck | PlainMessage<HTTPHealthCheck> | undefined): boolean {
+    return proto2.util.equals(HTTPHealthCheck, a, b);
+  }
+}
+
+/**
+ * Health describes the health of a Stage.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.Health
+ */
+export class Health extends Message<Health> {
+  /**
+   * Status describes the health of the Stage.
+   *
+   * @generated from field: optional string status = 1;
+   */
+  status?: string;
+
+  /**
+   * Issues clarifies why a Stage in any state other than Healthy is in that
+   * state. This field will always be the empty when a Stage is Healthy.
+   *
+   * @generated from field: repeated string issues = 2;
+   */
+  issues: string[] = [];
+
+  /**
+   * ArgoCDApps describes the current state of any related ArgoCD Applications.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ArgoCDAppStatus argoCDApps = 3;
    */
   argoCDApps: ArgoCDAppStatus[] = [];
 
+  /**
+   * CheckedAt is the time at which the Stage's health was last assessed. Health
+   * that has not been assessed recently may no longer be accurate.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time checkedAt = 4;
+   */
+  checkedAt?: Time;
+
   constructor(data?: PartialMessage<Health>) {
     super();
     proto2.util.initPartial(data, this);
@@ -2479,6 +3488,7 @@ export class Health extends Message<Health> {
     { no: 1, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 2, name: "issues", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 3, name: "argoCDApps", kind: "message", T: ArgoCDAppStatus, repeated: true },
+    { no: 4, name: "checkedAt", kind: "message", T: Time, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Health {
@@ -2498,6 +3508,78 @@ export class Health extends Message<Health> {
   }
 }
 
+/**
+ * HealthChecks describes checks performed when assessing the health of a Stage.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.HealthChecks
+ */
+export class HealthChecks extends Message<HealthChecks> {
+  /**
+   * HTTPChecks describes HTTP endpoints that are probed when assessing the
+   * health of the Stage. The Stage is only considered healthy if each endpoint
+   * responds with its expected status code.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.HTTPHealthCheck httpChecks = 1;
+   */
+  httpChecks: HTTPHealthCheck[] = [];
+
+  /**
+   * ArgoCDApps describes Argo CD Applications, other than those updated by
+   * the Stage, whose health and sync status are checked when assessing the
+   * health of the Stage. The Stage is only considered healthy if each
+   * Application is healthy and has its required sync status.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ArgoCDAppStatusCheck argocdApps = 2;
+   */
+  argocdApps: ArgoCDAppStatusCheck[] = [];
+
+  /**
+   * PodImages describes Pods whose containers are checked to run the images
+   * of the Stage's current Freight when assessing the health of the Stage.
+   * Until every container of the matching Pods that runs an image from a
+   * repository referenced by the Freight runs the image referenced by the
+   * Freight, the Stage is considered to be progressing.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PodImageHealthCheck podImages = 3;
+   */
+  podImages: PodImageHealthCheck[] = [];
+
+  constructor(data?: PartialMessage<HealthChecks>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.HealthChecks";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "httpChecks", kind: "message", T: HTTPHealthCheck, repeated: true },
+    { no: 2, name: "argocdApps", kind: "message", T: ArgoCDAppStatusCheck, repeated: true },
+    { no: 3, name: "podImages", kind: "message", T: PodImageHealthCheck, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HealthChecks {
+    return new HealthChecks().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HealthChecks {
+    return new HealthChecks().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HealthChecks {
+    return new HealthChecks().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HealthChecks | PlainMessage<HealthChecks> | undefined, b: HealthChecks | PlainMessage<HealthChecks> | undefined): boolean {
+    return proto2.util.equals(HealthChecks, a, b);
+  }
+}
+
 /**
  * HelmChartDependencyUpdate describes how a specific Helm chart that is used
  * as a subchart of an umbrella chart can be updated.
@@ -2730,6 +3812,58 @@ export class HelmPromotionMechanism extends Message<HelmPromotionMechanism> {
    */
   origin?: FreightOrigin;
 
+  /**
+   * ValuesFilePath specifies a path to a Helm values file into which the
+   * contents of ValuesOverrides are to be merged. This field is optional, but
+   * is required if ValuesOverrides is specified.
+   *
+   * +kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+   *
+   * @generated from field: optional string valuesFilePath = 4;
+   */
+  valuesFilePath?: string;
+
+  /**
+   * ValuesOverrides specifies arbitrary values to be deep-merged into the Helm
+   * values file at ValuesFilePath after any image and chart dependency updates
+   * have been applied. Nested maps are merged key by key. Any other value,
+   * including a list, replaces the existing value at the same key. Keys not
+   * specified here are left untouched, as are comments wherever possible.
+   * This field is optional.
+   *
+   * +kubebuilder:validation:Type=object
+   * +kubebuilder:pruning:PreserveUnknownFields
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.runtime.RawExtension valuesOverrides = 5;
+   */
+  valuesOverrides?: RawExtension;
+
+  /**
+   * UmbrellaChartPath specifies a path to an umbrella chart whose dependencies
+   * are all kept up to date with the Freight being promoted. Each dependency
+   * listed in the umbrella chart's Chart.yaml that is referenced by the Freight
+   * has its version updated to the one referenced by the Freight, unless it
+   * already matches. Dependencies not referenced by the Freight are left
+   * untouched. Chart.lock is regenerated whenever any version changes. This
+   * field is optional.
+   *
+   * +kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+   *
+   * @generated from field: optional string umbrellaChartPath = 6;
+   */
+  umbrellaChartPath?: string;
+
+  /**
+   * ValuesFiles describes an ordered chain of Helm values files, such as a base
+   * values file followed by the files that override it, along with the changes
+   * to be made to each of them. Files are updated in the order in which they are
+   * listed, after any image and chart dependency updates have been applied and
+   * before ValuesOverrides are merged. This field is optional.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.HelmValuesFileUpdate valuesFiles = 7;
+   */
+  valuesFiles: HelmValuesFileUpdate[] = [];
+
   constructor(data?: PartialMessage<HelmPromotionMechanism>) {
     super();
     proto2.util.initPartial(data, this);
@@ -2741,6 +3875,10 @@ export class HelmPromotionMechanism extends Message<HelmPromotionMechanism> {
     { no: 1, name: "images", kind: "message", T: HelmImageUpdate, repeated: true },
     { no: 2, name: "charts", kind: "message", T: HelmChartDependencyUpdate, repeated: true },
     { no: 3, name: "origin", kind: "message", T: FreightOrigin, opt: true },
+    { no: 4, name: "valuesFilePath", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "valuesOverrides", kind: "message", T: RawExtension, opt: true },
+    { no: 6, name: "umbrellaChartPath", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 7, name: "valuesFiles", kind: "message", T: HelmValuesFileUpdate, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HelmPromotionMechanism {
@@ -2760,6 +3898,68 @@ export class HelmPromotionMechanism extends Message<HelmPromotionMechanism> {
   }
 }
 
+/**
+ * HelmValuesFileUpdate describes changes to be made to a single Helm values
+ * file within a chain of values files.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.HelmValuesFileUpdate
+ */
+export class HelmValuesFileUpdate extends Message<HelmValuesFileUpdate> {
+  /**
+   * Path specifies a path to the Helm values file that is to be updated. This
+   * is a required field.
+   *
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+   *
+   * @generated from field: optional string path = 1;
+   */
+  path?: string;
+
+  /**
+   * Updates maps keys of the form <key 0>.<key 1>...<key n> to the new values
+   * those keys should be set to. Values are written to the file as they are, so
+   * each must be valid YAML. A key that does not exist in this file, but that
+   * does exist in a values file listed before it, is added to this file. This
+   * permits an override file to begin overriding a value defined by a file it
+   * overrides. Any other key that does not exist in this file is ignored. This
+   * is a required field.
+   *
+   * +kubebuilder:validation:MinProperties=1
+   *
+   * @generated from field: map<string, string> updates = 2;
+   */
+  updates: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<HelmValuesFileUpdate>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.HelmValuesFileUpdate";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "updates", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HelmValuesFileUpdate {
+    return new HelmValuesFileUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HelmValuesFileUpdate {
+    return new HelmValuesFileUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HelmValuesFileUpdate {
+    return new HelmValuesFileUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HelmValuesFileUpdate | PlainMessage<HelmValuesFileUpdate> | undefined, b: HelmValuesFileUpdate | PlainMessage<HelmValuesFileUpdate> | undefined): boolean {
+    return proto2.util.equals(HelmValuesFileUpdate, a, b);
+  }
+}
+
 /**
  * Image describes a specific version of a container image.
  *
@@ -2897,6 +4097,56 @@ export class ImageDiscoveryResult extends Message<ImageDiscoveryResult> {
   }
 }
 
+/**
+ * ImagePullCheck describes how to verify that the images referenced by Freight
+ * can be pulled before the Freight is promoted to a Stage. This guards against
+ * promotions that would leave workloads unable to start because an image is
+ * missing from its registry or is inaccessible with the available credentials.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ImagePullCheck
+ */
+export class ImagePullCheck extends Message<ImagePullCheck> {
+  /**
+   * PullSecret is the name of a Secret of type kubernetes.io/dockerconfigjson
+   * in the Stage's namespace, such as the image pull Secret used by the
+   * Stage's workloads, holding the credentials used to retrieve image
+   * manifests. When left unspecified, credentials are looked up in the same
+   * manner as for image subscriptions.
+   *
+   * +optional
+   *
+   * @generated from field: optional string pullSecret = 1;
+   */
+  pullSecret?: string;
+
+  constructor(data?: PartialMessage<ImagePullCheck>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.ImagePullCheck";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "pullSecret", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImagePullCheck {
+    return new ImagePullCheck().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImagePullCheck {
+    return new ImagePullCheck().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImagePullCheck {
+    return new ImagePullCheck().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImagePullCheck | PlainMessage<ImagePullCheck> | undefined, b: ImagePullCheck | PlainMessage<ImagePullCheck> | undefined): boolean {
+    return proto2.util.equals(ImagePullCheck, a, b);
+  }
+}
+
 /**
  * ImageSubscription defines a subscription to an image repository.
  *
@@ -2939,6 +4189,22 @@ export class ImageSubscription extends Message<ImageSubscription> {
    */
   imageSelectionStrategy?: string;
 
+  /**
+   * LatestByBuildDate specifies whether the newest version of the image should
+   * be identified by the build date recorded in the org.opencontainers.image.created
+   * annotation of each tag's manifest. This is useful for images whose tags
+   * carry no ordering information and whose image configurations do not record
+   * a meaningful creation date, e.g. images produced by reproducible builds. When
+   * true, the ImageSelectionStrategy and SemverConstraint fields are ignored and
+   * tags whose manifests lack a valid annotation are not considered. This field
+   * is optional.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional bool latestByBuildDate = 12;
+   */
+  latestByBuildDate?: boolean;
+
   /**
    * SemverConstraint specifies constraints on what new image versions are
    * permissible. The value in this field only has any effect when the
@@ -2968,6 +4234,18 @@ export class ImageSubscription extends Message<ImageSubscription> {
    */
   allowTags?: string;
 
+  /**
+   * DenyTags is a regular expression that can optionally be used to exclude
+   * image tags from consideration in determining the newest version of an
+   * image. A tag matched by both AllowTags and DenyTags is excluded. This
+   * field is optional.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string denyTags = 10;
+   */
+  denyTags?: string;
+
   /**
    * IgnoreTags is a list of tags that must be ignored when determining the
    * newest version of an image. No regular expressions or glob patterns are
@@ -3004,10 +4282,24 @@ export class ImageSubscription extends Message<ImageSubscription> {
    */
   insecureSkipTLSVerify?: boolean;
 
+  /**
+   * CABundleConfigMapRef references a ConfigMap in the Warehouse's namespace
+   * whose ca.crt key holds one or more PEM-encoded CA certificates. When
+   * specified, the repository's TLS certificate is verified against these
+   * certificates in addition to the system's trusted CAs. This is useful for
+   * registries whose certificates are issued by a private CA. Discovery fails
+   * if the ConfigMap does not exist or holds no valid certificates.
+   *
+   * +optional
+   *
+   * @generated from field: optional k8s.io.api.core.v1.LocalObjectReference caBundleConfigMapRef = 11;
+   */
+  caBundleConfigMapRef?: LocalObjectReference;
+
   /**
    * DiscoveryLimit is an optional limit on the number of image references
    * that can be discovered for this subscription. The limit is applied after
-   * filtering images based on the AllowTags and IgnoreTags fields.
+   * filtering images based on the AllowTags, DenyTags, and IgnoreTags fields.
    * When left unspecified, the field is implicitly treated as if its value
    * were "20". The upper limit for this field is 100.
    *
@@ -3030,11 +4322,14 @@ export class ImageSubscription extends Message<ImageSubscription> {
     { no: 1, name: "repoURL", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 2, name: "gitRepoURL", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 3, name: "imageSelectionStrategy", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 12, name: "latestByBuildDate", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
     { no: 4, name: "semverConstraint", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 5, name: "allowTags", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 10, name: "denyTags", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 6, name: "ignoreTags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 7, name: "platform", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 8, name: "insecureSkipTLSVerify", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 11, name: "caBundleConfigMapRef", kind: "message", T: LocalObjectReference, opt: true },
     { no: 9, name: "discoveryLimit", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
   ]);
 
@@ -3055,6 +4350,53 @@ export class ImageSubscription extends Message<ImageSubscription> {
   }
 }
 
+/**
+ * JobTemplate describes a Job that is run as a promotion hook.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.JobTemplate
+ */
+export class JobTemplate extends Message<JobTemplate> {
+  /**
+   * Template is the template of the Job. The Job is created in the Stage's
+   * namespace with a name derived from the Promotion it is run for. Any name
+   * or namespace specified by the template is ignored.
+   *
+   * +kubebuilder:validation:Schemaless
+   * +kubebuilder:validation:Type=object
+   * +kubebuilder:pruning:PreserveUnknownFields
+   *
+   * @generated from field: optional k8s.io.api.batch.v1.JobTemplateSpec template = 1;
+   */
+  template?: JobTemplateSpec;
+
+  constructor(data?: PartialMessage<JobTemplate>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.JobTemplate";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "template", kind: "message", T: JobTemplateSpec, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobTemplate {
+    return new JobTemplate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobTemplate {
+    return new JobTemplate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobTemplate {
+    return new JobTemplate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobTemplate | PlainMessage<JobTemplate> | undefined, b: JobTemplate | PlainMessage<JobTemplate> | undefined): boolean {
+    return proto2.util.equals(JobTemplate, a, b);
+  }
+}
+
 /**
  * KargoRenderImageUpdate describes how an image can be incorporated into a
  * Stage using Kargo Render.
@@ -3190,56 +4532,174 @@ export class KargoRenderPromotionMechanism extends Message<KargoRenderPromotionM
 }
 
 /**
- * KustomizeImageUpdate describes how to run `kustomize edit set image`
- * for a given image.
+ * KubernetesResourceUpdate describes a JSON patch that should be applied to a
+ * Kubernetes resource, such as a Deployment or StatefulSet, residing in the
+ * same cluster as Kargo to incorporate Freight into a Stage. The resource must
+ * be annotated with kargo.akuity.io/authorized-stage: <project>:<stage> to
+ * permit the Stage to update it.
  *
- * @generated from message github.com.akuity.kargo.api.v1alpha1.KustomizeImageUpdate
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.KubernetesResourceUpdate
  */
-export class KustomizeImageUpdate extends Message<KustomizeImageUpdate> {
+export class KubernetesResourceUpdate extends Message<KubernetesResourceUpdate> {
   /**
-   * Image specifies a container image (without tag). This is a required field.
+   * APIVersion is the API version of the resource, e.g. apps/v1. This is a
+   * required field.
    *
    * +kubebuilder:validation:MinLength=1
    *
-   * @generated from field: optional string image = 1;
+   * @generated from field: optional string apiVersion = 1;
    */
-  image?: string;
+  apiVersion?: string;
 
   /**
-   * Origin disambiguates the origin from which artifacts used by this promotion
-   * mechanism must have originated. This is especially useful in cases where a
-   * Stage may request Freight from multiples origins (e.g. multiple Warehouses)
-   * and some of those each reference different versions of artifacts from the
-   * same repository. This field is optional. When left unspecified, it will
-   * implicitly inherit the value of the enclosing KustomizePromotionMechanism's
-   * Origin field. If that, too, is unspecified, Promotions will fail if there
-   * is ever ambiguity regarding from which piece of Freight an artifact is to
-   * be sourced.
+   * Kind is the kind of the resource, e.g. Deployment. This is a required
+   * field.
    *
-   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.FreightOrigin origin = 4;
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string kind = 2;
    */
-  origin?: FreightOrigin;
+  kind?: string;
 
   /**
-   * Path specifies a path in which the `kustomize edit set image` command
-   * should be executed. This is a required field.
+   * Namespace is the namespace of the resource. When left unspecified, the
+   * resource is assumed to be in the Stage's namespace.
    *
-   * +kubebuilder:validation:MinLength=1
-   * +kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
-   *
-   * @generated from field: optional string path = 2;
+   * @generated from field: optional string namespace = 3;
    */
-  path?: string;
+  namespace?: string;
 
   /**
-   * UseDigest specifies whether the image's digest should be used instead of
-   * its tag.
+   * Name is the name of the resource within its namespace. This is a
+   * required field.
    *
-   * +kubebuilder:validation:Optional
+   * +kubebuilder:validation:MinLength=1
    *
-   * @generated from field: optional bool useDigest = 3;
+   * @generated from field: optional string name = 4;
    */
-  useDigest?: boolean;
+  name?: string;
+
+  /**
+   * JSONPatch is a JSON patch, as described by RFC 6902, to apply to the
+   * resource. It is rendered as a Go template before being applied, with the
+   * names of the Project and Stage and the Freight being promoted available as
+   * .Project, .Stage, and .Freight respectively. This is a required field.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string jsonPatch = 5;
+   */
+  jsonPatch?: string;
+
+  constructor(data?: PartialMessage<KubernetesResourceUpdate>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.KubernetesResourceUpdate";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "apiVersion", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "jsonPatch", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KubernetesResourceUpdate {
+    return new KubernetesResourceUpdate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KubernetesResourceUpdate {
+    return new KubernetesResourceUpdate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KubernetesResourceUpdate {
+    return new KubernetesResourceUpdate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KubernetesResourceUpdate | PlainMessage<KubernetesResourceUpdate> | undefined, b: KubernetesResourceUpdate | PlainMessage<KubernetesResourceUpdate> | undefined): boolean {
+    return proto2.util.equals(KubernetesResourceUpdate, a, b);
+  }
+}
+
+/**
+ * KustomizeImageUpdate describes how to run `kustomize edit set image`
+ * for a given image.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.KustomizeImageUpdate
+ */
+export class KustomizeImageUpdate extends Message<KustomizeImageUpdate> {
+  /**
+   * Image specifies a container image (without tag). This is a required field.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string image = 1;
+   */
+  image?: string;
+
+  /**
+   * Origin disambiguates the origin from which artifacts used by this promotion
+   * mechanism must have originated. This is especially useful in cases where a
+   * Stage may request Freight from multiples origins (e.g. multiple Warehouses)
+   * and some of those each reference different versions of artifacts from the
+   * same repository. This field is optional. When left unspecified, it will
+   * implicitly inherit the value of the enclosing KustomizePromotionMechanism's
+   * Origin field. If that, too, is unspecified, Promotions will fail if there
+   * is ever ambiguity regarding from which piece of Freight an artifact is to
+   * be sourced.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.FreightOrigin origin = 4;
+   */
+  origin?: FreightOrigin;
+
+  /**
+   * Path specifies a path in which the `kustomize edit set image` command
+   * should be executed. This is a required field.
+   *
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+   *
+   * @generated from field: optional string path = 2;
+   */
+  path?: string;
+
+  /**
+   * UseDigest specifies whether the image's digest should be used instead of
+   * its tag.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional bool useDigest = 3;
+   */
+  useDigest?: boolean;
+
+  /**
+   * StripRegistryHost specifies whether the registry host should be removed
+   * from the image reference written to the kustomization.yaml file. This is
+   * useful when the kustomization.yaml file references images by short names
+   * and a registry mirror is relied upon to resolve them.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional bool stripRegistryHost = 5;
+   */
+  stripRegistryHost?: boolean;
+
+  /**
+   * ReplacementSource optionally designates a literal of a ConfigMap generated
+   * by the kustomization.yaml file in Path as the single source of the image
+   * reference. When specified, `kustomize edit set image` is not executed.
+   * Instead, the value of the literal is set to the image reference and the
+   * kustomization's replacements are relied upon to propagate it to every
+   * field that consumes it.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.KustomizeReplacementSource replacementSource = 6;
+   */
+  replacementSource?: KustomizeReplacementSource;
 
   constructor(data?: PartialMessage<KustomizeImageUpdate>) {
     super();
@@ -3253,6 +4713,8 @@ export class KustomizeImageUpdate extends Message<KustomizeImageUpdate> {
     { no: 4, name: "origin", kind: "message", T: FreightOrigin, opt: true },
     { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 3, name: "useDigest", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 5, name: "stripRegistryHost", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 6, name: "replacementSource", kind: "message", T: KustomizeReplacementSource, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KustomizeImageUpdate {
@@ -3304,6 +4766,21 @@ export class KustomizePromotionMechanism extends Message<KustomizePromotionMecha
    */
   origin?: FreightOrigin;
 
+  /**
+   * Components lists the paths of directories containing Kustomize components
+   * whose kustomization.yaml files should also be updated. Every entry of a
+   * component's images section that names an image updated by this promotion
+   * mechanism is updated to reference the same version of that image. Images a
+   * component does not already list are not added to it. This field is
+   * optional.
+   *
+   * +kubebuilder:validation:items:Pattern=^[\w-\.]+(/[\w-\.]+)*$
+   * +optional
+   *
+   * @generated from field: repeated string components = 3;
+   */
+  components: string[] = [];
+
   constructor(data?: PartialMessage<KustomizePromotionMechanism>) {
     super();
     proto2.util.initPartial(data, this);
@@ -3314,6 +4791,7 @@ export class KustomizePromotionMechanism extends Message<KustomizePromotionMecha
   static readonly fields: FieldList = proto2.util.newFieldList(() => [
     { no: 1, name: "images", kind: "message", T: KustomizeImageUpdate, repeated: true },
     { no: 2, name: "origin", kind: "message", T: FreightOrigin, opt: true },
+    { no: 3, name: "components", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KustomizePromotionMechanism {
@@ -3333,6 +4811,189 @@ export class KustomizePromotionMechanism extends Message<KustomizePromotionMecha
   }
 }
 
+/**
+ * KustomizeReplacementSource identifies a literal of a ConfigMap generated by
+ * a kustomization.yaml file's configMapGenerator that serves as the source of
+ * one or more of the kustomization's replacements.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.KustomizeReplacementSource
+ */
+export class KustomizeReplacementSource extends Message<KustomizeReplacementSource> {
+  /**
+   * ConfigMap is the name of the ConfigMap, as it appears in the
+   * configMapGenerator section of the kustomization.yaml file. This is a
+   * required field.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string configMap = 1;
+   */
+  configMap?: string;
+
+  /**
+   * Key is the key of the literal whose value should be set to the image
+   * reference. The literal must already exist. This is a required field.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string key = 2;
+   */
+  key?: string;
+
+  constructor(data?: PartialMessage<KustomizeReplacementSource>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.KustomizeReplacementSource";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "configMap", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KustomizeReplacementSource {
+    return new KustomizeReplacementSource().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KustomizeReplacementSource {
+    return new KustomizeReplacementSource().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KustomizeReplacementSource {
+    return new KustomizeReplacementSource().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KustomizeReplacementSource | PlainMessage<KustomizeReplacementSource> | undefined, b: KustomizeReplacementSource | PlainMessage<KustomizeReplacementSource> | undefined): boolean {
+    return proto2.util.equals(KustomizeReplacementSource, a, b);
+  }
+}
+
+/**
+ * PodImageHealthCheck describes Pods whose container images are checked
+ * against the images of a Stage's current Freight when assessing the health
+ * of the Stage.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PodImageHealthCheck
+ */
+export class PodImageHealthCheck extends Message<PodImageHealthCheck> {
+  /**
+   * Namespace is the namespace of the Pods. When left unspecified, the
+   * namespace of the Stage is used.
+   *
+   * +optional
+   *
+   * @generated from field: optional string namespace = 1;
+   */
+  namespace?: string;
+
+  /**
+   * Selector is a label selector that selects the Pods to check.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.LabelSelector selector = 2;
+   */
+  selector?: LabelSelector;
+
+  constructor(data?: PartialMessage<PodImageHealthCheck>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.PodImageHealthCheck";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "namespace", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "selector", kind: "message", T: LabelSelector, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PodImageHealthCheck {
+    return new PodImageHealthCheck().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PodImageHealthCheck {
+    return new PodImageHealthCheck().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PodImageHealthCheck {
+    return new PodImageHealthCheck().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PodImageHealthCheck | PlainMessage<PodImageHealthCheck> | undefined, b: PodImageHealthCheck | PlainMessage<PodImageHealthCheck> | undefined): boolean {
+    return proto2.util.equals(PodImageHealthCheck, a, b);
+  }
+}
+
+/**
+ * PollingIntervals describes how often each type of a Warehouse's
+ * subscriptions is polled for new artifacts. An unspecified or zero interval
+ * falls back to the Warehouse's Interval.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PollingIntervals
+ */
+export class PollingIntervals extends Message<PollingIntervals> {
+  /**
+   * GitIntervalSeconds is the number of seconds between polls of the
+   * Warehouse's Git subscriptions.
+   *
+   * +kubebuilder:validation:Minimum=0
+   * +optional
+   *
+   * @generated from field: optional int32 gitIntervalSeconds = 1;
+   */
+  gitIntervalSeconds?: number;
+
+  /**
+   * ImageIntervalSeconds is the number of seconds between polls of the
+   * Warehouse's image subscriptions.
+   *
+   * +kubebuilder:validation:Minimum=0
+   * +optional
+   *
+   * @generated from field: optional int32 imageIntervalSeconds = 2;
+   */
+  imageIntervalSeconds?: number;
+
+  /**
+   * ChartIntervalSeconds is the number of seconds between polls of the
+   * Warehouse's chart subscriptions.
+   *
+   * +kubebuilder:validation:Minimum=0
+   * +optional
+   *
+   * @generated from field: optional int32 chartIntervalSeconds = 3;
+   */
+  chartIntervalSeconds?: number;
+
+  constructor(data?: PartialMessage<PollingIntervals>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.PollingIntervals";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "gitIntervalSeconds", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 2, name: "imageIntervalSeconds", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 3, name: "chartIntervalSeconds", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PollingIntervals {
+    return new PollingIntervals().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PollingIntervals {
+    return new PollingIntervals().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PollingIntervals {
+    return new PollingIntervals().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PollingIntervals | PlainMessage<PollingIntervals> | undefined, b: PollingIntervals | PlainMessage<PollingIntervals> | undefined): boolean {
+    return proto2.util.equals(PollingIntervals, a, b);
+  }
+}
+
 /**
  * Project is a resource type that reconciles to a specially labeled namespace
  * and other TODO: TBD project-level resources.
@@ -3670,6 +5331,61 @@ export class PromotionMechanisms extends Message<PromotionMechanisms> {
    */
   argoCDAppUpdates: ArgoCDAppUpdate[] = [];
 
+  /**
+   * ChangeApproval describes a check that must find an approved change request
+   * in an external ticketing system before Freight is promoted to the Stage.
+   * This field is optional. When specified, the check is performed BEFORE any
+   * other promotion mechanisms are executed.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ChangeApprovalCheck changeApproval = 4;
+   */
+  changeApproval?: ChangeApprovalCheck;
+
+  /**
+   * ImagePullCheck describes a check that must be able to retrieve the
+   * manifest of every image referenced by the Freight being promoted before
+   * that Freight is promoted to the Stage. This field is optional. When
+   * specified, the check is performed after any change approval check and
+   * BEFORE any other promotion mechanisms are executed.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ImagePullCheck imagePullCheck = 5;
+   */
+  imagePullCheck?: ImagePullCheck;
+
+  /**
+   * FluxHelmReleaseUpdates describes updates that should be applied to Flux
+   * HelmRelease resources to incorporate Freight into the Stage. This field is
+   * optional, as such actions are not required in all cases. Note that all
+   * updates specified by the GitRepoUpdates field, if any, are applied BEFORE
+   * these.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.FluxHelmReleaseUpdate fluxHelmReleaseUpdates = 6;
+   */
+  fluxHelmReleaseUpdates: FluxHelmReleaseUpdate[] = [];
+
+  /**
+   * KubernetesResourceUpdates describes JSON patches that should be applied to
+   * Kubernetes resources, such as Deployments, to incorporate Freight into the
+   * Stage. This field is optional, as such actions are not required in all
+   * cases. Note that all updates specified by the GitRepoUpdates field, if any,
+   * are applied BEFORE these.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.KubernetesResourceUpdate kubernetesResourceUpdates = 7;
+   */
+  kubernetesResourceUpdates: KubernetesResourceUpdate[] = [];
+
+  /**
+   * Canary describes how to expose the Freight being promoted to only a
+   * percentage of traffic using the canary strategy of an Argo Rollouts Rollout.
+   * This field is optional. When specified, the Promotion is not considered
+   * complete until the Rollout's canary analysis has passed and the Rollout has
+   * been fully promoted. Note that all updates specified by the other fields, if
+   * any, are applied BEFORE the canary weight is set.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.CanaryPromotion canary = 8;
+   */
+  canary?: CanaryPromotion;
+
   constructor(data?: PartialMessage<PromotionMechanisms>) {
     super();
     proto2.util.initPartial(data, this);
@@ -3681,6 +5397,11 @@ export class PromotionMechanisms extends Message<PromotionMechanisms> {
     { no: 3, name: "origin", kind: "message", T: FreightOrigin, opt: true },
     { no: 1, name: "gitRepoUpdates", kind: "message", T: GitRepoUpdate, repeated: true },
     { no: 2, name: "argoCDAppUpdates", kind: "message", T: ArgoCDAppUpdate, repeated: true },
+    { no: 4, name: "changeApproval", kind: "message", T: ChangeApprovalCheck, opt: true },
+    { no: 5, name: "imagePullCheck", kind: "message", T: ImagePullCheck, opt: true },
+    { no: 6, name: "fluxHelmReleaseUpdates", kind: "message", T: FluxHelmReleaseUpdate, repeated: true },
+    { no: 7, name: "kubernetesResourceUpdates", kind: "message", T: KubernetesResourceUpdate, repeated: true },
+    { no: 8, name: "canary", kind: "message", T: CanaryPromotion, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionMechanisms {
@@ -3912,6 +5633,19 @@ export class PromotionStatus extends Message<PromotionStatus> {
    */
   message?: string;
 
+  /**
+   * Conditions contains the last observations of the Promotion's current state.
+   * Condition types are those of PromotionConditionType.
+   *
+   * +patchMergeKey=type
+   * +patchStrategy=merge
+   * +listType=map
+   * +listMapKey=type
+   *
+   * @generated from field: repeated k8s.io.apimachinery.pkg.apis.meta.v1.Condition conditions = 9;
+   */
+  conditions: Condition[] = [];
+
   /**
    * Metadata holds arbitrary metadata set by promotion mechanisms
    * (e.g. for display purposes, or internal bookkeeping)
@@ -3943,6 +5677,30 @@ export class PromotionStatus extends Message<PromotionStatus> {
    */
   finishedAt?: Time;
 
+  /**
+   * DryRun indicates that the Promotion was executed in dry-run mode because
+   * its Stage requested it. A dry-run Promotion makes no changes to any
+   * external system and does not alter the Freight history of its Stage.
+   *
+   * +optional
+   *
+   * @generated from field: optional bool dryRun = 8;
+   */
+  dryRun?: boolean;
+
+  /**
+   * ChartChanges lists the changes made to the Helm charts referenced by the
+   * promoted Freight since the versions of those charts previously used by
+   * the Stage. Changes are taken from the artifacthub.io/changes annotation of
+   * each intervening version of a chart and are only available for charts from
+   * classic (HTTP/S) chart repositories.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ChartChange chartChanges = 10;
+   */
+  chartChanges: ChartChange[] = [];
+
   constructor(data?: PartialMessage<PromotionStatus>) {
     super();
     proto2.util.initPartial(data, this);
@@ -3954,10 +5712,13 @@ export class PromotionStatus extends Message<PromotionStatus> {
     { no: 4, name: "lastHandledRefresh", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 1, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 9, name: "conditions", kind: "message", T: Condition, repeated: true },
     { no: 3, name: "metadata", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
     { no: 5, name: "freight", kind: "message", T: FreightReference, opt: true },
     { no: 7, name: "freightCollection", kind: "message", T: FreightCollection, opt: true },
     { no: 6, name: "finishedAt", kind: "message", T: Time, opt: true },
+    { no: 8, name: "dryRun", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 10, name: "chartChanges", kind: "message", T: ChartChange, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionStatus {
@@ -3977,6 +5738,148 @@ export class PromotionStatus extends Message<PromotionStatus> {
   }
 }
 
+/**
+ * PromotionTemplate describes PromotionMechanisms that may be shared by any
+ * number of Stages in the same Project, each of which references it using its
+ * PromotionTemplateRef field.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTemplate
+ */
+export class PromotionTemplate extends Message<PromotionTemplate> {
+  /**
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ObjectMeta metadata = 1;
+   */
+  metadata?: ObjectMeta;
+
+  /**
+   * Spec describes the PromotionMechanisms shared by this PromotionTemplate.
+   *
+   * +kubebuilder:validation:Required
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionTemplateSpec spec = 2;
+   */
+  spec?: PromotionTemplateSpec;
+
+  constructor(data?: PartialMessage<PromotionTemplate>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.PromotionTemplate";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: ObjectMeta, opt: true },
+    { no: 2, name: "spec", kind: "message", T: PromotionTemplateSpec, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionTemplate {
+    return new PromotionTemplate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionTemplate {
+    return new PromotionTemplate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionTemplate {
+    return new PromotionTemplate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionTemplate | PlainMessage<PromotionTemplate> | undefined, b: PromotionTemplate | PlainMessage<PromotionTemplate> | undefined): boolean {
+    return proto2.util.equals(PromotionTemplate, a, b);
+  }
+}
+
+/**
+ * PromotionTemplateList is a list of PromotionTemplate resources.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTemplateList
+ */
+export class PromotionTemplateList extends Message<PromotionTemplateList> {
+  /**
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ListMeta metadata = 1;
+   */
+  metadata?: ListMeta;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionTemplate items = 2;
+   */
+  items: PromotionTemplate[] = [];
+
+  constructor(data?: PartialMessage<PromotionTemplateList>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.PromotionTemplateList";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: ListMeta, opt: true },
+    { no: 2, name: "items", kind: "message", T: PromotionTemplate, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionTemplateList {
+    return new PromotionTemplateList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionTemplateList {
+    return new PromotionTemplateList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionTemplateList {
+    return new PromotionTemplateList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionTemplateList | PlainMessage<PromotionTemplateList> | undefined, b: PromotionTemplateList | PlainMessage<PromotionTemplateList> | undefined): boolean {
+    return proto2.util.equals(PromotionTemplateList, a, b);
+  }
+}
+
+/**
+ * PromotionTemplateSpec describes the PromotionMechanisms shared by a
+ * PromotionTemplate.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTemplateSpec
+ */
+export class PromotionTemplateSpec extends Message<PromotionTemplateSpec> {
+  /**
+   * PromotionMechanisms describes how to incorporate Freight into Stages
+   * referencing this PromotionTemplate. Any PromotionMechanisms specified by
+   * such a Stage are merged with these, with the Stage's taking precedence.
+   *
+   * +kubebuilder:validation:Required
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionMechanisms promotionMechanisms = 1;
+   */
+  promotionMechanisms?: PromotionMechanisms;
+
+  constructor(data?: PartialMessage<PromotionTemplateSpec>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.PromotionTemplateSpec";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "promotionMechanisms", kind: "message", T: PromotionMechanisms, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionTemplateSpec {
+    return new PromotionTemplateSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionTemplateSpec {
+    return new PromotionTemplateSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionTemplateSpec {
+    return new PromotionTemplateSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionTemplateSpec | PlainMessage<PromotionTemplateSpec> | undefined, b: PromotionTemplateSpec | PlainMessage<PromotionTemplateSpec> | undefined): boolean {
+    return proto2.util.equals(PromotionTemplateSpec, a, b);
+  }
+}
+
 /**
  * PullRequestPromotionMechanism describes how to generate a pull request against the write branch during promotion
  * Attempts to infer the git provider from well-known git domains.
@@ -3998,6 +5901,16 @@ export class PullRequestPromotionMechanism extends Message<PullRequestPromotionM
    */
   gitlab?: GitLabPullRequest;
 
+  /**
+   * DeleteBranchOnMerge specifies whether the branch from which a pull request
+   * was opened should be deleted from the remote repository once the pull
+   * request has been merged. The branch is never deleted while any other open
+   * pull request uses it.
+   *
+   * @generated from field: optional bool deleteBranchOnMerge = 3;
+   */
+  deleteBranchOnMerge?: boolean;
+
   constructor(data?: PartialMessage<PullRequestPromotionMechanism>) {
     super();
     proto2.util.initPartial(data, this);
@@ -4008,6 +5921,7 @@ export class PullRequestPromotionMechanism extends Message<PullRequestPromotionM
   static readonly fields: FieldList = proto2.util.newFieldList(() => [
     { no: 1, name: "github", kind: "message", T: GitHubPullRequest, opt: true },
     { no: 2, name: "gitlab", kind: "message", T: GitLabPullRequest, opt: true },
+    { no: 3, name: "deleteBranchOnMerge", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PullRequestPromotionMechanism {
@@ -4085,6 +5999,60 @@ export class RepoSubscription extends Message<RepoSubscription> {
   }
 }
 
+/**
+ * SecretKeyReference references a key of a Secret in the same namespace as
+ * the resource that references it.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.SecretKeyReference
+ */
+export class SecretKeyReference extends Message<SecretKeyReference> {
+  /**
+   * Name is the name of the Secret.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string name = 1;
+   */
+  name?: string;
+
+  /**
+   * Key is the key of the Secret's data that holds the referenced value.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string key = 2;
+   */
+  key?: string;
+
+  constructor(data?: PartialMessage<SecretKeyReference>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.SecretKeyReference";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SecretKeyReference {
+    return new SecretKeyReference().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SecretKeyReference {
+    return new SecretKeyReference().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SecretKeyReference {
+    return new SecretKeyReference().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SecretKeyReference | PlainMessage<SecretKeyReference> | undefined, b: SecretKeyReference | PlainMessage<SecretKeyReference> | undefined): boolean {
+    return proto2.util.equals(SecretKeyReference, a, b);
+  }
+}
+
 /**
  * Stage is the Kargo API's main type.
  *
@@ -4183,8 +6151,161 @@ export class StageList extends Message<StageList> {
     return new StageList().fromJsonString(jsonString, options);
   }
 
-  static equals(a: StageList | PlainMessage<StageList> | undefined, b: StageList | PlainMessage<StageList> | undefined): boolean {
-    return proto2.util.equals(StageList, a, b);
+  static equals(a: StageList | PlainMessage<StageList> | undefined, b: StageList | PlainMessage<StageList> | undefined): boolean {
+    return proto2.util.equals(StageList, a, b);
+  }
+}
+
+/**
+ * StageRole grants a set of subjects permission to get and patch a single
+ * Stage in the same Project, without granting them access to any of the
+ * Project's other Stages. This permits responsibility for a Stage, such as
+ * approving what is promoted to it, to be delegated to a specific team.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.StageRole
+ */
+export class StageRole extends Message<StageRole> {
+  /**
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ObjectMeta metadata = 1;
+   */
+  metadata?: ObjectMeta;
+
+  /**
+   * Spec describes the Stage and the subjects that are granted access to it.
+   *
+   * +kubebuilder:validation:Required
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.StageRoleSpec spec = 2;
+   */
+  spec?: StageRoleSpec;
+
+  constructor(data?: PartialMessage<StageRole>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.StageRole";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: ObjectMeta, opt: true },
+    { no: 2, name: "spec", kind: "message", T: StageRoleSpec, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageRole {
+    return new StageRole().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StageRole {
+    return new StageRole().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StageRole {
+    return new StageRole().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StageRole | PlainMessage<StageRole> | undefined, b: StageRole | PlainMessage<StageRole> | undefined): boolean {
+    return proto2.util.equals(StageRole, a, b);
+  }
+}
+
+/**
+ * StageRoleList is a list of StageRole resources.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.StageRoleList
+ */
+export class StageRoleList extends Message<StageRoleList> {
+  /**
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ListMeta metadata = 1;
+   */
+  metadata?: ListMeta;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.StageRole items = 2;
+   */
+  items: StageRole[] = [];
+
+  constructor(data?: PartialMessage<StageRoleList>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.StageRoleList";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: ListMeta, opt: true },
+    { no: 2, name: "items", kind: "message", T: StageRole, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageRoleList {
+    return new StageRoleList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StageRoleList {
+    return new StageRoleList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StageRoleList {
+    return new StageRoleList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StageRoleList | PlainMessage<StageRoleList> | undefined, b: StageRoleList | PlainMessage<StageRoleList> | undefined): boolean {
+    return proto2.util.equals(StageRoleList, a, b);
+  }
+}
+
+/**
+ * StageRoleSpec describes the Stage and the subjects that are granted access
+ * to it by a StageRole.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.StageRoleSpec
+ */
+export class StageRoleSpec extends Message<StageRoleSpec> {
+  /**
+   * Stage is the name of a Stage in the same namespace as the StageRole. This
+   * is a required field.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string stage = 1;
+   */
+  stage?: string;
+
+  /**
+   * Subjects are the users, groups, and ServiceAccounts that are granted
+   * permission to get and patch the Stage. At least one subject is required.
+   *
+   * +kubebuilder:validation:MinItems=1
+   *
+   * @generated from field: repeated k8s.io.api.rbac.v1.Subject subjects = 2;
+   */
+  subjects: Subject[] = [];
+
+  constructor(data?: PartialMessage<StageRoleSpec>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.StageRoleSpec";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "stage", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "subjects", kind: "message", T: Subject, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageRoleSpec {
+    return new StageRoleSpec().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StageRoleSpec {
+    return new StageRoleSpec().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StageRoleSpec {
+    return new StageRoleSpec().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StageRoleSpec | PlainMessage<StageRoleSpec> | undefined, b: StageRoleSpec | PlainMessage<StageRoleSpec> | undefined): boolean {
+    return proto2.util.equals(StageRoleSpec, a, b);
   }
 }
 
@@ -4202,6 +6323,10 @@ export class StageSpec extends Message<StageSpec> {
    * kargo.akuity.io/shard label with the value of this field. When this field
    * is empty, the webhook will ensure that label is absent.
    *
+   * +kubebuilder:validation:MaxLength=63
+   * +kubebuilder:validation:Pattern=^(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?$
+   * +optional
+   *
    * @generated from field: optional string shard = 4;
    */
   shard?: string;
@@ -4252,6 +6377,214 @@ export class StageSpec extends Message<StageSpec> {
    */
   verification?: Verification;
 
+  /**
+   * DryRun indicates that Promotions to this Stage should execute their
+   * promotion mechanisms without writing to any external system. Changes that
+   * would have been pushed to Git repositories or applied to Argo CD
+   * Applications are logged instead, and successful Promotions do not alter
+   * the Stage's Freight history.
+   *
+   * +optional
+   *
+   * @generated from field: optional bool dryRun = 6;
+   */
+  dryRun?: boolean;
+
+  /**
+   * FreightHistoryLimit is the maximum number of entries retained in the
+   * Stage's Freight history. When a successful Promotion causes the history to
+   * grow beyond this limit, the oldest entries are removed. When left
+   * unspecified, the limit is 10.
+   *
+   * +kubebuilder:default=10
+   * +kubebuilder:validation:Minimum=1
+   * +kubebuilder:validation:Maximum=100
+   *
+   * @generated from field: optional int32 freightHistoryLimit = 7;
+   */
+  freightHistoryLimit?: number;
+
+  /**
+   * NotificationWebhooks describes HTTP endpoints to be notified each time
+   * Freight is successfully promoted to the Stage. Notifications are delivered
+   * asynchronously and failures to deliver them do not affect the Stage.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.WebhookConfig notificationWebhooks = 8;
+   */
+  notificationWebhooks: WebhookConfig[] = [];
+
+  /**
+   * ConcurrencyPolicy specifies how a Promotion to this Stage is handled while
+   * a Promotion to another Stage is updating any of the same Git repositories.
+   * Allow, the default, lets both Promotions proceed. Forbid leaves this
+   * Promotion waiting until the other Promotion has concluded. Replace aborts
+   * the other Promotion so that this one can proceed immediately. Promotions
+   * to Stages with the Allow policy never wait for, or abort, other
+   * Promotions.
+   *
+   * +kubebuilder:default=Allow
+   * +kubebuilder:validation:Enum=Allow;Forbid;Replace
+   *
+   * @generated from field: optional string concurrencyPolicy = 9;
+   */
+  concurrencyPolicy?: string;
+
+  /**
+   * PromotionTimeout is the maximum amount of time a Promotion to this Stage
+   * may spend executing its promotion mechanisms, including any promotion
+   * hooks, in a single attempt. A Promotion that exceeds it is aborted and
+   * marked as Failed. If unspecified, no timeout is enforced.
+   *
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+   * +optional
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration promotionTimeout = 10;
+   */
+  promotionTimeout?: Duration;
+
+  /**
+   * CircuitBreaker describes when automatic Promotions to this Stage are
+   * suspended following repeated Promotion failures. If unspecified,
+   * automatic Promotions are never suspended.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.CircuitBreaker circuitBreaker = 11;
+   */
+  circuitBreaker?: CircuitBreaker;
+
+  /**
+   * HealthChecks describes checks, in addition to those of any Argo CD
+   * Applications updated by the Stage, that are performed when assessing the
+   * health of the Stage.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.HealthChecks healthChecks = 12;
+   */
+  healthChecks?: HealthChecks;
+
+  /**
+   * PrePromotionHook describes a Job that is run in the Stage's namespace
+   * before the promotion mechanisms of a Promotion to this Stage are
+   * executed. The promotion mechanisms are only executed once the Job has
+   * completed successfully. If the Job fails, the Promotion fails without
+   * any changes having been made.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.JobTemplate prePromotionHook = 13;
+   */
+  prePromotionHook?: JobTemplate;
+
+  /**
+   * PostPromotionHook describes a Job that is run in the Stage's namespace
+   * after the promotion mechanisms of a Promotion to this Stage have
+   * succeeded. The Promotion only succeeds once the Job has completed
+   * successfully. If the Job fails, the Promotion fails, but changes already
+   * made by the promotion mechanisms are not reverted.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.JobTemplate postPromotionHook = 14;
+   */
+  postPromotionHook?: JobTemplate;
+
+  /**
+   * Pipeline is the name of the pipeline that this Stage belongs to. This is an
+   * optional field. Stages belonging to the same pipeline are promoted in
+   * waves: a Promotion to a Stage is only executed once every Stage of the
+   * same pipeline in a lower wave is healthy. A defaulting webhook will sync
+   * the value of the kargo.akuity.io/pipeline label with the value of this
+   * field. When this field is empty, the webhook will ensure that label is
+   * absent.
+   *
+   * +kubebuilder:validation:MaxLength=63
+   * +kubebuilder:validation:Pattern=^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$
+   * +optional
+   *
+   * @generated from field: optional string pipeline = 15;
+   */
+  pipeline?: string;
+
+  /**
+   * Wave is the position of this Stage within its pipeline. Promotions to
+   * this Stage are only executed once every Stage of the same pipeline with a
+   * lower Wave is healthy. It is ignored if Pipeline is not specified. When
+   * left unspecified, the wave is 0.
+   *
+   * +kubebuilder:validation:Minimum=0
+   * +optional
+   *
+   * @generated from field: optional int32 wave = 16;
+   */
+  wave?: number;
+
+  /**
+   * PromotionTemplateRef references a PromotionTemplate in the Stage's
+   * namespace whose PromotionMechanisms describe how to incorporate Freight
+   * into the Stage. Any PromotionMechanisms specified by the Stage itself are
+   * merged with those of the PromotionTemplate, with the Stage's taking
+   * precedence wherever both describe the same update or check.
+   *
+   * +optional
+   *
+   * @generated from field: optional k8s.io.api.core.v1.LocalObjectReference promotionTemplateRef = 17;
+   */
+  promotionTemplateRef?: LocalObjectReference;
+
+  /**
+   * AutoPromotionExpression is an optional CEL expression that must evaluate
+   * to true for a piece of Freight to be promoted to the Stage automatically,
+   * e.g. `freight.images.all(i, !i.tag.contains("-rc"))`. The candidate
+   * Freight and the Stage are available to the expression as the freight and
+   * stage variables, respectively, with the same field names as their JSON
+   * representations. When the expression evaluates to false, the Freight is
+   * not promoted automatically, as if auto-promotion were not permitted.
+   * Promotions that are requested explicitly are not subject to it.
+   *
+   * +optional
+   *
+   * @generated from field: optional string autoPromotionExpression = 18;
+   */
+  autoPromotionExpression?: string;
+
+  /**
+   * PinnedFreight is the name of a piece of Freight the Stage is pinned to.
+   * While it is set, no Promotions of the Stage are created automatically,
+   * regardless of whether auto-promotion is otherwise permitted, so that the
+   * Stage remains at a known-good state, e.g. during an incident. The health of
+   * the Stage continues to be assessed and a Warning event is recorded whenever
+   * newer Freight is available to the Stage. Removing the field resumes
+   * auto-promotion.
+   *
+   * +optional
+   *
+   * @generated from field: optional string pinnedFreight = 19;
+   */
+  pinnedFreight?: string;
+
+  /**
+   * PromotionSchedule is an optional cron expression, in the standard five-field
+   * format and evaluated in UTC, describing when the newest Freight available to
+   * the Stage is promoted to it, e.g. "0 2 * * *" for a nightly rollout. At each
+   * scheduled time, the newest Freight available from each origin is promoted,
+   * even if it is already the Stage's current Freight. When a schedule is
+   * specified, newly available Freight is no longer promoted as soon as it
+   * becomes available. Scheduled Promotions are otherwise subject to the same
+   * conditions as auto-promotion: the Stage's Project must permit auto-promotion
+   * of the Stage, and scheduled Promotions are skipped while the Stage is pinned
+   * or its circuit is open.
+   *
+   * +optional
+   *
+   * @generated from field: optional string promotionSchedule = 20;
+   */
+  promotionSchedule?: string;
+
   constructor(data?: PartialMessage<StageSpec>) {
     super();
     proto2.util.initPartial(data, this);
@@ -4265,6 +6598,21 @@ export class StageSpec extends Message<StageSpec> {
     { no: 5, name: "requestedFreight", kind: "message", T: FreightRequest, repeated: true },
     { no: 2, name: "promotionMechanisms", kind: "message", T: PromotionMechanisms, opt: true },
     { no: 3, name: "verification", kind: "message", T: Verification, opt: true },
+    { no: 6, name: "dryRun", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 7, name: "freightHistoryLimit", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 8, name: "notificationWebhooks", kind: "message", T: WebhookConfig, repeated: true },
+    { no: 9, name: "concurrencyPolicy", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 10, name: "promotionTimeout", kind: "message", T: Duration, opt: true },
+    { no: 11, name: "circuitBreaker", kind: "message", T: CircuitBreaker, opt: true },
+    { no: 12, name: "healthChecks", kind: "message", T: HealthChecks, opt: true },
+    { no: 13, name: "prePromotionHook", kind: "message", T: JobTemplate, opt: true },
+    { no: 14, name: "postPromotionHook", kind: "message", T: JobTemplate, opt: true },
+    { no: 15, name: "pipeline", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 16, name: "wave", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 17, name: "promotionTemplateRef", kind: "message", T: LocalObjectReference, opt: true },
+    { no: 18, name: "autoPromotionExpression", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 19, name: "pinnedFreight", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 20, name: "promotionSchedule", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageSpec {
@@ -4318,6 +6666,19 @@ export class StageStatus extends Message<StageStatus> {
    */
   freightHistory: FreightCollection[] = [];
 
+  /**
+   * FreightHistoryDeltas is a compact representation of a FreightHistory, for
+   * Stages whose history would otherwise cause the Stage to exceed the maximum
+   * size of a resource. Entries are ordered like those of FreightHistory, with
+   * the most recent first. The last entry holds a full baseline and every other
+   * entry holds the differences from the entry that follows it. Use
+   * ReconstructFreightCollection to obtain the FreightCollection represented by
+   * any entry.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.FreightCollectionDelta freightHistoryDeltas = 17;
+   */
+  freightHistoryDeltas: FreightCollectionDelta[] = [];
+
   /**
    * FreightSummary is human-readable text maintained by the controller that
    * summarizes what Freight is currently deployed to the Stage. For Stages that
@@ -4361,6 +6722,19 @@ export class StageStatus extends Message<StageStatus> {
    */
   health?: Health;
 
+  /**
+   * Conditions contains the last observations of the Stage's current state.
+   * Condition types are those of StageConditionType.
+   *
+   * +patchMergeKey=type
+   * +patchStrategy=merge
+   * +listType=map
+   * +listMapKey=type
+   *
+   * @generated from field: repeated k8s.io.apimachinery.pkg.apis.meta.v1.Condition conditions = 15;
+   */
+  conditions: Condition[] = [];
+
   /**
    * Message describes any errors that are preventing the Stage controller
    * from assessing Stage health or from finding new Freight.
@@ -4391,6 +6765,31 @@ export class StageStatus extends Message<StageStatus> {
    */
   lastPromotion?: PromotionReference;
 
+  /**
+   * NotificationWebhooks describes the last attempt to deliver a notification
+   * to each of the Stage's notification webhooks.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.WebhookDeliveryStatus notificationWebhooks = 13;
+   */
+  notificationWebhooks: WebhookDeliveryStatus[] = [];
+
+  /**
+   * CircuitBreaker describes the state of the Stage's circuit breaker. It is
+   * only maintained for Stages that specify a circuit breaker.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.CircuitBreakerStatus circuitBreaker = 14;
+   */
+  circuitBreaker?: CircuitBreakerStatus;
+
+  /**
+   * NextScheduledPromotion is the time at which the Stage is next scheduled to be
+   * promoted. It is only maintained for Stages that specify a PromotionSchedule. It is absent while
+   * scheduled Promotions are suspended.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time nextScheduledPromotion = 16;
+   */
+  nextScheduledPromotion?: Time;
+
   constructor(data?: PartialMessage<StageStatus>) {
     super();
     proto2.util.initPartial(data, this);
@@ -4402,14 +6801,19 @@ export class StageStatus extends Message<StageStatus> {
     { no: 11, name: "lastHandledRefresh", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 1, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "freightHistory", kind: "message", T: FreightCollection, repeated: true },
+    { no: 17, name: "freightHistoryDeltas", kind: "message", T: FreightCollectionDelta, repeated: true },
     { no: 12, name: "freightSummary", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 2, name: "currentFreight", kind: "message", T: FreightReference, opt: true },
     { no: 3, name: "history", kind: "message", T: FreightReference, repeated: true },
     { no: 8, name: "health", kind: "message", T: Health, opt: true },
+    { no: 15, name: "conditions", kind: "message", T: Condition, repeated: true },
     { no: 9, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 6, name: "observedGeneration", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
     { no: 7, name: "currentPromotion", kind: "message", T: PromotionReference, opt: true },
     { no: 10, name: "lastPromotion", kind: "message", T: PromotionReference, opt: true },
+    { no: 13, name: "notificationWebhooks", kind: "message", T: WebhookDeliveryStatus, repeated: true },
+    { no: 14, name: "circuitBreaker", kind: "message", T: CircuitBreakerStatus, opt: true },
+    { no: 16, name: "nextScheduledPromotion", kind: "message", T: Time, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StageStatus {
@@ -4475,6 +6879,67 @@ export class StageSubscription extends Message<StageSubscription> {
   }
 }
 
+/**
+ * SubscriptionPollTimes holds the time at which each type of a Warehouse's
+ * subscriptions was last polled.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.SubscriptionPollTimes
+ */
+export class SubscriptionPollTimes extends Message<SubscriptionPollTimes> {
+  /**
+   * Git is the time at which the Warehouse's Git subscriptions were last
+   * polled.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time git = 1;
+   */
+  git?: Time;
+
+  /**
+   * Image is the time at which the Warehouse's image subscriptions were last
+   * polled.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time image = 2;
+   */
+  image?: Time;
+
+  /**
+   * Chart is the time at which the Warehouse's chart subscriptions were last
+   * polled.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time chart = 3;
+   */
+  chart?: Time;
+
+  constructor(data?: PartialMessage<SubscriptionPollTimes>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.SubscriptionPollTimes";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "git", kind: "message", T: Time, opt: true },
+    { no: 2, name: "image", kind: "message", T: Time, opt: true },
+    { no: 3, name: "chart", kind: "message", T: Time, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SubscriptionPollTimes {
+    return new SubscriptionPollTimes().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SubscriptionPollTimes {
+    return new SubscriptionPollTimes().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SubscriptionPollTimes {
+    return new SubscriptionPollTimes().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SubscriptionPollTimes | PlainMessage<SubscriptionPollTimes> | undefined, b: SubscriptionPollTimes | PlainMessage<SubscriptionPollTimes> | undefined): boolean {
+    return proto2.util.equals(SubscriptionPollTimes, a, b);
+  }
+}
+
 /**
  * Subscriptions describes a Stage's sources of Freight.
  *
@@ -4855,6 +7320,17 @@ export class WarehouseSpec extends Message<WarehouseSpec> {
    */
   interval?: Duration;
 
+  /**
+   * PollingIntervals optionally overrides Interval for each type of
+   * subscription, so that, for instance, frequent polling of image registries
+   * does not also cause Git repositories to be fetched just as frequently.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PollingIntervals pollingIntervals = 5;
+   */
+  pollingIntervals?: PollingIntervals;
+
   /**
    * FreightCreationPolicy describes how Freight is created by this Warehouse.
    * This field is optional. When left unspecified, the field is implicitly
@@ -4867,6 +7343,20 @@ export class WarehouseSpec extends Message<WarehouseSpec> {
    */
   freightCreationPolicy?: string;
 
+  /**
+   * FreightEqualityMode describes which artifacts are compared when deciding
+   * whether Freight built from the latest discovered artifacts differs from the
+   * Freight most recently produced by this Warehouse. Only Freight that differs
+   * is created. This field is optional. When left unspecified, the field is
+   * implicitly treated as if its value were "Strict".
+   *
+   * +kubebuilder:default=Strict
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string freightEqualityMode = 6;
+   */
+  freightEqualityMode?: string;
+
   /**
    * Subscriptions describes sources of artifacts to be included in Freight
    * produced by this Warehouse.
@@ -4887,7 +7377,9 @@ export class WarehouseSpec extends Message<WarehouseSpec> {
   static readonly fields: FieldList = proto2.util.newFieldList(() => [
     { no: 2, name: "shard", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "interval", kind: "message", T: Duration, opt: true },
+    { no: 5, name: "pollingIntervals", kind: "message", T: PollingIntervals, opt: true },
     { no: 3, name: "freightCreationPolicy", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "freightEqualityMode", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 1, name: "subscriptions", kind: "message", T: RepoSubscription, repeated: true },
   ]);
 
@@ -4955,6 +7447,14 @@ export class WarehouseStatus extends Message<WarehouseStatus> {
    */
   discoveredArtifacts?: DiscoveredArtifacts;
 
+  /**
+   * LastPolled holds the time at which each type of the Warehouse's
+   * subscriptions was last polled.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.SubscriptionPollTimes lastPolled = 9;
+   */
+  lastPolled?: SubscriptionPollTimes;
+
   constructor(data?: PartialMessage<WarehouseStatus>) {
     super();
     proto2.util.initPartial(data, this);
@@ -4968,6 +7468,7 @@ export class WarehouseStatus extends Message<WarehouseStatus> {
     { no: 4, name: "observedGeneration", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
     { no: 8, name: "lastFreightID", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 7, name: "discoveredArtifacts", kind: "message", T: DiscoveredArtifacts, opt: true },
+    { no: 9, name: "lastPolled", kind: "message", T: SubscriptionPollTimes, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WarehouseStatus {
@@ -4987,3 +7488,168 @@ export class WarehouseStatus extends Message<WarehouseStatus> {
   }
 }
 
+/**
+ * WebhookConfig describes an HTTP endpoint to be notified of successful
+ * Promotions to a Stage.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.WebhookConfig
+ */
+export class WebhookConfig extends Message<WebhookConfig> {
+  /**
+   * URL is the URL of the endpoint to be notified.
+   *
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:Pattern=`^https?://.+$`
+   *
+   * @generated from field: optional string url = 1;
+   */
+  url?: string;
+
+  /**
+   * Method is the HTTP method used to notify the endpoint. When left
+   * unspecified, the method is POST.
+   *
+   * +kubebuilder:default=POST
+   * +kubebuilder:validation:Enum=GET;POST
+   *
+   * @generated from field: optional string method = 2;
+   */
+  method?: string;
+
+  /**
+   * Headers are additional HTTP headers sent to the endpoint.
+   *
+   * +optional
+   *
+   * @generated from field: map<string, string> headers = 3;
+   */
+  headers: { [key: string]: string } = {};
+
+  /**
+   * BodyTemplate is a Go template rendered to produce the body of the request
+   * sent to the endpoint. The template is rendered against an object with the
+   * fields Project, Stage, and Promotion, which hold the names of the Stage's
+   * Project, the Stage, and the successful Promotion, and Freight, which holds
+   * the FreightCollection that was promoted. When left unspecified, the
+   * request has no body.
+   *
+   * +optional
+   *
+   * @generated from field: optional string bodyTemplate = 4;
+   */
+  bodyTemplate?: string;
+
+  constructor(data?: PartialMessage<WebhookConfig>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.WebhookConfig";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "method", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "headers", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 4, name: "bodyTemplate", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WebhookConfig {
+    return new WebhookConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WebhookConfig {
+    return new WebhookConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WebhookConfig {
+    return new WebhookConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WebhookConfig | PlainMessage<WebhookConfig> | undefined, b: WebhookConfig | PlainMessage<WebhookConfig> | undefined): boolean {
+    return proto2.util.equals(WebhookConfig, a, b);
+  }
+}
+
+/**
+ * WebhookDeliveryStatus describes the last attempt to deliver a notification
+ * to a notification webhook.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.WebhookDeliveryStatus
+ */
+export class WebhookDeliveryStatus extends Message<WebhookDeliveryStatus> {
+  /**
+   * URL is the URL of the notification webhook.
+   *
+   * @generated from field: optional string url = 1;
+   */
+  url?: string;
+
+  /**
+   * Promotion is the name of the Promotion the notification was sent for.
+   *
+   * @generated from field: optional string promotion = 2;
+   */
+  promotion?: string;
+
+  /**
+   * DeliveredAt is the time at which the delivery was attempted.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time deliveredAt = 3;
+   */
+  deliveredAt?: Time;
+
+  /**
+   * StatusCode is the HTTP status code returned by the notification webhook,
+   * if any.
+   *
+   * @generated from field: optional int32 statusCode = 4;
+   */
+  statusCode?: number;
+
+  /**
+   * Succeeded indicates whether the notification was delivered successfully.
+   *
+   * @generated from field: optional bool succeeded = 5;
+   */
+  succeeded?: boolean;
+
+  /**
+   * Message describes why the delivery failed, if it did.
+   *
+   * @generated from field: optional string message = 6;
+   */
+  message?: string;
+
+  constructor(data?: PartialMessage<WebhookDeliveryStatus>) {
+    super();
+    proto2.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto2 = proto2;
+  static readonly typeName = "github.com.akuity.kargo.api.v1alpha1.WebhookDeliveryStatus";
+  static readonly fields: FieldList = proto2.util.newFieldList(() => [
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 2, name: "promotion", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "deliveredAt", kind: "message", T: Time, opt: true },
+    { no: 4, name: "statusCode", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 5, name: "succeeded", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 6, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WebhookDeliveryStatus {
+    return new WebhookDeliveryStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WebhookDeliveryStatus {
+    return new WebhookDeliveryStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WebhookDeliveryStatus {
+    return new WebhookDeliveryStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WebhookDeliveryStatus | PlainMessage<WebhookDeliveryStatus> | undefined, b: WebhookDeliveryStatus | PlainMessage<WebhookDeliveryStatus> | undefined): boolean {
+    return proto2.util.equals(WebhookDeliveryStatus, a, b);
+  }
+}
+